@@ -9,12 +9,18 @@ import (
 // Claims representa las claims personalizadas para el token JWT.
 type Claims struct {
 	Subject string `json:"sub"`
+	// Purpose restringe el token a un único uso (ver GenerateScopedToken); vacío en los tokens de sesión.
+	Purpose string `json:"pur,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// PurposeClaim es el nombre de la claim con el propósito de un token de alcance restringido.
+const PurposeClaim = "pur"
+
 // TokenClaims representa las claims extraídas de un token validado.
 type TokenClaims struct {
 	Subject   string
+	Purpose   string
 	ExpiresAt time.Time
 	IssuedAt  time.Time
 }
@@ -29,4 +35,3 @@ type Token struct {
 	Subject          string
 	TokenType        string
 }
-
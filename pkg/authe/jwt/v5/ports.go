@@ -7,6 +7,7 @@ import (
 
 type Service interface {
 	GenerateTokens(context.Context, string, time.Duration, time.Duration) (*Token, error)
+	GenerateScopedToken(context.Context, string, string, time.Duration) (*Token, error)
 	ValidateToken(context.Context, string) (*TokenClaims, error)
 	GetAccessExpiration() time.Duration
 	GetRefreshExpiration() time.Duration
@@ -2,8 +2,10 @@ package pkgjwt
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -93,6 +95,46 @@ func (s *service) GenerateTokens(ctx context.Context, subject string,
 	}, nil
 }
 
+// GenerateScopedToken crea un único access token, sin refresh, cuyo uso queda restringido por la
+// claim de propósito. Lleva un jti aleatorio para que dos tokens del mismo sujeto nunca coincidan.
+func (s *service) GenerateScopedToken(ctx context.Context, subject, purpose string, expiration time.Duration) (*Token, error) {
+	if purpose == "" {
+		return nil, fmt.Errorf("scoped token purpose cannot be empty")
+	}
+	if expiration == 0 {
+		expiration = s.accessExpiration
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return nil, fmt.Errorf("error generating the token id: %w", err)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(expiration)
+	claims := Claims{
+		Subject: subject,
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        hex.EncodeToString(jti),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return nil, fmt.Errorf("error signing the scoped token: %w", err)
+	}
+
+	return &Token{
+		AccessToken:     signed,
+		AccessExpiresAt: expiresAt,
+		IssuedAt:        now,
+		Subject:         subject,
+		TokenType:       "Bearer",
+	}, nil
+}
+
 // ValidateToken valida un token y retorna las claims extraídas, usando el único secret.
 func (s *service) ValidateToken(ctx context.Context, tokenString string) (*TokenClaims, error) {
 	claims := &Claims{}
@@ -113,6 +155,7 @@ func (s *service) ValidateToken(ctx context.Context, tokenString string) (*Token
 
 	tokenClaims := &TokenClaims{
 		Subject:   claims.Subject,
+		Purpose:   claims.Purpose,
 		ExpiresAt: claims.ExpiresAt.Time,
 		IssuedAt:  claims.IssuedAt.Time,
 	}
@@ -137,6 +180,7 @@ func (s *service) ValidateTokenAllowExpired(ctx context.Context, tokenString str
 			// Devolvemos las claims aunque el token esté expirado
 			return &TokenClaims{
 				Subject:   claims.Subject,
+				Purpose:   claims.Purpose,
 				ExpiresAt: claims.ExpiresAt.Time,
 				IssuedAt:  claims.IssuedAt.Time,
 			}, nil
@@ -150,6 +194,7 @@ func (s *service) ValidateTokenAllowExpired(ctx context.Context, tokenString str
 
 	return &TokenClaims{
 		Subject:   claims.Subject,
+		Purpose:   claims.Purpose,
 		ExpiresAt: claims.ExpiresAt.Time,
 		IssuedAt:  claims.IssuedAt.Time,
 	}, nil
@@ -0,0 +1,42 @@
+package pkgtotp
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Bootstrap inicializa el servicio TOTP. Los valores vacíos se leen de variables de entorno.
+func Bootstrap(issuer string, digits, periodSeconds, skew int) (Service, error) {
+	if issuer == "" {
+		issuer = os.Getenv("TOTP_ISSUER")
+	}
+	if digits == 0 {
+		digits, _ = strconv.Atoi(os.Getenv("TOTP_DIGITS"))
+		if digits == 0 {
+			digits = 6
+		}
+	}
+	if periodSeconds == 0 {
+		periodSeconds, _ = strconv.Atoi(os.Getenv("TOTP_PERIOD_SECONDS"))
+		if periodSeconds == 0 {
+			periodSeconds = 30
+		}
+	}
+	if skew == 0 {
+		skew, _ = strconv.Atoi(os.Getenv("TOTP_SKEW"))
+	}
+
+	config := newConfig(
+		issuer,
+		digits,
+		periodSeconds,
+		skew,
+	)
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid TOTP configuration: %w", err)
+	}
+
+	return newService(config), nil
+}
@@ -0,0 +1,59 @@
+package pkgtotp
+
+import (
+	"fmt"
+	"time"
+)
+
+type config struct {
+	issuer string
+	digits int
+	period time.Duration
+	skew   int
+}
+
+// newConfig crea una nueva configuración TOTP.
+func newConfig(issuer string, digits, periodSeconds, skew int) Config {
+	return &config{
+		issuer: issuer,
+		digits: digits,
+		period: time.Duration(periodSeconds) * time.Second,
+		skew:   skew,
+	}
+}
+
+// GetIssuer devuelve el emisor que se muestra en la app autenticadora.
+func (c *config) GetIssuer() string {
+	return c.issuer
+}
+
+// GetDigits devuelve la cantidad de dígitos de cada código.
+func (c *config) GetDigits() int {
+	return c.digits
+}
+
+// GetPeriod devuelve la duración de cada ventana de tiempo.
+func (c *config) GetPeriod() time.Duration {
+	return c.period
+}
+
+// GetSkew devuelve cuántas ventanas adyacentes se aceptan al validar.
+func (c *config) GetSkew() int {
+	return c.skew
+}
+
+func (c *config) Validate() error {
+	if c.issuer == "" {
+		return fmt.Errorf("TOTP issuer is not configured")
+	}
+	if c.digits != 6 && c.digits != 8 {
+		return fmt.Errorf("TOTP digits must be 6 or 8, got %d", c.digits)
+	}
+	if c.period <= 0 {
+		return fmt.Errorf("TOTP period must be greater than 0")
+	}
+	if c.skew < 0 {
+		return fmt.Errorf("TOTP skew must be a non-negative integer")
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package pkgtotp
+
+import "time"
+
+// Service define las operaciones TOTP (RFC 6238) disponibles.
+type Service interface {
+	GenerateSecret() (string, error)
+	ProvisioningURI(secret, accountName string) string
+	GenerateCode(secret string, t time.Time) (string, error)
+	ValidateCode(secret, code string, t time.Time) (bool, error)
+	MatchStep(secret, code string, t time.Time) (uint64, bool, error)
+}
+
+// Config define la configuración que debe cumplir el servicio TOTP.
+type Config interface {
+	GetIssuer() string
+	GetDigits() int
+	GetPeriod() time.Duration
+	GetSkew() int
+	Validate() error
+}
@@ -0,0 +1,124 @@
+package pkgtotp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// secretSize es el tamaño en bytes del secreto compartido (160 bits, recomendado por RFC 4226).
+const secretSize = 20
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type service struct {
+	config Config
+}
+
+func newService(c Config) Service {
+	return &service{
+		config: c,
+	}
+}
+
+// GenerateSecret genera un secreto aleatorio codificado en base32, listo para la app autenticadora.
+func (s *service) GenerateSecret() (string, error) {
+	buf := make([]byte, secretSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return encoding.EncodeToString(buf), nil
+}
+
+// ProvisioningURI construye el URI otpauth:// que se codifica en el QR de enrolamiento.
+func (s *service) ProvisioningURI(secret, accountName string) string {
+	issuer := s.config.GetIssuer()
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprintf("%d", s.config.GetDigits()))
+	params.Set("period", fmt.Sprintf("%d", int(s.config.GetPeriod().Seconds())))
+
+	label := url.PathEscape(issuer + ":" + accountName)
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, params.Encode())
+}
+
+// GenerateCode calcula el código correspondiente al instante t.
+func (s *service) GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return s.codeAt(key, s.counter(t)), nil
+}
+
+// ValidateCode verifica un código aceptando las ventanas adyacentes configuradas en skew.
+func (s *service) ValidateCode(secret, code string, t time.Time) (bool, error) {
+	_, ok, err := s.MatchStep(secret, code, t)
+	return ok, err
+}
+
+// MatchStep verifica un código como ValidateCode y devuelve además la ventana (contador RFC 6238)
+// en la que coincidió. Quien lo use puede rechazar ventanas ya aceptadas para evitar replays.
+func (s *service) MatchStep(secret, code string, t time.Time) (uint64, bool, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) != s.config.GetDigits() {
+		return 0, false, nil
+	}
+
+	counter := s.counter(t)
+	skew := int64(s.config.GetSkew())
+	for i := -skew; i <= skew; i++ {
+		step := uint64(int64(counter) + i)
+		expected := s.codeAt(key, step)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func (s *service) counter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(s.config.GetPeriod().Seconds()))
+}
+
+// codeAt implementa HOTP (RFC 4226) con truncamiento dinámico.
+func (s *service) codeAt(key []byte, counter uint64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	digits := s.config.GetDigits()
+	mod := uint32(math.Pow10(digits))
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := encoding.DecodeString(strings.TrimRight(normalized, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	return key, nil
+}
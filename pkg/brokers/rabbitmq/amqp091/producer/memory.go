@@ -0,0 +1,155 @@
+// memory.go
+package pkgrabbit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// MemoryMessage es un mensaje publicado en un MemoryProducer.
+type MemoryMessage struct {
+	Queue         string
+	ReplyTo       string
+	CorrelationID string
+	Body          []byte
+}
+
+// MemoryProducer es un Producer que guarda los mensajes en memoria en lugar de enviarlos.
+// Sirve para tests y para levantar la API sin RabbitMQ. Channel y GetConnection no tienen
+// conexión que devolver. También implementa el Consumer del paquete consumer, así los
+// workers pueden consumir en memoria lo que se publica.
+type MemoryProducer struct {
+	mu       sync.Mutex
+	messages []MemoryMessage
+	cursors  map[string]int // Próximo mensaje a entregar por cola
+	notify   chan struct{}  // Se cierra (y se reemplaza) con cada mensaje nuevo
+	closed   bool
+}
+
+// NewMemoryProducer crea un MemoryProducer vacío.
+func NewMemoryProducer() *MemoryProducer {
+	return &MemoryProducer{
+		cursors: make(map[string]int),
+		notify:  make(chan struct{}),
+	}
+}
+
+func (p *MemoryProducer) Channel() (*amqp091.Channel, error) {
+	return nil, errors.New("memory producer has no channel")
+}
+
+func (p *MemoryProducer) GetConnection() *amqp091.Connection {
+	return nil
+}
+
+func (p *MemoryProducer) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.notify)
+	}
+	return nil
+}
+
+// Produce serializa el mensaje a JSON como el producer real y lo guarda.
+func (p *MemoryProducer) Produce(ctx context.Context, queueName, replyTo, corrID string, message any) (string, error) {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal message: %w", err)
+	}
+	if corrID == "" {
+		corrID = fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return "", errors.New("memory producer is closed")
+	}
+	p.publish(MemoryMessage{
+		Queue:         queueName,
+		ReplyTo:       replyTo,
+		CorrelationID: corrID,
+		Body:          body,
+	})
+	return corrID, nil
+}
+
+// publish agrega el mensaje y despierta a los consumidores. Requiere p.mu tomado.
+func (p *MemoryProducer) publish(msg MemoryMessage) {
+	p.messages = append(p.messages, msg)
+	close(p.notify)
+	p.notify = make(chan struct{})
+}
+
+func (p *MemoryProducer) ProduceWithRetry(ctx context.Context, queueName, replyTo, corrID string, message any, maxRetries int) (string, error) {
+	return p.Produce(ctx, queueName, replyTo, corrID, message)
+}
+
+// Consume entrega los mensajes de queueName a handler hasta que se cancele ctx o se cierre el
+// producer. Varios consumidores de la misma cola compiten por los mensajes, y si handler
+// devuelve error el mensaje vuelve al final de la cola, como el Nack con requeue del consumer real.
+func (p *MemoryProducer) Consume(ctx context.Context, queueName, consumerTag string, handler func(amqp091.Delivery) error) error {
+	for {
+		msg, wait, err := p.next(queueName)
+		if err != nil {
+			return err
+		}
+		if wait != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-wait:
+			}
+			continue
+		}
+
+		delivery := amqp091.Delivery{
+			ContentType:   "application/json",
+			CorrelationId: msg.CorrelationID,
+			ReplyTo:       msg.ReplyTo,
+			RoutingKey:    msg.Queue,
+			ConsumerTag:   consumerTag,
+			Body:          msg.Body,
+		}
+		if err := handler(delivery); err != nil {
+			p.mu.Lock()
+			if !p.closed {
+				p.publish(*msg)
+			}
+			p.mu.Unlock()
+		}
+	}
+}
+
+// next devuelve el próximo mensaje de la cola o, si no hay, un canal para esperar el siguiente.
+func (p *MemoryProducer) next(queueName string) (*MemoryMessage, <-chan struct{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil, nil, errors.New("memory producer is closed")
+	}
+	for i := p.cursors[queueName]; i < len(p.messages); i++ {
+		if p.messages[i].Queue == queueName {
+			p.cursors[queueName] = i + 1
+			msg := p.messages[i]
+			return &msg, nil, nil
+		}
+	}
+	p.cursors[queueName] = len(p.messages)
+	return nil, p.notify, nil
+}
+
+// Messages devuelve una copia de los mensajes publicados, en orden.
+func (p *MemoryProducer) Messages() []MemoryMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]MemoryMessage(nil), p.messages...)
+}
@@ -85,6 +85,21 @@ func (ch *cache) Get(ctx context.Context, key string) (string, error) {
 	return result, nil
 }
 
+// GetDel recupera y elimina atómicamente el valor de una clave
+func (ch *cache) GetDel(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		return "", errors.New("key cannot be empty")
+	}
+
+	result, err := ch.client.GetDel(ctx, key).Result()
+	if err == redis.Nil {
+		return "", redis.Nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to getdel key: %w", err)
+	}
+	return result, nil
+}
+
 // Delete elimina una clave de Redis
 func (ch *cache) Delete(ctx context.Context, key string) error {
 	if key == "" {
@@ -130,6 +145,25 @@ func (ch *cache) Exists(ctx context.Context, key string) (bool, error) {
 	return count > 0, nil
 }
 
+// Incr incrementa el contador de una clave y devuelve el nuevo valor. Al crear el contador le
+// asigna la expiración indicada, de modo que vence junto con la ventana que controla.
+func (ch *cache) Incr(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	if key == "" {
+		return 0, errors.New("key cannot be empty")
+	}
+
+	count, err := ch.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment key: %w", err)
+	}
+	if count == 1 && expiration > 0 {
+		if err := ch.client.Expire(ctx, key, expiration).Err(); err != nil {
+			return 0, fmt.Errorf("failed to set key expiration: %w", err)
+		}
+	}
+	return count, nil
+}
+
 // Close cierra la conexión con el servidor Redis
 func (ch *cache) Close() {
 	if ch.client != nil {
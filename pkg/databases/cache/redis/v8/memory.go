@@ -0,0 +1,246 @@
+package pkgredis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// memoryEntry es un valor string o una lista, con su vencimiento (cero = sin vencimiento).
+type memoryEntry struct {
+	value     string
+	list      []string
+	isList    bool
+	expiresAt time.Time
+}
+
+func (e memoryEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+type memoryCache struct {
+	mu                sync.Mutex
+	entries           map[string]memoryEntry
+	defaultExpiration time.Duration
+}
+
+// NewMemoryCache devuelve una Cache en memoria con la misma semántica que Redis para las operaciones
+// de la interfaz (Get de una clave inexistente devuelve redis.Nil). Pensada para tests y para
+// levantar la API sin Redis; Client devuelve nil.
+func NewMemoryCache(defaultExpiration time.Duration) Cache {
+	return &memoryCache{
+		entries:           map[string]memoryEntry{},
+		defaultExpiration: defaultExpiration,
+	}
+}
+
+// get devuelve la entrada vigente de key; las vencidas se borran al leerlas. Requiere mu.
+func (m *memoryCache) get(key string) (memoryEntry, bool) {
+	entry, ok := m.entries[key]
+	if ok && entry.expired(time.Now()) {
+		delete(m.entries, key)
+		return memoryEntry{}, false
+	}
+	return entry, ok
+}
+
+func (m *memoryCache) Set(ctx context.Context, key string, value any, expiration ...time.Duration) error {
+	if key == "" {
+		return errors.New("key cannot be empty")
+	}
+
+	exp := m.defaultExpiration
+	if len(expiration) > 0 {
+		exp = expiration[0]
+	}
+	entry := memoryEntry{value: formatValue(value)}
+	if exp > 0 {
+		entry.expiresAt = time.Now().Add(exp)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = entry
+	return nil
+}
+
+func (m *memoryCache) Get(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		return "", errors.New("key cannot be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.get(key)
+	if !ok {
+		return "", redis.Nil
+	}
+	if entry.isList {
+		return "", errors.New("failed to get key: WRONGTYPE Operation against a key holding the wrong kind of value")
+	}
+	return entry.value, nil
+}
+
+func (m *memoryCache) GetDel(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		return "", errors.New("key cannot be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.get(key)
+	if !ok {
+		return "", redis.Nil
+	}
+	if entry.isList {
+		return "", errors.New("failed to getdel key: WRONGTYPE Operation against a key holding the wrong kind of value")
+	}
+	delete(m.entries, key)
+	return entry.value, nil
+}
+
+func (m *memoryCache) Delete(ctx context.Context, key string) error {
+	if key == "" {
+		return errors.New("key cannot be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+	return nil
+}
+
+func (m *memoryCache) TTL(ctx context.Context, key string) (time.Duration, error) {
+	if key == "" {
+		return 0, errors.New("key cannot be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.get(key)
+	if !ok {
+		return 0, fmt.Errorf("key does not exist")
+	}
+	if entry.expiresAt.IsZero() {
+		return 0, fmt.Errorf("key exists but has no expiration")
+	}
+	return time.Until(entry.expiresAt), nil
+}
+
+func (m *memoryCache) Exists(ctx context.Context, key string) (bool, error) {
+	if key == "" {
+		return false, errors.New("key cannot be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.get(key)
+	return ok, nil
+}
+
+func (m *memoryCache) Incr(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	if key == "" {
+		return 0, errors.New("key cannot be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.get(key)
+	if entry.isList {
+		return 0, errors.New("failed to increment key: WRONGTYPE Operation against a key holding the wrong kind of value")
+	}
+
+	var count int64
+	if ok {
+		current, err := strconv.ParseInt(entry.value, 10, 64)
+		if err != nil {
+			return 0, errors.New("failed to increment key: ERR value is not an integer or out of range")
+		}
+		count = current
+	}
+	count++
+
+	entry.value = strconv.FormatInt(count, 10)
+	if !ok && expiration > 0 {
+		entry.expiresAt = time.Now().Add(expiration)
+	}
+	m.entries[key] = entry
+	return count, nil
+}
+
+// LPush inserta los valores al inicio de la lista, uno por uno como Redis (LPUSH k a b deja b, a).
+func (m *memoryCache) LPush(ctx context.Context, key string, values ...any) error {
+	if key == "" {
+		return errors.New("key cannot be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.get(key)
+	if ok && !entry.isList {
+		return errors.New("WRONGTYPE Operation against a key holding the wrong kind of value")
+	}
+	entry.isList = true
+	for _, v := range values {
+		entry.list = slices.Insert(entry.list, 0, formatValue(v))
+	}
+	m.entries[key] = entry
+	return nil
+}
+
+// LTrim conserva los elementos entre start y stop (inclusive); los índices negativos cuentan desde el final.
+func (m *memoryCache) LTrim(ctx context.Context, key string, start, stop int64) error {
+	if key == "" {
+		return errors.New("key cannot be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.get(key)
+	if !ok {
+		return nil
+	}
+	if !entry.isList {
+		return errors.New("WRONGTYPE Operation against a key holding the wrong kind of value")
+	}
+
+	n := int64(len(entry.list))
+	if start < 0 {
+		start = max(n+start, 0)
+	}
+	if stop < 0 {
+		stop = n + stop
+	}
+	stop = min(stop, n-1)
+	if start > stop {
+		delete(m.entries, key)
+		return nil
+	}
+	entry.list = slices.Clone(entry.list[start : stop+1])
+	m.entries[key] = entry
+	return nil
+}
+
+func (m *memoryCache) Close() {}
+
+func (m *memoryCache) Client() *redis.Client {
+	return nil
+}
+
+// formatValue convierte el valor como lo hace go-redis al enviarlo al servidor.
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
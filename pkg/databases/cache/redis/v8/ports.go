@@ -11,9 +11,11 @@ import (
 type Cache interface {
 	Set(ctx context.Context, key string, value any, expiration ...time.Duration) error
 	Get(ctx context.Context, key string) (string, error)
+	GetDel(ctx context.Context, key string) (string, error)
 	Delete(ctx context.Context, key string) error
 	TTL(ctx context.Context, key string) (time.Duration, error)
 	Exists(ctx context.Context, key string) (bool, error)
+	Incr(ctx context.Context, key string, expiration time.Duration) (int64, error)
 	LPush(ctx context.Context, key string, values ...any) error
 	LTrim(ctx context.Context, key string, start, stop int64) error
 	Close()
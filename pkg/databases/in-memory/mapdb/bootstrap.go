@@ -1,5 +1,7 @@
 package pkgmapdb
 
-func Boostrap() Repository {
-	return newRepository()
+// Bootstrap crea una base en memoria vacía. Cada llamada devuelve una base independiente,
+// por lo que cada test puede tener la suya.
+func Bootstrap() Repository {
+	return newStore()
 }
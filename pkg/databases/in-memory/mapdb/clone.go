@@ -0,0 +1,68 @@
+package pkgmapdb
+
+import "reflect"
+
+// clone devuelve una copia profunda de v: punteros, slices, mapas e interfaces se copian para que
+// quien lee una fila no pueda modificar la versión guardada (ni al revés). Los campos no exportados
+// se copian por valor (time.Time, por ejemplo, es inmutable).
+func clone[T any](v *T) *T {
+	out := new(T)
+	*out = *v
+	deepCopy(reflect.ValueOf(out).Elem())
+	return out
+}
+
+// deepCopy reemplaza en el lugar las referencias de v por copias.
+func deepCopy(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+		copied := reflect.New(v.Elem().Type())
+		copied.Elem().Set(v.Elem())
+		deepCopy(copied.Elem())
+		v.Set(copied)
+	case reflect.Slice:
+		if v.IsNil() {
+			return
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(copied, v)
+		for i := 0; i < copied.Len(); i++ {
+			deepCopy(copied.Index(i))
+		}
+		v.Set(copied)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			deepCopy(v.Index(i))
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			value := reflect.New(iter.Value().Type()).Elem()
+			value.Set(iter.Value())
+			deepCopy(value)
+			copied.SetMapIndex(iter.Key(), value)
+		}
+		v.Set(copied)
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		inner := reflect.New(v.Elem().Type()).Elem()
+		inner.Set(v.Elem())
+		deepCopy(inner)
+		v.Set(inner)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Field(i); field.CanSet() {
+				deepCopy(field)
+			}
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package pkgmapdb
+
+// Repository es una base de datos en memoria: tablas tipadas (NewTable) con índices secundarios,
+// predicados simples, paginación por QuerySpec y transacciones con aislamiento snapshot (NewTxManager).
+// Es segura para uso concurrente. Pensada para tests y para levantar la API sin servicios externos.
+type Repository interface {
+	// Tables devuelve los nombres de las tablas registradas, ordenados.
+	Tables() []string
+	// Reset borra los datos de todas las tablas; las tablas e índices siguen registrados.
+	Reset()
+
+	store() *store
+}
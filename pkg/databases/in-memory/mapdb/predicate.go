@@ -0,0 +1,194 @@
+package pkgmapdb
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// Predicate filtra filas en Find, Count, DeleteWhere y Page. Recibe la fila guardada: no debe modificarla.
+type Predicate[T any] func(*T) bool
+
+// And se cumple si se cumplen todos los predicados (sin predicados, siempre).
+func And[T any](preds ...Predicate[T]) Predicate[T] {
+	return func(v *T) bool {
+		for _, p := range preds {
+			if !p(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or se cumple si se cumple alguno de los predicados.
+func Or[T any](preds ...Predicate[T]) Predicate[T] {
+	return func(v *T) bool {
+		for _, p := range preds {
+			if p(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not niega un predicado.
+func Not[T any](pred Predicate[T]) Predicate[T] {
+	return func(v *T) bool { return !pred(v) }
+}
+
+// Where compara una columna de la fila con values usando los operadores de los listados.
+// La columna se resuelve como en types.KeysetCursorFromItem (tags db, bson, cql, gorm o json).
+// Como en SQL, una columna nil no cumple ninguna comparación.
+func Where[T any](column string, op types.FilterOperator, values ...any) Predicate[T] {
+	filter := types.Filter{Column: column, Op: op, Values: values}
+	return func(v *T) bool { return matchFilter(v, filter) }
+}
+
+func matchFilter(item any, f types.Filter) bool {
+	value, ok := types.ColumnValue(item, f.Column)
+	if !ok || value == nil {
+		return false
+	}
+
+	switch f.Op {
+	case types.OpIn:
+		for _, candidate := range f.Values {
+			if c, ok := compareValues(value, candidate); ok && c == 0 {
+				return true
+			}
+		}
+		return false
+	case types.OpLike:
+		return strings.Contains(strings.ToLower(fmt.Sprint(value)), strings.ToLower(fmt.Sprint(f.Value())))
+	}
+
+	c, ok := compareValues(value, f.Value())
+	if !ok {
+		return false
+	}
+	switch f.Op {
+	case types.OpEq:
+		return c == 0
+	case types.OpNe:
+		return c != 0
+	case types.OpGt:
+		return c > 0
+	case types.OpGte:
+		return c >= 0
+	case types.OpLt:
+		return c < 0
+	case types.OpLte:
+		return c <= 0
+	default:
+		return false
+	}
+}
+
+// compareValues compara dos valores de columna normalizando enteros, flotantes, strings (incluidos
+// tipos definidos sobre string), bools y fechas. nil es menor que cualquier valor.
+// Devuelve false si los tipos no son comparables.
+func compareValues(a, b any) (int, bool) {
+	a, b = normalizeValue(a), normalizeValue(b)
+	switch {
+	case a == nil && b == nil:
+		return 0, true
+	case a == nil:
+		return -1, true
+	case b == nil:
+		return 1, true
+	}
+
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return cmpOrdered(x, y), true
+		case float64:
+			return cmpOrdered(float64(x), y), true
+		}
+	case float64:
+		switch y := b.(type) {
+		case float64:
+			return cmpOrdered(x, y), true
+		case int64:
+			return cmpOrdered(x, float64(y)), true
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), true
+		}
+	case bool:
+		if y, ok := b.(bool); ok {
+			switch {
+			case x == y:
+				return 0, true
+			case !x:
+				return -1, true
+			default:
+				return 1, true
+			}
+		}
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y), true
+		}
+	}
+	return 0, false
+}
+
+func cmpOrdered[N int64 | float64](a, b N) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func normalizeValue(v any) any {
+	if v == nil {
+		return nil
+	}
+	switch t := v.(type) {
+	case time.Time:
+		return t
+	case driver.Valuer:
+		// sql.NullTime, gorm.DeletedAt y similares se comparan por su valor en la base
+		if value, err := t.Value(); err == nil && reflect.TypeOf(value) != reflect.TypeOf(v) {
+			return normalizeValue(value)
+		}
+	case encoding.TextMarshaler:
+		// ObjectID, uuid.UUID y similares se comparan por su forma textual
+		if text, err := t.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return normalizeValue(rv.Elem().Interface())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	}
+	return v
+}
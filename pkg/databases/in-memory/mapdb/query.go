@@ -0,0 +1,116 @@
+package pkgmapdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// Page devuelve una página de filas con la misma semántica que gorm.Paginate: aplica preds y los
+// filtros del spec, ordena por spec.Sort, continúa desde el cursor keyset, aplica Offset y Limit y
+// cuenta el total (sin cursor) si se pidió. Las columnas se resuelven con types.ColumnValue.
+// Si T tiene columna deleted_at, spec.Deleted decide si se incluyen las filas borradas lógicamente.
+// El sparse fieldset no se aplica: lo recorta types.NewPageResponse.
+func (t *Table[T]) Page(ctx context.Context, spec *types.QuerySpec, preds ...Predicate[T]) (*types.Page[T], error) {
+	deleted, err := deletedFilter[T](spec.Deleted)
+	if err != nil {
+		return nil, err
+	}
+	filters := make([]Predicate[T], 0, len(preds)+len(spec.Filters)+1)
+	filters = append(filters, deleted)
+	filters = append(filters, preds...)
+	for _, f := range spec.Filters {
+		filter := f
+		filters = append(filters, func(v *T) bool { return matchFilter(v, filter) })
+	}
+	rows := t.scan(t.store.read(ctx), And(filters...))
+
+	var total *int64
+	if spec.IncludeTotal {
+		count := int64(len(rows))
+		total = &count
+	}
+
+	slices.SortStableFunc(rows, func(a, b *T) int { return compareRows(spec.Sort, a, b) })
+
+	after, err := types.DecodeKeysetCursor(spec)
+	if err != nil {
+		return nil, err
+	}
+	if after != nil {
+		start, _ := slices.BinarySearchFunc(rows, after, func(row *T, cursor []any) int {
+			if compareToCursor(spec.Sort, row, cursor) <= 0 {
+				return -1
+			}
+			return 1
+		})
+		rows = rows[start:]
+	}
+
+	rows = rows[min(spec.Offset, len(rows)):]
+	rows = rows[:min(spec.Limit+1, len(rows))]
+
+	items := make([]T, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, *clone(r))
+	}
+	return types.NewPage(items, spec, total, func(last T) (string, error) {
+		return types.KeysetCursorFromItem(spec, last)
+	})
+}
+
+func compareRows(sort []types.SortField, a, b any) int {
+	for _, s := range sort {
+		x, _ := types.ColumnValue(a, s.Column)
+		y, _ := types.ColumnValue(b, s.Column)
+		if c := directed(s, x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareToCursor compara la fila con los valores de orden del cursor: > 0 si la fila va después.
+func compareToCursor(sort []types.SortField, row any, cursor []any) int {
+	for i, s := range sort {
+		x, _ := types.ColumnValue(row, s.Column)
+		if c := directed(s, x, cursor[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func directed(s types.SortField, x, y any) int {
+	c, _ := compareValues(x, y)
+	if s.Desc {
+		return -c
+	}
+	return c
+}
+
+// IsDeleted indica si la fila tiene deleted_at con valor (soft delete, como gorm.DeletedAt).
+// Las filas sin columna deleted_at nunca se consideran borradas.
+func IsDeleted(v any) bool {
+	value, ok := types.ColumnValue(v, "deleted_at")
+	return ok && normalizeValue(value) != nil
+}
+
+func deletedFilter[T any](mode types.DeletedMode) (Predicate[T], error) {
+	switch mode {
+	case types.DeletedExclude:
+		return func(v *T) bool { return !IsDeleted(v) }, nil
+	case types.DeletedInclude:
+		return func(*T) bool { return true }, nil
+	case types.DeletedOnly:
+		var zero T
+		if _, ok := types.ColumnValue(zero, "deleted_at"); !ok {
+			return nil, types.NewError(types.ErrValidation, "resource does not support deleted records", nil)
+		}
+		return func(v *T) bool { return IsDeleted(v) }, nil
+	default:
+		return nil, types.NewError(types.ErrValidation, fmt.Sprintf("unknown deleted mode %q", mode), nil)
+	}
+}
@@ -1,33 +1,237 @@
 package pkgmapdb
 
 import (
+	"fmt"
+	"slices"
 	"sync"
+	"sync/atomic"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 )
 
-type Repository interface {
-	GetDb() map[string]any
+// row es una versión de una fila. Los borrados dejan una fila con deleted en true (tombstone)
+// para que una transacción que leyó la fila antes del borrado detecte el conflicto.
+type row struct {
+	value   any
+	version uint64
+	deleted bool
 }
 
+// tableData son las filas de una tabla y sus índices (índice -> valor -> claves).
+type tableData struct {
+	rows    map[string]row
+	indexes map[string]map[string]map[string]struct{}
+}
 
-var (
-	instance Repository
-	once     sync.Once
-)
+// snapshot es el estado completo de la base en una versión. Nunca se modifica una vez publicado:
+// cada commit arma una copia de las tablas que cambió.
+type snapshot struct {
+	version uint64
+	tables  map[string]*tableData
+}
+
+// indexDef es la definición de un índice sin el tipo de la fila.
+type indexDef struct {
+	name   string
+	unique bool
+	values func(any) []string
+}
+
+type tableSchema struct {
+	name    string
+	indexes []indexDef
+}
+
+type store struct {
+	// mu serializa los commits y el registro de tablas; las lecturas solo cargan current.
+	mu      sync.Mutex
+	current atomic.Pointer[snapshot]
+	schemas map[string]*tableSchema
+}
+
+func newStore() *store {
+	s := &store{schemas: map[string]*tableSchema{}}
+	s.current.Store(&snapshot{tables: map[string]*tableData{}})
+	return s
+}
+
+func (s *store) store() *store {
+	return s
+}
+
+func (s *store) Tables() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make([]string, 0, len(s.schemas))
+	for name := range s.schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (s *store) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cur := s.current.Load()
+	s.current.Store(&snapshot{version: cur.version + 1, tables: map[string]*tableData{}})
+}
+
+// register guarda el schema de una tabla. Si ya existía con datos, reconstruye sus índices.
+func (s *store) register(schema *tableSchema) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.schemas[schema.name] = schema
+
+	cur := s.current.Load()
+	data, ok := cur.tables[schema.name]
+	if !ok {
+		return
+	}
+	rebuilt := &tableData{rows: data.rows, indexes: map[string]map[string]map[string]struct{}{}}
+	for key, r := range data.rows {
+		if !r.deleted {
+			rebuilt.index(schema, key, r.value)
+		}
+	}
+	next := &snapshot{version: cur.version, tables: make(map[string]*tableData, len(cur.tables))}
+	for name, t := range cur.tables {
+		next.tables[name] = t
+	}
+	next.tables[schema.name] = rebuilt
+	s.current.Store(next)
+}
+
+// commit aplica las escrituras de tx sobre la última versión. Falla con ErrWriteConflict si otra
+// transacción escribió alguna de las mismas filas después del snapshot de tx (first committer wins)
+// y con un error de conflicto si el resultado viola un índice único.
+func (s *store) commit(tx *tx) error {
+	if len(tx.writes) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cur := s.current.Load()
+	for table, writes := range tx.writes {
+		data, ok := cur.tables[table]
+		if !ok {
+			continue
+		}
+		for key := range writes {
+			if r, ok := data.rows[key]; ok && r.version > tx.snap.version {
+				return ErrWriteConflict
+			}
+		}
+	}
+
+	next := &snapshot{version: cur.version + 1, tables: make(map[string]*tableData, len(cur.tables))}
+	for name, t := range cur.tables {
+		next.tables[name] = t
+	}
+
+	for table, writes := range tx.writes {
+		schema := tx.schemas[table]
+		data := cur.tables[table].clone()
+		for key, w := range writes {
+			if old, ok := data.rows[key]; ok && !old.deleted {
+				data.unindex(schema, key, old.value)
+			}
+			if w == nil {
+				data.rows[key] = row{version: next.version, deleted: true}
+				continue
+			}
+			data.rows[key] = row{value: w, version: next.version}
+			data.index(schema, key, w)
+		}
+		if err := data.checkUnique(schema, writes); err != nil {
+			return err
+		}
+		next.tables[table] = data
+	}
 
-type service struct {
-	db map[string]any
+	s.current.Store(next)
+	return nil
 }
 
-func newRepository() Repository {
-	once.Do(func() {
-		instance = &service{
-			db: make(map[string]any),
+func (t *tableData) clone() *tableData {
+	out := &tableData{
+		rows:    map[string]row{},
+		indexes: map[string]map[string]map[string]struct{}{},
+	}
+	if t == nil {
+		return out
+	}
+	for key, r := range t.rows {
+		out.rows[key] = r
+	}
+	for name, values := range t.indexes {
+		copied := make(map[string]map[string]struct{}, len(values))
+		for value, keys := range values {
+			set := make(map[string]struct{}, len(keys))
+			for k := range keys {
+				set[k] = struct{}{}
+			}
+			copied[value] = set
+		}
+		out.indexes[name] = copied
+	}
+	return out
+}
+
+func (t *tableData) index(schema *tableSchema, key string, value any) {
+	for _, idx := range schema.indexes {
+		values, ok := t.indexes[idx.name]
+		if !ok {
+			values = map[string]map[string]struct{}{}
+			t.indexes[idx.name] = values
+		}
+		for _, v := range idx.values(value) {
+			if values[v] == nil {
+				values[v] = map[string]struct{}{}
+			}
+			values[v][key] = struct{}{}
+		}
+	}
+}
+
+func (t *tableData) unindex(schema *tableSchema, key string, value any) {
+	for _, idx := range schema.indexes {
+		values := t.indexes[idx.name]
+		for _, v := range idx.values(value) {
+			delete(values[v], key)
+			if len(values[v]) == 0 {
+				delete(values, v)
+			}
+		}
+	}
+}
+
+func (t *tableData) checkUnique(schema *tableSchema, writes map[string]any) error {
+	for _, idx := range schema.indexes {
+		if !idx.unique {
+			continue
+		}
+		for key, w := range writes {
+			if w == nil {
+				continue
+			}
+			for _, v := range idx.values(w) {
+				if len(t.indexes[idx.name][v]) > 1 {
+					return uniqueViolation(schema.name, idx.name, key)
+				}
+			}
 		}
-	})
-	return instance
+	}
+	return nil
 }
 
-func (c *service) GetDb() map[string]any {
-	return c.db
+func uniqueViolation(table, index, key string) error {
+	return types.NewErrorWithContext(types.ErrConflict,
+		fmt.Sprintf("duplicate value for unique index %s of %s", index, table), nil,
+		map[string]any{"table": table, "index": index, "key": key})
 }
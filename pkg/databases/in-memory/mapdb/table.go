@@ -0,0 +1,236 @@
+package pkgmapdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// Index es un índice secundario de una tabla. Values devuelve los valores indexados de la fila;
+// una fila sin valores (por ejemplo, con la columna vacía) no entra en el índice, lo que permite
+// índices únicos parciales.
+type Index[T any] struct {
+	Name   string
+	Unique bool
+	Values func(*T) []string
+}
+
+// Table es una tabla de filas T identificadas por la clave que devuelve key. Las filas se copian
+// al guardarlas y al leerlas, así que los valores devueltos pueden modificarse libremente.
+// Todas las operaciones participan de la transacción de NewTxManager que viaja en el contexto;
+// sin transacción, cada escritura se confirma por separado.
+type Table[T any] struct {
+	store  *store
+	schema *tableSchema
+	key    func(*T) string
+	byName map[string]indexDef
+}
+
+// NewTable registra la tabla name en repo con sus índices. Registrar de nuevo una tabla existente
+// reemplaza sus índices y los reconstruye.
+func NewTable[T any](repo Repository, name string, key func(*T) string, indexes ...Index[T]) *Table[T] {
+	schema := &tableSchema{name: name}
+	byName := make(map[string]indexDef, len(indexes))
+	for _, idx := range indexes {
+		values := idx.Values
+		def := indexDef{
+			name:   idx.Name,
+			unique: idx.Unique,
+			values: func(v any) []string { return values(v.(*T)) },
+		}
+		schema.indexes = append(schema.indexes, def)
+		byName[idx.Name] = def
+	}
+
+	s := repo.store()
+	s.register(schema)
+	return &Table[T]{store: s, schema: schema, key: key, byName: byName}
+}
+
+// Name devuelve el nombre de la tabla.
+func (t *Table[T]) Name() string {
+	return t.schema.name
+}
+
+// Insert agrega la fila. Falla con un error de conflicto si la clave ya existe o si se repite
+// el valor de un índice único.
+func (t *Table[T]) Insert(ctx context.Context, v *T) error {
+	key, err := t.keyOf(v)
+	if err != nil {
+		return err
+	}
+	return t.store.write(ctx, func(tx *tx) error {
+		if _, ok := tx.get(t.schema.name, key); ok {
+			return types.NewError(types.ErrConflict, fmt.Sprintf("%s %s already exists", t.schema.name, key), nil)
+		}
+		return t.put(tx, key, v)
+	})
+}
+
+// Upsert agrega la fila o reemplaza la existente con la misma clave.
+func (t *Table[T]) Upsert(ctx context.Context, v *T) error {
+	key, err := t.keyOf(v)
+	if err != nil {
+		return err
+	}
+	return t.store.write(ctx, func(tx *tx) error {
+		return t.put(tx, key, v)
+	})
+}
+
+// Update reemplaza la fila existente con la misma clave; falla con NotFound si no existe.
+func (t *Table[T]) Update(ctx context.Context, v *T) error {
+	key, err := t.keyOf(v)
+	if err != nil {
+		return err
+	}
+	return t.store.write(ctx, func(tx *tx) error {
+		if _, ok := tx.get(t.schema.name, key); !ok {
+			return t.notFound(key)
+		}
+		return t.put(tx, key, v)
+	})
+}
+
+// Modify aplica fn sobre una copia de la fila key y guarda el resultado; falla con NotFound si
+// la fila no existe. fn no debe cambiar la clave.
+func (t *Table[T]) Modify(ctx context.Context, key string, fn func(*T) error) error {
+	return t.store.write(ctx, func(tx *tx) error {
+		current, ok := tx.get(t.schema.name, key)
+		if !ok {
+			return t.notFound(key)
+		}
+		v := clone(current.(*T))
+		if err := fn(v); err != nil {
+			return err
+		}
+		if t.key(v) != key {
+			return fmt.Errorf("mapdb: %s modify cannot change the key %s", t.schema.name, key)
+		}
+		return t.put(tx, key, v)
+	})
+}
+
+// Delete borra la fila key; falla con NotFound si no existe.
+func (t *Table[T]) Delete(ctx context.Context, key string) error {
+	return t.store.write(ctx, func(tx *tx) error {
+		if _, ok := tx.get(t.schema.name, key); !ok {
+			return t.notFound(key)
+		}
+		tx.put(t.schema, key, nil)
+		return nil
+	})
+}
+
+// DeleteWhere borra las filas que cumplen preds y devuelve cuántas borró.
+func (t *Table[T]) DeleteWhere(ctx context.Context, preds ...Predicate[T]) (int, error) {
+	deleted := 0
+	err := t.store.write(ctx, func(tx *tx) error {
+		deleted = 0
+		match := And(preds...)
+		for _, key := range tx.keys(t.schema.name) {
+			current, _ := tx.get(t.schema.name, key)
+			if match(current.(*T)) {
+				tx.put(t.schema, key, nil)
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// Get devuelve la fila key o NotFound.
+func (t *Table[T]) Get(ctx context.Context, key string) (*T, error) {
+	current, ok := t.store.read(ctx).get(t.schema.name, key)
+	if !ok {
+		return nil, t.notFound(key)
+	}
+	return clone(current.(*T)), nil
+}
+
+// FindBy devuelve las filas con value en el índice, ordenadas por clave.
+func (t *Table[T]) FindBy(ctx context.Context, index, value string) ([]T, error) {
+	idx, ok := t.byName[index]
+	if !ok {
+		return nil, fmt.Errorf("mapdb: table %s has no index %s", t.schema.name, index)
+	}
+	view := t.store.read(ctx)
+	keys := view.lookup(t.schema, idx, value)
+	slices.Sort(keys)
+
+	items := make([]T, 0, len(keys))
+	for _, key := range keys {
+		current, _ := view.get(t.schema.name, key)
+		items = append(items, *clone(current.(*T)))
+	}
+	return items, nil
+}
+
+// FindOneBy devuelve la primera fila (por clave) con value en el índice, o NotFound.
+func (t *Table[T]) FindOneBy(ctx context.Context, index, value string) (*T, error) {
+	items, err := t.FindBy(ctx, index, value)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("%s with %s %s not found", t.schema.name, index, value), nil)
+	}
+	return &items[0], nil
+}
+
+// Find devuelve las filas que cumplen preds, ordenadas por clave.
+func (t *Table[T]) Find(ctx context.Context, preds ...Predicate[T]) ([]T, error) {
+	rows := t.scan(t.store.read(ctx), And(preds...))
+	items := make([]T, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, *clone(r))
+	}
+	return items, nil
+}
+
+// Count devuelve cuántas filas cumplen preds.
+func (t *Table[T]) Count(ctx context.Context, preds ...Predicate[T]) (int, error) {
+	return len(t.scan(t.store.read(ctx), And(preds...))), nil
+}
+
+// scan devuelve las filas guardadas (sin copiar) que cumplen match, ordenadas por clave.
+func (t *Table[T]) scan(view *tx, match Predicate[T]) []*T {
+	keys := view.keys(t.schema.name)
+	slices.Sort(keys)
+
+	rows := make([]*T, 0, len(keys))
+	for _, key := range keys {
+		current, _ := view.get(t.schema.name, key)
+		if v := current.(*T); match(v) {
+			rows = append(rows, v)
+		}
+	}
+	return rows
+}
+
+func (t *Table[T]) put(tx *tx, key string, v *T) error {
+	stored := clone(v)
+	if err := tx.checkUnique(t.schema, key, stored); err != nil {
+		return err
+	}
+	tx.put(t.schema, key, stored)
+	return nil
+}
+
+func (t *Table[T]) keyOf(v *T) (string, error) {
+	if v == nil {
+		return "", fmt.Errorf("mapdb: cannot store a nil row in %s", t.schema.name)
+	}
+	key := t.key(v)
+	if key == "" {
+		return "", fmt.Errorf("mapdb: row of %s has an empty key", t.schema.name)
+	}
+	return key, nil
+}
+
+func (t *Table[T]) notFound(key string) error {
+	return types.NewError(types.ErrNotFound, fmt.Sprintf("%s %s not found", t.schema.name, key), nil)
+}
@@ -0,0 +1,214 @@
+package pkgmapdb
+
+import (
+	"context"
+	"maps"
+
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+)
+
+// ErrWriteConflict indica que otra transacción modificó una fila escrita por la transacción actual
+// después de que esta tomó su snapshot. Expone SQLState 40001 (serialization_failure) para que
+// pkgtx.IsRetryable lo trate como una falla de serialización y el Manager repita la transacción.
+var ErrWriteConflict error = writeConflict{}
+
+type writeConflict struct{}
+
+func (writeConflict) Error() string    { return "mapdb: write conflict, the transaction must be retried" }
+func (writeConflict) SQLState() string { return "40001" }
+
+type txKey struct{}
+
+// tx es la vista de la base que ve una operación: el snapshot en el que arrancó más sus propias
+// escrituras todavía no confirmadas (tabla -> clave -> fila; nil es un borrado).
+type tx struct {
+	store   *store
+	snap    *snapshot
+	writes  map[string]map[string]any
+	schemas map[string]*tableSchema
+}
+
+func (s *store) begin() *tx {
+	return &tx{
+		store:   s,
+		snap:    s.current.Load(),
+		writes:  map[string]map[string]any{},
+		schemas: map[string]*tableSchema{},
+	}
+}
+
+// txFrom devuelve la transacción de s que viaja en ctx, si hay una.
+func txFrom(ctx context.Context, s *store) (*tx, bool) {
+	t, ok := ctx.Value(txKey{}).(*tx)
+	if !ok || t.store != s {
+		return nil, false
+	}
+	return t, true
+}
+
+// read devuelve la vista para una lectura: la transacción en curso o el último snapshot.
+func (s *store) read(ctx context.Context) *tx {
+	if t, ok := txFrom(ctx, s); ok {
+		return t
+	}
+	return &tx{store: s, snap: s.current.Load()}
+}
+
+// write ejecuta fn dentro de la transacción en curso o, si no hay ninguna, en una transacción
+// propia que se confirma al terminar (autocommit) y se repite ante conflictos de escritura.
+func (s *store) write(ctx context.Context, fn func(*tx) error) error {
+	if t, ok := txFrom(ctx, s); ok {
+		return fn(t)
+	}
+	return pkgtx.Retry(ctx, pkgtx.NewOptions(), func() error {
+		t := s.begin()
+		if err := fn(t); err != nil {
+			return err
+		}
+		return s.commit(t)
+	})
+}
+
+func (t *tx) get(table, key string) (any, bool) {
+	if w, ok := t.writes[table][key]; ok {
+		return w, w != nil
+	}
+	data, ok := t.snap.tables[table]
+	if !ok {
+		return nil, false
+	}
+	r, ok := data.rows[key]
+	if !ok || r.deleted {
+		return nil, false
+	}
+	return r.value, true
+}
+
+func (t *tx) put(schema *tableSchema, key string, value any) {
+	if t.writes[schema.name] == nil {
+		t.writes[schema.name] = map[string]any{}
+	}
+	t.writes[schema.name][key] = value
+	t.schemas[schema.name] = schema
+}
+
+// keys devuelve las claves visibles de la tabla, sin orden.
+func (t *tx) keys(table string) []string {
+	var keys []string
+	if data, ok := t.snap.tables[table]; ok {
+		for key, r := range data.rows {
+			if _, written := t.writes[table][key]; !written && !r.deleted {
+				keys = append(keys, key)
+			}
+		}
+	}
+	for key, w := range t.writes[table] {
+		if w != nil {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// lookup devuelve las claves visibles con value en el índice idx, sin orden.
+func (t *tx) lookup(schema *tableSchema, idx indexDef, value string) []string {
+	var keys []string
+	if data, ok := t.snap.tables[schema.name]; ok {
+		for key := range data.indexes[idx.name][value] {
+			if _, written := t.writes[schema.name][key]; !written {
+				keys = append(keys, key)
+			}
+		}
+	}
+	// Las filas escritas en la transacción no están en los índices del snapshot
+	for key, w := range t.writes[schema.name] {
+		if w != nil && containsValue(idx.values(w), value) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// checkUnique verifica que value no repita, dentro de la vista de t, un valor de un índice único.
+// El commit lo vuelve a verificar contra la última versión.
+func (t *tx) checkUnique(schema *tableSchema, key string, value any) error {
+	for _, idx := range schema.indexes {
+		if !idx.unique {
+			continue
+		}
+		for _, v := range idx.values(value) {
+			for _, other := range t.lookup(schema, idx, v) {
+				if other != key {
+					return uniqueViolation(schema.name, idx.name, key)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// savepoint es el estado de una transacción al entrar a un WithinTx anidado.
+type savepoint struct {
+	writes  map[string]map[string]any
+	schemas map[string]*tableSchema
+}
+
+func (t *tx) savepoint() savepoint {
+	saved := savepoint{
+		writes:  make(map[string]map[string]any, len(t.writes)),
+		schemas: maps.Clone(t.schemas),
+	}
+	for table, writes := range t.writes {
+		saved.writes[table] = maps.Clone(writes)
+	}
+	return saved
+}
+
+// rollbackTo descarta lo escrito después de sp, incluidas las tablas que se tocaron por primera vez.
+func (t *tx) rollbackTo(sp savepoint) {
+	t.writes = sp.writes
+	t.schemas = sp.schemas
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type txManager struct {
+	store *store
+	opts  pkgtx.Options
+}
+
+// NewTxManager devuelve el unit of work de la base: las tablas que reciben el contexto de fn
+// leen el snapshot de la transacción y acumulan sus escrituras hasta el commit.
+// Una llamada anidada crea un savepoint. Los conflictos de escritura se reintentan según opts.
+func NewTxManager(repo Repository, opts ...pkgtx.Option) pkgtx.Manager {
+	return &txManager{
+		store: repo.store(),
+		opts:  pkgtx.NewOptions(opts...),
+	}
+}
+
+func (m *txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if current, ok := txFrom(ctx, m.store); ok {
+		saved := current.savepoint()
+		if err := fn(ctx); err != nil {
+			current.rollbackTo(saved)
+			return err
+		}
+		return nil
+	}
+
+	return pkgtx.Retry(ctx, m.opts, func() error {
+		t := m.store.begin()
+		if err := fn(context.WithValue(ctx, txKey{}, t)); err != nil {
+			return err
+		}
+		return m.store.commit(t)
+	})
+}
@@ -0,0 +1,273 @@
+package pkgmapdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+type account struct {
+	ID    string
+	Email string
+	Total int
+}
+
+type note struct {
+	ID   string
+	Body string
+}
+
+// newTables crea una base con una tabla de cuentas (email único) y otra de notas.
+func newTables() (Repository, *Table[account], *Table[note]) {
+	repo := Bootstrap()
+	accounts := NewTable(repo, "accounts", func(a *account) string { return a.ID },
+		Index[account]{Name: "email", Unique: true, Values: func(a *account) []string { return []string{a.Email} }},
+	)
+	notes := NewTable(repo, "notes", func(n *note) string { return n.ID })
+	return repo, accounts, notes
+}
+
+func TestWithinTx(t *testing.T) {
+	errFail := errors.New("fail")
+
+	tests := []struct {
+		name         string
+		fn           func(ctx context.Context, tm pkgtx.Manager, accounts *Table[account], notes *Table[note]) error
+		wantErr      error
+		wantAccounts []string
+		wantNotes    []string
+	}{
+		{
+			name: "Success: writes are committed together",
+			fn: func(ctx context.Context, tm pkgtx.Manager, accounts *Table[account], notes *Table[note]) error {
+				if err := accounts.Insert(ctx, &account{ID: "a2", Email: "b@x"}); err != nil {
+					return err
+				}
+				return notes.Insert(ctx, &note{ID: "n1"})
+			},
+			wantAccounts: []string{"a1", "a2"},
+			wantNotes:    []string{"n1"},
+		},
+		{
+			name: "Error: every write is rolled back",
+			fn: func(ctx context.Context, tm pkgtx.Manager, accounts *Table[account], notes *Table[note]) error {
+				if err := accounts.Delete(ctx, "a1"); err != nil {
+					return err
+				}
+				if err := notes.Insert(ctx, &note{ID: "n1"}); err != nil {
+					return err
+				}
+				return errFail
+			},
+			wantErr:      errFail,
+			wantAccounts: []string{"a1"},
+		},
+		{
+			name: "Success: failed nested call only rolls back its savepoint",
+			fn: func(ctx context.Context, tm pkgtx.Manager, accounts *Table[account], notes *Table[note]) error {
+				if err := accounts.Insert(ctx, &account{ID: "a2", Email: "b@x"}); err != nil {
+					return err
+				}
+				nestedErr := tm.WithinTx(ctx, func(ctx context.Context) error {
+					if err := accounts.Delete(ctx, "a1"); err != nil {
+						return err
+					}
+					// La primera escritura en notas ocurre dentro del savepoint
+					if err := notes.Insert(ctx, &note{ID: "n1"}); err != nil {
+						return err
+					}
+					return errFail
+				})
+				if !errors.Is(nestedErr, errFail) {
+					return errors.New("nested call must return its error")
+				}
+				return nil
+			},
+			wantAccounts: []string{"a1", "a2"},
+		},
+		{
+			name: "Success: nested savepoints roll back independently",
+			fn: func(ctx context.Context, tm pkgtx.Manager, accounts *Table[account], notes *Table[note]) error {
+				return tm.WithinTx(ctx, func(ctx context.Context) error {
+					if err := notes.Insert(ctx, &note{ID: "n1"}); err != nil {
+						return err
+					}
+					_ = tm.WithinTx(ctx, func(ctx context.Context) error {
+						if err := notes.Insert(ctx, &note{ID: "n2"}); err != nil {
+							return err
+						}
+						return errFail
+					})
+					return notes.Insert(ctx, &note{ID: "n3"})
+				})
+			},
+			wantAccounts: []string{"a1"},
+			wantNotes:    []string{"n1", "n3"},
+		},
+		{
+			name: "Error: unique index violation inside the transaction",
+			fn: func(ctx context.Context, tm pkgtx.Manager, accounts *Table[account], notes *Table[note]) error {
+				if err := notes.Insert(ctx, &note{ID: "n1"}); err != nil {
+					return err
+				}
+				return accounts.Insert(ctx, &account{ID: "a2", Email: "a@x"})
+			},
+			wantErr:      types.NewError(types.ErrConflict, "", nil),
+			wantAccounts: []string{"a1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo, accounts, notes := newTables()
+			assert.NoError(t, accounts.Insert(ctx, &account{ID: "a1", Email: "a@x"}))
+			tm := NewTxManager(repo)
+
+			err := tm.WithinTx(ctx, func(ctx context.Context) error {
+				return tc.fn(ctx, tm, accounts, notes)
+			})
+
+			switch {
+			case tc.wantErr == nil:
+				assert.NoError(t, err, "expected no error but got one")
+			case types.IsConflict(tc.wantErr):
+				assert.True(t, types.IsConflict(err), "expected a conflict, got %v", err)
+			default:
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+
+			gotAccounts, _ := accounts.Find(ctx)
+			gotNotes, _ := notes.Find(ctx)
+			assert.Equal(t, tc.wantAccounts, accountIDs(gotAccounts), "accounts mismatch")
+			assert.Equal(t, tc.wantNotes, noteIDs(gotNotes), "notes mismatch")
+		})
+	}
+}
+
+func TestSavepointRestoresSchemas(t *testing.T) {
+	ctx := context.Background()
+	repo, accounts, notes := newTables()
+	tm := NewTxManager(repo)
+
+	err := tm.WithinTx(ctx, func(ctx context.Context) error {
+		assert.NoError(t, accounts.Insert(ctx, &account{ID: "a1", Email: "a@x"}))
+		_ = tm.WithinTx(ctx, func(ctx context.Context) error {
+			assert.NoError(t, notes.Insert(ctx, &note{ID: "n1"}))
+			return errors.New("fail")
+		})
+
+		current, ok := txFrom(ctx, repo.store())
+		assert.True(t, ok, "the transaction must travel in the context")
+		// La tabla tocada solo dentro del savepoint no queda registrada en la transacción
+		assert.NotContains(t, current.schemas, "notes", "schema of a rolled back table kept")
+		assert.NotContains(t, current.writes, "notes", "writes of a rolled back table kept")
+		assert.Contains(t, current.schemas, "accounts", "schema written before the savepoint lost")
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestCommitWriteConflict(t *testing.T) {
+	tests := []struct {
+		name    string
+		second  func(tx *tx, accounts *Table[account])
+		wantErr bool
+	}{
+		{
+			name: "Error: second committer of the same row loses",
+			second: func(tx *tx, accounts *Table[account]) {
+				tx.put(accounts.schema, "a1", &account{ID: "a1", Email: "a@x", Total: 2})
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error: deleting a row updated after the snapshot conflicts",
+			second: func(tx *tx, accounts *Table[account]) {
+				tx.put(accounts.schema, "a1", nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Success: writes to different rows do not conflict",
+			second: func(tx *tx, accounts *Table[account]) {
+				tx.put(accounts.schema, "a2", &account{ID: "a2", Email: "b@x"})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo, accounts, _ := newTables()
+			assert.NoError(t, accounts.Insert(ctx, &account{ID: "a1", Email: "a@x"}))
+			s := repo.store()
+
+			first, second := s.begin(), s.begin()
+			first.put(accounts.schema, "a1", &account{ID: "a1", Email: "a@x", Total: 1})
+			tc.second(second, accounts)
+
+			assert.NoError(t, s.commit(first), "first committer must win")
+			err := s.commit(second)
+
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrWriteConflict)
+				assert.True(t, pkgtx.IsRetryable(err), "write conflicts must be retryable")
+				got, _ := accounts.Get(ctx, "a1")
+				assert.Equal(t, 1, got.Total, "the losing transaction must not be applied")
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+			}
+		})
+	}
+}
+
+func TestWithinTxRetriesWriteConflicts(t *testing.T) {
+	ctx := context.Background()
+	repo, accounts, _ := newTables()
+	assert.NoError(t, accounts.Insert(ctx, &account{ID: "a1", Email: "a@x"}))
+	tm := NewTxManager(repo, pkgtx.WithBackoff(1, 1))
+
+	attempts := 0
+	err := tm.WithinTx(ctx, func(txCtx context.Context) error {
+		attempts++
+		current, err := accounts.Get(txCtx, "a1")
+		if err != nil {
+			return err
+		}
+		if attempts == 1 {
+			// Otra escritura confirmada después del snapshot de este intento
+			if err := accounts.Modify(ctx, "a1", func(a *account) error { a.Total += 10; return nil }); err != nil {
+				return err
+			}
+		}
+		current.Total++
+		return accounts.Update(txCtx, current)
+	})
+
+	assert.NoError(t, err, "the conflict must be retried")
+	assert.Equal(t, 2, attempts, "attempts mismatch")
+	got, _ := accounts.Get(ctx, "a1")
+	assert.Equal(t, 11, got.Total, "the retry must see the concurrent write")
+}
+
+func accountIDs(items []account) []string {
+	var ids []string
+	for _, a := range items {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
+
+func noteIDs(items []note) []string {
+	var ids []string
+	for _, n := range items {
+		ids = append(ids, n.ID)
+	}
+	return ids
+}
@@ -0,0 +1,142 @@
+package pkgcassandra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gocql/gocql"
+)
+
+const (
+	defaultBatchMaxStatements = 50
+	defaultBatchConcurrency   = 8
+)
+
+// BatchOptions configura un BatchWriter.
+type BatchOptions struct {
+	// MaxStatements parte los batches de una misma partición que superen este tamaño;
+	// batches muy grandes disparan los warnings (y el límite) de batch_size del servidor.
+	MaxStatements int
+	// Concurrency es la cantidad de batches que se envían en paralelo.
+	Concurrency int
+	// Options se aplica a cada batch (consistencia, Idempotent, reintentos).
+	Options []QueryOption
+}
+
+type batchEntry struct {
+	stmt string
+	args []any
+}
+
+// BatchWriter acumula escrituras agrupadas por partición y las envía como batches UNLOGGED.
+// Un batch UNLOGGED de una sola partición se aplica de forma atómica en una réplica y cuesta un
+// único round trip; mezclar particiones en el mismo batch sobrecarga al coordinador, por eso
+// cada partición viaja en su propio batch. No es seguro para uso concurrente.
+type BatchWriter struct {
+	session  *gocql.Session
+	options  BatchOptions
+	defaults []QueryOption
+	groups   map[string][]batchEntry
+	order    []string
+	size     int
+}
+
+// NewBatchWriter crea un BatchWriter sobre la sesión del repositorio.
+func (c *repository) NewBatchWriter(opts BatchOptions) *BatchWriter {
+	if opts.MaxStatements <= 0 {
+		opts.MaxStatements = defaultBatchMaxStatements
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultBatchConcurrency
+	}
+	return &BatchWriter{
+		session:  c.session,
+		options:  opts,
+		defaults: c.defaults,
+		groups:   map[string][]batchEntry{},
+	}
+}
+
+// Add agrega una escritura a la partición indicada. partition es cualquier string que identifique
+// la clave de partición de la fila (por ejemplo, el user_id de timeline_by_user).
+func (w *BatchWriter) Add(partition string, stmt string, args ...any) {
+	if _, ok := w.groups[partition]; !ok {
+		w.order = append(w.order, partition)
+	}
+	w.groups[partition] = append(w.groups[partition], batchEntry{stmt: stmt, args: args})
+	w.size++
+}
+
+// Len devuelve la cantidad de escrituras pendientes.
+func (w *BatchWriter) Len() int {
+	return w.size
+}
+
+// Flush envía las escrituras pendientes y vacía el writer. Una partición con una sola escritura
+// se envía como query simple. Los errores de cada partición se devuelven juntos; las demás
+// particiones se escriben igual.
+func (w *BatchWriter) Flush(ctx context.Context) error {
+	groups, order := w.groups, w.order
+	w.groups, w.order, w.size = map[string][]batchEntry{}, nil, 0
+
+	type job struct {
+		partition string
+		entries   []batchEntry
+	}
+	jobs := make(chan job)
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	for i := 0; i < w.options.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				if err := w.execute(ctx, j.entries); err != nil {
+					mu.Lock()
+					errs = append(errs, fmt.Errorf("partition %s: %w", j.partition, err))
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for _, partition := range order {
+		entries := groups[partition]
+		for start := 0; start < len(entries); start += w.options.MaxStatements {
+			end := min(start+w.options.MaxStatements, len(entries))
+			select {
+			case <-ctx.Done():
+				mu.Lock()
+				errs = append(errs, ctx.Err())
+				mu.Unlock()
+				break dispatch
+			case jobs <- job{partition: partition, entries: entries[start:end]}:
+			}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (w *BatchWriter) execute(ctx context.Context, entries []batchEntry) error {
+	opts := newStatementOptions(w.defaults, w.options.Options)
+	if len(entries) == 1 {
+		return opts.applyQuery(w.session.Query(entries[0].stmt, entries[0].args...).WithContext(ctx)).Exec()
+	}
+
+	batch := w.session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, e := range entries {
+		batch.Query(e.stmt, e.args...)
+	}
+	opts.applyBatch(batch)
+	return w.session.ExecuteBatch(batch)
+}
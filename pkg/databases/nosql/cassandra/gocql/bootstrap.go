@@ -1,8 +1,11 @@
 package pkgcassandra
 
 import (
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 func Bootstrap() (Repository, error) {
@@ -24,9 +27,83 @@ func Bootstrap() (Repository, error) {
 
 	config := newConfig(hosts, keyspace, username, password)
 
+	// Replicación del keyspace: SimpleStrategy por defecto, NetworkTopologyStrategy usa CASSANDRA_DC
+	if class := os.Getenv("CASSANDRA_REPLICATION_CLASS"); class != "" {
+		config.SetReplicationClass(class)
+	}
+	if factor := os.Getenv("CASSANDRA_REPLICATION_FACTOR"); factor != "" {
+		n, err := strconv.Atoi(factor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CASSANDRA_REPLICATION_FACTOR: %w", err)
+		}
+		config.SetReplicationFactor(n)
+	}
+	config.SetDatacenter(os.Getenv("CASSANDRA_DC"))
+	if timeout := os.Getenv("CASSANDRA_SCHEMA_AGREEMENT_TIMEOUT"); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CASSANDRA_SCHEMA_AGREEMENT_TIMEOUT: %w", err)
+		}
+		config.SetSchemaAgreementTimeout(d)
+	}
+
+	// Consistencia por defecto, reintentos y ejecución especulativa de las queries
+	if consistency := os.Getenv("CASSANDRA_CONSISTENCY"); consistency != "" {
+		config.SetConsistency(consistency)
+	}
+	if retries := os.Getenv("CASSANDRA_RETRIES"); retries != "" {
+		n, err := strconv.Atoi(retries)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CASSANDRA_RETRIES: %w", err)
+		}
+		config.SetRetries(n)
+	}
+	minBackoff, maxBackoff := config.GetRetryBackoff()
+	if err := durationFromEnv("CASSANDRA_RETRY_MIN_BACKOFF", &minBackoff); err != nil {
+		return nil, err
+	}
+	if err := durationFromEnv("CASSANDRA_RETRY_MAX_BACKOFF", &maxBackoff); err != nil {
+		return nil, err
+	}
+	config.SetRetryBackoff(minBackoff, maxBackoff)
+
+	attempts, delay := config.GetSpeculativeExecution()
+	if raw := os.Getenv("CASSANDRA_SPECULATIVE_ATTEMPTS"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CASSANDRA_SPECULATIVE_ATTEMPTS: %w", err)
+		}
+		attempts = n
+	}
+	if err := durationFromEnv("CASSANDRA_SPECULATIVE_DELAY", &delay); err != nil {
+		return nil, err
+	}
+	config.SetSpeculativeExecution(attempts, delay)
+
+	if raw := os.Getenv("CASSANDRA_MAX_PREPARED_STMTS"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CASSANDRA_MAX_PREPARED_STMTS: %w", err)
+		}
+		config.SetMaxPreparedStmts(n)
+	}
+
 	if err := config.Validate(); err != nil {
 		return nil, err
 	}
 
 	return newRepository(config)
 }
+
+func durationFromEnv(key string, target *time.Duration) error {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+	*target = d
+	return nil
+}
@@ -2,25 +2,49 @@ package pkgcassandra
 
 import (
 	"fmt"
+	"strings"
+	"time"
 )
 
-
+const (
+	SimpleStrategy          = "SimpleStrategy"
+	NetworkTopologyStrategy = "NetworkTopologyStrategy"
+)
 
 type config struct {
-	hosts    []string
-	keyspace string
-	username string
-	password string
+	hosts                  []string
+	keyspace               string
+	username               string
+	password               string
+	replicationClass       string
+	replicationFactor      int
+	datacenter             string
+	schemaAgreementTimeout time.Duration
+	consistency            string
+	retries                int
+	retryMinBackoff        time.Duration
+	retryMaxBackoff        time.Duration
+	speculativeAttempts    int
+	speculativeDelay       time.Duration
+	maxPreparedStmts       int
 }
 
 func newConfig(hosts []string, keyspace string, username string, password string) Config {
 	h := make([]string, len(hosts))
 	copy(h, hosts)
 	return &config{
-		hosts:    h,
-		keyspace: keyspace,
-		username: username,
-		password: password,
+		hosts:                  h,
+		keyspace:               keyspace,
+		username:               username,
+		password:               password,
+		replicationClass:       SimpleStrategy,
+		replicationFactor:      1,
+		schemaAgreementTimeout: 60 * time.Second,
+		consistency:            "LOCAL_QUORUM",
+		retries:                3,
+		retryMinBackoff:        100 * time.Millisecond,
+		retryMaxBackoff:        2 * time.Second,
+		maxPreparedStmts:       1000,
 	}
 }
 
@@ -58,6 +82,90 @@ func (c *config) SetPassword(password string) {
 	c.password = password
 }
 
+func (c *config) GetReplicationClass() string {
+	return c.replicationClass
+}
+
+func (c *config) SetReplicationClass(class string) {
+	c.replicationClass = class
+}
+
+func (c *config) GetReplicationFactor() int {
+	return c.replicationFactor
+}
+
+func (c *config) SetReplicationFactor(factor int) {
+	c.replicationFactor = factor
+}
+
+func (c *config) GetDatacenter() string {
+	return c.datacenter
+}
+
+func (c *config) SetDatacenter(dc string) {
+	c.datacenter = dc
+}
+
+func (c *config) GetSchemaAgreementTimeout() time.Duration {
+	return c.schemaAgreementTimeout
+}
+
+func (c *config) SetSchemaAgreementTimeout(timeout time.Duration) {
+	c.schemaAgreementTimeout = timeout
+}
+
+func (c *config) GetConsistency() string {
+	return c.consistency
+}
+
+func (c *config) SetConsistency(consistency string) {
+	c.consistency = strings.ToUpper(strings.TrimSpace(consistency))
+}
+
+func (c *config) GetRetries() int {
+	return c.retries
+}
+
+func (c *config) SetRetries(retries int) {
+	c.retries = retries
+}
+
+func (c *config) GetRetryBackoff() (time.Duration, time.Duration) {
+	return c.retryMinBackoff, c.retryMaxBackoff
+}
+
+func (c *config) SetRetryBackoff(min, max time.Duration) {
+	c.retryMinBackoff = min
+	c.retryMaxBackoff = max
+}
+
+func (c *config) GetSpeculativeExecution() (int, time.Duration) {
+	return c.speculativeAttempts, c.speculativeDelay
+}
+
+// SetSpeculativeExecution configura cuántas ejecuciones extra se lanzan contra otros nodos si la
+// primera no respondió en delay. Solo aplica a queries idempotentes; attempts = 0 lo desactiva.
+func (c *config) SetSpeculativeExecution(attempts int, delay time.Duration) {
+	c.speculativeAttempts = attempts
+	c.speculativeDelay = delay
+}
+
+func (c *config) GetMaxPreparedStmts() int {
+	return c.maxPreparedStmts
+}
+
+func (c *config) SetMaxPreparedStmts(n int) {
+	c.maxPreparedStmts = n
+}
+
+// ReplicationCQL devuelve el mapa de replicación del keyspace en formato CQL.
+func (c *config) ReplicationCQL() string {
+	if c.replicationClass == NetworkTopologyStrategy {
+		return fmt.Sprintf("{'class': '%s', '%s': %d}", NetworkTopologyStrategy, c.datacenter, c.replicationFactor)
+	}
+	return fmt.Sprintf("{'class': '%s', 'replication_factor': %d}", SimpleStrategy, c.replicationFactor)
+}
+
 func (c *config) Validate() error {
 	if len(c.hosts) == 0 {
 		return fmt.Errorf("cassandra hosts are not configured")
@@ -71,5 +179,38 @@ func (c *config) Validate() error {
 	if c.password == "" {
 		return fmt.Errorf("cassandra password is not configured")
 	}
+	if strings.ContainsAny(c.keyspace, " ;'\"") {
+		return fmt.Errorf("invalid cassandra keyspace %q", c.keyspace)
+	}
+	if c.replicationClass != SimpleStrategy && c.replicationClass != NetworkTopologyStrategy {
+		return fmt.Errorf("invalid cassandra replication class %q", c.replicationClass)
+	}
+	if c.replicationFactor < 1 {
+		return fmt.Errorf("cassandra replication factor must be at least 1")
+	}
+	if c.replicationClass == NetworkTopologyStrategy && c.datacenter == "" {
+		return fmt.Errorf("cassandra datacenter is required for %s", NetworkTopologyStrategy)
+	}
+	if c.schemaAgreementTimeout <= 0 {
+		return fmt.Errorf("cassandra schema agreement timeout must be positive")
+	}
+	if _, err := ParseConsistency(c.consistency); err != nil {
+		return err
+	}
+	if c.retries < 0 {
+		return fmt.Errorf("cassandra retries cannot be negative")
+	}
+	if c.retryMinBackoff <= 0 || c.retryMaxBackoff < c.retryMinBackoff {
+		return fmt.Errorf("invalid cassandra retry backoff %s-%s", c.retryMinBackoff, c.retryMaxBackoff)
+	}
+	if c.speculativeAttempts < 0 {
+		return fmt.Errorf("cassandra speculative attempts cannot be negative")
+	}
+	if c.speculativeAttempts > 0 && c.speculativeDelay <= 0 {
+		return fmt.Errorf("cassandra speculative delay must be positive")
+	}
+	if c.maxPreparedStmts <= 0 {
+		return fmt.Errorf("cassandra prepared statement cache size must be positive")
+	}
 	return nil
 }
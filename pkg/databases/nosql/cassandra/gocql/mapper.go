@@ -0,0 +1,140 @@
+package pkgcassandra
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/gocql/gocql"
+)
+
+// Mapper asocia los campos exportados de un struct con columnas de Cassandra. La columna se toma
+// del tag cql (`cql:"user_id"`); sin tag se usa el nombre del campo en snake_case y `cql:"-"`
+// lo excluye. Los structs embebidos se aplanan.
+type Mapper[T any] struct {
+	columns []string
+	fields  [][]int
+}
+
+// NewMapper arma el mapper de T. Entra en pánico si T no es un struct: es un error de programación.
+func NewMapper[T any]() *Mapper[T] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("cassandra mapper requires a struct, got %s", t))
+	}
+	m := &Mapper[T]{}
+	m.collect(t, nil)
+	return m
+}
+
+func (m *Mapper[T]) collect(t reflect.Type, parent []int) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		index := append(append([]int(nil), parent...), i)
+		tag := f.Tag.Get("cql")
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && tag == "" {
+			m.collect(f.Type, index)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		column := tag
+		if column == "" {
+			column = snakeCase(f.Name)
+		}
+		m.columns = append(m.columns, column)
+		m.fields = append(m.fields, index)
+	}
+}
+
+// Columns devuelve las columnas en el orden de los campos.
+func (m *Mapper[T]) Columns() []string {
+	return append([]string(nil), m.columns...)
+}
+
+// ColumnList devuelve las columnas separadas por coma, para armar SELECT e INSERT.
+func (m *Mapper[T]) ColumnList() string {
+	return strings.Join(m.columns, ", ")
+}
+
+// InsertCQL devuelve el INSERT de todas las columnas en table.
+func (m *Mapper[T]) InsertCQL(table string) string {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(m.columns)), ", ")
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, m.ColumnList(), placeholders)
+}
+
+// SelectCQL devuelve el SELECT de todas las columnas de table; where (opcional) se agrega tal cual.
+func (m *Mapper[T]) SelectCQL(table string, where string) string {
+	query := fmt.Sprintf("SELECT %s FROM %s", m.ColumnList(), table)
+	if where != "" {
+		query += " WHERE " + where
+	}
+	return query
+}
+
+// Values devuelve los valores de v en el orden de Columns, para los placeholders de InsertCQL.
+func (m *Mapper[T]) Values(v *T) []any {
+	rv := reflect.ValueOf(v).Elem()
+	values := make([]any, len(m.fields))
+	for i, index := range m.fields {
+		values[i] = rv.FieldByIndex(index).Interface()
+	}
+	return values
+}
+
+// Pointers devuelve punteros a los campos de v en el orden de Columns, para Scan.
+func (m *Mapper[T]) Pointers(v *T) []any {
+	rv := reflect.ValueOf(v).Elem()
+	ptrs := make([]any, len(m.fields))
+	for i, index := range m.fields {
+		ptrs[i] = rv.FieldByIndex(index).Addr().Interface()
+	}
+	return ptrs
+}
+
+// Scan lee la fila actual de scanner; la query debe seleccionar las columnas de Columns en orden.
+// Tiene la firma que espera SelectPage.
+func (m *Mapper[T]) Scan(scanner gocql.Scanner) (T, error) {
+	var v T
+	err := scanner.Scan(m.Pointers(&v)...)
+	return v, err
+}
+
+// ScanAll lee todas las filas de iter y lo cierra.
+func (m *Mapper[T]) ScanAll(iter *gocql.Iter) ([]T, error) {
+	var items []T
+	scanner := iter.Scanner()
+	for scanner.Next() {
+		item, err := m.Scan(scanner)
+		if err != nil {
+			_ = iter.Close()
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rows: %w", err)
+	}
+	return items, nil
+}
+
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Un límite de palabra: minúscula→Mayúscula o fin de una sigla (IDValue → id_value)
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
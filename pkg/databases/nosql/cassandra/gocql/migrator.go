@@ -0,0 +1,421 @@
+package pkgcassandra
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"math/rand"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/google/uuid"
+)
+
+const (
+	// MigrationsTable registra las versiones aplicadas en el keyspace de la sesión.
+	MigrationsTable = "schema_migrations"
+
+	migrationsLockTable = "schema_migrations_lock"
+	lockTTLSeconds      = 300
+	lockBaseBackoff     = 500 * time.Millisecond
+	lockMaxBackoff      = 10 * time.Second
+)
+
+// migrationFilePattern: NNN_descripcion.cql; el prefijo numérico define el orden.
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_([A-Za-z0-9_\-]+)\.cql$`)
+
+// Migration es un archivo CQL con sus sentencias ya separadas.
+type Migration struct {
+	Version    int
+	Name       string
+	Checksum   string
+	Statements []string
+}
+
+// MigrationRecord es una fila de schema_migrations.
+type MigrationRecord struct {
+	Version   int
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// MigrateOptions controla la ejecución de Up.
+type MigrateOptions struct {
+	// DryRun escribe en Out las sentencias pendientes sin ejecutarlas.
+	DryRun bool
+	Out    io.Writer
+}
+
+type migrator struct {
+	session  *gocql.Session
+	keyspace string
+	fsys     fs.FS
+	dir      string
+}
+
+// NewMigrator crea un Migrator que lee los archivos .cql de dir dentro de fsys (p. ej. un embed.FS).
+func (c *repository) NewMigrator(fsys fs.FS, dir string) Migrator {
+	if dir == "" {
+		dir = "."
+	}
+	keyspace := ""
+	if c.config != nil {
+		keyspace = c.config.GetKeyspace()
+	}
+	return &migrator{
+		session:  c.session,
+		keyspace: keyspace,
+		fsys:     fsys,
+		dir:      dir,
+	}
+}
+
+// Up aplica en orden las migraciones pendientes. Falla si una migración ya aplicada
+// cambió su contenido (checksum distinto), para evitar que el esquema diverja entre entornos.
+// Devuelve las migraciones aplicadas (o las que se aplicarían, en dry-run).
+func (m *migrator) Up(ctx context.Context, opts MigrateOptions) ([]Migration, error) {
+	migrations, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := pendingMigrations(migrations, applied)
+	if err != nil {
+		return nil, err
+	}
+
+	// En dry-run no se crea ni modifica nada en el cluster
+	if opts.DryRun {
+		if opts.Out != nil {
+			writePlan(opts.Out, pending)
+		}
+		return pending, nil
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	if err := m.ensureTables(ctx); err != nil {
+		return nil, err
+	}
+
+	owner, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer m.unlock(owner)
+
+	// Otro runner pudo haber aplicado migraciones antes de que tomáramos el lock
+	if applied, err = m.Status(ctx); err != nil {
+		return nil, err
+	}
+	if pending, err = pendingMigrations(migrations, applied); err != nil {
+		return nil, err
+	}
+
+	for i, mg := range pending {
+		if err := m.apply(ctx, mg); err != nil {
+			return pending[:i], err
+		}
+	}
+	return pending, nil
+}
+
+// Status devuelve las migraciones aplicadas ordenadas por versión.
+func (m *migrator) Status(ctx context.Context) ([]MigrationRecord, error) {
+	if m.session == nil {
+		return nil, fmt.Errorf("cassandra session is not initialized")
+	}
+
+	exists, err := m.migrationsTableExists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	iter := m.session.Query(
+		`SELECT version, name, checksum, applied_at FROM ` + MigrationsTable,
+	).WithContext(ctx).Iter()
+
+	var (
+		records []MigrationRecord
+		rec     MigrationRecord
+	)
+	for iter.Scan(&rec.Version, &rec.Name, &rec.Checksum, &rec.AppliedAt) {
+		records = append(records, rec)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", MigrationsTable, err)
+	}
+
+	sort.Slice(records, func(i, j int) bool { return records[i].Version < records[j].Version })
+	return records, nil
+}
+
+func (m *migrator) apply(ctx context.Context, mg Migration) error {
+	log.Printf("Cassandra migration %d_%s: applying %d statements", mg.Version, mg.Name, len(mg.Statements))
+
+	for i, stmt := range mg.Statements {
+		if err := m.session.Query(stmt).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("migration %d_%s failed at statement %d: %w", mg.Version, mg.Name, i+1, err)
+		}
+		// Cada DDL se propaga de forma asíncrona: se espera a que todos los nodos coincidan
+		if err := m.session.AwaitSchemaAgreement(ctx); err != nil {
+			return fmt.Errorf("migration %d_%s: schema agreement failed: %w", mg.Version, mg.Name, err)
+		}
+	}
+
+	if err := m.session.Query(
+		`INSERT INTO `+MigrationsTable+` (version, name, checksum, applied_at) VALUES (?, ?, ?, ?)`,
+		mg.Version, mg.Name, mg.Checksum, time.Now().UTC(),
+	).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to record migration %d_%s: %w", mg.Version, mg.Name, err)
+	}
+	return nil
+}
+
+func (m *migrator) ensureTables(ctx context.Context) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS ` + MigrationsTable + ` (
+			version int PRIMARY KEY,
+			name text,
+			checksum text,
+			applied_at timestamp
+		)`,
+		`CREATE TABLE IF NOT EXISTS ` + migrationsLockTable + ` (
+			id text PRIMARY KEY,
+			owner text
+		)`,
+	}
+	for _, stmt := range stmts {
+		if err := m.session.Query(stmt).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to create migration tables: %w", err)
+		}
+	}
+	return m.session.AwaitSchemaAgreement(ctx)
+}
+
+func (m *migrator) migrationsTableExists(ctx context.Context) (bool, error) {
+	var count int
+	if err := m.session.Query(
+		`SELECT COUNT(*) FROM system_schema.tables WHERE keyspace_name = ? AND table_name = ?`,
+		m.keyspace, MigrationsTable,
+	).WithContext(ctx).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to check %s table: %w", MigrationsTable, err)
+	}
+	return count > 0, nil
+}
+
+// lock toma un lock distribuido con una LWT; el TTL lo libera si el runner muere.
+// Si otro runner lo tiene, reintenta con backoff exponencial hasta el deadline de ctx
+// (o hasta lockTTLSeconds si ctx no tiene deadline, cuando el lock ya habría expirado).
+func (m *migrator) lock(ctx context.Context) (string, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, lockTTLSeconds*time.Second)
+		defer cancel()
+	}
+
+	owner := uuid.New().String()
+	backoff := lockBaseBackoff
+	for {
+		var currentID, currentOwner string
+		applied, err := m.session.Query(
+			`INSERT INTO `+migrationsLockTable+` (id, owner) VALUES ('lock', ?) IF NOT EXISTS USING TTL `+strconv.Itoa(lockTTLSeconds),
+			owner,
+		).WithContext(ctx).SerialConsistency(gocql.Serial).ScanCAS(&currentID, &currentOwner)
+		if err != nil {
+			return "", fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if applied {
+			return owner, nil
+		}
+
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		log.Printf("Cassandra migrations are locked by another runner (%s), retrying in %s", currentOwner, wait)
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("migrations are locked by another runner (%s): %w", currentOwner, ctx.Err())
+		case <-time.After(wait):
+		}
+
+		backoff *= 2
+		if backoff > lockMaxBackoff {
+			backoff = lockMaxBackoff
+		}
+	}
+}
+
+func (m *migrator) unlock(owner string) {
+	var currentOwner string
+	if _, err := m.session.Query(
+		`DELETE FROM `+migrationsLockTable+` WHERE id = 'lock' IF owner = ?`, owner,
+	).SerialConsistency(gocql.Serial).ScanCAS(&currentOwner); err != nil {
+		log.Printf("Error releasing Cassandra migration lock: %v", err)
+	}
+}
+
+// load lee y ordena los archivos de migración.
+func (m *migrator) load() ([]Migration, error) {
+	entries, err := fs.ReadDir(m.fsys, m.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations dir %s: %w", m.dir, err)
+	}
+
+	var migrations []Migration
+	seen := make(map[int]string)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+		if prev, ok := seen[version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, prev, entry.Name())
+		}
+		seen[version] = entry.Name()
+
+		content, err := fs.ReadFile(m.fsys, path.Join(m.dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		sum := sha256.Sum256(content)
+		migrations = append(migrations, Migration{
+			Version:    version,
+			Name:       match[2],
+			Checksum:   hex.EncodeToString(sum[:]),
+			Statements: splitStatements(string(content)),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+func pendingMigrations(migrations []Migration, applied []MigrationRecord) ([]Migration, error) {
+	appliedByVersion := make(map[int]MigrationRecord, len(applied))
+	for _, rec := range applied {
+		appliedByVersion[rec.Version] = rec
+	}
+
+	var pending []Migration
+	for _, mg := range migrations {
+		rec, ok := appliedByVersion[mg.Version]
+		if !ok {
+			pending = append(pending, mg)
+			continue
+		}
+		if rec.Checksum != mg.Checksum {
+			return nil, fmt.Errorf("checksum mismatch for migration %d_%s: applied %s, file %s", mg.Version, mg.Name, rec.Checksum, mg.Checksum)
+		}
+	}
+	return pending, nil
+}
+
+// splitStatements separa las sentencias por ';' ignorando comentarios (--, // y /* */).
+// Los ';' dentro de literales de texto (entre comillas simples o $$) no cortan la sentencia.
+func splitStatements(content string) []string {
+	var (
+		stmts   []string
+		current strings.Builder
+	)
+	flush := func() {
+		if stmt := strings.TrimSpace(current.String()); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\'' || strings.HasPrefix(content[i:], "$$"):
+			end := literalEnd(content, i)
+			current.WriteString(content[i:end])
+			i = end - 1
+		case strings.HasPrefix(content[i:], "--") || strings.HasPrefix(content[i:], "//"):
+			end := strings.IndexByte(content[i:], '\n')
+			if end < 0 {
+				i = len(content)
+				continue
+			}
+			i += end
+			current.WriteByte('\n')
+		case strings.HasPrefix(content[i:], "/*"):
+			end := strings.Index(content[i+2:], "*/")
+			if end < 0 {
+				i = len(content)
+				continue
+			}
+			i += end + 3
+			current.WriteByte(' ')
+		case c == ';':
+			flush()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+	return stmts
+}
+
+// literalEnd devuelve la posición siguiente al cierre del literal que empieza en start.
+// Un literal sin cerrar se extiende hasta el final del contenido.
+func literalEnd(content string, start int) int {
+	if strings.HasPrefix(content[start:], "$$") {
+		if end := strings.Index(content[start+2:], "$$"); end >= 0 {
+			return start + 2 + end + 2
+		}
+		return len(content)
+	}
+
+	for i := start + 1; i < len(content); i++ {
+		if content[i] != '\'' {
+			continue
+		}
+		// '' es una comilla escapada dentro del literal
+		if i+1 < len(content) && content[i+1] == '\'' {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(content)
+}
+
+func writePlan(out io.Writer, pending []Migration) {
+	if len(pending) == 0 {
+		fmt.Fprintln(out, "-- no pending Cassandra migrations")
+		return
+	}
+	for _, mg := range pending {
+		fmt.Fprintf(out, "-- %d_%s (checksum %s)\n", mg.Version, mg.Name, mg.Checksum)
+		for _, stmt := range mg.Statements {
+			fmt.Fprintf(out, "%s;\n", stmt)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package pkgcassandra
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Niveles de consistencia más usados, para no depender de gocql en los módulos.
+const (
+	One         = gocql.One
+	LocalOne    = gocql.LocalOne
+	Quorum      = gocql.Quorum
+	LocalQuorum = gocql.LocalQuorum
+	All         = gocql.All
+)
+
+// QueryOption ajusta una query o un batch antes de ejecutarlo, por encima de los valores por
+// defecto de la sesión (consistencia, reintentos y ejecución especulativa de la Config).
+type QueryOption func(*statementOptions)
+
+type statementOptions struct {
+	consistency *gocql.Consistency
+	serial      *gocql.SerialConsistency
+	idempotent  bool
+	retry       gocql.RetryPolicy
+	speculative gocql.SpeculativeExecutionPolicy
+	pageSize    int
+}
+
+// WithConsistency fija el nivel de consistencia de la query (por ejemplo, gocql.One para lecturas
+// que toleran datos algo viejos, o gocql.LocalQuorum para leer lo recién escrito).
+func WithConsistency(c gocql.Consistency) QueryOption {
+	return func(o *statementOptions) { o.consistency = &c }
+}
+
+// WithSerialConsistency fija la consistencia de la fase Paxos de las escrituras condicionales (IF ...).
+func WithSerialConsistency(c gocql.SerialConsistency) QueryOption {
+	return func(o *statementOptions) { o.serial = &c }
+}
+
+// Idempotent marca la query como segura de repetir. gocql solo reintenta y ejecuta en forma
+// especulativa las queries idempotentes: un INSERT con valores fijos lo es, un UPDATE de contador no.
+func Idempotent() QueryOption {
+	return func(o *statementOptions) { o.idempotent = true }
+}
+
+// WithRetryPolicy reemplaza la política de reintentos de la sesión.
+func WithRetryPolicy(p gocql.RetryPolicy) QueryOption {
+	return func(o *statementOptions) { o.retry = p }
+}
+
+// WithSpeculativeExecution lanza hasta attempts ejecuciones extra contra otros nodos si la query
+// no respondió en delay. Solo tiene efecto junto con Idempotent.
+func WithSpeculativeExecution(attempts int, delay time.Duration) QueryOption {
+	return func(o *statementOptions) {
+		o.speculative = &gocql.SimpleSpeculativeExecution{NumAttempts: attempts, TimeoutDelay: delay}
+	}
+}
+
+// WithPageSize fija cuántas filas trae cada página del servidor.
+func WithPageSize(n int) QueryOption {
+	return func(o *statementOptions) { o.pageSize = n }
+}
+
+// ParseConsistency convierte un nivel de consistencia escrito como en CQL (ONE, LOCAL_QUORUM, ...).
+func ParseConsistency(s string) (gocql.Consistency, error) {
+	c, err := gocql.ParseConsistencyWrapper(strings.ToUpper(strings.TrimSpace(s)))
+	if err != nil {
+		return c, fmt.Errorf("invalid cassandra consistency %q", s)
+	}
+	return c, nil
+}
+
+func newStatementOptions(defaults []QueryOption, opts []QueryOption) *statementOptions {
+	o := &statementOptions{}
+	for _, opt := range defaults {
+		opt(o)
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *statementOptions) applyQuery(q *gocql.Query) *gocql.Query {
+	if o.consistency != nil {
+		q.Consistency(*o.consistency)
+	}
+	if o.serial != nil {
+		q.SerialConsistency(*o.serial)
+	}
+	if o.retry != nil {
+		q.RetryPolicy(o.retry)
+	}
+	if o.speculative != nil {
+		q.SetSpeculativeExecutionPolicy(o.speculative)
+	}
+	if o.pageSize > 0 {
+		q.PageSize(o.pageSize)
+	}
+	return q.Idempotent(o.idempotent)
+}
+
+func (o *statementOptions) applyBatch(b *gocql.Batch) {
+	if o.consistency != nil {
+		b.SetConsistency(*o.consistency)
+	}
+	if o.serial != nil {
+		b.SerialConsistency(*o.serial)
+	}
+	if o.retry != nil {
+		b.RetryPolicy(o.retry)
+	}
+	if o.speculative != nil {
+		b.SpeculativeExecutionPolicy(o.speculative)
+	}
+	// Un batch es idempotente solo si todas sus entradas lo son
+	for i := range b.Entries {
+		b.Entries[i].Idempotent = o.idempotent
+	}
+}
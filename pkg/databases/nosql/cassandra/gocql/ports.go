@@ -1,11 +1,26 @@
 package pkgcassandra
 
-import "github.com/gocql/gocql"
+import (
+	"context"
+	"io/fs"
+	"time"
+
+	"github.com/gocql/gocql"
+)
 
 type Repository interface {
 	Connect(config Config) error
 	Close()
 	GetSession() *gocql.Session
+	NewMigrator(fs.FS, string) Migrator
+	Query(ctx context.Context, stmt string, args []any, opts ...QueryOption) *gocql.Query
+	NewBatchWriter(BatchOptions) *BatchWriter
+}
+
+// Migrator aplica archivos CQL versionados y registra cada versión en schema_migrations.
+type Migrator interface {
+	Up(context.Context, MigrateOptions) ([]Migration, error)
+	Status(context.Context) ([]MigrationRecord, error)
 }
 
 type Config interface {
@@ -17,5 +32,24 @@ type Config interface {
 	SetUsername(username string)
 	GetPassword() string
 	SetPassword(password string)
+	GetReplicationClass() string
+	SetReplicationClass(class string)
+	GetReplicationFactor() int
+	SetReplicationFactor(factor int)
+	GetDatacenter() string
+	SetDatacenter(dc string)
+	GetSchemaAgreementTimeout() time.Duration
+	SetSchemaAgreementTimeout(timeout time.Duration)
+	GetConsistency() string
+	SetConsistency(consistency string)
+	GetRetries() int
+	SetRetries(retries int)
+	GetRetryBackoff() (time.Duration, time.Duration)
+	SetRetryBackoff(min, max time.Duration)
+	GetSpeculativeExecution() (int, time.Duration)
+	SetSpeculativeExecution(attempts int, delay time.Duration)
+	GetMaxPreparedStmts() int
+	SetMaxPreparedStmts(n int)
+	ReplicationCQL() string
 	Validate() error
 }
@@ -0,0 +1,111 @@
+package pkgcassandra
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gocql/gocql"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// BuildSelect traduce el spec a un SELECT de CQL. Cassandra solo admite filtros de igualdad,
+// in y rangos sobre las columnas de la clave, y ordenar por columnas de clustering:
+// orderable lista esas columnas. La paginación es por page state (cursor), sin offset.
+func BuildSelect(table string, columns []string, spec *types.QuerySpec, orderable ...string) (string, []any, error) {
+	if spec.Offset > 0 {
+		return "", nil, types.NewError(types.ErrValidation, "offset pagination is not supported, use cursor", nil)
+	}
+	if len(spec.Columns) > 0 {
+		columns = spec.Columns
+	}
+
+	var conditions []string
+	var args []any
+	for _, f := range spec.Filters {
+		switch f.Op {
+		case types.OpEq:
+			conditions = append(conditions, f.Column+" = ?")
+		case types.OpGt:
+			conditions = append(conditions, f.Column+" > ?")
+		case types.OpGte:
+			conditions = append(conditions, f.Column+" >= ?")
+		case types.OpLt:
+			conditions = append(conditions, f.Column+" < ?")
+		case types.OpLte:
+			conditions = append(conditions, f.Column+" <= ?")
+		case types.OpIn:
+			conditions = append(conditions, f.Column+" IN ?")
+			args = append(args, f.Values)
+			continue
+		default:
+			return "", nil, types.NewError(types.ErrValidation, fmt.Sprintf("operator %s is not supported for %q", f.Op, f.Field), nil)
+		}
+		args = append(args, f.Value())
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "SELECT %s FROM %s", strings.Join(columns, ", "), table)
+	if len(conditions) > 0 {
+		b.WriteString(" WHERE " + strings.Join(conditions, " AND "))
+	}
+
+	// Solo el primer criterio define el orden; el KeyField que agrega el parser al final se ignora
+	if len(spec.Sort) > 0 {
+		first := spec.Sort[0]
+		switch {
+		case slices.Contains(orderable, first.Column):
+			direction := "ASC"
+			if first.Desc {
+				direction = "DESC"
+			}
+			fmt.Fprintf(&b, " ORDER BY %s %s", first.Column, direction)
+		case len(spec.Sort) > 1:
+			return "", nil, types.NewError(types.ErrValidation, fmt.Sprintf("cannot sort by %q", first.Field), nil)
+		}
+	}
+	return b.String(), args, nil
+}
+
+// SelectPage ejecuta query trayendo una página de spec.Limit filas a partir del cursor del spec.
+// scan lee una fila (Mapper.Scan sirve); el cursor siguiente es el page state de Cassandra,
+// opaco para el cliente, por lo que cada página cuesta lo mismo sin importar su posición.
+func SelectPage[T any](ctx context.Context, repo Repository, query string, args []any, spec *types.QuerySpec, scan func(gocql.Scanner) (T, error), opts ...QueryOption) (*types.Page[T], error) {
+	state, err := types.DecodePageState(spec.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	iter := repo.Query(ctx, query, args, opts...).
+		PageSize(spec.Limit).
+		PageState(state).
+		Iter()
+
+	items := make([]T, 0, spec.Limit)
+	scanner := iter.Scanner()
+	for len(items) < spec.Limit && scanner.Next() {
+		item, err := scan(scanner)
+		if err != nil {
+			_ = iter.Close()
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	next := iter.PageState()
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rows: %w", err)
+	}
+
+	page := &types.Page[T]{
+		Items: items,
+		Info: types.PageInfo{
+			Limit:      spec.Limit,
+			NextCursor: types.EncodePageState(next),
+			HasMore:    len(next) > 0,
+		},
+	}
+	return page, nil
+}
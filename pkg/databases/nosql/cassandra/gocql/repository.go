@@ -1,6 +1,7 @@
 package pkgcassandra
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sync"
@@ -16,12 +17,15 @@ var (
 
 type repository struct {
 	session *gocql.Session
+	config  Config
+	// defaults se aplica a cada Query y batch antes que las opciones de la llamada
+	defaults []QueryOption
 }
 
 func newRepository(config Config) (Repository, error) {
 	once.Do(func() {
 		// Asignamos directamente a la variable global "instance", sin redeclararla.
-		instance = &repository{}
+		instance = &repository{config: config}
 		initError = instance.Connect(config)
 		if initError != nil {
 			instance = nil
@@ -31,12 +35,18 @@ func newRepository(config Config) (Repository, error) {
 }
 
 func (c *repository) Connect(config Config) error {
+	c.config = config
+
 	// Conectar sin especificar keyspace para poder crear el keyspace si no existe.
 	cluster := gocql.NewCluster(config.GetHosts()...)
 	cluster.Authenticator = gocql.PasswordAuthenticator{
 		Username: config.GetUsername(),
 		Password: config.GetPassword(),
 	}
+	cluster.MaxWaitSchemaAgreement = config.GetSchemaAgreementTimeout()
+	if err := c.applyPolicies(cluster, config); err != nil {
+		return err
+	}
 	// Conectar sin keyspace
 	session, err := cluster.CreateSession()
 	if err != nil {
@@ -45,15 +55,18 @@ func (c *repository) Connect(config Config) error {
 	// Cerrar la sesión temporal al finalizar.
 	defer session.Close()
 
-	// Crear el keyspace si no existe.
-	// Se usa el nombre proporcionado en config.GetKeyspace()
+	// Crear el keyspace si no existe, con la replicación configurada.
 	createKeyspaceCQL := fmt.Sprintf(
-		`CREATE KEYSPACE IF NOT EXISTS %s WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 3}`,
+		`CREATE KEYSPACE IF NOT EXISTS %s WITH replication = %s`,
 		config.GetKeyspace(),
+		config.ReplicationCQL(),
 	)
 	if err := session.Query(createKeyspaceCQL).Exec(); err != nil {
 		return fmt.Errorf("failed to create keyspace %s: %w", config.GetKeyspace(), err)
 	}
+	if err := session.AwaitSchemaAgreement(context.Background()); err != nil {
+		return fmt.Errorf("schema agreement failed after creating keyspace %s: %w", config.GetKeyspace(), err)
+	}
 
 	// Ahora reconectar especificando el keyspace creado.
 	cluster.Keyspace = config.GetKeyspace()
@@ -68,6 +81,38 @@ func (c *repository) Connect(config Config) error {
 	return nil
 }
 
+// applyPolicies configura la consistencia por defecto, los reintentos con backoff exponencial y el
+// cache de prepared statements. gocql prepara y cachea las queries con argumentos automáticamente,
+// por lo que conviene reutilizar el mismo texto de query y pasar los valores como args.
+func (c *repository) applyPolicies(cluster *gocql.ClusterConfig, config Config) error {
+	consistency, err := ParseConsistency(config.GetConsistency())
+	if err != nil {
+		return err
+	}
+	cluster.Consistency = consistency
+
+	minBackoff, maxBackoff := config.GetRetryBackoff()
+	cluster.RetryPolicy = &gocql.ExponentialBackoffRetryPolicy{
+		NumRetries: config.GetRetries(),
+		Min:        minBackoff,
+		Max:        maxBackoff,
+	}
+	cluster.MaxPreparedStmts = config.GetMaxPreparedStmts()
+
+	// La sesión no tiene política especulativa por defecto: se agrega a cada query creada con Query
+	c.defaults = nil
+	if attempts, delay := config.GetSpeculativeExecution(); attempts > 0 {
+		c.defaults = append(c.defaults, WithSpeculativeExecution(attempts, delay))
+	}
+	return nil
+}
+
+// Query crea una query sobre la sesión con ctx, las políticas por defecto y opts.
+func (c *repository) Query(ctx context.Context, stmt string, args []any, opts ...QueryOption) *gocql.Query {
+	q := c.session.Query(stmt, args...).WithContext(ctx)
+	return newStatementOptions(c.defaults, opts).applyQuery(q)
+}
+
 func (c *repository) Close() {
 	if c.session != nil {
 		c.session.Close()
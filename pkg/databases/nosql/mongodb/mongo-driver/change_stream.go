@@ -0,0 +1,200 @@
+package pkgmongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const defaultWatchRetryDelay = 5 * time.Second
+
+// changeStreamHistoryLost es el código con el que Mongo rechaza un resume token que ya salió del oplog.
+const changeStreamHistoryLost = 286
+
+// ChangeEvent es un evento de un change stream sobre una colección de T.
+type ChangeEvent[T any] struct {
+	OperationType string              `bson:"operationType"`
+	DocumentKey   bson.M              `bson:"documentKey"`
+	FullDocument  *T                  `bson:"fullDocument"`
+	ClusterTime   primitive.Timestamp `bson:"clusterTime"`
+	// ResumeToken permite reanudar el stream inmediatamente después de este evento.
+	ResumeToken bson.Raw `bson:"-"`
+}
+
+// DocumentID devuelve el _id del documento afectado como string.
+func (e ChangeEvent[T]) DocumentID() string {
+	return IDString(e.DocumentKey["_id"])
+}
+
+// ResumeTokenStore persiste la posición de cada change stream para reanudarlo tras un reinicio.
+type ResumeTokenStore interface {
+	LoadResumeToken(ctx context.Context, stream string) (bson.Raw, error)
+	SaveResumeToken(ctx context.Context, stream string, token bson.Raw) error
+}
+
+// WatchOptions configura Collection.Watch.
+type WatchOptions struct {
+	// Name identifica al stream en el ResumeTokenStore; dos consumidores con el mismo nombre comparten posición.
+	Name string
+	// Pipeline filtra o transforma los eventos en el servidor (por ejemplo, $match por operationType).
+	Pipeline mongo.Pipeline
+	// FullDocument pide el documento completo también en los updates (updateLookup).
+	FullDocument bool
+	// Store guarda el resume token después de cada evento procesado; sin Store el stream arranca siempre desde "ahora".
+	Store ResumeTokenStore
+	// RetryDelay es la espera antes de reabrir el stream tras un error del servidor.
+	RetryDelay time.Duration
+}
+
+// Watch se suscribe a los cambios de la colección y llama a handler por cada evento, en orden.
+// El resume token se guarda después de que handler termina sin error, por lo que la entrega es
+// at-least-once: tras un reinicio se reprocesa como máximo el evento que estaba en curso.
+// Si handler falla, Watch devuelve su error. Los errores del stream se reintentan reabriéndolo
+// desde el último token; si el token ya no está en el oplog se reanuda desde el momento actual.
+// Watch bloquea hasta que ctx se cancela (y entonces devuelve nil).
+func (c *Collection[T]) Watch(ctx context.Context, opts WatchOptions, handler func(context.Context, ChangeEvent[T]) error) error {
+	if opts.Store != nil && opts.Name == "" {
+		return fmt.Errorf("watch options require a name to persist resume tokens")
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = defaultWatchRetryDelay
+	}
+	pipeline := opts.Pipeline
+	if pipeline == nil {
+		pipeline = mongo.Pipeline{}
+	}
+
+	var token bson.Raw
+	if opts.Store != nil {
+		loaded, err := opts.Store.LoadResumeToken(ctx, opts.Name)
+		if err != nil {
+			return fmt.Errorf("failed to load resume token for %s: %w", opts.Name, err)
+		}
+		token = loaded
+	}
+
+	for {
+		streamOpts := options.ChangeStream()
+		if opts.FullDocument {
+			streamOpts.SetFullDocument(options.UpdateLookup)
+		}
+		if token != nil {
+			streamOpts.SetResumeAfter(token)
+		}
+
+		next, err := c.consume(ctx, pipeline, streamOpts, opts, handler)
+		if next != nil {
+			token = next
+		}
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err == nil:
+			log.Printf("Change stream %s closed by the server, reopening in %s", c.coll.Name(), opts.RetryDelay)
+		case errors.Is(err, errHandler):
+			return errors.Unwrap(err)
+		case isHistoryLost(err):
+			log.Printf("Change stream %s: resume token expired, resuming from now: %v", c.coll.Name(), err)
+			token = nil
+		default:
+			log.Printf("Change stream %s failed, retrying in %s: %v", c.coll.Name(), opts.RetryDelay, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.RetryDelay):
+		}
+	}
+}
+
+// errHandler envuelve los errores de handler para distinguirlos de los del stream.
+var errHandler = errors.New("change stream handler failed")
+
+type handlerError struct{ err error }
+
+func (e handlerError) Error() string        { return e.err.Error() }
+func (e handlerError) Unwrap() error        { return e.err }
+func (e handlerError) Is(target error) bool { return target == errHandler }
+
+// consume procesa el stream hasta un error y devuelve el último token procesado.
+func (c *Collection[T]) consume(ctx context.Context, pipeline mongo.Pipeline, streamOpts *options.ChangeStreamOptions, opts WatchOptions, handler func(context.Context, ChangeEvent[T]) error) (bson.Raw, error) {
+	stream, err := c.coll.Watch(ctx, pipeline, streamOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := stream.Close(context.Background()); closeErr != nil {
+			log.Printf("Error closing change stream: %v", closeErr)
+		}
+	}()
+
+	var last bson.Raw
+	for stream.Next(ctx) {
+		var event ChangeEvent[T]
+		if err := stream.Decode(&event); err != nil {
+			return last, fmt.Errorf("failed to decode change event: %w", err)
+		}
+		event.ResumeToken = append(bson.Raw(nil), stream.ResumeToken()...)
+
+		if err := handler(ctx, event); err != nil {
+			return last, handlerError{err: err}
+		}
+		last = event.ResumeToken
+
+		if opts.Store != nil {
+			if err := opts.Store.SaveResumeToken(ctx, opts.Name, last); err != nil {
+				return last, fmt.Errorf("failed to save resume token: %w", err)
+			}
+		}
+	}
+	return last, stream.Err()
+}
+
+func isHistoryLost(err error) bool {
+	var serverErr mongo.ServerError
+	return errors.As(err, &serverErr) && serverErr.HasErrorCode(changeStreamHistoryLost)
+}
+
+// resumeTokenDocument es el documento con el que mongoResumeTokenStore guarda cada posición.
+type resumeTokenDocument struct {
+	Stream    string    `bson:"_id"`
+	Token     bson.Raw  `bson:"token"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+type mongoResumeTokenStore struct {
+	tokens *Collection[resumeTokenDocument]
+}
+
+// NewResumeTokenStore guarda los resume tokens en la colección name de la base del repositorio.
+func NewResumeTokenStore(repo Repository, name string) ResumeTokenStore {
+	return &mongoResumeTokenStore{tokens: NewCollection[resumeTokenDocument](repo, name)}
+}
+
+func (s *mongoResumeTokenStore) LoadResumeToken(ctx context.Context, stream string) (bson.Raw, error) {
+	var doc resumeTokenDocument
+	err := s.tokens.Raw().FindOne(ctx, bson.M{"_id": stream}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc.Token, nil
+}
+
+func (s *mongoResumeTokenStore) SaveResumeToken(ctx context.Context, stream string, token bson.Raw) error {
+	return s.tokens.Upsert(ctx, bson.M{"_id": stream}, &resumeTokenDocument{
+		Stream:    stream,
+		Token:     token,
+		UpdatedAt: time.Now(),
+	})
+}
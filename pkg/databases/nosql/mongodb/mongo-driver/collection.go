@@ -0,0 +1,214 @@
+package pkgmongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// Collection es un acceso tipado a una colección: los documentos se codifican y decodifican como T,
+// que debe llevar tags bson. Los ids se reciben y devuelven como string: si son hexadecimales de
+// 24 caracteres se tratan como ObjectID.
+type Collection[T any] struct {
+	coll *mongo.Collection
+}
+
+// NewCollection crea el acceso tipado a la colección name de la base del repositorio.
+func NewCollection[T any](repo Repository, name string) *Collection[T] {
+	return &Collection[T]{coll: repo.DB().Collection(name)}
+}
+
+// Raw devuelve la colección del driver para operaciones que el helper no cubre.
+func (c *Collection[T]) Raw() *mongo.Collection {
+	return c.coll
+}
+
+// InsertOne inserta doc y devuelve su id.
+func (c *Collection[T]) InsertOne(ctx context.Context, doc *T) (string, error) {
+	res, err := c.coll.InsertOne(ctx, doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to insert document into %s: %w", c.coll.Name(), err)
+	}
+	return IDString(res.InsertedID), nil
+}
+
+// InsertMany inserta docs en una sola operación y devuelve sus ids en el mismo orden.
+func (c *Collection[T]) InsertMany(ctx context.Context, docs []T) ([]string, error) {
+	if len(docs) == 0 {
+		return nil, nil
+	}
+	batch := make([]any, len(docs))
+	for i := range docs {
+		batch[i] = docs[i]
+	}
+
+	res, err := c.coll.InsertMany(ctx, batch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert documents into %s: %w", c.coll.Name(), err)
+	}
+	ids := make([]string, len(res.InsertedIDs))
+	for i, id := range res.InsertedIDs {
+		ids[i] = IDString(id)
+	}
+	return ids, nil
+}
+
+// FindByID busca un documento por _id. Devuelve types.ErrNotFound si no existe.
+func (c *Collection[T]) FindByID(ctx context.Context, id string) (*T, error) {
+	return c.FindOne(ctx, bson.M{"_id": IDValue(id)})
+}
+
+// FindOne devuelve el primer documento que cumple filter. Devuelve types.ErrNotFound si no hay ninguno.
+func (c *Collection[T]) FindOne(ctx context.Context, filter any, opts ...*options.FindOneOptions) (*T, error) {
+	var doc T
+	if err := c.coll.FindOne(ctx, filter, opts...).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("document not found in %s", c.coll.Name()), err)
+		}
+		return nil, fmt.Errorf("failed to find document in %s: %w", c.coll.Name(), err)
+	}
+	return &doc, nil
+}
+
+// Find devuelve todos los documentos que cumplen filter.
+func (c *Collection[T]) Find(ctx context.Context, filter any, opts ...*options.FindOptions) ([]T, error) {
+	if filter == nil {
+		filter = bson.M{}
+	}
+	cursor, err := c.coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find documents in %s: %w", c.coll.Name(), err)
+	}
+	defer func() {
+		if closeErr := cursor.Close(ctx); closeErr != nil {
+			log.Printf("Error closing cursor: %v", closeErr)
+		}
+	}()
+
+	docs := []T{}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode documents from %s: %w", c.coll.Name(), err)
+	}
+	return docs, nil
+}
+
+// FindPage devuelve la página descripta por spec; base se combina con los filtros del spec.
+func (c *Collection[T]) FindPage(ctx context.Context, base bson.M, spec *types.QuerySpec) (*types.Page[T], error) {
+	return FindPage[T](ctx, c.coll, base, spec)
+}
+
+// Count cuenta los documentos que cumplen filter.
+func (c *Collection[T]) Count(ctx context.Context, filter any) (int64, error) {
+	if filter == nil {
+		filter = bson.M{}
+	}
+	n, err := c.coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count documents in %s: %w", c.coll.Name(), err)
+	}
+	return n, nil
+}
+
+// UpdateByID aplica update (con operadores $set, $inc, ...) al documento id.
+// Devuelve types.ErrNotFound si no existe.
+func (c *Collection[T]) UpdateByID(ctx context.Context, id string, update any) error {
+	res, err := c.coll.UpdateByID(ctx, IDValue(id), update)
+	if err != nil {
+		return fmt.Errorf("failed to update document in %s: %w", c.coll.Name(), err)
+	}
+	if res.MatchedCount == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("document %s not found in %s", id, c.coll.Name()), nil)
+	}
+	return nil
+}
+
+// UpdateMany aplica update a los documentos que cumplen filter y devuelve cuántos se modificaron.
+func (c *Collection[T]) UpdateMany(ctx context.Context, filter, update any) (int64, error) {
+	res, err := c.coll.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update documents in %s: %w", c.coll.Name(), err)
+	}
+	return res.ModifiedCount, nil
+}
+
+// ReplaceByID reemplaza el documento id por doc. Devuelve types.ErrNotFound si no existe.
+func (c *Collection[T]) ReplaceByID(ctx context.Context, id string, doc *T) error {
+	res, err := c.coll.ReplaceOne(ctx, bson.M{"_id": IDValue(id)}, doc)
+	if err != nil {
+		return fmt.Errorf("failed to replace document in %s: %w", c.coll.Name(), err)
+	}
+	if res.MatchedCount == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("document %s not found in %s", id, c.coll.Name()), nil)
+	}
+	return nil
+}
+
+// Upsert reemplaza el documento que cumple filter o lo inserta si no existe.
+func (c *Collection[T]) Upsert(ctx context.Context, filter any, doc *T) error {
+	if _, err := c.coll.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to upsert document in %s: %w", c.coll.Name(), err)
+	}
+	return nil
+}
+
+// DeleteByID borra el documento id. Devuelve types.ErrNotFound si no existe.
+func (c *Collection[T]) DeleteByID(ctx context.Context, id string) error {
+	res, err := c.coll.DeleteOne(ctx, bson.M{"_id": IDValue(id)})
+	if err != nil {
+		return fmt.Errorf("failed to delete document in %s: %w", c.coll.Name(), err)
+	}
+	if res.DeletedCount == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("document %s not found in %s", id, c.coll.Name()), nil)
+	}
+	return nil
+}
+
+// DeleteMany borra los documentos que cumplen filter y devuelve cuántos se borraron.
+func (c *Collection[T]) DeleteMany(ctx context.Context, filter any) (int64, error) {
+	res, err := c.coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete documents in %s: %w", c.coll.Name(), err)
+	}
+	return res.DeletedCount, nil
+}
+
+// BulkWrite ejecuta writes en una sola ida al servidor. Con ordered=false el servidor sigue
+// ante errores individuales y los reporta todos juntos en un mongo.BulkWriteException.
+func (c *Collection[T]) BulkWrite(ctx context.Context, writes []mongo.WriteModel, ordered bool) (*mongo.BulkWriteResult, error) {
+	if len(writes) == 0 {
+		return &mongo.BulkWriteResult{}, nil
+	}
+	res, err := c.coll.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(ordered))
+	if err != nil {
+		return res, fmt.Errorf("failed to bulk write into %s: %w", c.coll.Name(), err)
+	}
+	return res, nil
+}
+
+// IDValue convierte un id string en ObjectID si es hexadecimal válido; si no, lo deja como string.
+func IDValue(id string) any {
+	if oid, err := primitive.ObjectIDFromHex(id); err == nil {
+		return oid
+	}
+	return id
+}
+
+// IDString convierte el _id devuelto por el driver en string.
+func IDString(id any) string {
+	switch v := id.(type) {
+	case primitive.ObjectID:
+		return v.Hex()
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,252 @@
+package pkgmongo
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IndexKey es un campo de un índice y su dirección.
+type IndexKey struct {
+	Field string
+	Desc  bool
+}
+
+// Asc y Desc arman las claves de un IndexDefinition.
+func Asc(field string) IndexKey  { return IndexKey{Field: field} }
+func Desc(field string) IndexKey { return IndexKey{Field: field, Desc: true} }
+
+// IndexDefinition declara un índice que EnsureIndexes crea o ajusta al arrancar.
+type IndexDefinition struct {
+	Collection string
+	// Name identifica al índice; si cambia la definición de un índice existente con el mismo nombre se recrea.
+	Name   string
+	Keys   []IndexKey
+	Unique bool
+	Sparse bool
+	// TTL > 0 crea un índice TTL: Mongo borra los documentos cuando el campo (una fecha) supera esa antigüedad.
+	// Solo admite una clave.
+	TTL time.Duration
+	// PartialFilter limita el índice a los documentos que cumplen el filtro.
+	PartialFilter bson.M
+}
+
+func (d IndexDefinition) validate() error {
+	if d.Collection == "" || d.Name == "" || len(d.Keys) == 0 {
+		return fmt.Errorf("index definition requires collection, name and keys")
+	}
+	if d.TTL > 0 && len(d.Keys) != 1 {
+		return fmt.Errorf("ttl index %s must have exactly one key", d.Name)
+	}
+	if d.TTL > 0 && d.TTL < time.Second {
+		return fmt.Errorf("ttl of index %s must be at least one second", d.Name)
+	}
+	return nil
+}
+
+func (d IndexDefinition) keys() bson.D {
+	keys := bson.D{}
+	for _, k := range d.Keys {
+		direction := 1
+		if k.Desc {
+			direction = -1
+		}
+		keys = append(keys, bson.E{Key: k.Field, Value: direction})
+	}
+	return keys
+}
+
+func (d IndexDefinition) model() mongo.IndexModel {
+	opts := options.Index().SetName(d.Name)
+	if d.Unique {
+		opts.SetUnique(true)
+	}
+	if d.Sparse {
+		opts.SetSparse(true)
+	}
+	if d.TTL > 0 {
+		opts.SetExpireAfterSeconds(int32(d.TTL / time.Second))
+	}
+	if len(d.PartialFilter) > 0 {
+		opts.SetPartialFilterExpression(d.PartialFilter)
+	}
+	return mongo.IndexModel{Keys: d.keys(), Options: opts}
+}
+
+// existingIndex es lo que devuelve listIndexes para cada índice.
+type existingIndex struct {
+	Name               string `bson:"name"`
+	Key                bson.D `bson:"key"`
+	Unique             bool   `bson:"unique"`
+	Sparse             bool   `bson:"sparse"`
+	ExpireAfterSeconds *int64 `bson:"expireAfterSeconds"`
+	PartialFilter      bson.M `bson:"partialFilterExpression"`
+}
+
+// EnsureIndexes crea los índices que faltan y ajusta los existentes para que coincidan con defs.
+// Un cambio de TTL se aplica en el lugar (collMod); cualquier otro cambio borra y recrea el índice.
+// Los índices que no están en defs no se tocan.
+func EnsureIndexes(ctx context.Context, db *mongo.Database, defs ...IndexDefinition) error {
+	byCollection := map[string][]IndexDefinition{}
+	var order []string
+	for _, def := range defs {
+		if err := def.validate(); err != nil {
+			return err
+		}
+		if _, ok := byCollection[def.Collection]; !ok {
+			order = append(order, def.Collection)
+		}
+		byCollection[def.Collection] = append(byCollection[def.Collection], def)
+	}
+
+	for _, name := range order {
+		if err := ensureCollectionIndexes(ctx, db, name, byCollection[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnsureIndexes aplica EnsureIndexes sobre la base del repositorio.
+func (r *repository) EnsureIndexes(ctx context.Context, defs ...IndexDefinition) error {
+	return EnsureIndexes(ctx, r.db, defs...)
+}
+
+func ensureCollectionIndexes(ctx context.Context, db *mongo.Database, collection string, defs []IndexDefinition) error {
+	coll := db.Collection(collection)
+
+	existing, err := listIndexes(ctx, coll)
+	if err != nil {
+		return err
+	}
+
+	var create []mongo.IndexModel
+	for _, def := range defs {
+		current, ok := existing[def.Name]
+		switch {
+		case !ok:
+			create = append(create, def.model())
+		case sameIndex(current, def):
+			continue
+		case onlyTTLChanged(current, def):
+			cmd := bson.D{
+				{Key: "collMod", Value: collection},
+				{Key: "index", Value: bson.D{
+					{Key: "name", Value: def.Name},
+					{Key: "expireAfterSeconds", Value: int64(def.TTL / time.Second)},
+				}},
+			}
+			if err := db.RunCommand(ctx, cmd).Err(); err != nil {
+				return fmt.Errorf("failed to update ttl of index %s.%s: %w", collection, def.Name, err)
+			}
+			log.Printf("Mongo index %s.%s ttl updated to %s", collection, def.Name, def.TTL)
+		default:
+			if _, err := coll.Indexes().DropOne(ctx, def.Name); err != nil {
+				return fmt.Errorf("failed to drop outdated index %s.%s: %w", collection, def.Name, err)
+			}
+			log.Printf("Mongo index %s.%s changed, recreating it", collection, def.Name)
+			create = append(create, def.model())
+		}
+	}
+
+	if len(create) == 0 {
+		return nil
+	}
+	if _, err := coll.Indexes().CreateMany(ctx, create); err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", collection, err)
+	}
+	log.Printf("Mongo created %d index(es) on %s", len(create), collection)
+	return nil
+}
+
+func listIndexes(ctx context.Context, coll *mongo.Collection) (map[string]existingIndex, error) {
+	cursor, err := coll.Indexes().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes of %s: %w", coll.Name(), err)
+	}
+	var list []existingIndex
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode indexes of %s: %w", coll.Name(), err)
+	}
+
+	indexes := make(map[string]existingIndex, len(list))
+	for _, idx := range list {
+		indexes[idx.Name] = idx
+	}
+	return indexes, nil
+}
+
+func sameIndex(current existingIndex, def IndexDefinition) bool {
+	return onlyTTLChanged(current, def) && sameTTL(current, def)
+}
+
+// onlyTTLChanged compara todo salvo el TTL; un índice sin TTL no puede pasar a tenerlo con collMod.
+func onlyTTLChanged(current existingIndex, def IndexDefinition) bool {
+	if (current.ExpireAfterSeconds != nil) != (def.TTL > 0) {
+		return false
+	}
+	if current.Unique != def.Unique || current.Sparse != def.Sparse {
+		return false
+	}
+	if !sameKeys(current.Key, def.keys()) {
+		return false
+	}
+	if len(current.PartialFilter) == 0 && len(def.PartialFilter) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(normalize(current.PartialFilter), normalize(def.PartialFilter))
+}
+
+func sameTTL(current existingIndex, def IndexDefinition) bool {
+	if current.ExpireAfterSeconds == nil {
+		return def.TTL == 0
+	}
+	return *current.ExpireAfterSeconds == int64(def.TTL/time.Second)
+}
+
+// sameKeys compara claves y direcciones; el servidor puede devolver la dirección como int32, int64 o double.
+func sameKeys(current, wanted bson.D) bool {
+	if len(current) != len(wanted) {
+		return false
+	}
+	for i := range current {
+		if current[i].Key != wanted[i].Key || direction(current[i].Value) != direction(wanted[i].Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func direction(v any) float64 {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	default:
+		return 0
+	}
+}
+
+// normalize pasa el filtro por bson para comparar los valores con los mismos tipos que devuelve el servidor.
+func normalize(m bson.M) bson.M {
+	raw, err := bson.Marshal(m)
+	if err != nil {
+		return m
+	}
+	var out bson.M
+	if err := bson.Unmarshal(raw, &out); err != nil {
+		return m
+	}
+	return out
+}
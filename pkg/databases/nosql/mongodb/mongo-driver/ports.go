@@ -1,11 +1,17 @@
 package pkgmongo
 
-import "go.mongodb.org/mongo-driver/mongo"
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
 
 type Repository interface {
 	Connect(Config) error
 	Close()
 	DB() *mongo.Database
+	// EnsureIndexes crea o ajusta los índices declarados (ver IndexDefinition).
+	EnsureIndexes(context.Context, ...IndexDefinition) error
 }
 
 type Config interface {
@@ -0,0 +1,178 @@
+package pkgmongo
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// BuildFilter traduce los filtros y el cursor del spec a un filtro de Mongo.
+func BuildFilter(spec *types.QuerySpec) (bson.M, error) {
+	conditions := bson.A{}
+	for _, f := range spec.Filters {
+		condition, err := filterCondition(f)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	after, err := types.DecodeKeysetCursor(spec)
+	if err != nil {
+		return nil, err
+	}
+	if after != nil {
+		conditions = append(conditions, keysetCondition(spec.Sort, after))
+	}
+
+	switch len(conditions) {
+	case 0:
+		return bson.M{}, nil
+	case 1:
+		return conditions[0].(bson.M), nil
+	default:
+		return bson.M{"$and": conditions}, nil
+	}
+}
+
+// BuildFindOptions traduce el orden, el sparse fieldset y la paginación del spec.
+// Pide Limit+1 documentos para que FindPage pueda saber si hay una página siguiente.
+func BuildFindOptions(spec *types.QuerySpec) *options.FindOptions {
+	sort := bson.D{}
+	for _, s := range spec.Sort {
+		direction := 1
+		if s.Desc {
+			direction = -1
+		}
+		sort = append(sort, bson.E{Key: s.Column, Value: direction})
+	}
+
+	opts := options.Find().
+		SetSort(sort).
+		SetLimit(int64(spec.Limit + 1)).
+		SetSkip(int64(spec.Offset))
+
+	if len(spec.Columns) > 0 {
+		projection := bson.M{}
+		for _, column := range spec.Columns {
+			projection[column] = 1
+		}
+		opts.SetProjection(projection)
+	}
+	return opts
+}
+
+// FindPage ejecuta la búsqueda descripta por el spec sobre collection y decodifica los documentos en T.
+// base se combina con los filtros del spec (por ejemplo, restricciones impuestas por el servidor).
+func FindPage[T any](ctx context.Context, collection *mongo.Collection, base bson.M, spec *types.QuerySpec) (*types.Page[T], error) {
+	filter, err := BuildFilter(spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(base) > 0 {
+		filter = bson.M{"$and": bson.A{base, filter}}
+	}
+
+	var total *int64
+	if spec.IncludeTotal {
+		countSpec := *spec
+		countSpec.Cursor = ""
+		countFilter, err := BuildFilter(&countSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(base) > 0 {
+			countFilter = bson.M{"$and": bson.A{base, countFilter}}
+		}
+		count, err := collection.CountDocuments(ctx, countFilter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count documents: %w", err)
+		}
+		total = &count
+	}
+
+	cursor, err := collection.Find(ctx, filter, BuildFindOptions(spec))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find documents: %w", err)
+	}
+	defer func() {
+		if closeErr := cursor.Close(ctx); closeErr != nil {
+			log.Printf("Error closing cursor: %v", closeErr)
+		}
+	}()
+
+	var docs []T
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode documents: %w", err)
+	}
+
+	return types.NewPage(docs, spec, total, func(last T) (string, error) {
+		return types.KeysetCursorFromItem(spec, last)
+	})
+}
+
+func filterCondition(f types.Filter) (bson.M, error) {
+	values := make([]any, 0, len(f.Values))
+	for _, value := range f.Values {
+		values = append(values, mongoValue(f.Column, value))
+	}
+	f.Values = values
+
+	switch f.Op {
+	case types.OpEq:
+		return bson.M{f.Column: f.Value()}, nil
+	case types.OpNe:
+		return bson.M{f.Column: bson.M{"$ne": f.Value()}}, nil
+	case types.OpGt:
+		return bson.M{f.Column: bson.M{"$gt": f.Value()}}, nil
+	case types.OpGte:
+		return bson.M{f.Column: bson.M{"$gte": f.Value()}}, nil
+	case types.OpLt:
+		return bson.M{f.Column: bson.M{"$lt": f.Value()}}, nil
+	case types.OpLte:
+		return bson.M{f.Column: bson.M{"$lte": f.Value()}}, nil
+	case types.OpIn:
+		return bson.M{f.Column: bson.M{"$in": f.Values}}, nil
+	case types.OpLike:
+		pattern := regexp.QuoteMeta(fmt.Sprint(f.Value()))
+		return bson.M{f.Column: bson.M{"$regex": pattern, "$options": "i"}}, nil
+	default:
+		return nil, types.NewError(types.ErrValidation, fmt.Sprintf("unsupported filter operator %q", f.Op), nil)
+	}
+}
+
+// keysetCondition arma la condición "documento posterior al cursor" para un orden de varios campos.
+func keysetCondition(sort []types.SortField, after []any) bson.M {
+	branches := bson.A{}
+	for i := range sort {
+		branch := bson.M{}
+		for j := 0; j < i; j++ {
+			branch[sort[j].Column] = mongoValue(sort[j].Column, after[j])
+		}
+		op := "$gt"
+		if sort[i].Desc {
+			op = "$lt"
+		}
+		branch[sort[i].Column] = bson.M{op: mongoValue(sort[i].Column, after[i])}
+		branches = append(branches, branch)
+	}
+	return bson.M{"$or": branches}
+}
+
+// mongoValue convierte los ids hexadecimales de _id en ObjectID para que la comparación sea por tipo.
+func mongoValue(column string, value any) any {
+	if column != "_id" {
+		return value
+	}
+	if id, ok := value.(string); ok {
+		return IDValue(id)
+	}
+	return value
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
 )
 
 // Bootstrap inicializa la base de datos sin aplicar migraciones automáticamente.
@@ -46,6 +48,12 @@ func Bootstrap(dbTypeStr, name, password, user, host string, port int) (Reposito
 			port, _ = strconv.Atoi(os.Getenv("GORM_PORT"))
 		}
 
+		// Réplicas de lectura opcionales: GORM_REPLICA_HOSTS=host1:5432,host2
+		replicas, err := pkgreplicas.ConfigFromEnv("GORM")
+		if err != nil {
+			return nil, err
+		}
+
 		config = newConfig(
 			dbType,
 			host,
@@ -54,6 +62,7 @@ func Bootstrap(dbTypeStr, name, password, user, host string, port int) (Reposito
 			name,
 			port,
 			"",
+			replicas,
 		)
 	case SQLite:
 		config = newConfig(
@@ -64,6 +73,7 @@ func Bootstrap(dbTypeStr, name, password, user, host string, port int) (Reposito
 			"",
 			0,
 			os.Getenv("SQLITE_PATH"),
+			pkgreplicas.Config{},
 		)
 	}
 
@@ -2,6 +2,8 @@ package pkggorm
 
 import (
 	"fmt"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
 )
 
 // DBType define los tipos de bases de datos soportadas
@@ -22,6 +24,7 @@ type Config interface {
 	GetDBName() string
 	GetPort() int
 	GetSQLitePath() string
+	GetReplicas() pkgreplicas.Config
 	Validate() error
 }
 
@@ -34,10 +37,11 @@ type config struct {
 	dbname     string
 	port       int
 	sqlitePath string
+	replicas   pkgreplicas.Config
 }
 
 // newConfig crea una nueva instancia de Config
-func newConfig(dbType DBType, host, user, password, dbname string, port int, sqlitePath string) Config {
+func newConfig(dbType DBType, host, user, password, dbname string, port int, sqlitePath string, replicas pkgreplicas.Config) Config {
 	return &config{
 		dbType:     dbType,
 		host:       host,
@@ -46,6 +50,7 @@ func newConfig(dbType DBType, host, user, password, dbname string, port int, sql
 		dbname:     dbname,
 		port:       port,
 		sqlitePath: sqlitePath,
+		replicas:   replicas,
 	}
 }
 
@@ -78,6 +83,10 @@ func (c *config) GetSQLitePath() string {
 	return c.sqlitePath
 }
 
+func (c *config) GetReplicas() pkgreplicas.Config {
+	return c.replicas
+}
+
 // Validate verifica si la configuración es válida
 func (c *config) Validate() error {
 	switch c.dbType {
@@ -89,8 +98,11 @@ func (c *config) Validate() error {
 		if c.sqlitePath == "" {
 			return fmt.Errorf("sqlite path is required")
 		}
+		if len(c.replicas.Hosts) > 0 {
+			return fmt.Errorf("read replicas are not supported for sqlite")
+		}
 	default:
 		return fmt.Errorf("unsupported database type: %s", c.dbType)
 	}
-	return nil
+	return c.replicas.Validate()
 }
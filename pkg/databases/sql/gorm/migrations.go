@@ -0,0 +1,298 @@
+package pkggorm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MigrationsTable registra las migraciones versionadas aplicadas.
+// No se usa "schema_migrations" porque esa tabla la gestiona el runner de pgxpool (golang-migrate).
+const MigrationsTable = "gorm_schema_migrations"
+
+// migrationLockKey identifica el lock de migraciones en Postgres (advisory lock) y MySQL (GET_LOCK).
+const migrationLockKey = "gorm_schema_migrations"
+
+// migrationFilePattern: NNNNNN_descripcion.sql; el prefijo numérico define el orden.
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_([A-Za-z0-9_\-]+)\.sql$`)
+
+// Migration es un archivo SQL versionado para un dialecto.
+type Migration struct {
+	Version  int64
+	Name     string
+	Checksum string
+	SQL      string
+}
+
+// MigrationRecord es una fila de MigrationsTable.
+type MigrationRecord struct {
+	Version   int64     `gorm:"column:version;primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"column:name;size:255;not null"`
+	Checksum  string    `gorm:"column:checksum;size:64;not null"`
+	AppliedAt time.Time `gorm:"column:applied_at;not null"`
+}
+
+func (MigrationRecord) TableName() string {
+	return MigrationsTable
+}
+
+// Migrate aplica en orden las migraciones pendientes de <dir>/<dialecto> dentro de fsys,
+// cada una en su propia transacción y bajo un lock para serializar runners concurrentes.
+// Falla si una migración ya aplicada cambió su contenido (checksum distinto).
+func (r *repository) Migrate(ctx context.Context, fsys fs.FS, dir string) ([]Migration, error) {
+	migrations, err := loadMigrations(fsys, path.Join(dir, string(r.config.GetDBType())))
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	err = r.client.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		// El lock se toma antes de crear la tabla de registro para que dos runners no compitan al crearla
+		if err := r.lockMigrations(conn); err != nil {
+			return err
+		}
+		defer r.unlockMigrations(conn)
+
+		if !conn.Migrator().HasTable(&MigrationRecord{}) {
+			if err := conn.Migrator().CreateTable(&MigrationRecord{}); err != nil {
+				return fmt.Errorf("failed to create %s table: %w", MigrationsTable, err)
+			}
+		}
+
+		records, err := appliedMigrations(conn)
+		if err != nil {
+			return err
+		}
+		pending, err := pendingMigrations(migrations, records)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range pending {
+			log.Printf("Gorm migration %06d_%s: applying", m.Version, m.Name)
+			if err := conn.Transaction(func(tx *gorm.DB) error {
+				for _, stmt := range splitStatements(m.SQL) {
+					if err := tx.Exec(stmt).Error; err != nil {
+						return err
+					}
+				}
+				return tx.Create(&MigrationRecord{
+					Version:   m.Version,
+					Name:      m.Name,
+					Checksum:  m.Checksum,
+					AppliedAt: time.Now().UTC(),
+				}).Error
+			}); err != nil {
+				return fmt.Errorf("migration %06d_%s failed: %w", m.Version, m.Name, err)
+			}
+			applied = append(applied, m)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// MigrationStatus devuelve las migraciones aplicadas y las pendientes de <dir>/<dialecto>.
+func (r *repository) MigrationStatus(ctx context.Context, fsys fs.FS, dir string) ([]MigrationRecord, []Migration, error) {
+	migrations, err := loadMigrations(fsys, path.Join(dir, string(r.config.GetDBType())))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db := r.client.WithContext(ctx)
+	var records []MigrationRecord
+	if db.Migrator().HasTable(&MigrationRecord{}) {
+		if records, err = appliedMigrations(db); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	pending, err := pendingMigrations(migrations, records)
+	if err != nil {
+		return nil, nil, err
+	}
+	return records, pending, nil
+}
+
+func (r *repository) lockMigrations(conn *gorm.DB) error {
+	switch r.config.GetDBType() {
+	case Postgres:
+		if err := conn.Exec("SELECT pg_advisory_lock(hashtext(?))", migrationLockKey).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+	case MySQL:
+		var acquired int
+		if err := conn.Raw("SELECT GET_LOCK(?, 60)", migrationLockKey).Scan(&acquired).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if acquired != 1 {
+			return fmt.Errorf("timeout acquiring migration lock")
+		}
+	}
+	// SQLite: las escrituras ya se serializan a nivel de archivo
+	return nil
+}
+
+func (r *repository) unlockMigrations(conn *gorm.DB) {
+	var err error
+	switch r.config.GetDBType() {
+	case Postgres:
+		err = conn.Exec("SELECT pg_advisory_unlock(hashtext(?))", migrationLockKey).Error
+	case MySQL:
+		err = conn.Exec("SELECT RELEASE_LOCK(?)", migrationLockKey).Error
+	}
+	if err != nil {
+		log.Printf("Error releasing migration lock: %v", err)
+	}
+}
+
+// WriteMigrationFile escribe las sentencias en <dir>/<dialecto>/NNNNNN_name.sql con la siguiente versión libre.
+func WriteMigrationFile(dir string, dbType DBType, name string, statements []string) (string, error) {
+	if len(statements) == 0 {
+		return "", errors.New("no statements to write")
+	}
+	if !regexp.MustCompile(`^[A-Za-z0-9_\-]+$`).MatchString(name) {
+		return "", fmt.Errorf("invalid migration name %q", name)
+	}
+
+	dialectDir := filepath.Join(dir, string(dbType))
+	if err := os.MkdirAll(dialectDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create migrations dir: %w", err)
+	}
+
+	existing, err := loadMigrations(os.DirFS(dialectDir), ".")
+	if err != nil {
+		return "", err
+	}
+	version := int64(1)
+	if len(existing) > 0 {
+		version = existing[len(existing)-1].Version + 1
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "-- Generated from model diff on %s. Review before committing.\n", time.Now().UTC().Format(time.RFC3339))
+	for _, stmt := range statements {
+		b.WriteString(strings.TrimSuffix(strings.TrimSpace(stmt), ";"))
+		b.WriteString(";\n")
+	}
+
+	file := filepath.Join(dialectDir, fmt.Sprintf("%06d_%s.sql", version, name))
+	if err := os.WriteFile(file, []byte(b.String()), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write migration file: %w", err)
+	}
+	return file, nil
+}
+
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read migrations dir %s: %w", dir, err)
+	}
+
+	var migrations []Migration
+	seen := make(map[int64]string)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+		if prev, ok := seen[version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, prev, entry.Name())
+		}
+		seen[version] = entry.Name()
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		sum := sha256.Sum256(content)
+		migrations = append(migrations, Migration{
+			Version:  version,
+			Name:     match[2],
+			Checksum: hex.EncodeToString(sum[:]),
+			SQL:      string(content),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+func appliedMigrations(db *gorm.DB) ([]MigrationRecord, error) {
+	var records []MigrationRecord
+	if err := db.Order("version").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", MigrationsTable, err)
+	}
+	return records, nil
+}
+
+func pendingMigrations(migrations []Migration, records []MigrationRecord) ([]Migration, error) {
+	applied := make(map[int64]MigrationRecord, len(records))
+	for _, rec := range records {
+		applied[rec.Version] = rec
+	}
+
+	var pending []Migration
+	for _, m := range migrations {
+		rec, ok := applied[m.Version]
+		if !ok {
+			pending = append(pending, m)
+			continue
+		}
+		if rec.Checksum != m.Checksum {
+			return nil, fmt.Errorf("checksum mismatch for migration %06d_%s: applied %s, file %s", m.Version, m.Name, rec.Checksum, m.Checksum)
+		}
+	}
+	return pending, nil
+}
+
+// splitStatements separa el archivo en sentencias terminadas en ';' al final de línea,
+// ignorando los comentarios de línea.
+func splitStatements(content string) []string {
+	var (
+		stmts   []string
+		current strings.Builder
+	)
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
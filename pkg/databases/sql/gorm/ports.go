@@ -1,11 +1,29 @@
 package pkggorm
 
-import "gorm.io/gorm"
+import (
+	"context"
+	"io/fs"
+
+	"gorm.io/gorm"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
+)
 
 // Repository es la interfaz para manejar operaciones relacionadas con GORM
 type Repository interface {
 	Connect(Config) error
 	Client() *gorm.DB
+	// DB devuelve la conexión a usar para ctx: la transacción abierta por NewTxManager o el cliente.
+	DB(context.Context) *gorm.DB
 	Address() string
+	DBType() DBType
+	// AutoMigrate altera el esquema sin versionado: usar solo en tests.
+	// En los entornos desplegados el esquema se gestiona con Migrate.
 	AutoMigrate(models ...any) error
+	Migrate(context.Context, fs.FS, string) ([]Migration, error)
+	MigrationStatus(context.Context, fs.FS, string) ([]MigrationRecord, []Migration, error)
+	GenerateMigration(context.Context, ...any) ([]string, error)
+	DetectDrift(context.Context, ...any) (*DriftReport, error)
+	// Stats devuelve el estado (salud, lag, lecturas) y el pool de la primaria y de las réplicas.
+	Stats() []pkgreplicas.NodeStats
 }
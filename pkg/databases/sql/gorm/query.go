@@ -0,0 +1,100 @@
+package pkggorm
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	sqlspec "github.com/teamcubation/teamcandidates/pkg/databases/sql/sqlspec"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// ApplyFilters agrega a db las condiciones de los filtros, del cursor y del modo de borrados del spec.
+func ApplyFilters(db *gorm.DB, spec *types.QuerySpec) (*gorm.DB, error) {
+	db, err := ApplyDeleted(db, spec.Deleted)
+	if err != nil {
+		return nil, err
+	}
+	where, args, err := sqlspec.Where(spec, sqlspec.QuestionMark, 0)
+	if err != nil {
+		return nil, err
+	}
+	if where != "" {
+		db = db.Where(where, args...)
+	}
+	return db, nil
+}
+
+// ApplyDeleted quita el scope de soft delete de GORM según el modo pedido.
+// DeletedOnly requiere que el modelo tenga una columna deleted_at.
+func ApplyDeleted(db *gorm.DB, mode types.DeletedMode) (*gorm.DB, error) {
+	switch mode {
+	case types.DeletedExclude:
+		return db, nil
+	case types.DeletedInclude:
+		return db.Unscoped(), nil
+	case types.DeletedOnly:
+		if db.Statement.Model == nil {
+			return nil, fmt.Errorf("deleted=only requires a model")
+		}
+		if err := db.Statement.Parse(db.Statement.Model); err != nil {
+			return nil, fmt.Errorf("failed to parse model: %w", err)
+		}
+		field := db.Statement.Schema.LookUpField("deleted_at")
+		if field == nil {
+			return nil, types.NewError(types.ErrValidation, "resource does not support deleted records", nil)
+		}
+		return db.Unscoped().Where(field.DBName + " IS NOT NULL"), nil
+	default:
+		return nil, types.NewError(types.ErrValidation, fmt.Sprintf("unknown deleted mode %q", mode), nil)
+	}
+}
+
+// ApplyQuerySpec aplica filtros, cursor, orden, sparse fieldset y paginación.
+// Pide Limit+1 filas para que Paginate pueda saber si hay una página siguiente.
+func ApplyQuerySpec(db *gorm.DB, spec *types.QuerySpec) (*gorm.DB, error) {
+	db, err := ApplyFilters(db, spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.Columns) > 0 {
+		db = db.Select(spec.Columns)
+	}
+	if order := sqlspec.OrderBy(spec); order != "" {
+		db = db.Order(order)
+	}
+	return db.Limit(spec.Limit + 1).Offset(spec.Offset), nil
+}
+
+// Paginate ejecuta sobre db (con Model o Table ya definidos) la query descripta por el spec
+// y devuelve una página de modelos T con el total (si se pidió) y el cursor siguiente.
+func Paginate[T any](db *gorm.DB, spec *types.QuerySpec) (*types.Page[T], error) {
+	var total *int64
+	if spec.IncludeTotal {
+		// El total ignora el cursor: cuenta todas las filas que cumplen los filtros
+		countSpec := *spec
+		countSpec.Cursor = ""
+		countDB, err := ApplyFilters(db.Session(&gorm.Session{}), &countSpec)
+		if err != nil {
+			return nil, err
+		}
+		var count int64
+		if err := countDB.Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to count rows: %w", err)
+		}
+		total = &count
+	}
+
+	query, err := ApplyQuerySpec(db.Session(&gorm.Session{}), spec)
+	if err != nil {
+		return nil, err
+	}
+	var rows []T
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list rows: %w", err)
+	}
+
+	return types.NewPage(rows, spec, total, func(last T) (string, error) {
+		return types.KeysetCursorFromItem(spec, last)
+	})
+}
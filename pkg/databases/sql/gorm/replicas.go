@@ -0,0 +1,202 @@
+package pkggorm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
+)
+
+// postgresLagQuery devuelve 0 si la réplica ya aplicó todo lo recibido: sin escrituras en la primaria
+// pg_last_xact_replay_timestamp no avanza y el lag aparente crecería indefinidamente.
+const postgresLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// dialector arma el driver de GORM para host:port con las credenciales de config.
+func dialector(config Config, host string, port int) gorm.Dialector {
+	switch config.GetDBType() {
+	case MySQL:
+		return mysql.Open(fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+			config.GetUser(), config.GetPassword(), host, port, config.GetDBName()))
+	default:
+		return postgres.Open(fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable",
+			host, config.GetUser(), config.GetPassword(), config.GetDBName(), port))
+	}
+}
+
+// connectReplicas abre las réplicas configuradas y registra los callbacks que mandan las lecturas a ellas.
+// Una réplica inaccesible no impide arrancar: el health check la deja fuera de la rotación.
+func (r *repository) connectReplicas(config Config) error {
+	primary, err := r.client.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get sql.DB from gorm.DB: %w", err)
+	}
+
+	replicasConfig := config.GetReplicas()
+	nodes := make([]pkgreplicas.Node[*sql.DB], 0, len(replicasConfig.Hosts))
+	for _, hostport := range replicasConfig.Hosts {
+		host, portStr := pkgreplicas.SplitHostPort(hostport, strconv.Itoa(config.GetPort()))
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			return fmt.Errorf("invalid port for replica %s: %w", hostport, err)
+		}
+
+		replica, err := gorm.Open(dialector(config, host, port), &gorm.Config{DisableAutomaticPing: true})
+		if err != nil {
+			return fmt.Errorf("failed to open replica %s: %w", hostport, err)
+		}
+		replicaDB, err := replica.DB()
+		if err != nil {
+			return fmt.Errorf("failed to get sql.DB for replica %s: %w", hostport, err)
+		}
+
+		nodes = append(nodes, pkgreplicas.Node[*sql.DB]{
+			Name: hostport,
+			Conn: replicaDB,
+			Lag:  replicaLag(config.GetDBType(), replica),
+			Pool: sqlPoolStats(replicaDB),
+		})
+	}
+
+	return r.routeReads(primary, nodes, replicasConfig)
+}
+
+// routeReads arma el router sobre la conexión primaria y las réplicas ya abiertas, y registra
+// los callbacks de lectura si hay réplicas.
+func (r *repository) routeReads(primary *sql.DB, nodes []pkgreplicas.Node[*sql.DB], replicasConfig pkgreplicas.Config) error {
+	r.router = pkgreplicas.NewRouter(pkgreplicas.Node[*sql.DB]{
+		Name: r.address,
+		Conn: primary,
+		Pool: sqlPoolStats(primary),
+	}, nodes, replicasConfig)
+
+	if !r.router.HasReplicas() {
+		return nil
+	}
+
+	if err := r.client.Callback().Query().Before("gorm:query").Register("replicas:route_query", r.routeRead); err != nil {
+		return fmt.Errorf("failed to register query callback: %w", err)
+	}
+	if err := r.client.Callback().Row().Before("gorm:row").Register("replicas:route_row", r.routeRead); err != nil {
+		return fmt.Errorf("failed to register row callback: %w", err)
+	}
+
+	r.router.Start(context.Background())
+	log.Printf("Gorm routing reads to %d replica(s)", len(nodes))
+	return nil
+}
+
+// routeRead manda la sentencia a una réplica salvo que corra dentro de una transacción,
+// tome locks (SELECT ... FOR UPDATE), sea SQL crudo que no es un SELECT o el contexto pida la primaria.
+func (r *repository) routeRead(db *gorm.DB) {
+	primary := r.router.Primary()
+	stmt := db.Statement
+	if stmt.ConnPool != gorm.ConnPool(primary) {
+		return
+	}
+	if _, locking := stmt.Clauses["FOR"]; locking {
+		return
+	}
+	if raw := strings.TrimSpace(stmt.SQL.String()); raw != "" && !isSelect(raw) {
+		return
+	}
+
+	if reader := r.router.Reader(stmt.Context); reader != primary {
+		stmt.ConnPool = reader
+	}
+}
+
+// Stats devuelve el estado y el pool de la primaria y de cada réplica.
+func (r *repository) Stats() []pkgreplicas.NodeStats {
+	if r.router == nil {
+		return nil
+	}
+	return r.router.Stats()
+}
+
+func isSelect(query string) bool {
+	upper := strings.ToUpper(query)
+	return strings.HasPrefix(upper, "SELECT") || strings.HasPrefix(upper, "WITH")
+}
+
+func replicaLag(dbType DBType, replica *gorm.DB) func(context.Context) (time.Duration, error) {
+	return func(ctx context.Context) (time.Duration, error) {
+		switch dbType {
+		case MySQL:
+			return mysqlReplicaLag(ctx, replica)
+		default:
+			var seconds float64
+			if err := replica.WithContext(ctx).Raw(postgresLagQuery).Scan(&seconds).Error; err != nil {
+				return 0, fmt.Errorf("failed to read replication lag: %w", err)
+			}
+			return time.Duration(seconds * float64(time.Second)), nil
+		}
+	}
+}
+
+// mysqlReplicaLag lee Seconds_Behind_Source (o Seconds_Behind_Master en versiones previas a 8.0.22).
+// NULL indica que la replicación está detenida.
+func mysqlReplicaLag(ctx context.Context, replica *gorm.DB) (time.Duration, error) {
+	rows, err := replica.WithContext(ctx).Raw("SHOW REPLICA STATUS").Rows()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read replica status: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, fmt.Errorf("failed to scan replica status: %w", err)
+	}
+
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		if values[i] == nil {
+			return 0, fmt.Errorf("replication is not running")
+		}
+		seconds, err := strconv.Atoi(string(values[i]))
+		if err != nil {
+			return 0, fmt.Errorf("invalid replication lag %q: %w", values[i], err)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return 0, fmt.Errorf("replica status has no lag column")
+}
+
+func sqlPoolStats(db *sql.DB) func() pkgreplicas.PoolStats {
+	return func() pkgreplicas.PoolStats {
+		s := db.Stats()
+		return pkgreplicas.PoolStats{
+			MaxConns:     int64(s.MaxOpenConnections),
+			TotalConns:   int64(s.OpenConnections),
+			InUse:        int64(s.InUse),
+			Idle:         int64(s.Idle),
+			WaitCount:    s.WaitCount,
+			WaitDuration: s.WaitDuration,
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package pkggorm
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
+)
+
+// replicaHealth controla el resultado del health check de la réplica de prueba.
+type replicaHealth struct {
+	err error
+}
+
+func (h *replicaHealth) lag(context.Context) (time.Duration, error) { return 0, h.err }
+
+// newReplicatedRepository abre una primaria y una réplica SQLite con datos distintos,
+// para que cada lectura delate a qué nodo fue.
+func newReplicatedRepository(t *testing.T, health *replicaHealth) *repository {
+	repo := newSQLiteRepository(t).(*repository)
+	assert.NoError(t, repo.client.Create(&item{Name: "primary"}).Error)
+	assert.NoError(t, repo.client.Exec("PRAGMA user_version = 1").Error)
+
+	replica, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "replica.db")), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, replica.AutoMigrate(&item{}))
+	assert.NoError(t, replica.Create(&item{Name: "replica"}).Error)
+	assert.NoError(t, replica.Exec("PRAGMA user_version = 2").Error)
+
+	primaryDB, err := repo.client.DB()
+	assert.NoError(t, err)
+	replicaDB, err := replica.DB()
+	assert.NoError(t, err)
+
+	err = repo.routeReads(primaryDB, []pkgreplicas.Node[*sql.DB]{{Name: "replica", Conn: replicaDB, Lag: health.lag}}, pkgreplicas.Config{
+		CheckInterval: time.Hour,
+		CheckTimeout:  time.Second,
+	})
+	assert.NoError(t, err)
+	t.Cleanup(repo.router.Close)
+	return repo
+}
+
+func TestRouteRead(t *testing.T) {
+	errDown := errors.New("connection refused")
+
+	// find devuelve el nombre del único item visible, que identifica al nodo que respondió
+	find := func(ctx context.Context, repo *repository, _ *txManager) (string, error) {
+		var found item
+		err := repo.DB(ctx).First(&found).Error
+		return found.Name, err
+	}
+
+	tests := []struct {
+		name   string
+		health replicaHealth
+		read   func(ctx context.Context, repo *repository, tm *txManager) (string, error)
+		want   string
+	}{
+		{
+			name: "Success: model queries go to the replica",
+			read: find,
+			want: "replica",
+		},
+		{
+			name: "Success: WithPrimary reads from the primary",
+			read: func(ctx context.Context, repo *repository, tm *txManager) (string, error) {
+				return find(pkgreplicas.WithPrimary(ctx), repo, tm)
+			},
+			want: "primary",
+		},
+		{
+			name: "Success: reads inside a transaction stay on the primary",
+			read: func(ctx context.Context, repo *repository, tm *txManager) (string, error) {
+				var name string
+				err := tm.WithinTx(ctx, func(ctx context.Context) error {
+					var err error
+					name, err = find(ctx, repo, tm)
+					return err
+				})
+				return name, err
+			},
+			want: "primary",
+		},
+		{
+			name: "Success: locking reads go to the primary",
+			read: func(ctx context.Context, repo *repository, _ *txManager) (string, error) {
+				var found item
+				err := repo.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&found).Error
+				return found.Name, err
+			},
+			want: "primary",
+		},
+		{
+			name:   "Success: reads fall back to the primary when the replica is unhealthy",
+			health: replicaHealth{err: errDown},
+			read:   find,
+			want:   "primary",
+		},
+		{
+			name: "Success: raw SELECT goes to the replica",
+			read: func(ctx context.Context, repo *repository, _ *txManager) (string, error) {
+				var name string
+				err := repo.DB(ctx).Raw("SELECT name FROM items").Scan(&name).Error
+				return name, err
+			},
+			want: "replica",
+		},
+		{
+			name: "Success: raw WITH goes to the replica",
+			read: func(ctx context.Context, repo *repository, _ *txManager) (string, error) {
+				var name string
+				err := repo.DB(ctx).Raw("  with names AS (SELECT name FROM items) SELECT name FROM names").Row().Scan(&name)
+				return name, err
+			},
+			want: "replica",
+		},
+		{
+			name: "Success: raw statements that are not a SELECT go to the primary",
+			read: func(ctx context.Context, repo *repository, _ *txManager) (string, error) {
+				var version int
+				err := repo.DB(ctx).Raw("PRAGMA user_version").Scan(&version).Error
+				if version == 1 {
+					return "primary", err
+				}
+				return "replica", err
+			},
+			want: "primary",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			health := tc.health
+			repo := newReplicatedRepository(t, &health)
+			repo.router.Check(context.Background())
+
+			got, err := tc.read(context.Background(), repo, NewTxManager(repo).(*txManager))
+
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.want, got, "read routed to the wrong node")
+		})
+	}
+}
+
+func TestIsSelect(t *testing.T) {
+	assert.True(t, isSelect("select 1"))
+	assert.True(t, isSelect("WITH x AS (SELECT 1) SELECT * FROM x"))
+	assert.False(t, isSelect("UPDATE items SET name = 'a'"))
+	assert.False(t, isSelect("SHOW REPLICA STATUS"))
+}
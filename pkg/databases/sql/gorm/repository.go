@@ -1,6 +1,7 @@
 package pkggorm
 
 import (
+	"database/sql"
 	"fmt"
 	"log"
 	"os"
@@ -10,6 +11,8 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
 )
 
 // repository es la implementación de Repository
@@ -17,6 +20,7 @@ type repository struct {
 	client  *gorm.DB
 	address string
 	config  Config
+	router  *pkgreplicas.Router[*sql.DB]
 }
 
 // NewRepository inicializa un nuevo repositorio sin usar singleton
@@ -42,17 +46,13 @@ func (r *repository) Connect(config Config) error {
 
 	switch config.GetDBType() {
 	case Postgres:
-		dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable",
-			config.GetHost(), config.GetUser(), config.GetPassword(), config.GetDBName(), config.GetPort())
-		db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+		db, err = gorm.Open(dialector(config, config.GetHost(), config.GetPort()), &gorm.Config{})
 		if err != nil {
 			return fmt.Errorf("failed to connect to PostgreSQL: %w", err)
 		}
 
 	case MySQL:
-		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-			config.GetUser(), config.GetPassword(), config.GetHost(), config.GetPort(), config.GetDBName())
-		db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+		db, err = gorm.Open(dialector(config, config.GetHost(), config.GetPort()), &gorm.Config{})
 		if err != nil {
 			return fmt.Errorf("failed to connect to MySQL: %w", err)
 		}
@@ -82,6 +82,10 @@ func (r *repository) Connect(config Config) error {
 		}
 	}
 
+	if err := r.connectReplicas(config); err != nil {
+		return fmt.Errorf("failed to configure read replicas: %w", err)
+	}
+
 	log.Printf("Gorm successfully connected to %s database: %s", config.GetDBType(), config.GetDBName())
 	return nil
 }
@@ -96,6 +100,10 @@ func (r *repository) Address() string {
 	return r.address
 }
 
+func (r *repository) DBType() DBType {
+	return r.config.GetDBType()
+}
+
 func (r *repository) AutoMigrate(models ...any) error {
 	return r.client.AutoMigrate(models...)
 }
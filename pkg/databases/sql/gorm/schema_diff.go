@@ -0,0 +1,169 @@
+package pkggorm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+// DriftReport compara el esquema de la base con los modelos.
+type DriftReport struct {
+	// PendingStatements es el DDL que AutoMigrate ejecutaría para alinear la base con los modelos.
+	PendingStatements []string
+	// UnmappedColumns son columnas presentes en la base que ningún campo del modelo mapea, por tabla.
+	UnmappedColumns map[string][]string
+}
+
+// HasDrift indica si la base y los modelos difieren.
+func (d *DriftReport) HasDrift() bool {
+	return len(d.PendingStatements) > 0 || len(d.UnmappedColumns) > 0
+}
+
+// GenerateMigration devuelve el DDL necesario para llevar la base actual al estado de los modelos,
+// sin modificar la base. En Postgres y SQLite (DDL transaccional) AutoMigrate corre dentro de una
+// transacción que se revierte al final; en MySQL las escrituras se registran sin ejecutarse.
+func (r *repository) GenerateMigration(ctx context.Context, models ...any) ([]string, error) {
+	sqlDB, err := r.client.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql.DB from gorm.DB: %w", err)
+	}
+
+	recorder := &recordingConnPool{pool: sqlDB, dialector: r.client.Dialector}
+	if r.config.GetDBType() != MySQL {
+		sqlTx, err := sqlDB.BeginTx(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to begin diff transaction: %w", err)
+		}
+		defer sqlTx.Rollback()
+
+		recorder.pool = sqlTx
+		recorder.execute = true
+	}
+
+	tx := r.client.Session(&gorm.Session{NewDB: true, Context: ctx, SkipDefaultTransaction: true})
+	tx.Statement.ConnPool = recorder
+
+	if err := tx.AutoMigrate(models...); err != nil {
+		return nil, fmt.Errorf("failed to diff models against database: %w", err)
+	}
+	return recorder.statements(), nil
+}
+
+// DetectDrift reporta las diferencias entre la base y los modelos, en ambos sentidos.
+func (r *repository) DetectDrift(ctx context.Context, models ...any) (*DriftReport, error) {
+	pending, err := r.GenerateMigration(ctx, models...)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &DriftReport{
+		PendingStatements: pending,
+		UnmappedColumns:   make(map[string][]string),
+	}
+
+	db := r.client.WithContext(ctx)
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, fmt.Errorf("failed to parse model %T: %w", model, err)
+		}
+		table := stmt.Schema.Table
+		if !db.Migrator().HasTable(table) {
+			continue
+		}
+
+		columns, err := db.Migrator().ColumnTypes(model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read columns of %s: %w", table, err)
+		}
+		for _, column := range columns {
+			if stmt.Schema.LookUpField(column.Name()) == nil {
+				report.UnmappedColumns[table] = append(report.UnmappedColumns[table], column.Name())
+			}
+		}
+		sort.Strings(report.UnmappedColumns[table])
+	}
+	for table, columns := range report.UnmappedColumns {
+		if len(columns) == 0 {
+			delete(report.UnmappedColumns, table)
+		}
+	}
+	return report, nil
+}
+
+// recordingConnPool registra las escrituras que genera el migrador de GORM.
+// Si execute es false no las ejecuta; las consultas de introspección siempre pasan.
+type recordingConnPool struct {
+	pool      gorm.ConnPool
+	dialector gorm.Dialector
+	execute   bool
+
+	mu   sync.Mutex
+	stmt []string
+}
+
+func (p *recordingConnPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return p.pool.PrepareContext(ctx, query)
+}
+
+func (p *recordingConnPool) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	statement := strings.TrimSpace(p.dialector.Explain(query, args...))
+	if !isSavepointStatement(statement) {
+		p.mu.Lock()
+		p.stmt = append(p.stmt, statement)
+		p.mu.Unlock()
+	}
+
+	if p.execute {
+		return p.pool.ExecContext(ctx, query, args...)
+	}
+	return driverResult{}, nil
+}
+
+func (p *recordingConnPool) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return p.pool.QueryContext(ctx, query, args...)
+}
+
+func (p *recordingConnPool) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return p.pool.QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx, Commit y Rollback permiten que los migradores que usan transacciones corran sobre el recorder;
+// GORM las anida con savepoints, que no forman parte del DDL generado.
+func (p *recordingConnPool) BeginTx(context.Context, *sql.TxOptions) (gorm.ConnPool, error) {
+	return p, nil
+}
+
+func (p *recordingConnPool) Commit() error {
+	return nil
+}
+
+func (p *recordingConnPool) Rollback() error {
+	return nil
+}
+
+func (p *recordingConnPool) statements() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := make([]string, len(p.stmt))
+	copy(out, p.stmt)
+	return out
+}
+
+func isSavepointStatement(statement string) bool {
+	upper := strings.ToUpper(statement)
+	return strings.HasPrefix(upper, "SAVEPOINT") ||
+		strings.HasPrefix(upper, "RELEASE SAVEPOINT") ||
+		strings.HasPrefix(upper, "ROLLBACK TO SAVEPOINT")
+}
+
+type driverResult struct{}
+
+func (driverResult) LastInsertId() (int64, error) { return 0, nil }
+
+func (driverResult) RowsAffected() (int64, error) { return 0, nil }
@@ -0,0 +1,78 @@
+package pkggorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+)
+
+// txKey identifica la transacción GORM en curso dentro del contexto.
+type txKey struct{}
+
+// gormTx es la transacción GORM activa junto con la profundidad de anidamiento.
+type gormTx struct {
+	db    *gorm.DB
+	depth int
+}
+
+type txManager struct {
+	repo Repository
+	opts pkgtx.Options
+}
+
+// NewTxManager crea un pkgtx.Manager cuyas transacciones se propagan a Repository.DB(ctx).
+// Las llamadas anidadas crean un savepoint por nivel.
+func NewTxManager(repo Repository, opts ...pkgtx.Option) pkgtx.Manager {
+	return &txManager{
+		repo: repo,
+		opts: pkgtx.NewOptions(opts...),
+	}
+}
+
+func (m *txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if current, ok := ctx.Value(txKey{}).(*gormTx); ok {
+		return withinSavepoint(ctx, current, fn)
+	}
+
+	return pkgtx.Retry(ctx, m.opts, func() error {
+		return m.repo.Client().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return fn(context.WithValue(ctx, txKey{}, &gormTx{db: tx}))
+		}, m.opts.TxOptions())
+	})
+}
+
+// withinSavepoint ejecuta fn dentro de un savepoint de la transacción en curso. No usa
+// gorm.DB.Transaction: nombra el savepoint según la función, así que los niveles anidados
+// compartirían nombre y revertir uno volvería al savepoint del nivel más interno.
+func withinSavepoint(ctx context.Context, current *gormTx, fn func(ctx context.Context) error) error {
+	nested := &gormTx{db: current.db, depth: current.depth + 1}
+	name := fmt.Sprintf("sp_%d", nested.depth)
+
+	if err := current.db.SavePoint(name).Error; err != nil {
+		return fmt.Errorf("failed to create savepoint %s: %w", name, err)
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, nested)); err != nil {
+		if rbErr := current.db.RollbackTo(name).Error; rbErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to rollback to savepoint %s: %w", name, rbErr))
+		}
+		return err
+	}
+
+	if err := current.db.Exec("RELEASE SAVEPOINT " + name).Error; err != nil {
+		return fmt.Errorf("failed to release savepoint %s: %w", name, err)
+	}
+	return nil
+}
+
+// DB devuelve la transacción que viaja en ctx o, si no hay ninguna, el cliente con ctx asociado.
+func (r *repository) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gormTx); ok {
+		return tx.db.WithContext(ctx)
+	}
+	return r.client.WithContext(ctx)
+}
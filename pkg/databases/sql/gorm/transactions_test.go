@@ -0,0 +1,105 @@
+package pkggorm
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
+)
+
+type item struct {
+	Name string `gorm:"primaryKey"`
+}
+
+// newSQLiteRepository abre una base SQLite en un archivo temporal con la tabla items.
+func newSQLiteRepository(t *testing.T) Repository {
+	path := filepath.Join(t.TempDir(), "test.db")
+	repo, err := newRepository(newConfig(SQLite, "", "", "", "", 0, path, pkgreplicas.Config{}))
+	assert.NoError(t, err)
+	assert.NoError(t, repo.AutoMigrate(&item{}))
+	return repo
+}
+
+func itemNames(t *testing.T, repo Repository) []string {
+	var names []string
+	assert.NoError(t, repo.Client().Model(&item{}).Order("name").Pluck("name", &names).Error)
+	return names
+}
+
+func TestTxManagerWithinTx(t *testing.T) {
+	errFail := errors.New("fail")
+	insert := func(ctx context.Context, repo Repository, name string) error {
+		return repo.DB(ctx).Create(&item{Name: name}).Error
+	}
+
+	tests := []struct {
+		name      string
+		fn        func(ctx context.Context, tm *txManager) error
+		wantErr   error
+		wantItems []string
+	}{
+		{
+			name: "Success: writes through DB(ctx) are committed",
+			fn: func(ctx context.Context, tm *txManager) error {
+				if err := insert(ctx, tm.repo, "a"); err != nil {
+					return err
+				}
+				return insert(ctx, tm.repo, "b")
+			},
+			wantItems: []string{"a", "b"},
+		},
+		{
+			name: "Error: writes through DB(ctx) are rolled back",
+			fn: func(ctx context.Context, tm *txManager) error {
+				if err := insert(ctx, tm.repo, "a"); err != nil {
+					return err
+				}
+				return errFail
+			},
+			wantErr: errFail,
+		},
+		{
+			name: "Success: failed nested call only rolls back its savepoint",
+			fn: func(ctx context.Context, tm *txManager) error {
+				if err := insert(ctx, tm.repo, "a"); err != nil {
+					return err
+				}
+				_ = tm.WithinTx(ctx, func(ctx context.Context) error {
+					if err := insert(ctx, tm.repo, "b"); err != nil {
+						return err
+					}
+					return tm.WithinTx(ctx, func(ctx context.Context) error {
+						if err := insert(ctx, tm.repo, "c"); err != nil {
+							return err
+						}
+						return errFail
+					})
+				})
+				return insert(ctx, tm.repo, "d")
+			},
+			wantItems: []string{"a", "d"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := newSQLiteRepository(t)
+			tm := NewTxManager(repo).(*txManager)
+
+			err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+				return tc.fn(ctx, tm)
+			})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+			}
+			assert.ElementsMatch(t, tc.wantItems, itemNames(t, repo), "items mismatch")
+		})
+	}
+}
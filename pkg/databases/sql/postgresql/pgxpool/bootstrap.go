@@ -1,6 +1,10 @@
 package pkgpostgresql
 
-import "os"
+import (
+	"os"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
+)
 
 func Bootstrap(user, password, host, port, migrationsDir, dbName string) (Repository, error) {
 	// Si algún parámetro es vacío, se usa os.Getenv para obtener el valor
@@ -23,6 +27,12 @@ func Bootstrap(user, password, host, port, migrationsDir, dbName string) (Reposi
 		dbName = os.Getenv("POSTGRES_DB")
 	}
 
+	// Réplicas de lectura opcionales: POSTGRES_REPLICA_HOSTS=host1:5432,host2
+	replicas, err := pkgreplicas.ConfigFromEnv("POSTGRES")
+	if err != nil {
+		return nil, err
+	}
+
 	// Crear la configuración
 	config := newConfig(
 		user,
@@ -31,6 +41,7 @@ func Bootstrap(user, password, host, port, migrationsDir, dbName string) (Reposi
 		port,
 		migrationsDir,
 		dbName,
+		replicas,
 	)
 
 	// Validar la configuración
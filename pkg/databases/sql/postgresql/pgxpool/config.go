@@ -3,9 +3,9 @@ package pkgpostgresql
 import (
 	"fmt"
 	"log"
-)
-
 
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
+)
 
 type config struct {
 	Host          string
@@ -14,10 +14,11 @@ type config struct {
 	DbName        string
 	Port          string
 	MigrationsDir string
+	Replicas      pkgreplicas.Config
 }
 
 // newConfig crea una nueva configuración con los valores proporcionados
-func newConfig(user, password, host, port, migrationsDir, dbName string) Config {
+func newConfig(user, password, host, port, migrationsDir, dbName string, replicas pkgreplicas.Config) Config {
 	return &config{
 		Host:          host,
 		User:          user,
@@ -25,12 +26,18 @@ func newConfig(user, password, host, port, migrationsDir, dbName string) Config
 		DbName:        dbName,
 		Port:          port,
 		MigrationsDir: migrationsDir,
+		Replicas:      replicas,
 	}
 }
 
 // DNS genera la cadena de conexión para PostgreSQL
 func (c *config) DNS() string {
-	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", c.User, c.Password, c.Host, c.Port, c.DbName)
+	return c.ReplicaDNS(c.Host, c.Port)
+}
+
+// ReplicaDNS genera la cadena de conexión para otro host con las mismas credenciales y base.
+func (c *config) ReplicaDNS(host, port string) string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", c.User, c.Password, host, port, c.DbName)
 }
 
 func (c *config) GetHost() string {
@@ -57,6 +64,10 @@ func (c *config) GetMigrationsDir() string {
 	return c.MigrationsDir
 }
 
+func (c *config) GetReplicas() pkgreplicas.Config {
+	return c.Replicas
+}
+
 // Validate valida que los campos necesarios estén presentes
 func (c *config) Validate() error {
 	if c.User == "" {
@@ -77,5 +88,5 @@ func (c *config) Validate() error {
 	if c.MigrationsDir == "" {
 		log.Println("Warning: MIGRATIONS_DIR environment variable is empty")
 	}
-	return nil
+	return c.Replicas.Validate()
 }
@@ -0,0 +1,189 @@
+package pkgpostgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratepgx "github.com/golang-migrate/migrate/v4/database/pgx"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v4/stdlib"
+)
+
+// MigrationsTable es la tabla donde golang-migrate registra la versión aplicada.
+const MigrationsTable = "schema_migrations"
+
+// MigrationStatus resume el estado de las migraciones de la base.
+type MigrationStatus struct {
+	Version uint   // Última versión aplicada (0 si no se aplicó ninguna)
+	Dirty   bool   // Una migración falló a mitad de camino; requiere Force
+	Latest  uint   // Última versión disponible en la fuente
+	Pending []uint // Versiones disponibles aún no aplicadas
+}
+
+type migrator struct {
+	m      *migrate.Migrate
+	source source.Driver
+}
+
+// NewMigrator crea un Migrator sobre el pool actual.
+// Si fsys es nil, las migraciones se leen del directorio configurado en POSTGRES_MIGRATIONS_DIR;
+// si no, de dir dentro de fsys (p. ej. un embed.FS).
+func (r *repository) NewMigrator(fsys fs.FS, dir string) (Migrator, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("postgres pool is not initialized")
+	}
+
+	src, sourceName, err := r.openSource(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// golang-migrate trabaja sobre database/sql: se abre un *sql.DB con la misma configuración del pool
+	db := stdlib.OpenDB(*r.pool.Config().ConnConfig)
+	driver, err := migratepgx.WithInstance(db, &migratepgx.Config{
+		MigrationsTable:       MigrationsTable,
+		DatabaseName:          r.config.GetDbName(),
+		LockStrategy:          migratepgx.LockStrategyAdvisory,
+		MultiStatementEnabled: true,
+	})
+	if err != nil {
+		src.Close()
+		db.Close()
+		return nil, fmt.Errorf("failed to create migration driver: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance(sourceName, src, r.config.GetDbName(), driver)
+	if err != nil {
+		src.Close()
+		driver.Close()
+		return nil, fmt.Errorf("failed to create migrator: %w", err)
+	}
+	m.Log = migrationLogger{}
+
+	return &migrator{
+		m:      m,
+		source: src,
+	}, nil
+}
+
+func (r *repository) openSource(fsys fs.FS, dir string) (source.Driver, string, error) {
+	if fsys != nil {
+		if dir == "" {
+			dir = "."
+		}
+		src, err := iofs.New(fsys, dir)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to open embedded migrations: %w", err)
+		}
+		return src, "iofs", nil
+	}
+
+	migrationsDir := r.config.GetMigrationsDir()
+	if dir != "" {
+		migrationsDir = dir
+	}
+	if migrationsDir == "" {
+		return nil, "", fmt.Errorf("no migrations source: POSTGRES_MIGRATIONS_DIR is empty")
+	}
+	src, err := source.Open("file://" + migrationsDir)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open migrations dir %s: %w", migrationsDir, err)
+	}
+	return src, "file", nil
+}
+
+// Up aplica todas las migraciones pendientes.
+func (mg *migrator) Up(ctx context.Context) error {
+	return mg.run(ctx, mg.m.Up)
+}
+
+// Down revierte steps migraciones; con steps <= 0 revierte todas.
+func (mg *migrator) Down(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		return mg.run(ctx, mg.m.Down)
+	}
+	return mg.run(ctx, func() error { return mg.m.Steps(-steps) })
+}
+
+// To migra hacia arriba o hacia abajo hasta la versión indicada.
+func (mg *migrator) To(ctx context.Context, version uint) error {
+	return mg.run(ctx, func() error { return mg.m.Migrate(version) })
+}
+
+// Force fija la versión sin ejecutar migraciones y limpia el flag dirty.
+// Se usa para recuperar la base después de una migración fallida; -1 equivale a "sin versión".
+func (mg *migrator) Force(ctx context.Context, version int) error {
+	return mg.run(ctx, func() error { return mg.m.Force(version) })
+}
+
+func (mg *migrator) Status(ctx context.Context) (*MigrationStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	status := &MigrationStatus{}
+	version, dirty, err := mg.m.Version()
+	switch {
+	case errors.Is(err, migrate.ErrNilVersion):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read migration version: %w", err)
+	default:
+		status.Version = version
+		status.Dirty = dirty
+	}
+
+	v, err := mg.source.First()
+	for err == nil {
+		status.Latest = v
+		if v > status.Version {
+			status.Pending = append(status.Pending, v)
+		}
+		v, err = mg.source.Next(v)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read migrations source: %w", err)
+	}
+
+	return status, nil
+}
+
+func (mg *migrator) Close() error {
+	srcErr, dbErr := mg.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+// run ejecuta la operación y la detiene de forma ordenada si se cancela el context.
+// ErrNoChange no se considera un error.
+func (mg *migrator) run(ctx context.Context, op func() error) error {
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			mg.m.GracefulStop <- true
+		case <-done:
+		}
+	}()
+
+	if err := op(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return ctx.Err()
+}
+
+type migrationLogger struct{}
+
+func (migrationLogger) Printf(format string, v ...any) {
+	log.Printf("postgres migrations: "+format, v...)
+}
+
+func (migrationLogger) Verbose() bool {
+	return false
+}
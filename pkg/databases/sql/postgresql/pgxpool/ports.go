@@ -2,17 +2,39 @@ package pkgpostgresql
 
 import (
 	"context"
+	"io/fs"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
 )
 
 type Repository interface {
 	Connect(Config) error
 	Close()
 	Pool() *pgxpool.Pool
+	// Querier devuelve la conexión a usar para ctx: la transacción abierta por NewTxManager o el pool.
+	Querier(context.Context) Querier
+	// Reader devuelve la conexión para una lectura: la transacción de ctx, la primaria si ctx lo pide
+	// (pkgreplicas.WithPrimary) o una réplica saludable. SelectContext lee siempre a través de Reader.
+	Reader(context.Context) Querier
+	// Stats devuelve el estado (salud, lag, lecturas) y el pool de la primaria y de las réplicas.
+	Stats() []pkgreplicas.NodeStats
 	SelectContext(context.Context, any, string, ...any) error
 	QueryRowContext(context.Context, string, ...any) pgx.Row
+	NewMigrator(fs.FS, string) (Migrator, error)
+}
+
+// Migrator aplica migraciones versionadas (formato golang-migrate: NNNNNN_name.up.sql / .down.sql).
+// Todas las operaciones toman un advisory lock, por lo que varios runners concurrentes se serializan.
+type Migrator interface {
+	Up(context.Context) error
+	Down(context.Context, int) error
+	To(context.Context, uint) error
+	Force(context.Context, int) error
+	Status(context.Context) (*MigrationStatus, error)
+	Close() error
 }
 
 type Config interface {
@@ -24,4 +46,6 @@ type Config interface {
 	GetDbName() string
 	GetPort() string
 	GetMigrationsDir() string
+	GetReplicas() pkgreplicas.Config
+	ReplicaDNS(host, port string) string
 }
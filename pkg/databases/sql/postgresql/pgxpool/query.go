@@ -0,0 +1,100 @@
+package pkgpostgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sqlspec "github.com/teamcubation/teamcandidates/pkg/databases/sql/sqlspec"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// BuildSelect arma un SELECT sobre table con los filtros, cursor, orden y paginación del spec.
+// columns son las columnas por defecto; el sparse fieldset del spec las reemplaza.
+// conditions son condiciones fijas (sin argumentos) que se agregan con AND, por ejemplo sqlspec.Deleted.
+func BuildSelect(table string, columns []string, spec *types.QuerySpec, conditions ...string) (string, []any, error) {
+	if len(spec.Columns) > 0 {
+		columns = spec.Columns
+	}
+
+	where, args, err := sqlspec.Where(spec, sqlspec.Dollar, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	where = joinConditions(where, conditions)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "SELECT %s FROM %s", strings.Join(columns, ", "), table)
+	if where != "" {
+		b.WriteString(" WHERE " + where)
+	}
+	if order := sqlspec.OrderBy(spec); order != "" {
+		b.WriteString(" ORDER BY " + order)
+	}
+
+	// Limit+1 filas para saber si hay una página siguiente
+	args = append(args, spec.Limit+1, spec.Offset)
+	fmt.Fprintf(&b, " LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	return b.String(), args, nil
+}
+
+// BuildCount arma el COUNT(*) de las filas de table que cumplen los filtros del spec (sin cursor).
+func BuildCount(table string, spec *types.QuerySpec, conditions ...string) (string, []any, error) {
+	countSpec := *spec
+	countSpec.Cursor = ""
+
+	where, args, err := sqlspec.Where(&countSpec, sqlspec.Dollar, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	where = joinConditions(where, conditions)
+
+	query := "SELECT COUNT(*) FROM " + table
+	if where != "" {
+		query += " WHERE " + where
+	}
+	return query, args, nil
+}
+
+// SelectPage ejecuta BuildSelect (y BuildCount si se pidió el total) y escanea las filas en T.
+// Participa de la transacción del contexto, si la hay.
+func SelectPage[T any](ctx context.Context, repo Repository, table string, columns []string, spec *types.QuerySpec, conditions ...string) (*types.Page[T], error) {
+	var total *int64
+	if spec.IncludeTotal {
+		query, args, err := BuildCount(table, spec, conditions...)
+		if err != nil {
+			return nil, err
+		}
+		var count int64
+		if err := repo.Reader(ctx).QueryRow(ctx, query, args...).Scan(&count); err != nil {
+			return nil, fmt.Errorf("failed to count rows: %w", err)
+		}
+		total = &count
+	}
+
+	query, args, err := BuildSelect(table, columns, spec, conditions...)
+	if err != nil {
+		return nil, err
+	}
+	var rows []T
+	if err := repo.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list rows: %w", err)
+	}
+
+	return types.NewPage(rows, spec, total, func(last T) (string, error) {
+		return types.KeysetCursorFromItem(spec, last)
+	})
+}
+
+func joinConditions(where string, conditions []string) string {
+	parts := make([]string, 0, len(conditions)+1)
+	if where != "" {
+		parts = append(parts, where)
+	}
+	for _, c := range conditions {
+		if c != "" {
+			parts = append(parts, c)
+		}
+	}
+	return strings.Join(parts, " AND ")
+}
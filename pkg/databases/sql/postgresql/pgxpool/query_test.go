@@ -0,0 +1,68 @@
+package pkgpostgresql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+func TestBuildSelect(t *testing.T) {
+	sort := []types.SortField{
+		{Field: "created_at", Column: "created_at", Type: types.FieldString, Desc: true},
+		{Field: "id", Column: "id", Type: types.FieldString},
+	}
+	cursor, err := types.EncodeKeysetCursor(&types.QuerySpec{Sort: sort}, []any{"2024-05-01", "t1"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		spec       *types.QuerySpec
+		conditions []string
+		wantQuery  string
+		wantArgs   []any
+		wantCount  string
+		wantCArgs  []any
+	}{
+		{
+			name:      "Success: default columns with offset pagination",
+			spec:      &types.QuerySpec{Limit: 10, Offset: 20, Sort: sort},
+			wantQuery: "SELECT id, content FROM tweets ORDER BY created_at DESC, id ASC LIMIT $1 OFFSET $2",
+			wantArgs:  []any{11, 20},
+			wantCount: "SELECT COUNT(*) FROM tweets",
+		},
+		{
+			name: "Success: filters, keyset cursor, sparse fieldset and fixed conditions",
+			spec: &types.QuerySpec{
+				Limit:   5,
+				Sort:    sort,
+				Cursor:  cursor,
+				Columns: []string{"id", "created_at"},
+				Filters: []types.Filter{{Column: "user_id", Op: types.OpEq, Values: []any{"u1"}}},
+			},
+			conditions: []string{"", "deleted_at IS NULL"},
+			wantQuery: "SELECT id, created_at FROM tweets " +
+				"WHERE user_id = $1 AND ((created_at < $2) OR (created_at = $3 AND id > $4)) AND deleted_at IS NULL " +
+				"ORDER BY created_at DESC, id ASC LIMIT $5 OFFSET $6",
+			wantArgs:  []any{"u1", "2024-05-01", "2024-05-01", "t1", 6, 0},
+			wantCount: "SELECT COUNT(*) FROM tweets WHERE user_id = $1 AND deleted_at IS NULL",
+			wantCArgs: []any{"u1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			query, args, err := BuildSelect("tweets", []string{"id", "content"}, tc.spec, tc.conditions...)
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.wantQuery, query, "select mismatch")
+			assert.Equal(t, tc.wantArgs, args, "select args mismatch")
+
+			// El total ignora el cursor
+			count, countArgs, err := BuildCount("tweets", tc.spec, tc.conditions...)
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.wantCount, count, "count mismatch")
+			assert.Equal(t, tc.wantCArgs, countArgs, "count args mismatch")
+		})
+	}
+}
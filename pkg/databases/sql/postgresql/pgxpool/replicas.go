@@ -0,0 +1,111 @@
+package pkgpostgresql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
+)
+
+// replicaLagQuery devuelve 0 si la réplica ya aplicó todo lo recibido: sin escrituras en la primaria
+// pg_last_xact_replay_timestamp no avanza y el lag aparente crecería indefinidamente.
+const replicaLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// connectReplicas abre un pool por réplica configurada y arranca sus health checks.
+// Los pools de réplicas son lazy: una réplica inaccesible no impide arrancar, el health check la deja fuera.
+func (r *repository) connectReplicas(c Config) error {
+	replicasConfig := c.GetReplicas()
+	nodes := make([]pkgreplicas.Node[*pgxpool.Pool], 0, len(replicasConfig.Hosts))
+	for _, hostport := range replicasConfig.Hosts {
+		host, port := pkgreplicas.SplitHostPort(hostport, c.GetPort())
+
+		config, err := poolConfig(c.ReplicaDNS(host, port))
+		if err != nil {
+			closePools(nodes)
+			return fmt.Errorf("invalid replica %s: %w", hostport, err)
+		}
+		config.LazyConnect = true
+
+		pool, err := pgxpool.ConnectConfig(context.Background(), config)
+		if err != nil {
+			closePools(nodes)
+			return fmt.Errorf("failed to open replica %s: %w", hostport, err)
+		}
+
+		nodes = append(nodes, pkgreplicas.Node[*pgxpool.Pool]{
+			Name: hostport,
+			Conn: pool,
+			Lag:  replicaLag(pool),
+			Pool: poolStats(pool),
+		})
+	}
+
+	r.router = pkgreplicas.NewRouter(pkgreplicas.Node[*pgxpool.Pool]{
+		Name: c.GetHost(),
+		Conn: r.pool,
+		Pool: poolStats(r.pool),
+	}, nodes, replicasConfig)
+
+	if r.router.HasReplicas() {
+		r.router.Start(context.Background())
+		log.Printf("Postgres routing reads to %d replica(s)", len(nodes))
+	}
+	return nil
+}
+
+// Reader devuelve la transacción que viaja en ctx o, si no hay ninguna, el pool que corresponde a una lectura.
+func (r *repository) Reader(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	if r.router == nil {
+		return r.pool
+	}
+	return r.router.Reader(ctx)
+}
+
+// Stats devuelve el estado y el pool de la primaria y de cada réplica.
+func (r *repository) Stats() []pkgreplicas.NodeStats {
+	if r.router == nil {
+		return nil
+	}
+	return r.router.Stats()
+}
+
+func replicaLag(pool *pgxpool.Pool) func(context.Context) (time.Duration, error) {
+	return func(ctx context.Context) (time.Duration, error) {
+		var seconds float64
+		if err := pool.QueryRow(ctx, replicaLagQuery).Scan(&seconds); err != nil {
+			return 0, fmt.Errorf("failed to read replication lag: %w", err)
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+}
+
+func poolStats(pool *pgxpool.Pool) func() pkgreplicas.PoolStats {
+	return func() pkgreplicas.PoolStats {
+		s := pool.Stat()
+		return pkgreplicas.PoolStats{
+			MaxConns:     int64(s.MaxConns()),
+			TotalConns:   int64(s.TotalConns()),
+			InUse:        int64(s.AcquiredConns()),
+			Idle:         int64(s.IdleConns()),
+			WaitCount:    s.EmptyAcquireCount(),
+			WaitDuration: s.AcquireDuration(),
+		}
+	}
+}
+
+func closePools(nodes []pkgreplicas.Node[*pgxpool.Pool]) {
+	for _, n := range nodes {
+		n.Conn.Close()
+	}
+}
@@ -0,0 +1,115 @@
+package pkgpostgresql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
+)
+
+// fakeTx representa la transacción abierta por NewTxManager; Reader solo la devuelve.
+type fakeTx struct {
+	pgx.Tx
+}
+
+// lazyPool crea un pool que no abre conexiones hasta la primera query.
+func lazyPool(t *testing.T, host string) *pgxpool.Pool {
+	config, err := pgxpool.ParseConfig("postgres://user:pass@" + host + ":5432/db")
+	assert.NoError(t, err)
+	config.LazyConnect = true
+
+	pool, err := pgxpool.ConnectConfig(context.Background(), config)
+	assert.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestRepositoryReader(t *testing.T) {
+	errDown := errors.New("connection refused")
+	tx := &fakeTx{}
+
+	tests := []struct {
+		name        string
+		withRouter  bool
+		replicaDown bool
+		ctx         context.Context
+		want        func(primary, replica *pgxpool.Pool) Querier
+	}{
+		{
+			name:       "Success: reads go to a healthy replica",
+			withRouter: true,
+			ctx:        context.Background(),
+			want:       func(primary, replica *pgxpool.Pool) Querier { return replica },
+		},
+		{
+			name:       "Success: WithPrimary reads from the primary",
+			withRouter: true,
+			ctx:        pkgreplicas.WithPrimary(context.Background()),
+			want:       func(primary, replica *pgxpool.Pool) Querier { return primary },
+		},
+		{
+			name:        "Success: unhealthy replica falls back to the primary",
+			withRouter:  true,
+			replicaDown: true,
+			ctx:         context.Background(),
+			want:        func(primary, replica *pgxpool.Pool) Querier { return primary },
+		},
+		{
+			name:       "Success: the transaction in the context wins over the replicas",
+			withRouter: true,
+			ctx:        context.WithValue(context.Background(), txKey{}, pgx.Tx(tx)),
+			want:       func(primary, replica *pgxpool.Pool) Querier { return tx },
+		},
+		{
+			name: "Success: without router reads use the pool",
+			ctx:  context.Background(),
+			want: func(primary, replica *pgxpool.Pool) Querier { return primary },
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			primary, replica := lazyPool(t, "primary.invalid"), lazyPool(t, "replica.invalid")
+			repo := &repository{pool: primary}
+			if tc.withRouter {
+				lag := func(context.Context) (time.Duration, error) {
+					if tc.replicaDown {
+						return 0, errDown
+					}
+					return 0, nil
+				}
+				repo.router = pkgreplicas.NewRouter(
+					pkgreplicas.Node[*pgxpool.Pool]{Name: "primary", Conn: primary},
+					[]pkgreplicas.Node[*pgxpool.Pool]{{Name: "replica", Conn: replica, Lag: lag}},
+					pkgreplicas.Config{CheckTimeout: time.Second},
+				)
+				repo.router.Check(context.Background())
+			}
+
+			assert.Same(t, tc.want(primary, replica), repo.Reader(tc.ctx), "read routed to the wrong connection")
+		})
+	}
+}
+
+func TestRepositoryQuerierIgnoresReplicas(t *testing.T) {
+	primary, replica := lazyPool(t, "primary.invalid"), lazyPool(t, "replica.invalid")
+	repo := &repository{
+		pool: primary,
+		router: pkgreplicas.NewRouter(
+			pkgreplicas.Node[*pgxpool.Pool]{Name: "primary", Conn: primary},
+			[]pkgreplicas.Node[*pgxpool.Pool]{{Name: "replica", Conn: replica}},
+			pkgreplicas.Config{},
+		),
+	}
+
+	// Las escrituras usan siempre la primaria o la transacción en curso
+	assert.Same(t, primary, repo.Querier(context.Background()))
+	tx := &fakeTx{}
+	assert.Same(t, tx, repo.Querier(context.WithValue(context.Background(), txKey{}, pgx.Tx(tx))))
+}
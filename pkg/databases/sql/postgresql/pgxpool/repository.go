@@ -12,35 +12,40 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	_ "github.com/jackc/pgx/v4/stdlib"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
 )
 
 var (
-	instance  Repository
-	once      sync.Once
-	initError error
+	instanceMu sync.RWMutex
+	instance   Repository
 )
 
 type repository struct {
 	pool   *pgxpool.Pool
+	router *pkgreplicas.Router[*pgxpool.Pool]
 	config Config
 }
 
+// newRepository abre un pool nuevo por llamada; GetInstance devuelve el último repositorio conectado.
 func newRepository(c Config) (Repository, error) {
-	once.Do(func() {
-		instance = &repository{
-			config: c,
-		}
-		initError = instance.Connect(c)
-		if initError != nil {
-			instance = nil
-		} else {
-			log.Printf("Postgres successfully connected to database: %s", c.GetDbName())
-		}
-	})
-	return instance, initError
+	repo := &repository{
+		config: c,
+	}
+	if err := repo.Connect(c); err != nil {
+		return nil, err
+	}
+	log.Printf("Postgres successfully connected to database: %s", c.GetDbName())
+
+	instanceMu.Lock()
+	instance = repo
+	instanceMu.Unlock()
+	return repo, nil
 }
 
 func GetInstance() (Repository, error) {
+	instanceMu.RLock()
+	defer instanceMu.RUnlock()
 	if instance == nil {
 		return nil, fmt.Errorf("repository instance is not initialized")
 	}
@@ -57,10 +62,21 @@ func (r *repository) Connect(c Config) error {
 		return err
 	}
 	r.pool = pool
+
+	if err := r.connectReplicas(c); err != nil {
+		pool.Close()
+		return fmt.Errorf("failed to configure read replicas: %w", err)
+	}
 	return nil
 }
 
 func (r *repository) Close() {
+	if r.router != nil {
+		r.router.Close()
+		for _, replica := range r.router.Replicas() {
+			replica.Close()
+		}
+	}
 	if r.pool != nil {
 		r.pool.Close()
 	}
@@ -71,24 +87,18 @@ func (r *repository) Pool() *pgxpool.Pool {
 }
 
 func (r *repository) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
-	return pgxscan.Select(ctx, r.pool, dest, query, args...)
+	return pgxscan.Select(ctx, r.Reader(ctx), dest, query, args...)
 }
 
 func ConnectPool(connString string) (*pgxpool.Pool, error) {
 	// Usar context.Background() para permitir todos los reintentos
 	ctx := context.Background()
 
-	config, err := pgxpool.ParseConfig(connString)
+	config, err := poolConfig(connString)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse database connection string: %w", err)
+		return nil, err
 	}
 
-	config.MaxConns = 10
-	config.MinConns = 1
-	config.HealthCheckPeriod = 1 * time.Minute
-	config.MaxConnLifetime = 24 * time.Hour
-	config.MaxConnIdleTime = 30 * time.Minute
-
 	var pool *pgxpool.Pool
 	maxRetries := 5
 	retryDelay := 5 * time.Second
@@ -120,6 +130,21 @@ func ConnectPool(connString string) (*pgxpool.Pool, error) {
 	return nil, fmt.Errorf("failed to connect after %d attempts: %w", maxRetries, err)
 }
 
+// poolConfig parsea connString y aplica los límites del pool compartidos por primaria y réplicas.
+func poolConfig(connString string) (*pgxpool.Config, error) {
+	config, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse database connection string: %w", err)
+	}
+
+	config.MaxConns = 10
+	config.MinConns = 1
+	config.HealthCheckPeriod = 1 * time.Minute
+	config.MaxConnLifetime = 24 * time.Hour
+	config.MaxConnIdleTime = 30 * time.Minute
+	return config, nil
+}
+
 func (r *repository) QueryRowContext(ctx context.Context, query string, args ...any) pgx.Row {
-	return r.pool.QueryRow(ctx, query, args...)
+	return r.Querier(ctx).QueryRow(ctx, query, args...)
 }
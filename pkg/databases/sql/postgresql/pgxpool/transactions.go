@@ -0,0 +1,96 @@
+package pkgpostgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+)
+
+// Querier es el subconjunto común de *pgxpool.Pool y pgx.Tx.
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// txKey identifica la transacción pgx en curso dentro del contexto.
+type txKey struct{}
+
+type txManager struct {
+	repo Repository
+	opts pkgtx.Options
+}
+
+// NewTxManager crea un pkgtx.Manager cuyas transacciones se propagan a Repository.Querier(ctx).
+// Las llamadas anidadas usan pseudo-transacciones de pgx (savepoints).
+func NewTxManager(repo Repository, opts ...pkgtx.Option) pkgtx.Manager {
+	return &txManager{
+		repo: repo,
+		opts: pkgtx.NewOptions(opts...),
+	}
+}
+
+func (m *txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if current, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		// pgx.Tx.Begin crea un savepoint; los reintentos quedan a cargo de la transacción externa
+		return runTx(ctx, func() (pgx.Tx, error) { return current.Begin(ctx) }, fn)
+	}
+
+	return pkgtx.Retry(ctx, m.opts, func() error {
+		return runTx(ctx, func() (pgx.Tx, error) { return m.repo.Pool().BeginTx(ctx, pgxTxOptions(m.opts)) }, fn)
+	})
+}
+
+func runTx(ctx context.Context, begin func() (pgx.Tx, error), fn func(ctx context.Context) error) error {
+	tx, err := begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil && !errors.Is(rbErr, pgx.ErrTxClosed) {
+			return errors.Join(err, fmt.Errorf("failed to rollback transaction: %w", rbErr))
+		}
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func pgxTxOptions(o pkgtx.Options) pgx.TxOptions {
+	opts := pgx.TxOptions{}
+	switch o.Isolation {
+	case sql.LevelReadUncommitted:
+		opts.IsoLevel = pgx.ReadUncommitted
+	case sql.LevelReadCommitted:
+		opts.IsoLevel = pgx.ReadCommitted
+	case sql.LevelRepeatableRead, sql.LevelSnapshot:
+		opts.IsoLevel = pgx.RepeatableRead
+	case sql.LevelSerializable, sql.LevelLinearizable:
+		opts.IsoLevel = pgx.Serializable
+	}
+	if o.ReadOnly {
+		opts.AccessMode = pgx.ReadOnly
+	}
+	return opts
+}
+
+// Querier devuelve la transacción que viaja en ctx o, si no hay ninguna, el pool.
+func (r *repository) Querier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return r.pool
+}
@@ -0,0 +1,96 @@
+package pkgreplicas
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxLag        = 10 * time.Second
+	defaultCheckInterval = 15 * time.Second
+	defaultCheckTimeout  = 2 * time.Second
+)
+
+// Config describe las réplicas de lectura de una base de datos.
+// Las réplicas usan las mismas credenciales y el mismo nombre de base que la primaria.
+type Config struct {
+	// Hosts son las réplicas en formato host o host:port.
+	Hosts []string
+	// MaxLag es el retraso de replicación a partir del cual una réplica deja de recibir lecturas (0 lo ignora).
+	MaxLag time.Duration
+	// CheckInterval es la frecuencia de los health checks.
+	CheckInterval time.Duration
+	// CheckTimeout limita la duración de cada health check.
+	CheckTimeout time.Duration
+}
+
+// ConfigFromEnv lee la configuración de réplicas de las variables <prefix>_REPLICA_HOSTS (separadas por coma),
+// <prefix>_REPLICA_MAX_LAG, <prefix>_REPLICA_CHECK_INTERVAL y <prefix>_REPLICA_CHECK_TIMEOUT.
+func ConfigFromEnv(prefix string) (Config, error) {
+	cfg := Config{
+		Hosts:         splitHosts(os.Getenv(prefix + "_REPLICA_HOSTS")),
+		MaxLag:        defaultMaxLag,
+		CheckInterval: defaultCheckInterval,
+		CheckTimeout:  defaultCheckTimeout,
+	}
+
+	durations := []struct {
+		name string
+		dest *time.Duration
+	}{
+		{prefix + "_REPLICA_MAX_LAG", &cfg.MaxLag},
+		{prefix + "_REPLICA_CHECK_INTERVAL", &cfg.CheckInterval},
+		{prefix + "_REPLICA_CHECK_TIMEOUT", &cfg.CheckTimeout},
+	}
+	for _, d := range durations {
+		raw := os.Getenv(d.name)
+		if raw == "" {
+			continue
+		}
+		value, err := time.ParseDuration(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid %s: %w", d.name, err)
+		}
+		*d.dest = value
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate verifica que los intervalos sean utilizables.
+func (c Config) Validate() error {
+	if len(c.Hosts) == 0 {
+		return nil
+	}
+	if c.CheckInterval <= 0 {
+		return fmt.Errorf("replica check interval must be positive")
+	}
+	if c.CheckTimeout <= 0 {
+		return fmt.Errorf("replica check timeout must be positive")
+	}
+	if c.MaxLag < 0 {
+		return fmt.Errorf("replica max lag cannot be negative")
+	}
+	return nil
+}
+
+// SplitHostPort separa host:port; si el host no trae puerto se usa defaultPort.
+func SplitHostPort(hostport, defaultPort string) (string, string) {
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return hostport, defaultPort
+	}
+	return host, port
+}
+
+func splitHosts(raw string) []string {
+	var hosts []string
+	for _, host := range strings.Split(raw, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
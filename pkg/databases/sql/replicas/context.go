@@ -0,0 +1,21 @@
+package pkgreplicas
+
+import "context"
+
+// primaryKey marca en el contexto que las lecturas deben ir a la primaria.
+type primaryKey struct{}
+
+// WithPrimary fuerza que las lecturas hechas con ctx vayan a la primaria.
+// Sirve para leer lo recién escrito sin depender del retraso de replicación.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// PrimaryRequired indica si ctx fue marcado con WithPrimary.
+func PrimaryRequired(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	forced, _ := ctx.Value(primaryKey{}).(bool)
+	return forced
+}
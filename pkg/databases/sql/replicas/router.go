@@ -0,0 +1,227 @@
+package pkgreplicas
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	RolePrimary = "primary"
+	RoleReplica = "replica"
+)
+
+// PoolStats es una foto del pool de conexiones de un nodo.
+type PoolStats struct {
+	MaxConns     int64
+	TotalConns   int64
+	InUse        int64
+	Idle         int64
+	WaitCount    int64
+	WaitDuration time.Duration
+}
+
+// Node es una conexión (primaria o réplica) administrada por el Router.
+type Node[T any] struct {
+	Name string
+	Conn T
+	// Lag mide el retraso de replicación; un error marca al nodo como no saludable.
+	Lag func(context.Context) (time.Duration, error)
+	// Pool devuelve las estadísticas del pool del nodo.
+	Pool func() PoolStats
+}
+
+// NodeStats es el estado de un nodo expuesto como métrica.
+type NodeStats struct {
+	Name      string
+	Role      string
+	Healthy   bool
+	Lag       time.Duration
+	LastError string
+	LastCheck time.Time
+	Reads     uint64
+	Pool      PoolStats
+}
+
+type node[T any] struct {
+	Node[T]
+	role  string
+	reads atomic.Uint64
+
+	mu        sync.RWMutex
+	healthy   bool
+	lag       time.Duration
+	lastErr   error
+	lastCheck time.Time
+}
+
+// Router reparte las lecturas entre las réplicas saludables (round robin) y manda a la primaria
+// las escrituras, las lecturas marcadas con WithPrimary y las lecturas sin réplicas disponibles.
+// Un health check periódico saca de la rotación a las réplicas caídas o con retraso mayor a MaxLag
+// y las reincorpora cuando se recuperan.
+type Router[T any] struct {
+	primary  *node[T]
+	replicas []*node[T]
+	cfg      Config
+	next     atomic.Uint64
+
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+// NewRouter crea un Router. Las réplicas arrancan dentro de la rotación hasta el primer health check.
+func NewRouter[T any](primary Node[T], replicas []Node[T], cfg Config) *Router[T] {
+	r := &Router[T]{
+		primary: &node[T]{Node: primary, role: RolePrimary, healthy: true},
+		cfg:     cfg,
+		stop:    make(chan struct{}),
+	}
+	for _, replica := range replicas {
+		r.replicas = append(r.replicas, &node[T]{Node: replica, role: RoleReplica, healthy: true})
+	}
+	return r
+}
+
+// Primary devuelve la conexión primaria.
+func (r *Router[T]) Primary() T {
+	return r.primary.Conn
+}
+
+// HasReplicas indica si hay réplicas configuradas (saludables o no).
+func (r *Router[T]) HasReplicas() bool {
+	return len(r.replicas) > 0
+}
+
+// Reader devuelve la conexión para una lectura hecha con ctx.
+func (r *Router[T]) Reader(ctx context.Context) T {
+	if !PrimaryRequired(ctx) && len(r.replicas) > 0 {
+		start := r.next.Add(1)
+		for i := range r.replicas {
+			replica := r.replicas[(start+uint64(i))%uint64(len(r.replicas))]
+			if replica.isHealthy() {
+				replica.reads.Add(1)
+				return replica.Conn
+			}
+		}
+	}
+	r.primary.reads.Add(1)
+	return r.primary.Conn
+}
+
+// Check ejecuta un health check sobre cada réplica y actualiza la rotación.
+func (r *Router[T]) Check(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, replica := range r.replicas {
+		wg.Add(1)
+		go func(n *node[T]) {
+			defer wg.Done()
+			r.check(ctx, n)
+		}(replica)
+	}
+	wg.Wait()
+}
+
+func (r *Router[T]) check(ctx context.Context, n *node[T]) {
+	var lag time.Duration
+	var err error
+	if n.Lag != nil {
+		checkCtx, cancel := context.WithTimeout(ctx, r.cfg.CheckTimeout)
+		lag, err = n.Lag(checkCtx)
+		cancel()
+	}
+	if err == nil && r.cfg.MaxLag > 0 && lag > r.cfg.MaxLag {
+		err = fmt.Errorf("replication lag %s exceeds %s", lag, r.cfg.MaxLag)
+	}
+
+	n.mu.Lock()
+	wasHealthy := n.healthy
+	n.healthy = err == nil
+	n.lag = lag
+	n.lastErr = err
+	n.lastCheck = time.Now()
+	n.mu.Unlock()
+
+	switch {
+	case wasHealthy && err != nil:
+		log.Printf("Replica %s evicted from read rotation: %v", n.Name, err)
+	case !wasHealthy && err == nil:
+		log.Printf("Replica %s back in read rotation (lag %s)", n.Name, lag)
+	}
+}
+
+// Start corre un health check inmediato y luego cada CheckInterval, hasta que ctx termine o se llame a Close.
+func (r *Router[T]) Start(ctx context.Context) {
+	if len(r.replicas) == 0 {
+		return
+	}
+	r.Check(ctx)
+
+	go func() {
+		ticker := time.NewTicker(r.cfg.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-r.stop:
+				return
+			case <-ticker.C:
+				r.Check(ctx)
+			}
+		}
+	}()
+}
+
+// Close detiene los health checks. No cierra las conexiones.
+func (r *Router[T]) Close() {
+	r.stopOnce.Do(func() { close(r.stop) })
+}
+
+// Replicas devuelve las conexiones de todas las réplicas, estén o no en la rotación.
+func (r *Router[T]) Replicas() []T {
+	conns := make([]T, 0, len(r.replicas))
+	for _, replica := range r.replicas {
+		conns = append(conns, replica.Conn)
+	}
+	return conns
+}
+
+// Stats devuelve el estado de la primaria y de cada réplica.
+func (r *Router[T]) Stats() []NodeStats {
+	stats := make([]NodeStats, 0, len(r.replicas)+1)
+	stats = append(stats, r.primary.stats())
+	for _, replica := range r.replicas {
+		stats = append(stats, replica.stats())
+	}
+	return stats
+}
+
+func (n *node[T]) isHealthy() bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.healthy
+}
+
+func (n *node[T]) stats() NodeStats {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	s := NodeStats{
+		Name:      n.Name,
+		Role:      n.role,
+		Healthy:   n.healthy,
+		Lag:       n.lag,
+		LastCheck: n.lastCheck,
+		Reads:     n.reads.Load(),
+	}
+	if n.lastErr != nil {
+		s.LastError = n.lastErr.Error()
+	}
+	if n.Pool != nil {
+		s.Pool = n.Pool()
+	}
+	return s
+}
@@ -0,0 +1,178 @@
+package pkgreplicas
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// lagState es el resultado que devuelve el health check de una réplica de prueba.
+type lagState struct {
+	lag time.Duration
+	err error
+}
+
+func (s *lagState) measure(context.Context) (time.Duration, error) { return s.lag, s.err }
+
+func newTestRouter(states ...*lagState) *Router[string] {
+	replicas := make([]Node[string], 0, len(states))
+	for i, state := range states {
+		name := string(rune('a' + i))
+		replicas = append(replicas, Node[string]{Name: name, Conn: name, Lag: state.measure})
+	}
+	return NewRouter(Node[string]{Name: "primary", Conn: "primary"}, replicas, Config{
+		MaxLag:       time.Second,
+		CheckTimeout: time.Second,
+	})
+}
+
+func reads(r *Router[string], ctx context.Context, n int) []string {
+	got := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		got = append(got, r.Reader(ctx))
+	}
+	return got
+}
+
+func TestRouterReader(t *testing.T) {
+	errDown := errors.New("connection refused")
+
+	tests := []struct {
+		name   string
+		states []*lagState
+		ctx    context.Context
+		want   []string
+	}{
+		{
+			name:   "Success: reads rotate between healthy replicas",
+			states: []*lagState{{}, {}},
+			ctx:    context.Background(),
+			want:   []string{"b", "a", "b", "a"},
+		},
+		{
+			name:   "Success: WithPrimary forces the primary",
+			states: []*lagState{{}, {}},
+			ctx:    WithPrimary(context.Background()),
+			want:   []string{"primary", "primary"},
+		},
+		{
+			name:   "Success: replica with a failing check is evicted",
+			states: []*lagState{{err: errDown}, {}},
+			ctx:    context.Background(),
+			want:   []string{"b", "b", "b"},
+		},
+		{
+			name:   "Success: replica lagging past MaxLag is evicted",
+			states: []*lagState{{}, {lag: 2 * time.Second}},
+			ctx:    context.Background(),
+			want:   []string{"a", "a", "a"},
+		},
+		{
+			name:   "Success: without healthy replicas reads fall back to the primary",
+			states: []*lagState{{err: errDown}, {lag: time.Minute}},
+			ctx:    context.Background(),
+			want:   []string{"primary", "primary"},
+		},
+		{
+			name: "Success: without replicas reads go to the primary",
+			ctx:  context.Background(),
+			want: []string{"primary"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestRouter(tc.states...)
+			r.Check(context.Background())
+
+			assert.Equal(t, tc.want, reads(r, tc.ctx, len(tc.want)))
+		})
+	}
+}
+
+func TestRouterCheckRecovery(t *testing.T) {
+	state := &lagState{err: errors.New("connection refused")}
+	r := newTestRouter(state)
+
+	assert.Equal(t, "a", r.Reader(context.Background()), "replicas start in the rotation")
+
+	r.Check(context.Background())
+	assert.Equal(t, "primary", r.Reader(context.Background()), "failing replica must be evicted")
+
+	// La réplica vuelve a la rotación cuando el health check se recupera
+	state.err, state.lag = nil, 100*time.Millisecond
+	r.Check(context.Background())
+	assert.Equal(t, "a", r.Reader(context.Background()), "recovered replica must be back in the rotation")
+
+	stats := r.Stats()
+	assert.Len(t, stats, 2)
+	assert.Equal(t, NodeStats{Name: "primary", Role: RolePrimary, Healthy: true, Reads: 1}, stats[0])
+	assert.Equal(t, RoleReplica, stats[1].Role)
+	assert.True(t, stats[1].Healthy)
+	assert.Equal(t, 100*time.Millisecond, stats[1].Lag)
+	assert.Empty(t, stats[1].LastError)
+	assert.Equal(t, uint64(2), stats[1].Reads)
+	assert.False(t, stats[1].LastCheck.IsZero())
+}
+
+func TestRouterStatsReportsLastError(t *testing.T) {
+	r := newTestRouter(&lagState{lag: 5 * time.Second})
+	r.Check(context.Background())
+
+	stats := r.Stats()
+	assert.False(t, stats[1].Healthy)
+	assert.Equal(t, "replication lag 5s exceeds 1s", stats[1].LastError)
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    Config
+		wantErr bool
+	}{
+		{
+			name: "Success: defaults without replicas",
+			want: Config{MaxLag: defaultMaxLag, CheckInterval: defaultCheckInterval, CheckTimeout: defaultCheckTimeout},
+		},
+		{
+			name: "Success: hosts and durations",
+			env: map[string]string{
+				"DB_REPLICA_HOSTS":          " r1:5433, ,r2 ",
+				"DB_REPLICA_MAX_LAG":        "3s",
+				"DB_REPLICA_CHECK_INTERVAL": "1m",
+			},
+			want: Config{Hosts: []string{"r1:5433", "r2"}, MaxLag: 3 * time.Second, CheckInterval: time.Minute, CheckTimeout: defaultCheckTimeout},
+		},
+		{
+			name:    "Error: invalid duration",
+			env:     map[string]string{"DB_REPLICA_MAX_LAG": "soon"},
+			wantErr: true,
+		},
+		{
+			name:    "Error: non positive check interval with replicas",
+			env:     map[string]string{"DB_REPLICA_HOSTS": "r1", "DB_REPLICA_CHECK_INTERVAL": "0s"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, name := range []string{"DB_REPLICA_HOSTS", "DB_REPLICA_MAX_LAG", "DB_REPLICA_CHECK_INTERVAL", "DB_REPLICA_CHECK_TIMEOUT"} {
+				t.Setenv(name, tc.env[name])
+			}
+
+			got, err := ConfigFromEnv("DB")
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
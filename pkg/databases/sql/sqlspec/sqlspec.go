@@ -0,0 +1,135 @@
+// Package pkgsqlspec traduce un pkgtypes.QuerySpec a fragmentos SQL (WHERE y ORDER BY).
+// Lo usan los helpers de gorm y pgxpool; cada uno define su estilo de placeholder.
+package pkgsqlspec
+
+import (
+	"fmt"
+	"strings"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// Placeholder devuelve el placeholder del argumento n (1-based): "?" o "$n".
+type Placeholder func(n int) string
+
+// QuestionMark es el placeholder de GORM, MySQL y SQLite.
+func QuestionMark(int) string { return "?" }
+
+// Dollar es el placeholder de PostgreSQL (pgx, lib/pq).
+func Dollar(n int) string { return fmt.Sprintf("$%d", n) }
+
+// Where devuelve las condiciones de filtros y cursor del spec unidas con AND (sin la palabra WHERE),
+// o "" si no hay condiciones.
+func Where(spec *types.QuerySpec, placeholder Placeholder, argOffset int) (string, []any, error) {
+	w := &whereBuilder{placeholder: placeholder, offset: argOffset}
+
+	for _, f := range spec.Filters {
+		condition, err := w.filter(f)
+		if err != nil {
+			return "", nil, err
+		}
+		w.conditions = append(w.conditions, condition)
+	}
+
+	after, err := types.DecodeKeysetCursor(spec)
+	if err != nil {
+		return "", nil, err
+	}
+	if after != nil {
+		w.conditions = append(w.conditions, w.keyset(spec.Sort, after))
+	}
+
+	return strings.Join(w.conditions, " AND "), w.args, nil
+}
+
+// Deleted devuelve la condición sobre la columna de soft delete según el modo del spec:
+// excluye los borrados por defecto, no filtra con DeletedInclude y deja solo los borrados con DeletedOnly.
+func Deleted(mode types.DeletedMode, column string) (string, error) {
+	switch mode {
+	case types.DeletedExclude:
+		return column + " IS NULL", nil
+	case types.DeletedInclude:
+		return "", nil
+	case types.DeletedOnly:
+		return column + " IS NOT NULL", nil
+	default:
+		return "", types.NewError(types.ErrValidation, fmt.Sprintf("unknown deleted mode %q", mode), nil)
+	}
+}
+
+// OrderBy devuelve la lista de ORDER BY del spec (sin las palabras ORDER BY).
+func OrderBy(spec *types.QuerySpec) string {
+	parts := make([]string, 0, len(spec.Sort))
+	for _, s := range spec.Sort {
+		if s.Desc {
+			parts = append(parts, s.Column+" DESC")
+			continue
+		}
+		parts = append(parts, s.Column+" ASC")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// EscapeLike escapa los comodines de value para usarlo dentro de un LIKE con ESCAPE '!'.
+func EscapeLike(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
+}
+
+type whereBuilder struct {
+	placeholder Placeholder
+	offset      int
+	conditions  []string
+	args        []any
+}
+
+func (w *whereBuilder) arg(value any) string {
+	w.args = append(w.args, value)
+	return w.placeholder(w.offset + len(w.args))
+}
+
+func (w *whereBuilder) filter(f types.Filter) (string, error) {
+	switch f.Op {
+	case types.OpEq:
+		return fmt.Sprintf("%s = %s", f.Column, w.arg(f.Value())), nil
+	case types.OpNe:
+		return fmt.Sprintf("%s <> %s", f.Column, w.arg(f.Value())), nil
+	case types.OpGt:
+		return fmt.Sprintf("%s > %s", f.Column, w.arg(f.Value())), nil
+	case types.OpGte:
+		return fmt.Sprintf("%s >= %s", f.Column, w.arg(f.Value())), nil
+	case types.OpLt:
+		return fmt.Sprintf("%s < %s", f.Column, w.arg(f.Value())), nil
+	case types.OpLte:
+		return fmt.Sprintf("%s <= %s", f.Column, w.arg(f.Value())), nil
+	case types.OpIn:
+		placeholders := make([]string, 0, len(f.Values))
+		for _, value := range f.Values {
+			placeholders = append(placeholders, w.arg(value))
+		}
+		return fmt.Sprintf("%s IN (%s)", f.Column, strings.Join(placeholders, ", ")), nil
+	case types.OpLike:
+		pattern := "%" + EscapeLike(fmt.Sprint(f.Value())) + "%"
+		return fmt.Sprintf("LOWER(%s) LIKE LOWER(%s) ESCAPE '!'", f.Column, w.arg(pattern)), nil
+	default:
+		return "", types.NewError(types.ErrValidation, fmt.Sprintf("unsupported filter operator %q", f.Op), nil)
+	}
+}
+
+// keyset arma la condición "fila posterior al cursor" para un orden de varias columnas:
+// (c1 > v1) OR (c1 = v1 AND c2 > v2) OR ..., usando < en las columnas descendentes.
+func (w *whereBuilder) keyset(sort []types.SortField, after []any) string {
+	branches := make([]string, 0, len(sort))
+	for i := range sort {
+		parts := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			parts = append(parts, fmt.Sprintf("%s = %s", sort[j].Column, w.arg(after[j])))
+		}
+		op := ">"
+		if sort[i].Desc {
+			op = "<"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s %s", sort[i].Column, op, w.arg(after[i])))
+		branches = append(branches, "("+strings.Join(parts, " AND ")+")")
+	}
+	return "(" + strings.Join(branches, " OR ") + ")"
+}
@@ -0,0 +1,135 @@
+package pkgsqlspec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+func TestWhere(t *testing.T) {
+	sort := []types.SortField{
+		{Field: "likes", Column: "like_count", Type: types.FieldInt, Desc: true},
+		{Field: "id", Column: "id", Type: types.FieldString},
+	}
+	cursor, err := types.EncodeKeysetCursor(&types.QuerySpec{Sort: sort}, []any{7, "t1"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		spec        *types.QuerySpec
+		placeholder Placeholder
+		offset      int
+		wantWhere   string
+		wantArgs    []any
+		wantErr     bool
+	}{
+		{
+			name:        "Success: no conditions",
+			spec:        &types.QuerySpec{Sort: sort},
+			placeholder: QuestionMark,
+		},
+		{
+			name: "Success: every operator with question marks",
+			spec: &types.QuerySpec{Filters: []types.Filter{
+				{Column: "a", Op: types.OpEq, Values: []any{1}},
+				{Column: "b", Op: types.OpNe, Values: []any{2}},
+				{Column: "c", Op: types.OpGt, Values: []any{3}},
+				{Column: "d", Op: types.OpGte, Values: []any{4}},
+				{Column: "e", Op: types.OpLt, Values: []any{5}},
+				{Column: "f", Op: types.OpLte, Values: []any{6}},
+				{Column: "g", Op: types.OpIn, Values: []any{"x", "y"}},
+				{Column: "h", Op: types.OpLike, Values: []any{"50%_off!"}},
+			}},
+			placeholder: QuestionMark,
+			wantWhere:   "a = ? AND b <> ? AND c > ? AND d >= ? AND e < ? AND f <= ? AND g IN (?, ?) AND LOWER(h) LIKE LOWER(?) ESCAPE '!'",
+			wantArgs:    []any{1, 2, 3, 4, 5, 6, "x", "y", "%50!%!_off!!%"},
+		},
+		{
+			name: "Success: dollar placeholders continue after the offset",
+			spec: &types.QuerySpec{Filters: []types.Filter{
+				{Column: "user_id", Op: types.OpEq, Values: []any{"u1"}},
+				{Column: "status", Op: types.OpIn, Values: []any{"a", "b"}},
+			}},
+			placeholder: Dollar,
+			offset:      2,
+			wantWhere:   "user_id = $3 AND status IN ($4, $5)",
+			wantArgs:    []any{"u1", "a", "b"},
+		},
+		{
+			name: "Success: keyset cursor uses < for descending columns",
+			spec: &types.QuerySpec{
+				Sort:    sort,
+				Cursor:  cursor,
+				Filters: []types.Filter{{Column: "user_id", Op: types.OpEq, Values: []any{"u1"}}},
+			},
+			placeholder: Dollar,
+			wantWhere:   "user_id = $1 AND ((like_count < $2) OR (like_count = $3 AND id > $4))",
+			wantArgs:    []any{"u1", int64(7), int64(7), "t1"},
+		},
+		{
+			name:        "Error: cursor generated for another sort",
+			spec:        &types.QuerySpec{Sort: sort[1:], Cursor: cursor},
+			placeholder: QuestionMark,
+			wantErr:     true,
+		},
+		{
+			name:        "Error: unsupported operator",
+			spec:        &types.QuerySpec{Filters: []types.Filter{{Column: "a", Op: "regex", Values: []any{"x"}}}},
+			placeholder: QuestionMark,
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			where, args, err := Where(tc.spec, tc.placeholder, tc.offset)
+
+			if tc.wantErr {
+				assert.True(t, types.IsValidationError(err), "expected a validation error, got %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.wantWhere, where, "where mismatch")
+			assert.Equal(t, tc.wantArgs, args, "args mismatch")
+		})
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	spec := &types.QuerySpec{Sort: []types.SortField{
+		{Column: "created_at", Desc: true},
+		{Column: "id"},
+	}}
+
+	assert.Equal(t, "created_at DESC, id ASC", OrderBy(spec))
+	assert.Empty(t, OrderBy(&types.QuerySpec{}))
+}
+
+func TestDeleted(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    types.DeletedMode
+		want    string
+		wantErr bool
+	}{
+		{name: "exclude", mode: types.DeletedExclude, want: "deleted_at IS NULL"},
+		{name: "include", mode: types.DeletedInclude, want: ""},
+		{name: "only", mode: types.DeletedOnly, want: "deleted_at IS NOT NULL"},
+		{name: "unknown", mode: "all", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Deleted(tc.mode, "deleted_at")
+
+			if tc.wantErr {
+				assert.True(t, types.IsValidationError(err), "expected a validation error, got %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package pkgtx
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Manager ejecuta una unidad de trabajo dentro de una transacción que viaja en el contexto.
+//
+// Los repositorios que toman su conexión del contexto (gorm: Repository.DB, pgx: Repository.Querier,
+// database/sql: Executor) participan automáticamente de la transacción en curso.
+// Una llamada anidada a WithinTx crea un savepoint: si fn falla se revierte solo el savepoint.
+// Ante fallas de serialización o deadlocks la transacción externa se reintenta completa,
+// por lo que fn debe poder ejecutarse más de una vez.
+type Manager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Executor es el subconjunto de *sql.DB y *sql.Tx que usan los repositorios sobre database/sql.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
@@ -0,0 +1,132 @@
+package pkgtx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const (
+	defaultMaxRetries  = 3
+	defaultBaseBackoff = 20 * time.Millisecond
+	defaultMaxBackoff  = 500 * time.Millisecond
+)
+
+// Options configura las transacciones que abre un Manager.
+type Options struct {
+	// Isolation y ReadOnly se aplican a la transacción externa; las anidadas heredan su configuración.
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+	// MaxRetries es la cantidad de reintentos ante errores reintentables (0 desactiva los reintentos).
+	MaxRetries  int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Option modifica las Options por defecto.
+type Option func(*Options)
+
+// WithIsolation define el nivel de aislamiento de la transacción externa.
+func WithIsolation(level sql.IsolationLevel) Option {
+	return func(o *Options) { o.Isolation = level }
+}
+
+// WithReadOnly abre la transacción externa en modo solo lectura.
+func WithReadOnly() Option {
+	return func(o *Options) { o.ReadOnly = true }
+}
+
+// WithMaxRetries define cuántas veces se reintenta una transacción ante fallas de serialización.
+func WithMaxRetries(n int) Option {
+	return func(o *Options) {
+		if n >= 0 {
+			o.MaxRetries = n
+		}
+	}
+}
+
+// WithBackoff define la espera inicial y máxima entre reintentos (backoff exponencial con jitter).
+func WithBackoff(base, max time.Duration) Option {
+	return func(o *Options) {
+		if base > 0 {
+			o.BaseBackoff = base
+		}
+		if max >= base {
+			o.MaxBackoff = max
+		}
+	}
+}
+
+// NewOptions aplica las opciones sobre los valores por defecto.
+func NewOptions(opts ...Option) Options {
+	o := Options{
+		Isolation:   sql.LevelDefault,
+		MaxRetries:  defaultMaxRetries,
+		BaseBackoff: defaultBaseBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// TxOptions convierte las opciones al formato de database/sql.
+func (o Options) TxOptions() *sql.TxOptions {
+	return &sql.TxOptions{Isolation: o.Isolation, ReadOnly: o.ReadOnly}
+}
+
+// IsRetryable indica si err es una falla de serialización o un deadlock,
+// casos en los que repetir la transacción completa puede tener éxito.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// PostgreSQL (pgconn v4/v5 y lib/pq exponen SQLState)
+	var pgErr interface{ SQLState() string }
+	if errors.As(err, &pgErr) {
+		switch pgErr.SQLState() {
+		case "40001", "40P01": // serialization_failure, deadlock_detected
+			return true
+		}
+		return false
+	}
+
+	var myErr *mysql.MySQLError
+	if errors.As(err, &myErr) {
+		return myErr.Number == 1213 || myErr.Number == 1205 // deadlock, lock wait timeout
+	}
+
+	// SQLite no tiene un tipo de error común entre drivers
+	msg := err.Error()
+	return strings.Contains(msg, "database is locked") || strings.Contains(msg, "SQLITE_BUSY")
+}
+
+// Retry ejecuta fn y la repite mientras devuelva un error reintentable, hasta MaxRetries veces.
+func Retry(ctx context.Context, o Options, fn func() error) error {
+	backoff := o.BaseBackoff
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= o.MaxRetries || !IsRetryable(err) {
+			return err
+		}
+
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(wait):
+		}
+
+		backoff *= 2
+		if backoff > o.MaxBackoff {
+			backoff = o.MaxBackoff
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package pkgtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+)
+
+// sqlStateError imita los errores de pgconn y lib/pq, que exponen el código SQLSTATE.
+type sqlStateError string
+
+func (e sqlStateError) Error() string    { return "sqlstate " + string(e) }
+func (e sqlStateError) SQLState() string { return string(e) }
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil error", err: nil, want: false},
+		{name: "postgres serialization failure", err: sqlStateError("40001"), want: true},
+		{name: "postgres deadlock", err: sqlStateError("40P01"), want: true},
+		{name: "wrapped postgres serialization failure", err: fmt.Errorf("update: %w", sqlStateError("40001")), want: true},
+		{name: "postgres unique violation", err: sqlStateError("23505"), want: false},
+		{name: "mysql deadlock", err: &mysql.MySQLError{Number: 1213}, want: true},
+		{name: "mysql lock wait timeout", err: &mysql.MySQLError{Number: 1205}, want: true},
+		{name: "mysql duplicate entry", err: &mysql.MySQLError{Number: 1062}, want: false},
+		{name: "sqlite busy", err: errors.New("database is locked (5) (SQLITE_BUSY)"), want: true},
+		{name: "unrelated error", err: errors.New("connection refused"), want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsRetryable(tc.err))
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	errSerialization := sqlStateError("40001")
+	errPermanent := errors.New("permanent")
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		failures  []error // errores de los primeros intentos, en orden
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "Success: first attempt",
+			wantCalls: 1,
+		},
+		{
+			name:      "Success: retryable errors are retried",
+			failures:  []error{errSerialization, errSerialization},
+			wantCalls: 3,
+		},
+		{
+			name:      "Error: retries are exhausted",
+			opts:      []Option{WithMaxRetries(2)},
+			failures:  []error{errSerialization, errSerialization, errSerialization, errSerialization},
+			wantCalls: 3,
+			wantErr:   errSerialization,
+		},
+		{
+			name:      "Error: non retryable errors are returned at once",
+			failures:  []error{errPermanent},
+			wantCalls: 1,
+			wantErr:   errPermanent,
+		},
+		{
+			name:      "Error: zero retries disables retrying",
+			opts:      []Option{WithMaxRetries(0)},
+			failures:  []error{errSerialization},
+			wantCalls: 1,
+			wantErr:   errSerialization,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := NewOptions(append([]Option{WithBackoff(time.Millisecond, time.Millisecond)}, tc.opts...)...)
+			calls := 0
+			err := Retry(context.Background(), opts, func() error {
+				calls++
+				if calls <= len(tc.failures) {
+					return tc.failures[calls-1]
+				}
+				return nil
+			})
+
+			assert.Equal(t, tc.wantCalls, calls, "calls mismatch")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+			}
+		})
+	}
+}
+
+func TestRetryStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := Retry(ctx, NewOptions(WithBackoff(time.Second, time.Second)), func() error {
+		calls++
+		return sqlStateError("40001")
+	})
+
+	assert.Equal(t, 1, calls, "no retry after the context is done")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, err, sqlStateError("40001"))
+}
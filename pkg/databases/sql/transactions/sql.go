@@ -0,0 +1,89 @@
+package pkgtx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// sqlTxKey identifica la transacción database/sql en curso dentro del contexto.
+type sqlTxKey struct{ db *sql.DB }
+
+// sqlTx es la transacción database/sql activa junto con la profundidad de anidamiento.
+type sqlTx struct {
+	tx    *sql.Tx
+	depth int
+}
+
+type sqlManager struct {
+	db   *sql.DB
+	opts Options
+}
+
+// NewSQLManager crea un Manager sobre database/sql (lib/pq, go-sql-driver/mysql, sqlite).
+func NewSQLManager(db *sql.DB, opts ...Option) Manager {
+	return &sqlManager{
+		db:   db,
+		opts: NewOptions(opts...),
+	}
+}
+
+// SQLExecutor devuelve la transacción de db que viaja en ctx o, si no hay ninguna, db.
+func SQLExecutor(ctx context.Context, db *sql.DB) Executor {
+	if current, ok := ctx.Value(sqlTxKey{db}).(*sqlTx); ok {
+		return current.tx
+	}
+	return db
+}
+
+func (m *sqlManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if current, ok := ctx.Value(sqlTxKey{m.db}).(*sqlTx); ok {
+		return m.withinSavepoint(ctx, current, fn)
+	}
+
+	return Retry(ctx, m.opts, func() error {
+		tx, err := m.db.BeginTx(ctx, m.opts.TxOptions())
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+
+		defer func() {
+			if p := recover(); p != nil {
+				_ = tx.Rollback()
+				panic(p)
+			}
+		}()
+
+		if err := fn(context.WithValue(ctx, sqlTxKey{m.db}, &sqlTx{tx: tx})); err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+				return errors.Join(err, fmt.Errorf("failed to rollback transaction: %w", rbErr))
+			}
+			return err
+		}
+		return tx.Commit()
+	})
+}
+
+// withinSavepoint ejecuta fn dentro de un savepoint de la transacción en curso.
+// Los reintentos quedan a cargo de la transacción externa.
+func (m *sqlManager) withinSavepoint(ctx context.Context, current *sqlTx, fn func(ctx context.Context) error) error {
+	nested := &sqlTx{tx: current.tx, depth: current.depth + 1}
+	name := fmt.Sprintf("sp_%d", nested.depth)
+
+	if _, err := current.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to create savepoint %s: %w", name, err)
+	}
+
+	if err := fn(context.WithValue(ctx, sqlTxKey{m.db}, nested)); err != nil {
+		if _, rbErr := current.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to rollback to savepoint %s: %w", name, rbErr))
+		}
+		return err
+	}
+
+	if _, err := current.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to release savepoint %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package pkgtx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestDB abre una base SQLite en memoria con una tabla items. Una sola conexión, porque
+// cada conexión a :memory: es una base distinta.
+func newTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec("CREATE TABLE items (name TEXT PRIMARY KEY)")
+	assert.NoError(t, err)
+	return db
+}
+
+func insertItem(ctx context.Context, db *sql.DB, name string) error {
+	_, err := SQLExecutor(ctx, db).ExecContext(ctx, "INSERT INTO items (name) VALUES (?)", name)
+	return err
+}
+
+func itemNames(t *testing.T, db *sql.DB) []string {
+	rows, err := db.Query("SELECT name FROM items ORDER BY name")
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		assert.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestSQLManagerWithinTx(t *testing.T) {
+	errFail := errors.New("fail")
+
+	tests := []struct {
+		name      string
+		fn        func(ctx context.Context, m Manager, db *sql.DB) error
+		wantErr   error
+		wantItems []string
+	}{
+		{
+			name: "Success: writes are committed",
+			fn: func(ctx context.Context, m Manager, db *sql.DB) error {
+				if err := insertItem(ctx, db, "a"); err != nil {
+					return err
+				}
+				return insertItem(ctx, db, "b")
+			},
+			wantItems: []string{"a", "b"},
+		},
+		{
+			name: "Error: writes are rolled back",
+			fn: func(ctx context.Context, m Manager, db *sql.DB) error {
+				if err := insertItem(ctx, db, "a"); err != nil {
+					return err
+				}
+				return errFail
+			},
+			wantErr: errFail,
+		},
+		{
+			name: "Success: failed nested call only rolls back its savepoint",
+			fn: func(ctx context.Context, m Manager, db *sql.DB) error {
+				if err := insertItem(ctx, db, "a"); err != nil {
+					return err
+				}
+				nestedErr := m.WithinTx(ctx, func(ctx context.Context) error {
+					if err := insertItem(ctx, db, "b"); err != nil {
+						return err
+					}
+					return errFail
+				})
+				if !errors.Is(nestedErr, errFail) {
+					return errors.New("nested call must return its error")
+				}
+				return insertItem(ctx, db, "c")
+			},
+			wantItems: []string{"a", "c"},
+		},
+		{
+			name: "Success: savepoints nest with their own names",
+			fn: func(ctx context.Context, m Manager, db *sql.DB) error {
+				return m.WithinTx(ctx, func(ctx context.Context) error {
+					if err := insertItem(ctx, db, "a"); err != nil {
+						return err
+					}
+					_ = m.WithinTx(ctx, func(ctx context.Context) error {
+						if err := insertItem(ctx, db, "b"); err != nil {
+							return err
+						}
+						return errFail
+					})
+					return m.WithinTx(ctx, func(ctx context.Context) error {
+						return insertItem(ctx, db, "c")
+					})
+				})
+			},
+			wantItems: []string{"a", "c"},
+		},
+		{
+			name: "Error: failing outer transaction discards released savepoints",
+			fn: func(ctx context.Context, m Manager, db *sql.DB) error {
+				if err := m.WithinTx(ctx, func(ctx context.Context) error {
+					return insertItem(ctx, db, "a")
+				}); err != nil {
+					return err
+				}
+				return errFail
+			},
+			wantErr: errFail,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			db := newTestDB(t)
+			m := NewSQLManager(db)
+
+			err := m.WithinTx(context.Background(), func(ctx context.Context) error {
+				return tc.fn(ctx, m, db)
+			})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+			}
+			assert.Equal(t, tc.wantItems, itemNames(t, db), "items mismatch")
+		})
+	}
+}
+
+func TestSQLManagerRetriesTheWholeTransaction(t *testing.T) {
+	db := newTestDB(t)
+	m := NewSQLManager(db, WithBackoff(time.Millisecond, time.Millisecond))
+
+	attempts := 0
+	err := m.WithinTx(context.Background(), func(ctx context.Context) error {
+		attempts++
+		if err := insertItem(ctx, db, "a"); err != nil {
+			return err
+		}
+		// Una falla de serialización en un savepoint revierte y repite la transacción externa
+		return m.WithinTx(ctx, func(ctx context.Context) error {
+			if attempts == 1 {
+				return sqlStateError("40001")
+			}
+			return insertItem(ctx, db, "b")
+		})
+	})
+
+	assert.NoError(t, err, "expected no error but got one")
+	assert.Equal(t, 2, attempts, "attempts mismatch")
+	assert.Equal(t, []string{"a", "b"}, itemNames(t, db), "items mismatch")
+}
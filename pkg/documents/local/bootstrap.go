@@ -0,0 +1,35 @@
+package pkgdocuments
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Bootstrap crea el generador de documentos a partir de las variables de entorno DOCUMENTS_*.
+func Bootstrap() (Service, error) {
+	config := newConfig(
+		envString("DOCUMENTS_PDF_PAGE_SIZE", string(PageA4)),
+		envInt("DOCUMENTS_PDF_FONT_SIZE", 10),
+		envInt("DOCUMENTS_PDF_MARGIN", 48),
+	)
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("documents config error: %w", err)
+	}
+
+	return newService(config), nil
+}
+
+func envString(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func envInt(key string, def int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,39 @@
+package pkgdocuments
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type config struct {
+	pageSize PageSize
+	fontSize int
+	margin   int
+}
+
+func newConfig(pageSize string, fontSize, margin int) Config {
+	return &config{
+		pageSize: PageSize(strings.ToUpper(pageSize)),
+		fontSize: fontSize,
+		margin:   margin,
+	}
+}
+
+func (c *config) GetPageSize() PageSize { return c.pageSize }
+func (c *config) GetFontSize() int      { return c.fontSize }
+func (c *config) GetMargin() int        { return c.margin }
+
+func (c *config) Validate() error {
+	width, height, ok := c.pageSize.dimensions()
+	if !ok {
+		return fmt.Errorf("unsupported page size %q (use A4 or LETTER)", c.pageSize)
+	}
+	if c.fontSize < 6 || c.fontSize > 24 {
+		return errors.New("font size must be between 6 and 24")
+	}
+	if c.margin < 0 || 4*float64(c.margin) >= min(width, height) {
+		return errors.New("margin must be positive and leave at least half of the page for the content")
+	}
+	return nil
+}
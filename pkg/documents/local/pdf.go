@@ -0,0 +1,306 @@
+package pkgdocuments
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Fuentes estándar del PDF: no hace falta embeberlas, todos los lectores las traen.
+const (
+	fontRegular = "F1" // Helvetica
+	fontBold    = "F2" // Helvetica-Bold
+	fontMono    = "F3" // Courier
+)
+
+// Proporciones respecto del tamaño de texto configurado.
+const (
+	titleScale   = 1.8
+	headingScale = 1.3
+	monoScale    = 0.9
+	leading      = 1.4 // Alto de línea
+	boldFactor   = 1.08
+	monoWidth    = 600 // Ancho de todos los glifos de Courier, en milésimas del tamaño
+	defaultWidth = 556 // Ancho de los caracteres que no están en helveticaWidths
+	tabWidth     = 4
+)
+
+// helveticaWidths son los anchos de Helvetica para los caracteres 32 a 126, en milésimas del
+// tamaño de la fuente (métricas AFM de Adobe). Helvetica-Bold se aproxima con boldFactor: solo se
+// usa para cortar líneas, así que alcanza con no quedarse corto.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // espacio a /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 a ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ a O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P a _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` a o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p a ~
+}
+
+// winAnsi son los caracteres fuera de Latin-1 que tienen lugar en WinAnsiEncoding.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+}
+
+// pdfWriter acumula el contenido de cada página mientras recorre el documento.
+type pdfWriter struct {
+	width, height, margin, size float64
+	pages                       []*bytes.Buffer
+	y                           float64 // Línea base de la próxima línea
+}
+
+func newPDFWriter(width, height, margin, size float64) *pdfWriter {
+	w := &pdfWriter{width: width, height: height, margin: margin, size: size}
+	w.newPage()
+	return w
+}
+
+func (w *pdfWriter) newPage() {
+	w.pages = append(w.pages, &bytes.Buffer{})
+	w.y = w.height - w.margin
+}
+
+// reserve pasa a una página nueva si no entra una línea de alto h.
+func (w *pdfWriter) reserve(h float64) {
+	if w.y-h < w.margin+w.size*leading { // Se deja lugar para el pie de página
+		w.newPage()
+	}
+}
+
+func (w *pdfWriter) space(h float64) {
+	w.y -= h
+}
+
+func (w *pdfWriter) text(font string, size, x float64, s string) {
+	fmt.Fprintf(w.pages[len(w.pages)-1], "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, w.y, escape(s))
+}
+
+// line escribe una línea y baja el cursor. Las líneas ya tienen que entrar en el ancho.
+func (w *pdfWriter) line(font string, size, x float64, s string) {
+	h := size * leading
+	w.reserve(h)
+	w.y -= size
+	w.text(font, size, x, s)
+	w.y -= h - size
+}
+
+func (w *pdfWriter) contentWidth() float64 {
+	return w.width - 2*w.margin
+}
+
+func (w *pdfWriter) document(doc *Document) {
+	for _, l := range wrap(doc.Title, fontBold, w.size*titleScale, w.contentWidth()) {
+		w.line(fontBold, w.size*titleScale, w.margin, l)
+	}
+	for _, l := range wrap(doc.Subtitle, fontRegular, w.size, w.contentWidth()) {
+		w.line(fontRegular, w.size, w.margin, l)
+	}
+
+	for _, s := range doc.Sections {
+		w.space(w.size)
+		if s.Heading != "" {
+			// El encabezado no queda solo al final de una página
+			w.reserve(w.size * (headingScale + 2) * leading)
+			w.line(fontBold, w.size*headingScale, w.margin, s.Heading)
+		}
+		for _, f := range s.Fields {
+			w.field(f)
+		}
+		for _, p := range s.Paragraphs {
+			for _, l := range wrap(p, fontRegular, w.size, w.contentWidth()) {
+				w.line(fontRegular, w.size, w.margin, l)
+			}
+			w.space(w.size * (leading - 1))
+		}
+		if s.Code != "" {
+			w.code(s.Code)
+		}
+	}
+}
+
+// field escribe la etiqueta en negrita y el valor a continuación; si el valor no entra, sigue
+// en las líneas siguientes alineado con la primera.
+func (w *pdfWriter) field(f Field) {
+	label := f.Label + ": "
+	offset := textWidth(label, fontBold, w.size)
+	lines := wrap(f.Value, fontRegular, w.size, w.contentWidth()-offset)
+	if len(lines) == 0 {
+		lines = []string{""}
+	}
+	for i, l := range lines {
+		h := w.size * leading
+		w.reserve(h)
+		w.y -= w.size
+		if i == 0 {
+			w.text(fontBold, w.size, w.margin, label)
+		}
+		w.text(fontRegular, w.size, w.margin+offset, l)
+		w.y -= h - w.size
+	}
+}
+
+// code escribe el texto en Courier respetando los saltos de línea y cortando las líneas largas.
+func (w *pdfWriter) code(code string) {
+	size := w.size * monoScale
+	perLine := max(1, int(w.contentWidth()/(size*monoWidth/1000)))
+	for _, raw := range strings.Split(strings.TrimRight(code, "\n"), "\n") {
+		runes := []rune(strings.ReplaceAll(raw, "\t", strings.Repeat(" ", tabWidth)))
+		for len(runes) > perLine {
+			w.line(fontMono, size, w.margin, string(runes[:perLine]))
+			runes = runes[perLine:]
+		}
+		w.line(fontMono, size, w.margin, string(runes))
+	}
+}
+
+// bytes serializa el PDF: catálogo, árbol de páginas, fuentes, metadatos y una página con su
+// contenido comprimido por cada página escrita, con la tabla xref de offsets al final.
+func (w *pdfWriter) bytes(doc *Document) ([]byte, error) {
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	const firstPage = 7 // Los objetos 1 a 6 son fijos
+	kids := make([]string, 0, len(w.pages))
+	for i := range w.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", firstPage+2*i))
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %.2f %.2f] >>",
+		strings.Join(kids, " "), len(w.pages), w.width, w.height))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title (%s) /Author (%s) /Producer (teamcandidates) >>", escape(doc.Title), escape(doc.Author)))
+
+	for i, page := range w.pages {
+		// Pie de página con la numeración, que recién se conoce al terminar
+		footer := fmt.Sprintf("%d / %d", i+1, len(w.pages))
+		fmt.Fprintf(page, "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n", fontRegular, w.size*monoScale,
+			w.width-w.margin-textWidth(footer, fontRegular, w.size*monoScale), w.margin/2, footer)
+
+		var stream bytes.Buffer
+		zw := zlib.NewWriter(&stream)
+		if _, err := zw.Write(page.Bytes()); err != nil {
+			return nil, fmt.Errorf("failed to compress page %d: %w", i+1, err)
+		}
+		if err := zw.Close(); err != nil {
+			return nil, fmt.Errorf("failed to compress page %d: %w", i+1, err)
+		}
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Resources << /Font << /%s 3 0 R /%s 4 0 R /%s 5 0 R >> >> /Contents %d 0 R >>",
+			fontRegular, fontBold, fontMono, firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", stream.Len(), stream.Bytes()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 6 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return out.Bytes(), nil
+}
+
+// wrap corta el texto en líneas que entran en width. Las palabras más largas que una línea se
+// cortan por caracteres. Los saltos de línea del texto se respetan.
+func wrap(text, font string, size, width float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		current := ""
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if current != "" {
+				candidate = current + " " + word
+			}
+			if textWidth(candidate, font, size) <= width {
+				current = candidate
+				continue
+			}
+			if current != "" {
+				lines = append(lines, current)
+			}
+			for textWidth(word, font, size) > width && utf8.RuneCountInString(word) > 1 {
+				cut := fit(word, font, size, width)
+				lines = append(lines, word[:cut])
+				word = word[cut:]
+			}
+			current = word
+		}
+		if current != "" {
+			lines = append(lines, current)
+		}
+	}
+	return lines
+}
+
+// fit devuelve cuántos bytes del principio de word entran en width (al menos un carácter).
+func fit(word, font string, size, width float64) int {
+	end := 0
+	for i, r := range word {
+		if i > 0 && textWidth(word[:i+utf8.RuneLen(r)], font, size) > width {
+			break
+		}
+		end = i + utf8.RuneLen(r)
+	}
+	return end
+}
+
+// textWidth devuelve el ancho en puntos del texto escrito con la fuente y el tamaño indicados.
+func textWidth(s, font string, size float64) float64 {
+	if font == fontMono {
+		return float64(utf8.RuneCountInString(s)) * monoWidth * size / 1000
+	}
+	total := 0
+	for _, r := range s {
+		if r >= 32 && r <= 126 {
+			total += helveticaWidths[r-32]
+		} else {
+			total += defaultWidth
+		}
+	}
+	width := float64(total) * size / 1000
+	if font == fontBold {
+		width *= boldFactor
+	}
+	return width
+}
+
+// escape convierte el texto a WinAnsiEncoding y lo escapa para un string literal del PDF. Los
+// bytes no ASCII se escriben en octal para que el archivo no dependa de la codificación.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		var c byte
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+			continue
+		case r < 32:
+			c = ' '
+		case r < 127:
+			c = byte(r)
+		case r >= 0xA0 && r <= 0xFF:
+			c = byte(r)
+		default:
+			var ok bool
+			if c, ok = winAnsi[r]; !ok {
+				c = '?'
+			}
+		}
+		if c < 127 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "\\%03o", c)
+		}
+	}
+	return b.String()
+}
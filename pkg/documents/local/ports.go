@@ -0,0 +1,19 @@
+package pkgdocuments
+
+// Config define el formato de las páginas de los PDF.
+type Config interface {
+	GetPageSize() PageSize // Tamaño de página (A4 o LETTER)
+	GetFontSize() int      // Tamaño del texto en puntos; los títulos se escalan a partir de él
+	GetMargin() int        // Margen de la página en puntos
+	Validate() error
+}
+
+// Service genera documentos PDF y planillas XLSX en memoria, sin dependencias externas ni
+// procesos auxiliares. Los PDF usan las fuentes estándar (Helvetica y Courier), así que solo
+// representan caracteres Latin-1; el resto se reemplaza por '?'.
+type Service interface {
+	// PDF arma el documento paginando y cortando las líneas largas al ancho de la página.
+	PDF(doc *Document) ([]byte, error)
+	// XLSX arma un libro con una hoja por Sheet. La fila Header se escribe en negrita.
+	XLSX(sheets ...Sheet) ([]byte, error)
+}
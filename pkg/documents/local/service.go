@@ -0,0 +1,29 @@
+package pkgdocuments
+
+import "errors"
+
+type service struct {
+	config Config
+}
+
+// newService crea el generador de documentos. No guarda estado: es seguro para uso concurrente.
+func newService(config Config) Service {
+	return &service{config: config}
+}
+
+func (s *service) PDF(doc *Document) ([]byte, error) {
+	if doc == nil {
+		return nil, errors.New("document is nil")
+	}
+	width, height, _ := s.config.GetPageSize().dimensions()
+	w := newPDFWriter(width, height, float64(s.config.GetMargin()), float64(s.config.GetFontSize()))
+	w.document(doc)
+	return w.bytes(doc)
+}
+
+func (s *service) XLSX(sheets ...Sheet) ([]byte, error) {
+	if len(sheets) == 0 {
+		return nil, errors.New("workbook needs at least one sheet")
+	}
+	return writeXLSX(sheets)
+}
@@ -0,0 +1,52 @@
+package pkgdocuments
+
+// PageSize es el tamaño de página de los PDF.
+type PageSize string
+
+const (
+	PageA4     PageSize = "A4"
+	PageLetter PageSize = "LETTER"
+)
+
+// dimensions devuelve el ancho y el alto de la página en puntos.
+func (p PageSize) dimensions() (float64, float64, bool) {
+	switch p {
+	case PageA4:
+		return 595.28, 841.89, true
+	case PageLetter:
+		return 612, 792, true
+	}
+	return 0, 0, false
+}
+
+// Document es el contenido de un PDF: un título y secciones que se escriben una debajo de otra.
+type Document struct {
+	Title    string
+	Subtitle string // Línea debajo del título (opcional)
+	Author   string // Metadato del PDF (opcional)
+	Sections []Section
+}
+
+// Section es un bloque del documento con un encabezado, pares etiqueta/valor, párrafos y un
+// bloque de texto preformateado (código), en ese orden.
+type Section struct {
+	Heading    string
+	Fields     []Field
+	Paragraphs []string
+	Code       string // Se escribe en Courier respetando los saltos de línea
+}
+
+// Field es un dato del documento que se escribe como "Label: Value".
+type Field struct {
+	Label string
+	Value string
+}
+
+// Sheet es una hoja de una planilla XLSX.
+type Sheet struct {
+	Name   string // Máximo 31 caracteres; se reemplazan los caracteres que Excel no admite
+	Header []string
+	// Rows admite string, bool, enteros y flotantes (se escriben como números); el resto se
+	// escribe con fmt.Sprint. Un valor nil deja la celda vacía.
+	Rows [][]any
+}
@@ -0,0 +1,191 @@
+package pkgdocuments
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	xmlHeader      = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+	nsMain         = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	nsRelations    = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	nsPackageRels  = "http://schemas.openxmlformats.org/package/2006/relationships"
+	maxSheetName   = 31
+	headerStyleID  = 1 // Índice en cellXfs del estilo en negrita
+	sheetNameChars = `[]:*?/\`
+)
+
+// stylesXML define dos estilos de celda: el normal y el de los encabezados, en negrita.
+const stylesXML = `<styleSheet xmlns="` + nsMain + `">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+// writeXLSX arma el paquete OOXML mínimo que abren Excel, LibreOffice y Google Sheets: los
+// textos van como inline strings, así no hace falta la tabla de strings compartidos.
+func writeXLSX(sheets []Sheet) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	add := func(name, content string) error {
+		f, err := zw.Create(name)
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", name, err)
+		}
+		_, err = f.Write([]byte(xmlHeader + content))
+		return err
+	}
+
+	var types, workbook, rels strings.Builder
+	types.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	workbook.WriteString(`<workbook xmlns="` + nsMain + `" xmlns:r="` + nsRelations + `"><sheets>`)
+	rels.WriteString(`<Relationships xmlns="` + nsPackageRels + `">`)
+
+	names := make(map[string]bool, len(sheets))
+	for i, sheet := range sheets {
+		n := i + 1
+		part := fmt.Sprintf("worksheets/sheet%d.xml", n)
+		fmt.Fprintf(&types, `<Override PartName="/xl/%s" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, part)
+		fmt.Fprintf(&workbook, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escapeXML(sheetName(sheet.Name, n, names)), n, n)
+		fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="%s/worksheet" Target="%s"/>`, n, nsRelations, part)
+
+		if err := add("xl/"+part, worksheetXML(&sheet)); err != nil {
+			return nil, err
+		}
+	}
+	types.WriteString(`</Types>`)
+	workbook.WriteString(`</sheets></workbook>`)
+	fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="%s/styles" Target="styles.xml"/></Relationships>`, len(sheets)+1, nsRelations)
+
+	files := []struct{ name, content string }{
+		{"[Content_Types].xml", types.String()},
+		{"_rels/.rels", `<Relationships xmlns="` + nsPackageRels + `"><Relationship Id="rId1" Type="` + nsRelations + `/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+		{"xl/workbook.xml", workbook.String()},
+		{"xl/_rels/workbook.xml.rels", rels.String()},
+		{"xl/styles.xml", stylesXML},
+	}
+	for _, f := range files {
+		if err := add(f.name, f.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write workbook: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// worksheetXML escribe la hoja con el encabezado fijo en la primera fila.
+func worksheetXML(sheet *Sheet) string {
+	var b strings.Builder
+	b.WriteString(`<worksheet xmlns="` + nsMain + `">`)
+	if len(sheet.Header) > 0 {
+		b.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	}
+	b.WriteString(`<sheetData>`)
+
+	row := 0
+	if len(sheet.Header) > 0 {
+		row++
+		fmt.Fprintf(&b, `<row r="%d">`, row)
+		for col, h := range sheet.Header {
+			writeCell(&b, cellRef(col, row), h, headerStyleID)
+		}
+		b.WriteString(`</row>`)
+	}
+	for _, values := range sheet.Rows {
+		row++
+		fmt.Fprintf(&b, `<row r="%d">`, row)
+		for col, v := range values {
+			writeCell(&b, cellRef(col, row), v, 0)
+		}
+		b.WriteString(`</row>`)
+	}
+
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+func writeCell(b *strings.Builder, ref string, value any, style int) {
+	attrs := fmt.Sprintf(`r="%s"`, ref)
+	if style != 0 {
+		attrs += fmt.Sprintf(` s="%d"`, style)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return
+	case bool:
+		n := 0
+		if v {
+			n = 1
+		}
+		fmt.Fprintf(b, `<c %s t="b"><v>%d</v></c>`, attrs, n)
+		return
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		fmt.Fprintf(b, `<c %s><v>%d</v></c>`, attrs, v)
+		return
+	case float32:
+		value = float64(v)
+	case time.Time:
+		value = v.Format("2006-01-02 15:04:05")
+	}
+	if f, ok := value.(float64); ok && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		fmt.Fprintf(b, `<c %s><v>%s</v></c>`, attrs, strconv.FormatFloat(f, 'f', -1, 64))
+		return
+	}
+	fmt.Fprintf(b, `<c %s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, attrs, escapeXML(fmt.Sprint(value)))
+}
+
+// cellRef devuelve la referencia A1 de la celda (col empieza en 0, row en 1).
+func cellRef(col, row int) string {
+	name := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = string(rune('A'+(col-1)%26)) + name
+	}
+	return name + strconv.Itoa(row)
+}
+
+// sheetName devuelve un nombre válido y único: sin los caracteres que Excel no admite y de hasta
+// 31 caracteres.
+func sheetName(name string, n int, used map[string]bool) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(sheetNameChars, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" {
+		name = fmt.Sprintf("Sheet%d", n)
+	}
+	if r := []rune(name); len(r) > maxSheetName {
+		name = string(r[:maxSheetName])
+	}
+	for base, i := name, 2; used[strings.ToLower(name)]; i++ {
+		suffix := fmt.Sprintf(" (%d)", i)
+		r := []rune(base)
+		name = string(r[:min(len(r), maxSheetName-len(suffix))]) + suffix
+	}
+	used[strings.ToLower(name)] = true
+	return name
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,167 @@
+package pkgmwr
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	pkgtypes "github.com/teamcubation/teamcandidates/pkg/types"
+	pkgutils "github.com/teamcubation/teamcandidates/pkg/utils"
+)
+
+// APIKeyHeader es el header donde los clientes máquina envían su API key.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyAuthenticator valida una API key y devuelve el principal asociado.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(context.Context, string) (*pkgtypes.Principal, error)
+}
+
+// UserPermissionResolver devuelve los permisos que los roles de un usuario le otorgan.
+type UserPermissionResolver interface {
+	UserPermissions(context.Context, string) ([]string, error)
+}
+
+// TokenRevocationChecker indica si un JWT del usuario emitido en el instante recibido fue revocado.
+type TokenRevocationChecker interface {
+	TokenRevoked(context.Context, string, time.Time) (bool, error)
+}
+
+// Authenticate devuelve la cadena de middlewares que acepta un Bearer JWT o un header X-API-Key.
+// En ambos casos deja un *pkgtypes.Principal en el gin context bajo pkgtypes.PrincipalContextKey
+// y en el context de la request (ver pkgtypes.PrincipalFromContext). Los permisos de un usuario
+// se resuelven en cada request, por lo que un cambio de roles aplica sin reemitir el JWT, y los JWT
+// emitidos antes de una revocación (por ejemplo, un reseteo de contraseña) se rechazan.
+func Authenticate(jwtMiddleware gin.HandlerFunc, authenticator APIKeyAuthenticator, resolver UserPermissionResolver, revocations TokenRevocationChecker) []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		func(c *gin.Context) {
+			apiKey := c.GetHeader(APIKeyHeader)
+			if apiKey == "" {
+				// Sin API key se delega en el middleware JWT, que continúa la cadena si el token es válido.
+				jwtMiddleware(c)
+				return
+			}
+
+			principal, err := authenticator.AuthenticateAPIKey(c.Request.Context(), apiKey)
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid api key"})
+				c.Abort()
+				return
+			}
+
+			setPrincipal(c, principal)
+			c.Next()
+		},
+		principalFromJWT(resolver, revocations),
+	}
+}
+
+// principalFromJWT construye el principal a partir del JWT validado cuando la request no usó API key.
+func principalFromJWT(resolver UserPermissionResolver, revocations TokenRevocationChecker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, exists := c.Get(pkgtypes.PrincipalContextKey); exists {
+			c.Next()
+			return
+		}
+
+		raw, exists := c.Get(pkgutils.DefaultContextKey)
+		token, ok := raw.(*jwt.Token)
+		if !exists || !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "token not found in context"})
+			c.Abort()
+			return
+		}
+
+		subject, err := pkgutils.ExtractClaim(token, "sub")
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		}
+
+		// Un token sin iat solo sobrevive si el usuario nunca tuvo una revocación
+		var issuedAt time.Time
+		if iat, err := token.Claims.GetIssuedAt(); err == nil && iat != nil {
+			issuedAt = iat.Time
+		}
+		revoked, err := revocations.TokenRevoked(c.Request.Context(), subject, issuedAt)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "unable to check token revocation"})
+			c.Abort()
+			return
+		}
+		if revoked {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "token has been revoked"})
+			c.Abort()
+			return
+		}
+
+		permissions, err := resolver.UserPermissions(c.Request.Context(), subject)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "unable to resolve user permissions"})
+			c.Abort()
+			return
+		}
+
+		setPrincipal(c, &pkgtypes.Principal{
+			ID:          subject,
+			Type:        pkgtypes.PrincipalUser,
+			Permissions: permissions,
+		})
+		c.Next()
+	}
+}
+
+func setPrincipal(c *gin.Context, principal *pkgtypes.Principal) {
+	c.Set(pkgtypes.PrincipalContextKey, principal)
+	c.Request = c.Request.WithContext(pkgtypes.WithPrincipal(c.Request.Context(), principal))
+}
+
+// RequirePermission rechaza la request si el principal no tiene el permiso indicado.
+func RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, err := GetPrincipal(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		}
+
+		if !principal.HasPermission(permission) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "missing permission " + permission})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireMethodPermission exige readPermission en las requests de lectura (GET, HEAD y OPTIONS)
+// y writePermission en el resto. Pensado para aplicarse a un grupo de rutas completo.
+func RequireMethodPermission(readPermission, writePermission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			RequirePermission(readPermission)(c)
+		default:
+			RequirePermission(writePermission)(c)
+		}
+	}
+}
+
+// GetPrincipal recupera el principal autenticado del contexto.
+func GetPrincipal(c *gin.Context) (*pkgtypes.Principal, error) {
+	raw, exists := c.Get(pkgtypes.PrincipalContextKey)
+	if !exists {
+		return nil, pkgtypes.NewAuthenticationError("principal not found in context", nil)
+	}
+
+	principal, ok := raw.(*pkgtypes.Principal)
+	if !ok {
+		return nil, pkgtypes.NewAuthenticationError("invalid principal type in context", nil)
+	}
+	return principal, nil
+}
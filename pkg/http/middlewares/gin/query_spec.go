@@ -0,0 +1,51 @@
+package pkgmwr
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkgtypes "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// ParseQuerySpec parsea la paginación, filtros, orden y campos del query string según schema
+// y guarda el QuerySpec en el contexto. Responde 400 si el query string no respeta el schema.
+// Pedir registros borrados (deleted=include|only) requiere un principal con PermissionReadDeleted,
+// por lo que en rutas públicas siempre se rechaza.
+func ParseQuerySpec(schema pkgtypes.QuerySchema) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec, err := pkgtypes.ParseQuerySpec(c.Request.URL.Query(), schema)
+		if err != nil {
+			apiErr, code := pkgtypes.NewAPIError(err)
+			c.JSON(code, apiErr.ToResponse())
+			c.Abort()
+			return
+		}
+
+		if spec.Deleted != pkgtypes.DeletedExclude {
+			principal, err := GetPrincipal(c)
+			if err != nil || !principal.HasPermission(pkgtypes.PermissionReadDeleted) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "missing permission " + pkgtypes.PermissionReadDeleted})
+				c.Abort()
+				return
+			}
+		}
+
+		c.Set(pkgtypes.QuerySpecContextKey, spec)
+		c.Next()
+	}
+}
+
+// GetQuerySpec recupera el QuerySpec guardado por ParseQuerySpec.
+func GetQuerySpec(c *gin.Context) (*pkgtypes.QuerySpec, error) {
+	raw, exists := c.Get(pkgtypes.QuerySpecContextKey)
+	if !exists {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "query spec not found in context", nil)
+	}
+
+	spec, ok := raw.(*pkgtypes.QuerySpec)
+	if !ok {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "invalid query spec type in context", nil)
+	}
+	return spec, nil
+}
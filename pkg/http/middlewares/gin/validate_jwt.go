@@ -9,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 
+	pkgjwt "github.com/teamcubation/teamcandidates/pkg/authe/jwt/v5"
 	pkgutils "github.com/teamcubation/teamcandidates/pkg/utils"
 )
 
@@ -54,6 +55,15 @@ func Validate(cfg pkgutils.Config) gin.HandlerFunc {
 			return
 		}
 
+		// Scoped tokens (e.g. assessment links) carry a purpose claim and are not session tokens.
+		if claims, ok := parsedToken.Claims.(jwt.MapClaims); ok {
+			if purpose, exists := claims[pkgjwt.PurposeClaim]; exists && purpose != "" {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "token is not valid for this API"})
+				c.Abort()
+				return
+			}
+		}
+
 		// Save the token and claims in the Gin context.
 		c.Set(cfg.ContextKey, parsedToken)
 		c.Set(pkgutils.GetClaimsKey(cfg.ContextKey), parsedToken.Claims)
@@ -0,0 +1,29 @@
+package pkgquality
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Bootstrap crea el analizador de calidad a partir de las variables de entorno QUALITY_*.
+func Bootstrap() (Service, error) {
+	config := newConfig(
+		envInt("QUALITY_MAX_COMPLEXITY", 10),
+		envInt("QUALITY_MAX_PARAMS", 3),
+		envInt("QUALITY_MAX_STRUCT_FIELDS", 5),
+		envInt("QUALITY_MAX_FUNCTION_LINES", 50),
+	)
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("quality config error: %w", err)
+	}
+
+	return newService(config), nil
+}
+
+func envInt(key string, def int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,31 @@
+package pkgquality
+
+import "errors"
+
+type config struct {
+	maxComplexity    int
+	maxParams        int
+	maxStructFields  int
+	maxFunctionLines int
+}
+
+func newConfig(maxComplexity, maxParams, maxStructFields, maxFunctionLines int) Config {
+	return &config{
+		maxComplexity:    maxComplexity,
+		maxParams:        maxParams,
+		maxStructFields:  maxStructFields,
+		maxFunctionLines: maxFunctionLines,
+	}
+}
+
+func (c *config) GetMaxComplexity() int    { return c.maxComplexity }
+func (c *config) GetMaxParams() int        { return c.maxParams }
+func (c *config) GetMaxStructFields() int  { return c.maxStructFields }
+func (c *config) GetMaxFunctionLines() int { return c.maxFunctionLines }
+
+func (c *config) Validate() error {
+	if c.maxComplexity <= 0 || c.maxParams <= 0 || c.maxStructFields <= 0 || c.maxFunctionLines <= 0 {
+		return errors.New("quality limits must be positive")
+	}
+	return nil
+}
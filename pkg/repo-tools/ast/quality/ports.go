@@ -0,0 +1,18 @@
+package pkgquality
+
+// Config define los límites a partir de los cuales se reporta un hallazgo.
+type Config interface {
+	GetMaxComplexity() int    // Complejidad ciclomática máxima por función
+	GetMaxParams() int        // Parámetros máximos por función
+	GetMaxStructFields() int  // Campos máximos por struct
+	GetMaxFunctionLines() int // Líneas máximas por función
+	Validate() error
+}
+
+// Service analiza estáticamente código Go con los mismos criterios que pkg/repo-tools/ast
+// (complejidad ciclomática, code smells, números mágicos, formato y manejo de errores), pero
+// sobre el código en memoria y sin cargar el paquete, así sirve para código que no compila.
+type Service interface {
+	// Analyze devuelve el reporte de calidad del código. Solo falla si el código no se puede parsear.
+	Analyze(source string) (*Report, error)
+}
@@ -0,0 +1,340 @@
+package pkgquality
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Penalización por hallazgo en cada dimensión. Formato no usa penalización: su puntaje es la
+// proporción de líneas que gofmt no cambia.
+const (
+	complexityPenalty    = 10.0 // Más complexityStep por cada punto sobre el máximo
+	complexityStep       = 5.0
+	codeSmellPenalty     = 15.0
+	magicNumberPenalty   = 5.0
+	errorHandlingPenalty = 20.0
+)
+
+// packageClause se agrega al código que no declara su paquete.
+const packageClause = "package main\n\n"
+
+type service struct {
+	config Config
+}
+
+// newService crea el analizador. No guarda estado: es seguro para uso concurrente.
+func newService(config Config) Service {
+	return &service{config: config}
+}
+
+func (s *service) Analyze(source string) (*Report, error) {
+	fset := token.NewFileSet()
+	file, parseErr := parser.ParseFile(fset, "solution.go", source, parser.ParseComments)
+	lineOffset := 0
+	if !parsed(file, parseErr) {
+		// Sin cláusula package el parser no devuelve declaraciones: se agrega una, separada por la
+		// línea en blanco que exige gofmt, y se corrigen las líneas
+		source = packageClause + source
+		fset = token.NewFileSet()
+		file, parseErr = parser.ParseFile(fset, "solution.go", source, parser.ParseComments)
+		lineOffset = strings.Count(packageClause, "\n")
+	}
+	if !parsed(file, parseErr) {
+		return nil, fmt.Errorf("failed to parse source: %w", parseErr)
+	}
+
+	a := &analysis{
+		config: s.config,
+		fset:   fset,
+		offset: lineOffset,
+		report: &Report{Lines: strings.Count(strings.TrimRight(source, "\n"), "\n") + 1 - lineOffset},
+		scores: make(map[Dimension]float64, len(Dimensions)),
+	}
+	for _, d := range Dimensions {
+		a.scores[d.Name] = 100
+	}
+
+	a.checkFunctions(file)
+	a.checkStructs(file)
+	a.checkMagicNumbers(file)
+	a.checkErrorHandling(file)
+	a.checkFormatting(source, parseErr)
+
+	return a.finish(), nil
+}
+
+// analysis acumula los hallazgos y los puntajes de un análisis.
+type analysis struct {
+	config Config
+	fset   *token.FileSet
+	offset int
+	report *Report
+	scores map[Dimension]float64
+}
+
+func (a *analysis) line(pos token.Pos) int {
+	return a.fset.Position(pos).Line - a.offset
+}
+
+func (a *analysis) add(d Dimension, rule string, pos token.Pos, penalty float64, format string, args ...any) {
+	a.report.Findings = append(a.report.Findings, Finding{
+		Dimension: d,
+		Rule:      rule,
+		Line:      a.line(pos),
+		Message:   fmt.Sprintf(format, args...),
+	})
+	a.scores[d] -= penalty
+}
+
+// checkFunctions calcula la complejidad ciclomática de cada función (como CalculateCyclomaticComplexity)
+// y reporta las funciones demasiado complejas, largas o con demasiados parámetros.
+func (a *analysis) checkFunctions(file *ast.File) {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		a.report.Functions++
+		name := funcName(fn)
+
+		complexity := cyclomaticComplexity(fn.Body)
+		a.report.MaxComplexity = max(a.report.MaxComplexity, complexity)
+		if over := complexity - a.config.GetMaxComplexity(); over > 0 {
+			a.add(DimensionComplexity, "high_complexity", fn.Pos(), complexityPenalty+complexityStep*float64(over),
+				"function %s has cyclomatic complexity %d (max %d)", name, complexity, a.config.GetMaxComplexity())
+		}
+
+		if params := fn.Type.Params.NumFields(); params > a.config.GetMaxParams() {
+			a.add(DimensionCodeSmells, "too_many_params", fn.Pos(), codeSmellPenalty,
+				"function %s has too many parameters (%d, max %d)", name, params, a.config.GetMaxParams())
+		}
+		if lines := a.line(fn.Body.Rbrace) - a.line(fn.Body.Lbrace) - 1; lines > a.config.GetMaxFunctionLines() {
+			a.add(DimensionCodeSmells, "long_function", fn.Pos(), codeSmellPenalty,
+				"function %s is too long (%d lines, max %d)", name, lines, a.config.GetMaxFunctionLines())
+		}
+	}
+}
+
+// checkStructs reporta los structs con demasiados campos, como DetectCodeSmells.
+func (a *analysis) checkStructs(file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		if st, ok := ts.Type.(*ast.StructType); ok {
+			if fields := st.Fields.NumFields(); fields > a.config.GetMaxStructFields() {
+				a.add(DimensionCodeSmells, "too_many_fields", ts.Pos(), codeSmellPenalty,
+					"struct %s has too many fields (%d, max %d)", ts.Name.Name, fields, a.config.GetMaxStructFields())
+			}
+		}
+		return true
+	})
+}
+
+// checkMagicNumbers reporta los literales numéricos distintos de 0 y 1, como IdentifyMagicNumbers.
+// Los que están en una declaración const ya tienen nombre y no se reportan.
+func (a *analysis) checkMagicNumbers(file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GenDecl:
+			return n.Tok != token.CONST
+		case *ast.BasicLit:
+			if (n.Kind == token.INT || n.Kind == token.FLOAT) && n.Value != "0" && n.Value != "1" {
+				a.add(DimensionMagicNumbers, "magic_number", n.Pos(), magicNumberPenalty,
+					"magic number %s, consider a named constant", n.Value)
+			}
+		}
+		return true
+	})
+}
+
+// checkErrorHandling reporta los errores descartados con _, los chequeos de error vacíos y los
+// panic con un error. Sin información de tipos se asume la convención de Go: el error es el
+// último valor que devuelve una función.
+func (a *analysis) checkErrorHandling(file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) > 1 && len(n.Rhs) == 1 {
+				last, ok := n.Lhs[len(n.Lhs)-1].(*ast.Ident)
+				if _, isCall := n.Rhs[0].(*ast.CallExpr); isCall && ok && last.Name == "_" {
+					a.add(DimensionErrorHandling, "discarded_error", n.Pos(), errorHandlingPenalty,
+						"possible error discarded with _")
+				}
+			}
+		case *ast.IfStmt:
+			if isErrCheck(n.Cond) && len(n.Body.List) == 0 {
+				a.add(DimensionErrorHandling, "empty_error_check", n.Pos(), errorHandlingPenalty,
+					"error is checked but not handled")
+			}
+		case *ast.CallExpr:
+			if id, ok := n.Fun.(*ast.Ident); ok && id.Name == "panic" && len(n.Args) == 1 && isErrIdent(n.Args[0]) {
+				a.add(DimensionErrorHandling, "panic_on_error", n.Pos(), errorHandlingPenalty,
+					"panic on error instead of handling or returning it")
+			}
+		}
+		return true
+	})
+}
+
+// checkFormatting compara el código con la salida de gofmt, como CheckFormatting. El puntaje es la
+// proporción de líneas que gofmt deja igual; un código con errores de sintaxis no se puede formatear.
+func (a *analysis) checkFormatting(source string, parseErr error) {
+	if parseErr != nil {
+		line := 1
+		var list scanner.ErrorList
+		if errors.As(parseErr, &list) && len(list) > 0 {
+			line = list[0].Pos.Line - a.offset
+		}
+		a.report.Findings = append(a.report.Findings, Finding{
+			Dimension: DimensionFormatting,
+			Rule:      "syntax_error",
+			Line:      line,
+			Message:   "code has syntax errors and cannot be formatted",
+		})
+		a.scores[DimensionFormatting] = 0
+		return
+	}
+
+	formatted, err := format.Source([]byte(source))
+	if err != nil {
+		a.scores[DimensionFormatting] = 0
+		return
+	}
+	original := strings.Split(strings.TrimRight(source, "\n"), "\n")
+	changed, first := changedLines(original, strings.Split(strings.TrimRight(string(formatted), "\n"), "\n"))
+	if changed == 0 {
+		return
+	}
+
+	a.report.Findings = append(a.report.Findings, Finding{
+		Dimension: DimensionFormatting,
+		Rule:      "not_gofmt",
+		Line:      first + 1 - a.offset,
+		Message:   fmt.Sprintf("code is not gofmt-formatted (%d lines differ)", changed),
+	})
+	// Las líneas de la cláusula package agregada no cuentan: gofmt nunca las cambia
+	a.scores[DimensionFormatting] = 100 * (1 - float64(changed)/float64(len(original)-a.offset))
+}
+
+// finish calcula el puntaje de cada dimensión y el total ponderado.
+func (a *analysis) finish() *Report {
+	counts := make(map[Dimension]int, len(Dimensions))
+	for _, f := range a.report.Findings {
+		counts[f.Dimension]++
+	}
+
+	var total, weights float64
+	for _, d := range Dimensions {
+		score := math.Round(max(0, a.scores[d.Name])*100) / 100
+		a.report.Dimensions = append(a.report.Dimensions, DimensionScore{
+			Dimension: d.Name,
+			Score:     score,
+			Weight:    d.Weight,
+			Findings:  counts[d.Name],
+		})
+		total += score * d.Weight
+		weights += d.Weight
+	}
+	a.report.Score = math.Round(total/weights*100) / 100
+
+	slices.SortStableFunc(a.report.Findings, func(x, y Finding) int { return x.Line - y.Line })
+	return a.report
+}
+
+// cyclomaticComplexity cuenta los caminos independientes de una función: 1 más cada if, for,
+// case y operador lógico.
+func cyclomaticComplexity(body *ast.BlockStmt) int {
+	complexity := 1
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt, *ast.CaseClause, *ast.CommClause:
+			complexity++
+		case *ast.BinaryExpr:
+			if n.Op == token.LAND || n.Op == token.LOR {
+				complexity++
+			}
+		}
+		return true
+	})
+	return complexity
+}
+
+// changedLines cuenta las líneas de original que no aparecen en formatted (como multiconjunto) y
+// devuelve el índice de la primera línea distinta.
+func changedLines(original, formatted []string) (int, int) {
+	remaining := make(map[string]int, len(formatted))
+	for _, l := range formatted {
+		remaining[l]++
+	}
+
+	changed, first := 0, -1
+	for i, l := range original {
+		if remaining[l] > 0 {
+			remaining[l]--
+			continue
+		}
+		changed++
+		if first < 0 {
+			first = i
+		}
+	}
+	if changed == 0 && len(original) != len(formatted) {
+		changed, first = 1, min(len(original), len(formatted))-1
+	}
+	return changed, max(first, 0)
+}
+
+// parsed indica si el parser recuperó alguna declaración válida, aunque haya errores de sintaxis.
+func parsed(file *ast.File, err error) bool {
+	if file == nil {
+		return false
+	}
+	if err == nil {
+		return true
+	}
+	return slices.ContainsFunc(file.Decls, func(d ast.Decl) bool {
+		_, bad := d.(*ast.BadDecl)
+		return !bad
+	})
+}
+
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if id, ok := recv.(*ast.Ident); ok {
+		return id.Name + "." + fn.Name.Name
+	}
+	return fn.Name.Name
+}
+
+// isErrCheck indica si cond es de la forma err != nil.
+func isErrCheck(cond ast.Expr) bool {
+	be, ok := cond.(*ast.BinaryExpr)
+	if !ok || be.Op != token.NEQ {
+		return false
+	}
+	if y, ok := be.Y.(*ast.Ident); !ok || y.Name != "nil" {
+		return false
+	}
+	return isErrIdent(be.X)
+}
+
+func isErrIdent(e ast.Expr) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && (id.Name == "err" || strings.HasSuffix(id.Name, "Err"))
+}
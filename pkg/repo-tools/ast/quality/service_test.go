@@ -0,0 +1,183 @@
+package pkgquality
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+	s := newService(newConfig(3, 2, 2, 10))
+
+	tests := []struct {
+		name          string
+		source        string
+		wantRules     []string
+		wantLines     []int
+		wantScore     float64
+		wantDimension map[Dimension]float64
+		wantMaxCx     int
+		wantErr       bool
+	}{
+		{
+			name: "Success: clean code",
+			source: `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("hola")
+}
+`,
+			wantScore: 100,
+			wantMaxCx: 1,
+		},
+		{
+			name: "Success: magic numbers outside const declarations",
+			source: `package main
+
+const limit = 42
+
+func main() {
+	println(limit * 7)
+}
+`,
+			wantRules:     []string{"magic_number"},
+			wantLines:     []int{6},
+			wantScore:     99.25,
+			wantDimension: map[Dimension]float64{DimensionMagicNumbers: 95},
+			wantMaxCx:     1,
+		},
+		{
+			name: "Success: discarded, ignored and panicked errors",
+			source: `package main
+
+import "strconv"
+
+func main() {
+	n, _ := strconv.Atoi("x")
+	_, err := strconv.Atoi("y")
+	if err != nil {
+	}
+	panic(err)
+	println(n)
+}
+`,
+			wantRules:     []string{"discarded_error", "empty_error_check", "panic_on_error"},
+			wantLines:     []int{6, 8, 10},
+			wantScore:     85,
+			wantDimension: map[Dimension]float64{DimensionErrorHandling: 40},
+			wantMaxCx:     2,
+		},
+		{
+			name: "Success: complex functions with too many parameters and fields",
+			source: `package main
+
+type point struct {
+	x, y, z int
+}
+
+func classify(a, b, c int) int {
+	if a > b && b > c || c == a {
+		return a
+	}
+	for i := range b {
+		if i == c {
+			return i
+		}
+	}
+	return c
+}
+
+func main() {
+	println(classify(1, 0, 0), point{}.x)
+}
+`,
+			wantRules: []string{"too_many_fields", "high_complexity", "too_many_params"},
+			wantLines: []int{3, 7, 7},
+			wantDimension: map[Dimension]float64{
+				DimensionComplexity: 75,
+				DimensionCodeSmells: 70,
+			},
+			wantScore: 87.75,
+			wantMaxCx: 6,
+		},
+		{
+			name:      "Success: code without gofmt",
+			source:    "package main\n\nfunc main()  {\n\tprintln( \"hola\" )\n}\n",
+			wantRules: []string{"not_gofmt"},
+			wantLines: []int{3},
+			wantScore: 94,
+			wantDimension: map[Dimension]float64{
+				DimensionFormatting: 60,
+			},
+			wantMaxCx: 1,
+		},
+		{
+			name:      "Success: missing package clause keeps the original line numbers",
+			source:    "func main() {\n\tprintln(3)\n}\n",
+			wantRules: []string{"magic_number"},
+			wantLines: []int{2},
+			wantScore: 99.25,
+			wantMaxCx: 1,
+		},
+		{
+			name:          "Success: syntax errors are analyzed on the partial AST",
+			source:        "package main\n\nfunc main() {\n\tprintln(\"hola\")\n}\n\nfunc broken( {\n",
+			wantRules:     []string{"syntax_error"},
+			wantLines:     []int{7},
+			wantScore:     85,
+			wantDimension: map[Dimension]float64{DimensionFormatting: 0},
+			wantMaxCx:     1,
+		},
+		{
+			name:          "Success: formatting of code without package clause is checked as written",
+			source:        "func main()  {\n\tprintln(\"hola\")\n}\n",
+			wantRules:     []string{"not_gofmt"},
+			wantLines:     []int{1},
+			wantScore:     95,
+			wantDimension: map[Dimension]float64{DimensionFormatting: 66.67},
+			wantMaxCx:     1,
+		},
+		{
+			name:    "Error: source cannot be parsed at all",
+			source:  "}}} not go {{{",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report, err := s.Analyze(tc.source)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+
+			var rules []string
+			var lines []int
+			for _, f := range report.Findings {
+				rules = append(rules, f.Rule)
+				lines = append(lines, f.Line)
+			}
+			assert.Equal(t, tc.wantRules, rules, "rules mismatch")
+			assert.Equal(t, tc.wantLines, lines, "finding lines mismatch")
+			assert.Equal(t, tc.wantScore, report.Score, "score mismatch")
+			assert.Equal(t, tc.wantMaxCx, report.MaxComplexity, "max complexity mismatch")
+			assert.Len(t, report.Dimensions, len(Dimensions))
+			for _, d := range report.Dimensions {
+				want, ok := tc.wantDimension[d.Dimension]
+				if !ok {
+					want = 100
+					if len(rules) == 0 || d.Findings == 0 {
+						assert.Equal(t, want, d.Score, "dimension %s should not be penalized", d.Dimension)
+					}
+					continue
+				}
+				assert.Equal(t, want, d.Score, "dimension %s score mismatch", d.Dimension)
+			}
+		})
+	}
+}
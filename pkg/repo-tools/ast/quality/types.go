@@ -0,0 +1,50 @@
+package pkgquality
+
+// Dimension es un aspecto de la calidad del código que recibe su propio puntaje.
+type Dimension string
+
+const (
+	DimensionComplexity    Dimension = "complexity"     // Complejidad ciclomática de las funciones
+	DimensionCodeSmells    Dimension = "code_smells"    // Funciones y structs demasiado grandes
+	DimensionMagicNumbers  Dimension = "magic_numbers"  // Literales numéricos sin nombre
+	DimensionFormatting    Dimension = "formatting"     // Diferencias con gofmt
+	DimensionErrorHandling Dimension = "error_handling" // Errores descartados o ignorados
+)
+
+// Dimensions lista las dimensiones en el orden en que se reportan, con su peso en el puntaje total.
+var Dimensions = []struct {
+	Name   Dimension
+	Weight float64
+}{
+	{DimensionComplexity, 25},
+	{DimensionCodeSmells, 20},
+	{DimensionMagicNumbers, 15},
+	{DimensionFormatting, 15},
+	{DimensionErrorHandling, 25},
+}
+
+// Finding es un problema encontrado en una línea del código.
+type Finding struct {
+	Dimension Dimension
+	Rule      string // Identificador del chequeo (high_complexity, too_many_params, ...)
+	Line      int
+	Message   string
+}
+
+// DimensionScore es el puntaje de una dimensión, de 0 a 100.
+type DimensionScore struct {
+	Dimension Dimension
+	Score     float64
+	Weight    float64
+	Findings  int
+}
+
+// Report es el resultado del análisis de calidad.
+type Report struct {
+	Score         float64 // Promedio ponderado de las dimensiones, de 0 a 100
+	Lines         int     // Líneas del código
+	Functions     int     // Funciones y métodos declarados
+	MaxComplexity int     // Mayor complejidad ciclomática entre las funciones
+	Dimensions    []DimensionScore
+	Findings      []Finding // Ordenados por línea
+}
@@ -0,0 +1,27 @@
+package pkgsimilarity
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Bootstrap crea el servicio de similitud a partir de las variables de entorno SIMILARITY_*.
+func Bootstrap() (Service, error) {
+	config := newConfig(
+		envInt("SIMILARITY_KGRAM_SIZE", 10),
+		envInt("SIMILARITY_WINDOW_SIZE", 6),
+	)
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("similarity config error: %w", err)
+	}
+
+	return newService(config), nil
+}
+
+func envInt(key string, def int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,28 @@
+package pkgsimilarity
+
+import "errors"
+
+type config struct {
+	kgramSize  int
+	windowSize int
+}
+
+func newConfig(kgramSize, windowSize int) Config {
+	return &config{
+		kgramSize:  kgramSize,
+		windowSize: windowSize,
+	}
+}
+
+func (c *config) GetKGramSize() int  { return c.kgramSize }
+func (c *config) GetWindowSize() int { return c.windowSize }
+
+func (c *config) Validate() error {
+	if c.kgramSize <= 0 {
+		return errors.New("similarity k-gram size must be positive")
+	}
+	if c.windowSize <= 0 {
+		return errors.New("similarity window size must be positive")
+	}
+	return nil
+}
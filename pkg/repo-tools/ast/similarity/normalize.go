@@ -0,0 +1,104 @@
+package pkgsimilarity
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// lexeme es un token normalizado junto con la línea del código original en la que aparece.
+type lexeme struct {
+	text string
+	line int
+}
+
+// normalize recorre el AST del código y lo convierte en una secuencia de tokens que no depende
+// de los nombres elegidos por el autor: los identificadores propios se reemplazan por ID y los
+// literales por su tipo. Se conservan los identificadores predeclarados (int, len, nil, ...) y
+// las referencias a paquetes importados (fmt.Println, aunque se importe con un alias), que no se
+// pueden renombrar. Los imports, comentarios, paréntesis, bloques y nodos inválidos no generan tokens.
+func normalize(source string) ([]lexeme, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "submission.go", source, parser.SkipObjectResolution)
+	lineOffset := 0
+	if file == nil || (err != nil && len(file.Decls) == 0) {
+		// Sin cláusula package el parser no devuelve declaraciones: se agrega una y se corrigen las líneas
+		file, err = parser.ParseFile(fset, "submission.go", "package main\n"+source, parser.SkipObjectResolution)
+		lineOffset = 1
+	}
+	if file == nil || (err != nil && len(file.Decls) == 0) {
+		return nil, fmt.Errorf("failed to parse source: %w", err)
+	}
+
+	// Nombre local de cada import -> path del paquete, para que un alias no cambie los tokens
+	imports := make(map[string]string, len(file.Imports))
+	for _, imp := range file.Imports {
+		p, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := path.Base(p)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = p
+	}
+
+	var out []lexeme
+	emit := func(text string, pos token.Pos) {
+		out = append(out, lexeme{text: text, line: fset.Position(pos).Line - lineOffset})
+	}
+
+	for _, decl := range file.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			continue
+		}
+		ast.Inspect(decl, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case nil:
+				return false
+			case *ast.CommentGroup, *ast.BadDecl, *ast.BadStmt, *ast.BadExpr:
+				return false
+			case *ast.BlockStmt, *ast.ExprStmt, *ast.ParenExpr, *ast.FieldList, *ast.Field:
+				// Nodos estructurales: sus hijos alcanzan para describir el código
+			case *ast.Ident:
+				if p, ok := imports[n.Name]; ok {
+					emit(p, n.Pos())
+				} else if types.Universe.Lookup(n.Name) != nil {
+					emit(n.Name, n.Pos())
+				} else {
+					emit("ID", n.Pos())
+				}
+			case *ast.SelectorExpr:
+				if x, ok := n.X.(*ast.Ident); ok && imports[x.Name] != "" {
+					emit(imports[x.Name]+"."+n.Sel.Name, n.Pos())
+					return false
+				}
+				emit(".", n.Pos())
+			case *ast.BasicLit:
+				emit("LIT:"+n.Kind.String(), n.Pos())
+			case *ast.BinaryExpr:
+				emit("BIN:"+n.Op.String(), n.OpPos)
+			case *ast.UnaryExpr:
+				emit("UN:"+n.Op.String(), n.Pos())
+			case *ast.AssignStmt:
+				emit("ASSIGN:"+n.Tok.String(), n.Pos())
+			case *ast.IncDecStmt:
+				emit(n.Tok.String(), n.Pos())
+			case *ast.BranchStmt:
+				emit(n.Tok.String(), n.Pos())
+			case *ast.GenDecl:
+				emit(n.Tok.String(), n.Pos())
+			default:
+				emit(strings.TrimPrefix(fmt.Sprintf("%T", n), "*ast."), n.Pos())
+			}
+			return true
+		})
+	}
+	return out, nil
+}
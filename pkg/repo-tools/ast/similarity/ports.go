@@ -0,0 +1,22 @@
+package pkgsimilarity
+
+// Config define la configuración del fingerprinting.
+type Config interface {
+	// GetKGramSize es la cantidad de tokens normalizados que forman cada k-grama. Coincidencias
+	// más cortas se consideran ruido (idioms comunes como un for o un if err != nil).
+	GetKGramSize() int
+	// GetWindowSize es el tamaño de la ventana del winnowing. Toda coincidencia de al menos
+	// k + w - 1 tokens queda detectada.
+	GetWindowSize() int
+	Validate() error
+}
+
+// Service compara código Go de forma robusta a los cambios cosméticos: renombrar identificadores,
+// cambiar literales, reformatear o mover comentarios no cambia el fingerprint.
+type Service interface {
+	// Fingerprint normaliza el código y devuelve su huella. Tolera errores de sintaxis: se usa
+	// el AST parcial, porque una entrega que no compila también puede ser una copia.
+	Fingerprint(source string) (*Document, error)
+	// Compare mide cuánto de a aparece en b y en qué líneas.
+	Compare(a, b *Document) *Comparison
+}
@@ -0,0 +1,150 @@
+package pkgsimilarity
+
+import (
+	"hash/fnv"
+	"slices"
+)
+
+type service struct {
+	config Config
+}
+
+// newService crea el servicio de similitud. No guarda estado: es seguro para uso concurrente.
+func newService(config Config) Service {
+	return &service{config: config}
+}
+
+func (s *service) Fingerprint(source string) (*Document, error) {
+	lexemes, err := normalize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	grams := kgrams(lexemes, s.config.GetKGramSize())
+	return &Document{
+		Tokens:       len(lexemes),
+		Fingerprints: winnow(grams, s.config.GetWindowSize()),
+	}, nil
+}
+
+func (s *service) Compare(a, b *Document) *Comparison {
+	cmp := &Comparison{}
+	if a == nil || b == nil || len(a.Fingerprints) == 0 || len(b.Fingerprints) == 0 {
+		return cmp
+	}
+
+	inB := make(map[uint64][]Fingerprint, len(b.Fingerprints))
+	for _, fp := range b.Fingerprints {
+		inB[fp.Hash] = append(inB[fp.Hash], fp)
+	}
+
+	hashesA := make(map[uint64]bool, len(a.Fingerprints))
+	shared := make(map[uint64]bool)
+	var pairs []Region
+	for _, fp := range a.Fingerprints {
+		hashesA[fp.Hash] = true
+		others, ok := inB[fp.Hash]
+		if !ok {
+			continue
+		}
+		shared[fp.Hash] = true
+		other := closest(others, pairs)
+		pairs = append(pairs, Region{
+			StartLine:      fp.StartLine,
+			EndLine:        fp.EndLine,
+			OtherStartLine: other.StartLine,
+			OtherEndLine:   other.EndLine,
+		})
+	}
+
+	union := len(hashesA) + len(inB) - len(shared)
+	cmp.Shared = len(shared)
+	cmp.Containment = float64(len(shared)) / float64(len(hashesA))
+	cmp.Jaccard = float64(len(shared)) / float64(union)
+	cmp.Regions = mergeRegions(pairs)
+	return cmp
+}
+
+// kgrams calcula el hash de cada secuencia de k tokens consecutivos. Un código más corto que k
+// produce un único k-grama con todos sus tokens, para que también pueda compararse.
+func kgrams(lexemes []lexeme, k int) []Fingerprint {
+	if len(lexemes) == 0 {
+		return nil
+	}
+	k = min(k, len(lexemes))
+
+	grams := make([]Fingerprint, 0, len(lexemes)-k+1)
+	for i := 0; i+k <= len(lexemes); i++ {
+		h := fnv.New64a()
+		start, end := lexemes[i].line, lexemes[i].line
+		for _, l := range lexemes[i : i+k] {
+			h.Write([]byte(l.text))
+			h.Write([]byte{0})
+			start, end = min(start, l.line), max(end, l.line)
+		}
+		grams = append(grams, Fingerprint{Hash: h.Sum64(), StartLine: start, EndLine: end})
+	}
+	return grams
+}
+
+// winnow selecciona en cada ventana de w k-gramas consecutivos el de menor hash (el de más a la
+// derecha si hay empate), sin repetir posiciones. Así se guarda una fracción de los k-gramas y se
+// garantiza que toda coincidencia de al menos w k-gramas comparta un fingerprint.
+func winnow(grams []Fingerprint, w int) []Fingerprint {
+	if len(grams) == 0 {
+		return nil
+	}
+	w = min(w, len(grams))
+
+	var out []Fingerprint
+	last := -1
+	for start := 0; start+w <= len(grams); start++ {
+		minIdx := start
+		for i := start + 1; i < start+w; i++ {
+			if grams[i].Hash <= grams[minIdx].Hash {
+				minIdx = i
+			}
+		}
+		if minIdx != last {
+			out = append(out, grams[minIdx])
+			last = minIdx
+		}
+	}
+	return out
+}
+
+// closest elige, entre las apariciones de un fingerprint en b, la que continúa la última región
+// encontrada; si ninguna la continúa, la primera.
+func closest(others []Fingerprint, pairs []Region) Fingerprint {
+	if len(pairs) > 0 {
+		prev := pairs[len(pairs)-1]
+		for _, o := range others {
+			if o.StartLine >= prev.OtherStartLine && o.StartLine <= prev.OtherEndLine+1 {
+				return o
+			}
+		}
+	}
+	return others[0]
+}
+
+// mergeRegions une los tramos coincidentes que se solapan o son contiguos en ambos documentos.
+func mergeRegions(pairs []Region) []Region {
+	if len(pairs) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(pairs, func(x, y Region) int { return x.StartLine - y.StartLine })
+
+	regions := []Region{pairs[0]}
+	for _, p := range pairs[1:] {
+		cur := &regions[len(regions)-1]
+		if p.StartLine <= cur.EndLine+1 &&
+			p.OtherStartLine <= cur.OtherEndLine+1 && p.OtherEndLine >= cur.OtherStartLine-1 {
+			cur.EndLine = max(cur.EndLine, p.EndLine)
+			cur.OtherStartLine = min(cur.OtherStartLine, p.OtherStartLine)
+			cur.OtherEndLine = max(cur.OtherEndLine, p.OtherEndLine)
+			continue
+		}
+		regions = append(regions, p)
+	}
+	return regions
+}
@@ -0,0 +1,138 @@
+package pkgsimilarity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const original = `package main
+
+import "fmt"
+
+func sum(values []int) int {
+	total := 0
+	for _, v := range values {
+		if v > 0 {
+			total += v
+		}
+	}
+	return total
+}
+
+func main() {
+	fmt.Println(sum([]int{1, 2, 3}))
+}
+`
+
+// renamed es original con otros identificadores, literales, comentarios y formato.
+const renamed = `package main
+
+import "fmt"
+
+// acumular suma los positivos
+func acumular(nums []int) int {
+	acc := 100
+	for _, n := range nums { if n > 7 { acc += n } }
+	return acc
+}
+
+func main() {
+	fmt.Println(acumular([]int{4, 5}))
+}
+`
+
+const different = `package main
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+func main() {
+	scanner := bufio.NewScanner(os.Stdin)
+	words := map[string]int{}
+	for scanner.Scan() {
+		for _, w := range strings.Fields(scanner.Text()) {
+			words[strings.ToLower(w)]++
+		}
+	}
+	println(len(words))
+}
+`
+
+func TestCompare(t *testing.T) {
+	s := newService(newConfig(10, 6))
+
+	tests := []struct {
+		name            string
+		a, b            string
+		wantContainment float64
+		maxContainment  float64
+		wantRegions     bool
+	}{
+		{
+			name:            "identical code",
+			a:               original,
+			b:               original,
+			wantContainment: 1,
+			wantRegions:     true,
+		},
+		{
+			name:            "renamed identifiers, literals and formatting",
+			a:               renamed,
+			b:               original,
+			wantContainment: 1,
+			wantRegions:     true,
+		},
+		{
+			name:           "unrelated code",
+			a:              different,
+			b:              original,
+			maxContainment: 0.3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := s.Fingerprint(tc.a)
+			assert.NoError(t, err)
+			b, err := s.Fingerprint(tc.b)
+			assert.NoError(t, err)
+
+			cmp := s.Compare(a, b)
+
+			switch {
+			case tc.maxContainment > 0:
+				assert.LessOrEqual(t, cmp.Containment, tc.maxContainment, "containment too high")
+			default:
+				assert.Equal(t, tc.wantContainment, cmp.Containment, "containment mismatch")
+			}
+			if tc.wantRegions {
+				assert.NotEmpty(t, cmp.Regions, "matching code should report regions")
+				assert.Greater(t, cmp.Shared, 0)
+			}
+		})
+	}
+}
+
+func TestFingerprintToleratesSyntaxErrors(t *testing.T) {
+	s := newService(newConfig(10, 6))
+
+	broken, err := s.Fingerprint(original + "\nfunc broken( {")
+	assert.NoError(t, err, "partial ASTs should still be fingerprinted")
+	full, err := s.Fingerprint(original)
+	assert.NoError(t, err)
+
+	assert.Greater(t, s.Compare(full, broken).Containment, 0.9, "the valid part should still match")
+}
+
+func TestCompareEmptyDocuments(t *testing.T) {
+	s := newService(newConfig(10, 6))
+	doc, err := s.Fingerprint(original)
+	assert.NoError(t, err)
+
+	assert.Equal(t, &Comparison{}, s.Compare(doc, &Document{}))
+	assert.Equal(t, &Comparison{}, s.Compare(nil, doc))
+}
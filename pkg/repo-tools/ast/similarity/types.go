@@ -0,0 +1,31 @@
+package pkgsimilarity
+
+// Fingerprint es el hash de un k-grama seleccionado por el winnowing, con las líneas del código
+// original que cubre.
+type Fingerprint struct {
+	Hash      uint64
+	StartLine int
+	EndLine   int
+}
+
+// Document es la huella de un código: los fingerprints en orden de aparición.
+type Document struct {
+	Tokens       int // Tokens normalizados del código
+	Fingerprints []Fingerprint
+}
+
+// Region es un tramo de código presente en los dos documentos comparados.
+type Region struct {
+	StartLine      int // Líneas en el documento a
+	EndLine        int
+	OtherStartLine int // Líneas en el documento b
+	OtherEndLine   int
+}
+
+// Comparison es el resultado de comparar el documento a contra el b.
+type Comparison struct {
+	Containment float64 // Fracción de los fingerprints de a presentes en b, de 0 a 1
+	Jaccard     float64 // Fingerprints compartidos sobre la unión de ambos, de 0 a 1
+	Shared      int     // Fingerprints distintos compartidos
+	Regions     []Region
+}
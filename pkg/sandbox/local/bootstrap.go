@@ -0,0 +1,64 @@
+package pkgsandbox
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// nobodyID es el uid/gid de "nobody": el usuario por defecto con el que se compila el código.
+const nobodyID = 65534
+
+// defaultRunUIDBase es el primer uid del rango con el que corren los programas, fuera de los
+// usuarios habituales del sistema.
+const defaultRunUIDBase = 200000
+
+// Bootstrap crea el sandbox a partir de las variables de entorno SANDBOX_*.
+func Bootstrap() (Service, error) {
+	workDir := os.Getenv("SANDBOX_WORK_DIR")
+	if workDir == "" {
+		workDir = filepath.Join(os.TempDir(), "sandbox")
+	}
+	goCacheDir := os.Getenv("SANDBOX_GO_CACHE_DIR")
+	if goCacheDir == "" {
+		goCacheDir = filepath.Join(workDir, "gocache")
+	}
+
+	config := newConfig(
+		workDir,
+		time.Duration(envInt("SANDBOX_RUN_TIMEOUT_SECONDS", 5))*time.Second,
+		time.Duration(envInt("SANDBOX_COMPILE_TIMEOUT_SECONDS", 120))*time.Second,
+		envInt("SANDBOX_MEMORY_LIMIT_MB", 256),
+		envInt("SANDBOX_COMPILE_MEMORY_LIMIT_MB", 2048),
+		envInt("SANDBOX_MAX_OUTPUT_KB", 64)*1024,
+		goCacheDir,
+		envBool("SANDBOX_REQUIRE_NETWORK_ISOLATION", true),
+		envInt("SANDBOX_MAX_PROCESSES", 64),
+		envInt("SANDBOX_COMPILE_MAX_PROCESSES", 512),
+		envInt("SANDBOX_UID", nobodyID),
+		envInt("SANDBOX_GID", nobodyID),
+		envInt("SANDBOX_RUN_UID_BASE", defaultRunUIDBase),
+		envInt("SANDBOX_RUN_UID_COUNT", 32),
+	)
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("sandbox config error: %w", err)
+	}
+
+	return newService(config)
+}
+
+func envInt(key string, def int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return def
+}
+
+func envBool(key string, def bool) bool {
+	if v, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,96 @@
+package pkgsandbox
+
+import (
+	"errors"
+	"time"
+)
+
+type config struct {
+	workDir                 string
+	runTimeout              time.Duration
+	compileTimeout          time.Duration
+	memoryLimitMB           int
+	compileMemoryLimitMB    int
+	maxOutputBytes          int
+	goCacheDir              string
+	requireNetworkIsolation bool
+	maxProcesses            int
+	compileMaxProcesses     int
+	runAsUID                int
+	runAsGID                int
+	runUIDBase              int
+	runUIDCount             int
+}
+
+func newConfig(
+	workDir string,
+	runTimeout, compileTimeout time.Duration,
+	memoryLimitMB, compileMemoryLimitMB, maxOutputBytes int,
+	goCacheDir string,
+	requireNetworkIsolation bool,
+	maxProcesses, compileMaxProcesses int,
+	runAsUID, runAsGID int,
+	runUIDBase, runUIDCount int,
+) Config {
+	return &config{
+		workDir:                 workDir,
+		runTimeout:              runTimeout,
+		compileTimeout:          compileTimeout,
+		memoryLimitMB:           memoryLimitMB,
+		compileMemoryLimitMB:    compileMemoryLimitMB,
+		maxOutputBytes:          maxOutputBytes,
+		goCacheDir:              goCacheDir,
+		requireNetworkIsolation: requireNetworkIsolation,
+		maxProcesses:            maxProcesses,
+		compileMaxProcesses:     compileMaxProcesses,
+		runAsUID:                runAsUID,
+		runAsGID:                runAsGID,
+		runUIDBase:              runUIDBase,
+		runUIDCount:             runUIDCount,
+	}
+}
+
+func (c *config) GetWorkDir() string               { return c.workDir }
+func (c *config) GetRunTimeout() time.Duration     { return c.runTimeout }
+func (c *config) GetCompileTimeout() time.Duration { return c.compileTimeout }
+func (c *config) GetMemoryLimitMB() int            { return c.memoryLimitMB }
+func (c *config) GetCompileMemoryLimitMB() int     { return c.compileMemoryLimitMB }
+func (c *config) GetMaxOutputBytes() int           { return c.maxOutputBytes }
+func (c *config) GetGoCacheDir() string            { return c.goCacheDir }
+func (c *config) RequireNetworkIsolation() bool    { return c.requireNetworkIsolation }
+func (c *config) GetMaxProcesses() int             { return c.maxProcesses }
+func (c *config) GetCompileMaxProcesses() int      { return c.compileMaxProcesses }
+func (c *config) GetRunAsUID() int                 { return c.runAsUID }
+func (c *config) GetRunAsGID() int                 { return c.runAsGID }
+func (c *config) GetRunUIDBase() int               { return c.runUIDBase }
+func (c *config) GetRunUIDCount() int              { return c.runUIDCount }
+
+func (c *config) Validate() error {
+	if c.workDir == "" {
+		return errors.New("sandbox work dir is not configured")
+	}
+	if c.runTimeout <= 0 || c.compileTimeout <= 0 {
+		return errors.New("sandbox timeouts must be positive")
+	}
+	if c.memoryLimitMB <= 0 || c.compileMemoryLimitMB <= 0 {
+		return errors.New("sandbox memory limits must be positive")
+	}
+	if c.maxOutputBytes <= 0 {
+		return errors.New("sandbox max output must be positive")
+	}
+	if c.maxProcesses <= 0 || c.compileMaxProcesses <= 0 {
+		return errors.New("sandbox process limits must be positive")
+	}
+	// El código no confiable nunca corre como root ni con el usuario del servidor por defecto
+	if c.runAsUID <= 0 || c.runAsGID <= 0 {
+		return errors.New("sandbox uid and gid must be positive (non-root)")
+	}
+	if c.runUIDBase <= 0 || c.runUIDCount <= 0 {
+		return errors.New("sandbox run uid range must be positive")
+	}
+	// El usuario que compila es dueño de la caché de Go: ningún programa puede correr con él
+	if c.runAsUID >= c.runUIDBase && c.runAsUID < c.runUIDBase+c.runUIDCount {
+		return errors.New("sandbox uid must be outside the run uid range")
+	}
+	return nil
+}
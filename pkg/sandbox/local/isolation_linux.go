@@ -0,0 +1,156 @@
+//go:build linux
+
+package pkgsandbox
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// initArg0 es el argv[0] con el que el sandbox se re-ejecuta a sí mismo dentro de los namespaces
+// nuevos para montar el sistema de archivos en solo lectura antes de ejecutar el programa.
+const initArg0 = "pkgsandbox-init"
+
+func init() {
+	if len(os.Args) == 0 || os.Args[0] != initArg0 {
+		return
+	}
+	// El bounding set de capabilities es por hilo: se descarta en el mismo hilo que hace el exec
+	runtime.LockOSThread()
+	if err := sandboxInit(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "sandbox init: %v\n", err)
+		os.Exit(126)
+	}
+}
+
+// isolate corre el proceso en su propio process group (para matar también a sus hijos) con el
+// usuario user. Si isolated es true, además lo corre en user, mount y network namespaces nuevos:
+// es root dentro del namespace pero en el host solo tiene los permisos de user, solo ve una
+// interfaz loopback sin levantar y el sistema de archivos es de solo lectura salvo writable.
+// El montaje lo hace el propio binario re-ejecutado como initArg0, que luego descarta todas las
+// capabilities para que el programa no pueda deshacerlo.
+func isolate(cmd *exec.Cmd, isolated bool, user identity, writable []string) {
+	attr := &syscall.SysProcAttr{
+		Setpgid:   true,
+		Pdeathsig: syscall.SIGKILL,
+	}
+	if isolated {
+		cmd.Path = "/proc/self/exe"
+		args := append([]string{initArg0, strconv.Itoa(len(writable))}, writable...)
+		cmd.Args = append(args, cmd.Args...)
+
+		attr.Cloneflags = syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS | syscall.CLONE_NEWNET
+		attr.UidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: user.uid, Size: 1}}
+		attr.GidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: user.gid, Size: 1}}
+		// Sin el setuid(0) dentro del namespace el proceso conserva el uid del servidor
+		attr.Credential = &syscall.Credential{Uid: 0, Gid: 0, NoSetGroups: true}
+	} else if os.Geteuid() == 0 {
+		// Sin user namespace el cambio de usuario lo hace el propio exec; si el servidor no es
+		// root ya corre con su usuario (lo verifica newService)
+		attr.Credential = &syscall.Credential{Uid: uint32(user.uid), Gid: uint32(user.gid), Groups: []uint32{}}
+	}
+	cmd.SysProcAttr = attr
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
+
+// sandboxInit corre dentro de los namespaces nuevos. args es la cantidad de directorios con
+// escritura, esos directorios y el comando a ejecutar.
+func sandboxInit(args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing arguments")
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 0 || len(args) < n+2 {
+		return errors.New("malformed arguments")
+	}
+	writable, argv := args[1:n+1], args[n+1:]
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get work dir: %w", err)
+	}
+	// Que los montajes no se propaguen al namespace del servidor
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to make mounts private: %w", err)
+	}
+	// Cada directorio con escritura pasa a ser un montaje propio para poder exceptuarlo
+	for _, dir := range writable {
+		if err := unix.Mount(dir, dir, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+			return fmt.Errorf("failed to bind %s: %w", dir, err)
+		}
+	}
+	if err := unix.MountSetattr(unix.AT_FDCWD, "/", unix.AT_RECURSIVE, &unix.MountAttr{Attr_set: unix.MOUNT_ATTR_RDONLY}); err != nil {
+		return fmt.Errorf("failed to make root read-only: %w", err)
+	}
+	for _, dir := range writable {
+		if err := unix.MountSetattr(unix.AT_FDCWD, dir, 0, &unix.MountAttr{Attr_clr: unix.MOUNT_ATTR_RDONLY}); err != nil {
+			return fmt.Errorf("failed to make %s writable: %w", dir, err)
+		}
+	}
+	// El directorio actual sigue apuntando al montaje de abajo, que ahora es de solo lectura
+	if err := unix.Chdir(wd); err != nil {
+		return fmt.Errorf("failed to enter work dir: %w", err)
+	}
+
+	if err := dropCapabilities(); err != nil {
+		return err
+	}
+	return unix.Exec(argv[0], argv, os.Environ())
+}
+
+// dropCapabilities vacía el bounding set y las capabilities ambient: el exec de root dentro del
+// namespace ya no recibe ninguna, así que el programa no puede volver a montar nada.
+func dropCapabilities() error {
+	for c := 0; ; c++ {
+		err := unix.Prctl(unix.PR_CAPBSET_DROP, uintptr(c), 0, 0, 0)
+		if errors.Is(err, unix.EINVAL) {
+			// Se recorrieron todas las capabilities que conoce el kernel
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to drop capability %d: %w", c, err)
+		}
+	}
+	if err := unix.Prctl(unix.PR_CAP_AMBIENT, unix.PR_CAP_AMBIENT_CLEAR_ALL, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to clear ambient capabilities: %w", err)
+	}
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to set no_new_privs: %w", err)
+	}
+	return nil
+}
+
+// killUser mata todos los procesos de uid, incluso los que dejaron su process group, antes de
+// que el uid pase a otro programa. kill(-1) no alcanza al propio shell que lo envía.
+func killUser(uid int) error {
+	cmd := exec.Command("/bin/sh", "-c", "kill -KILL -1 2>/dev/null; exit 0")
+	cmd.Env = []string{"PATH=/usr/bin:/bin"}
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: &syscall.Credential{Uid: uint32(uid), Gid: uint32(uid), Groups: []uint32{}},
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to kill processes of uid %d: %w", uid, err)
+	}
+	return nil
+}
+
+func maxRSSKB(state *os.ProcessState) int64 {
+	if usage, ok := state.SysUsage().(*syscall.Rusage); ok {
+		return usage.Maxrss
+	}
+	return 0
+}
+
+func cpuLimitExceeded(state *os.ProcessState) bool {
+	status, ok := state.Sys().(syscall.WaitStatus)
+	return ok && status.Signaled() && status.Signal() == syscall.SIGXCPU
+}
@@ -0,0 +1,31 @@
+//go:build !linux
+
+package pkgsandbox
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+// isolate no puede aislar la red ni el sistema de archivos ni cambiar de usuario fuera de Linux:
+// con isolated=true el Start falla y, salvo que SANDBOX_REQUIRE_NETWORK_ISOLATION sea true, el
+// sandbox reintenta sin aislamiento con el usuario del servidor (newService exige que coincida con uid/gid).
+func isolate(cmd *exec.Cmd, isolated bool, user identity, writable []string) {
+	if isolated {
+		cmd.Err = errors.New("network isolation is only supported on linux")
+	}
+}
+
+// killUser no hace nada: fuera de Linux no hay rango de uids por programa.
+func killUser(uid int) error {
+	return nil
+}
+
+func maxRSSKB(state *os.ProcessState) int64 {
+	return 0
+}
+
+func cpuLimitExceeded(state *os.ProcessState) bool {
+	return false
+}
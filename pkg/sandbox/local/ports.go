@@ -0,0 +1,44 @@
+package pkgsandbox
+
+import (
+	"context"
+	"time"
+)
+
+// Config define la configuración del sandbox local.
+type Config interface {
+	GetWorkDir() string
+	GetRunTimeout() time.Duration
+	GetCompileTimeout() time.Duration
+	GetMemoryLimitMB() int
+	GetCompileMemoryLimitMB() int
+	GetMaxOutputBytes() int
+	GetGoCacheDir() string
+	RequireNetworkIsolation() bool
+	GetMaxProcesses() int
+	GetCompileMaxProcesses() int
+	GetRunAsUID() int
+	GetRunAsGID() int
+	GetRunUIDBase() int
+	GetRunUIDCount() int
+	Validate() error
+}
+
+// Service prepara y ejecuta código no confiable en procesos separados, cada uno con su
+// directorio temporal, límites de recursos, timeout, sin acceso a la red, con el sistema de archivos
+// en solo lectura salvo su directorio y con un usuario sin privilegios propio de cada programa.
+type Service interface {
+	// Languages devuelve los lenguajes cuyo toolchain está disponible en el host.
+	Languages() []Language
+	// Prepare escribe el código en un directorio temporal y lo compila si el lenguaje lo requiere.
+	// Si no compila devuelve un *CompileError con la salida del compilador.
+	Prepare(ctx context.Context, lang Language, source string) (Program, error)
+}
+
+// Program es un programa listo para ejecutarse. Close borra su directorio temporal.
+type Program interface {
+	// Run ejecuta el programa con input como stdin. Un timeout o un exit code distinto de
+	// cero no son errores: quedan reflejados en la Execution.
+	Run(ctx context.Context, input string) (*Execution, error)
+	Close() error
+}
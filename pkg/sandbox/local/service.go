@@ -0,0 +1,371 @@
+package pkgsandbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Límites fijos de los procesos del sandbox; memoria y tiempo salen de Config.
+const (
+	maxOpenFiles  = 64
+	maxFileBlocks = 131072 // 64 MB en bloques de 512 bytes (ulimit -f)
+)
+
+// toolchains es el binario que se busca en el PATH para cada lenguaje.
+var toolchains = map[Language]string{
+	LanguageGo:         "go",
+	LanguagePython:     "python3",
+	LanguageJavaScript: "node",
+}
+
+// identity es el usuario y grupo del host con el que corre un proceso del sandbox.
+type identity struct {
+	uid int
+	gid int
+}
+
+type service struct {
+	config    Config
+	binaries  map[Language]string
+	languages []Language
+	// runUIDs son los uids libres del rango de ejecución; cada programa toma uno propio hasta su
+	// Close. Es nil si el servidor no es root: entonces todo corre con el usuario del servidor.
+	runUIDs chan int
+}
+
+func newService(config Config) (Service, error) {
+	// Solo root puede correr los procesos con otro usuario; sin root, uid/gid deben ser los del servidor
+	if os.Geteuid() != 0 && (config.GetRunAsUID() != os.Geteuid() || config.GetRunAsGID() != os.Getegid()) {
+		return nil, fmt.Errorf("sandbox uid/gid %d/%d require running as root (or set SANDBOX_UID/SANDBOX_GID to the server user)",
+			config.GetRunAsUID(), config.GetRunAsGID())
+	}
+
+	// El work dir se puede atravesar pero no listar: cada ejecución solo conoce su propio directorio
+	if err := os.MkdirAll(config.GetWorkDir(), 0o711); err != nil {
+		return nil, fmt.Errorf("failed to create sandbox work dir: %w", err)
+	}
+	if err := os.Chmod(config.GetWorkDir(), 0o711); err != nil {
+		return nil, fmt.Errorf("failed to set sandbox work dir permissions: %w", err)
+	}
+	s := &service{config: config, binaries: make(map[Language]string)}
+	if os.Geteuid() == 0 {
+		// go build escribe la caché compartida con el usuario que compila, que nunca ejecuta
+		// programas: el código de un candidato no puede alterar lo que compilan los demás
+		if err := os.MkdirAll(config.GetGoCacheDir(), 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create sandbox go cache dir: %w", err)
+		}
+		err := filepath.WalkDir(config.GetGoCacheDir(), func(path string, _ fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Lchown(path, config.GetRunAsUID(), config.GetRunAsGID())
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to chown sandbox go cache dir: %w", err)
+		}
+
+		s.runUIDs = make(chan int, config.GetRunUIDCount())
+		for i := 0; i < config.GetRunUIDCount(); i++ {
+			s.runUIDs <- config.GetRunUIDBase() + i
+		}
+	}
+
+	for lang, name := range toolchains {
+		if path, err := exec.LookPath(name); err == nil {
+			s.binaries[lang] = path
+			s.languages = append(s.languages, lang)
+		}
+	}
+	slices.Sort(s.languages)
+	return s, nil
+}
+
+func (s *service) Languages() []Language {
+	return slices.Clone(s.languages)
+}
+
+func (s *service) Prepare(ctx context.Context, lang Language, source string) (Program, error) {
+	bin, ok := s.binaries[lang]
+	if !ok {
+		return nil, fmt.Errorf("language %s is not available in the sandbox", lang)
+	}
+
+	user, err := s.acquireRunUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp(s.config.GetWorkDir(), "run-")
+	if err != nil {
+		s.releaseRunUser(user)
+		return nil, fmt.Errorf("failed to create sandbox dir: %w", err)
+	}
+	p := &program{service: s, dir: dir, runDir: filepath.Join(dir, "run"), user: user}
+
+	// dir queda del servidor y solo se atraviesa; run es del usuario del programa y build,
+	// si hace falta compilar, del usuario que compila
+	err = os.Chmod(dir, 0o711)
+	if err == nil {
+		err = s.mkdir(p.runDir, user)
+	}
+	if err == nil {
+		switch lang {
+		case LanguageGo:
+			err = s.compileGo(ctx, p, bin, source)
+		case LanguagePython:
+			err = writeSource(p.runDir, "main.py", source, user)
+			p.argv = []string{bin, "-I", "main.py"}
+		case LanguageJavaScript:
+			err = writeSource(p.runDir, "main.js", source, user)
+			p.argv = []string{bin, "main.js"}
+		}
+	}
+	if err != nil {
+		p.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+// acquireRunUser reserva un uid del rango de ejecución para un programa, esperando a que se
+// libere uno si están todos en uso. Sin root el programa corre con el usuario del servidor.
+func (s *service) acquireRunUser(ctx context.Context) (identity, error) {
+	if s.runUIDs == nil {
+		return s.buildUser(), nil
+	}
+	select {
+	case uid := <-s.runUIDs:
+		return identity{uid: uid, gid: uid}, nil
+	case <-ctx.Done():
+		return identity{}, fmt.Errorf("no sandbox user available: %w", ctx.Err())
+	}
+}
+
+// releaseRunUser devuelve el uid al rango. Quien lo libera ya mató sus procesos y borró sus archivos.
+func (s *service) releaseRunUser(user identity) {
+	if s.runUIDs != nil {
+		s.runUIDs <- user.uid
+	}
+}
+
+// buildUser es el usuario que compila y es dueño de la caché de Go compartida.
+func (s *service) buildUser() identity {
+	return identity{uid: s.config.GetRunAsUID(), gid: s.config.GetRunAsGID()}
+}
+
+func (s *service) compileGo(ctx context.Context, p *program, bin, source string) error {
+	buildDir := filepath.Join(p.dir, "build")
+	builder := s.buildUser()
+	if err := s.mkdir(buildDir, builder); err != nil {
+		return err
+	}
+	// El programa tiene que poder ejecutar el binario, pero no modificarlo
+	if err := os.Chmod(buildDir, 0o711); err != nil {
+		return fmt.Errorf("failed to set build dir permissions: %w", err)
+	}
+	if err := writeSource(buildDir, "main.go", source, builder); err != nil {
+		return err
+	}
+
+	// Sin root el que compila es el mismo usuario que ejecuta, así que la caché no se comparte
+	goCache := filepath.Join(buildDir, "gocache")
+	writable := []string{buildDir}
+	if s.runUIDs != nil {
+		goCache = s.config.GetGoCacheDir()
+		writable = append(writable, goCache)
+	}
+
+	env := append(baseEnv(buildDir),
+		"GOCACHE="+goCache,
+		"GOPATH="+filepath.Join(buildDir, "gopath"),
+		"GOTOOLCHAIN=local",
+		"GOPROXY=off",
+		"CGO_ENABLED=0",
+	)
+	limits := processLimits{
+		timeout:   s.config.GetCompileTimeout(),
+		memoryMB:  s.config.GetCompileMemoryLimitMB(),
+		processes: s.config.GetCompileMaxProcesses(),
+	}
+
+	build, err := s.run(ctx, buildDir, builder, writable, limits, env, "", bin, "build", "-o", "prog", "main.go")
+	if err != nil {
+		return fmt.Errorf("failed to run go build: %w", err)
+	}
+	if build.TimedOut {
+		return &CompileError{Output: "compilation timed out"}
+	}
+	if build.ExitCode != 0 {
+		return &CompileError{Output: strings.TrimSpace(build.Stderr + build.Stdout)}
+	}
+
+	p.argv = []string{filepath.Join(buildDir, "prog")}
+	return nil
+}
+
+// mkdir crea dir como privado de user, que es quien lee y escribe en él.
+func (s *service) mkdir(dir string, user identity) error {
+	if err := os.Mkdir(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create sandbox dir: %w", err)
+	}
+	return chown(dir, user)
+}
+
+func chown(path string, user identity) error {
+	if err := os.Lchown(path, user.uid, user.gid); err != nil {
+		return fmt.Errorf("failed to chown %s to the sandbox user: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+type processLimits struct {
+	timeout   time.Duration
+	memoryMB  int
+	processes int // RLIMIT_NPROC: procesos e hilos del usuario del sandbox (ulimit -u)
+}
+
+// run ejecuta argv en dir como user a través de /bin/sh para aplicar los rlimits con ulimit antes
+// del exec. Aislado, solo los directorios de writable admiten escritura.
+func (s *service) run(ctx context.Context, dir string, user identity, writable []string, limits processLimits, env []string, input string, argv ...string) (*Execution, error) {
+	ctx, cancel := context.WithTimeout(ctx, limits.timeout)
+	defer cancel()
+
+	cpuSeconds := int(limits.timeout/time.Second) + 1
+	// RLIMIT_NPROC es -u en bash/busybox y -p en dash
+	script := fmt.Sprintf("ulimit -d %d && ulimit -t %d && ulimit -f %d && ulimit -n %d && { ulimit -u %d 2>/dev/null || ulimit -p %d; } && exec \"$@\"",
+		limits.memoryMB*1024, cpuSeconds, maxFileBlocks, maxOpenFiles, limits.processes, limits.processes)
+
+	maxOutput := s.config.GetMaxOutputBytes()
+	stdout := &limitedBuffer{limit: maxOutput}
+	stderr := &limitedBuffer{limit: maxOutput}
+
+	newCmd := func(isolated bool) *exec.Cmd {
+		cmd := exec.CommandContext(ctx, "/bin/sh", append([]string{"-c", script, "sandbox"}, argv...)...)
+		cmd.Dir = dir
+		cmd.Env = env
+		cmd.Stdin = strings.NewReader(input)
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+		cmd.WaitDelay = time.Second
+		isolate(cmd, isolated, user, writable)
+		return cmd
+	}
+
+	cmd := newCmd(true)
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		if s.config.RequireNetworkIsolation() {
+			return nil, fmt.Errorf("failed to start isolated process: %w", err)
+		}
+		// Sin namespaces disponibles (p. ej. contenedores sin user namespaces) se corre sin aislar la
+		// red ni el sistema de archivos
+		cmd = newCmd(false)
+		start = time.Now()
+		if err := cmd.Start(); err != nil {
+			return nil, fmt.Errorf("failed to start process: %w", err)
+		}
+	}
+	err := cmd.Wait()
+
+	result := &Execution{
+		Stdout:          stdout.String(),
+		Stderr:          stderr.String(),
+		Duration:        time.Since(start),
+		TimedOut:        errors.Is(ctx.Err(), context.DeadlineExceeded),
+		OutputTruncated: stdout.truncated || stderr.truncated,
+	}
+	if state := cmd.ProcessState; state != nil {
+		result.ExitCode = state.ExitCode()
+		result.MemoryKB = maxRSSKB(state)
+		result.TimedOut = result.TimedOut || cpuLimitExceeded(state)
+	}
+
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) && !result.TimedOut {
+		return nil, err
+	}
+	return result, nil
+}
+
+// baseEnv es el entorno mínimo de los procesos del sandbox; no hereda variables del servidor.
+func baseEnv(dir string) []string {
+	return []string{
+		"PATH=/usr/local/bin:/usr/bin:/bin",
+		"HOME=" + dir,
+		"TMPDIR=" + dir,
+		"LANG=C.UTF-8",
+	}
+}
+
+type program struct {
+	service *service
+	dir     string
+	runDir  string // Directorio de trabajo del programa, el único en el que puede escribir
+	user    identity
+	argv    []string
+	close   sync.Once
+	err     error
+}
+
+func writeSource(dir, name, source string, user identity) error {
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(source), 0o600); err != nil {
+		return fmt.Errorf("failed to write source: %w", err)
+	}
+	return chown(path, user)
+}
+
+func (p *program) Run(ctx context.Context, input string) (*Execution, error) {
+	limits := processLimits{
+		timeout:   p.service.config.GetRunTimeout(),
+		memoryMB:  p.service.config.GetMemoryLimitMB(),
+		processes: p.service.config.GetMaxProcesses(),
+	}
+	return p.service.run(ctx, p.runDir, p.user, []string{p.runDir}, limits, baseEnv(p.runDir), input, p.argv...)
+}
+
+// Close mata lo que haya quedado corriendo con el uid del programa (aunque haya dejado su process
+// group) y borra sus archivos antes de devolver el uid, para que el siguiente programa no
+// comparta usuario con procesos ni archivos de otro.
+func (p *program) Close() error {
+	p.close.Do(func() {
+		var killErr error
+		if p.service.runUIDs != nil {
+			killErr = killUser(p.user.uid)
+		}
+		p.err = errors.Join(killErr, os.RemoveAll(p.dir))
+		p.service.releaseRunUser(p.user)
+	})
+	return p.err
+}
+
+// limitedBuffer guarda hasta limit bytes y descarta el resto sin cortar al proceso.
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.buf.Len(); room < len(p) {
+		b.truncated = true
+		if room > 0 {
+			b.buf.Write(p[:room])
+		}
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *limitedBuffer) String() string {
+	return b.buf.String()
+}
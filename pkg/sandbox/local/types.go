@@ -0,0 +1,50 @@
+package pkgsandbox
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Language identifica un lenguaje soportado por el sandbox.
+type Language string
+
+const (
+	LanguageGo         Language = "go"
+	LanguagePython     Language = "python"
+	LanguageJavaScript Language = "javascript"
+)
+
+// ParseLanguage normaliza los nombres habituales de cada lenguaje ("golang", "py", "js", ...).
+func ParseLanguage(name string) (Language, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "go", "golang":
+		return LanguageGo, nil
+	case "python", "python3", "py":
+		return LanguagePython, nil
+	case "javascript", "js", "node", "nodejs":
+		return LanguageJavaScript, nil
+	default:
+		return "", fmt.Errorf("unsupported language %q", name)
+	}
+}
+
+// Execution es el resultado de una ejecución del programa.
+type Execution struct {
+	Stdout          string
+	Stderr          string
+	ExitCode        int
+	Duration        time.Duration
+	MemoryKB        int64 // Pico de memoria residente (max RSS)
+	TimedOut        bool
+	OutputTruncated bool
+}
+
+// CompileError indica que el código no compiló; Output es la salida del compilador.
+type CompileError struct {
+	Output string
+}
+
+func (e *CompileError) Error() string {
+	return "compilation failed: " + e.Output
+}
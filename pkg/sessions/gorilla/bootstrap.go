@@ -1,18 +1,110 @@
 package pkgsession
 
 import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/spf13/viper"
 )
 
-// Bootstrap inicializa el gestor de sesiones con la configuración necesaria
+// Valores por defecto seguros para la cookie de sesión.
+const (
+	defaultMaxAge          = 86400 // 1 día
+	defaultPath            = "/"
+	defaultSameSite        = "lax"
+	defaultRedisKeyPrefix  = "session:"
+	defaultPostgresTable   = "http_sessions"
+	defaultCleanupInterval = 10 // minutos
+)
+
+// Bootstrap inicializa el gestor de sesiones basado en cookies (sin estado en el servidor)
 func Bootstrap() (SessionManager, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return newSessionManager(config)
+}
+
+// BootstrapRedis inicializa un gestor de sesiones server-side respaldado por Redis
+func BootstrapRedis(client *redis.Client) (SessionManager, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return newServerSessionManager(config, newRedisBackend(client, config.GetRedisKeyPrefix()))
+}
+
+// BootstrapPostgres inicializa un gestor de sesiones server-side respaldado por Postgres.
+// La tabla de sesiones debe existir (la crean las migraciones); lanza la limpieza periódica de sesiones expiradas.
+func BootstrapPostgres(ctx context.Context, pool *pgxpool.Pool) (SessionManager, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	pgBackend, err := newPostgresBackend(ctx, pool, config.GetPostgresTable(), config.GetCleanupInterval())
+	if err != nil {
+		return nil, err
+	}
+
+	return newServerSessionManager(config, pgBackend)
+}
+
+func loadConfig() (Config, error) {
 	config := newConfig(
 		viper.GetString("GORILLA_SESSION_SECRET_KEY"),
+		splitKeys(viper.GetString("GORILLA_SESSION_KEYS")),
+		getInt("GORILLA_SESSION_MAX_AGE", defaultMaxAge),
+		getString("GORILLA_SESSION_COOKIE_PATH", defaultPath),
+		viper.GetString("GORILLA_SESSION_COOKIE_DOMAIN"),
+		getBool("GORILLA_SESSION_COOKIE_SECURE", true),
+		getBool("GORILLA_SESSION_COOKIE_HTTP_ONLY", true),
+		getString("GORILLA_SESSION_COOKIE_SAME_SITE", defaultSameSite),
+		getString("GORILLA_SESSION_REDIS_PREFIX", defaultRedisKeyPrefix),
+		getString("GORILLA_SESSION_PG_TABLE", defaultPostgresTable),
+		time.Duration(getInt("GORILLA_SESSION_CLEANUP_INTERVAL_MINUTES", defaultCleanupInterval))*time.Minute,
 	)
 
 	if err := config.Validate(); err != nil {
 		return nil, err
 	}
+	return config, nil
+}
 
-	return newSessionManager(config)
+// splitKeys separa la lista de claves (más nueva primero) separadas por coma.
+func splitKeys(raw string) []string {
+	keys := make([]string, 0)
+	for _, key := range strings.Split(raw, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func getString(key, defaultVal string) string {
+	if viper.IsSet(key) {
+		return viper.GetString(key)
+	}
+	return defaultVal
+}
+
+func getInt(key string, defaultVal int) int {
+	if viper.IsSet(key) {
+		return viper.GetInt(key)
+	}
+	return defaultVal
+}
+
+func getBool(key string, defaultVal bool) bool {
+	if viper.IsSet(key) {
+		return viper.GetBool(key)
+	}
+	return defaultVal
 }
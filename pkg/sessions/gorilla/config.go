@@ -2,16 +2,53 @@ package pkgsession
 
 import (
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gorilla/sessions"
 )
 
+// tableNamePattern restringe el nombre de la tabla de Postgres, que se interpola en las queries.
+var tableNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
 type config struct {
-	secretKey string
+	secretKey       string
+	keys            []string // Pares "hashKey[:blockKey]", el primero firma y el resto solo verifica (rotación)
+	maxAge          int      // Segundos
+	path            string
+	domain          string
+	secure          bool
+	httpOnly        bool
+	sameSite        string
+	redisKeyPrefix  string
+	postgresTable   string
+	cleanupInterval time.Duration
 }
 
 // newConfig crea una nueva configuración para Gorilla Sessions
-func newConfig(secretKey string) Config {
+func newConfig(
+	secretKey string,
+	keys []string,
+	maxAge int,
+	path, domain string,
+	secure, httpOnly bool,
+	sameSite, redisKeyPrefix, postgresTable string,
+	cleanupInterval time.Duration,
+) Config {
 	return &config{
-		secretKey: secretKey,
+		secretKey:       secretKey,
+		keys:            keys,
+		maxAge:          maxAge,
+		path:            path,
+		domain:          domain,
+		secure:          secure,
+		httpOnly:        httpOnly,
+		sameSite:        sameSite,
+		redisKeyPrefix:  redisKeyPrefix,
+		postgresTable:   postgresTable,
+		cleanupInterval: cleanupInterval,
 	}
 }
 
@@ -20,9 +57,89 @@ func (c *config) GetSecretKey() string {
 	return c.secretKey
 }
 
+// GetKeyPairs retorna los pares hash/block en el formato esperado por securecookie.CodecsFromPairs.
+// Si no hay claves configuradas se usa la clave secreta como única clave de firma.
+func (c *config) GetKeyPairs() [][]byte {
+	if len(c.keys) == 0 {
+		return [][]byte{[]byte(c.secretKey), nil}
+	}
+
+	pairs := make([][]byte, 0, len(c.keys)*2)
+	for _, key := range c.keys {
+		hashKey, blockKey, _ := strings.Cut(key, ":")
+		var block []byte
+		if blockKey != "" {
+			block = []byte(blockKey)
+		}
+		pairs = append(pairs, []byte(hashKey), block)
+	}
+	return pairs
+}
+
+// GetOptions retorna las opciones de la cookie de sesión.
+func (c *config) GetOptions() *sessions.Options {
+	return &sessions.Options{
+		Path:     c.path,
+		Domain:   c.domain,
+		MaxAge:   c.maxAge,
+		Secure:   c.secure,
+		HttpOnly: c.httpOnly,
+		SameSite: parseSameSite(c.sameSite),
+	}
+}
+
+func (c *config) GetRedisKeyPrefix() string {
+	return c.redisKeyPrefix
+}
+
+func (c *config) GetPostgresTable() string {
+	return c.postgresTable
+}
+
+func (c *config) GetCleanupInterval() time.Duration {
+	return c.cleanupInterval
+}
+
 func (c *config) Validate() error {
-	if c.secretKey == "" {
-		return fmt.Errorf("GORILLA_SESSION_SECRET_KEY is required")
+	if c.secretKey == "" && len(c.keys) == 0 {
+		return fmt.Errorf("GORILLA_SESSION_SECRET_KEY or GORILLA_SESSION_KEYS is required")
+	}
+	for _, key := range c.keys {
+		hashKey, blockKey, _ := strings.Cut(key, ":")
+		if hashKey == "" {
+			return fmt.Errorf("GORILLA_SESSION_KEYS contains an empty hash key")
+		}
+		if n := len(blockKey); n != 0 && n != 16 && n != 24 && n != 32 {
+			return fmt.Errorf("GORILLA_SESSION_KEYS block keys must be 16, 24 or 32 bytes long")
+		}
+	}
+	if c.maxAge < 0 {
+		return fmt.Errorf("GORILLA_SESSION_MAX_AGE must be a non-negative integer")
+	}
+	switch strings.ToLower(c.sameSite) {
+	case "", "lax", "strict", "none":
+	default:
+		return fmt.Errorf("GORILLA_SESSION_COOKIE_SAME_SITE must be one of lax, strict or none")
+	}
+	if strings.EqualFold(c.sameSite, "none") && !c.secure {
+		return fmt.Errorf("GORILLA_SESSION_COOKIE_SAME_SITE=none requires secure cookies")
+	}
+	if !tableNamePattern.MatchString(c.postgresTable) {
+		return fmt.Errorf("GORILLA_SESSION_PG_TABLE is not a valid table name")
+	}
+	if c.cleanupInterval < 0 {
+		return fmt.Errorf("GORILLA_SESSION_CLEANUP_INTERVAL_MINUTES must be a non-negative integer")
 	}
 	return nil
 }
+
+func parseSameSite(value string) http.SameSite {
+	switch strings.ToLower(value) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
+	}
+}
@@ -1,20 +1,54 @@
 package pkgsession
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/sessions"
 )
 
+// UserIDKey es la clave de session.Values que asocia la sesión a un usuario.
+// Los stores server-side la usan para listar y revocar las sesiones de cada usuario.
+const UserIDKey = "user_id"
+
 // SessionManager define la interfaz para el manejo de sesiones
 type SessionManager interface {
 	Get(*http.Request, string) (*sessions.Session, error)
 	Save(*http.Request, http.ResponseWriter, *sessions.Session) error
 	New(*http.Request, string) (*sessions.Session, error)
+	Destroy(*http.Request, http.ResponseWriter, *sessions.Session) error
+	ListUserSessions(context.Context, string) ([]SessionInfo, error)
+	RevokeSession(context.Context, string) error
+	RevokeUserSessions(context.Context, string) error
+	Close()
 }
 
 // Config define la interfaz de la configuración de sesiones
 type Config interface {
 	GetSecretKey() string
+	GetKeyPairs() [][]byte
+	GetOptions() *sessions.Options
+	GetRedisKeyPrefix() string
+	GetPostgresTable() string
+	GetCleanupInterval() time.Duration
 	Validate() error
 }
+
+// SessionInfo describe una sesión server-side sin exponer sus valores.
+type SessionInfo struct {
+	ID        string
+	UserID    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// backend es el almacenamiento de las sesiones server-side (Redis, Postgres).
+type backend interface {
+	load(context.Context, string) ([]byte, error)
+	save(context.Context, string, string, []byte, time.Duration) error
+	delete(context.Context, string) error
+	listByUser(context.Context, string) ([]SessionInfo, error)
+	deleteByUser(context.Context, string) error
+	close()
+}
@@ -0,0 +1,158 @@
+package pkgsession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// postgresBackend guarda las sesiones en una tabla con expires_at y las purga periódicamente.
+type postgresBackend struct {
+	pool  *pgxpool.Pool
+	table string
+	stop  chan struct{}
+}
+
+func newPostgresBackend(ctx context.Context, pool *pgxpool.Pool, table string, cleanupInterval time.Duration) (*postgresBackend, error) {
+	if pool == nil {
+		return nil, fmt.Errorf("postgres pool cannot be nil")
+	}
+
+	b := &postgresBackend{
+		pool:  pool,
+		table: table,
+		stop:  make(chan struct{}),
+	}
+
+	if err := b.checkTable(ctx); err != nil {
+		return nil, err
+	}
+
+	if cleanupInterval > 0 {
+		go b.cleanupLoop(cleanupInterval)
+	}
+	return b, nil
+}
+
+// checkTable verifica que la tabla de sesiones exista. El backend no ejecuta DDL al arrancar: la
+// aplicación que lo use debe crear la tabla en sus migraciones con este esquema:
+//
+//	CREATE TABLE http_sessions (
+//	    id         TEXT PRIMARY KEY,
+//	    user_id    TEXT NOT NULL DEFAULT '',
+//	    data       BYTEA NOT NULL,
+//	    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+//	    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+//	    expires_at TIMESTAMPTZ NOT NULL
+//	);
+//	CREATE INDEX http_sessions_user_id_idx ON http_sessions (user_id);
+//	CREATE INDEX http_sessions_expires_at_idx ON http_sessions (expires_at);
+func (b *postgresBackend) checkTable(ctx context.Context) error {
+	var exists bool
+	if err := b.pool.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, b.table).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check sessions table: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("sessions table %q does not exist, run the database migrations", b.table)
+	}
+	return nil
+}
+
+func (b *postgresBackend) load(ctx context.Context, id string) ([]byte, error) {
+	var data []byte
+	query := fmt.Sprintf(`SELECT data FROM %s WHERE id = $1 AND expires_at > now()`, b.table)
+	if err := b.pool.QueryRow(ctx, query, id).Scan(&data); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to load session: %w", err)
+	}
+	return data, nil
+}
+
+func (b *postgresBackend) save(ctx context.Context, id, userID string, data []byte, ttl time.Duration) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, data, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE
+		SET user_id = EXCLUDED.user_id, data = EXCLUDED.data, updated_at = now(), expires_at = EXCLUDED.expires_at`, b.table)
+
+	if _, err := b.pool.Exec(ctx, query, id, userID, data, time.Now().Add(ttl)); err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+	return nil
+}
+
+func (b *postgresBackend) delete(ctx context.Context, id string) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, b.table)
+	if _, err := b.pool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+func (b *postgresBackend) listByUser(ctx context.Context, userID string) ([]SessionInfo, error) {
+	query := fmt.Sprintf(`
+		SELECT id, created_at, expires_at FROM %s
+		WHERE user_id = $1 AND expires_at > now()
+		ORDER BY created_at`, b.table)
+
+	rows, err := b.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user sessions: %w", err)
+	}
+	defer rows.Close()
+
+	infos := make([]SessionInfo, 0)
+	for rows.Next() {
+		info := SessionInfo{UserID: userID}
+		if err := rows.Scan(&info.ID, &info.CreatedAt, &info.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		infos = append(infos, info)
+	}
+	return infos, rows.Err()
+}
+
+func (b *postgresBackend) deleteByUser(ctx context.Context, userID string) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1`, b.table)
+	if _, err := b.pool.Exec(ctx, query, userID); err != nil {
+		return fmt.Errorf("failed to revoke user sessions: %w", err)
+	}
+	return nil
+}
+
+// deleteExpired purga las sesiones vencidas; load ya las ignora, esto solo libera espacio.
+func (b *postgresBackend) deleteExpired(ctx context.Context) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE expires_at <= now()`, b.table)
+	_, err := b.pool.Exec(ctx, query)
+	return err
+}
+
+func (b *postgresBackend) cleanupLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			if err := b.deleteExpired(ctx); err != nil {
+				log.Printf("failed to purge expired sessions: %v", err)
+			}
+			cancel()
+		case <-b.stop:
+			return
+		}
+	}
+}
+
+// close detiene la limpieza periódica; el pool pertenece a quien lo creó.
+func (b *postgresBackend) close() {
+	close(b.stop)
+}
@@ -0,0 +1,160 @@
+package pkgsession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// extendTTLScript solo extiende el TTL de una clave: lo deja en max(actual, nuevo). El índice
+// por usuario vive tanto como su sesión más larga, así una sesión corta no acorta al resto.
+var extendTTLScript = redis.NewScript(`
+local current = redis.call("PTTL", KEYS[1])
+local ttl = tonumber(ARGV[1])
+if current >= 0 and current >= ttl then
+	return 0
+end
+return redis.call("PEXPIRE", KEYS[1], ttl)
+`)
+
+// redisBackend guarda cada sesión en un hash con TTL y mantiene un set de IDs por usuario.
+type redisBackend struct {
+	client *redis.Client
+	prefix string
+}
+
+func newRedisBackend(client *redis.Client, prefix string) *redisBackend {
+	return &redisBackend{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (b *redisBackend) sessionKey(id string) string {
+	return b.prefix + "data:" + id
+}
+
+func (b *redisBackend) userKey(userID string) string {
+	return b.prefix + "user:" + userID
+}
+
+func (b *redisBackend) load(ctx context.Context, id string) ([]byte, error) {
+	data, err := b.client.HGet(ctx, b.sessionKey(id), "data").Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to load session: %w", err)
+	}
+	return data, nil
+}
+
+func (b *redisBackend) save(ctx context.Context, id, userID string, data []byte, ttl time.Duration) error {
+	key := b.sessionKey(id)
+
+	// Si la sesión cambia de dueño (logout y login de otro usuario) se saca del índice del anterior
+	previousUserID, err := b.client.HGet(ctx, key, "user_id").Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("failed to load session owner: %w", err)
+	}
+
+	_, err = b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if previousUserID != "" && previousUserID != userID {
+			pipe.SRem(ctx, b.userKey(previousUserID), id)
+		}
+		pipe.HSet(ctx, key, "data", data, "user_id", userID)
+		pipe.HSetNX(ctx, key, "created_at", time.Now().Unix())
+		pipe.Expire(ctx, key, ttl)
+		if userID != "" {
+			pipe.SAdd(ctx, b.userKey(userID), id)
+			extendTTLScript.Eval(ctx, pipe, []string{b.userKey(userID)}, ttl.Milliseconds())
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+	return nil
+}
+
+func (b *redisBackend) delete(ctx context.Context, id string) error {
+	key := b.sessionKey(id)
+
+	userID, err := b.client.HGet(ctx, key, "user_id").Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("failed to load session owner: %w", err)
+	}
+
+	_, err = b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		if userID != "" {
+			pipe.SRem(ctx, b.userKey(userID), id)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+func (b *redisBackend) listByUser(ctx context.Context, userID string) ([]SessionInfo, error) {
+	ids, err := b.client.SMembers(ctx, b.userKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user sessions: %w", err)
+	}
+
+	infos := make([]SessionInfo, 0, len(ids))
+	for _, id := range ids {
+		key := b.sessionKey(id)
+
+		createdAt, err := b.client.HGet(ctx, key, "created_at").Result()
+		if errors.Is(err, redis.Nil) {
+			// La sesión expiró: se limpia el índice del usuario
+			b.client.SRem(ctx, b.userKey(userID), id)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load session %s: %w", id, err)
+		}
+
+		ttl, err := b.client.PTTL(ctx, key).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load session %s ttl: %w", id, err)
+		}
+
+		created, _ := strconv.ParseInt(createdAt, 10, 64)
+		infos = append(infos, SessionInfo{
+			ID:        id,
+			UserID:    userID,
+			CreatedAt: time.Unix(created, 0),
+			ExpiresAt: time.Now().Add(ttl),
+		})
+	}
+	return infos, nil
+}
+
+func (b *redisBackend) deleteByUser(ctx context.Context, userID string) error {
+	ids, err := b.client.SMembers(ctx, b.userKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("failed to list user sessions: %w", err)
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, b.sessionKey(id))
+	}
+	keys = append(keys, b.userKey(userID))
+
+	if err := b.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to revoke user sessions: %w", err)
+	}
+	return nil
+}
+
+// close no cierra el cliente: su ciclo de vida pertenece a quien lo creó.
+func (b *redisBackend) close() {}
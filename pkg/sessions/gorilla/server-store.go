@@ -0,0 +1,117 @@
+package pkgsession
+
+import (
+	"encoding/base32"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+)
+
+// defaultServerTTL se usa cuando la cookie es de sesión de navegador (MaxAge = 0).
+const defaultServerTTL = 24 * time.Hour
+
+// errSessionNotFound indica que la sesión no existe, expiró o fue revocada.
+var errSessionNotFound = errors.New("session not found")
+
+// serverStore implementa sessions.Store guardando los valores en un backend.
+// La expiración la controla el backend, por lo que revocar una sesión la invalida aunque la cookie siga vigente.
+type serverStore struct {
+	codecs     []securecookie.Codec
+	options    *sessions.Options
+	backend    backend
+	serializer securecookie.GobEncoder
+}
+
+func newServerStore(b backend, options *sessions.Options, keyPairs ...[]byte) *serverStore {
+	codecs := securecookie.CodecsFromPairs(keyPairs...)
+	for _, codec := range codecs {
+		if sc, ok := codec.(*securecookie.SecureCookie); ok {
+			sc.MaxAge(options.MaxAge)
+		}
+	}
+
+	return &serverStore{
+		codecs:  codecs,
+		options: options,
+		backend: b,
+	}
+}
+
+func (s *serverStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(s, name)
+}
+
+func (s *serverStore) New(r *http.Request, name string) (*sessions.Session, error) {
+	session := sessions.NewSession(s, name)
+	opts := *s.options
+	session.Options = &opts
+	session.IsNew = true
+
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return session, nil
+	}
+
+	// Con rotación de claves, DecodeMulti acepta cookies firmadas con cualquiera de los pares configurados.
+	if err := securecookie.DecodeMulti(name, cookie.Value, &session.ID, s.codecs...); err != nil {
+		return session, err
+	}
+
+	data, err := s.backend.load(r.Context(), session.ID)
+	if err != nil {
+		session.ID = ""
+		if errors.Is(err, errSessionNotFound) {
+			return session, nil
+		}
+		return session, err
+	}
+
+	if err := s.serializer.Deserialize(data, &session.Values); err != nil {
+		return session, err
+	}
+	session.IsNew = false
+	return session, nil
+}
+
+func (s *serverStore) Save(r *http.Request, w http.ResponseWriter, session *sessions.Session) error {
+	if session.Options.MaxAge < 0 {
+		if session.ID != "" {
+			if err := s.backend.delete(r.Context(), session.ID); err != nil {
+				return err
+			}
+		}
+		http.SetCookie(w, sessions.NewCookie(session.Name(), "", session.Options))
+		return nil
+	}
+
+	if session.ID == "" {
+		session.ID = strings.TrimRight(base32.StdEncoding.EncodeToString(securecookie.GenerateRandomKey(32)), "=")
+	}
+
+	data, err := s.serializer.Serialize(session.Values)
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Duration(session.Options.MaxAge) * time.Second
+	if ttl == 0 {
+		ttl = defaultServerTTL
+	}
+
+	userID, _ := session.Values[UserIDKey].(string)
+	if err := s.backend.save(r.Context(), session.ID, userID, data, ttl); err != nil {
+		return err
+	}
+
+	// EncodeMulti firma con el primer par de claves, así las cookies migran a la clave nueva al guardarse.
+	encoded, err := securecookie.EncodeMulti(session.Name(), session.ID, s.codecs...)
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, sessions.NewCookie(session.Name(), encoded, session.Options))
+	return nil
+}
@@ -1,42 +1,47 @@
 package pkgsession
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
-	"sync"
 
 	"github.com/gorilla/sessions"
 )
 
-var (
-	instance SessionManager
-	once     sync.Once
-)
+// ErrServerSideRequired indica que la operación requiere un store server-side (Redis o Postgres).
+var ErrServerSideRequired = errors.New("operation requires a server-side session store")
 
 type sessionManager struct {
-	store *sessions.CookieStore
+	store   sessions.Store
+	backend backend // nil cuando las sesiones viven solo en la cookie
 }
 
-// newSessionManager es una función que crea una única instancia del manejador de sesiones
+// newSessionManager crea un manejador de sesiones basado en cookies firmadas/encriptadas
 func newSessionManager(c Config) (SessionManager, error) {
-	var err error
-	once.Do(func() {
-		// Crear el almacén de cookies
-		store := sessions.NewCookieStore([]byte(c.GetSecretKey()))
+	store := sessions.NewCookieStore(c.GetKeyPairs()...)
+	if store == nil {
+		return nil, fmt.Errorf("failed to create session store")
+	}
+	store.Options = c.GetOptions()
+	store.MaxAge(store.Options.MaxAge)
 
-		// Comprobación opcional de algún error durante la inicialización
-		if store == nil {
-			err = fmt.Errorf("failed to create session store")
-			return
-		}
+	return &sessionManager{
+		store: store,
+	}, nil
+}
 
-		instance = &sessionManager{
-			store: store,
-		}
-	})
+// newServerSessionManager crea un manejador de sesiones cuyos valores se guardan en el backend.
+// La cookie solo transporta el ID de sesión firmado.
+func newServerSessionManager(c Config, b backend) (SessionManager, error) {
+	if b == nil {
+		return nil, fmt.Errorf("session backend cannot be nil")
+	}
 
-	// Retornar la instancia y el error si ocurrió
-	return instance, err
+	return &sessionManager{
+		store:   newServerStore(b, c.GetOptions(), c.GetKeyPairs()...),
+		backend: b,
+	}, nil
 }
 
 // Implementación de los métodos definidos en la interfaz SessionManager
@@ -51,3 +56,36 @@ func (r *sessionManager) Save(rq *http.Request, w http.ResponseWriter, session *
 func (r *sessionManager) New(rq *http.Request, name string) (*sessions.Session, error) {
 	return r.store.New(rq, name)
 }
+
+// Destroy expira la cookie y, en stores server-side, elimina la sesión del backend.
+func (r *sessionManager) Destroy(rq *http.Request, w http.ResponseWriter, session *sessions.Session) error {
+	session.Options.MaxAge = -1
+	return session.Save(rq, w)
+}
+
+func (r *sessionManager) ListUserSessions(ctx context.Context, userID string) ([]SessionInfo, error) {
+	if r.backend == nil {
+		return nil, ErrServerSideRequired
+	}
+	return r.backend.listByUser(ctx, userID)
+}
+
+func (r *sessionManager) RevokeSession(ctx context.Context, sessionID string) error {
+	if r.backend == nil {
+		return ErrServerSideRequired
+	}
+	return r.backend.delete(ctx, sessionID)
+}
+
+func (r *sessionManager) RevokeUserSessions(ctx context.Context, userID string) error {
+	if r.backend == nil {
+		return ErrServerSideRequired
+	}
+	return r.backend.deleteByUser(ctx, userID)
+}
+
+func (r *sessionManager) Close() {
+	if r.backend != nil {
+		r.backend.close()
+	}
+}
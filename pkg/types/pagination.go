@@ -0,0 +1,315 @@
+package pkgtypes
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// PageInfo son los metadatos de paginación de una respuesta.
+type PageInfo struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset,omitempty"`
+	// Total solo se informa si el cliente lo pidió con total=true.
+	Total      *int64 `json:"total,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// Page es una página de resultados de un listado.
+type Page[T any] struct {
+	Items []T
+	Info  PageInfo
+}
+
+// PageResponse es el envelope JSON de los endpoints de listado.
+type PageResponse struct {
+	Data any      `json:"data"`
+	Meta PageInfo `json:"meta"`
+}
+
+// MapPage convierte los elementos de una página conservando sus metadatos.
+func MapPage[T, U any](page *Page[T], fn func(T) U) *Page[U] {
+	items := make([]U, 0, len(page.Items))
+	for _, item := range page.Items {
+		items = append(items, fn(item))
+	}
+	return &Page[U]{Items: items, Info: page.Info}
+}
+
+// NewPageResponse arma el envelope de respuesta. Si el spec pide un sparse fieldset,
+// cada elemento se reduce a los campos pedidos (según sus nombres JSON).
+func NewPageResponse[T any](page *Page[T], spec *QuerySpec) (*PageResponse, error) {
+	if spec == nil || len(spec.Fields) == 0 {
+		items := page.Items
+		if items == nil {
+			items = []T{}
+		}
+		return &PageResponse{Data: items, Meta: page.Info}, nil
+	}
+
+	raw, err := json.Marshal(page.Items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode page items: %w", err)
+	}
+	var full []map[string]any
+	if err := json.Unmarshal(raw, &full); err != nil {
+		return nil, fmt.Errorf("page items are not JSON objects: %w", err)
+	}
+
+	pruned := make([]map[string]any, 0, len(full))
+	for _, item := range full {
+		selected := make(map[string]any, len(spec.Fields))
+		for _, field := range spec.Fields {
+			if value, ok := item[field]; ok {
+				selected[field] = value
+			}
+		}
+		pruned = append(pruned, selected)
+	}
+	return &PageResponse{Data: pruned, Meta: page.Info}, nil
+}
+
+// NewPage arma una página a partir de los resultados de una query que pidió Limit+1 filas:
+// la fila extra solo indica que hay más resultados y se descarta.
+// cursor calcula el cursor de continuación a partir del último elemento devuelto.
+func NewPage[T any](items []T, spec *QuerySpec, total *int64, cursor func(last T) (string, error)) (*Page[T], error) {
+	page := &Page[T]{
+		Info: PageInfo{Limit: spec.Limit, Offset: spec.Offset, Total: total},
+	}
+
+	if len(items) > spec.Limit {
+		items = items[:spec.Limit]
+		page.Info.HasMore = true
+	}
+	page.Items = items
+
+	if page.Info.HasMore && cursor != nil && len(items) > 0 {
+		next, err := cursor(items[len(items)-1])
+		if err != nil {
+			return nil, err
+		}
+		page.Info.NextCursor = next
+	}
+	return page, nil
+}
+
+// keysetCursor es el contenido de un cursor keyset: el orden con el que se generó
+// y los valores de las columnas de orden de la última fila devuelta.
+type keysetCursor struct {
+	Sort   string `json:"s"`
+	Values []any  `json:"v"`
+}
+
+// EncodeKeysetCursor genera el cursor de continuación para los valores de orden de la última fila.
+func EncodeKeysetCursor(spec *QuerySpec, values []any) (string, error) {
+	if len(values) != len(spec.Sort) {
+		return "", fmt.Errorf("cursor needs %d values, got %d", len(spec.Sort), len(values))
+	}
+	raw, err := json.Marshal(keysetCursor{Sort: spec.sortSignature(), Values: values})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// DecodeKeysetCursor devuelve los valores del cursor del spec convertidos al tipo de cada
+// columna de orden, o nil si el spec no tiene cursor.
+func DecodeKeysetCursor(spec *QuerySpec) ([]any, error) {
+	if spec.Cursor == "" {
+		return nil, nil
+	}
+
+	invalid := func(err error) error { return NewError(ErrValidation, "invalid cursor", err) }
+
+	raw, err := base64.RawURLEncoding.DecodeString(spec.Cursor)
+	if err != nil {
+		return nil, invalid(err)
+	}
+	var cursor keysetCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, invalid(err)
+	}
+	if cursor.Sort != spec.sortSignature() || len(cursor.Values) != len(spec.Sort) {
+		return nil, NewError(ErrValidation, "cursor does not match the requested sort", nil)
+	}
+
+	values := make([]any, len(cursor.Values))
+	for i, value := range cursor.Values {
+		if values[i], err = convertCursorValue(spec.Sort[i].Type, value); err != nil {
+			return nil, invalid(err)
+		}
+	}
+	return values, nil
+}
+
+// EncodePageState envuelve un page state de Cassandra como cursor opaco.
+func EncodePageState(state []byte) string {
+	if len(state) == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(state)
+}
+
+// DecodePageState recupera el page state de Cassandra de un cursor.
+func DecodePageState(cursor string) ([]byte, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	state, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, NewError(ErrValidation, "invalid cursor", err)
+	}
+	return state, nil
+}
+
+// KeysetCursorFromItem genera el cursor leyendo por reflexión las columnas de orden de item.
+// Los campos del struct se asocian a columnas por los tags db, bson, cql, gorm (column:) o json,
+// o por el nombre del campo en snake_case.
+func KeysetCursorFromItem(spec *QuerySpec, item any) (string, error) {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return "", fmt.Errorf("cannot build cursor from %T", item)
+	}
+
+	values := make([]any, 0, len(spec.Sort))
+	for _, sort := range spec.Sort {
+		field, ok := structFieldByColumn(v, sort.Column)
+		if !ok {
+			return "", fmt.Errorf("%T has no field for column %q", item, sort.Column)
+		}
+		values = append(values, field.Interface())
+	}
+	return EncodeKeysetCursor(spec, values)
+}
+
+// ColumnValue devuelve el valor del campo de item asociado a column, con las mismas reglas que
+// KeysetCursorFromItem. Los punteros se desreferencian; un puntero nil devuelve (nil, true).
+func ColumnValue(item any, column string) (any, bool) {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	field, ok := structFieldByColumn(v, column)
+	if !ok {
+		return nil, false
+	}
+	return field.Interface(), true
+}
+
+func (s *QuerySpec) sortSignature() string {
+	parts := make([]string, 0, len(s.Sort))
+	for _, sort := range s.Sort {
+		if sort.Desc {
+			parts = append(parts, "-"+sort.Field)
+			continue
+		}
+		parts = append(parts, sort.Field)
+	}
+	return strings.Join(parts, ",")
+}
+
+func convertCursorValue(fieldType FieldType, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch fieldType {
+	case FieldInt:
+		n, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("expected number, got %T", value)
+		}
+		return int64(n), nil
+	case FieldFloat:
+		n, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("expected number, got %T", value)
+		}
+		return n, nil
+	case FieldBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool, got %T", value)
+		}
+		return b, nil
+	case FieldTime:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected time, got %T", value)
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	default:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+		return s, nil
+	}
+}
+
+func structFieldByColumn(v reflect.Value, column string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		if sf.Anonymous && reflect.Indirect(v.Field(i)).Kind() == reflect.Struct {
+			if field, ok := structFieldByColumn(reflect.Indirect(v.Field(i)), column); ok {
+				return field, true
+			}
+			continue
+		}
+		if fieldColumn(sf) == column {
+			field := v.Field(i)
+			if field.Kind() == reflect.Pointer {
+				if field.IsNil() {
+					return reflect.Zero(reflect.TypeOf((*any)(nil)).Elem()), true
+				}
+				field = field.Elem()
+			}
+			return field, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func fieldColumn(sf reflect.StructField) string {
+	for _, tag := range []string{"db", "bson", "cql"} {
+		if name, _, _ := strings.Cut(sf.Tag.Get(tag), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	for _, part := range strings.Split(sf.Tag.Get("gorm"), ";") {
+		if name, ok := strings.CutPrefix(part, "column:"); ok {
+			return name
+		}
+	}
+	if name, _, _ := strings.Cut(sf.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	return toSnakeCase(sf.Name)
+}
+
+func toSnakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		upper := r >= 'A' && r <= 'Z'
+		if upper && i > 0 {
+			prevLower := runes[i-1] >= 'a' && runes[i-1] <= 'z'
+			nextLower := i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z'
+			if prevLower || (nextLower && runes[i-1] >= 'A' && runes[i-1] <= 'Z') {
+				b.WriteByte('_')
+			}
+		}
+		if upper {
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
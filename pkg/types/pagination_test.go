@@ -0,0 +1,279 @@
+package pkgtypes
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTweet struct {
+	ID        string    `json:"id"`
+	Content   string    `json:"content"`
+	Likes     int64     `gorm:"column:like_count" json:"likes"`
+	CreatedAt time.Time `json:"created_at"`
+	Author    *string   `db:"author_id" json:"author,omitempty"`
+}
+
+func TestNewPage(t *testing.T) {
+	errCursor := errors.New("cursor failed")
+
+	tests := []struct {
+		name       string
+		items      []int
+		cursor     func(last int) (string, error)
+		wantItems  []int
+		wantMore   bool
+		wantCursor string
+		wantErr    error
+	}{
+		{
+			name:      "Success: last page has no cursor",
+			items:     []int{1, 2},
+			cursor:    func(last int) (string, error) { return "unexpected", nil },
+			wantItems: []int{1, 2},
+		},
+		{
+			name:       "Success: extra row is dropped and the cursor points at the last item",
+			items:      []int{1, 2, 3, 4},
+			cursor:     func(last int) (string, error) { return string(rune('0' + last)), nil },
+			wantItems:  []int{1, 2, 3},
+			wantMore:   true,
+			wantCursor: "3",
+		},
+		{
+			name:      "Success: offset pagination without cursor function",
+			items:     []int{1, 2, 3, 4},
+			wantItems: []int{1, 2, 3},
+			wantMore:  true,
+		},
+		{
+			name:    "Error: cursor function fails",
+			items:   []int{1, 2, 3, 4},
+			cursor:  func(last int) (string, error) { return "", errCursor },
+			wantErr: errCursor,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			total := int64(9)
+			spec := &QuerySpec{Limit: 3, Offset: 6}
+
+			page, err := NewPage(tc.items, spec, &total, tc.cursor)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.wantItems, page.Items, "items mismatch")
+			assert.Equal(t, PageInfo{Limit: 3, Offset: 6, Total: &total, NextCursor: tc.wantCursor, HasMore: tc.wantMore}, page.Info)
+		})
+	}
+}
+
+func TestKeysetCursor(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 123, time.UTC)
+	spec := NewQuerySpec(testSchema)
+	cursor, err := EncodeKeysetCursor(spec, []any{createdAt, "t1"})
+	assert.NoError(t, err)
+
+	otherSort := *spec
+	otherSort.Sort = []SortField{{Field: "id", Column: "id", Type: FieldString}}
+
+	tests := []struct {
+		name       string
+		spec       *QuerySpec
+		cursor     string
+		wantValues []any
+		wantErr    bool
+	}{
+		{
+			name:       "Success: values are converted to the sort types",
+			spec:       spec,
+			cursor:     cursor,
+			wantValues: []any{createdAt, "t1"},
+		},
+		{
+			name: "Success: no cursor",
+			spec: spec,
+		},
+		{
+			name:    "Error: not base64",
+			spec:    spec,
+			cursor:  "%%%",
+			wantErr: true,
+		},
+		{
+			name:    "Error: not JSON",
+			spec:    spec,
+			cursor:  base64.RawURLEncoding.EncodeToString([]byte("nope")),
+			wantErr: true,
+		},
+		{
+			name:    "Error: cursor generated for another sort",
+			spec:    &otherSort,
+			cursor:  cursor,
+			wantErr: true,
+		},
+		{
+			name:    "Error: value of the wrong type",
+			spec:    spec,
+			cursor:  base64.RawURLEncoding.EncodeToString([]byte(`{"s":"-created_at,id","v":[1,"t1"]}`)),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			withCursor := *tc.spec
+			withCursor.Cursor = tc.cursor
+
+			values, err := DecodeKeysetCursor(&withCursor)
+
+			if tc.wantErr {
+				assert.True(t, IsValidationError(err), "expected a validation error, got %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.wantValues, values)
+		})
+	}
+
+	_, err = EncodeKeysetCursor(spec, []any{"t1"})
+	assert.Error(t, err, "the cursor needs one value per sort column")
+}
+
+func TestKeysetCursorFromItem(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	author := "u1"
+	tweet := testTweet{ID: "t1", Likes: 7, CreatedAt: createdAt, Author: &author}
+
+	tests := []struct {
+		name       string
+		sort       []SortField
+		item       any
+		wantValues []any
+		wantErr    bool
+	}{
+		{
+			name: "Success: columns resolved from gorm and snake case names",
+			sort: []SortField{
+				{Field: "likes", Column: "like_count", Type: FieldInt, Desc: true},
+				{Field: "created_at", Column: "created_at", Type: FieldTime},
+				{Field: "id", Column: "id", Type: FieldString},
+			},
+			item:       &tweet,
+			wantValues: []any{int64(7), createdAt, "t1"},
+		},
+		{
+			name:       "Success: pointers are dereferenced through the db tag",
+			sort:       []SortField{{Field: "author", Column: "author_id", Type: FieldString}},
+			item:       tweet,
+			wantValues: []any{"u1"},
+		},
+		{
+			name:    "Error: item has no field for the column",
+			sort:    []SortField{{Field: "score", Column: "score", Type: FieldFloat}},
+			item:    tweet,
+			wantErr: true,
+		},
+		{
+			name:    "Error: item is not a struct",
+			sort:    []SortField{{Field: "id", Column: "id", Type: FieldString}},
+			item:    "t1",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &QuerySpec{Sort: tc.sort}
+
+			cursor, err := KeysetCursorFromItem(spec, tc.item)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+
+			// El cursor generado se decodifica con los mismos valores
+			spec.Cursor = cursor
+			values, err := DecodeKeysetCursor(spec)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantValues, values)
+		})
+	}
+}
+
+func TestColumnValue(t *testing.T) {
+	tweet := testTweet{ID: "t1", Likes: 3}
+
+	value, ok := ColumnValue(&tweet, "like_count")
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), value)
+
+	value, ok = ColumnValue(tweet, "author_id")
+	assert.True(t, ok, "nil pointers are found")
+	assert.Nil(t, value)
+
+	_, ok = ColumnValue(tweet, "missing")
+	assert.False(t, ok)
+}
+
+func TestNewPageResponse(t *testing.T) {
+	page := &Page[testTweet]{
+		Items: []testTweet{{ID: "t1", Content: "hola", Likes: 2}},
+		Info:  PageInfo{Limit: 10},
+	}
+
+	tests := []struct {
+		name     string
+		page     *Page[testTweet]
+		spec     *QuerySpec
+		wantData any
+	}{
+		{
+			name:     "Success: full items without sparse fieldset",
+			page:     page,
+			spec:     &QuerySpec{},
+			wantData: page.Items,
+		},
+		{
+			name:     "Success: empty page is an empty list",
+			page:     &Page[testTweet]{},
+			wantData: []testTweet{},
+		},
+		{
+			name:     "Success: items reduced to the requested fields",
+			page:     page,
+			spec:     &QuerySpec{Fields: []string{"content", "id", "author"}},
+			wantData: []map[string]any{{"id": "t1", "content": "hola"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := NewPageResponse(tc.page, tc.spec)
+
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.wantData, resp.Data)
+			assert.Equal(t, tc.page.Info, resp.Meta)
+		})
+	}
+}
+
+func TestPageState(t *testing.T) {
+	assert.Empty(t, EncodePageState(nil))
+
+	state, err := DecodePageState(EncodePageState([]byte{0x01, 0xff}))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0xff}, state)
+
+	_, err = DecodePageState("%%%")
+	assert.True(t, IsValidationError(err), "expected a validation error, got %v", err)
+}
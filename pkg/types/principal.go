@@ -0,0 +1,77 @@
+package pkgtypes
+
+import "context"
+
+// PrincipalContextKey es la clave del gin context donde se guarda el Principal autenticado.
+const PrincipalContextKey = "principal"
+
+// Permisos de administración de registros borrados lógicamente.
+const (
+	PermissionReadDeleted = "records.read_deleted"
+	PermissionRestore     = "records.restore"
+)
+
+// Permisos sobre los recursos de la API. Los usuarios los obtienen de sus roles y los
+// service accounts de los scopes de su API key.
+const (
+	PermissionCandidateRead   = "candidate.read"
+	PermissionCandidateWrite  = "candidate.write"
+	PermissionAssessmentRead  = "assessment.read"
+	PermissionAssessmentWrite = "assessment.write"
+	PermissionUserRead        = "user.read"
+	PermissionUserWrite       = "user.write"
+	PermissionCatalogRead     = "catalog.read"
+	PermissionCatalogWrite    = "catalog.write"
+	PermissionAuditRead       = "audit.read"
+	PermissionUserAdmin       = "user.admin"   // Roles y reseteo de MFA de otros usuarios
+	PermissionAPIKeyAdmin     = "apikey.admin" // Gestión de service accounts y API keys
+)
+
+// principalCtxKey es la clave del principal en el context.Context de la request.
+type principalCtxKey struct{}
+
+// PrincipalType distingue usuarios humanos (JWT) de clientes máquina (API key).
+type PrincipalType string
+
+const (
+	PrincipalUser           PrincipalType = "user"
+	PrincipalServiceAccount PrincipalType = "service_account"
+)
+
+// Principal es la identidad autenticada de la request, independiente del mecanismo de autenticación.
+type Principal struct {
+	ID          string
+	Type        PrincipalType
+	APIKeyID    string   // Solo para service accounts
+	Permissions []string // Derivados de los roles del usuario o de los scopes de la API key
+}
+
+// HasPermission indica si el principal tiene el permiso indicado.
+func (p *Principal) HasPermission(permission string) bool {
+	for _, perm := range p.Permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// WithPrincipal devuelve un context que transporta el principal, para que los use cases puedan leerlo.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, p)
+}
+
+// PrincipalFromContext recupera el principal guardado con WithPrincipal.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalCtxKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// PrincipalIDFromContext devuelve el ID del principal de la request, o "" si no hay uno
+// (por ejemplo, en jobs programados).
+func PrincipalIDFromContext(ctx context.Context) string {
+	if p, ok := PrincipalFromContext(ctx); ok {
+		return p.ID
+	}
+	return ""
+}
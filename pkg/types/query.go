@@ -0,0 +1,332 @@
+package pkgtypes
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QuerySpecContextKey es la clave del gin context donde se guarda el QuerySpec parseado.
+const QuerySpecContextKey = "query_spec"
+
+const (
+	defaultQueryLimit = 20
+	maxQueryLimit     = 100
+)
+
+// FilterOperator es un operador de comparación soportado en los filtros.
+type FilterOperator string
+
+// Operadores de filtro. En el query string se expresan como filter[campo][op]=valor;
+// filter[campo]=valor equivale a eq. Los valores de in se separan por coma.
+const (
+	OpEq   FilterOperator = "eq"
+	OpNe   FilterOperator = "ne"
+	OpGt   FilterOperator = "gt"
+	OpGte  FilterOperator = "gte"
+	OpLt   FilterOperator = "lt"
+	OpLte  FilterOperator = "lte"
+	OpIn   FilterOperator = "in"
+	OpLike FilterOperator = "like"
+)
+
+var filterOperators = []FilterOperator{OpEq, OpNe, OpGt, OpGte, OpLt, OpLte, OpIn, OpLike}
+
+// FieldType define cómo se convierten los valores de filtros y cursores de un campo.
+type FieldType string
+
+const (
+	FieldString FieldType = "string"
+	FieldInt    FieldType = "int"
+	FieldFloat  FieldType = "float"
+	FieldBool   FieldType = "bool"
+	FieldTime   FieldType = "time"
+)
+
+// DeletedMode indica cómo trata un listado los registros con soft delete.
+type DeletedMode string
+
+// Modos del parámetro deleted. Incluir borrados requiere el permiso PermissionReadDeleted.
+const (
+	DeletedExclude DeletedMode = ""
+	DeletedInclude DeletedMode = "include"
+	DeletedOnly    DeletedMode = "only"
+)
+
+// FieldSpec describe un campo expuesto por un endpoint de listado.
+type FieldSpec struct {
+	// Column es el nombre en la base (columna SQL, campo de Mongo o columna de Cassandra).
+	Column     string
+	Type       FieldType
+	Filterable bool
+	Sortable   bool
+}
+
+// QuerySchema es la lista blanca de campos de un endpoint: solo los campos declarados
+// pueden filtrarse, ordenarse o seleccionarse, y solo sus columnas llegan a las queries.
+type QuerySchema struct {
+	// Fields indexa los campos por su nombre público (el mismo que en el JSON de respuesta).
+	Fields map[string]FieldSpec
+	// KeyField es el campo único que desempata el orden y alimenta los cursores (por defecto "id").
+	KeyField     string
+	DefaultSort  []string
+	DefaultLimit int
+	MaxLimit     int
+}
+
+// Filter es un filtro ya validado contra el QuerySchema.
+type Filter struct {
+	Field  string
+	Column string
+	Op     FilterOperator
+	// Values tiene un único elemento salvo para OpIn. Los valores ya están convertidos al Type del campo.
+	Values []any
+}
+
+// Value devuelve el primer valor del filtro.
+func (f Filter) Value() any {
+	if len(f.Values) == 0 {
+		return nil
+	}
+	return f.Values[0]
+}
+
+// SortField es un criterio de orden ya validado contra el QuerySchema.
+type SortField struct {
+	Field  string
+	Column string
+	Type   FieldType
+	Desc   bool
+}
+
+// QuerySpec es la especificación de paginación, filtros, orden y campos de un listado.
+type QuerySpec struct {
+	Limit  int
+	Offset int
+	// Cursor es opaco para el cliente: keyset (SQL, Mongo) o page state (Cassandra).
+	Cursor  string
+	Filters []Filter
+	// Sort siempre termina con el KeyField, por lo que el orden es total y estable.
+	Sort []SortField
+	// Fields es el sparse fieldset pedido (vacío = todos). Siempre incluye el KeyField.
+	Fields       []string
+	Columns      []string
+	IncludeTotal bool
+	// Deleted indica si se listan también (o solo) los registros borrados lógicamente.
+	Deleted DeletedMode
+}
+
+// NewQuerySpec devuelve un spec sin filtros con el orden y el límite por defecto del schema.
+func NewQuerySpec(schema QuerySchema) *QuerySpec {
+	spec, _ := ParseQuerySpec(url.Values{}, schema)
+	return spec
+}
+
+var filterParamRegex = regexp.MustCompile(`^filter\[([A-Za-z0-9_.]+)\](?:\[([a-z]+)\])?$`)
+
+// ParseQuerySpec parsea los parámetros limit, offset, cursor, sort, fields, total, deleted y filter[...]
+// de un query string. Devuelve un error de validación ante campos u operadores no permitidos.
+func ParseQuerySpec(values url.Values, schema QuerySchema) (*QuerySpec, error) {
+	keyField := schema.keyField()
+	spec := &QuerySpec{Limit: schema.defaultLimit()}
+
+	if raw := values.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			return nil, NewError(ErrValidation, "limit must be a positive integer", err)
+		}
+		spec.Limit = min(limit, schema.maxLimit())
+	}
+
+	if raw := values.Get("offset"); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return nil, NewError(ErrValidation, "offset must be a non-negative integer", err)
+		}
+		spec.Offset = offset
+	}
+
+	spec.Cursor = values.Get("cursor")
+	if spec.Cursor != "" && spec.Offset > 0 {
+		return nil, NewError(ErrValidation, "cursor and offset cannot be combined", nil)
+	}
+
+	if raw := values.Get("total"); raw != "" {
+		total, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, NewError(ErrValidation, "total must be a boolean", err)
+		}
+		spec.IncludeTotal = total
+	}
+
+	switch mode := DeletedMode(values.Get("deleted")); mode {
+	case DeletedExclude, DeletedInclude, DeletedOnly:
+		spec.Deleted = mode
+	default:
+		return nil, NewError(ErrValidation, "deleted must be include or only", nil)
+	}
+
+	sortParam := splitList(values.Get("sort"))
+	if len(sortParam) == 0 {
+		sortParam = schema.DefaultSort
+	}
+	for _, raw := range sortParam {
+		name, desc := strings.TrimPrefix(raw, "-"), strings.HasPrefix(raw, "-")
+		field, ok := schema.Fields[name]
+		if !ok || !field.Sortable {
+			return nil, NewError(ErrValidation, fmt.Sprintf("cannot sort by %q", name), nil)
+		}
+		spec.Sort = append(spec.Sort, SortField{Field: name, Column: field.Column, Type: field.Type, Desc: desc})
+	}
+	if !slices.ContainsFunc(spec.Sort, func(s SortField) bool { return s.Field == keyField }) {
+		key, ok := schema.Fields[keyField]
+		if !ok {
+			return nil, NewError(ErrInternal, fmt.Sprintf("key field %q is not declared in the query schema", keyField), nil)
+		}
+		spec.Sort = append(spec.Sort, SortField{Field: keyField, Column: key.Column, Type: key.Type})
+	}
+
+	for _, name := range splitList(values.Get("fields")) {
+		field, ok := schema.Fields[name]
+		if !ok {
+			return nil, NewError(ErrValidation, fmt.Sprintf("unknown field %q", name), nil)
+		}
+		if !slices.Contains(spec.Fields, name) {
+			spec.Fields = append(spec.Fields, name)
+			spec.Columns = append(spec.Columns, field.Column)
+		}
+	}
+	if len(spec.Fields) > 0 && !slices.Contains(spec.Fields, keyField) {
+		spec.Fields = append(spec.Fields, keyField)
+		spec.Columns = append(spec.Columns, schema.Fields[keyField].Column)
+	}
+	// Las columnas del orden se leen siempre: el cursor de la página siguiente se arma con ellas
+	if len(spec.Columns) > 0 {
+		for _, s := range spec.Sort {
+			if !slices.Contains(spec.Columns, s.Column) {
+				spec.Columns = append(spec.Columns, s.Column)
+			}
+		}
+	}
+
+	// Orden determinístico de los filtros, independiente del orden del query string
+	params := make([]string, 0, len(values))
+	for param := range values {
+		params = append(params, param)
+	}
+	slices.Sort(params)
+
+	for _, param := range params {
+		match := filterParamRegex.FindStringSubmatch(param)
+		if match == nil {
+			continue
+		}
+		name, op := match[1], FilterOperator(match[2])
+		if op == "" {
+			op = OpEq
+		}
+		if !slices.Contains(filterOperators, op) {
+			return nil, NewError(ErrValidation, fmt.Sprintf("unknown filter operator %q", op), nil)
+		}
+
+		field, ok := schema.Fields[name]
+		if !ok || !field.Filterable {
+			return nil, NewError(ErrValidation, fmt.Sprintf("cannot filter by %q", name), nil)
+		}
+
+		filter, err := newFilter(name, field, op, values.Get(param))
+		if err != nil {
+			return nil, err
+		}
+		spec.Filters = append(spec.Filters, filter)
+	}
+
+	return spec, nil
+}
+
+func newFilter(name string, field FieldSpec, op FilterOperator, raw string) (Filter, error) {
+	filter := Filter{Field: name, Column: field.Column, Op: op}
+
+	if op == OpLike && field.Type != FieldString {
+		return filter, NewError(ErrValidation, fmt.Sprintf("operator like is not supported for %q", name), nil)
+	}
+
+	rawValues := []string{raw}
+	if op == OpIn {
+		rawValues = splitList(raw)
+		if len(rawValues) == 0 {
+			return filter, NewError(ErrValidation, fmt.Sprintf("operator in requires at least one value for %q", name), nil)
+		}
+	}
+
+	for _, rv := range rawValues {
+		value, err := ParseFieldValue(field.Type, rv)
+		if err != nil {
+			return filter, NewError(ErrValidation, fmt.Sprintf("invalid value %q for %q", rv, name), err)
+		}
+		filter.Values = append(filter.Values, value)
+	}
+	return filter, nil
+}
+
+// ParseFieldValue convierte un valor de query string al tipo del campo.
+func ParseFieldValue(fieldType FieldType, raw string) (any, error) {
+	switch fieldType {
+	case FieldInt:
+		return strconv.ParseInt(raw, 10, 64)
+	case FieldFloat:
+		return strconv.ParseFloat(raw, 64)
+	case FieldBool:
+		return strconv.ParseBool(raw)
+	case FieldTime:
+		return time.Parse(time.RFC3339Nano, raw)
+	default:
+		return raw, nil
+	}
+}
+
+// HasFilter indica si el spec filtra por el campo indicado.
+func (s *QuerySpec) HasFilter(field string) bool {
+	return slices.ContainsFunc(s.Filters, func(f Filter) bool { return f.Field == field })
+}
+
+// AddFilter agrega un filtro impuesto por el servidor (por ejemplo, el dueño del recurso),
+// sin pasar por la lista blanca del schema.
+func (s *QuerySpec) AddFilter(field, column string, op FilterOperator, values ...any) {
+	s.Filters = append(s.Filters, Filter{Field: field, Column: column, Op: op, Values: values})
+}
+
+func (q QuerySchema) keyField() string {
+	if q.KeyField == "" {
+		return "id"
+	}
+	return q.KeyField
+}
+
+func (q QuerySchema) defaultLimit() int {
+	if q.DefaultLimit <= 0 {
+		return min(defaultQueryLimit, q.maxLimit())
+	}
+	return min(q.DefaultLimit, q.maxLimit())
+}
+
+func (q QuerySchema) maxLimit() int {
+	if q.MaxLimit <= 0 {
+		return maxQueryLimit
+	}
+	return q.MaxLimit
+}
+
+func splitList(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,172 @@
+package pkgtypes
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testSchema es el schema de un listado de tweets usado en los tests.
+var testSchema = QuerySchema{
+	Fields: map[string]FieldSpec{
+		"id":         {Column: "id", Type: FieldString, Filterable: true, Sortable: true},
+		"content":    {Column: "content", Type: FieldString, Filterable: true},
+		"likes":      {Column: "like_count", Type: FieldInt, Filterable: true, Sortable: true},
+		"score":      {Column: "score", Type: FieldFloat, Filterable: true},
+		"pinned":     {Column: "pinned", Type: FieldBool, Filterable: true},
+		"created_at": {Column: "created_at", Type: FieldTime, Filterable: true, Sortable: true},
+		"author":     {Column: "author_id", Type: FieldString},
+	},
+	DefaultSort:  []string{"-created_at"},
+	DefaultLimit: 10,
+	MaxLimit:     50,
+}
+
+func TestParseQuerySpec(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		query   string
+		schema  QuerySchema
+		want    *QuerySpec
+		wantErr ErrorType
+	}{
+		{
+			name:   "Success: defaults of the schema",
+			query:  "",
+			schema: testSchema,
+			want: &QuerySpec{
+				Limit: 10,
+				Sort: []SortField{
+					{Field: "created_at", Column: "created_at", Type: FieldTime, Desc: true},
+					{Field: "id", Column: "id", Type: FieldString},
+				},
+			},
+		},
+		{
+			name:   "Success: limit is capped by the schema maximum",
+			query:  "limit=500&offset=20&total=true&deleted=include",
+			schema: testSchema,
+			want: &QuerySpec{
+				Limit:        50,
+				Offset:       20,
+				IncludeTotal: true,
+				Deleted:      DeletedInclude,
+				Sort: []SortField{
+					{Field: "created_at", Column: "created_at", Type: FieldTime, Desc: true},
+					{Field: "id", Column: "id", Type: FieldString},
+				},
+			},
+		},
+		{
+			name:   "Success: explicit sort including the key field is kept as is",
+			query:  "sort=likes,-id",
+			schema: testSchema,
+			want: &QuerySpec{
+				Limit: 10,
+				Sort: []SortField{
+					{Field: "likes", Column: "like_count", Type: FieldInt},
+					{Field: "id", Column: "id", Type: FieldString, Desc: true},
+				},
+			},
+		},
+		{
+			name:   "Success: sparse fieldset adds the key field and the sort columns",
+			query:  "fields=content,author,content&sort=-likes",
+			schema: testSchema,
+			want: &QuerySpec{
+				Limit: 10,
+				Sort: []SortField{
+					{Field: "likes", Column: "like_count", Type: FieldInt, Desc: true},
+					{Field: "id", Column: "id", Type: FieldString},
+				},
+				Fields:  []string{"content", "author", "id"},
+				Columns: []string{"content", "author_id", "id", "like_count"},
+			},
+		},
+		{
+			name:   "Success: filters are typed and sorted by parameter name",
+			query:  "filter[score][gte]=1.5&filter[likes][in]=1,%202,&filter[content][like]=go&filter[pinned]=true&filter[created_at][lt]=2024-05-01T12:00:00Z",
+			schema: testSchema,
+			want: &QuerySpec{
+				Limit: 10,
+				Sort: []SortField{
+					{Field: "created_at", Column: "created_at", Type: FieldTime, Desc: true},
+					{Field: "id", Column: "id", Type: FieldString},
+				},
+				Filters: []Filter{
+					{Field: "content", Column: "content", Op: OpLike, Values: []any{"go"}},
+					{Field: "created_at", Column: "created_at", Op: OpLt, Values: []any{createdAt}},
+					{Field: "likes", Column: "like_count", Op: OpIn, Values: []any{int64(1), int64(2)}},
+					{Field: "pinned", Column: "pinned", Op: OpEq, Values: []any{true}},
+					{Field: "score", Column: "score", Op: OpGte, Values: []any{1.5}},
+				},
+			},
+		},
+		{
+			name:   "Success: default limit falls back to the package default",
+			query:  "",
+			schema: QuerySchema{Fields: map[string]FieldSpec{"id": {Column: "id", Type: FieldInt}}},
+			want: &QuerySpec{
+				Limit: defaultQueryLimit,
+				Sort:  []SortField{{Field: "id", Column: "id", Type: FieldInt}},
+			},
+		},
+		{name: "Error: non numeric limit", query: "limit=abc", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: zero limit", query: "limit=0", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: negative offset", query: "offset=-1", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: cursor combined with offset", query: "cursor=abc&offset=5", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: invalid total", query: "total=maybe", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: unknown deleted mode", query: "deleted=all", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: sort by a non sortable field", query: "sort=content", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: sort by an unknown field", query: "sort=-password", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: unknown sparse field", query: "fields=password", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: unknown filter operator", query: "filter[likes][regex]=1", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: filter by a non filterable field", query: "filter[author]=u1", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: like on a non string field", query: "filter[likes][like]=1", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: in without values", query: "filter[likes][in]=,", schema: testSchema, wantErr: ErrValidation},
+		{name: "Error: value of the wrong type", query: "filter[likes][gt]=many", schema: testSchema, wantErr: ErrValidation},
+		{
+			name:    "Error: key field missing from the schema",
+			query:   "",
+			schema:  QuerySchema{Fields: map[string]FieldSpec{"name": {Column: "name", Sortable: true}}},
+			wantErr: ErrInternal,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tc.query)
+			assert.NoError(t, err)
+
+			got, err := ParseQuerySpec(values, tc.schema)
+
+			if tc.wantErr != "" {
+				var apiErr *Error
+				assert.ErrorAs(t, err, &apiErr)
+				if apiErr != nil {
+					assert.Equal(t, tc.wantErr, apiErr.Type, "error type mismatch")
+				}
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestQuerySpecFilters(t *testing.T) {
+	spec := NewQuerySpec(testSchema)
+	assert.False(t, spec.HasFilter("author"))
+
+	// Los filtros del servidor no pasan por la lista blanca del schema
+	spec.AddFilter("author", "author_id", OpEq, "u1")
+
+	assert.True(t, spec.HasFilter("author"))
+	assert.Equal(t, "u1", spec.Filters[0].Value())
+	assert.Nil(t, Filter{}.Value())
+}
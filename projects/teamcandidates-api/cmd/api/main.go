@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -12,6 +14,9 @@ import (
 )
 
 func main() {
+	migrateOnStartup := flag.Bool("migrate", false, "apply pending PostgreSQL migrations before starting the server")
+	flag.Parse()
+
 	// Create a context with cancellation to handle graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -26,25 +31,74 @@ func main() {
 		cancel()
 	}()
 
-	// Initialize dependencies using Wire
-	deps, err := wire.Initialize()
+	// Subcomando "migrate": gestiona las migraciones de PostgreSQL y termina
+	if flag.Arg(0) == "migrate" {
+		if err := RunMigrateCommand(ctx, flag.Args()[1:]); err != nil {
+			log.Fatalf("Migration command failed: %v", err)
+		}
+		return
+	}
+
+	// Initialize dependencies using Wire. STORAGE_BACKEND=memory arranca la API sin Postgres,
+	// Mongo, Cassandra, Redis ni RabbitMQ.
+	initialize := wire.Initialize
+	if strings.EqualFold(os.Getenv("STORAGE_BACKEND"), "memory") {
+		log.Println("Using in-memory storage backend")
+		initialize = wire.InitializeInMemory
+	}
+
+	deps, err := initialize()
 	if err != nil {
 		log.Fatalf("Error initializing dependencies: %s", err)
 	}
 
-	// Cargar datos de prueba en el repositorio de personas.
-	if err := seedTestData(ctx, deps.PersonUseCases, deps.UserUseCases, deps.TweetUseCases); err != nil {
-		log.Printf("Error seeding test data: %v", err)
+	// Subcomando "roles": consulta o asigna roles (requiere las migraciones de GORM aplicadas) y termina
+	if flag.Arg(0) == "roles" {
+		if err := RunRolesCommand(ctx, deps.UserUseCases, flag.Args()[1:]); err != nil {
+			log.Fatalf("Roles command failed: %v", err)
+		}
+		return
+	}
+
+	if *migrateOnStartup && deps.PostgresRepository != nil {
+		if err := RunPostgresMigrations(ctx, deps.PostgresRepository); err != nil {
+			log.Fatalf("Failed to run PostgreSQL migrations: %v", err)
+		}
 	}
 
 	if err := RunGormMigrations(ctx, deps.GormRepository); err != nil {
 		log.Fatalf("Failed to run Gorm's migrations: %v", err)
 	}
 
-	if err := RunCassandraMigrations(ctx, deps.CassandraRepository); err != nil {
-		log.Fatalf("Failed to run Cassandra's migrations: %v", err)
+	if deps.CassandraRepository != nil {
+		if err := RunCassandraMigrations(ctx, deps.CassandraRepository); err != nil {
+			log.Fatalf("Failed to run Cassandra's migrations: %v", err)
+		}
+	}
+
+	if deps.MongoRepository != nil {
+		if err := RunMongoIndexes(ctx, deps.MongoRepository); err != nil {
+			log.Fatalf("Failed to ensure MongoDB indexes: %v", err)
+		}
+	}
+
+	// Cargar datos de prueba una vez que todos los esquemas están creados por las migraciones.
+	if err := seedTestData(ctx, deps.PersonUseCases, deps.UserUseCases, deps.TweetUseCases); err != nil {
+		log.Printf("Error seeding test data: %v", err)
 	}
 
+	// Purga periódica de registros con soft delete vencidos
+	go deps.RetentionUseCases.Run(ctx)
+
+	// Cierre de las sesiones cuyo deadline pasó (entrega automática o vencimiento)
+	go deps.AssessmentUseCases.RunExpiry(ctx)
+
+	// Recordatorios por email de los links de evaluación por vencer
+	go deps.AssessmentUseCases.RunReminders(ctx)
+
+	// Workers que corrigen en el sandbox las entregas encoladas
+	go deps.GradingUseCases.RunWorker(ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(1)
 
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	cass "github.com/teamcubation/teamcandidates/pkg/databases/nosql/cassandra/gocql"
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	pgdb "github.com/teamcubation/teamcandidates/pkg/databases/sql/postgresql/pgxpool"
+
+	migrations "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/cmd/api/migrations"
+)
+
+const migrateUsage = `usage: api migrate [postgres] <command> [arg]
+       api migrate cassandra <command>
+       api migrate gorm <command> [name]
+
+postgres commands:
+  up              apply all pending migrations
+  down [n]        roll back n migrations (all if n is omitted)
+  to <version>    migrate up or down to the given version
+  status          show applied version, dirty flag and pending migrations
+  force <version> set the version without running migrations (use -1 for none)
+
+cassandra commands:
+  up              apply all pending migrations
+  plan            print the pending CQL without running it (dry-run)
+  status          list applied migrations with their checksums
+
+gorm commands:
+  up              apply all pending migrations
+  status          list applied and pending migrations
+  check           report schema drift between the models and the database (exit 1 on drift)
+  generate <name> write the DDL needed by the models as a new migration file`
+
+// RunMigrateCommand ejecuta el subcomando "migrate" sin levantar el resto de las dependencias
+// de la aplicación. Por defecto opera sobre PostgreSQL (pgxpool).
+func RunMigrateCommand(ctx context.Context, args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "cassandra":
+			return runCassandraMigrateCommand(ctx, args[1:])
+		case "gorm":
+			return runGormMigrateCommand(ctx, args[1:])
+		case "postgres":
+			args = args[1:]
+		}
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("missing migrate command\n%s", migrateUsage)
+	}
+
+	repo, err := pgdb.Bootstrap("", "", "", "", "", "")
+	if err != nil {
+		return fmt.Errorf("failed to bootstrap PostgreSQL repository: %w", err)
+	}
+	defer repo.Close()
+
+	migrator, err := repo.NewMigrator(migrations.Postgres, migrations.PostgresDir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := migrator.Close(); err != nil {
+			log.Printf("Error closing migrator: %v", err)
+		}
+	}()
+
+	command, arg := args[0], ""
+	if len(args) > 1 {
+		arg = args[1]
+	}
+
+	switch command {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		steps := 0
+		if arg != "" {
+			if steps, err = strconv.Atoi(arg); err != nil || steps <= 0 {
+				return fmt.Errorf("invalid number of steps %q", arg)
+			}
+		}
+		return migrator.Down(ctx, steps)
+	case "to":
+		version, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", arg)
+		}
+		return migrator.To(ctx, uint(version))
+	case "force":
+		version, err := strconv.Atoi(arg)
+		if err != nil || version < -1 {
+			return fmt.Errorf("invalid version %q", arg)
+		}
+		return migrator.Force(ctx, version)
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version: %d\ndirty: %t\nlatest: %d\npending: %v\n", status.Version, status.Dirty, status.Latest, status.Pending)
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q\n%s", command, migrateUsage)
+	}
+}
+
+func runCassandraMigrateCommand(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing migrate command\n%s", migrateUsage)
+	}
+
+	repo, err := cass.Bootstrap()
+	if err != nil {
+		return fmt.Errorf("failed to bootstrap Cassandra repository: %w", err)
+	}
+	defer repo.Close()
+
+	migrator := repo.NewMigrator(migrations.Cassandra, migrations.CassandraDir)
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx, cass.MigrateOptions{})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("applied %d migrations\n", len(applied))
+		return nil
+	case "plan":
+		_, err := migrator.Up(ctx, cass.MigrateOptions{DryRun: true, Out: os.Stdout})
+		return err
+	case "status":
+		records, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			fmt.Printf("%d_%s\t%s\t%s\n", r.Version, r.Name, r.AppliedAt.Format(time.RFC3339), r.Checksum)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q\n%s", args[0], migrateUsage)
+	}
+}
+
+func runGormMigrateCommand(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing migrate command\n%s", migrateUsage)
+	}
+
+	repo, err := gorm.Bootstrap("", "", "", "", "", 0)
+	if err != nil {
+		return fmt.Errorf("failed to bootstrap GORM repository: %w", err)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := repo.Migrate(ctx, migrations.Gorm, migrations.GormDir)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("applied %d migrations\n", len(applied))
+		return nil
+	case "status":
+		records, pending, err := repo.MigrationStatus(ctx, migrations.Gorm, migrations.GormDir)
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			fmt.Printf("%06d_%s\t%s\t%s\n", r.Version, r.Name, r.AppliedAt.Format(time.RFC3339), r.Checksum)
+		}
+		for _, m := range pending {
+			fmt.Printf("%06d_%s\tpending\n", m.Version, m.Name)
+		}
+		return nil
+	case "check":
+		report, err := repo.DetectDrift(ctx, gormModels()...)
+		if err != nil {
+			return err
+		}
+		if !report.HasDrift() {
+			fmt.Println("no drift detected")
+			return nil
+		}
+		for _, stmt := range report.PendingStatements {
+			fmt.Printf("pending: %s\n", stmt)
+		}
+		for table, columns := range report.UnmappedColumns {
+			fmt.Printf("unmapped columns in %s: %v\n", table, columns)
+		}
+		return errors.New("schema drift detected")
+	case "generate":
+		if len(args) < 2 {
+			return fmt.Errorf("missing migration name\n%s", migrateUsage)
+		}
+		statements, err := repo.GenerateMigration(ctx, gormModels()...)
+		if err != nil {
+			return err
+		}
+		if len(statements) == 0 {
+			fmt.Println("models and database are in sync, nothing to generate")
+			return nil
+		}
+		dir := os.Getenv("GORM_MIGRATIONS_DIR")
+		if dir == "" {
+			dir = filepath.Join("cmd", "api", "migrations", migrations.GormDir)
+		}
+		file, err := gorm.WriteMigrationFile(dir, repo.DBType(), args[1], statements)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("wrote %s\n", file)
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q\n%s", args[0], migrateUsage)
+	}
+}
+
+// RunPostgresMigrations aplica las migraciones pendientes al iniciar la aplicación (flag --migrate).
+func RunPostgresMigrations(ctx context.Context, repo pgdb.Repository) error {
+	log.Println("Starting PostgreSQL migrations...")
+
+	migrator, err := repo.NewMigrator(migrations.Postgres, migrations.PostgresDir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := migrator.Close(); err != nil {
+			log.Printf("Error closing migrator: %v", err)
+		}
+	}()
+
+	if err := migrator.Up(ctx); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+	log.Printf("PostgreSQL migrations completed, schema at version %d.", status.Version)
+	return nil
+}
@@ -0,0 +1,19 @@
+-- Esquema base equivalente al AutoMigrate previo. Idempotente para adoptar bases ya creadas por AutoMigrate.
+CREATE TABLE IF NOT EXISTS `groups` (`id` uuid,`name` varchar(255) NOT NULL,`description` text,`category` varchar(100),`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,`privacy` varchar(50) NOT NULL,`max_members` bigint DEFAULT null,`image_url` varchar(255),`organizer_id` varchar(256) NOT NULL,`is_organizer_company` boolean NOT NULL,`status` varchar(50) NOT NULL DEFAULT 'active',PRIMARY KEY (`id`));
+CREATE TABLE IF NOT EXISTS `group_members` (`group_id` uuid,`user_id` varchar(256),`role` varchar(50) NOT NULL,`joined_at` datetime(3) NULL,PRIMARY KEY (`group_id`,`user_id`),CONSTRAINT `fk_groups_group_members` FOREIGN KEY (`group_id`) REFERENCES `groups`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `assessments` (`id` varchar(256),`hr_id` varchar(256),`candidate_id` varchar(256),`start_date` datetime(3) NOT NULL,`end_date` datetime(3) NULL,`status` varchar(50) NOT NULL,`max_duration` bigint NOT NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_assessments_hr_id` (`hr_id`),INDEX `idx_assessments_candidate_id` (`candidate_id`));
+CREATE TABLE IF NOT EXISTS `problems` (`id` varchar(256),`assessment_id` varchar(256) NOT NULL,`description` text NOT NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_problems_assessment_id` (`assessment_id`),CONSTRAINT `fk_assessments_problem` FOREIGN KEY (`assessment_id`) REFERENCES `assessments`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `skill_configs` (`id` varchar(256),`assessment_id` varchar(256) NOT NULL,`skill_name` varchar(100) NOT NULL,`skill_level` varchar(50) NOT NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_skill_configs_assessment_id` (`assessment_id`),CONSTRAINT `fk_assessments_skills` FOREIGN KEY (`assessment_id`) REFERENCES `assessments`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `unit_tests` (`id` varchar(256),`assessment_id` varchar(256) NOT NULL,`test_name` varchar(100) NOT NULL,`input_data` text NOT NULL,`expected_output` text NOT NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_unit_tests_assessment_id` (`assessment_id`),CONSTRAINT `fk_assessments_unit_tests` FOREIGN KEY (`assessment_id`) REFERENCES `assessments`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `candidates` (`id` varchar(256),`person_id` varchar(256) NOT NULL,`email` varchar(256) NOT NULL,`experience_level` varchar(256),`experience_rank` bigint,`assessments_ids` text[],`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,`deleted_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_candidates_deleted_at` (`deleted_at`),INDEX `idx_candidates_person_id` (`person_id`),CONSTRAINT `uni_candidates_email` UNIQUE (`email`));
+CREATE TABLE IF NOT EXISTS `links` (`id` varchar(256),`assessment_id` varchar(256) NOT NULL,`token` varchar(255) NOT NULL,`expires_at` datetime(3) NOT NULL,`url` text NOT NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),UNIQUE INDEX `idx_links_token` (`token`),INDEX `idx_links_assessment_id` (`assessment_id`));
+CREATE TABLE IF NOT EXISTS `users` (`id` varchar(256),`person_id` varchar(256),`email` varchar(256) NOT NULL,`password` varchar(256) NOT NULL,`email_validated` boolean DEFAULT false,`user_type` varchar(256) NOT NULL,`logged_at` datetime(3) NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,`deleted_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_users_deleted_at` (`deleted_at`),CONSTRAINT `uni_users_email` UNIQUE (`email`));
+CREATE TABLE IF NOT EXISTS `follows` (`id` varchar(256),`follower_id` varchar(256) NOT NULL,`followee_id` varchar(256) NOT NULL,`created_at` datetime(3) NULL,PRIMARY KEY (`id`));
+CREATE TABLE IF NOT EXISTS `user_mfas` (`user_id` varchar(256),`secret` varchar(256) NOT NULL,`enabled` boolean DEFAULT false,`confirmed_at` datetime(3) NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`user_id`));
+CREATE TABLE IF NOT EXISTS `recovery_codes` (`id` varchar(256),`user_id` varchar(256) NOT NULL,`code_hash` varchar(256) NOT NULL,`used_at` datetime(3) NULL,`created_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_recovery_codes_user_id` (`user_id`));
+CREATE TABLE IF NOT EXISTS `items` (`id` bigint AUTO_INCREMENT,`name` varchar(150) NOT NULL,`price_usd` double NOT NULL,`category_id` bigint NOT NULL,`supplier_id` bigint NOT NULL,PRIMARY KEY (`id`));
+CREATE TABLE IF NOT EXISTS `categories` (`id` bigint AUTO_INCREMENT,`name` varchar(100) NOT NULL,`macro_category_id` bigint NOT NULL,PRIMARY KEY (`id`));
+CREATE TABLE IF NOT EXISTS `macro_categories` (`id` bigint AUTO_INCREMENT,`name` varchar(100) NOT NULL,PRIMARY KEY (`id`));
+CREATE TABLE IF NOT EXISTS `suppliers` (`id` bigint AUTO_INCREMENT,`name` varchar(100) NOT NULL,PRIMARY KEY (`id`));
+CREATE TABLE IF NOT EXISTS `service_accounts` (`id` varchar(256),`name` varchar(256) NOT NULL,`description` varchar(256),`created_by` varchar(256) NOT NULL,`disabled` boolean DEFAULT false,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),CONSTRAINT `uni_service_accounts_name` UNIQUE (`name`));
+CREATE TABLE IF NOT EXISTS `api_keys` (`id` varchar(256),`service_account_id` varchar(256) NOT NULL,`name` varchar(256) NOT NULL,`prefix` varchar(256) NOT NULL,`secret_hash` varchar(256) NOT NULL,`scopes` text[],`expires_at` datetime(3) NULL,`last_used_at` datetime(3) NULL,`revoked_at` datetime(3) NULL,`created_by` varchar(256) NOT NULL,`created_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_api_keys_service_account_id` (`service_account_id`),UNIQUE INDEX `idx_api_keys_prefix` (`prefix`));
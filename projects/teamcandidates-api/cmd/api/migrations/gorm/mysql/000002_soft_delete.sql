@@ -0,0 +1,5 @@
+-- Soft delete consistente: deleted_at y deleted_by en todas las entidades que admiten restore y purga.
+ALTER TABLE `assessments` ADD COLUMN `deleted_at` datetime(3) NULL, ADD COLUMN `deleted_by` varchar(256), ADD INDEX `idx_assessments_deleted_at` (`deleted_at`);
+ALTER TABLE `items` ADD COLUMN `deleted_at` datetime(3) NULL, ADD COLUMN `deleted_by` varchar(256), ADD INDEX `idx_items_deleted_at` (`deleted_at`);
+ALTER TABLE `candidates` ADD COLUMN `deleted_by` varchar(256);
+ALTER TABLE `users` ADD COLUMN `deleted_by` varchar(256);
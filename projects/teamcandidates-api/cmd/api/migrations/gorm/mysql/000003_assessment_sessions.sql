@@ -0,0 +1,3 @@
+-- Sesiones del candidato (start, autosave y submit) y pruebas ocultas al candidato.
+CREATE TABLE IF NOT EXISTS `assessment_sessions` (`id` varchar(256),`assessment_id` varchar(256) NOT NULL,`link_id` varchar(256) NOT NULL,`language` varchar(50),`code` text,`answers` text,`revision` bigint NOT NULL DEFAULT 0,`started_at` datetime(3) NOT NULL,`last_saved_at` datetime(3) NULL,`submitted_at` datetime(3) NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),UNIQUE INDEX `idx_assessment_sessions_assessment_id` (`assessment_id`),INDEX `idx_assessment_sessions_link_id` (`link_id`));
+ALTER TABLE `unit_tests` ADD COLUMN `hidden` boolean NOT NULL DEFAULT false;
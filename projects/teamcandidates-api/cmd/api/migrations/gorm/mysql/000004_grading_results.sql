@@ -0,0 +1,3 @@
+-- Correcciones automáticas de las entregas y resultado de cada prueba.
+CREATE TABLE IF NOT EXISTS `grading_results` (`id` varchar(256),`assessment_id` varchar(256) NOT NULL,`session_id` varchar(256),`language` varchar(50),`status` varchar(50) NOT NULL,`passed` bigint NOT NULL DEFAULT 0,`total` bigint NOT NULL DEFAULT 0,`score` double NOT NULL DEFAULT 0,`compile_output` text,`error` text,`queued_at` datetime(3) NOT NULL,`started_at` datetime(3) NULL,`finished_at` datetime(3) NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_grading_results_session_id` (`session_id`),INDEX `idx_grading_results_assessment_id` (`assessment_id`));
+CREATE TABLE IF NOT EXISTS `grading_test_results` (`id` varchar(256),`result_id` varchar(256) NOT NULL,`position` bigint NOT NULL DEFAULT 0,`unit_test_id` varchar(256) NOT NULL,`test_name` varchar(100),`hidden` boolean NOT NULL DEFAULT false,`passed` boolean NOT NULL DEFAULT false,`output` text,`error` text,`exit_code` bigint NOT NULL DEFAULT 0,`runtime_ms` bigint NOT NULL DEFAULT 0,`memory_kb` bigint NOT NULL DEFAULT 0,`timed_out` boolean NOT NULL DEFAULT false,`created_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_grading_test_results_result_id` (`result_id`),CONSTRAINT `fk_grading_results_tests` FOREIGN KEY (`result_id`) REFERENCES `grading_results`(`id`) ON DELETE CASCADE);
@@ -0,0 +1,5 @@
+-- Máquina de estados de las evaluaciones: deadline fijado al iniciar e historial de transiciones.
+ALTER TABLE `assessments` ADD COLUMN `deadline_at` datetime(3) NULL, ADD INDEX `idx_assessments_deadline_at` (`deadline_at`);
+CREATE TABLE IF NOT EXISTS `assessment_status_transitions` (`id` varchar(256),`assessment_id` varchar(256) NOT NULL,`from_status` varchar(50),`to_status` varchar(50) NOT NULL,`reason` text,`actor` varchar(100),`occurred_at` datetime(3) NOT NULL,PRIMARY KEY (`id`),INDEX `idx_assessment_status_transitions_assessment_id` (`assessment_id`),CONSTRAINT `fk_assessments_history` FOREIGN KEY (`assessment_id`) REFERENCES `assessments`(`id`) ON DELETE CASCADE);
+UPDATE `assessments` SET `status` = 'submitted' WHERE `status` = 'completed';
+UPDATE `assessments` SET `deadline_at` = DATE_ADD(`start_date`, INTERVAL `max_duration` MINUTE) WHERE `status` = 'in_progress' AND `max_duration` > 0;
@@ -0,0 +1,7 @@
+-- Banco de problemas versionado y origen en el banco del problema de cada evaluación.
+ALTER TABLE `problems` ADD COLUMN `language` varchar(50), ADD COLUMN `starter_code` text, ADD COLUMN `bank_problem_id` varchar(256), ADD COLUMN `bank_version` bigint NOT NULL DEFAULT 0, ADD INDEX `idx_problems_bank_problem_id` (`bank_problem_id`);
+CREATE TABLE IF NOT EXISTS `bank_problems` (`id` varchar(256),`title` varchar(200) NOT NULL,`difficulty` varchar(20) NOT NULL,`archived` boolean NOT NULL DEFAULT false,`current_version` bigint NOT NULL DEFAULT 1,`created_by` varchar(256),`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_bank_problems_archived` (`archived`),INDEX `idx_bank_problems_difficulty` (`difficulty`));
+CREATE TABLE IF NOT EXISTS `bank_problem_tags` (`id` varchar(256),`problem_id` varchar(256) NOT NULL,`tag` varchar(50) NOT NULL,PRIMARY KEY (`id`),INDEX `idx_bank_problem_tags_tag` (`tag`),INDEX `idx_bank_problem_tags_problem_id` (`problem_id`),CONSTRAINT `fk_bank_problems_tags` FOREIGN KEY (`problem_id`) REFERENCES `bank_problems`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `bank_problem_skills` (`id` varchar(256),`problem_id` varchar(256) NOT NULL,`skill_name` varchar(100) NOT NULL,`skill_level` varchar(50),PRIMARY KEY (`id`),INDEX `idx_bank_problem_skills_skill_name` (`skill_name`),INDEX `idx_bank_problem_skills_problem_id` (`problem_id`),CONSTRAINT `fk_bank_problems_skills` FOREIGN KEY (`problem_id`) REFERENCES `bank_problems`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `bank_problem_versions` (`id` varchar(256),`problem_id` varchar(256) NOT NULL,`version` bigint NOT NULL,`statement` text NOT NULL,`language` varchar(50),`starter_code` text,`created_by` varchar(256),`created_at` datetime(3) NULL,PRIMARY KEY (`id`),UNIQUE INDEX `idx_bank_problem_versions_problem_version` (`problem_id`,`version`),CONSTRAINT `fk_bank_problems_versions` FOREIGN KEY (`problem_id`) REFERENCES `bank_problems`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `bank_problem_tests` (`id` varchar(256),`version_id` varchar(256) NOT NULL,`position` bigint NOT NULL DEFAULT 0,`test_name` varchar(100) NOT NULL,`input_data` text NOT NULL,`expected_output` text NOT NULL,`hidden` boolean NOT NULL DEFAULT false,PRIMARY KEY (`id`),INDEX `idx_bank_problem_tests_version_id` (`version_id`),CONSTRAINT `fk_bank_problem_versions_unit_tests` FOREIGN KEY (`version_id`) REFERENCES `bank_problem_versions`(`id`) ON DELETE CASCADE);
@@ -0,0 +1,4 @@
+-- Detección de similitud entre entregas: huellas de cada entrega y entregas parecidas por corrección.
+ALTER TABLE `grading_results` ADD COLUMN `similarity_status` varchar(20), ADD COLUMN `similarity_reason` text, ADD COLUMN `similarity_compared` bigint NOT NULL DEFAULT 0, ADD COLUMN `similarity_score` double NOT NULL DEFAULT 0, ADD COLUMN `similarity_flagged` boolean NOT NULL DEFAULT false, ADD INDEX `idx_grading_results_similarity_flagged` (`similarity_flagged`);
+CREATE TABLE IF NOT EXISTS `grading_similarity_matches` (`id` varchar(256),`result_id` varchar(256) NOT NULL,`position` bigint NOT NULL DEFAULT 0,`assessment_id` varchar(256) NOT NULL,`session_id` varchar(256),`candidate_id` varchar(256),`score` double NOT NULL DEFAULT 0,`regions` text,`created_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_grading_similarity_matches_candidate_id` (`candidate_id`),INDEX `idx_grading_similarity_matches_assessment_id` (`assessment_id`),INDEX `idx_grading_similarity_matches_result_id` (`result_id`),CONSTRAINT `fk_grading_results_similarity_matches` FOREIGN KEY (`result_id`) REFERENCES `grading_results`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `grading_submissions` (`id` varchar(256),`assessment_id` varchar(256) NOT NULL,`session_id` varchar(256) NOT NULL,`candidate_id` varchar(256),`problem_key` varchar(300) NOT NULL,`language` varchar(50),`tokens` bigint NOT NULL DEFAULT 0,`fingerprints` text,`submitted_at` datetime(3) NOT NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_grading_submissions_assessment_id` (`assessment_id`),INDEX `idx_grading_submissions_submitted_at` (`submitted_at`),INDEX `idx_grading_submissions_problem_key` (`problem_key`),INDEX `idx_grading_submissions_candidate_id` (`candidate_id`),UNIQUE INDEX `idx_grading_submissions_session_id` (`session_id`));
@@ -0,0 +1,4 @@
+-- Reporte de calidad del código y puntaje final (rúbrica) de cada corrección.
+ALTER TABLE `grading_results` ADD COLUMN `rubric_score` double NOT NULL DEFAULT 0, ADD COLUMN `quality_status` varchar(20), ADD COLUMN `quality_score` double NOT NULL DEFAULT 0, ADD COLUMN `quality_report` text;
+-- Las correcciones anteriores no tienen reporte de calidad: su puntaje final es el de las pruebas.
+UPDATE `grading_results` SET `rubric_score` = `score`;
@@ -0,0 +1,2 @@
+-- Ciclo de vida de los links de evaluación: envío, apertura, inicio, revocación y recordatorios.
+ALTER TABLE `links` ADD COLUMN `sent_at` datetime(3) NULL, ADD COLUMN `opened_at` datetime(3) NULL, ADD COLUMN `started_at` datetime(3) NULL, ADD COLUMN `revoked_at` datetime(3) NULL, ADD COLUMN `revoke_reason` text, ADD COLUMN `replaced_by` varchar(255), ADD COLUMN `reminders_sent` bigint NOT NULL DEFAULT 0, ADD COLUMN `last_reminder_at` datetime(3) NULL, ADD INDEX `idx_links_revoked_at` (`revoked_at`);
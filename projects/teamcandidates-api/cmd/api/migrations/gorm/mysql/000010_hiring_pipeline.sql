@@ -0,0 +1,4 @@
+-- Tablero de selección por búsqueda: etapas configuradas, candidatos por etapa e historial de transiciones.
+CREATE TABLE IF NOT EXISTS `pipelines` (`job_opening_id` varchar(256),`stages` text NOT NULL,`assessment_stage` varchar(50),`assessment_completed_stage` varchar(50),`updated_by` varchar(256),`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`job_opening_id`));
+CREATE TABLE IF NOT EXISTS `pipeline_entries` (`id` varchar(256),`job_opening_id` varchar(256) NOT NULL,`candidate_id` varchar(256) NOT NULL,`stage` varchar(50) NOT NULL,`entered_stage_at` datetime(3) NOT NULL,`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_pipeline_entries_stage` (`stage`),INDEX `idx_pipeline_entries_candidate_id` (`candidate_id`),UNIQUE INDEX `idx_pipeline_entries_opening_candidate` (`job_opening_id`,`candidate_id`));
+CREATE TABLE IF NOT EXISTS `pipeline_transitions` (`id` varchar(256),`entry_id` varchar(256) NOT NULL,`job_opening_id` varchar(256) NOT NULL,`candidate_id` varchar(256) NOT NULL,`from_stage` varchar(50),`to_stage` varchar(50) NOT NULL,`reason` text,`actor` varchar(256),`automatic` boolean NOT NULL DEFAULT false,`occurred_at` datetime(3) NOT NULL,PRIMARY KEY (`id`),INDEX `idx_pipeline_transitions_entry_id` (`entry_id`));
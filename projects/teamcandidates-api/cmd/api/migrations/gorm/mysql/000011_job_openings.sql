@@ -0,0 +1,6 @@
+-- Búsquedas con skills requeridas y responsables de RR. HH., postulaciones de candidatos y búsqueda de cada evaluación.
+ALTER TABLE `assessments` ADD COLUMN `job_opening_id` varchar(256), ADD INDEX `idx_assessments_job_opening_id` (`job_opening_id`);
+CREATE TABLE IF NOT EXISTS `job_openings` (`id` varchar(256),`title` varchar(200) NOT NULL,`team` varchar(100),`location_address` varchar(200),`location_city` varchar(100),`location_state` varchar(100),`location_country` varchar(100),`location_postal_code` varchar(20),`experience_level` varchar(50),`status` varchar(20) NOT NULL,`created_by` varchar(256),`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,PRIMARY KEY (`id`),INDEX `idx_job_openings_status` (`status`),INDEX `idx_job_openings_team` (`team`));
+CREATE TABLE IF NOT EXISTS `job_opening_skills` (`id` varchar(256),`job_opening_id` varchar(256) NOT NULL,`skill_name` varchar(100) NOT NULL,`skill_level` varchar(50),PRIMARY KEY (`id`),INDEX `idx_job_opening_skills_skill_name` (`skill_name`),INDEX `idx_job_opening_skills_job_opening_id` (`job_opening_id`),CONSTRAINT `fk_job_openings_skills` FOREIGN KEY (`job_opening_id`) REFERENCES `job_openings`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `job_opening_owners` (`id` varchar(256),`job_opening_id` varchar(256) NOT NULL,`user_id` varchar(256) NOT NULL,PRIMARY KEY (`id`),INDEX `idx_job_opening_owners_user_id` (`user_id`),UNIQUE INDEX `idx_job_opening_owners_opening_user` (`job_opening_id`,`user_id`),CONSTRAINT `fk_job_openings_owners` FOREIGN KEY (`job_opening_id`) REFERENCES `job_openings`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `job_applications` (`id` varchar(256),`job_opening_id` varchar(256) NOT NULL,`candidate_id` varchar(256) NOT NULL,`source` varchar(50),`applied_by` varchar(256),`applied_at` datetime(3) NOT NULL,PRIMARY KEY (`id`),INDEX `idx_job_applications_applied_at` (`applied_at`),INDEX `idx_job_applications_candidate_id` (`candidate_id`),UNIQUE INDEX `idx_job_applications_opening_candidate` (`job_opening_id`,`candidate_id`),CONSTRAINT `fk_job_openings_applications` FOREIGN KEY (`job_opening_id`) REFERENCES `job_openings`(`id`) ON DELETE CASCADE);
@@ -0,0 +1,9 @@
+-- Soft delete consistente: deleted_at y deleted_by en todas las entidades que admiten restore y purga.
+ALTER TABLE "assessments" ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz;
+ALTER TABLE "assessments" ADD COLUMN IF NOT EXISTS "deleted_by" text;
+CREATE INDEX IF NOT EXISTS "idx_assessments_deleted_at" ON "assessments" ("deleted_at");
+ALTER TABLE "items" ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz;
+ALTER TABLE "items" ADD COLUMN IF NOT EXISTS "deleted_by" text;
+CREATE INDEX IF NOT EXISTS "idx_items_deleted_at" ON "items" ("deleted_at");
+ALTER TABLE "candidates" ADD COLUMN IF NOT EXISTS "deleted_by" text;
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "deleted_by" text;
@@ -0,0 +1,5 @@
+-- Sesiones del candidato (start, autosave y submit) y pruebas ocultas al candidato.
+CREATE TABLE IF NOT EXISTS "assessment_sessions" ("id" text,"assessment_id" text NOT NULL,"link_id" text NOT NULL,"language" varchar(50),"code" text,"answers" text,"revision" bigint NOT NULL DEFAULT 0,"started_at" timestamptz NOT NULL,"last_saved_at" timestamptz,"submitted_at" timestamptz,"created_at" timestamptz,"updated_at" timestamptz,PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_assessment_sessions_assessment_id" ON "assessment_sessions" ("assessment_id");
+CREATE INDEX IF NOT EXISTS "idx_assessment_sessions_link_id" ON "assessment_sessions" ("link_id");
+ALTER TABLE "unit_tests" ADD COLUMN IF NOT EXISTS "hidden" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,6 @@
+-- Correcciones automáticas de las entregas y resultado de cada prueba.
+CREATE TABLE IF NOT EXISTS "grading_results" ("id" text,"assessment_id" text NOT NULL,"session_id" text,"language" varchar(50),"status" varchar(50) NOT NULL,"passed" bigint NOT NULL DEFAULT 0,"total" bigint NOT NULL DEFAULT 0,"score" decimal NOT NULL DEFAULT 0,"compile_output" text,"error" text,"queued_at" timestamptz NOT NULL,"started_at" timestamptz,"finished_at" timestamptz,"created_at" timestamptz,"updated_at" timestamptz,PRIMARY KEY ("id"));
+CREATE INDEX IF NOT EXISTS "idx_grading_results_session_id" ON "grading_results" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_grading_results_assessment_id" ON "grading_results" ("assessment_id");
+CREATE TABLE IF NOT EXISTS "grading_test_results" ("id" text,"result_id" text NOT NULL,"position" bigint NOT NULL DEFAULT 0,"unit_test_id" text NOT NULL,"test_name" varchar(100),"hidden" boolean NOT NULL DEFAULT false,"passed" boolean NOT NULL DEFAULT false,"output" text,"error" text,"exit_code" bigint NOT NULL DEFAULT 0,"runtime_ms" bigint NOT NULL DEFAULT 0,"memory_kb" bigint NOT NULL DEFAULT 0,"timed_out" boolean NOT NULL DEFAULT false,"created_at" timestamptz,PRIMARY KEY ("id"),CONSTRAINT "fk_grading_results_tests" FOREIGN KEY ("result_id") REFERENCES "grading_results"("id") ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS "idx_grading_test_results_result_id" ON "grading_test_results" ("result_id");
@@ -0,0 +1,7 @@
+-- Máquina de estados de las evaluaciones: deadline fijado al iniciar e historial de transiciones.
+ALTER TABLE "assessments" ADD COLUMN IF NOT EXISTS "deadline_at" timestamptz;
+CREATE INDEX IF NOT EXISTS "idx_assessments_deadline_at" ON "assessments" ("deadline_at");
+CREATE TABLE IF NOT EXISTS "assessment_status_transitions" ("id" text,"assessment_id" text NOT NULL,"from_status" varchar(50),"to_status" varchar(50) NOT NULL,"reason" text,"actor" varchar(100),"occurred_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "fk_assessments_history" FOREIGN KEY ("assessment_id") REFERENCES "assessments"("id") ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS "idx_assessment_status_transitions_assessment_id" ON "assessment_status_transitions" ("assessment_id");
+UPDATE "assessments" SET "status" = 'submitted' WHERE "status" = 'completed';
+UPDATE "assessments" SET "deadline_at" = "start_date" + "max_duration" * interval '1 minute' WHERE "status" = 'in_progress' AND "max_duration" > 0;
@@ -0,0 +1,7 @@
+-- Reporte de calidad del código y puntaje final (rúbrica) de cada corrección.
+ALTER TABLE "grading_results" ADD COLUMN IF NOT EXISTS "rubric_score" decimal NOT NULL DEFAULT 0;
+ALTER TABLE "grading_results" ADD COLUMN IF NOT EXISTS "quality_status" varchar(20);
+ALTER TABLE "grading_results" ADD COLUMN IF NOT EXISTS "quality_score" decimal NOT NULL DEFAULT 0;
+ALTER TABLE "grading_results" ADD COLUMN IF NOT EXISTS "quality_report" text;
+-- Las correcciones anteriores no tienen reporte de calidad: su puntaje final es el de las pruebas.
+UPDATE "grading_results" SET "rubric_score" = "score";
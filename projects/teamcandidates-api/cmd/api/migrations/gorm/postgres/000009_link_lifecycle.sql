@@ -0,0 +1,10 @@
+-- Ciclo de vida de los links de evaluación: envío, apertura, inicio, revocación y recordatorios.
+ALTER TABLE "links" ADD COLUMN IF NOT EXISTS "sent_at" timestamptz;
+ALTER TABLE "links" ADD COLUMN IF NOT EXISTS "opened_at" timestamptz;
+ALTER TABLE "links" ADD COLUMN IF NOT EXISTS "started_at" timestamptz;
+ALTER TABLE "links" ADD COLUMN IF NOT EXISTS "revoked_at" timestamptz;
+ALTER TABLE "links" ADD COLUMN IF NOT EXISTS "revoke_reason" text;
+ALTER TABLE "links" ADD COLUMN IF NOT EXISTS "replaced_by" varchar(255);
+ALTER TABLE "links" ADD COLUMN IF NOT EXISTS "reminders_sent" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "links" ADD COLUMN IF NOT EXISTS "last_reminder_at" timestamptz;
+CREATE INDEX IF NOT EXISTS "idx_links_revoked_at" ON "links" ("revoked_at");
@@ -0,0 +1,8 @@
+-- Tablero de selección por búsqueda: etapas configuradas, candidatos por etapa e historial de transiciones.
+CREATE TABLE IF NOT EXISTS "pipelines" ("job_opening_id" varchar(256),"stages" text NOT NULL,"assessment_stage" varchar(50),"assessment_completed_stage" varchar(50),"updated_by" varchar(256),"created_at" timestamptz,"updated_at" timestamptz,PRIMARY KEY ("job_opening_id"));
+CREATE TABLE IF NOT EXISTS "pipeline_entries" ("id" text,"job_opening_id" varchar(256) NOT NULL,"candidate_id" varchar(256) NOT NULL,"stage" varchar(50) NOT NULL,"entered_stage_at" timestamptz NOT NULL,"created_at" timestamptz,"updated_at" timestamptz,PRIMARY KEY ("id"));
+CREATE INDEX IF NOT EXISTS "idx_pipeline_entries_stage" ON "pipeline_entries" ("stage");
+CREATE INDEX IF NOT EXISTS "idx_pipeline_entries_candidate_id" ON "pipeline_entries" ("candidate_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_pipeline_entries_opening_candidate" ON "pipeline_entries" ("job_opening_id","candidate_id");
+CREATE TABLE IF NOT EXISTS "pipeline_transitions" ("id" text,"entry_id" text NOT NULL,"job_opening_id" varchar(256) NOT NULL,"candidate_id" varchar(256) NOT NULL,"from_stage" varchar(50),"to_stage" varchar(50) NOT NULL,"reason" text,"actor" varchar(256),"automatic" boolean NOT NULL DEFAULT false,"occurred_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+CREATE INDEX IF NOT EXISTS "idx_pipeline_transitions_entry_id" ON "pipeline_transitions" ("entry_id");
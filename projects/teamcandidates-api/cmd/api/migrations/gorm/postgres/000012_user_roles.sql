@@ -0,0 +1,3 @@
+-- Roles de usuario (los permisos de cada rol se definen en código); los usuarios existentes quedan con el rol "user".
+CREATE TABLE IF NOT EXISTS "user_roles" ("user_id" text,"role" varchar(50),"created_at" timestamptz,PRIMARY KEY ("user_id","role"));
+INSERT INTO "user_roles" ("user_id","role","created_at") SELECT "id",'user',CURRENT_TIMESTAMP FROM "users" ON CONFLICT DO NOTHING;
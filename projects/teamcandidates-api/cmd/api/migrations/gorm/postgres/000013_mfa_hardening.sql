@@ -0,0 +1,3 @@
+-- Protección contra replay de códigos TOTP: última ventana aceptada por usuario.
+-- Los secretos TOTP pasan a guardarse cifrados; los existentes en claro se cifran en la siguiente verificación exitosa.
+ALTER TABLE "user_mfas" ADD COLUMN IF NOT EXISTS "last_used_step" bigint NOT NULL DEFAULT 0;
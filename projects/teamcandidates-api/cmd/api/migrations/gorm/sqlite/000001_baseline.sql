@@ -0,0 +1,32 @@
+-- Esquema base equivalente al AutoMigrate previo. Idempotente para adoptar bases ya creadas por AutoMigrate.
+CREATE TABLE IF NOT EXISTS `groups` (`id` uuid,`name` text NOT NULL,`description` text,`category` text,`created_at` datetime,`updated_at` datetime,`privacy` text NOT NULL,`max_members` integer DEFAULT null,`image_url` text,`organizer_id` text NOT NULL,`is_organizer_company` numeric NOT NULL,`status` text NOT NULL DEFAULT "active",PRIMARY KEY (`id`));
+CREATE TABLE IF NOT EXISTS `group_members` (`group_id` uuid,`user_id` text,`role` text NOT NULL,`joined_at` datetime,PRIMARY KEY (`group_id`,`user_id`),CONSTRAINT `fk_groups_group_members` FOREIGN KEY (`group_id`) REFERENCES `groups`(`id`) ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS `assessments` (`id` text,`hr_id` text,`candidate_id` text,`start_date` datetime NOT NULL,`end_date` datetime,`status` varchar(50) NOT NULL,`max_duration` integer NOT NULL,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`));
+CREATE INDEX IF NOT EXISTS `idx_assessments_candidate_id` ON `assessments`(`candidate_id`);
+CREATE INDEX IF NOT EXISTS `idx_assessments_hr_id` ON `assessments`(`hr_id`);
+CREATE TABLE IF NOT EXISTS `problems` (`id` text,`assessment_id` text NOT NULL,`description` text NOT NULL,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`),CONSTRAINT `fk_assessments_problem` FOREIGN KEY (`assessment_id`) REFERENCES `assessments`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_problems_assessment_id` ON `problems`(`assessment_id`);
+CREATE TABLE IF NOT EXISTS `skill_configs` (`id` text,`assessment_id` text NOT NULL,`skill_name` varchar(100) NOT NULL,`skill_level` varchar(50) NOT NULL,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`),CONSTRAINT `fk_assessments_skills` FOREIGN KEY (`assessment_id`) REFERENCES `assessments`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_skill_configs_assessment_id` ON `skill_configs`(`assessment_id`);
+CREATE TABLE IF NOT EXISTS `unit_tests` (`id` text,`assessment_id` text NOT NULL,`test_name` varchar(100) NOT NULL,`input_data` text NOT NULL,`expected_output` text NOT NULL,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`),CONSTRAINT `fk_assessments_unit_tests` FOREIGN KEY (`assessment_id`) REFERENCES `assessments`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_unit_tests_assessment_id` ON `unit_tests`(`assessment_id`);
+CREATE TABLE IF NOT EXISTS `candidates` (`id` text,`person_id` text NOT NULL,`email` text NOT NULL,`experience_level` text,`experience_rank` integer,`assessments_ids` text[],`created_at` datetime,`updated_at` datetime,`deleted_at` datetime,PRIMARY KEY (`id`),CONSTRAINT `uni_candidates_email` UNIQUE (`email`));
+CREATE INDEX IF NOT EXISTS `idx_candidates_deleted_at` ON `candidates`(`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_candidates_person_id` ON `candidates`(`person_id`);
+CREATE TABLE IF NOT EXISTS `links` (`id` text,`assessment_id` text NOT NULL,`token` varchar(255) NOT NULL,`expires_at` datetime NOT NULL,`url` text NOT NULL,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`));
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_links_token` ON `links`(`token`);
+CREATE INDEX IF NOT EXISTS `idx_links_assessment_id` ON `links`(`assessment_id`);
+CREATE TABLE IF NOT EXISTS `users` (`id` text,`person_id` text,`email` text NOT NULL,`password` text NOT NULL,`email_validated` numeric DEFAULT false,`user_type` text NOT NULL,`logged_at` datetime,`created_at` datetime,`updated_at` datetime,`deleted_at` datetime,PRIMARY KEY (`id`),CONSTRAINT `uni_users_email` UNIQUE (`email`));
+CREATE INDEX IF NOT EXISTS `idx_users_deleted_at` ON `users`(`deleted_at`);
+CREATE TABLE IF NOT EXISTS `follows` (`id` text,`follower_id` text NOT NULL,`followee_id` text NOT NULL,`created_at` datetime,PRIMARY KEY (`id`));
+CREATE TABLE IF NOT EXISTS `user_mfas` (`user_id` text,`secret` text NOT NULL,`enabled` numeric DEFAULT false,`confirmed_at` datetime,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`user_id`));
+CREATE TABLE IF NOT EXISTS `recovery_codes` (`id` text,`user_id` text NOT NULL,`code_hash` text NOT NULL,`used_at` datetime,`created_at` datetime,PRIMARY KEY (`id`));
+CREATE INDEX IF NOT EXISTS `idx_recovery_codes_user_id` ON `recovery_codes`(`user_id`);
+CREATE TABLE IF NOT EXISTS `items` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` varchar(150) NOT NULL,`price_usd` real NOT NULL,`category_id` integer NOT NULL,`supplier_id` integer NOT NULL);
+CREATE TABLE IF NOT EXISTS `categories` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` varchar(100) NOT NULL,`macro_category_id` integer NOT NULL);
+CREATE TABLE IF NOT EXISTS `macro_categories` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` varchar(100) NOT NULL);
+CREATE TABLE IF NOT EXISTS `suppliers` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` varchar(100) NOT NULL);
+CREATE TABLE IF NOT EXISTS `service_accounts` (`id` text,`name` text NOT NULL,`description` text,`created_by` text NOT NULL,`disabled` numeric DEFAULT false,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`),CONSTRAINT `uni_service_accounts_name` UNIQUE (`name`));
+CREATE TABLE IF NOT EXISTS `api_keys` (`id` text,`service_account_id` text NOT NULL,`name` text NOT NULL,`prefix` text NOT NULL,`secret_hash` text NOT NULL,`scopes` text[],`expires_at` datetime,`last_used_at` datetime,`revoked_at` datetime,`created_by` text NOT NULL,`created_at` datetime,PRIMARY KEY (`id`));
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_api_keys_prefix` ON `api_keys`(`prefix`);
+CREATE INDEX IF NOT EXISTS `idx_api_keys_service_account_id` ON `api_keys`(`service_account_id`);
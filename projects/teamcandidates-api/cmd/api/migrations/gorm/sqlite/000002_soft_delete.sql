@@ -0,0 +1,10 @@
+-- Soft delete consistente: deleted_at y deleted_by en todas las entidades que admiten restore y purga.
+-- SQLite solo admite una columna por ALTER TABLE.
+ALTER TABLE `assessments` ADD COLUMN `deleted_at` datetime;
+ALTER TABLE `assessments` ADD COLUMN `deleted_by` text;
+CREATE INDEX IF NOT EXISTS `idx_assessments_deleted_at` ON `assessments`(`deleted_at`);
+ALTER TABLE `items` ADD COLUMN `deleted_at` datetime;
+ALTER TABLE `items` ADD COLUMN `deleted_by` text;
+CREATE INDEX IF NOT EXISTS `idx_items_deleted_at` ON `items`(`deleted_at`);
+ALTER TABLE `candidates` ADD COLUMN `deleted_by` text;
+ALTER TABLE `users` ADD COLUMN `deleted_by` text;
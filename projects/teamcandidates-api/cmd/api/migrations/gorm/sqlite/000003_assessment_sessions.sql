@@ -0,0 +1,5 @@
+-- Sesiones del candidato (start, autosave y submit) y pruebas ocultas al candidato.
+CREATE TABLE IF NOT EXISTS `assessment_sessions` (`id` text,`assessment_id` text NOT NULL,`link_id` text NOT NULL,`language` varchar(50),`code` text,`answers` text,`revision` integer NOT NULL DEFAULT 0,`started_at` datetime NOT NULL,`last_saved_at` datetime,`submitted_at` datetime,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`));
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_assessment_sessions_assessment_id` ON `assessment_sessions`(`assessment_id`);
+CREATE INDEX IF NOT EXISTS `idx_assessment_sessions_link_id` ON `assessment_sessions`(`link_id`);
+ALTER TABLE `unit_tests` ADD COLUMN `hidden` numeric NOT NULL DEFAULT false;
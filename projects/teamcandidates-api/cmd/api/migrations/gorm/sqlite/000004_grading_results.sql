@@ -0,0 +1,6 @@
+-- Correcciones automáticas de las entregas y resultado de cada prueba.
+CREATE TABLE IF NOT EXISTS `grading_results` (`id` text,`assessment_id` text NOT NULL,`session_id` text,`language` varchar(50),`status` varchar(50) NOT NULL,`passed` integer NOT NULL DEFAULT 0,`total` integer NOT NULL DEFAULT 0,`score` real NOT NULL DEFAULT 0,`compile_output` text,`error` text,`queued_at` datetime NOT NULL,`started_at` datetime,`finished_at` datetime,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`));
+CREATE INDEX IF NOT EXISTS `idx_grading_results_session_id` ON `grading_results`(`session_id`);
+CREATE INDEX IF NOT EXISTS `idx_grading_results_assessment_id` ON `grading_results`(`assessment_id`);
+CREATE TABLE IF NOT EXISTS `grading_test_results` (`id` text,`result_id` text NOT NULL,`position` integer NOT NULL DEFAULT 0,`unit_test_id` text NOT NULL,`test_name` varchar(100),`hidden` numeric NOT NULL DEFAULT false,`passed` numeric NOT NULL DEFAULT false,`output` text,`error` text,`exit_code` integer NOT NULL DEFAULT 0,`runtime_ms` integer NOT NULL DEFAULT 0,`memory_kb` integer NOT NULL DEFAULT 0,`timed_out` numeric NOT NULL DEFAULT false,`created_at` datetime,PRIMARY KEY (`id`),CONSTRAINT `fk_grading_results_tests` FOREIGN KEY (`result_id`) REFERENCES `grading_results`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_grading_test_results_result_id` ON `grading_test_results`(`result_id`);
@@ -0,0 +1,19 @@
+-- Banco de problemas versionado y origen en el banco del problema de cada evaluación.
+ALTER TABLE `problems` ADD COLUMN `language` varchar(50);
+ALTER TABLE `problems` ADD COLUMN `starter_code` text;
+ALTER TABLE `problems` ADD COLUMN `bank_problem_id` text;
+ALTER TABLE `problems` ADD COLUMN `bank_version` integer NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS `idx_problems_bank_problem_id` ON `problems`(`bank_problem_id`);
+CREATE TABLE IF NOT EXISTS `bank_problems` (`id` text,`title` varchar(200) NOT NULL,`difficulty` varchar(20) NOT NULL,`archived` numeric NOT NULL DEFAULT false,`current_version` integer NOT NULL DEFAULT 1,`created_by` varchar(256),`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`));
+CREATE INDEX IF NOT EXISTS `idx_bank_problems_archived` ON `bank_problems`(`archived`);
+CREATE INDEX IF NOT EXISTS `idx_bank_problems_difficulty` ON `bank_problems`(`difficulty`);
+CREATE TABLE IF NOT EXISTS `bank_problem_tags` (`id` text,`problem_id` text NOT NULL,`tag` varchar(50) NOT NULL,PRIMARY KEY (`id`),CONSTRAINT `fk_bank_problems_tags` FOREIGN KEY (`problem_id`) REFERENCES `bank_problems`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_bank_problem_tags_tag` ON `bank_problem_tags`(`tag`);
+CREATE INDEX IF NOT EXISTS `idx_bank_problem_tags_problem_id` ON `bank_problem_tags`(`problem_id`);
+CREATE TABLE IF NOT EXISTS `bank_problem_skills` (`id` text,`problem_id` text NOT NULL,`skill_name` varchar(100) NOT NULL,`skill_level` varchar(50),PRIMARY KEY (`id`),CONSTRAINT `fk_bank_problems_skills` FOREIGN KEY (`problem_id`) REFERENCES `bank_problems`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_bank_problem_skills_skill_name` ON `bank_problem_skills`(`skill_name`);
+CREATE INDEX IF NOT EXISTS `idx_bank_problem_skills_problem_id` ON `bank_problem_skills`(`problem_id`);
+CREATE TABLE IF NOT EXISTS `bank_problem_versions` (`id` text,`problem_id` text NOT NULL,`version` integer NOT NULL,`statement` text NOT NULL,`language` varchar(50),`starter_code` text,`created_by` varchar(256),`created_at` datetime,PRIMARY KEY (`id`),CONSTRAINT `fk_bank_problems_versions` FOREIGN KEY (`problem_id`) REFERENCES `bank_problems`(`id`) ON DELETE CASCADE);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_bank_problem_versions_problem_version` ON `bank_problem_versions`(`problem_id`,`version`);
+CREATE TABLE IF NOT EXISTS `bank_problem_tests` (`id` text,`version_id` text NOT NULL,`position` integer NOT NULL DEFAULT 0,`test_name` varchar(100) NOT NULL,`input_data` text NOT NULL,`expected_output` text NOT NULL,`hidden` numeric NOT NULL DEFAULT false,PRIMARY KEY (`id`),CONSTRAINT `fk_bank_problem_versions_unit_tests` FOREIGN KEY (`version_id`) REFERENCES `bank_problem_versions`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_bank_problem_tests_version_id` ON `bank_problem_tests`(`version_id`);
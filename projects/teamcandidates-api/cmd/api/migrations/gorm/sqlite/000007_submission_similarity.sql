@@ -0,0 +1,17 @@
+-- Detección de similitud entre entregas: huellas de cada entrega y entregas parecidas por corrección.
+ALTER TABLE `grading_results` ADD COLUMN `similarity_status` varchar(20);
+ALTER TABLE `grading_results` ADD COLUMN `similarity_reason` text;
+ALTER TABLE `grading_results` ADD COLUMN `similarity_compared` integer NOT NULL DEFAULT 0;
+ALTER TABLE `grading_results` ADD COLUMN `similarity_score` real NOT NULL DEFAULT 0;
+ALTER TABLE `grading_results` ADD COLUMN `similarity_flagged` numeric NOT NULL DEFAULT false;
+CREATE INDEX IF NOT EXISTS `idx_grading_results_similarity_flagged` ON `grading_results`(`similarity_flagged`);
+CREATE TABLE IF NOT EXISTS `grading_similarity_matches` (`id` text,`result_id` text NOT NULL,`position` integer NOT NULL DEFAULT 0,`assessment_id` text NOT NULL,`session_id` text,`candidate_id` text,`score` real NOT NULL DEFAULT 0,`regions` text,`created_at` datetime,PRIMARY KEY (`id`),CONSTRAINT `fk_grading_results_similarity_matches` FOREIGN KEY (`result_id`) REFERENCES `grading_results`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_grading_similarity_matches_candidate_id` ON `grading_similarity_matches`(`candidate_id`);
+CREATE INDEX IF NOT EXISTS `idx_grading_similarity_matches_assessment_id` ON `grading_similarity_matches`(`assessment_id`);
+CREATE INDEX IF NOT EXISTS `idx_grading_similarity_matches_result_id` ON `grading_similarity_matches`(`result_id`);
+CREATE TABLE IF NOT EXISTS `grading_submissions` (`id` text,`assessment_id` text NOT NULL,`session_id` text NOT NULL,`candidate_id` text,`problem_key` varchar(300) NOT NULL,`language` varchar(50),`tokens` integer NOT NULL DEFAULT 0,`fingerprints` text,`submitted_at` datetime NOT NULL,`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`));
+CREATE INDEX IF NOT EXISTS `idx_grading_submissions_assessment_id` ON `grading_submissions`(`assessment_id`);
+CREATE INDEX IF NOT EXISTS `idx_grading_submissions_submitted_at` ON `grading_submissions`(`submitted_at`);
+CREATE INDEX IF NOT EXISTS `idx_grading_submissions_problem_key` ON `grading_submissions`(`problem_key`);
+CREATE INDEX IF NOT EXISTS `idx_grading_submissions_candidate_id` ON `grading_submissions`(`candidate_id`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_grading_submissions_session_id` ON `grading_submissions`(`session_id`);
@@ -0,0 +1,10 @@
+-- Ciclo de vida de los links de evaluación: envío, apertura, inicio, revocación y recordatorios.
+ALTER TABLE `links` ADD COLUMN `sent_at` datetime;
+ALTER TABLE `links` ADD COLUMN `opened_at` datetime;
+ALTER TABLE `links` ADD COLUMN `started_at` datetime;
+ALTER TABLE `links` ADD COLUMN `revoked_at` datetime;
+ALTER TABLE `links` ADD COLUMN `revoke_reason` text;
+ALTER TABLE `links` ADD COLUMN `replaced_by` varchar(255);
+ALTER TABLE `links` ADD COLUMN `reminders_sent` integer NOT NULL DEFAULT 0;
+ALTER TABLE `links` ADD COLUMN `last_reminder_at` datetime;
+CREATE INDEX IF NOT EXISTS `idx_links_revoked_at` ON `links`(`revoked_at`);
@@ -0,0 +1,16 @@
+-- Búsquedas con skills requeridas y responsables de RR. HH., postulaciones de candidatos y búsqueda de cada evaluación.
+ALTER TABLE `assessments` ADD COLUMN `job_opening_id` text;
+CREATE INDEX IF NOT EXISTS `idx_assessments_job_opening_id` ON `assessments`(`job_opening_id`);
+CREATE TABLE IF NOT EXISTS `job_openings` (`id` text,`title` varchar(200) NOT NULL,`team` varchar(100),`location_address` varchar(200),`location_city` varchar(100),`location_state` varchar(100),`location_country` varchar(100),`location_postal_code` varchar(20),`experience_level` varchar(50),`status` varchar(20) NOT NULL,`created_by` varchar(256),`created_at` datetime,`updated_at` datetime,PRIMARY KEY (`id`));
+CREATE INDEX IF NOT EXISTS `idx_job_openings_status` ON `job_openings`(`status`);
+CREATE INDEX IF NOT EXISTS `idx_job_openings_team` ON `job_openings`(`team`);
+CREATE TABLE IF NOT EXISTS `job_opening_skills` (`id` text,`job_opening_id` text NOT NULL,`skill_name` varchar(100) NOT NULL,`skill_level` varchar(50),PRIMARY KEY (`id`),CONSTRAINT `fk_job_openings_skills` FOREIGN KEY (`job_opening_id`) REFERENCES `job_openings`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_job_opening_skills_skill_name` ON `job_opening_skills`(`skill_name`);
+CREATE INDEX IF NOT EXISTS `idx_job_opening_skills_job_opening_id` ON `job_opening_skills`(`job_opening_id`);
+CREATE TABLE IF NOT EXISTS `job_opening_owners` (`id` text,`job_opening_id` text NOT NULL,`user_id` varchar(256) NOT NULL,PRIMARY KEY (`id`),CONSTRAINT `fk_job_openings_owners` FOREIGN KEY (`job_opening_id`) REFERENCES `job_openings`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_job_opening_owners_user_id` ON `job_opening_owners`(`user_id`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_job_opening_owners_opening_user` ON `job_opening_owners`(`job_opening_id`,`user_id`);
+CREATE TABLE IF NOT EXISTS `job_applications` (`id` text,`job_opening_id` text NOT NULL,`candidate_id` varchar(256) NOT NULL,`source` varchar(50),`applied_by` varchar(256),`applied_at` datetime NOT NULL,PRIMARY KEY (`id`),CONSTRAINT `fk_job_openings_applications` FOREIGN KEY (`job_opening_id`) REFERENCES `job_openings`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `idx_job_applications_applied_at` ON `job_applications`(`applied_at`);
+CREATE INDEX IF NOT EXISTS `idx_job_applications_candidate_id` ON `job_applications`(`candidate_id`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_job_applications_opening_candidate` ON `job_applications`(`job_opening_id`,`candidate_id`);
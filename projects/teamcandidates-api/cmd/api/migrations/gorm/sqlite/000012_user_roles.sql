@@ -0,0 +1,3 @@
+-- Roles de usuario (los permisos de cada rol se definen en código); los usuarios existentes quedan con el rol "user".
+CREATE TABLE IF NOT EXISTS `user_roles` (`user_id` text,`role` text,`created_at` datetime,PRIMARY KEY (`user_id`,`role`));
+INSERT INTO `user_roles` (`user_id`,`role`,`created_at`) SELECT `id`,'user',CURRENT_TIMESTAMP FROM `users` WHERE true ON CONFLICT DO NOTHING;
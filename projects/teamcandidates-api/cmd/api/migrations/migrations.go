@@ -0,0 +1,29 @@
+// Package migrations embebe los scripts de migración versionados que se aplican con el subcomando "migrate".
+package migrations
+
+import "embed"
+
+// Postgres contiene las migraciones de la base PostgreSQL accedida vía pgxpool, bajo el directorio "postgres".
+//
+//go:embed postgres/*.sql
+var Postgres embed.FS
+
+// PostgresDir es el directorio de las migraciones dentro de Postgres.
+const PostgresDir = "postgres"
+
+// Cassandra contiene los archivos CQL versionados del keyspace de Cassandra, bajo el directorio "cassandra".
+//
+//go:embed cassandra/*.cql
+var Cassandra embed.FS
+
+// CassandraDir es el directorio de las migraciones dentro de Cassandra.
+const CassandraDir = "cassandra"
+
+// Gorm contiene las migraciones SQL de la base accedida vía GORM, un subdirectorio por dialecto
+// (postgres, mysql, sqlite) bajo el directorio "gorm".
+//
+//go:embed gorm/*/*.sql
+var Gorm embed.FS
+
+// GormDir es el directorio de las migraciones dentro de Gorm.
+const GormDir = "gorm"
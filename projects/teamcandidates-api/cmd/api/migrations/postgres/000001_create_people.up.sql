@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS people (
+    id          TEXT PRIMARY KEY,
+    national_id BIGINT NOT NULL,
+    first_name  TEXT NOT NULL,
+    last_name   TEXT NOT NULL,
+    age         INTEGER NOT NULL DEFAULT 0,
+    gender      TEXT NOT NULL DEFAULT '',
+    phone       TEXT NOT NULL DEFAULT '',
+    interests   TEXT[] NOT NULL DEFAULT '{}',
+    hobbies     TEXT[] NOT NULL DEFAULT '{}',
+    deleted     BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ,
+    deleted_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_people_national_id ON people (national_id);
+CREATE INDEX IF NOT EXISTS idx_people_deleted_at ON people (deleted_at);
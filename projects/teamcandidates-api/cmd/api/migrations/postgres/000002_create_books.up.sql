@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS books (
+    id     SERIAL PRIMARY KEY,
+    title  TEXT NOT NULL,
+    author TEXT NOT NULL,
+    year   INTEGER NOT NULL
+);
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
+)
+
+const rolesUsage = `usage: api roles <email> [role ...]
+
+Without roles, prints the roles of the user. With roles, replaces them (admin, user).`
+
+// RunRolesCommand consulta o reemplaza los roles de un usuario. Es la forma de dar de alta el
+// primer administrador, ya que PUT /users/protected/:id/roles requiere uno.
+func RunRolesCommand(ctx context.Context, uc user.UseCases, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing user email\n%s", rolesUsage)
+	}
+
+	u, err := uc.GetUserByEmail(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to find user %s: %w", args[0], err)
+	}
+
+	if len(args) > 1 {
+		if err := uc.SetUserRoles(ctx, u.ID, args[1:]); err != nil {
+			return err
+		}
+	}
+
+	roles, err := uc.GetUserRoles(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s (%s): %s\n", args[0], u.ID, strings.Join(roles, ", "))
+	return nil
+}
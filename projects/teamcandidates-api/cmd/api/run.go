@@ -7,19 +7,34 @@ import (
 	"log"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
 	cass "github.com/teamcubation/teamcandidates/pkg/databases/nosql/cassandra/gocql"
+	mng "github.com/teamcubation/teamcandidates/pkg/databases/nosql/mongodb/mongo-driver"
 	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
 
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	browserevent "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events"
+	event "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event"
+	rating "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/rating"
+
+	apikeymodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/repository/models"
 	assessmentmodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/repository/models"
 	candidatemodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/repository/models"
 	categorymodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/category/repository/models"
+	gradingmodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/repository/models"
 	groupmodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/group/repository/models"
 	itemmodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/item/repository/models"
+	jobopeningmodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/repository/models"
 	macrocategorymodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/macrocategory/repository/models"
-	personmodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/repository/models"
+	monitoring "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/monitoring"
+	pipelinemodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/repository/models"
+	problemmodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/repository/models"
 	suppliermodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/supplier/repository/models"
 	usermodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/repository/models"
 
+	migrations "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/cmd/api/migrations"
 	wire "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/wire"
 )
 
@@ -60,9 +75,33 @@ func registerHttpRoutes(deps *wire.Dependencies) {
 	deps.CategoryHandler.Routes()
 	deps.MacroCategoryHandler.Routes()
 	deps.SupplierHandler.Routes()
+	deps.ApiKeyHandler.Routes()
+	deps.AuditHandler.Routes()
+	deps.GradingHandler.Routes()
+	deps.ProblemHandler.Routes()
+	deps.PipelineHandler.Routes()
+	deps.JobOpeningHandler.Routes()
+	deps.ReportHandler.Routes()
+
+	registerMetrics(deps)
 }
 
-// RunGormMigrations runs SQL migrations using GORM.
+// registerMetrics exposes the Prometheus metrics, including the SQL pools and read replicas.
+func registerMetrics(deps *wire.Dependencies) {
+	sources := map[string]monitoring.StatsSource{
+		"gorm": deps.GormRepository.Stats,
+	}
+	// Con el backend en memoria no hay pool de PostgreSQL.
+	if deps.PostgresRepository != nil {
+		sources["postgres"] = deps.PostgresRepository.Stats
+	}
+	prometheus.MustRegister(monitoring.NewDBPoolCollector(sources))
+
+	deps.GinServer.GetRouter().GET("/metrics", deps.GinServer.WrapH(promhttp.Handler()))
+}
+
+// RunGormMigrations applies the pending versioned GORM migrations. The drift check is not run here
+// because it replays AutoMigrate inside a transaction; use 'api migrate gorm check' instead.
 func RunGormMigrations(ctx context.Context, repo gorm.Repository) error {
 	log.Println("Starting GORM migrations...")
 
@@ -75,8 +114,19 @@ func RunGormMigrations(ctx context.Context, repo gorm.Repository) error {
 		return fmt.Errorf("database connection failed: %w", err)
 	}
 
-	// List of models to migrate.
-	modelsToMigrate := []any{
+	start := time.Now()
+	applied, err := repo.Migrate(ctx, migrations.Gorm, migrations.GormDir)
+	if err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+	log.Printf("GORM migrations completed successfully in %s (%d applied).", time.Since(start), len(applied))
+	return nil
+}
+
+// gormModels lista los modelos cuyo esquema se gestiona con las migraciones de GORM. La tabla
+// people no figura: es del repositorio de personas (pgxpool) y la gestionan las migraciones de PostgreSQL.
+func gormModels() []any {
+	return []any{
 		&groupmodels.Group{},
 		&groupmodels.GroupMember{},
 		&assessmentmodels.Assessment{},
@@ -84,67 +134,69 @@ func RunGormMigrations(ctx context.Context, repo gorm.Repository) error {
 		&assessmentmodels.SkillConfig{},
 		&assessmentmodels.UnitTest{},
 		&candidatemodels.Candidate{},
-		&personmodels.Person{},
 		&assessmentmodels.Link{},
+		&assessmentmodels.AssessmentSession{},
+		&assessmentmodels.AssessmentStatusTransition{},
+		&gradingmodels.GradingResult{},
+		&gradingmodels.GradingTestResult{},
+		&gradingmodels.GradingSimilarityMatch{},
+		&gradingmodels.GradingSubmission{},
+		&problemmodels.BankProblem{},
+		&problemmodels.BankProblemTag{},
+		&problemmodels.BankProblemSkill{},
+		&problemmodels.BankProblemVersion{},
+		&problemmodels.BankProblemTest{},
+		&pipelinemodels.Pipeline{},
+		&pipelinemodels.PipelineEntry{},
+		&pipelinemodels.PipelineTransition{},
+		&jobopeningmodels.JobOpening{},
+		&jobopeningmodels.JobOpeningSkill{},
+		&jobopeningmodels.JobOpeningOwner{},
+		&jobopeningmodels.JobApplication{},
 		&usermodels.User{},
 		&usermodels.Follow{},
+		&usermodels.UserMfa{},
+		&usermodels.RecoveryCode{},
+		&usermodels.UserRole{},
 		&itemmodels.Item{},
 		&categorymodels.Category{},
 		&macrocategorymodels.MacroCategory{},
 		&suppliermodels.Supplier{},
+		&apikeymodels.ServiceAccount{},
+		&apikeymodels.APIKey{},
 	}
+}
 
-	start := time.Now()
-	if err := repo.AutoMigrate(modelsToMigrate...); err != nil {
-		return fmt.Errorf("failed to migrate database models: %w", err)
+// RunMongoIndexes ensures the declared MongoDB indexes (including TTL indexes) exist and match their definitions.
+func RunMongoIndexes(ctx context.Context, repo mng.Repository) error {
+	log.Println("Ensuring MongoDB indexes...")
+
+	var defs []mng.IndexDefinition
+	defs = append(defs, audit.MongoIndexes...)
+	defs = append(defs, event.MongoIndexes...)
+	defs = append(defs, browserevent.MongoIndexes...)
+	defs = append(defs, rating.MongoIndexes...)
+
+	if err := repo.EnsureIndexes(ctx, defs...); err != nil {
+		return fmt.Errorf("failed to ensure MongoDB indexes: %w", err)
 	}
-	duration := time.Since(start)
-	log.Printf("GORM migrations completed successfully in %s.", duration)
+	log.Println("MongoDB indexes are up to date.")
 	return nil
 }
 
-// RunCassandraMigrations runs Cassandra migrations.
+// RunCassandraMigrations applies the pending versioned CQL migrations.
 func RunCassandraMigrations(ctx context.Context, repo cass.Repository) error {
 	log.Println("Starting Cassandra migrations...")
-	session := repo.GetSession()
-
-	// Create keyspace if it doesn't exist.
-	createKeyspaceCQL := `
-		CREATE KEYSPACE IF NOT EXISTS mi_keyspace 
-		WITH REPLICATION = { 'class': 'SimpleStrategy', 'replication_factor': 1 }`
-	if err := session.Query(createKeyspaceCQL).WithContext(ctx).Exec(); err != nil {
-		return fmt.Errorf("failed to create keyspace: %w", err)
-	}
-	log.Println("Keyspace 'mi_keyspace' created or already exists.")
-
-	// Create table "tweets".
-	createTweetsTableCQL := `
-		CREATE TABLE IF NOT EXISTS tweets (
-			id uuid PRIMARY KEY,
-			user_id text,
-			content text,
-			created_at timestamp
-		)`
-	if err := session.Query(createTweetsTableCQL).WithContext(ctx).Exec(); err != nil {
-		return fmt.Errorf("failed to create table 'tweets': %w", err)
-	}
-	log.Println("Table 'tweets' created or already exists.")
-
-	// Create denormalized table "timeline_by_user".
-	createTimelineTableCQL := `
-		CREATE TABLE IF NOT EXISTS timeline_by_user (
-			user_id text,
-			created_at timestamp,
-			tweet_id text,
-			content text,
-			PRIMARY KEY (user_id, created_at, tweet_id)
-		) WITH CLUSTERING ORDER BY (created_at DESC)
-	`
-	if err := session.Query(createTimelineTableCQL).WithContext(ctx).Exec(); err != nil {
-		return fmt.Errorf("failed to create table 'timeline_by_user': %w", err)
+
+	migrator := repo.NewMigrator(migrations.Cassandra, migrations.CassandraDir)
+	applied, err := migrator.Up(ctx, cass.MigrateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to apply Cassandra migrations: %w", err)
 	}
-	log.Println("Table 'timeline_by_user' created or already exists.")
 
+	for _, m := range applied {
+		log.Printf("Cassandra migration %d_%s applied.", m.Version, m.Name)
+	}
 	log.Println("Cassandra migrations completed successfully.")
 	return nil
 }
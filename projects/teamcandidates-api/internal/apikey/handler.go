@@ -0,0 +1,168 @@
+package apikey
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	gsv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/handler/dto"
+)
+
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{
+		ucs: u,
+		gsv: s,
+		mws: m,
+	}
+}
+
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/api-keys"
+	protectedPrefix := apiBase + "/protected"
+
+	// Rutas protegidas: la gestión de keys solo está disponible para administradores (JWT)
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(requireUserPrincipal())
+		protected.Use(mdw.RequirePermission(types.PermissionAPIKeyAdmin))
+
+		protected.POST("/service-accounts", h.CreateServiceAccount)
+		protected.GET("/service-accounts", h.ListServiceAccounts)
+		protected.DELETE("/service-accounts/:id", h.DisableServiceAccount)
+		protected.POST("/service-accounts/:id/keys", h.IssueAPIKey)
+		protected.GET("/service-accounts/:id/keys", h.ListAPIKeys)
+		protected.DELETE("/keys/:id", h.RevokeAPIKey)
+	}
+}
+
+func (h *Handler) CreateServiceAccount(c *gin.Context) {
+	var req dto.ServiceAccount
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	principal, _ := mdw.GetPrincipal(c)
+	id, err := h.ucs.CreateServiceAccount(c.Request.Context(), req.ToDomain(principal.ID))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateServiceAccountResponse{
+		Message:          "Service account created successfully",
+		ServiceAccountID: id,
+	})
+}
+
+func (h *Handler) ListServiceAccounts(c *gin.Context) {
+	accounts, err := h.ucs.ListServiceAccounts(c.Request.Context())
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	resp := make([]dto.ServiceAccountResponse, 0, len(accounts))
+	for i := range accounts {
+		resp = append(resp, dto.ToServiceAccountResponse(&accounts[i]))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) DisableServiceAccount(c *gin.Context) {
+	if err := h.ucs.DisableServiceAccount(c.Request.Context(), c.Param("id")); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Service account disabled successfully",
+	})
+}
+
+func (h *Handler) IssueAPIKey(c *gin.Context) {
+	var req dto.APIKey
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	principal, _ := mdw.GetPrincipal(c)
+	issued, err := h.ucs.IssueAPIKey(c.Request.Context(), req.ToDomain(c.Param("id"), principal.ID))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.IssueAPIKeyResponse{
+		Message: "API key created successfully, store it in a safe place",
+		APIKey:  issued.PlainKey,
+		Key:     dto.ToAPIKeyResponse(&issued.APIKey),
+	})
+}
+
+func (h *Handler) ListAPIKeys(c *gin.Context) {
+	keys, err := h.ucs.ListAPIKeys(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	resp := make([]dto.APIKeyResponse, 0, len(keys))
+	for i := range keys {
+		resp = append(resp, dto.ToAPIKeyResponse(&keys[i]))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) RevokeAPIKey(c *gin.Context) {
+	if err := h.ucs.RevokeAPIKey(c.Request.Context(), c.Param("id")); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "API key revoked successfully",
+	})
+}
+
+// requireUserPrincipal impide que un service account gestione API keys.
+func requireUserPrincipal() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, err := mdw.GetPrincipal(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, types.ErrorResponse{Error: err.Error()})
+			c.Abort()
+			return
+		}
+		if principal.Type != types.PrincipalUser {
+			c.JSON(http.StatusForbidden, types.ErrorResponse{Error: "service accounts cannot manage api keys"})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
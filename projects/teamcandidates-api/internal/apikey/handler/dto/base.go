@@ -0,0 +1,116 @@
+package dto
+
+import (
+	"time"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/usecases/domain"
+)
+
+type ServiceAccount struct {
+	Name        string `json:"name" binding:"required,min=3,max=100"`
+	Description string `json:"description" binding:"omitempty,max=250"`
+}
+
+type APIKey struct {
+	Name      string     `json:"name" binding:"required,min=3,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at" binding:"omitempty"`
+}
+
+// Mappers
+func (d *ServiceAccount) ToDomain(createdBy string) *domain.ServiceAccount {
+	return &domain.ServiceAccount{
+		Name:        d.Name,
+		Description: d.Description,
+		CreatedBy:   createdBy,
+	}
+}
+
+func (d *APIKey) ToDomain(serviceAccountID, createdBy string) *domain.APIKey {
+	scopes := make([]domain.Scope, 0, len(d.Scopes))
+	for _, scope := range d.Scopes {
+		scopes = append(scopes, domain.Scope(scope))
+	}
+
+	key := &domain.APIKey{
+		ServiceAccountID: serviceAccountID,
+		Name:             d.Name,
+		Scopes:           scopes,
+		CreatedBy:        createdBy,
+	}
+	if d.ExpiresAt != nil {
+		key.ExpiresAt = *d.ExpiresAt
+	}
+	return key
+}
+
+// Response
+type CreateServiceAccountResponse struct {
+	Message          string `json:"message"`
+	ServiceAccountID string `json:"service_account_id"`
+}
+
+type ServiceAccountResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedBy   string    `json:"created_by"`
+	Disabled    bool      `json:"disabled"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// APIKeyResponse nunca incluye el secreto ni su hash.
+type APIKeyResponse struct {
+	ID               string     `json:"id"`
+	ServiceAccountID string     `json:"service_account_id"`
+	Name             string     `json:"name"`
+	Prefix           string     `json:"prefix"`
+	Scopes           []string   `json:"scopes"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt       *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt        *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+type IssueAPIKeyResponse struct {
+	Message string         `json:"message"`
+	APIKey  string         `json:"api_key"` // Valor en claro, solo se devuelve al crearla
+	Key     APIKeyResponse `json:"key"`
+}
+
+func ToServiceAccountResponse(sa *domain.ServiceAccount) ServiceAccountResponse {
+	return ServiceAccountResponse{
+		ID:          sa.ID,
+		Name:        sa.Name,
+		Description: sa.Description,
+		CreatedBy:   sa.CreatedBy,
+		Disabled:    sa.Disabled,
+		CreatedAt:   sa.CreatedAt,
+	}
+}
+
+func ToAPIKeyResponse(k *domain.APIKey) APIKeyResponse {
+	scopes := make([]string, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	return APIKeyResponse{
+		ID:               k.ID,
+		ServiceAccountID: k.ServiceAccountID,
+		Name:             k.Name,
+		Prefix:           k.Prefix,
+		Scopes:           scopes,
+		ExpiresAt:        optionalTime(k.ExpiresAt),
+		LastUsedAt:       optionalTime(k.LastUsedAt),
+		RevokedAt:        optionalTime(k.RevokedAt),
+		CreatedAt:        k.CreatedAt,
+	}
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,35 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/usecases/domain"
+)
+
+type UseCases interface {
+	CreateServiceAccount(context.Context, *domain.ServiceAccount) (string, error)
+	ListServiceAccounts(context.Context) ([]domain.ServiceAccount, error)
+	DisableServiceAccount(context.Context, string) error
+	IssueAPIKey(context.Context, *domain.APIKey) (*domain.IssuedAPIKey, error)
+	ListAPIKeys(context.Context, string) ([]domain.APIKey, error)
+	RevokeAPIKey(context.Context, string) error
+	AuthenticateAPIKey(context.Context, string) (*types.Principal, error)
+}
+
+type Repository interface {
+	CreateServiceAccount(context.Context, *domain.ServiceAccount) (string, error)
+	GetServiceAccount(context.Context, string) (*domain.ServiceAccount, error)
+	ListServiceAccounts(context.Context) ([]domain.ServiceAccount, error)
+	DisableServiceAccount(context.Context, string) error
+	CreateAPIKey(context.Context, *domain.APIKey) (string, error)
+	GetAPIKeyByPrefix(context.Context, string) (*domain.APIKey, error)
+	ListAPIKeys(context.Context, string) ([]domain.APIKey, error)
+	RevokeAPIKey(context.Context, string, time.Time) error
+	TouchAPIKey(context.Context, string, time.Time) error
+}
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_apikey.go -package=mocks
@@ -0,0 +1,152 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/usecases/domain"
+)
+
+type repository struct {
+	db gorm.Repository
+}
+
+// NewRepository crea una nueva implementación del repositorio de API keys.
+func NewRepository(db gorm.Repository) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// CreateServiceAccount persists a new service account.
+func (r *repository) CreateServiceAccount(ctx context.Context, sa *domain.ServiceAccount) (string, error) {
+	model, err := models.FromDomainServiceAccount(sa)
+	if err != nil {
+		return "", fmt.Errorf("error converting domain service account to model: %w", err)
+	}
+	model.ID = uuid.New().String()
+
+	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+		return "", fmt.Errorf("error creating service account: %w", err)
+	}
+	return model.ID, nil
+}
+
+// GetServiceAccount retrieves a service account by its ID.
+func (r *repository) GetServiceAccount(ctx context.Context, id string) (*domain.ServiceAccount, error) {
+	var model models.ServiceAccount
+	if err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, "service account not found", err)
+		}
+		return nil, fmt.Errorf("error retrieving service account %s: %w", id, err)
+	}
+	return model.ToDomain(), nil
+}
+
+// ListServiceAccounts retrieves all service accounts.
+func (r *repository) ListServiceAccounts(ctx context.Context) ([]domain.ServiceAccount, error) {
+	var modelsList []models.ServiceAccount
+	if err := r.db.Client().WithContext(ctx).Order("created_at").Find(&modelsList).Error; err != nil {
+		return nil, fmt.Errorf("error listing service accounts: %w", err)
+	}
+
+	accounts := make([]domain.ServiceAccount, 0, len(modelsList))
+	for _, m := range modelsList {
+		accounts = append(accounts, *m.ToDomain())
+	}
+	return accounts, nil
+}
+
+// DisableServiceAccount marks a service account as disabled.
+func (r *repository) DisableServiceAccount(ctx context.Context, id string) error {
+	result := r.db.Client().WithContext(ctx).
+		Model(&models.ServiceAccount{}).
+		Where("id = ?", id).
+		Update("disabled", true)
+	if result.Error != nil {
+		return fmt.Errorf("error disabling service account %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, "service account not found", nil)
+	}
+	return nil
+}
+
+// CreateAPIKey persists a new API key.
+func (r *repository) CreateAPIKey(ctx context.Context, key *domain.APIKey) (string, error) {
+	model, err := models.FromDomainAPIKey(key)
+	if err != nil {
+		return "", fmt.Errorf("error converting domain api key to model: %w", err)
+	}
+	model.ID = uuid.New().String()
+
+	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+		return "", fmt.Errorf("error creating api key: %w", err)
+	}
+	return model.ID, nil
+}
+
+// GetAPIKeyByPrefix retrieves an API key by its public prefix.
+func (r *repository) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	var model models.APIKey
+	if err := r.db.Client().WithContext(ctx).Where("prefix = ?", prefix).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, "api key not found", err)
+		}
+		return nil, fmt.Errorf("error retrieving api key: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// ListAPIKeys retrieves the API keys of a service account.
+func (r *repository) ListAPIKeys(ctx context.Context, serviceAccountID string) ([]domain.APIKey, error) {
+	var modelsList []models.APIKey
+	if err := r.db.Client().WithContext(ctx).
+		Where("service_account_id = ?", serviceAccountID).
+		Order("created_at").
+		Find(&modelsList).Error; err != nil {
+		return nil, fmt.Errorf("error listing api keys: %w", err)
+	}
+
+	keys := make([]domain.APIKey, 0, len(modelsList))
+	for _, m := range modelsList {
+		keys = append(keys, *m.ToDomain())
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey sets revoked_at on a non revoked API key.
+func (r *repository) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error {
+	result := r.db.Client().WithContext(ctx).
+		Model(&models.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		return fmt.Errorf("error revoking api key %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, "api key not found or already revoked", nil)
+	}
+	return nil
+}
+
+// TouchAPIKey updates the last_used_at of an API key.
+func (r *repository) TouchAPIKey(ctx context.Context, id string, usedAt time.Time) error {
+	if err := r.db.Client().WithContext(ctx).
+		Model(&models.APIKey{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt).Error; err != nil {
+		return fmt.Errorf("error updating last usage of api key %s: %w", id, err)
+	}
+	return nil
+}
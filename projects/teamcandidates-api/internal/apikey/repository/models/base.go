@@ -0,0 +1,127 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/usecases/domain"
+)
+
+type ServiceAccount struct {
+	ID          string    `gorm:"primaryKey;column:id"`
+	Name        string    `gorm:"column:name;unique;not null"`
+	Description string    `gorm:"column:description"`
+	CreatedBy   string    `gorm:"column:created_by;not null"`
+	Disabled    bool      `gorm:"column:disabled;default:false"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+type APIKey struct {
+	ID               string         `gorm:"primaryKey;column:id"`
+	ServiceAccountID string         `gorm:"column:service_account_id;index;not null"`
+	Name             string         `gorm:"column:name;not null"`
+	Prefix           string         `gorm:"column:prefix;uniqueIndex;not null"`
+	SecretHash       string         `gorm:"column:secret_hash;not null"`
+	Scopes           pq.StringArray `gorm:"type:text[];column:scopes"`
+	ExpiresAt        *time.Time     `gorm:"column:expires_at"`
+	LastUsedAt       *time.Time     `gorm:"column:last_used_at"`
+	RevokedAt        *time.Time     `gorm:"column:revoked_at"`
+	CreatedBy        string         `gorm:"column:created_by;not null"`
+	CreatedAt        time.Time      `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// Mappers
+func FromDomainServiceAccount(sa *domain.ServiceAccount) (*ServiceAccount, error) {
+	if sa == nil {
+		return nil, errors.New("service account cannot be nil")
+	}
+
+	return &ServiceAccount{
+		ID:          sa.ID,
+		Name:        sa.Name,
+		Description: sa.Description,
+		CreatedBy:   sa.CreatedBy,
+		Disabled:    sa.Disabled,
+		CreatedAt:   sa.CreatedAt,
+		UpdatedAt:   sa.UpdatedAt,
+	}, nil
+}
+
+func (m *ServiceAccount) ToDomain() *domain.ServiceAccount {
+	return &domain.ServiceAccount{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		CreatedBy:   m.CreatedBy,
+		Disabled:    m.Disabled,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func FromDomainAPIKey(k *domain.APIKey) (*APIKey, error) {
+	if k == nil {
+		return nil, errors.New("api key cannot be nil")
+	}
+
+	scopes := make(pq.StringArray, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	return &APIKey{
+		ID:               k.ID,
+		ServiceAccountID: k.ServiceAccountID,
+		Name:             k.Name,
+		Prefix:           k.Prefix,
+		SecretHash:       k.SecretHash,
+		Scopes:           scopes,
+		ExpiresAt:        timePtr(k.ExpiresAt),
+		LastUsedAt:       timePtr(k.LastUsedAt),
+		RevokedAt:        timePtr(k.RevokedAt),
+		CreatedBy:        k.CreatedBy,
+		CreatedAt:        k.CreatedAt,
+	}, nil
+}
+
+func (m *APIKey) ToDomain() *domain.APIKey {
+	scopes := make([]domain.Scope, 0, len(m.Scopes))
+	for _, scope := range m.Scopes {
+		scopes = append(scopes, domain.Scope(scope))
+	}
+
+	return &domain.APIKey{
+		ID:               m.ID,
+		ServiceAccountID: m.ServiceAccountID,
+		Name:             m.Name,
+		Prefix:           m.Prefix,
+		SecretHash:       m.SecretHash,
+		Scopes:           scopes,
+		ExpiresAt:        timeValue(m.ExpiresAt),
+		LastUsedAt:       timeValue(m.LastUsedAt),
+		RevokedAt:        timeValue(m.RevokedAt),
+		CreatedBy:        m.CreatedBy,
+		CreatedAt:        m.CreatedAt,
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
@@ -0,0 +1,178 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/usecases/domain"
+	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/usecases/support"
+)
+
+// lastUsedResolution evita escribir last_used_at en cada request de un cliente con mucho tráfico.
+const lastUsedResolution = time.Minute
+
+type useCases struct {
+	repository Repository
+}
+
+// NewUseCases crea una nueva instancia de useCases.
+func NewUseCases(rp Repository) UseCases {
+	return &useCases{
+		repository: rp,
+	}
+}
+
+// CreateServiceAccount registra un nuevo cliente máquina.
+func (u *useCases) CreateServiceAccount(ctx context.Context, sa *domain.ServiceAccount) (string, error) {
+	if sa == nil {
+		return "", fmt.Errorf("service account is nil")
+	}
+	if sa.Name == "" {
+		return "", types.NewMissingFieldError("name")
+	}
+
+	id, err := u.repository.CreateServiceAccount(ctx, sa)
+	if err != nil {
+		return "", fmt.Errorf("error creating service account: %w", err)
+	}
+	return id, nil
+}
+
+// ListServiceAccounts devuelve todos los service accounts.
+func (u *useCases) ListServiceAccounts(ctx context.Context) ([]domain.ServiceAccount, error) {
+	accounts, err := u.repository.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing service accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// DisableServiceAccount deshabilita el service account; sus keys dejan de autenticar.
+func (u *useCases) DisableServiceAccount(ctx context.Context, id string) error {
+	if id == "" {
+		return types.NewMissingFieldError("id")
+	}
+
+	if err := u.repository.DisableServiceAccount(ctx, id); err != nil {
+		return fmt.Errorf("error disabling service account %s: %w", id, err)
+	}
+	return nil
+}
+
+// IssueAPIKey genera una nueva key para el service account. El valor en claro solo se devuelve en esta llamada.
+func (u *useCases) IssueAPIKey(ctx context.Context, key *domain.APIKey) (*domain.IssuedAPIKey, error) {
+	if key == nil {
+		return nil, fmt.Errorf("api key is nil")
+	}
+	if len(key.Scopes) == 0 {
+		return nil, types.NewMissingFieldError("scopes")
+	}
+	if _, err := support.PermissionsForScopes(key.Scopes); err != nil {
+		return nil, types.NewError(types.ErrValidation, "invalid scopes", err)
+	}
+	if !key.ExpiresAt.IsZero() && key.ExpiresAt.Before(time.Now()) {
+		return nil, types.NewError(types.ErrValidation, "expiration must be in the future", nil)
+	}
+
+	sa, err := u.repository.GetServiceAccount(ctx, key.ServiceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving service account: %w", err)
+	}
+	if sa.Disabled {
+		return nil, types.NewError(types.ErrConflict, "service account is disabled", nil)
+	}
+
+	plainKey, prefix, secret, err := support.GenerateKey()
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to generate api key", err)
+	}
+	key.Prefix = prefix
+	key.SecretHash = support.HashSecret(secret)
+	key.CreatedAt = time.Now()
+
+	id, err := u.repository.CreateAPIKey(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("error storing api key: %w", err)
+	}
+	key.ID = id
+
+	return &domain.IssuedAPIKey{
+		APIKey:   *key,
+		PlainKey: plainKey,
+	}, nil
+}
+
+// ListAPIKeys devuelve las keys de un service account.
+func (u *useCases) ListAPIKeys(ctx context.Context, serviceAccountID string) ([]domain.APIKey, error) {
+	if serviceAccountID == "" {
+		return nil, types.NewMissingFieldError("service_account_id")
+	}
+
+	keys, err := u.repository.ListAPIKeys(ctx, serviceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing api keys: %w", err)
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revoca una key de forma inmediata y permanente.
+func (u *useCases) RevokeAPIKey(ctx context.Context, id string) error {
+	if id == "" {
+		return types.NewMissingFieldError("id")
+	}
+
+	if err := u.repository.RevokeAPIKey(ctx, id, time.Now()); err != nil {
+		return fmt.Errorf("error revoking api key %s: %w", id, err)
+	}
+	return nil
+}
+
+// AuthenticateAPIKey valida la key recibida en X-API-Key y devuelve el principal del service account.
+func (u *useCases) AuthenticateAPIKey(ctx context.Context, plainKey string) (*types.Principal, error) {
+	prefix, secret, err := support.ParseKey(plainKey)
+	if err != nil {
+		return nil, types.NewAuthenticationError("invalid api key", err)
+	}
+
+	key, err := u.repository.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewAuthenticationError("invalid api key", nil)
+		}
+		return nil, types.NewError(types.ErrOperationFailed, "failed to retrieve api key", err)
+	}
+
+	now := time.Now()
+	if !support.SecretMatches(secret, key.SecretHash) || !key.IsActive(now) {
+		return nil, types.NewAuthenticationError("invalid api key", nil)
+	}
+
+	sa, err := u.repository.GetServiceAccount(ctx, key.ServiceAccountID)
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to retrieve service account", err)
+	}
+	if sa.Disabled {
+		return nil, types.NewAuthenticationError("service account is disabled", nil)
+	}
+
+	permissions, err := support.PermissionsForScopes(key.Scopes)
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to resolve api key permissions", err)
+	}
+
+	if now.Sub(key.LastUsedAt) > lastUsedResolution {
+		if err := u.repository.TouchAPIKey(ctx, key.ID, now); err != nil {
+			return nil, types.NewError(types.ErrOperationFailed, "failed to track api key usage", err)
+		}
+	}
+
+	return &types.Principal{
+		ID:          sa.ID,
+		Type:        types.PrincipalServiceAccount,
+		APIKeyID:    key.ID,
+		Permissions: permissions,
+	}, nil
+}
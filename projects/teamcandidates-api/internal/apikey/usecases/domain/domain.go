@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// Scope acota lo que puede hacer una API key. Cada scope se traduce a uno o más permisos.
+type Scope string
+
+const (
+	ScopeCandidatesRead   Scope = "candidates:read"
+	ScopeCandidatesWrite  Scope = "candidates:write"
+	ScopeAssessmentsRead  Scope = "assessments:read"
+	ScopeAssessmentsWrite Scope = "assessments:write"
+	ScopeUsersRead        Scope = "users:read"
+	ScopeAuditRead        Scope = "audit:read"
+)
+
+// ScopePermissions mapea cada scope a los permisos que otorga.
+var ScopePermissions = map[Scope][]string{
+	ScopeCandidatesRead:   {types.PermissionCandidateRead},
+	ScopeCandidatesWrite:  {types.PermissionCandidateRead, types.PermissionCandidateWrite},
+	ScopeAssessmentsRead:  {types.PermissionAssessmentRead},
+	ScopeAssessmentsWrite: {types.PermissionAssessmentRead, types.PermissionAssessmentWrite},
+	ScopeUsersRead:        {types.PermissionUserRead},
+	ScopeAuditRead:        {types.PermissionAuditRead},
+}
+
+// ServiceAccount es la identidad de un cliente máquina (p. ej. un ATS) dueño de API keys.
+type ServiceAccount struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedBy   string
+	Disabled    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// APIKey se identifica por su prefijo público; del secreto solo se guarda el hash.
+type APIKey struct {
+	ID               string
+	ServiceAccountID string
+	Name             string
+	Prefix           string
+	SecretHash       string
+	Scopes           []Scope
+	ExpiresAt        time.Time // Zero value: no expira
+	LastUsedAt       time.Time
+	RevokedAt        time.Time
+	CreatedBy        string
+	CreatedAt        time.Time
+}
+
+// IsActive indica si la key no fue revocada ni expiró.
+func (k *APIKey) IsActive(now time.Time) bool {
+	if !k.RevokedAt.IsZero() {
+		return false
+	}
+	return k.ExpiresAt.IsZero() || now.Before(k.ExpiresAt)
+}
+
+// IssuedAPIKey devuelve la key recién generada junto con su valor en claro, que no vuelve a exponerse.
+type IssuedAPIKey struct {
+	APIKey   APIKey
+	PlainKey string
+}
@@ -0,0 +1,81 @@
+package support
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/usecases/domain"
+)
+
+const (
+	// keyPrefix identifica visualmente las API keys de la plataforma (útil para secret scanning).
+	keyPrefix  = "tc"
+	prefixSize = 6
+	secretSize = 32
+)
+
+// GenerateKey genera una API key con formato tc_<prefijo>_<secreto> y devuelve sus partes.
+func GenerateKey() (plainKey, prefix, secret string, err error) {
+	prefix, err = randomHex(prefixSize)
+	if err != nil {
+		return "", "", "", err
+	}
+	secret, err = randomHex(secretSize)
+	if err != nil {
+		return "", "", "", err
+	}
+	return keyPrefix + "_" + prefix + "_" + secret, prefix, secret, nil
+}
+
+// ParseKey separa una API key en prefijo y secreto.
+func ParseKey(plainKey string) (prefix, secret string, err error) {
+	parts := strings.Split(plainKey, "_")
+	if len(parts) != 3 || parts[0] != keyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", errors.New("malformed api key")
+	}
+	return parts[1], parts[2], nil
+}
+
+// HashSecret hashea el secreto con SHA-256. El secreto es aleatorio de alta entropía, por lo que no requiere bcrypt.
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// SecretMatches compara en tiempo constante el secreto recibido con el hash almacenado.
+func SecretMatches(secret, secretHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashSecret(secret)), []byte(secretHash)) == 1
+}
+
+// PermissionsForScopes traduce los scopes a permisos sin duplicados.
+func PermissionsForScopes(scopes []domain.Scope) ([]string, error) {
+	seen := make(map[string]struct{})
+	permissions := make([]string, 0)
+	for _, scope := range scopes {
+		perms, ok := domain.ScopePermissions[scope]
+		if !ok {
+			return nil, fmt.Errorf("unknown scope %q", scope)
+		}
+		for _, perm := range perms {
+			if _, dup := seen[perm]; dup {
+				continue
+			}
+			seen[perm] = struct{}{}
+			permissions = append(permissions, perm)
+		}
+	}
+	return permissions, nil
+}
+
+func randomHex(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
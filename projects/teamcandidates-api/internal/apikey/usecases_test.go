@@ -0,0 +1,212 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	mock_apikey "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/mocks"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/usecases/domain"
+	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey/usecases/support"
+)
+
+// isMissingField indica si el error es de tipo ErrMissingField.
+func isMissingField(err error) bool {
+	errType, ok := types.GetErrorType(err)
+	return ok && errType == types.ErrMissingField
+}
+
+func TestIssueAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type fields struct {
+		repository *mock_apikey.MockRepository
+	}
+
+	tests := []struct {
+		name    string
+		key     *domain.APIKey
+		setup   func(f *fields)
+		wantErr func(error) bool
+	}{
+		{
+			name:    "Error: missing scopes",
+			key:     &domain.APIKey{ServiceAccountID: "sa1"},
+			setup:   func(f *fields) {},
+			wantErr: isMissingField,
+		},
+		{
+			name: "Error: unknown scope",
+			key: &domain.APIKey{
+				ServiceAccountID: "sa1",
+				Scopes:           []domain.Scope{"candidates:delete"},
+			},
+			setup:   func(f *fields) {},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name: "Error: disabled service account",
+			key: &domain.APIKey{
+				ServiceAccountID: "sa1",
+				Scopes:           []domain.Scope{domain.ScopeCandidatesRead},
+			},
+			setup: func(f *fields) {
+				f.repository.EXPECT().
+					GetServiceAccount(gomock.Any(), "sa1").
+					Return(&domain.ServiceAccount{ID: "sa1", Disabled: true}, nil)
+			},
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Success: key issued with a hashed secret",
+			key: &domain.APIKey{
+				ServiceAccountID: "sa1",
+				Scopes:           []domain.Scope{domain.ScopeCandidatesWrite},
+			},
+			setup: func(f *fields) {
+				f.repository.EXPECT().
+					GetServiceAccount(gomock.Any(), "sa1").
+					Return(&domain.ServiceAccount{ID: "sa1"}, nil)
+				f.repository.EXPECT().
+					CreateAPIKey(gomock.Any(), gomock.Any()).
+					Return("key1", nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := fields{
+				repository: mock_apikey.NewMockRepository(ctrl),
+			}
+			tc.setup(&f)
+
+			uc := NewUseCases(f.repository)
+			issued, err := uc.IssueAPIKey(context.Background(), tc.key)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, "key1", issued.APIKey.ID, "api key ID mismatch")
+			// El valor en claro nunca se persiste, solo su hash.
+			prefix, secret, err := support.ParseKey(issued.PlainKey)
+			assert.NoError(t, err, "plain key must be well formed")
+			assert.Equal(t, prefix, issued.APIKey.Prefix, "prefix mismatch")
+			assert.True(t, support.SecretMatches(secret, issued.APIKey.SecretHash), "secret hash mismatch")
+		})
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type fields struct {
+		repository *mock_apikey.MockRepository
+	}
+
+	plainKey, prefix, secret, err := support.GenerateKey()
+	assert.NoError(t, err)
+	newKey := func(scopes ...domain.Scope) *domain.APIKey {
+		return &domain.APIKey{
+			ID:               "key1",
+			ServiceAccountID: "sa1",
+			Prefix:           prefix,
+			SecretHash:       support.HashSecret(secret),
+			Scopes:           scopes,
+			LastUsedAt:       time.Now(),
+		}
+	}
+
+	tests := []struct {
+		name            string
+		plainKey        string
+		setup           func(f *fields)
+		wantErr         func(error) bool
+		wantPermissions []string
+	}{
+		{
+			name:     "Error: malformed key",
+			plainKey: "not-a-key",
+			setup:    func(f *fields) {},
+			wantErr:  types.IsAuthenticationError,
+		},
+		{
+			name:     "Error: unknown prefix",
+			plainKey: plainKey,
+			setup: func(f *fields) {
+				f.repository.EXPECT().
+					GetAPIKeyByPrefix(gomock.Any(), prefix).
+					Return(nil, types.NewError(types.ErrNotFound, "api key not found", nil))
+			},
+			wantErr: types.IsAuthenticationError,
+		},
+		{
+			name:     "Error: revoked key",
+			plainKey: plainKey,
+			setup: func(f *fields) {
+				key := newKey(domain.ScopeCandidatesRead)
+				key.RevokedAt = time.Now().Add(-time.Minute)
+				f.repository.EXPECT().GetAPIKeyByPrefix(gomock.Any(), prefix).Return(key, nil)
+			},
+			wantErr: types.IsAuthenticationError,
+		},
+		{
+			name:     "Success: overlapping scopes grant each permission once",
+			plainKey: plainKey,
+			setup: func(f *fields) {
+				f.repository.EXPECT().
+					GetAPIKeyByPrefix(gomock.Any(), prefix).
+					Return(newKey(domain.ScopeCandidatesRead, domain.ScopeCandidatesWrite), nil)
+				f.repository.EXPECT().
+					GetServiceAccount(gomock.Any(), "sa1").
+					Return(&domain.ServiceAccount{ID: "sa1"}, nil)
+			},
+			wantPermissions: []string{types.PermissionCandidateRead, types.PermissionCandidateWrite},
+		},
+		{
+			name:     "Success: stale last use is tracked",
+			plainKey: plainKey,
+			setup: func(f *fields) {
+				key := newKey(domain.ScopeAuditRead)
+				key.LastUsedAt = time.Now().Add(-time.Hour)
+				f.repository.EXPECT().GetAPIKeyByPrefix(gomock.Any(), prefix).Return(key, nil)
+				f.repository.EXPECT().
+					GetServiceAccount(gomock.Any(), "sa1").
+					Return(&domain.ServiceAccount{ID: "sa1"}, nil)
+				f.repository.EXPECT().TouchAPIKey(gomock.Any(), "key1", gomock.Any()).Return(nil)
+			},
+			wantPermissions: []string{types.PermissionAuditRead},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := fields{
+				repository: mock_apikey.NewMockRepository(ctrl),
+			}
+			tc.setup(&f)
+
+			uc := NewUseCases(f.repository)
+			principal, err := uc.AuthenticateAPIKey(context.Background(), tc.plainKey)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, types.PrincipalServiceAccount, principal.Type, "principal type mismatch")
+			assert.ElementsMatch(t, tc.wantPermissions, principal.Permissions, "permissions mismatch")
+		})
+	}
+}
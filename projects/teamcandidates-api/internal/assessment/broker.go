@@ -0,0 +1,47 @@
+package assessment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rabbit "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/producer"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+type broker struct {
+	producer rabbit.Producer
+	queue    string // Cola de corrección (GRADING_QUEUE)
+}
+
+// NewBroker crea el adapter que publica las entregas en la cola de corrección.
+func NewBroker(producer rabbit.Producer, queue string) Broker {
+	return &broker{
+		producer: producer,
+		queue:    queue,
+	}
+}
+
+// submittedMessage es el mensaje que consume el worker de grading; solo necesita assessment_id.
+type submittedMessage struct {
+	AssessmentID string    `json:"assessment_id"`
+	SessionID    string    `json:"session_id"`
+	SubmittedAt  time.Time `json:"submitted_at"`
+}
+
+// PublishSubmitted avisa que la sesión fue entregada para que se corrija en segundo plano.
+func (b *broker) PublishSubmitted(ctx context.Context, session *domain.Session) error {
+	msg := submittedMessage{
+		AssessmentID: session.AssessmentID,
+		SessionID:    session.ID,
+	}
+	if session.SubmittedAt != nil {
+		msg.SubmittedAt = *session.SubmittedAt
+	}
+
+	if _, err := b.producer.Produce(ctx, b.queue, "", session.ID, msg); err != nil {
+		return fmt.Errorf("failed to publish submitted assessment: %w", err)
+	}
+	return nil
+}
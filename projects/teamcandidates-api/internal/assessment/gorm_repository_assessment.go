@@ -4,13 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/repository/models"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
 )
 
+// CreateAssessment inserta el assessment y sus skills, problema y unit tests.
+// Las inserciones usan la transacción del contexto, por lo que el use case las agrupa con WithinTx.
 func (r *repository) CreateAssessment(ctx context.Context, assessment *domain.Assessment) (string, error) {
 	// Validar que assessment no sea nil.
 	if assessment == nil {
@@ -23,43 +31,145 @@ func (r *repository) CreateAssessment(ctx context.Context, assessment *domain.As
 	// Asignar un nuevo UUID para el ID.
 	model.ID = uuid.New().String()
 
-	// Ejecutar la inserción en la base de datos.
-	err := r.db.Client().WithContext(ctx).Create(model).Error
-	if err != nil {
+	db := r.db.DB(ctx)
+	if err := db.Omit(clause.Associations).Create(model).Error; err != nil {
 		return "", fmt.Errorf("failed to create assessment: %w", err)
 	}
 
+	for i := range model.Skills {
+		model.Skills[i].ID = uuid.New().String()
+		model.Skills[i].AssessmentID = model.ID
+	}
+	if len(model.Skills) > 0 {
+		if err := db.Create(&model.Skills).Error; err != nil {
+			return "", fmt.Errorf("failed to create assessment skills: %w", err)
+		}
+	}
+
+	if model.Problem != nil {
+		model.Problem.ID = uuid.New().String()
+		model.Problem.AssessmentID = model.ID
+		if err := db.Create(model.Problem).Error; err != nil {
+			return "", fmt.Errorf("failed to create assessment problem: %w", err)
+		}
+	}
+
+	for i := range model.UnitTests {
+		model.UnitTests[i].ID = uuid.New().String()
+		model.UnitTests[i].AssessmentID = model.ID
+	}
+	if len(model.UnitTests) > 0 {
+		if err := db.Create(&model.UnitTests).Error; err != nil {
+			return "", fmt.Errorf("failed to create assessment unit tests: %w", err)
+		}
+	}
+
 	return model.ID, nil
 }
 
-func (r *repository) ListAssessments(ctx context.Context) ([]domain.Assessment, error) {
-	var models []models.CreateAssessment
-	if err := r.db.Client().WithContext(ctx).Find(&models).Error; err != nil {
+func (r *repository) ListAssessments(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Assessment], error) {
+	page, err := gorm.Paginate[models.Assessment](r.db.DB(ctx).Model(&models.Assessment{}), spec)
+	if err != nil {
 		return nil, err
 	}
-
-	assessments := make([]domain.Assessment, 0, len(models))
-	for _, m := range models {
-		assessments = append(assessments, *m.ToDomain())
-	}
-	return assessments, nil
+	return types.MapPage(page, func(m models.Assessment) domain.Assessment { return *m.ToDomain() }), nil
 }
 
+// GetAssessment devuelve el assessment con sus skills, problema y unit tests.
 func (r *repository) GetAssessment(ctx context.Context, id string) (*domain.Assessment, error) {
 	var model models.Assessment
-	if err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	err := r.db.DB(ctx).Preload("Skills").Preload("Problem").Preload("UnitTests").
+		Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("assessment with id %s not found", id), err)
+		}
 		return nil, err
 	}
 	return model.ToDomain(), nil
 }
 
+// ListSeenProblemIDs devuelve los problemas del banco usados en evaluaciones del candidato,
+// incluidas las borradas: el candidato ya los vio.
+func (r *repository) ListSeenProblemIDs(ctx context.Context, candidateID string) ([]string, error) {
+	var ids []string
+	err := r.db.DB(ctx).Model(&models.Problem{}).
+		Joins("JOIN assessments ON assessments.id = problems.assessment_id").
+		Where("assessments.candidate_id = ? AND problems.bank_problem_id <> ''", candidateID).
+		Distinct().
+		Pluck("problems.bank_problem_id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seen problems: %w", err)
+	}
+	return ids, nil
+}
+
+// UpdateAssessment actualiza los datos propios del assessment; skills, problema y unit tests no se
+// modifican, y el estado solo cambia con UpdateAssessmentStatus.
 func (r *repository) UpdateAssessment(ctx context.Context, assessment *domain.Assessment) error {
-	model := &models.CreateAssessment{}
-	models.FromDomainAssessment(assessment)
-	return r.db.Client().WithContext(ctx).Save(model).Error
+	if assessment == nil {
+		return errors.New("assessment is nil")
+	}
+
+	model := models.FromDomainAssessment(assessment)
+	result := r.db.DB(ctx).Model(&models.Assessment{ID: assessment.ID}).
+		Select("hr_id", "candidate_id", "start_date", "end_date", "max_duration").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update assessment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("assessment with id %s not found", assessment.ID), nil)
+	}
+	return nil
+}
+
+// SoftDeleteAssessment marca el assessment como borrado, registrando quién lo borró.
+func (r *repository) SoftDeleteAssessment(ctx context.Context, id, deletedBy string) error {
+	result := r.db.DB(ctx).Model(&models.Assessment{}).Where("id = ?", id).
+		Updates(map[string]any{"deleted_at": time.Now(), "deleted_by": deletedBy})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete assessment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("assessment with id %s not found", id), nil)
+	}
+	return nil
 }
 
-// DeleteAssessment elimina un paciente a partir de su ID.
-func (r *repository) DeleteAssessment(ctx context.Context, id string) error {
-	return r.db.Client().WithContext(ctx).Delete(&models.CreateAssessment{}, "id = ?", id).Error
+// HardDeleteAssessment elimina definitivamente el assessment; skills, problema y unit tests se borran en cascada.
+func (r *repository) HardDeleteAssessment(ctx context.Context, id string) error {
+	result := r.db.DB(ctx).Unscoped().Delete(&models.Assessment{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete assessment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("assessment with id %s not found", id), nil)
+	}
+	return nil
+}
+
+// RestoreAssessment deshace el soft delete del assessment.
+func (r *repository) RestoreAssessment(ctx context.Context, id string) error {
+	result := r.db.DB(ctx).Unscoped().Model(&models.Assessment{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_by": nil})
+	if result.Error != nil {
+		return fmt.Errorf("failed to restore assessment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("deleted assessment with id %s not found", id), nil)
+	}
+	return nil
+}
+
+// PurgeDeletedAssessments borra definitivamente los assessments con soft delete anterior a before.
+func (r *repository) PurgeDeletedAssessments(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.DB(ctx).Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Delete(&models.Assessment{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge deleted assessments: %w", result.Error)
+	}
+	return result.RowsAffected, nil
 }
@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/repository/models"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
@@ -22,7 +26,7 @@ func (r *repository) StoreLink(ctx context.Context, link *domain.Link) (string,
 	}
 	model.ID = uuid.New().String()
 
-	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+	if err := r.db.DB(ctx).Create(model).Error; err != nil {
 		return "", fmt.Errorf("failed to store link: %w", err)
 	}
 
@@ -31,8 +35,81 @@ func (r *repository) StoreLink(ctx context.Context, link *domain.Link) (string,
 
 func (r *repository) GetLink(ctx context.Context, id string) (*domain.Link, error) {
 	var model models.Link
-	if err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
-		return nil, err
+	if err := r.db.DB(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("assessment link with id %s not found", id), err)
+		}
+		return nil, fmt.Errorf("failed to get link: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// GetLinkByToken busca el link por su token único.
+func (r *repository) GetLinkByToken(ctx context.Context, token string) (*domain.Link, error) {
+	var model models.Link
+	if err := r.db.DB(ctx).Where("token = ?", token).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, "assessment link not found", err)
+		}
+		return nil, fmt.Errorf("failed to get link by token: %w", err)
 	}
 	return model.ToDomain(), nil
 }
+
+// UpdateLink guarda el ciclo de vida del link con compare-and-set sobre la revocación: un link
+// revocado no vuelve a cambiar, así un recordatorio en curso no pisa una revocación.
+func (r *repository) UpdateLink(ctx context.Context, link *domain.Link) error {
+	if link == nil {
+		return errors.New("link is nil")
+	}
+
+	model := models.FromDomainToLink(link)
+	result := r.db.DB(ctx).Model(&models.Link{}).
+		Where("id = ? AND revoked_at IS NULL", link.ID).
+		Select("expires_at", "sent_at", "opened_at", "started_at", "revoked_at", "revoke_reason",
+			"replaced_by", "reminders_sent", "last_reminder_at").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update link: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrConflict, fmt.Sprintf("assessment link %s was revoked", link.ID), nil)
+	}
+	return nil
+}
+
+func (r *repository) ListLinksByAssessment(ctx context.Context, assessmentID string) ([]domain.Link, error) {
+	var ms []models.Link
+	err := r.db.DB(ctx).
+		Where("assessment_id = ?", assessmentID).
+		Order("created_at, id").
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list links: %w", err)
+	}
+
+	links := make([]domain.Link, 0, len(ms))
+	for _, m := range ms {
+		links = append(links, *m.ToDomain())
+	}
+	return links, nil
+}
+
+// ListPendingLinks devuelve los links enviados que siguen vigentes en now y con los que todavía no
+// se inició la evaluación.
+func (r *repository) ListPendingLinks(ctx context.Context, now time.Time) ([]domain.Link, error) {
+	var ms []models.Link
+	err := r.db.DB(ctx).
+		Where("revoked_at IS NULL AND started_at IS NULL AND sent_at IS NOT NULL AND expires_at > ?", now).
+		Order("expires_at").
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending links: %w", err)
+	}
+
+	links := make([]domain.Link, 0, len(ms))
+	for _, m := range ms {
+		links = append(links, *m.ToDomain())
+	}
+	return links, nil
+}
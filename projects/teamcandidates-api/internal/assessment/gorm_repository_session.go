@@ -0,0 +1,62 @@
+package assessment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// CreateSession inserta la sesión; el índice único sobre assessment_id impide abrir dos sesiones.
+func (r *repository) CreateSession(ctx context.Context, session *domain.Session) (string, error) {
+	if session == nil {
+		return "", errors.New("session is nil")
+	}
+
+	model := models.FromDomainSession(session)
+	model.ID = uuid.New().String()
+
+	if err := r.db.DB(ctx).Create(model).Error; err != nil {
+		return "", fmt.Errorf("failed to create session: %w", err)
+	}
+	return model.ID, nil
+}
+
+func (r *repository) GetSessionByAssessment(ctx context.Context, assessmentID string) (*domain.Session, error) {
+	var model models.AssessmentSession
+	if err := r.db.DB(ctx).Where("assessment_id = ?", assessmentID).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, "assessment session not found", err)
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// UpdateSession guarda el borrador con compare-and-set sobre la revisión, así un autosave
+// atrasado o concurrente no pisa uno más nuevo ni una sesión ya entregada.
+func (r *repository) UpdateSession(ctx context.Context, session *domain.Session, expectedRevision int64) error {
+	if session == nil {
+		return errors.New("session is nil")
+	}
+
+	model := models.FromDomainSession(session)
+	result := r.db.DB(ctx).Model(&models.AssessmentSession{}).
+		Where("id = ? AND revision = ? AND submitted_at IS NULL", session.ID, expectedRevision).
+		Select("language", "code", "answers", "revision", "last_saved_at", "submitted_at").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update session: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrConflict, "session was modified or already submitted", nil)
+	}
+	return nil
+}
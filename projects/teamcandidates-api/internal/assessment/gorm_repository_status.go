@@ -0,0 +1,86 @@
+package assessment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// UpdateAssessmentStatus guarda el estado nuevo con compare-and-set sobre el estado anterior, así
+// dos transiciones concurrentes (p. ej., la entrega y el scheduler de vencimientos) no se pisan.
+// Junto con el estado se guardan las fechas que fija la transición.
+func (r *repository) UpdateAssessmentStatus(ctx context.Context, assessment *domain.Assessment, from domain.AssessmentStatus) error {
+	if assessment == nil {
+		return errors.New("assessment is nil")
+	}
+
+	model := models.FromDomainAssessment(assessment)
+	result := r.db.DB(ctx).Model(&models.Assessment{}).
+		Where("id = ? AND status = ?", assessment.ID, string(from)).
+		Select("status", "start_date", "end_date", "deadline_at").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update assessment status: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrConflict, fmt.Sprintf("assessment %s is no longer %s", assessment.ID, from), nil)
+	}
+	return nil
+}
+
+func (r *repository) AppendStatusTransition(ctx context.Context, transition *domain.StatusTransition) error {
+	if transition == nil {
+		return errors.New("status transition is nil")
+	}
+
+	model := models.FromDomainStatusTransition(transition)
+	model.ID = uuid.New().String()
+	if err := r.db.DB(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to append status transition: %w", err)
+	}
+	transition.ID = model.ID
+	return nil
+}
+
+func (r *repository) ListStatusTransitions(ctx context.Context, assessmentID string) ([]domain.StatusTransition, error) {
+	var ms []models.AssessmentStatusTransition
+	err := r.db.DB(ctx).
+		Where("assessment_id = ?", assessmentID).
+		Order("occurred_at, id").
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list status transitions: %w", err)
+	}
+
+	transitions := make([]domain.StatusTransition, 0, len(ms))
+	for _, m := range ms {
+		transitions = append(transitions, m.ToDomain())
+	}
+	return transitions, nil
+}
+
+// ListOverdueAssessments devuelve las evaluaciones en curso cuyo deadline es anterior a before.
+func (r *repository) ListOverdueAssessments(ctx context.Context, before time.Time) ([]domain.Assessment, error) {
+	var ms []models.Assessment
+	err := r.db.DB(ctx).
+		Where("status = ? AND deadline_at IS NOT NULL AND deadline_at < ?", string(domain.StatusInProgress), before).
+		Order("deadline_at").
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overdue assessments: %w", err)
+	}
+
+	assessments := make([]domain.Assessment, 0, len(ms))
+	for i := range ms {
+		assessments = append(assessments, *ms[i].ToDomain())
+	}
+	return assessments, nil
+}
@@ -8,6 +8,8 @@ import (
 	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
 	gsv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/handler/dto"
 )
 
 type Handler struct {
@@ -36,30 +38,40 @@ func (h *Handler) Routes() {
 	// Rutas públicas
 	// public := router.Group(publicPrefix){}
 
+	// Rutas del candidato: cada request se autoriza con el token del link (header X-Assessment-Token),
+	// no con credenciales de usuario
 	validated := router.Group(validatedPrefix)
 	{
-		// Aplicar middleware de validación de credenciales
-		validated.Use(h.mws.Validated...)
-
-		// Obtener detalles de un assessment validado
-		//validated.GET("/:id", h.GetValidatedAssessment)
-		//validated.POST("/:id/responses", h.SubmitAssessmentResponse) // Enviar respuestas a un assessment validado
+		validated.GET("/link", h.OpenLink)                     // Apertura del link (registra la primera apertura)
+		validated.POST("/session/start", h.StartSession)       // Iniciar la evaluación o retomar la sesión
+		validated.GET("/session", h.GetSession)                // Estado de la sesión para retomarla tras reconectar
+		validated.GET("/session/problem", h.GetSessionProblem) // Problema sin las pruebas ocultas
+		validated.PUT("/session/autosave", h.AutosaveSession)  // Guardado periódico del borrador
+		validated.POST("/session/submit", h.SubmitSession)     // Entrega final
 	}
 
 	// Rutas protegidas
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionAssessmentRead, types.PermissionAssessmentWrite))
 
 		protected.GET("/ping", h.ProtectedPing) // Endpoint de prueba protegido
 
-		protected.POST("", h.CreateAssessment)       // Crear un assessment
-		protected.GET("", h.ListAssessments)         // Listar todos los assessments
-		protected.GET("/:id", h.GetAssessment)       // Obtener un assessment por ID
-		protected.PUT("/:id", h.UpdateAssessment)    // Actualizar un assessment
-		protected.DELETE("/:id", h.DeleteAssessment) // Eliminar un assessment
-		protected.POST("/:id/link", h.GenerateLink)  // Generar link único para un assessment
-		protected.GET("/:id/link", h.SendLink)       // Generar link único para un assessment
+		protected.POST("", h.CreateAssessment)                                                              // Crear un assessment
+		protected.GET("", mdw.ParseQuerySpec(dto.AssessmentQuerySchema), h.ListAssessments)                 // Listar assessments (paginado)
+		protected.GET("/:id", h.GetAssessment)                                                              // Obtener un assessment por ID
+		protected.PUT("/:id", h.UpdateAssessment)                                                           // Actualizar un assessment
+		protected.DELETE("/:id", h.DeleteAssessment)                                                        // Eliminar un assessment (soft delete salvo hardDelete=true)
+		protected.POST("/:id/restore", mdw.RequirePermission(types.PermissionRestore), h.RestoreAssessment) // Restaurar un assessment borrado
+		protected.POST("/:id/status", h.TransitionAssessment)                                               // Cambiar el estado (sent, expired o cancelled)
+		protected.GET("/:id/history", h.ListStatusHistory)                                                  // Historial de estados
+		protected.POST("/:id/link", h.GenerateLink)                                                         // Generar link único para un assessment
+		protected.GET("/:id/link", h.SendLink)                                                              // Generar link único para un assessment
+		protected.GET("/:id/links", h.ListLinks)                                                            // Links de la evaluación con su ciclo de vida
+		protected.POST("/:id/link/resend", h.ResendLink)                                                    // Reenviar con un token nuevo (revoca los anteriores)
+		protected.POST("/links/:linkId/revoke", h.RevokeLink)                                               // Revocar un link
+		protected.POST("/links/:linkId/extend", h.ExtendLink)                                               // Extender el vencimiento de un link
 	}
 }
 
@@ -10,15 +10,18 @@ import (
 
 // Assessment es el DTO que se utiliza para exponer/recibir datos vía JSON.
 type Assessment struct {
-	HRID        string        `json:"hr_id"`
-	CandidateID string        `json:"candidate_id"`
-	StartDate   time.Time     `json:"start_date"`
-	EndDate     time.Time     `json:"end_date"`
-	Status      string        `json:"status"` // tipo string en vez de domain.AssessmentStatus
-	MaxDuration string        `json:"max_duration"`
-	Skills      []SkillConfig `json:"skills"`
-	Problem     Problem       `json:"problem"`
-	UnitTests   []UnitTest    `json:"unit_tests"`
+	ID           string        `json:"id,omitempty"`
+	HRID         string        `json:"hr_id"`
+	CandidateID  string        `json:"candidate_id"`
+	JobOpeningID string        `json:"job_opening_id,omitempty"` // Solo lectura; se fija al crearla desde una búsqueda
+	StartDate    time.Time     `json:"start_date"`
+	EndDate      time.Time     `json:"end_date"`
+	DeadlineAt   *time.Time    `json:"deadline_at,omitempty"` // Solo lectura; lo fija el servidor al iniciar la sesión
+	Status       string        `json:"status"`                // tipo string en vez de domain.AssessmentStatus
+	MaxDuration  string        `json:"max_duration"`
+	Skills       []SkillConfig `json:"skills"`
+	Problem      Problem       `json:"problem"`
+	UnitTests    []UnitTest    `json:"unit_tests"`
 }
 
 func (a Assessment) ToDomain() (*domain.Assessment, error) {
@@ -45,16 +48,23 @@ func FromDomain(a *domain.Assessment) (*Assessment, error) {
 	if a == nil {
 		return nil, errors.New("assessment cannot be nil")
 	}
+	var deadlineAt *time.Time
+	if !a.DeadlineAt.IsZero() {
+		deadlineAt = &a.DeadlineAt
+	}
 	return &Assessment{
-		HRID:        a.HRID,
-		CandidateID: a.CandidateID,
-		StartDate:   a.StartDate,
-		EndDate:     a.EndDate,
-		Status:      string(a.Status), // Cast de domain.AssessmentStatus a string
-		MaxDuration: a.MaxDuration.String(),
-		Skills:      FromDomainToSkillConfigs(a.Skills),
-		Problem:     FromDomainToProblem(&a.Problem),
-		UnitTests:   FromDomainToUnitTests(a.UnitTests),
+		ID:           a.ID,
+		HRID:         a.HRID,
+		CandidateID:  a.CandidateID,
+		JobOpeningID: a.JobOpeningID,
+		StartDate:    a.StartDate,
+		EndDate:      a.EndDate,
+		DeadlineAt:   deadlineAt,
+		Status:       string(a.Status), // Cast de domain.AssessmentStatus a string
+		MaxDuration:  a.MaxDuration.String(),
+		Skills:       FromDomainToSkillConfigs(a.Skills),
+		Problem:      FromDomainToProblem(&a.Problem),
+		UnitTests:    FromDomainToUnitTests(a.UnitTests),
 	}, nil
 }
 
@@ -88,9 +98,13 @@ func FromDomainSkillConfig(s domain.SkillConfig) SkillConfig {
 
 // Problem es el DTO para el enunciado de un problema.
 type Problem struct {
-	ID           string `json:"id"`
-	AssessmentID string `json:"assessment_id,omitempty"`
-	Description  string `json:"description"`
+	ID            string `json:"id"`
+	AssessmentID  string `json:"assessment_id,omitempty"`
+	Description   string `json:"description"`
+	Language      string `json:"language,omitempty"`
+	StarterCode   string `json:"starter_code,omitempty"`
+	BankProblemID string `json:"bank_problem_id,omitempty"` // Solo lectura; lo completa el armado desde el banco
+	BankVersion   int    `json:"bank_version,omitempty"`
 }
 
 // ToDomain convierte un DTO de Problem a domain.Problem.
@@ -99,15 +113,21 @@ func (p Problem) ToDomain() domain.Problem {
 		ID:           p.ID,
 		AssessmentID: p.AssessmentID,
 		Description:  p.Description,
+		Language:     p.Language,
+		StarterCode:  p.StarterCode,
 	}
 }
 
 // FromDomain convierte una entidad de dominio a Problem (DTO).
 func FromDomainToProblem(p *domain.Problem) Problem {
 	return Problem{
-		ID:           p.ID,
-		AssessmentID: p.AssessmentID,
-		Description:  p.Description,
+		ID:            p.ID,
+		AssessmentID:  p.AssessmentID,
+		Description:   p.Description,
+		Language:      p.Language,
+		StarterCode:   p.StarterCode,
+		BankProblemID: p.BankProblemID,
+		BankVersion:   p.BankVersion,
 	}
 }
 
@@ -118,6 +138,7 @@ type UnitTest struct {
 	TestName       string `json:"test_name"`
 	InputData      string `json:"input_data"`
 	ExpectedOutput string `json:"expected_output"`
+	Hidden         bool   `json:"hidden"`
 }
 
 // ToDomain convierte un DTO de UnitTest a domain.UnitTest.
@@ -128,6 +149,7 @@ func (u UnitTest) ToDomain() domain.UnitTest {
 		TestName:       u.TestName,
 		InputData:      u.InputData,
 		ExpectedOutput: u.ExpectedOutput,
+		Hidden:         u.Hidden,
 	}
 }
 
@@ -139,6 +161,7 @@ func FromDomainToUnitTest(u domain.UnitTest) UnitTest {
 		TestName:       u.TestName,
 		InputData:      u.InputData,
 		ExpectedOutput: u.ExpectedOutput,
+		Hidden:         u.Hidden,
 	}
 }
 
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// RevokeLink es el body de POST /links/:linkId/revoke.
+type RevokeLink struct {
+	Reason string `json:"reason" binding:"omitempty,max=500"`
+}
+
+// ExtendLink es el body de POST /links/:linkId/extend.
+type ExtendLink struct {
+	ExpiresAt time.Time `json:"expires_at" binding:"required"`
+}
+
+// LinkStatus es el estado del link con las fechas de su ciclo de vida. No incluye el token.
+type LinkStatus struct {
+	ID             string     `json:"id"`
+	AssessmentID   string     `json:"assessment_id"`
+	Status         string     `json:"status"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	SentAt         *time.Time `json:"sent_at,omitempty"`
+	OpenedAt       *time.Time `json:"opened_at,omitempty"`
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+	RevokeReason   string     `json:"revoke_reason,omitempty"`
+	ReplacedBy     string     `json:"replaced_by,omitempty"`
+	RemindersSent  int        `json:"reminders_sent"`
+	LastReminderAt *time.Time `json:"last_reminder_at,omitempty"`
+}
+
+type ListLinksResponse struct {
+	AssessmentID string       `json:"assessment_id"`
+	Links        []LinkStatus `json:"links"`
+}
+
+// CandidateLink es lo que ve el candidato al abrir el link.
+type CandidateLink struct {
+	AssessmentID string     `json:"assessment_id"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+}
+
+func FromDomainLinkStatus(link *domain.Link, now time.Time) LinkStatus {
+	return LinkStatus{
+		ID:             link.ID,
+		AssessmentID:   link.AssessmentID,
+		Status:         string(link.Status(now)),
+		ExpiresAt:      link.ExpiresAt,
+		CreatedAt:      link.CreatedAt,
+		SentAt:         link.SentAt,
+		OpenedAt:       link.OpenedAt,
+		StartedAt:      link.StartedAt,
+		RevokedAt:      link.RevokedAt,
+		RevokeReason:   link.RevokeReason,
+		ReplacedBy:     link.ReplacedBy,
+		RemindersSent:  link.RemindersSent,
+		LastReminderAt: link.LastReminderAt,
+	}
+}
+
+func FromDomainLinks(assessmentID string, links []domain.Link, now time.Time) ListLinksResponse {
+	resp := ListLinksResponse{AssessmentID: assessmentID, Links: make([]LinkStatus, 0, len(links))}
+	for i := range links {
+		resp.Links = append(resp.Links, FromDomainLinkStatus(&links[i], now))
+	}
+	return resp
+}
+
+func FromDomainCandidateLink(link *domain.Link) CandidateLink {
+	return CandidateLink{
+		AssessmentID: link.AssessmentID,
+		ExpiresAt:    link.ExpiresAt,
+		StartedAt:    link.StartedAt,
+	}
+}
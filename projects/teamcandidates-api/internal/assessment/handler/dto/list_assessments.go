@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// AssessmentQuerySchema define los campos por los que se puede filtrar, ordenar y seleccionar en el listado.
+var AssessmentQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":             {Column: "id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"hr_id":          {Column: "hr_id", Type: types.FieldString, Filterable: true},
+		"candidate_id":   {Column: "candidate_id", Type: types.FieldString, Filterable: true},
+		"job_opening_id": {Column: "job_opening_id", Type: types.FieldString, Filterable: true},
+		"start_date":     {Column: "start_date", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"end_date":       {Column: "end_date", Type: types.FieldTime, Filterable: true},
+		"status":         {Column: "status", Type: types.FieldString, Filterable: true, Sortable: true},
+		"max_duration":   {Column: "max_duration", Type: types.FieldString},
+		"created_at":     {Column: "created_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"deleted_at":     {Column: "deleted_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"-created_at"},
+}
+
+// AssessmentListItem es la vista de un assessment en el listado.
+// DeletedAt y DeletedBy solo aparecen cuando un admin lista con deleted=include|only.
+type AssessmentListItem struct {
+	Assessment
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy string     `json:"deleted_by,omitempty"`
+}
+
+// FromDomainListItem convierte un assessment del listado al DTO.
+func FromDomainListItem(a domain.Assessment) AssessmentListItem {
+	item, _ := FromDomain(&a)
+	return AssessmentListItem{Assessment: *item, DeletedAt: a.DeletedAt, DeletedBy: a.DeletedBy}
+}
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// SessionDraft es el borrador que envía el candidato en cada autosave y en la entrega final.
+type SessionDraft struct {
+	Language string            `json:"language" binding:"max=50"`
+	Code     string            `json:"code"`
+	Answers  map[string]string `json:"answers,omitempty"`
+	Revision int64             `json:"revision" binding:"gte=0"`
+}
+
+func (d SessionDraft) ToDomain() *domain.SessionDraft {
+	return &domain.SessionDraft{
+		Language: d.Language,
+		Code:     d.Code,
+		Answers:  d.Answers,
+		Revision: d.Revision,
+	}
+}
+
+// Session es el estado de la sesión que ve el candidato; alcanza para retomarla tras reconectarse.
+type Session struct {
+	ID               string            `json:"id"`
+	AssessmentID     string            `json:"assessment_id"`
+	Language         string            `json:"language,omitempty"`
+	Code             string            `json:"code"`
+	Answers          map[string]string `json:"answers,omitempty"`
+	Revision         int64             `json:"revision"`
+	StartedAt        time.Time         `json:"started_at"`
+	LastSavedAt      *time.Time        `json:"last_saved_at,omitempty"`
+	SubmittedAt      *time.Time        `json:"submitted_at,omitempty"`
+	Deadline         *time.Time        `json:"deadline,omitempty"`
+	RemainingSeconds *int64            `json:"remaining_seconds,omitempty"`
+}
+
+// FromDomainSession arma la respuesta calculando el tiempo restante respecto de now.
+func FromDomainSession(s *domain.Session, now time.Time) Session {
+	resp := Session{
+		ID:           s.ID,
+		AssessmentID: s.AssessmentID,
+		Language:     s.Language,
+		Code:         s.Code,
+		Answers:      s.Answers,
+		Revision:     s.Revision,
+		StartedAt:    s.StartedAt,
+		SubmittedAt:  s.SubmittedAt,
+	}
+	if !s.LastSavedAt.IsZero() {
+		resp.LastSavedAt = &s.LastSavedAt
+	}
+	if !s.Deadline.IsZero() {
+		deadline := s.Deadline
+		remaining := int64(max(deadline.Sub(now), 0) / time.Second)
+		resp.Deadline = &deadline
+		resp.RemainingSeconds = &remaining
+	}
+	return resp
+}
+
+// SessionProblem es la evaluación tal como la ve el candidato: sin las pruebas ocultas.
+type SessionProblem struct {
+	AssessmentID string        `json:"assessment_id"`
+	MaxDuration  string        `json:"max_duration"`
+	Skills       []SkillConfig `json:"skills"`
+	Problem      Problem       `json:"problem"`
+	UnitTests    []UnitTest    `json:"unit_tests"`
+}
+
+func FromDomainSessionProblem(a *domain.Assessment) SessionProblem {
+	return SessionProblem{
+		AssessmentID: a.ID,
+		MaxDuration:  a.MaxDuration.String(),
+		Skills:       FromDomainToSkillConfigs(a.Skills),
+		Problem:      FromDomainToProblem(&a.Problem),
+		UnitTests:    FromDomainToUnitTests(a.VisibleUnitTests()),
+	}
+}
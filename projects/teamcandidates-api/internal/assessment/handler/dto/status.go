@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// TransitionStatus es el body de POST /:id/status; solo acepta sent, expired o cancelled.
+type TransitionStatus struct {
+	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason"`
+}
+
+// StatusTransition es una entrada del historial de estados.
+type StatusTransition struct {
+	ID         string    `json:"id"`
+	From       string    `json:"from,omitempty"`
+	To         string    `json:"to"`
+	Reason     string    `json:"reason,omitempty"`
+	Actor      string    `json:"actor"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type StatusHistoryResponse struct {
+	AssessmentID string             `json:"assessment_id"`
+	History      []StatusTransition `json:"history"`
+}
+
+func FromDomainStatusHistory(assessmentID string, transitions []domain.StatusTransition) StatusHistoryResponse {
+	history := make([]StatusTransition, 0, len(transitions))
+	for _, t := range transitions {
+		history = append(history, StatusTransition{
+			ID:         t.ID,
+			From:       string(t.From),
+			To:         string(t.To),
+			Reason:     t.Reason,
+			Actor:      t.Actor,
+			OccurredAt: t.OccurredAt,
+		})
+	}
+	return StatusHistoryResponse{AssessmentID: assessmentID, History: history}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
 	types "github.com/teamcubation/teamcandidates/pkg/types"
 	utils "github.com/teamcubation/teamcandidates/pkg/utils"
 
@@ -67,13 +68,27 @@ func (h *Handler) CreateAssessment(c *gin.Context) {
 }
 
 func (h *Handler) ListAssessments(c *gin.Context) {
-	users, err := h.ucs.ListAssessments(c.Request.Context())
+	spec, err := mdw.GetQuerySpec(c)
 	if err != nil {
 		apiErr, errCode := types.NewAPIError(err)
 		c.Error(apiErr).SetMeta(errCode)
 		return
 	}
-	c.JSON(http.StatusOK, users)
+
+	page, err := h.ucs.ListAssessments(c.Request.Context(), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainListItem), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, res)
 }
 
 func (h *Handler) GetAssessment(c *gin.Context) {
@@ -103,6 +118,7 @@ func (h *Handler) UpdateAssessment(c *gin.Context) {
 		c.Error(apiErr).SetMeta(errCode)
 		return
 	}
+	updatedAssessment.ID = c.Param("id")
 	if err := h.ucs.UpdateAssessment(c.Request.Context(), updatedAssessment); err != nil {
 		apiErr, errCode := types.NewAPIError(err)
 		c.Error(apiErr).SetMeta(errCode)
@@ -115,7 +131,8 @@ func (h *Handler) UpdateAssessment(c *gin.Context) {
 
 func (h *Handler) DeleteAssessment(c *gin.Context) {
 	id := c.Param("id")
-	if err := h.ucs.DeleteAssessment(c.Request.Context(), id); err != nil {
+	hardDelete := c.Query("hardDelete") == "true"
+	if err := h.ucs.DeleteAssessment(c.Request.Context(), id, hardDelete); err != nil {
 		apiErr, errCode := types.NewAPIError(err)
 		c.Error(apiErr).SetMeta(errCode)
 		return
@@ -124,3 +141,15 @@ func (h *Handler) DeleteAssessment(c *gin.Context) {
 		Message: "Assessment deleted successfully",
 	})
 }
+
+func (h *Handler) RestoreAssessment(c *gin.Context) {
+	id := c.Param("id")
+	if err := h.ucs.RestoreAssessment(c.Request.Context(), id); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Assessment restored successfully",
+	})
+}
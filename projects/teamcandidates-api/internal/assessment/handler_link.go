@@ -2,9 +2,11 @@ package assessment
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
 	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/handler/dto"
 )
 
@@ -37,3 +39,83 @@ func (h *Handler) SendLink(c *gin.Context) {
 		Link:    assessmentLinkID,
 	})
 }
+
+func (h *Handler) ListLinks(c *gin.Context) {
+	assessmentID := c.Param("id")
+	links, err := h.ucs.ListLinks(c.Request.Context(), assessmentID)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainLinks(assessmentID, links, time.Now()))
+}
+
+func (h *Handler) ResendLink(c *gin.Context) {
+	link, err := h.ucs.ResendLink(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.FromDomainLinkStatus(link, time.Now()))
+}
+
+func (h *Handler) RevokeLink(c *gin.Context) {
+	var req dto.RevokeLink
+	// El body es opcional: sin motivo se registra quién revocó el link
+	if c.Request.ContentLength > 0 {
+		if err := utils.ValidateRequest(c, &req); err != nil {
+			apiErr, errCode := types.NewAPIError(err)
+			c.Error(apiErr).SetMeta(errCode)
+			return
+		}
+	}
+
+	if err := h.ucs.RevokeLink(c.Request.Context(), c.Param("linkId"), req.Reason); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Assessment link successfully revoked",
+	})
+}
+
+func (h *Handler) ExtendLink(c *gin.Context) {
+	var req dto.ExtendLink
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	link, err := h.ucs.ExtendLink(c.Request.Context(), c.Param("linkId"), req.ExpiresAt)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainLinkStatus(link, time.Now()))
+}
+
+// OpenLink es lo primero que llama el front del candidato al abrir la URL del email.
+func (h *Handler) OpenLink(c *gin.Context) {
+	token, ok := linkToken(c)
+	if !ok {
+		return
+	}
+
+	link, err := h.ucs.OpenLink(c.Request.Context(), token)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainCandidateLink(link))
+}
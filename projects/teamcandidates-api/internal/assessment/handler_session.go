@@ -0,0 +1,129 @@
+package assessment
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/handler/dto"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// linkTokenHeader es el header con el que el front del candidato envía el token del link.
+// El token llega al front en la URL del email, pero la API solo lo acepta por header para que
+// no quede en logs de acceso, proxies ni en el historial.
+const linkTokenHeader = "X-Assessment-Token"
+
+// linkToken extrae el token del link; responde 401 si la request no lo trae.
+func linkToken(c *gin.Context) (string, bool) {
+	token := c.GetHeader(linkTokenHeader)
+	if token == "" {
+		apiErr, errCode := types.NewAPIError(types.NewError(types.ErrAuthentication, "assessment link token is required", nil))
+		c.Error(apiErr).SetMeta(errCode)
+		return "", false
+	}
+	return token, true
+}
+
+func (h *Handler) StartSession(c *gin.Context) {
+	token, ok := linkToken(c)
+	if !ok {
+		return
+	}
+
+	session, err := h.ucs.StartSession(c.Request.Context(), token)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSession(session, time.Now()))
+}
+
+func (h *Handler) GetSession(c *gin.Context) {
+	token, ok := linkToken(c)
+	if !ok {
+		return
+	}
+
+	session, err := h.ucs.GetSession(c.Request.Context(), token)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSession(session, time.Now()))
+}
+
+func (h *Handler) GetSessionProblem(c *gin.Context) {
+	token, ok := linkToken(c)
+	if !ok {
+		return
+	}
+
+	assessment, err := h.ucs.GetSessionProblem(c.Request.Context(), token)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSessionProblem(assessment))
+}
+
+func (h *Handler) AutosaveSession(c *gin.Context) {
+	token, ok := linkToken(c)
+	if !ok {
+		return
+	}
+
+	var req dto.SessionDraft
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	session, err := h.ucs.AutosaveSession(c.Request.Context(), token, req.ToDomain())
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSession(session, time.Now()))
+}
+
+func (h *Handler) SubmitSession(c *gin.Context) {
+	token, ok := linkToken(c)
+	if !ok {
+		return
+	}
+
+	// El body es opcional: sin borrador se entrega lo último que se guardó
+	var draft *domain.SessionDraft
+	if c.Request.ContentLength != 0 {
+		var req dto.SessionDraft
+		if err := utils.ValidateRequest(c, &req); err != nil {
+			apiErr, errCode := types.NewAPIError(err)
+			c.Error(apiErr).SetMeta(errCode)
+			return
+		}
+		draft = req.ToDomain()
+	}
+
+	session, err := h.ucs.SubmitSession(c.Request.Context(), token, draft)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSession(session, time.Now()))
+}
@@ -0,0 +1,43 @@
+package assessment
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/handler/dto"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+func (h *Handler) TransitionAssessment(c *gin.Context) {
+	var req dto.TransitionStatus
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	id := c.Param("id")
+	if err := h.ucs.TransitionAssessment(c.Request.Context(), id, domain.AssessmentStatus(req.Status), req.Reason); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Assessment status updated successfully",
+	})
+}
+
+func (h *Handler) ListStatusHistory(c *gin.Context) {
+	id := c.Param("id")
+	history, err := h.ucs.ListStatusHistory(c.Request.Context(), id)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainStatusHistory(id, history))
+}
@@ -0,0 +1,364 @@
+package assessment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// memoryRepository guarda cada assessment junto con sus skills, problema y unit tests en una sola fila.
+type memoryRepository struct {
+	assessments *mapdb.Table[models.Assessment]
+	links       *mapdb.Table[models.Link]
+	sessions    *mapdb.Table[models.AssessmentSession]
+	transitions *mapdb.Table[models.AssessmentStatusTransition]
+}
+
+// NewMemoryRepository crea el repositorio de assessments y links sobre la base en memoria.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		assessments: mapdb.NewTable(db, "assessments",
+			func(a *models.Assessment) string { return a.ID },
+			mapdb.Index[models.Assessment]{
+				Name:   "candidate_id",
+				Values: func(a *models.Assessment) []string { return []string{a.CandidateID} },
+			},
+		),
+		links: mapdb.NewTable(db, "links",
+			func(l *models.Link) string { return l.ID },
+			mapdb.Index[models.Link]{
+				Name:   "token",
+				Unique: true,
+				Values: func(l *models.Link) []string { return []string{l.Token} },
+			},
+			mapdb.Index[models.Link]{
+				Name:   "assessment_id",
+				Values: func(l *models.Link) []string { return []string{l.AssessmentID} },
+			},
+		),
+		sessions: mapdb.NewTable(db, "assessment_sessions",
+			func(s *models.AssessmentSession) string { return s.ID },
+			mapdb.Index[models.AssessmentSession]{
+				Name:   "assessment_id",
+				Unique: true,
+				Values: func(s *models.AssessmentSession) []string { return []string{s.AssessmentID} },
+			},
+		),
+		transitions: mapdb.NewTable(db, "assessment_status_transitions",
+			func(t *models.AssessmentStatusTransition) string { return t.ID },
+			mapdb.Index[models.AssessmentStatusTransition]{
+				Name:   "assessment_id",
+				Values: func(t *models.AssessmentStatusTransition) []string { return []string{t.AssessmentID} },
+			},
+		),
+	}
+}
+
+func (r *memoryRepository) CreateAssessment(ctx context.Context, assessment *domain.Assessment) (string, error) {
+	if assessment == nil {
+		return "", errors.New("assessment is nil")
+	}
+
+	model := models.FromDomainAssessment(assessment)
+	model.ID = uuid.New().String()
+	model.CreatedAt = time.Now()
+	model.UpdatedAt = model.CreatedAt
+
+	for i := range model.Skills {
+		model.Skills[i].ID = uuid.New().String()
+		model.Skills[i].AssessmentID = model.ID
+	}
+	if model.Problem != nil {
+		model.Problem.ID = uuid.New().String()
+		model.Problem.AssessmentID = model.ID
+	}
+	for i := range model.UnitTests {
+		model.UnitTests[i].ID = uuid.New().String()
+		model.UnitTests[i].AssessmentID = model.ID
+	}
+
+	if err := r.assessments.Insert(ctx, model); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) ListAssessments(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Assessment], error) {
+	page, err := r.assessments.Page(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	return types.MapPage(page, func(m models.Assessment) domain.Assessment { return *m.ToDomain() }), nil
+}
+
+func (r *memoryRepository) GetAssessment(ctx context.Context, id string) (*domain.Assessment, error) {
+	model, err := r.assessments.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if mapdb.IsDeleted(model) {
+		return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("assessment with id %s not found", id), nil)
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *memoryRepository) UpdateAssessment(ctx context.Context, assessment *domain.Assessment) error {
+	if assessment == nil {
+		return errors.New("assessment is nil")
+	}
+
+	updated := models.FromDomainAssessment(assessment)
+	return r.assessments.Modify(ctx, assessment.ID, func(m *models.Assessment) error {
+		updated.CreatedAt, updated.UpdatedAt = m.CreatedAt, time.Now()
+		updated.DeletedAt, updated.DeletedBy = m.DeletedAt, m.DeletedBy
+		// El estado solo cambia con UpdateAssessmentStatus
+		updated.Status, updated.DeadlineAt = m.Status, m.DeadlineAt
+		*m = *updated
+		return nil
+	})
+}
+
+func (r *memoryRepository) SoftDeleteAssessment(ctx context.Context, id, deletedBy string) error {
+	return r.assessments.Modify(ctx, id, func(m *models.Assessment) error {
+		if m.DeletedAt.Valid {
+			return types.NewError(types.ErrNotFound, fmt.Sprintf("assessment with id %s not found", id), nil)
+		}
+		m.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		m.DeletedBy = deletedBy
+		return nil
+	})
+}
+
+func (r *memoryRepository) HardDeleteAssessment(ctx context.Context, id string) error {
+	return r.assessments.Delete(ctx, id)
+}
+
+func (r *memoryRepository) RestoreAssessment(ctx context.Context, id string) error {
+	return r.assessments.Modify(ctx, id, func(m *models.Assessment) error {
+		if !m.DeletedAt.Valid {
+			return types.NewError(types.ErrNotFound, fmt.Sprintf("deleted assessment with id %s not found", id), nil)
+		}
+		m.DeletedAt = gorm.DeletedAt{}
+		m.DeletedBy = ""
+		return nil
+	})
+}
+
+func (r *memoryRepository) PurgeDeletedAssessments(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := r.assessments.DeleteWhere(ctx, func(m *models.Assessment) bool {
+		return m.DeletedAt.Valid && m.DeletedAt.Time.Before(before)
+	})
+	return int64(purged), err
+}
+
+// ListSeenProblemIDs incluye las evaluaciones borradas: el candidato ya vio esos problemas.
+func (r *memoryRepository) ListSeenProblemIDs(ctx context.Context, candidateID string) ([]string, error) {
+	ms, err := r.assessments.FindBy(ctx, "candidate_id", candidateID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(ms))
+	for _, m := range ms {
+		if m.Problem != nil && m.Problem.BankProblemID != "" && !slices.Contains(ids, m.Problem.BankProblemID) {
+			ids = append(ids, m.Problem.BankProblemID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *memoryRepository) UpdateAssessmentStatus(ctx context.Context, assessment *domain.Assessment, from domain.AssessmentStatus) error {
+	if assessment == nil {
+		return errors.New("assessment is nil")
+	}
+
+	updated := models.FromDomainAssessment(assessment)
+	return r.assessments.Modify(ctx, assessment.ID, func(m *models.Assessment) error {
+		if m.Status != string(from) || m.DeletedAt.Valid {
+			return types.NewError(types.ErrConflict, fmt.Sprintf("assessment %s is no longer %s", assessment.ID, from), nil)
+		}
+		m.Status = updated.Status
+		m.StartDate, m.EndDate, m.DeadlineAt = updated.StartDate, updated.EndDate, updated.DeadlineAt
+		m.UpdatedAt = time.Now()
+		return nil
+	})
+}
+
+func (r *memoryRepository) AppendStatusTransition(ctx context.Context, transition *domain.StatusTransition) error {
+	if transition == nil {
+		return errors.New("status transition is nil")
+	}
+
+	model := models.FromDomainStatusTransition(transition)
+	model.ID = uuid.New().String()
+	if err := r.transitions.Insert(ctx, model); err != nil {
+		return err
+	}
+	transition.ID = model.ID
+	return nil
+}
+
+func (r *memoryRepository) ListStatusTransitions(ctx context.Context, assessmentID string) ([]domain.StatusTransition, error) {
+	ms, err := r.transitions.FindBy(ctx, "assessment_id", assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(ms, func(a, b models.AssessmentStatusTransition) int { return a.OccurredAt.Compare(b.OccurredAt) })
+
+	transitions := make([]domain.StatusTransition, 0, len(ms))
+	for _, m := range ms {
+		transitions = append(transitions, m.ToDomain())
+	}
+	return transitions, nil
+}
+
+func (r *memoryRepository) ListOverdueAssessments(ctx context.Context, before time.Time) ([]domain.Assessment, error) {
+	ms, err := r.assessments.Find(ctx, func(m *models.Assessment) bool {
+		return !m.DeletedAt.Valid && m.Status == string(domain.StatusInProgress) &&
+			m.DeadlineAt != nil && m.DeadlineAt.Before(before)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assessments := make([]domain.Assessment, 0, len(ms))
+	for i := range ms {
+		assessments = append(assessments, *ms[i].ToDomain())
+	}
+	return assessments, nil
+}
+
+func (r *memoryRepository) StoreLink(ctx context.Context, link *domain.Link) (string, error) {
+	if link == nil {
+		return "", errors.New("link is nil")
+	}
+
+	model := models.FromDomainToLink(link)
+	model.ID = uuid.New().String()
+	model.CreatedAt = time.Now()
+	model.UpdatedAt = model.CreatedAt
+
+	if err := r.links.Insert(ctx, model); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) GetLink(ctx context.Context, id string) (*domain.Link, error) {
+	model, err := r.links.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *memoryRepository) GetLinkByToken(ctx context.Context, token string) (*domain.Link, error) {
+	model, err := r.links.FindOneBy(ctx, "token", token)
+	if err != nil {
+		return nil, err
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *memoryRepository) UpdateLink(ctx context.Context, link *domain.Link) error {
+	if link == nil {
+		return errors.New("link is nil")
+	}
+
+	updated := models.FromDomainToLink(link)
+	return r.links.Modify(ctx, link.ID, func(m *models.Link) error {
+		if m.RevokedAt != nil {
+			return types.NewError(types.ErrConflict, fmt.Sprintf("assessment link %s was revoked", link.ID), nil)
+		}
+		updated.ID, updated.AssessmentID, updated.Token, updated.URL = m.ID, m.AssessmentID, m.Token, m.URL
+		updated.CreatedAt, updated.UpdatedAt = m.CreatedAt, time.Now()
+		*m = *updated
+		return nil
+	})
+}
+
+func (r *memoryRepository) ListLinksByAssessment(ctx context.Context, assessmentID string) ([]domain.Link, error) {
+	ms, err := r.links.FindBy(ctx, "assessment_id", assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(ms, func(a, b models.Link) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	links := make([]domain.Link, 0, len(ms))
+	for _, m := range ms {
+		links = append(links, *m.ToDomain())
+	}
+	return links, nil
+}
+
+func (r *memoryRepository) ListPendingLinks(ctx context.Context, now time.Time) ([]domain.Link, error) {
+	ms, err := r.links.Find(ctx, func(m *models.Link) bool {
+		return m.RevokedAt == nil && m.StartedAt == nil && m.SentAt != nil && m.ExpiresAt.After(now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(ms, func(a, b models.Link) int { return a.ExpiresAt.Compare(b.ExpiresAt) })
+
+	links := make([]domain.Link, 0, len(ms))
+	for _, m := range ms {
+		links = append(links, *m.ToDomain())
+	}
+	return links, nil
+}
+
+func (r *memoryRepository) CreateSession(ctx context.Context, session *domain.Session) (string, error) {
+	if session == nil {
+		return "", errors.New("session is nil")
+	}
+
+	model := models.FromDomainSession(session)
+	model.ID = uuid.New().String()
+	model.CreatedAt = time.Now()
+	model.UpdatedAt = model.CreatedAt
+
+	if err := r.sessions.Insert(ctx, model); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) GetSessionByAssessment(ctx context.Context, assessmentID string) (*domain.Session, error) {
+	model, err := r.sessions.FindOneBy(ctx, "assessment_id", assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *memoryRepository) UpdateSession(ctx context.Context, session *domain.Session, expectedRevision int64) error {
+	if session == nil {
+		return errors.New("session is nil")
+	}
+
+	updated := models.FromDomainSession(session)
+	return r.sessions.Modify(ctx, session.ID, func(m *models.AssessmentSession) error {
+		if m.Revision != expectedRevision || m.SubmittedAt != nil {
+			return types.NewError(types.ErrConflict, "session was modified or already submitted", nil)
+		}
+		m.Language, m.Code, m.Answers = updated.Language, updated.Code, updated.Answers
+		m.Revision = updated.Revision
+		m.LastSavedAt, m.SubmittedAt = updated.LastSavedAt, updated.SubmittedAt
+		m.UpdatedAt = time.Now()
+		return nil
+	})
+}
@@ -0,0 +1,857 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// AutosaveSession mocks base method.
+func (m *MockUseCases) AutosaveSession(arg0 context.Context, arg1 string, arg2 *domain.SessionDraft) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutosaveSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutosaveSession indicates an expected call of AutosaveSession.
+func (mr *MockUseCasesMockRecorder) AutosaveSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutosaveSession", reflect.TypeOf((*MockUseCases)(nil).AutosaveSession), arg0, arg1, arg2)
+}
+
+// CreateAssessment mocks base method.
+func (m *MockUseCases) CreateAssessment(arg0 context.Context, arg1 *domain.Assessment) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssessment", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAssessment indicates an expected call of CreateAssessment.
+func (mr *MockUseCasesMockRecorder) CreateAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssessment", reflect.TypeOf((*MockUseCases)(nil).CreateAssessment), arg0, arg1)
+}
+
+// DeleteAssessment mocks base method.
+func (m *MockUseCases) DeleteAssessment(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAssessment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAssessment indicates an expected call of DeleteAssessment.
+func (mr *MockUseCasesMockRecorder) DeleteAssessment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssessment", reflect.TypeOf((*MockUseCases)(nil).DeleteAssessment), arg0, arg1, arg2)
+}
+
+// ExpireOverdue mocks base method.
+func (m *MockUseCases) ExpireOverdue(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOverdue", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOverdue indicates an expected call of ExpireOverdue.
+func (mr *MockUseCasesMockRecorder) ExpireOverdue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOverdue", reflect.TypeOf((*MockUseCases)(nil).ExpireOverdue), arg0)
+}
+
+// ExtendLink mocks base method.
+func (m *MockUseCases) ExtendLink(arg0 context.Context, arg1 string, arg2 time.Time) (*domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendLink", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendLink indicates an expected call of ExtendLink.
+func (mr *MockUseCasesMockRecorder) ExtendLink(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendLink", reflect.TypeOf((*MockUseCases)(nil).ExtendLink), arg0, arg1, arg2)
+}
+
+// GenerateLink mocks base method.
+func (m *MockUseCases) GenerateLink(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateLink", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateLink indicates an expected call of GenerateLink.
+func (mr *MockUseCasesMockRecorder) GenerateLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLink", reflect.TypeOf((*MockUseCases)(nil).GenerateLink), arg0, arg1)
+}
+
+// GetAssessment mocks base method.
+func (m *MockUseCases) GetAssessment(arg0 context.Context, arg1 string) (*domain.Assessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssessment", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Assessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssessment indicates an expected call of GetAssessment.
+func (mr *MockUseCasesMockRecorder) GetAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssessment", reflect.TypeOf((*MockUseCases)(nil).GetAssessment), arg0, arg1)
+}
+
+// GetLink mocks base method.
+func (m *MockUseCases) GetLink(arg0 context.Context, arg1 string) (*domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLink", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLink indicates an expected call of GetLink.
+func (mr *MockUseCasesMockRecorder) GetLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLink", reflect.TypeOf((*MockUseCases)(nil).GetLink), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockUseCases) GetSession(arg0 context.Context, arg1 string) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockUseCasesMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockUseCases)(nil).GetSession), arg0, arg1)
+}
+
+// GetSessionProblem mocks base method.
+func (m *MockUseCases) GetSessionProblem(arg0 context.Context, arg1 string) (*domain.Assessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionProblem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Assessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionProblem indicates an expected call of GetSessionProblem.
+func (mr *MockUseCasesMockRecorder) GetSessionProblem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionProblem", reflect.TypeOf((*MockUseCases)(nil).GetSessionProblem), arg0, arg1)
+}
+
+// GetSubmission mocks base method.
+func (m *MockUseCases) GetSubmission(arg0 context.Context, arg1 string) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmission", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubmission indicates an expected call of GetSubmission.
+func (mr *MockUseCasesMockRecorder) GetSubmission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmission", reflect.TypeOf((*MockUseCases)(nil).GetSubmission), arg0, arg1)
+}
+
+// ListAssessments mocks base method.
+func (m *MockUseCases) ListAssessments(arg0 context.Context, arg1 *types.QuerySpec) (*types.Page[domain.Assessment], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssessments", arg0, arg1)
+	ret0, _ := ret[0].(*types.Page[domain.Assessment])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssessments indicates an expected call of ListAssessments.
+func (mr *MockUseCasesMockRecorder) ListAssessments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssessments", reflect.TypeOf((*MockUseCases)(nil).ListAssessments), arg0, arg1)
+}
+
+// ListLinks mocks base method.
+func (m *MockUseCases) ListLinks(arg0 context.Context, arg1 string) ([]domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLinks", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLinks indicates an expected call of ListLinks.
+func (mr *MockUseCasesMockRecorder) ListLinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLinks", reflect.TypeOf((*MockUseCases)(nil).ListLinks), arg0, arg1)
+}
+
+// ListSeenProblems mocks base method.
+func (m *MockUseCases) ListSeenProblems(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeenProblems", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeenProblems indicates an expected call of ListSeenProblems.
+func (mr *MockUseCasesMockRecorder) ListSeenProblems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeenProblems", reflect.TypeOf((*MockUseCases)(nil).ListSeenProblems), arg0, arg1)
+}
+
+// ListStatusHistory mocks base method.
+func (m *MockUseCases) ListStatusHistory(arg0 context.Context, arg1 string) ([]domain.StatusTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatusHistory", arg0, arg1)
+	ret0, _ := ret[0].([]domain.StatusTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatusHistory indicates an expected call of ListStatusHistory.
+func (mr *MockUseCasesMockRecorder) ListStatusHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatusHistory", reflect.TypeOf((*MockUseCases)(nil).ListStatusHistory), arg0, arg1)
+}
+
+// MarkGraded mocks base method.
+func (m *MockUseCases) MarkGraded(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkGraded", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkGraded indicates an expected call of MarkGraded.
+func (mr *MockUseCasesMockRecorder) MarkGraded(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkGraded", reflect.TypeOf((*MockUseCases)(nil).MarkGraded), arg0, arg1)
+}
+
+// OpenLink mocks base method.
+func (m *MockUseCases) OpenLink(arg0 context.Context, arg1 string) (*domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenLink", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenLink indicates an expected call of OpenLink.
+func (mr *MockUseCasesMockRecorder) OpenLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenLink", reflect.TypeOf((*MockUseCases)(nil).OpenLink), arg0, arg1)
+}
+
+// PurgeDeletedAssessments mocks base method.
+func (m *MockUseCases) PurgeDeletedAssessments(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedAssessments", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedAssessments indicates an expected call of PurgeDeletedAssessments.
+func (mr *MockUseCasesMockRecorder) PurgeDeletedAssessments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedAssessments", reflect.TypeOf((*MockUseCases)(nil).PurgeDeletedAssessments), arg0, arg1)
+}
+
+// ResendLink mocks base method.
+func (m *MockUseCases) ResendLink(arg0 context.Context, arg1 string) (*domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendLink", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendLink indicates an expected call of ResendLink.
+func (mr *MockUseCasesMockRecorder) ResendLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendLink", reflect.TypeOf((*MockUseCases)(nil).ResendLink), arg0, arg1)
+}
+
+// RestoreAssessment mocks base method.
+func (m *MockUseCases) RestoreAssessment(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAssessment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAssessment indicates an expected call of RestoreAssessment.
+func (mr *MockUseCasesMockRecorder) RestoreAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAssessment", reflect.TypeOf((*MockUseCases)(nil).RestoreAssessment), arg0, arg1)
+}
+
+// RevokeLink mocks base method.
+func (m *MockUseCases) RevokeLink(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeLink", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeLink indicates an expected call of RevokeLink.
+func (mr *MockUseCasesMockRecorder) RevokeLink(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeLink", reflect.TypeOf((*MockUseCases)(nil).RevokeLink), arg0, arg1, arg2)
+}
+
+// RunExpiry mocks base method.
+func (m *MockUseCases) RunExpiry(arg0 context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunExpiry", arg0)
+}
+
+// RunExpiry indicates an expected call of RunExpiry.
+func (mr *MockUseCasesMockRecorder) RunExpiry(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunExpiry", reflect.TypeOf((*MockUseCases)(nil).RunExpiry), arg0)
+}
+
+// RunReminders mocks base method.
+func (m *MockUseCases) RunReminders(arg0 context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunReminders", arg0)
+}
+
+// RunReminders indicates an expected call of RunReminders.
+func (mr *MockUseCasesMockRecorder) RunReminders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunReminders", reflect.TypeOf((*MockUseCases)(nil).RunReminders), arg0)
+}
+
+// SendDueReminders mocks base method.
+func (m *MockUseCases) SendDueReminders(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDueReminders", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendDueReminders indicates an expected call of SendDueReminders.
+func (mr *MockUseCasesMockRecorder) SendDueReminders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDueReminders", reflect.TypeOf((*MockUseCases)(nil).SendDueReminders), arg0)
+}
+
+// SendLink mocks base method.
+func (m *MockUseCases) SendLink(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendLink", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendLink indicates an expected call of SendLink.
+func (mr *MockUseCasesMockRecorder) SendLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendLink", reflect.TypeOf((*MockUseCases)(nil).SendLink), arg0, arg1)
+}
+
+// StartSession mocks base method.
+func (m *MockUseCases) StartSession(arg0 context.Context, arg1 string) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSession", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSession indicates an expected call of StartSession.
+func (mr *MockUseCasesMockRecorder) StartSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockUseCases)(nil).StartSession), arg0, arg1)
+}
+
+// SubmitSession mocks base method.
+func (m *MockUseCases) SubmitSession(arg0 context.Context, arg1 string, arg2 *domain.SessionDraft) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitSession indicates an expected call of SubmitSession.
+func (mr *MockUseCasesMockRecorder) SubmitSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitSession", reflect.TypeOf((*MockUseCases)(nil).SubmitSession), arg0, arg1, arg2)
+}
+
+// TransitionAssessment mocks base method.
+func (m *MockUseCases) TransitionAssessment(arg0 context.Context, arg1 string, arg2 domain.AssessmentStatus, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionAssessment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransitionAssessment indicates an expected call of TransitionAssessment.
+func (mr *MockUseCasesMockRecorder) TransitionAssessment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionAssessment", reflect.TypeOf((*MockUseCases)(nil).TransitionAssessment), arg0, arg1, arg2, arg3)
+}
+
+// UpdateAssessment mocks base method.
+func (m *MockUseCases) UpdateAssessment(arg0 context.Context, arg1 *domain.Assessment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssessment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAssessment indicates an expected call of UpdateAssessment.
+func (mr *MockUseCasesMockRecorder) UpdateAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssessment", reflect.TypeOf((*MockUseCases)(nil).UpdateAssessment), arg0, arg1)
+}
+
+// ValidateLink mocks base method.
+func (m *MockUseCases) ValidateLink(arg0 context.Context, arg1 string) (*domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateLink", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateLink indicates an expected call of ValidateLink.
+func (mr *MockUseCasesMockRecorder) ValidateLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateLink", reflect.TypeOf((*MockUseCases)(nil).ValidateLink), arg0, arg1)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendStatusTransition mocks base method.
+func (m *MockRepository) AppendStatusTransition(arg0 context.Context, arg1 *domain.StatusTransition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendStatusTransition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendStatusTransition indicates an expected call of AppendStatusTransition.
+func (mr *MockRepositoryMockRecorder) AppendStatusTransition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendStatusTransition", reflect.TypeOf((*MockRepository)(nil).AppendStatusTransition), arg0, arg1)
+}
+
+// CreateAssessment mocks base method.
+func (m *MockRepository) CreateAssessment(arg0 context.Context, arg1 *domain.Assessment) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssessment", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAssessment indicates an expected call of CreateAssessment.
+func (mr *MockRepositoryMockRecorder) CreateAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssessment", reflect.TypeOf((*MockRepository)(nil).CreateAssessment), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockRepository) CreateSession(arg0 context.Context, arg1 *domain.Session) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockRepositoryMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRepository)(nil).CreateSession), arg0, arg1)
+}
+
+// GetAssessment mocks base method.
+func (m *MockRepository) GetAssessment(arg0 context.Context, arg1 string) (*domain.Assessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssessment", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Assessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssessment indicates an expected call of GetAssessment.
+func (mr *MockRepositoryMockRecorder) GetAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssessment", reflect.TypeOf((*MockRepository)(nil).GetAssessment), arg0, arg1)
+}
+
+// GetLink mocks base method.
+func (m *MockRepository) GetLink(arg0 context.Context, arg1 string) (*domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLink", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLink indicates an expected call of GetLink.
+func (mr *MockRepositoryMockRecorder) GetLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLink", reflect.TypeOf((*MockRepository)(nil).GetLink), arg0, arg1)
+}
+
+// GetLinkByToken mocks base method.
+func (m *MockRepository) GetLinkByToken(arg0 context.Context, arg1 string) (*domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinkByToken", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLinkByToken indicates an expected call of GetLinkByToken.
+func (mr *MockRepositoryMockRecorder) GetLinkByToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinkByToken", reflect.TypeOf((*MockRepository)(nil).GetLinkByToken), arg0, arg1)
+}
+
+// GetSessionByAssessment mocks base method.
+func (m *MockRepository) GetSessionByAssessment(arg0 context.Context, arg1 string) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByAssessment", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByAssessment indicates an expected call of GetSessionByAssessment.
+func (mr *MockRepositoryMockRecorder) GetSessionByAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByAssessment", reflect.TypeOf((*MockRepository)(nil).GetSessionByAssessment), arg0, arg1)
+}
+
+// HardDeleteAssessment mocks base method.
+func (m *MockRepository) HardDeleteAssessment(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDeleteAssessment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HardDeleteAssessment indicates an expected call of HardDeleteAssessment.
+func (mr *MockRepositoryMockRecorder) HardDeleteAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDeleteAssessment", reflect.TypeOf((*MockRepository)(nil).HardDeleteAssessment), arg0, arg1)
+}
+
+// ListAssessments mocks base method.
+func (m *MockRepository) ListAssessments(arg0 context.Context, arg1 *types.QuerySpec) (*types.Page[domain.Assessment], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssessments", arg0, arg1)
+	ret0, _ := ret[0].(*types.Page[domain.Assessment])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssessments indicates an expected call of ListAssessments.
+func (mr *MockRepositoryMockRecorder) ListAssessments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssessments", reflect.TypeOf((*MockRepository)(nil).ListAssessments), arg0, arg1)
+}
+
+// ListLinksByAssessment mocks base method.
+func (m *MockRepository) ListLinksByAssessment(arg0 context.Context, arg1 string) ([]domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLinksByAssessment", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLinksByAssessment indicates an expected call of ListLinksByAssessment.
+func (mr *MockRepositoryMockRecorder) ListLinksByAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLinksByAssessment", reflect.TypeOf((*MockRepository)(nil).ListLinksByAssessment), arg0, arg1)
+}
+
+// ListOverdueAssessments mocks base method.
+func (m *MockRepository) ListOverdueAssessments(arg0 context.Context, arg1 time.Time) ([]domain.Assessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdueAssessments", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Assessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdueAssessments indicates an expected call of ListOverdueAssessments.
+func (mr *MockRepositoryMockRecorder) ListOverdueAssessments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdueAssessments", reflect.TypeOf((*MockRepository)(nil).ListOverdueAssessments), arg0, arg1)
+}
+
+// ListPendingLinks mocks base method.
+func (m *MockRepository) ListPendingLinks(arg0 context.Context, arg1 time.Time) ([]domain.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingLinks", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingLinks indicates an expected call of ListPendingLinks.
+func (mr *MockRepositoryMockRecorder) ListPendingLinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingLinks", reflect.TypeOf((*MockRepository)(nil).ListPendingLinks), arg0, arg1)
+}
+
+// ListSeenProblemIDs mocks base method.
+func (m *MockRepository) ListSeenProblemIDs(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeenProblemIDs", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeenProblemIDs indicates an expected call of ListSeenProblemIDs.
+func (mr *MockRepositoryMockRecorder) ListSeenProblemIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeenProblemIDs", reflect.TypeOf((*MockRepository)(nil).ListSeenProblemIDs), arg0, arg1)
+}
+
+// ListStatusTransitions mocks base method.
+func (m *MockRepository) ListStatusTransitions(arg0 context.Context, arg1 string) ([]domain.StatusTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatusTransitions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.StatusTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatusTransitions indicates an expected call of ListStatusTransitions.
+func (mr *MockRepositoryMockRecorder) ListStatusTransitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatusTransitions", reflect.TypeOf((*MockRepository)(nil).ListStatusTransitions), arg0, arg1)
+}
+
+// PurgeDeletedAssessments mocks base method.
+func (m *MockRepository) PurgeDeletedAssessments(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedAssessments", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedAssessments indicates an expected call of PurgeDeletedAssessments.
+func (mr *MockRepositoryMockRecorder) PurgeDeletedAssessments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedAssessments", reflect.TypeOf((*MockRepository)(nil).PurgeDeletedAssessments), arg0, arg1)
+}
+
+// RestoreAssessment mocks base method.
+func (m *MockRepository) RestoreAssessment(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAssessment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAssessment indicates an expected call of RestoreAssessment.
+func (mr *MockRepositoryMockRecorder) RestoreAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAssessment", reflect.TypeOf((*MockRepository)(nil).RestoreAssessment), arg0, arg1)
+}
+
+// SoftDeleteAssessment mocks base method.
+func (m *MockRepository) SoftDeleteAssessment(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteAssessment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteAssessment indicates an expected call of SoftDeleteAssessment.
+func (mr *MockRepositoryMockRecorder) SoftDeleteAssessment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteAssessment", reflect.TypeOf((*MockRepository)(nil).SoftDeleteAssessment), arg0, arg1, arg2)
+}
+
+// StoreLink mocks base method.
+func (m *MockRepository) StoreLink(arg0 context.Context, arg1 *domain.Link) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreLink", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreLink indicates an expected call of StoreLink.
+func (mr *MockRepositoryMockRecorder) StoreLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreLink", reflect.TypeOf((*MockRepository)(nil).StoreLink), arg0, arg1)
+}
+
+// UpdateAssessment mocks base method.
+func (m *MockRepository) UpdateAssessment(arg0 context.Context, arg1 *domain.Assessment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssessment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAssessment indicates an expected call of UpdateAssessment.
+func (mr *MockRepositoryMockRecorder) UpdateAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssessment", reflect.TypeOf((*MockRepository)(nil).UpdateAssessment), arg0, arg1)
+}
+
+// UpdateAssessmentStatus mocks base method.
+func (m *MockRepository) UpdateAssessmentStatus(arg0 context.Context, arg1 *domain.Assessment, arg2 domain.AssessmentStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssessmentStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAssessmentStatus indicates an expected call of UpdateAssessmentStatus.
+func (mr *MockRepositoryMockRecorder) UpdateAssessmentStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssessmentStatus", reflect.TypeOf((*MockRepository)(nil).UpdateAssessmentStatus), arg0, arg1, arg2)
+}
+
+// UpdateLink mocks base method.
+func (m *MockRepository) UpdateLink(arg0 context.Context, arg1 *domain.Link) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLink", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLink indicates an expected call of UpdateLink.
+func (mr *MockRepositoryMockRecorder) UpdateLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLink", reflect.TypeOf((*MockRepository)(nil).UpdateLink), arg0, arg1)
+}
+
+// UpdateSession mocks base method.
+func (m *MockRepository) UpdateSession(arg0 context.Context, arg1 *domain.Session, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSession indicates an expected call of UpdateSession.
+func (mr *MockRepositoryMockRecorder) UpdateSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSession", reflect.TypeOf((*MockRepository)(nil).UpdateSession), arg0, arg1, arg2)
+}
+
+// MockBroker is a mock of Broker interface.
+type MockBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBrokerMockRecorder
+}
+
+// MockBrokerMockRecorder is the mock recorder for MockBroker.
+type MockBrokerMockRecorder struct {
+	mock *MockBroker
+}
+
+// NewMockBroker creates a new mock instance.
+func NewMockBroker(ctrl *gomock.Controller) *MockBroker {
+	mock := &MockBroker{ctrl: ctrl}
+	mock.recorder = &MockBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroker) EXPECT() *MockBrokerMockRecorder {
+	return m.recorder
+}
+
+// PublishSubmitted mocks base method.
+func (m *MockBroker) PublishSubmitted(arg0 context.Context, arg1 *domain.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishSubmitted", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishSubmitted indicates an expected call of PublishSubmitted.
+func (mr *MockBrokerMockRecorder) PublishSubmitted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishSubmitted", reflect.TypeOf((*MockBroker)(nil).PublishSubmitted), arg0, arg1)
+}
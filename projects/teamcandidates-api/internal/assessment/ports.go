@@ -2,6 +2,9 @@ package assessment
 
 import (
 	"context"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
 )
@@ -9,26 +12,89 @@ import (
 // UseCases define la interfaz pública con los métodos que expondremos
 type UseCases interface {
 	CreateAssessment(context.Context, *domain.Assessment) (string, error)
-	ListAssessments(context.Context) ([]domain.Assessment, error)
+	ListAssessments(context.Context, *types.QuerySpec) (*types.Page[domain.Assessment], error)
 	GetAssessment(context.Context, string) (*domain.Assessment, error)
-	DeleteAssessment(context.Context, string) error
+	DeleteAssessment(context.Context, string, bool) error
+	RestoreAssessment(context.Context, string) error
+	PurgeDeletedAssessments(context.Context, time.Time) (int64, error)
 	UpdateAssessment(context.Context, *domain.Assessment) error
+	// ListSeenProblems devuelve los problemas del banco que el candidato ya recibió
+	ListSeenProblems(context.Context, string) ([]string, error)
+
+	// INFO: Assessment Status
+	TransitionAssessment(context.Context, string, domain.AssessmentStatus, string) error
+	ListStatusHistory(context.Context, string) ([]domain.StatusTransition, error)
+	MarkGraded(context.Context, string) error
+	// ExpireOverdue entrega o vence las sesiones cuyo deadline pasó y devuelve cuántas cerró
+	ExpireOverdue(context.Context) (int, error)
+	// RunExpiry ejecuta ExpireOverdue periódicamente hasta que se cancele ctx
+	RunExpiry(context.Context)
 
 	// INFO: Assessment Link
 	GenerateLink(context.Context, string) (string, error)
 	SendLink(context.Context, string) error
 	GetLink(context.Context, string) (*domain.Link, error)
 	ValidateLink(context.Context, string) (*domain.Link, error)
+	// OpenLink valida el token y registra la primera apertura del link por el candidato
+	OpenLink(context.Context, string) (*domain.Link, error)
+	ListLinks(context.Context, string) ([]domain.Link, error)
+	// ResendLink genera y envía un link nuevo y revoca los anteriores de la evaluación
+	ResendLink(context.Context, string) (*domain.Link, error)
+	RevokeLink(context.Context, string, string) error
+	ExtendLink(context.Context, string, time.Time) (*domain.Link, error)
+	// SendDueReminders manda los recordatorios de los links por vencer y devuelve cuántos envió
+	SendDueReminders(context.Context) (int, error)
+	// RunReminders ejecuta SendDueReminders periódicamente hasta que se cancele ctx
+	RunReminders(context.Context)
+
+	// INFO: Assessment Session (autorizada por el token del link)
+	StartSession(context.Context, string) (*domain.Session, error)
+	GetSession(context.Context, string) (*domain.Session, error)
+	GetSessionProblem(context.Context, string) (*domain.Assessment, error)
+	AutosaveSession(context.Context, string, *domain.SessionDraft) (*domain.Session, error)
+	SubmitSession(context.Context, string, *domain.SessionDraft) (*domain.Session, error)
+	GetSubmission(context.Context, string) (*domain.Session, error)
 }
 
 type Repository interface {
 	CreateAssessment(context.Context, *domain.Assessment) (string, error)
 	UpdateAssessment(context.Context, *domain.Assessment) error
 	GetAssessment(context.Context, string) (*domain.Assessment, error)
-	DeleteAssessment(context.Context, string) error
-	ListAssessments(context.Context) ([]domain.Assessment, error)
+	SoftDeleteAssessment(context.Context, string, string) error
+	HardDeleteAssessment(context.Context, string) error
+	RestoreAssessment(context.Context, string) error
+	PurgeDeletedAssessments(context.Context, time.Time) (int64, error)
+	ListAssessments(context.Context, *types.QuerySpec) (*types.Page[domain.Assessment], error)
+	ListSeenProblemIDs(context.Context, string) ([]string, error)
+
+	// INFO: Assessment Status
+	// UpdateAssessmentStatus guarda el estado y las fechas de la transición solo si el estado sigue siendo from
+	UpdateAssessmentStatus(context.Context, *domain.Assessment, domain.AssessmentStatus) error
+	AppendStatusTransition(context.Context, *domain.StatusTransition) error
+	ListStatusTransitions(context.Context, string) ([]domain.StatusTransition, error)
+	ListOverdueAssessments(context.Context, time.Time) ([]domain.Assessment, error)
 
 	// INFO: Assessment Link
 	StoreLink(context.Context, *domain.Link) (string, error)
 	GetLink(context.Context, string) (*domain.Link, error)
+	GetLinkByToken(context.Context, string) (*domain.Link, error)
+	// UpdateLink guarda el ciclo de vida del link solo si no fue revocado
+	UpdateLink(context.Context, *domain.Link) error
+	ListLinksByAssessment(context.Context, string) ([]domain.Link, error)
+	// ListPendingLinks devuelve los links enviados, vigentes y sin iniciar (candidatos a recordatorio)
+	ListPendingLinks(context.Context, time.Time) ([]domain.Link, error)
+
+	// INFO: Assessment Session
+	CreateSession(context.Context, *domain.Session) (string, error)
+	GetSessionByAssessment(context.Context, string) (*domain.Session, error)
+	// UpdateSession guarda el borrador solo si la sesión sigue abierta y en la revisión indicada
+	UpdateSession(context.Context, *domain.Session, int64) error
+}
+
+// Broker publica los eventos de la evaluación que se procesan en segundo plano.
+type Broker interface {
+	PublishSubmitted(context.Context, *domain.Session) error
 }
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_assessment.go -package=mocks
@@ -3,24 +3,31 @@ package models
 import (
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
 )
 
 // Assessment representa la evaluación en la capa GORM.
 type Assessment struct {
-	ID          string     `gorm:"primaryKey"`
-	HRID        string     `gorm:"index"`                     // Foreign Key a HR
-	CandidateID string     `gorm:"index"`                     // Foreign Key a Candidate
-	StartDate   time.Time  `gorm:"not null"`                  // Fecha de inicio
-	EndDate     *time.Time `gorm:""`                          // Fecha de fin (nullable)
-	Status      string     `gorm:"type:varchar(50);not null"` // "pending", "in_progress", "completed"
+	ID           string     `gorm:"primaryKey"`
+	HRID         string     `gorm:"column:hr_id;index"`        // Foreign Key a HR
+	CandidateID  string     `gorm:"index"`                     // Foreign Key a Candidate
+	JobOpeningID string     `gorm:"index"`                     // Búsqueda (vacío si no se creó para una)
+	StartDate    time.Time  `gorm:"not null"`                  // Fecha de inicio
+	EndDate      *time.Time `gorm:""`                          // Fecha de fin (nullable)
+	Status       string     `gorm:"type:varchar(50);not null"` // Ver domain.AssessmentStatus
+	DeadlineAt   *time.Time `gorm:"index"`                     // Vencimiento de la sesión (nullable)
 	// Se asume que en la BD se almacena el valor en entero (minutos)
-	MaxDuration int64         `gorm:"not null"`                                            // Duración máxima en minutos
-	Skills      []SkillConfig `gorm:"foreignKey:AssessmentID;constraint:OnDelete:CASCADE"` // Configuraciones de skills requeridas
-	Problem     *Problem      `gorm:"foreignKey:AssessmentID;constraint:OnDelete:CASCADE"` // Problema asociado a la evaluación
-	UnitTests   []UnitTest    `gorm:"foreignKey:AssessmentID;constraint:OnDelete:CASCADE"` // Pruebas unitarias
-	CreatedAt   time.Time     `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time     `gorm:"autoUpdateTime"`
+	MaxDuration int64                        `gorm:"not null"`                                            // Duración máxima en minutos
+	Skills      []SkillConfig                `gorm:"foreignKey:AssessmentID;constraint:OnDelete:CASCADE"` // Configuraciones de skills requeridas
+	Problem     *Problem                     `gorm:"foreignKey:AssessmentID;constraint:OnDelete:CASCADE"` // Problema asociado a la evaluación
+	UnitTests   []UnitTest                   `gorm:"foreignKey:AssessmentID;constraint:OnDelete:CASCADE"` // Pruebas unitarias
+	History     []AssessmentStatusTransition `gorm:"foreignKey:AssessmentID;constraint:OnDelete:CASCADE"` // Historial de estados (no se precarga)
+	CreatedAt   time.Time                    `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time                    `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt               `gorm:"index"`
+	DeletedBy   string                       // Principal que hizo el soft delete
 }
 
 // SkillConfig representa la configuración de una skill requerida.
@@ -35,11 +42,15 @@ type SkillConfig struct {
 
 // Problem representa el enunciado del problema para la evaluación.
 type Problem struct {
-	ID           string    `gorm:"primaryKey"`
-	AssessmentID string    `gorm:"index;not null"`     // Foreign Key a Assessment
-	Description  string    `gorm:"type:text;not null"` // Descripción del problema
-	CreatedAt    time.Time `gorm:"autoCreateTime"`
-	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+	ID            string    `gorm:"primaryKey"`
+	AssessmentID  string    `gorm:"index;not null"`     // Foreign Key a Assessment
+	Description   string    `gorm:"type:text;not null"` // Descripción del problema
+	Language      string    `gorm:"type:varchar(50)"`   // Lenguaje del código inicial
+	StarterCode   string    `gorm:"type:text"`          // Código inicial
+	BankProblemID string    `gorm:"index"`              // Problema del banco del que se copió (vacío si se cargó a mano)
+	BankVersion   int       `gorm:"not null;default:0"` // Versión copiada del banco
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
 }
 
 // UnitTest representa una prueba unitaria asociada a la evaluación.
@@ -49,6 +60,7 @@ type UnitTest struct {
 	TestName       string    `gorm:"type:varchar(100);not null"` // Nombre del test
 	InputData      string    `gorm:"type:text;not null"`         // Datos de entrada
 	ExpectedOutput string    `gorm:"type:text;not null"`         // Salida esperada
+	Hidden         bool      `gorm:"not null;default:false"`     // Oculto al candidato
 	CreatedAt      time.Time `gorm:"autoCreateTime"`
 	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
 }
@@ -56,10 +68,11 @@ type UnitTest struct {
 // ToDomain convierte este objeto GORM (DTO) a su equivalente en la capa de dominio.
 func (dto Assessment) ToDomain() *domain.Assessment {
 	return &domain.Assessment{
-		ID:          dto.ID,
-		HRID:        dto.HRID,
-		CandidateID: dto.CandidateID,
-		StartDate:   dto.StartDate,
+		ID:           dto.ID,
+		HRID:         dto.HRID,
+		CandidateID:  dto.CandidateID,
+		JobOpeningID: dto.JobOpeningID,
+		StartDate:    dto.StartDate,
 		EndDate: func() time.Time {
 			if dto.EndDate != nil {
 				return *dto.EndDate
@@ -67,6 +80,12 @@ func (dto Assessment) ToDomain() *domain.Assessment {
 			return time.Time{}
 		}(),
 		Status: domain.AssessmentStatus(dto.Status),
+		DeadlineAt: func() time.Time {
+			if dto.DeadlineAt != nil {
+				return *dto.DeadlineAt
+			}
+			return time.Time{}
+		}(),
 		// Convertimos el valor almacenado (en minutos) a una duración:
 		MaxDuration: time.Duration(dto.MaxDuration) * time.Minute,
 		Skills:      SkillConfigToDomain(dto.Skills),
@@ -78,6 +97,13 @@ func (dto Assessment) ToDomain() *domain.Assessment {
 			return *p
 		}(),
 		UnitTests: UnitTestToDomain(dto.UnitTests),
+		DeletedAt: func() *time.Time {
+			if dto.DeletedAt.Valid {
+				return &dto.DeletedAt.Time
+			}
+			return nil
+		}(),
+		DeletedBy: dto.DeletedBy,
 	}
 }
 
@@ -87,14 +113,20 @@ func FromDomainAssessment(assessment *domain.Assessment) *Assessment {
 	if !assessment.EndDate.IsZero() {
 		endDatePtr = &assessment.EndDate
 	}
+	var deadlineAtPtr *time.Time
+	if !assessment.DeadlineAt.IsZero() {
+		deadlineAtPtr = &assessment.DeadlineAt
+	}
 
 	return &Assessment{
-		ID:          assessment.ID,
-		HRID:        assessment.HRID,
-		CandidateID: assessment.CandidateID,
-		StartDate:   assessment.StartDate,
-		EndDate:     endDatePtr,
-		Status:      string(assessment.Status),
+		ID:           assessment.ID,
+		HRID:         assessment.HRID,
+		CandidateID:  assessment.CandidateID,
+		JobOpeningID: assessment.JobOpeningID,
+		StartDate:    assessment.StartDate,
+		EndDate:      endDatePtr,
+		Status:       string(assessment.Status),
+		DeadlineAt:   deadlineAtPtr,
 		// Convertimos la duración (en minutos) a un entero:
 		MaxDuration: int64(assessment.MaxDuration.Minutes()),
 		Skills:      SkillConfigFromDomainAssessment(assessment.Skills),
@@ -141,9 +173,13 @@ func ProblemToDomain(g *Problem) *domain.Problem {
 		return nil
 	}
 	return &domain.Problem{
-		ID:           g.ID,
-		AssessmentID: g.AssessmentID,
-		Description:  g.Description,
+		ID:            g.ID,
+		AssessmentID:  g.AssessmentID,
+		Description:   g.Description,
+		Language:      g.Language,
+		StarterCode:   g.StarterCode,
+		BankProblemID: g.BankProblemID,
+		BankVersion:   g.BankVersion,
 	}
 }
 
@@ -153,9 +189,13 @@ func ProblemFromDomainAssessment(d *domain.Problem) *Problem {
 		return nil
 	}
 	return &Problem{
-		ID:           d.ID,
-		AssessmentID: d.AssessmentID,
-		Description:  d.Description,
+		ID:            d.ID,
+		AssessmentID:  d.AssessmentID,
+		Description:   d.Description,
+		Language:      d.Language,
+		StarterCode:   d.StarterCode,
+		BankProblemID: d.BankProblemID,
+		BankVersion:   d.BankVersion,
 	}
 }
 
@@ -169,6 +209,7 @@ func UnitTestToDomain(gu []UnitTest) []domain.UnitTest {
 			TestName:       g.TestName,
 			InputData:      g.InputData,
 			ExpectedOutput: g.ExpectedOutput,
+			Hidden:         g.Hidden,
 		})
 	}
 	return du
@@ -184,6 +225,7 @@ func UnitTestFromDomainAssessment(du []domain.UnitTest) []UnitTest {
 			TestName:       d.TestName,
 			InputData:      d.InputData,
 			ExpectedOutput: d.ExpectedOutput,
+			Hidden:         d.Hidden,
 		})
 	}
 	return gu
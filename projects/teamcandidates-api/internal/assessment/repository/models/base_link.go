@@ -8,22 +8,38 @@ import (
 
 // Link es un enlace único para acceder a una evaluación.
 type Link struct {
-	ID           string    `gorm:"primaryKey"`                             // Clave primaria
-	AssessmentID string    `gorm:"index;not null"`                         // Clave foránea hacia Assessment
-	Token        string    `gorm:"type:varchar(255);not null;uniqueIndex"` // Token único para el enlace
-	ExpiresAt    time.Time `gorm:"not null"`                               // Fecha de expiración del enlace
-	URL          string    `gorm:"type:text;not null"`                     // URL para acceder a la evaluación
-	CreatedAt    time.Time `gorm:"autoCreateTime"`                         // Fecha de creación
-	UpdatedAt    time.Time `gorm:"autoUpdateTime"`                         // Fecha de última actualización
+	ID             string     `gorm:"primaryKey"`                             // Clave primaria
+	AssessmentID   string     `gorm:"index;not null"`                         // Clave foránea hacia Assessment
+	Token          string     `gorm:"type:varchar(255);not null;uniqueIndex"` // Token único para el enlace
+	ExpiresAt      time.Time  `gorm:"not null"`                               // Fecha de expiración del enlace
+	URL            string     `gorm:"type:text;not null"`                     // URL para acceder a la evaluación
+	SentAt         *time.Time `gorm:""`                                       // Último envío por email (nullable)
+	OpenedAt       *time.Time `gorm:""`                                       // Primera apertura (nullable)
+	StartedAt      *time.Time `gorm:""`                                       // Inicio de la evaluación (nullable)
+	RevokedAt      *time.Time `gorm:"index"`                                  // Revocación (nullable)
+	RevokeReason   string     `gorm:"type:text"`                              // Motivo de la revocación
+	ReplacedBy     string     `gorm:"type:varchar(255)"`                      // Link que lo reemplazó
+	RemindersSent  int        `gorm:"not null;default:0"`                     // Recordatorios ya cubiertos
+	LastReminderAt *time.Time `gorm:""`                                       // Último recordatorio (nullable)
+	CreatedAt      time.Time  `gorm:"autoCreateTime"`                         // Fecha de creación
+	UpdatedAt      time.Time  `gorm:"autoUpdateTime"`                         // Fecha de última actualización
 }
 
 func FromDomainToLink(domainLink *domain.Link) *Link {
 	return &Link{
-		ID:           domainLink.ID,
-		AssessmentID: domainLink.AssessmentID,
-		Token:        domainLink.Token,
-		ExpiresAt:    domainLink.ExpiresAt,
-		URL:          domainLink.URL,
+		ID:             domainLink.ID,
+		AssessmentID:   domainLink.AssessmentID,
+		Token:          domainLink.Token,
+		ExpiresAt:      domainLink.ExpiresAt,
+		URL:            domainLink.URL,
+		SentAt:         domainLink.SentAt,
+		OpenedAt:       domainLink.OpenedAt,
+		StartedAt:      domainLink.StartedAt,
+		RevokedAt:      domainLink.RevokedAt,
+		RevokeReason:   domainLink.RevokeReason,
+		ReplacedBy:     domainLink.ReplacedBy,
+		RemindersSent:  domainLink.RemindersSent,
+		LastReminderAt: domainLink.LastReminderAt,
 	}
 }
 
@@ -31,10 +47,19 @@ func FromDomainToLink(domainLink *domain.Link) *Link {
 // Este es un método del modelo.
 func (l Link) ToDomain() *domain.Link {
 	return &domain.Link{
-		ID:           l.ID,
-		AssessmentID: l.AssessmentID,
-		Token:        l.Token,
-		ExpiresAt:    l.ExpiresAt,
-		URL:          l.URL,
+		ID:             l.ID,
+		AssessmentID:   l.AssessmentID,
+		Token:          l.Token,
+		ExpiresAt:      l.ExpiresAt,
+		URL:            l.URL,
+		CreatedAt:      l.CreatedAt,
+		SentAt:         l.SentAt,
+		OpenedAt:       l.OpenedAt,
+		StartedAt:      l.StartedAt,
+		RevokedAt:      l.RevokedAt,
+		RevokeReason:   l.RevokeReason,
+		ReplacedBy:     l.ReplacedBy,
+		RemindersSent:  l.RemindersSent,
+		LastReminderAt: l.LastReminderAt,
 	}
 }
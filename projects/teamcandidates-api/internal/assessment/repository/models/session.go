@@ -0,0 +1,86 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// AssessmentSession guarda el progreso del candidato en una evaluación.
+type AssessmentSession struct {
+	ID           string     `gorm:"primaryKey"`
+	AssessmentID string     `gorm:"not null;uniqueIndex"` // Una sola sesión por assessment
+	LinkID       string     `gorm:"index;not null"`       // Link con el que se inició
+	Language     string     `gorm:"type:varchar(50)"`     // Lenguaje elegido
+	Code         string     `gorm:"type:text"`            // Último código guardado
+	Answers      string     `gorm:"type:text"`            // Respuestas en JSON
+	Revision     int64      `gorm:"not null;default:0"`   // Versión del borrador
+	StartedAt    time.Time  `gorm:"not null"`             // Inicio de la sesión
+	LastSavedAt  *time.Time `gorm:""`                     // Último autosave (nullable)
+	SubmittedAt  *time.Time `gorm:""`                     // Entrega final (nullable)
+	CreatedAt    time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time  `gorm:"autoUpdateTime"`
+}
+
+// FromDomainSession convierte la sesión de dominio al modelo GORM.
+func FromDomainSession(s *domain.Session) *AssessmentSession {
+	var lastSavedAt *time.Time
+	if !s.LastSavedAt.IsZero() {
+		lastSavedAt = &s.LastSavedAt
+	}
+
+	return &AssessmentSession{
+		ID:           s.ID,
+		AssessmentID: s.AssessmentID,
+		LinkID:       s.LinkID,
+		Language:     s.Language,
+		Code:         s.Code,
+		Answers:      encodeAnswers(s.Answers),
+		Revision:     s.Revision,
+		StartedAt:    s.StartedAt,
+		LastSavedAt:  lastSavedAt,
+		SubmittedAt:  s.SubmittedAt,
+	}
+}
+
+// ToDomain convierte el modelo en la sesión de dominio. Deadline lo completa el use case.
+func (m AssessmentSession) ToDomain() *domain.Session {
+	session := &domain.Session{
+		ID:           m.ID,
+		AssessmentID: m.AssessmentID,
+		LinkID:       m.LinkID,
+		Language:     m.Language,
+		Code:         m.Code,
+		Answers:      decodeAnswers(m.Answers),
+		Revision:     m.Revision,
+		StartedAt:    m.StartedAt,
+		SubmittedAt:  m.SubmittedAt,
+	}
+	if m.LastSavedAt != nil {
+		session.LastSavedAt = *m.LastSavedAt
+	}
+	return session
+}
+
+func encodeAnswers(answers map[string]string) string {
+	if len(answers) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(answers)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func decodeAnswers(data string) map[string]string {
+	if data == "" {
+		return nil
+	}
+	answers := make(map[string]string)
+	if err := json.Unmarshal([]byte(data), &answers); err != nil {
+		return nil
+	}
+	return answers
+}
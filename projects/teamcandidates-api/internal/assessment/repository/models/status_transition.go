@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// AssessmentStatusTransition es una entrada del historial de estados; la tabla es append-only.
+type AssessmentStatusTransition struct {
+	ID           string    `gorm:"primaryKey"`
+	AssessmentID string    `gorm:"index;not null"`            // Foreign Key a Assessment
+	FromStatus   string    `gorm:"type:varchar(50)"`          // Vacío en la creación
+	ToStatus     string    `gorm:"type:varchar(50);not null"` // Estado nuevo
+	Reason       string    `gorm:"type:text"`                 // Motivo de la transición
+	Actor        string    `gorm:"type:varchar(100)"`         // Principal, "candidate" o "system"
+	OccurredAt   time.Time `gorm:"not null"`
+}
+
+func FromDomainStatusTransition(t *domain.StatusTransition) *AssessmentStatusTransition {
+	return &AssessmentStatusTransition{
+		ID:           t.ID,
+		AssessmentID: t.AssessmentID,
+		FromStatus:   string(t.From),
+		ToStatus:     string(t.To),
+		Reason:       t.Reason,
+		Actor:        t.Actor,
+		OccurredAt:   t.OccurredAt,
+	}
+}
+
+func (m AssessmentStatusTransition) ToDomain() domain.StatusTransition {
+	return domain.StatusTransition{
+		ID:           m.ID,
+		AssessmentID: m.AssessmentID,
+		From:         domain.AssessmentStatus(m.FromStatus),
+		To:           domain.AssessmentStatus(m.ToStatus),
+		Reason:       m.Reason,
+		Actor:        m.Actor,
+		OccurredAt:   m.OccurredAt,
+	}
+}
@@ -1,6 +1,9 @@
 package assessment
 
 import (
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 	authe "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe"
 	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
 	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
@@ -11,28 +14,37 @@ import (
 // useCases implementa la interfaz UseCases
 type useCases struct {
 	repository     Repository
+	txManager      pkgtx.Manager
 	config         config.Loader
 	autheUc        authe.UseCases
 	candidateUc    candidate.UseCases
 	personUc       person.UseCases
 	notificationUc notification.UseCases
+	auditUc        audit.UseCases
+	broker         Broker
 }
 
 // NewUseCases crea una instancia de useCases con las dependencias adecuadas
 func NewUseCases(
 	repo Repository,
+	tx pkgtx.Manager,
 	notif notification.UseCases,
 	candidate candidate.UseCases,
 	cfg config.Loader,
 	au authe.UseCases,
 	pn person.UseCases,
+	ad audit.UseCases,
+	br Broker,
 ) UseCases {
 	return &useCases{
 		repository:     repo,
+		txManager:      tx,
 		notificationUc: notif,
 		candidateUc:    candidate,
 		config:         cfg,
 		autheUc:        au,
 		personUc:       pn,
+		auditUc:        ad,
+		broker:         br,
 	}
 }
@@ -2,28 +2,22 @@ package domain
 
 import "time"
 
-// AssessmentStatus representa valores de estado posibles para una evaluación.
-type AssessmentStatus string
-
-// Constantes de AssessmentStatus.
-const (
-	StatusPending    AssessmentStatus = "pending"     // Pendiente
-	StatusInProgress AssessmentStatus = "in_progress" // En progreso
-	StatusCompleted  AssessmentStatus = "completed"   // Completado
-)
-
 // Assessment representa una entidad de evaluación.
 type Assessment struct {
-	ID          string           // Clave primaria
-	HRID        string           // Clave foránea hacia HR
-	CandidateID string           // Clave foránea hacia Candidate
-	StartDate   time.Time        // Fecha de inicio de la evaluación
-	EndDate     time.Time        // Fecha de finalización de la evaluación
-	Status      AssessmentStatus // Estado: "pending", "in_progress", "completed"
-	MaxDuration time.Duration    // Duración máxima (en minutos)
-	Skills      []SkillConfig    // Lista de configuraciones de habilidades requeridas
-	Problem     Problem          // Enunciado del problema para la evaluación
-	UnitTests   []UnitTest       // Lista de pruebas unitarias
+	ID           string           // Clave primaria
+	HRID         string           // Clave foránea hacia HR
+	CandidateID  string           // Clave foránea hacia Candidate
+	JobOpeningID string           // Búsqueda en la que se toma; vacío si no se creó para una búsqueda
+	StartDate    time.Time        // Fecha de inicio de la evaluación
+	EndDate      time.Time        // Fecha de finalización de la evaluación
+	Status       AssessmentStatus // Estado; solo cambia con transiciones válidas (ver CanTransition)
+	MaxDuration  time.Duration    // Duración máxima (en minutos)
+	DeadlineAt   time.Time        // Vencimiento fijado al iniciar la sesión (StartDate + MaxDuration)
+	Skills       []SkillConfig    // Lista de configuraciones de habilidades requeridas
+	Problem      Problem          // Enunciado del problema para la evaluación
+	UnitTests    []UnitTest       // Lista de pruebas unitarias
+	DeletedAt    *time.Time       // Momento del soft delete
+	DeletedBy    string           // Principal que la eliminó
 }
 
 // SkillConfig representa la configuración de una habilidad requerida en la evaluación.
@@ -34,11 +28,16 @@ type SkillConfig struct {
 	SkillLevel   string // Nivel de dominio (p. ej., principiante, intermedio, experto)
 }
 
-// Problem almacena el enunciado del problema generado para la evaluación.
+// Problem almacena el enunciado del problema generado para la evaluación. Si se armó desde el
+// banco de problemas guarda una copia de la versión usada, así editar el banco no la cambia.
 type Problem struct {
-	ID           string // Clave primaria
-	AssessmentID string // Clave foránea hacia Assessment
-	Description  string // Descripción detallada del problema
+	ID            string // Clave primaria
+	AssessmentID  string // Clave foránea hacia Assessment
+	Description   string // Descripción detallada del problema
+	Language      string // Lenguaje del código inicial (opcional)
+	StarterCode   string // Código inicial que ve el candidato (opcional)
+	BankProblemID string // Problema del banco del que se copió; vacío si se cargó a mano
+	BankVersion   int    // Versión del problema del banco que se copió
 }
 
 // UnitTest representa una prueba unitaria para la evaluación.
@@ -48,4 +47,29 @@ type UnitTest struct {
 	TestName       string // Nombre de la prueba
 	InputData      string // Datos de entrada para la prueba
 	ExpectedOutput string // Salida esperada para la prueba
+	Hidden         bool   // Si es true no se muestra al candidato; solo se usa para la corrección
+}
+
+// Deadline devuelve el instante en que vence el tiempo del candidato, o el zero time si la
+// evaluación no empezó o no tiene duración máxima. Una vez iniciada manda el DeadlineAt guardado,
+// así cambiar MaxDuration no mueve el vencimiento de una sesión en curso.
+func (a *Assessment) Deadline() time.Time {
+	if !a.DeadlineAt.IsZero() {
+		return a.DeadlineAt
+	}
+	if a.StartDate.IsZero() || a.MaxDuration <= 0 {
+		return time.Time{}
+	}
+	return a.StartDate.Add(a.MaxDuration)
+}
+
+// VisibleUnitTests devuelve las pruebas que se pueden mostrar al candidato.
+func (a *Assessment) VisibleUnitTests() []UnitTest {
+	visible := make([]UnitTest, 0, len(a.UnitTests))
+	for _, t := range a.UnitTests {
+		if !t.Hidden {
+			visible = append(visible, t)
+		}
+	}
+	return visible
 }
@@ -2,6 +2,20 @@ package domain
 
 import "time"
 
+// LinkStatus es el estado del link calculado a partir de sus fechas.
+type LinkStatus string
+
+const (
+	LinkActive  LinkStatus = "active"
+	LinkExpired LinkStatus = "expired"
+	LinkRevoked LinkStatus = "revoked"
+)
+
+// Motivos de revocación que fija el sistema
+const (
+	RevokeReasonReplaced = "replaced by a new link"
+)
+
 // Link es un enlace único para acceder a una evaluación.
 type Link struct {
 	ID           string    // Clave primaria
@@ -9,4 +23,44 @@ type Link struct {
 	Token        string    // Token único para el enlace
 	ExpiresAt    time.Time // Fecha de expiración del enlace
 	URL          string    // URL para acceder a la evaluación
+	CreatedAt    time.Time
+
+	// Ciclo de vida del link
+	SentAt         *time.Time // Último envío por email
+	OpenedAt       *time.Time // Primera vez que el candidato abrió el link
+	StartedAt      *time.Time // Inicio de la evaluación con este link
+	RevokedAt      *time.Time // Si tiene valor el token ya no es válido
+	RevokeReason   string
+	ReplacedBy     string     // Link que reemplazó a este al reenviar la invitación
+	RemindersSent  int        // Cantidad de recordatorios de ReminderOffsets ya cubiertos
+	LastReminderAt *time.Time // Último recordatorio enviado
+}
+
+// Status devuelve el estado del link en now; la revocación tiene prioridad sobre el vencimiento.
+func (l *Link) Status(now time.Time) LinkStatus {
+	switch {
+	case l.RevokedAt != nil:
+		return LinkRevoked
+	case now.After(l.ExpiresAt):
+		return LinkExpired
+	default:
+		return LinkActive
+	}
+}
+
+func (l *Link) IsRevoked() bool {
+	return l.RevokedAt != nil
+}
+
+// DueReminders devuelve cuántos de los offsets (ordenados de mayor a menor) ya se cumplieron en
+// now. Si es mayor a RemindersSent corresponde mandar un recordatorio.
+func (l *Link) DueReminders(offsets []time.Duration, now time.Time) int {
+	due := 0
+	for _, offset := range offsets {
+		if now.Before(l.ExpiresAt.Add(-offset)) {
+			break
+		}
+		due++
+	}
+	return due
 }
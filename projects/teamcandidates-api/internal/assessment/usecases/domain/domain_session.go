@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// Session guarda el progreso del candidato mientras resuelve una evaluación.
+// Hay una sola sesión por evaluación: reconectarse con el mismo link retoma la existente.
+type Session struct {
+	ID           string            // Clave primaria
+	AssessmentID string            // Clave foránea hacia Assessment
+	LinkID       string            // Link con el que se inició la sesión
+	Language     string            // Lenguaje elegido por el candidato
+	Code         string            // Último código guardado
+	Answers      map[string]string // Respuestas libres, por ID de pregunta
+	Revision     int64             // Versión del borrador; cada autosave debe enviar una mayor
+	StartedAt    time.Time         // Inicio de la sesión
+	LastSavedAt  time.Time         // Último autosave
+	SubmittedAt  *time.Time        // Entrega final; nil mientras la sesión está abierta
+	Deadline     time.Time         // Vencimiento calculado a partir del assessment (no se persiste)
+}
+
+// SessionDraft es el contenido que el candidato envía en cada autosave y en la entrega final.
+type SessionDraft struct {
+	Language string
+	Code     string
+	Answers  map[string]string
+	Revision int64
+}
+
+// IsSubmitted indica si la sesión ya fue entregada.
+func (s *Session) IsSubmitted() bool {
+	return s.SubmittedAt != nil
+}
+
+// IsExpired indica si el tiempo de la evaluación venció en now.
+func (s *Session) IsExpired(now time.Time) bool {
+	return !s.Deadline.IsZero() && now.After(s.Deadline)
+}
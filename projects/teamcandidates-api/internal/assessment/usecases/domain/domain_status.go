@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// AssessmentStatus representa valores de estado posibles para una evaluación.
+type AssessmentStatus string
+
+// Constantes de AssessmentStatus.
+const (
+	StatusPending    AssessmentStatus = "pending"     // Creada, todavía sin link para el candidato
+	StatusSent       AssessmentStatus = "sent"        // Link generado o enviado al candidato
+	StatusInProgress AssessmentStatus = "in_progress" // El candidato inició la sesión
+	StatusSubmitted  AssessmentStatus = "submitted"   // Entregada (por el candidato o al vencer el tiempo)
+	StatusGraded     AssessmentStatus = "graded"      // Corregida
+	StatusExpired    AssessmentStatus = "expired"     // Venció sin una entrega
+	StatusCancelled  AssessmentStatus = "cancelled"   // Cancelada por RR. HH.
+)
+
+// transitions es la máquina de estados: para cada estado, a cuáles se puede pasar.
+// graded, expired y cancelled son finales.
+var transitions = map[AssessmentStatus][]AssessmentStatus{
+	StatusPending:    {StatusSent, StatusCancelled},
+	StatusSent:       {StatusInProgress, StatusExpired, StatusCancelled},
+	StatusInProgress: {StatusSubmitted, StatusExpired, StatusCancelled},
+	StatusSubmitted:  {StatusGraded},
+}
+
+// manualTransitions son los estados que RR. HH. puede fijar a mano; el resto los fija el flujo
+// del candidato, el scheduler de vencimientos o la corrección.
+var manualTransitions = []AssessmentStatus{StatusSent, StatusExpired, StatusCancelled}
+
+// IsValid indica si el estado es uno de los definidos.
+func (s AssessmentStatus) IsValid() bool {
+	switch s {
+	case StatusPending, StatusSent, StatusInProgress, StatusSubmitted, StatusGraded, StatusExpired, StatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsFinal indica si la evaluación ya no puede cambiar de estado.
+func (s AssessmentStatus) IsFinal() bool {
+	return s.IsValid() && len(transitions[s]) == 0
+}
+
+// CanTransition indica si la máquina de estados permite pasar de from a to.
+func CanTransition(from, to AssessmentStatus) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// IsManualTransition indica si RR. HH. puede pedir el paso a to desde la API.
+func IsManualTransition(to AssessmentStatus) bool {
+	return slices.Contains(manualTransitions, to)
+}
+
+// StatusTransition es una entrada del historial de estados de una evaluación.
+type StatusTransition struct {
+	ID           string
+	AssessmentID string
+	From         AssessmentStatus // Vacío en la creación
+	To           AssessmentStatus
+	Reason       string // Motivo (p. ej., "link sent", "deadline reached")
+	Actor        string // Principal que la pidió, "candidate" o "system"
+	OccurredAt   time.Time
+}
@@ -3,22 +3,58 @@ package assessment
 import (
 	"context"
 	"fmt"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+// Tipos de recurso con los que se registran los cambios en el log de auditoría
+const (
+	auditResourceAssessment = "assessment"
+	auditResourceLink       = "assessment_link"
+	auditResourceSession    = "assessment_session"
 )
 
-// CreateAssessment crea un nuevo assessment y lo guarda
+// CreateAssessment crea un nuevo assessment y lo guarda. Siempre arranca en StatusPending;
+// cualquier otro estado informado por el cliente se rechaza.
 func (u *useCases) CreateAssessment(ctx context.Context, assessment *domain.Assessment) (string, error) {
-	assessmentID, err := u.repository.CreateAssessment(ctx, assessment)
+	if assessment.Status != "" && assessment.Status != domain.StatusPending {
+		return "", types.NewError(types.ErrValidation, "assessment must be created in pending status, use the status endpoint", nil)
+	}
+	assessment.Status = domain.StatusPending
+	assessment.DeadlineAt = time.Time{}
+
+	// El assessment y sus skills, problema y unit tests se guardan en una única transacción
+	// junto con la primera entrada del historial de estados
+	var assessmentID string
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		assessmentID, err = u.repository.CreateAssessment(ctx, assessment)
+		if err != nil {
+			return err
+		}
+		return u.repository.AppendStatusTransition(ctx, &domain.StatusTransition{
+			AssessmentID: assessmentID,
+			To:           domain.StatusPending,
+			Reason:       "assessment created",
+			Actor:        actorFrom(ctx, actorSystem),
+			OccurredAt:   time.Now(),
+		})
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to create assessment: %w", err)
 	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionCreate, auditResourceAssessment, assessmentID, nil, assessment)
 	return assessmentID, nil
 }
 
 // ListAssessments obtiene la lista de todas las evaluaciones
-func (u *useCases) ListAssessments(ctx context.Context) ([]domain.Assessment, error) {
-	return u.repository.ListAssessments(ctx)
+func (u *useCases) ListAssessments(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Assessment], error) {
+	return u.repository.ListAssessments(ctx, spec)
 }
 
 // GetAssessment obtiene una evaluación por su ID
@@ -26,12 +62,76 @@ func (u *useCases) GetAssessment(ctx context.Context, assessmentID string) (*dom
 	return u.repository.GetAssessment(ctx, assessmentID)
 }
 
+// ListSeenProblems devuelve los problemas del banco usados en las evaluaciones del candidato.
+func (u *useCases) ListSeenProblems(ctx context.Context, candidateID string) ([]string, error) {
+	return u.repository.ListSeenProblemIDs(ctx, candidateID)
+}
+
 // DeleteAssessment elimina una evaluación
-func (u *useCases) DeleteAssessment(ctx context.Context, ID string) error {
-	return u.repository.DeleteAssessment(ctx, ID)
+func (u *useCases) DeleteAssessment(ctx context.Context, ID string, hardDelete bool) error {
+	before, _ := u.repository.GetAssessment(ctx, ID)
+
+	var err error
+	if hardDelete {
+		err = u.repository.HardDeleteAssessment(ctx, ID)
+	} else {
+		err = u.repository.SoftDeleteAssessment(ctx, ID, types.PrincipalIDFromContext(ctx))
+	}
+	if err != nil {
+		return err
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionDelete, auditResourceAssessment, ID, before, nil)
+	return nil
 }
 
-// UpdateAssessment actualiza una evaluación existente
+// RestoreAssessment deshace el soft delete de una evaluación
+func (u *useCases) RestoreAssessment(ctx context.Context, ID string) error {
+	if err := u.repository.RestoreAssessment(ctx, ID); err != nil {
+		return err
+	}
+
+	after, _ := u.repository.GetAssessment(ctx, ID)
+	u.auditUc.RecordChange(ctx, auditdom.ActionRestore, auditResourceAssessment, ID, nil, after)
+	return nil
+}
+
+// PurgeDeletedAssessments elimina definitivamente las evaluaciones borradas antes de before
+func (u *useCases) PurgeDeletedAssessments(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := u.repository.PurgeDeletedAssessments(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+
+	if purged > 0 {
+		u.auditUc.RecordChange(ctx, auditdom.ActionPurge, auditResourceAssessment, "", nil, map[string]any{"before": before, "purged": purged})
+	}
+	return purged, nil
+}
+
+// UpdateAssessment actualiza una evaluación existente. El estado no se cambia por acá, solo con
+// TransitionAssessment.
 func (u *useCases) UpdateAssessment(ctx context.Context, updateAssessment *domain.Assessment) error {
-	return u.repository.UpdateAssessment(ctx, updateAssessment)
+	var before, after *domain.Assessment
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		before, err = u.repository.GetAssessment(ctx, updateAssessment.ID)
+		if err != nil {
+			return err
+		}
+		if updateAssessment.Status != "" && updateAssessment.Status != before.Status {
+			return types.NewError(types.ErrValidation, "assessment status cannot be updated, use the status endpoint", nil)
+		}
+		if err := u.repository.UpdateAssessment(ctx, updateAssessment); err != nil {
+			return err
+		}
+		after, _ = u.repository.GetAssessment(ctx, updateAssessment.ID)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourceAssessment, updateAssessment.ID, before, after)
+	return nil
 }
@@ -2,47 +2,80 @@ package assessment
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"time"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
 )
 
+// reminderDateLayout es el formato de la fecha de vencimiento en el email de recordatorio.
+const reminderDateLayout = "2006-01-02 15:04 MST"
+
 func (u *useCases) GenerateLink(ctx context.Context, assessmentID string) (string, error) {
 	assessment, err := u.repository.GetAssessment(ctx, assessmentID)
 	if err != nil {
 		return "", fmt.Errorf("failed to get assessment by ID %s: %w", assessmentID, err)
 	}
+	if err := checkLinkable(assessment); err != nil {
+		return "", err
+	}
 
-	candidate, err := u.candidateUc.GetCandidate(ctx, assessment.CandidateID)
-	if err != nil {
+	// El link solo se emite para un candidato existente, que es quien recibe el email
+	if _, err := u.candidateUc.GetCandidate(ctx, assessment.CandidateID); err != nil {
 		return "", fmt.Errorf("failed to get candidate: %w", err)
 	}
 
 	assessmentCfg := u.config.GetAssessmentConfig()
-	token, err := u.autheUc.GenerateLinkTokens(ctx, candidate.ID)
+	token, err := u.autheUc.GenerateLinkTokens(ctx, assessmentID)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate token: %w", err)
 	}
 
 	linkURL := fmt.Sprintf("%s?token=%s", assessmentCfg.BaseURL, token.AccessToken)
 
+	now := time.Now()
 	link := &domain.Link{
 		AssessmentID: assessmentID,
 		Token:        token.AccessToken,
-		ExpiresAt:    time.Now().Add(assessmentCfg.AccessExpirationMinutes),
+		ExpiresAt:    now.Add(assessmentCfg.AccessExpirationMinutes),
 		URL:          linkURL,
 	}
+	// Los recordatorios cuya anticipación ya pasó al crear el link no se mandan
+	link.RemindersSent = link.DueReminders(assessmentCfg.ReminderOffsets, now)
 
 	linkID, err := u.repository.StoreLink(ctx, link)
 	if err != nil {
 		return "", fmt.Errorf("failed to store assessment link: %w", err)
 	}
 
+	// La URL contiene el token, por eso no se guarda el link completo en el log
+	u.auditUc.RecordChange(ctx, auditdom.ActionCreate, auditResourceLink, linkID, nil, map[string]any{
+		"assessment_id": assessmentID,
+		"expires_at":    link.ExpiresAt,
+	})
+
+	if assessment.Status == domain.StatusPending {
+		if err := u.transitionTx(ctx, assessment, domain.StatusSent, "link generated", actorFrom(ctx, actorSystem)); err != nil {
+			return "", fmt.Errorf("failed to mark assessment as sent: %w", err)
+		}
+	}
 	return linkID, nil
 }
 
 func (u *useCases) SendLink(ctx context.Context, linkID string) error {
+	link, err := u.repository.GetLink(ctx, linkID)
+	if err != nil {
+		return err
+	}
+	if status := link.Status(time.Now()); status != domain.LinkActive {
+		return types.NewError(types.ErrConflict, fmt.Sprintf("cannot send a %s assessment link", status), nil)
+	}
+
 	address, subject, body, err := u.buildEmail(ctx, linkID)
 	if err != nil {
 		return fmt.Errorf("failed to get candidate personal info: %w", err)
@@ -52,6 +85,11 @@ func (u *useCases) SendLink(ctx context.Context, linkID string) error {
 		return fmt.Errorf("failed to send assessment link: %w", err)
 	}
 
+	now := time.Now()
+	link.SentAt = &now
+	if err := u.repository.UpdateLink(ctx, link); err != nil {
+		return fmt.Errorf("failed to record assessment link delivery: %w", err)
+	}
 	return nil
 }
 
@@ -63,16 +101,268 @@ func (u *useCases) GetLink(ctx context.Context, ID string) (*domain.Link, error)
 	return link, nil
 }
 
+func (u *useCases) ListLinks(ctx context.Context, assessmentID string) ([]domain.Link, error) {
+	if _, err := u.repository.GetAssessment(ctx, assessmentID); err != nil {
+		return nil, err
+	}
+	return u.repository.ListLinksByAssessment(ctx, assessmentID)
+}
+
 func (u *useCases) ValidateLink(ctx context.Context, token string) (*domain.Link, error) {
-	link, err := u.repository.GetLink(ctx, token)
+	link, err := u.linkByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Verificar si el link fue revocado o ha expirado
+	switch link.Status(time.Now()) {
+	case domain.LinkRevoked:
+		return nil, types.NewError(types.ErrAuthentication, "assessment link has been revoked", nil)
+	case domain.LinkExpired:
+		return nil, types.NewError(types.ErrAuthentication, "assessment link has expired", nil)
+	}
+
+	return link, nil
+}
+
+// linkByToken resuelve el link de un token emitido para links de evaluación. El token debe tener el
+// propósito de link y pertenecer al mismo assessment que el link guardado.
+func (u *useCases) linkByToken(ctx context.Context, token string) (*domain.Link, error) {
+	assessmentID, err := u.autheUc.ValidateLinkToken(ctx, token)
+	if err != nil {
+		if types.IsAuthenticationError(err) {
+			return nil, types.NewError(types.ErrAuthentication, "invalid assessment link", err)
+		}
+		return nil, fmt.Errorf("failed to validate assessment link token: %w", err)
+	}
+
+	link, err := u.repository.GetLinkByToken(ctx, token)
 	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrAuthentication, "invalid assessment link", nil)
+		}
 		return nil, fmt.Errorf("failed to get assessment link by token: %w", err)
 	}
+	if link.AssessmentID != assessmentID {
+		return nil, types.NewError(types.ErrAuthentication, "invalid assessment link", nil)
+	}
+	return link, nil
+}
+
+func (u *useCases) OpenLink(ctx context.Context, token string) (*domain.Link, error) {
+	link, err := u.ValidateLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if link.OpenedAt != nil {
+		return link, nil
+	}
+
+	now := time.Now()
+	link.OpenedAt = &now
+	if err := u.repository.UpdateLink(ctx, link); err != nil {
+		if types.IsConflict(err) {
+			return nil, types.NewError(types.ErrAuthentication, "assessment link has been revoked", nil)
+		}
+		return nil, fmt.Errorf("failed to record assessment link opening: %w", err)
+	}
+	return link, nil
+}
+
+// ResendLink genera un link con un token nuevo, revoca los anteriores de la evaluación y envía
+// el nuevo. El link se genera antes de revocar para que el candidato nunca quede sin acceso.
+func (u *useCases) ResendLink(ctx context.Context, assessmentID string) (*domain.Link, error) {
+	previous, err := u.ListLinks(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	linkID, err := u.GenerateLink(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range previous {
+		if previous[i].IsRevoked() {
+			continue
+		}
+		previous[i].ReplacedBy = linkID
+		err := u.revoke(ctx, &previous[i], domain.RevokeReasonReplaced)
+		if err != nil && !types.IsConflict(err) {
+			return nil, fmt.Errorf("failed to revoke assessment link %s: %w", previous[i].ID, err)
+		}
+	}
+
+	if err := u.SendLink(ctx, linkID); err != nil {
+		return nil, err
+	}
+	return u.repository.GetLink(ctx, linkID)
+}
+
+func (u *useCases) RevokeLink(ctx context.Context, linkID, reason string) error {
+	link, err := u.repository.GetLink(ctx, linkID)
+	if err != nil {
+		return err
+	}
+	if link.IsRevoked() {
+		return types.NewError(types.ErrConflict, "assessment link is already revoked", nil)
+	}
+	if reason == "" {
+		reason = "revoked by " + actorFrom(ctx, actorSystem)
+	}
+	return u.revoke(ctx, link, reason)
+}
 
-	// 2. Verificar si el link ha expirado
-	if time.Now().After(link.ExpiresAt) {
-		return nil, fmt.Errorf("assessment link has expired")
+// ExtendLink cambia el vencimiento de un link que todavía no se usó para iniciar la evaluación.
+// Los recordatorios vuelven a contarse respecto del vencimiento nuevo.
+func (u *useCases) ExtendLink(ctx context.Context, linkID string, expiresAt time.Time) (*domain.Link, error) {
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, types.NewError(types.ErrValidation, "expires_at must be in the future", nil)
+	}
+
+	link, err := u.repository.GetLink(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if link.IsRevoked() {
+		return nil, types.NewError(types.ErrConflict, "cannot extend a revoked assessment link", nil)
+	}
+	if link.StartedAt != nil {
+		return nil, types.NewError(types.ErrConflict, "assessment was already started with this link", nil)
+	}
+
+	assessment, err := u.repository.GetAssessment(ctx, link.AssessmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+	if err := checkLinkable(assessment); err != nil {
+		return nil, err
 	}
 
+	before := link.ExpiresAt
+	link.ExpiresAt = expiresAt
+	link.RemindersSent = link.DueReminders(u.config.GetAssessmentConfig().ReminderOffsets, now)
+	if err := u.repository.UpdateLink(ctx, link); err != nil {
+		return nil, err
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourceLink, link.ID,
+		map[string]any{"expires_at": before},
+		map[string]any{"expires_at": link.ExpiresAt},
+	)
 	return link, nil
 }
+
+// SendDueReminders manda un recordatorio por cada link enviado, vigente y sin iniciar que llegó a
+// uno de los offsets configurados. Si varios offsets se cumplieron juntos se manda uno solo.
+func (u *useCases) SendDueReminders(ctx context.Context) (int, error) {
+	offsets := u.config.GetAssessmentConfig().ReminderOffsets
+	if len(offsets) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	links, err := u.repository.ListPendingLinks(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	for i := range links {
+		due := links[i].DueReminders(offsets, now)
+		if due <= links[i].RemindersSent {
+			continue
+		}
+		if err := u.sendReminder(ctx, &links[i], due, now); err != nil {
+			// Si el link se revocó mientras tanto, el recordatorio ya no aplica
+			if !types.IsConflict(err) {
+				errs = append(errs, fmt.Errorf("link %s: %w", links[i].ID, err))
+			}
+			continue
+		}
+		sent++
+	}
+	return sent, errors.Join(errs...)
+}
+
+func (u *useCases) RunReminders(ctx context.Context) {
+	cfg := u.config.GetAssessmentConfig()
+	if !cfg.RemindersEnabled {
+		log.Println("assessment: link reminders are disabled")
+		return
+	}
+
+	ticker := time.NewTicker(cfg.ReminderCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := u.SendDueReminders(ctx)
+		if err != nil {
+			log.Printf("assessment: failed to send link reminders: %v", err)
+		}
+		if sent > 0 {
+			log.Printf("assessment: sent %d link reminders", sent)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// sendReminder manda el recordatorio solo si la evaluación sigue esperando que el candidato la
+// inicie, y registra los offsets cubiertos.
+func (u *useCases) sendReminder(ctx context.Context, link *domain.Link, due int, now time.Time) error {
+	assessment, err := u.repository.GetAssessment(ctx, link.AssessmentID)
+	if err != nil {
+		return fmt.Errorf("failed to get assessment: %w", err)
+	}
+	if assessment.Status != domain.StatusSent {
+		return nil
+	}
+
+	cfg := u.config.GetAssessmentConfig()
+	address, _, body, err := u.buildEmail(ctx, link.ID)
+	if err != nil {
+		return err
+	}
+	body += "\n\n" + fmt.Sprintf(cfg.ReminderTemplate, link.ExpiresAt.UTC().Format(reminderDateLayout))
+
+	if err := u.notificationUc.SendEmail(ctx, address, cfg.ReminderSubject, body); err != nil {
+		return fmt.Errorf("failed to send reminder: %w", err)
+	}
+
+	link.RemindersSent = due
+	link.LastReminderAt = &now
+	return u.repository.UpdateLink(ctx, link)
+}
+
+// revoke invalida el token del link y lo registra en la auditoría.
+func (u *useCases) revoke(ctx context.Context, link *domain.Link, reason string) error {
+	now := time.Now()
+	link.RevokedAt = &now
+	link.RevokeReason = reason
+	if err := u.repository.UpdateLink(ctx, link); err != nil {
+		return err
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourceLink, link.ID,
+		map[string]any{"revoked_at": nil},
+		map[string]any{"revoked_at": now, "reason": reason, "replaced_by": link.ReplacedBy},
+	)
+	return nil
+}
+
+// checkLinkable indica si se pueden emitir o extender links: solo mientras el candidato no entregó.
+func checkLinkable(assessment *domain.Assessment) error {
+	switch assessment.Status {
+	case domain.StatusPending, domain.StatusSent, domain.StatusInProgress:
+		return nil
+	default:
+		return types.NewError(types.ErrConflict, fmt.Sprintf("cannot issue a link for a %s assessment", assessment.Status), nil)
+	}
+}
@@ -0,0 +1,158 @@
+package assessment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	authedom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
+	candom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+	perdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/usecases/domain"
+)
+
+func TestRevokeLink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, f *fields) string
+		wantErr func(error) bool
+	}{
+		{
+			name:    "Error: unknown link",
+			setup:   func(t *testing.T, f *fields) string { return "missing" },
+			wantErr: types.IsNotFound,
+		},
+		{
+			name: "Error: link already revoked",
+			setup: func(t *testing.T, f *fields) string {
+				assessment := f.seedAssessment(t, domain.StatusSent)
+				link := f.seedLink(t, assessment.ID, "tok")
+				assert.NoError(t, f.useCases().RevokeLink(context.Background(), link.ID, "first"))
+				return link.ID
+			},
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Success: link revoked",
+			setup: func(t *testing.T, f *fields) string {
+				assessment := f.seedAssessment(t, domain.StatusSent)
+				return f.seedLink(t, assessment.ID, "tok").ID
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			linkID := tc.setup(t, f)
+
+			err := f.useCases().RevokeLink(context.Background(), linkID, "")
+
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+
+			link, err := f.repository.GetLink(context.Background(), linkID)
+			assert.NoError(t, err)
+			assert.Equal(t, domain.LinkRevoked, link.Status(time.Now()), "link should be revoked")
+			assert.Equal(t, "revoked by system", link.RevokeReason, "revoke reason mismatch")
+
+			// El token revocado ya no autentica al candidato.
+			_, err = f.useCases().ValidateLink(context.Background(), "tok")
+			assert.True(t, types.IsAuthenticationError(err), "revoked token should not validate: %v", err)
+		})
+	}
+}
+
+func TestResendLink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// expectDelivery espera la generación del token y el envío del email del link nuevo.
+	expectDelivery := func(f *fields, sendErr error) {
+		f.candidate.EXPECT().
+			GetCandidate(gomock.Any(), "cand1").
+			Return(&candom.Candidate{ID: "cand1", PersonID: "per1", Email: "cand@example.com"}, nil).
+			AnyTimes()
+		f.person.EXPECT().
+			GetPerson(gomock.Any(), "per1").
+			Return(&perdom.Person{ID: "per1", FirstName: "Ana"}, nil).
+			AnyTimes()
+		// El token del link se emite para el assessment, no para el candidato.
+		f.authe.EXPECT().
+			GenerateLinkTokens(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, assessmentID string) (*authedom.Token, error) {
+				f.linkTokens["new-tok"] = assessmentID
+				return &authedom.Token{AccessToken: "new-tok"}, nil
+			})
+		f.notif.EXPECT().
+			SendEmail(gomock.Any(), "cand@example.com", "Your assessment", gomock.Any()).
+			Return(sendErr)
+	}
+
+	tests := []struct {
+		name    string
+		status  domain.AssessmentStatus
+		setup   func(f *fields)
+		wantErr func(error) bool
+	}{
+		{
+			name:    "Error: submitted assessment cannot get a new link",
+			status:  domain.StatusSubmitted,
+			setup:   func(f *fields) {},
+			wantErr: types.IsConflict,
+		},
+		{
+			name:   "Error: delivery failure is reported",
+			status: domain.StatusSent,
+			setup:  func(f *fields) { expectDelivery(f, errors.New("smtp down")) },
+			wantErr: func(err error) bool {
+				return err != nil
+			},
+		},
+		{
+			name:   "Success: previous links replaced by the new one",
+			status: domain.StatusSent,
+			setup:  func(f *fields) { expectDelivery(f, nil) },
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			assessment := f.seedAssessment(t, tc.status)
+			previous := f.seedLink(t, assessment.ID, "old-tok")
+			tc.setup(f)
+
+			link, err := f.useCases().ResendLink(context.Background(), assessment.ID)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, "new-tok", link.Token, "token mismatch")
+			assert.Equal(t, assessment.ID, f.linkTokens["new-tok"], "token should be issued for the assessment")
+			assert.NotNil(t, link.SentAt, "new link should be marked as sent")
+
+			old, err := f.repository.GetLink(context.Background(), previous.ID)
+			assert.NoError(t, err)
+			assert.True(t, old.IsRevoked(), "previous link should be revoked")
+			assert.Equal(t, domain.RevokeReasonReplaced, old.RevokeReason, "revoke reason mismatch")
+			assert.Equal(t, link.ID, old.ReplacedBy, "previous link should point to the new one")
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package assessment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+// StartSession inicia la evaluación del link (StartDate, DeadlineAt y StatusInProgress) o, si el
+// candidato ya la había iniciado, retoma la sesión existente.
+func (u *useCases) StartSession(ctx context.Context, token string) (*domain.Session, error) {
+	// La expiración del link solo se controla al iniciar; una vez iniciada manda el deadline
+	link, err := u.ValidateLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment, err := u.repository.GetAssessment(ctx, link.AssessmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+
+	existing, err := u.repository.GetSessionByAssessment(ctx, assessment.ID)
+	if err == nil {
+		existing.Deadline = assessment.Deadline()
+		return existing, nil
+	}
+	if !types.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	if !domain.CanTransition(assessment.Status, domain.StatusInProgress) {
+		return nil, types.NewError(types.ErrConflict, fmt.Sprintf("assessment cannot be started, it is %s", assessment.Status), nil)
+	}
+
+	now := time.Now()
+	session := &domain.Session{
+		AssessmentID: assessment.ID,
+		LinkID:       link.ID,
+		StartedAt:    now,
+	}
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		sessionID, err := u.repository.CreateSession(ctx, session)
+		if err != nil {
+			return err
+		}
+		session.ID = sessionID
+
+		// Se registra con qué link se inició; si el link se revocó mientras tanto, no se inicia
+		link.StartedAt = &now
+		if link.OpenedAt == nil {
+			link.OpenedAt = &now
+		}
+		if err := u.repository.UpdateLink(ctx, link); err != nil {
+			return err
+		}
+
+		// El deadline se fija en el servidor al iniciar y no cambia si después se edita MaxDuration
+		assessment.StartDate = now
+		assessment.DeadlineAt = time.Time{}
+		assessment.DeadlineAt = assessment.Deadline()
+		return u.transition(ctx, assessment, domain.StatusInProgress, "session started", actorCandidate)
+	})
+	if err != nil {
+		// Si otra pestaña inició la sesión al mismo tiempo, se retoma la que quedó guardada
+		if resumed, _, openErr := u.openSession(ctx, token); openErr == nil {
+			return resumed, nil
+		}
+		return nil, fmt.Errorf("failed to start session: %w", err)
+	}
+
+	session.Deadline = assessment.Deadline()
+	u.auditUc.RecordChange(ctx, auditdom.ActionCreate, auditResourceSession, session.ID, nil, map[string]any{
+		"assessment_id": assessment.ID,
+		"started_at":    now,
+		"deadline":      session.Deadline,
+	})
+	return session, nil
+}
+
+// GetSession devuelve el estado guardado de la sesión para retomarla después de una reconexión.
+func (u *useCases) GetSession(ctx context.Context, token string) (*domain.Session, error) {
+	session, _, err := u.openSession(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// GetSessionProblem devuelve la evaluación de una sesión iniciada. Las pruebas ocultas se
+// filtran al armar la respuesta (ver domain.Assessment.VisibleUnitTests).
+func (u *useCases) GetSessionProblem(ctx context.Context, token string) (*domain.Assessment, error) {
+	_, assessment, err := u.openSession(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return assessment, nil
+}
+
+// AutosaveSession guarda el borrador del candidato. Cada autosave debe traer una revisión mayor
+// a la guardada, así un request atrasado no pisa uno más nuevo.
+func (u *useCases) AutosaveSession(ctx context.Context, token string, draft *domain.SessionDraft) (*domain.Session, error) {
+	if draft == nil {
+		return nil, types.NewError(types.ErrValidation, "draft is required", nil)
+	}
+
+	session, assessment, err := u.openSession(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if session.IsSubmitted() {
+		return nil, types.NewError(types.ErrConflict, "assessment session already submitted", nil)
+	}
+	if assessment.Status != domain.StatusInProgress {
+		return nil, types.NewError(types.ErrConflict, fmt.Sprintf("assessment is %s", assessment.Status), nil)
+	}
+
+	now := time.Now()
+	if session.IsExpired(now) {
+		return nil, types.NewError(types.ErrConflict, "assessment time is over", nil)
+	}
+	if draft.Revision <= session.Revision {
+		return nil, staleRevisionError(draft.Revision, session.Revision)
+	}
+
+	previous := session.Revision
+	applyDraft(session, draft, now)
+	if err := u.repository.UpdateSession(ctx, session, previous); err != nil {
+		return nil, fmt.Errorf("failed to autosave session: %w", err)
+	}
+	return session, nil
+}
+
+// SubmitSession entrega la evaluación. Si el tiempo venció se entrega lo último que se guardó y
+// se ignora el borrador enviado.
+func (u *useCases) SubmitSession(ctx context.Context, token string, draft *domain.SessionDraft) (*domain.Session, error) {
+	session, assessment, err := u.openSession(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return u.submit(ctx, session, assessment, draft, "submitted by candidate", actorCandidate)
+}
+
+// submit guarda la entrega, pasa la evaluación a StatusSubmitted y encola la corrección. También
+// lo usa el scheduler de vencimientos (sin borrador) para entregar lo último guardado.
+func (u *useCases) submit(ctx context.Context, session *domain.Session, assessment *domain.Assessment, draft *domain.SessionDraft, reason, actor string) (*domain.Session, error) {
+	if session.IsSubmitted() {
+		return nil, types.NewError(types.ErrConflict, "assessment session already submitted", nil)
+	}
+	if !domain.CanTransition(assessment.Status, domain.StatusSubmitted) {
+		return nil, types.NewError(types.ErrConflict, fmt.Sprintf("assessment cannot be submitted, it is %s", assessment.Status), nil)
+	}
+
+	now := time.Now()
+	late := session.IsExpired(now)
+	previous := session.Revision
+	if draft != nil && !late {
+		if draft.Revision != 0 && draft.Revision <= session.Revision {
+			return nil, staleRevisionError(draft.Revision, session.Revision)
+		}
+		applyDraft(session, draft, now)
+	}
+	if session.Revision <= previous {
+		session.Revision = previous + 1
+	}
+	session.SubmittedAt = &now
+
+	from := assessment.Status
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.UpdateSession(ctx, session, previous); err != nil {
+			return err
+		}
+		assessment.EndDate = now
+		return u.transition(ctx, assessment, domain.StatusSubmitted, reason, actor)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit session: %w", err)
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourceSession, session.ID, nil, map[string]any{
+		"assessment_id": assessment.ID,
+		"submitted_at":  now,
+		"revision":      session.Revision,
+		"late":          late,
+	})
+	u.auditStatusChange(ctx, assessment.ID, from, domain.StatusSubmitted, reason)
+
+	// La corrección corre en segundo plano; si no se pudo encolar se puede pedir desde grading
+	if err := u.broker.PublishSubmitted(ctx, session); err != nil {
+		log.Printf("assessment %s: failed to enqueue grading: %v", assessment.ID, err)
+	}
+	return session, nil
+}
+
+// GetSubmission devuelve la sesión entregada de una evaluación; la usa la corrección automática.
+func (u *useCases) GetSubmission(ctx context.Context, assessmentID string) (*domain.Session, error) {
+	session, err := u.repository.GetSessionByAssessment(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsSubmitted() {
+		return nil, types.NewError(types.ErrConflict, "assessment session has not been submitted", nil)
+	}
+	return session, nil
+}
+
+// openSession resuelve el link por su token y devuelve la sesión iniciada con su deadline.
+func (u *useCases) openSession(ctx context.Context, token string) (*domain.Session, *domain.Assessment, error) {
+	link, err := u.linkByToken(ctx, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Una vez iniciada la evaluación el vencimiento del link no importa, pero la revocación sí
+	if link.IsRevoked() {
+		return nil, nil, types.NewError(types.ErrAuthentication, "assessment link has been revoked", nil)
+	}
+
+	assessment, err := u.repository.GetAssessment(ctx, link.AssessmentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+
+	session, err := u.repository.GetSessionByAssessment(ctx, assessment.ID)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, nil, types.NewError(types.ErrConflict, "assessment session has not been started", nil)
+		}
+		return nil, nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	session.Deadline = assessment.Deadline()
+	return session, assessment, nil
+}
+
+func applyDraft(session *domain.Session, draft *domain.SessionDraft, now time.Time) {
+	session.Language = draft.Language
+	session.Code = draft.Code
+	session.Answers = draft.Answers
+	session.Revision = draft.Revision
+	session.LastSavedAt = now
+}
+
+func staleRevisionError(got, current int64) error {
+	return types.NewError(types.ErrConflict, fmt.Sprintf("stale draft revision %d, current revision is %d", got, current), nil)
+}
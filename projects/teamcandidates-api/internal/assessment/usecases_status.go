@@ -0,0 +1,179 @@
+package assessment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+// Actores de las transiciones que no pide un usuario autenticado
+const (
+	actorCandidate = "candidate"
+	actorSystem    = "system"
+)
+
+// TransitionAssessment aplica una transición pedida por RR. HH. (sent, expired o cancelled).
+func (u *useCases) TransitionAssessment(ctx context.Context, assessmentID string, to domain.AssessmentStatus, reason string) error {
+	if !to.IsValid() {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("invalid assessment status %q", to), nil)
+	}
+	if !domain.IsManualTransition(to) {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("assessment status %s cannot be set manually", to), nil)
+	}
+
+	assessment, err := u.repository.GetAssessment(ctx, assessmentID)
+	if err != nil {
+		return err
+	}
+	if to != domain.StatusSent && assessment.EndDate.IsZero() {
+		assessment.EndDate = time.Now()
+	}
+
+	return u.transitionTx(ctx, assessment, to, reason, actorFrom(ctx, actorSystem))
+}
+
+func (u *useCases) ListStatusHistory(ctx context.Context, assessmentID string) ([]domain.StatusTransition, error) {
+	if _, err := u.repository.GetAssessment(ctx, assessmentID); err != nil {
+		return nil, err
+	}
+	return u.repository.ListStatusTransitions(ctx, assessmentID)
+}
+
+// MarkGraded pasa la evaluación entregada a graded; volver a corregirla no cambia el estado.
+func (u *useCases) MarkGraded(ctx context.Context, assessmentID string) error {
+	assessment, err := u.repository.GetAssessment(ctx, assessmentID)
+	if err != nil {
+		return err
+	}
+	if assessment.Status == domain.StatusGraded {
+		return nil
+	}
+	return u.transitionTx(ctx, assessment, domain.StatusGraded, "automatic grading finished", actorSystem)
+}
+
+// ExpireOverdue cierra las sesiones en curso cuyo deadline (más el margen configurado) pasó:
+// si el candidato guardó algo se entrega lo último guardado, si no la evaluación vence.
+func (u *useCases) ExpireOverdue(ctx context.Context) (int, error) {
+	grace := u.config.GetAssessmentConfig().DeadlineGrace
+	overdue, err := u.repository.ListOverdueAssessments(ctx, time.Now().Add(-grace))
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	var errs []error
+	for i := range overdue {
+		if err := u.closeOverdue(ctx, &overdue[i]); err != nil {
+			// Si la sesión se entregó mientras tanto, la transición ya no aplica
+			if !types.IsConflict(err) {
+				errs = append(errs, fmt.Errorf("assessment %s: %w", overdue[i].ID, err))
+			}
+			continue
+		}
+		closed++
+	}
+	return closed, errors.Join(errs...)
+}
+
+func (u *useCases) RunExpiry(ctx context.Context) {
+	cfg := u.config.GetAssessmentConfig()
+	if !cfg.ExpiryEnabled {
+		log.Println("assessment: expiry of overdue sessions is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(cfg.ExpiryCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		closed, err := u.ExpireOverdue(ctx)
+		if err != nil {
+			log.Printf("assessment: failed to close overdue sessions: %v", err)
+		}
+		if closed > 0 {
+			log.Printf("assessment: closed %d overdue sessions", closed)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (u *useCases) closeOverdue(ctx context.Context, assessment *domain.Assessment) error {
+	session, err := u.repository.GetSessionByAssessment(ctx, assessment.ID)
+	if err != nil && !types.IsNotFound(err) {
+		return err
+	}
+
+	if session != nil && !session.IsSubmitted() && session.Revision > 0 {
+		session.Deadline = assessment.Deadline()
+		_, err := u.submit(ctx, session, assessment, nil, "deadline reached", actorSystem)
+		return err
+	}
+
+	assessment.EndDate = assessment.Deadline()
+	return u.transitionTx(ctx, assessment, domain.StatusExpired, "deadline reached without a submission", actorSystem)
+}
+
+// transitionTx aplica la transición en su propia transacción y la registra en la auditoría.
+func (u *useCases) transitionTx(ctx context.Context, assessment *domain.Assessment, to domain.AssessmentStatus, reason, actor string) error {
+	from := assessment.Status
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		return u.transition(ctx, assessment, to, reason, actor)
+	})
+	if err != nil {
+		return err
+	}
+
+	u.auditStatusChange(ctx, assessment.ID, from, to, reason)
+	return nil
+}
+
+// transition valida la transición, guarda el estado (con las fechas que ya trae assessment) y la
+// agrega al historial. Corre dentro de la transacción del caller.
+func (u *useCases) transition(ctx context.Context, assessment *domain.Assessment, to domain.AssessmentStatus, reason, actor string) error {
+	from := assessment.Status
+	if !domain.CanTransition(from, to) {
+		return types.NewError(types.ErrConflict, fmt.Sprintf("assessment cannot go from %s to %s", from, to), nil)
+	}
+
+	assessment.Status = to
+	if err := u.repository.UpdateAssessmentStatus(ctx, assessment, from); err != nil {
+		assessment.Status = from
+		return err
+	}
+
+	return u.repository.AppendStatusTransition(ctx, &domain.StatusTransition{
+		AssessmentID: assessment.ID,
+		From:         from,
+		To:           to,
+		Reason:       reason,
+		Actor:        actor,
+		OccurredAt:   time.Now(),
+	})
+}
+
+func (u *useCases) auditStatusChange(ctx context.Context, assessmentID string, from, to domain.AssessmentStatus, reason string) {
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourceAssessment, assessmentID,
+		map[string]any{"status": from},
+		map[string]any{"status": to, "reason": reason},
+	)
+}
+
+// actorFrom devuelve el principal autenticado o fallback si el request no trae uno.
+func actorFrom(ctx context.Context, fallback string) string {
+	if id := types.PrincipalIDFromContext(ctx); id != "" {
+		return id
+	}
+	return fallback
+}
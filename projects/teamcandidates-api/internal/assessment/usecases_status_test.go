@@ -0,0 +1,156 @@
+package assessment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+func TestTransitionAssessment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		from    domain.AssessmentStatus
+		to      domain.AssessmentStatus
+		wantErr func(error) bool
+	}{
+		{
+			name:    "Error: unknown status",
+			from:    domain.StatusPending,
+			to:      "archived",
+			wantErr: types.IsValidationError,
+		},
+		{
+			name:    "Error: graded can only be set by the grading",
+			from:    domain.StatusSubmitted,
+			to:      domain.StatusGraded,
+			wantErr: types.IsValidationError,
+		},
+		{
+			name:    "Error: submitted assessment cannot be cancelled",
+			from:    domain.StatusSubmitted,
+			to:      domain.StatusCancelled,
+			wantErr: types.IsConflict,
+		},
+		{
+			name:    "Error: pending assessment cannot expire",
+			from:    domain.StatusPending,
+			to:      domain.StatusExpired,
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Success: pending to sent",
+			from: domain.StatusPending,
+			to:   domain.StatusSent,
+		},
+		{
+			name: "Success: in progress to cancelled",
+			from: domain.StatusInProgress,
+			to:   domain.StatusCancelled,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			assessment := f.seedAssessment(t, tc.from)
+
+			err := f.useCases().TransitionAssessment(context.Background(), assessment.ID, tc.to, "test")
+
+			history, histErr := f.useCases().ListStatusHistory(context.Background(), assessment.ID)
+			assert.NoError(t, histErr)
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+				assert.Equal(t, tc.from, f.status(t, assessment.ID), "status should not change")
+				assert.Empty(t, history, "no transition should be recorded")
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.to, f.status(t, assessment.ID), "status mismatch")
+			if assert.Len(t, history, 1, "transition should be recorded") {
+				assert.Equal(t, tc.from, history[0].From)
+				assert.Equal(t, tc.to, history[0].To)
+			}
+		})
+	}
+}
+
+func TestMarkGraded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		from    domain.AssessmentStatus
+		wantErr bool
+	}{
+		{name: "Error: assessment not submitted", from: domain.StatusInProgress, wantErr: true},
+		{name: "Success: submitted to graded", from: domain.StatusSubmitted},
+		{name: "Success: grading again keeps the status", from: domain.StatusGraded},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			assessment := f.seedAssessment(t, tc.from)
+
+			err := f.useCases().MarkGraded(context.Background(), assessment.ID)
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, types.IsConflict(err), "unexpected error type: %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, domain.StatusGraded, f.status(t, assessment.ID), "status mismatch")
+		})
+	}
+}
+
+func TestCreateAssessment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		status  domain.AssessmentStatus
+		wantErr bool
+	}{
+		{name: "Error: client status other than pending", status: domain.StatusSent, wantErr: true},
+		{name: "Success: empty status starts pending", status: ""},
+		{name: "Success: explicit pending status", status: domain.StatusPending},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+
+			id, err := f.useCases().CreateAssessment(context.Background(), &domain.Assessment{
+				CandidateID: "cand1",
+				Status:      tc.status,
+				MaxDuration: time.Hour,
+			})
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, types.IsValidationError(err), "unexpected error type: %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, domain.StatusPending, f.status(t, id), "status mismatch")
+			history, err := f.useCases().ListStatusHistory(context.Background(), id)
+			assert.NoError(t, err)
+			assert.Len(t, history, 1, "initial transition should be recorded")
+		})
+	}
+}
@@ -0,0 +1,368 @@
+package assessment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	mock_assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/mocks"
+	mock_audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/mocks"
+	mock_authe "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/mocks"
+	mock_candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/mocks"
+	mock_config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config/mocks"
+	mock_notification "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/notification/mocks"
+	mock_person "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/mocks"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+)
+
+// fields usa el repositorio en memoria real y mockea el resto de las dependencias.
+type fields struct {
+	repository Repository
+	notif      *mock_notification.MockUseCases
+	candidate  *mock_candidate.MockUseCases
+	config     *mock_config.MockLoader
+	authe      *mock_authe.MockUseCases
+	person     *mock_person.MockUseCases
+	audit      *mock_audit.MockUseCases
+	broker     *mock_assessment.MockBroker
+	db         mapdb.Repository
+	// linkTokens simula los tokens de link emitidos por authe: token -> assessment ID.
+	linkTokens map[string]string
+}
+
+func newFields(ctrl *gomock.Controller) *fields {
+	db := mapdb.Bootstrap()
+	f := &fields{
+		repository: NewMemoryRepository(db),
+		notif:      mock_notification.NewMockUseCases(ctrl),
+		candidate:  mock_candidate.NewMockUseCases(ctrl),
+		config:     mock_config.NewMockLoader(ctrl),
+		authe:      mock_authe.NewMockUseCases(ctrl),
+		person:     mock_person.NewMockUseCases(ctrl),
+		audit:      mock_audit.NewMockUseCases(ctrl),
+		broker:     mock_assessment.NewMockBroker(ctrl),
+		db:         db,
+		linkTokens: map[string]string{},
+	}
+	f.config.EXPECT().GetAssessmentConfig().Return(config.AssessmentConfig{
+		BaseURL:                 "https://app.test/assessment",
+		Subject:                 "Your assessment",
+		BodyTemplate:            "Start here: %s",
+		AccessExpirationMinutes: 24 * time.Hour,
+	}).AnyTimes()
+	// Solo los tokens emitidos como token de link autentican, y únicamente para su assessment.
+	f.authe.EXPECT().ValidateLinkToken(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, token string) (string, error) {
+			assessmentID, ok := f.linkTokens[token]
+			if !ok {
+				return "", types.NewAuthenticationError("token was not issued for an assessment link", nil)
+			}
+			return assessmentID, nil
+		},
+	).AnyTimes()
+	// La auditoría no corta el flujo; cada test verifica el estado en el repositorio.
+	f.audit.EXPECT().RecordChange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return f
+}
+
+func (f *fields) useCases() UseCases {
+	return NewUseCases(f.repository, mapdb.NewTxManager(f.db), f.notif, f.candidate, f.config, f.authe, f.person, f.audit, f.broker)
+}
+
+// seedAssessment guarda una evaluación de una hora en el estado indicado.
+func (f *fields) seedAssessment(t *testing.T, status domain.AssessmentStatus) *domain.Assessment {
+	t.Helper()
+	assessment := &domain.Assessment{
+		CandidateID: "cand1",
+		Status:      status,
+		MaxDuration: time.Hour,
+	}
+	id, err := f.repository.CreateAssessment(context.Background(), assessment)
+	assert.NoError(t, err)
+	assessment.ID = id
+	return assessment
+}
+
+// seedLink guarda un link vigente de la evaluación con un token de link emitido para ella.
+func (f *fields) seedLink(t *testing.T, assessmentID, token string) *domain.Link {
+	t.Helper()
+	f.linkTokens[token] = assessmentID
+	return f.seedLinkWithToken(t, assessmentID, token)
+}
+
+// seedLinkWithToken guarda un link vigente con un token cualquiera, sin registrarlo como token de link.
+func (f *fields) seedLinkWithToken(t *testing.T, assessmentID, token string) *domain.Link {
+	t.Helper()
+	link := &domain.Link{
+		AssessmentID: assessmentID,
+		Token:        token,
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+	id, err := f.repository.StoreLink(context.Background(), link)
+	assert.NoError(t, err)
+	link.ID = id
+	return link
+}
+
+// startSession deja la evaluación en curso con una sesión iniciada por el token.
+func (f *fields) startSession(t *testing.T, token string) *domain.Assessment {
+	t.Helper()
+	assessment := f.seedAssessment(t, domain.StatusSent)
+	f.seedLink(t, assessment.ID, token)
+	_, err := f.useCases().StartSession(context.Background(), token)
+	assert.NoError(t, err)
+	return assessment
+}
+
+func (f *fields) status(t *testing.T, assessmentID string) domain.AssessmentStatus {
+	t.Helper()
+	assessment, err := f.repository.GetAssessment(context.Background(), assessmentID)
+	assert.NoError(t, err)
+	return assessment.Status
+}
+
+func TestStartSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name       string
+		setup      func(t *testing.T, f *fields) string
+		wantErr    func(error) bool
+		wantStatus domain.AssessmentStatus
+	}{
+		{
+			name:    "Error: unknown token",
+			setup:   func(t *testing.T, f *fields) string { return "unknown" },
+			wantErr: types.IsAuthenticationError,
+		},
+		{
+			name: "Error: revoked link",
+			setup: func(t *testing.T, f *fields) string {
+				assessment := f.seedAssessment(t, domain.StatusSent)
+				link := f.seedLink(t, assessment.ID, "tok")
+				assert.NoError(t, f.useCases().RevokeLink(context.Background(), link.ID, "test"))
+				return "tok"
+			},
+			wantErr: types.IsAuthenticationError,
+		},
+		{
+			name: "Error: token without the assessment link purpose",
+			setup: func(t *testing.T, f *fields) string {
+				// Por ejemplo, un JWT de sesión de un usuario guardado como token del link.
+				assessment := f.seedAssessment(t, domain.StatusSent)
+				f.seedLinkWithToken(t, assessment.ID, "hr-jwt")
+				return "hr-jwt"
+			},
+			wantErr: types.IsAuthenticationError,
+		},
+		{
+			name: "Error: token issued for another assessment",
+			setup: func(t *testing.T, f *fields) string {
+				assessment := f.seedAssessment(t, domain.StatusSent)
+				f.seedLinkWithToken(t, assessment.ID, "tok")
+				f.linkTokens["tok"] = "other-assessment"
+				return "tok"
+			},
+			wantErr: types.IsAuthenticationError,
+		},
+		{
+			name: "Error: cancelled assessment cannot be started",
+			setup: func(t *testing.T, f *fields) string {
+				assessment := f.seedAssessment(t, domain.StatusCancelled)
+				f.seedLink(t, assessment.ID, "tok")
+				return "tok"
+			},
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Success: session started and assessment in progress",
+			setup: func(t *testing.T, f *fields) string {
+				assessment := f.seedAssessment(t, domain.StatusSent)
+				f.seedLink(t, assessment.ID, "tok")
+				return "tok"
+			},
+			wantStatus: domain.StatusInProgress,
+		},
+		{
+			name: "Success: started session is resumed",
+			setup: func(t *testing.T, f *fields) string {
+				f.startSession(t, "tok")
+				return "tok"
+			},
+			wantStatus: domain.StatusInProgress,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			token := tc.setup(t, f)
+
+			session, err := f.useCases().StartSession(context.Background(), token)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.NotEmpty(t, session.ID, "session ID should be set")
+			assert.False(t, session.Deadline.IsZero(), "deadline should be fixed when starting")
+			assert.Equal(t, tc.wantStatus, f.status(t, session.AssessmentID), "status mismatch")
+
+			// El link queda marcado como el que inició la evaluación.
+			link, err := f.repository.GetLink(context.Background(), session.LinkID)
+			assert.NoError(t, err)
+			assert.NotNil(t, link.StartedAt, "link should record the start")
+		})
+	}
+}
+
+func TestAutosaveSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, f *fields)
+		draft        *domain.SessionDraft
+		wantErr      func(error) bool
+		wantRevision int64
+	}{
+		{
+			name: "Error: session not started",
+			setup: func(t *testing.T, f *fields) {
+				assessment := f.seedAssessment(t, domain.StatusSent)
+				f.seedLink(t, assessment.ID, "tok")
+			},
+			draft:   &domain.SessionDraft{Code: "x", Revision: 1},
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Error: stale revision does not overwrite a newer draft",
+			setup: func(t *testing.T, f *fields) {
+				f.startSession(t, "tok")
+				_, err := f.useCases().AutosaveSession(context.Background(), "tok", &domain.SessionDraft{Code: "new", Revision: 2})
+				assert.NoError(t, err)
+			},
+			draft:   &domain.SessionDraft{Code: "old", Revision: 1},
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Error: submitted session",
+			setup: func(t *testing.T, f *fields) {
+				f.startSession(t, "tok")
+				f.broker.EXPECT().PublishSubmitted(gomock.Any(), gomock.Any()).Return(nil)
+				_, err := f.useCases().SubmitSession(context.Background(), "tok", nil)
+				assert.NoError(t, err)
+			},
+			draft:   &domain.SessionDraft{Code: "late", Revision: 5},
+			wantErr: types.IsConflict,
+		},
+		{
+			name:         "Success: draft saved",
+			setup:        func(t *testing.T, f *fields) { f.startSession(t, "tok") },
+			draft:        &domain.SessionDraft{Language: "go", Code: "package main", Revision: 1},
+			wantRevision: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(t, f)
+
+			session, err := f.useCases().AutosaveSession(context.Background(), "tok", tc.draft)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+
+			stored, err := f.repository.GetSessionByAssessment(context.Background(), session.AssessmentID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantRevision, stored.Revision, "revision mismatch")
+			assert.Equal(t, tc.draft.Code, stored.Code, "code mismatch")
+		})
+	}
+}
+
+func TestSubmitSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, f *fields)
+		draft   *domain.SessionDraft
+		wantErr func(error) bool
+	}{
+		{
+			name: "Error: session not started",
+			setup: func(t *testing.T, f *fields) {
+				assessment := f.seedAssessment(t, domain.StatusSent)
+				f.seedLink(t, assessment.ID, "tok")
+			},
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Error: already submitted",
+			setup: func(t *testing.T, f *fields) {
+				f.startSession(t, "tok")
+				f.broker.EXPECT().PublishSubmitted(gomock.Any(), gomock.Any()).Return(nil)
+				_, err := f.useCases().SubmitSession(context.Background(), "tok", nil)
+				assert.NoError(t, err)
+			},
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Success: submitted and grading enqueued",
+			setup: func(t *testing.T, f *fields) {
+				f.startSession(t, "tok")
+				f.broker.EXPECT().PublishSubmitted(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			draft: &domain.SessionDraft{Language: "go", Code: "package main", Revision: 1},
+		},
+		{
+			name: "Success: grading queue failure does not reject the submission",
+			setup: func(t *testing.T, f *fields) {
+				f.startSession(t, "tok")
+				f.broker.EXPECT().PublishSubmitted(gomock.Any(), gomock.Any()).Return(errors.New("broker down"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(t, f)
+
+			session, err := f.useCases().SubmitSession(context.Background(), "tok", tc.draft)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.True(t, session.IsSubmitted(), "session should be submitted")
+			assert.Equal(t, domain.StatusSubmitted, f.status(t, session.AssessmentID), "status mismatch")
+
+			submission, err := f.useCases().GetSubmission(context.Background(), session.AssessmentID)
+			assert.NoError(t, err)
+			assert.Equal(t, session.Revision, submission.Revision, "submitted revision mismatch")
+		})
+	}
+}
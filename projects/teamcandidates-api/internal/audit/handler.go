@@ -0,0 +1,77 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	gsv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/handler/dto"
+)
+
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{
+		ucs: u,
+		gsv: s,
+		mws: m,
+	}
+}
+
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/audit"
+	protectedPrefix := apiBase + "/protected"
+
+	// Rutas protegidas: solo lectura, el log es append-only
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequirePermission(types.PermissionAuditRead))
+
+		protected.GET("/events", h.ListEvents)
+	}
+}
+
+func (h *Handler) ListEvents(c *gin.Context) {
+	var req dto.EventFilter
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr, errCode := types.NewAPIError(types.NewError(types.ErrValidation, "invalid query parameters", err))
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	filter, err := req.ToDomain()
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	events, err := h.ucs.ListEvents(c.Request.Context(), filter)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	resp := dto.ListEventsResponse{
+		Events: make([]dto.AuditEventResponse, 0, len(events)),
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+	for i := range events {
+		resp.Events = append(resp.Events, dto.ToAuditEventResponse(&events[i]))
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+type EventFilter struct {
+	ActorID      string `form:"actor_id" binding:"omitempty,max=100"`
+	ResourceType string `form:"resource_type" binding:"omitempty,max=100"`
+	ResourceID   string `form:"resource_id" binding:"omitempty,max=100"`
+	Action       string `form:"action" binding:"omitempty,max=100"`
+	From         string `form:"from" binding:"omitempty"` // RFC3339
+	To           string `form:"to" binding:"omitempty"`   // RFC3339
+	Limit        int    `form:"limit" binding:"omitempty,min=1,max=500"`
+	Offset       int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+// Mappers
+func (d *EventFilter) ToDomain() (*domain.Filter, error) {
+	filter := &domain.Filter{
+		ActorID:      d.ActorID,
+		ResourceType: d.ResourceType,
+		ResourceID:   d.ResourceID,
+		Action:       domain.Action(d.Action),
+		Limit:        d.Limit,
+		Offset:       d.Offset,
+	}
+
+	if d.From != "" {
+		from, err := time.Parse(time.RFC3339, d.From)
+		if err != nil {
+			return nil, types.NewError(types.ErrInvalidInput, "invalid 'from', expected RFC3339", err)
+		}
+		filter.From = from
+	}
+	if d.To != "" {
+		to, err := time.Parse(time.RFC3339, d.To)
+		if err != nil {
+			return nil, types.NewError(types.ErrInvalidInput, "invalid 'to', expected RFC3339", err)
+		}
+		filter.To = to
+	}
+	return filter, nil
+}
+
+// Response
+type FieldChangeResponse struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+type AuditEventResponse struct {
+	ID           string                `json:"id"`
+	ActorID      string                `json:"actor_id"`
+	ActorType    string                `json:"actor_type"`
+	Action       string                `json:"action"`
+	ResourceType string                `json:"resource_type"`
+	ResourceID   string                `json:"resource_id"`
+	Before       map[string]any        `json:"before,omitempty"`
+	After        map[string]any        `json:"after,omitempty"`
+	Changes      []FieldChangeResponse `json:"changes,omitempty"`
+	RequestID    string                `json:"request_id"`
+	IP           string                `json:"ip"`
+	UserAgent    string                `json:"user_agent"`
+	Method       string                `json:"method,omitempty"`
+	Path         string                `json:"path,omitempty"`
+	StatusCode   int                   `json:"status_code,omitempty"`
+	OccurredAt   time.Time             `json:"occurred_at"`
+}
+
+type ListEventsResponse struct {
+	Events []AuditEventResponse `json:"events"`
+	Limit  int                  `json:"limit"`
+	Offset int                  `json:"offset"`
+}
+
+func ToAuditEventResponse(e *domain.AuditEvent) AuditEventResponse {
+	changes := make([]FieldChangeResponse, 0, len(e.Changes))
+	for _, c := range e.Changes {
+		changes = append(changes, FieldChangeResponse{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+
+	return AuditEventResponse{
+		ID:           e.ID,
+		ActorID:      e.ActorID,
+		ActorType:    e.ActorType,
+		Action:       string(e.Action),
+		ResourceType: e.ResourceType,
+		ResourceID:   e.ResourceID,
+		Before:       e.Before,
+		After:        e.After,
+		Changes:      changes,
+		RequestID:    e.RequestID,
+		IP:           e.IP,
+		UserAgent:    e.UserAgent,
+		Method:       e.Method,
+		Path:         e.Path,
+		StatusCode:   e.StatusCode,
+		OccurredAt:   e.OccurredAt,
+	}
+}
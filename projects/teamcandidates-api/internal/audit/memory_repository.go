@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+type memoryRepository struct {
+	events *mapdb.Table[models.AuditEvent]
+}
+
+// NewMemoryRepository crea el repositorio de auditoría sobre la base en memoria.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		events: mapdb.NewTable(db, auditCollection,
+			func(e *models.AuditEvent) string { return e.ID.Hex() },
+		),
+	}
+}
+
+func (r *memoryRepository) AppendEvent(ctx context.Context, event *domain.AuditEvent) (string, error) {
+	model := models.FromDomain(event)
+	model.ID = primitive.NewObjectID()
+
+	if err := r.events.Insert(ctx, model); err != nil {
+		return "", types.NewError(types.ErrOperationFailed, "failed to append audit event", err)
+	}
+	return model.ID.Hex(), nil
+}
+
+// ListEvents aplica el mismo filtro que la versión de Mongo: del más reciente al más antiguo,
+// con Offset y Limit (Limit 0 no limita).
+func (r *memoryRepository) ListEvents(ctx context.Context, filter *domain.Filter) ([]domain.AuditEvent, error) {
+	es, err := r.events.Find(ctx, func(e *models.AuditEvent) bool {
+		return (filter.ActorID == "" || e.ActorID == filter.ActorID) &&
+			(filter.ResourceType == "" || e.ResourceType == filter.ResourceType) &&
+			(filter.ResourceID == "" || e.ResourceID == filter.ResourceID) &&
+			(filter.Action == "" || e.Action == string(filter.Action)) &&
+			(filter.From.IsZero() || !e.OccurredAt.Before(filter.From)) &&
+			(filter.To.IsZero() || !e.OccurredAt.After(filter.To))
+	})
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to query audit events", err)
+	}
+
+	slices.SortStableFunc(es, func(a, b models.AuditEvent) int { return b.OccurredAt.Compare(a.OccurredAt) })
+
+	es = es[min(filter.Offset, len(es)):]
+	if filter.Limit > 0 {
+		es = es[:min(filter.Limit, len(es))]
+	}
+	return models.AuditEventList(es).ToDomain(), nil
+}
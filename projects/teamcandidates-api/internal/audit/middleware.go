@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+// RequestIDHeader permite propagar el ID de request desde un proxy o gateway.
+const RequestIDHeader = "X-Request-ID"
+
+// recordTimeout acota la escritura del evento HTTP, que ocurre cuando la respuesta ya fue escrita.
+const recordTimeout = 5 * time.Second
+
+// NewMiddleware deja los datos de la request en el context (para los hooks de los use cases)
+// y registra un evento por cada request mutante (POST, PUT, PATCH, DELETE).
+// Es idempotente: si ya se ejecutó para la request, no hace nada.
+func NewMiddleware(ucs UseCases) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := domain.RequestInfoFromContext(c.Request.Context()); ok {
+			c.Next()
+			return
+		}
+
+		info := &domain.RequestInfo{
+			RequestID: requestID(c),
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			Method:    c.Request.Method,
+			Path:      c.Request.URL.Path,
+		}
+		c.Header(RequestIDHeader, info.RequestID)
+		c.Request = c.Request.WithContext(domain.WithRequestInfo(c.Request.Context(), info))
+
+		c.Next()
+
+		if !isMutating(c.Request.Method) {
+			return
+		}
+
+		event := &domain.AuditEvent{
+			Action:       domain.ActionHttpRequest,
+			ResourceType: c.FullPath(),
+			ResourceID:   c.Param("id"),
+			StatusCode:   c.Writer.Status(),
+		}
+		if principal, err := mdw.GetPrincipal(c); err == nil {
+			event.ActorID = principal.ID
+			event.ActorType = string(principal.Type)
+		}
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), recordTimeout)
+		defer cancel()
+		if err := ucs.Record(ctx, event); err != nil {
+			log.Printf("audit: failed to record request %s %s: %v", info.Method, info.Path, err)
+		}
+	}
+}
+
+func requestID(c *gin.Context) string {
+	if id := c.GetString("RequestID"); id != "" {
+		return id
+	}
+	if id := c.GetHeader(RequestIDHeader); id != "" {
+		return id
+	}
+	return uuid.New().String()
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"context"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+type UseCases interface {
+	Record(context.Context, *domain.AuditEvent) error
+	RecordChange(context.Context, domain.Action, string, string, any, any)
+	ListEvents(context.Context, *domain.Filter) ([]domain.AuditEvent, error)
+}
+
+// Repository es append-only: no expone operaciones de actualización ni borrado.
+type Repository interface {
+	AppendEvent(context.Context, *domain.AuditEvent) (string, error)
+	ListEvents(context.Context, *domain.Filter) ([]domain.AuditEvent, error)
+}
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_audit.go -package=mocks
@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	mng "github.com/teamcubation/teamcandidates/pkg/databases/nosql/mongodb/mongo-driver"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+const auditCollection = "audit_events"
+
+// MongoIndexes cubren las consultas del endpoint de auditoría: por actor, por recurso y por rango de fechas.
+var MongoIndexes = []mng.IndexDefinition{
+	{
+		Collection: auditCollection,
+		Name:       "actor_occurred_at",
+		Keys:       []mng.IndexKey{mng.Asc("actor_id"), mng.Desc("occurred_at")},
+	},
+	{
+		Collection: auditCollection,
+		Name:       "resource_occurred_at",
+		Keys:       []mng.IndexKey{mng.Asc("resource_type"), mng.Asc("resource_id"), mng.Desc("occurred_at")},
+	},
+	{
+		Collection: auditCollection,
+		Name:       "occurred_at",
+		Keys:       []mng.IndexKey{mng.Desc("occurred_at")},
+	},
+}
+
+type mongoRepository struct {
+	events *mng.Collection[models.AuditEvent]
+}
+
+func NewRepository(r mng.Repository) Repository {
+	return &mongoRepository{
+		events: mng.NewCollection[models.AuditEvent](r, auditCollection),
+	}
+}
+
+func (r *mongoRepository) AppendEvent(ctx context.Context, event *domain.AuditEvent) (string, error) {
+	id, err := r.events.InsertOne(ctx, models.FromDomain(event))
+	if err != nil {
+		return "", types.NewError(types.ErrOperationFailed, "failed to append audit event", err)
+	}
+	return id, nil
+}
+
+func (r *mongoRepository) ListEvents(ctx context.Context, filter *domain.Filter) ([]domain.AuditEvent, error) {
+	query := bson.M{}
+	if filter.ActorID != "" {
+		query["actor_id"] = filter.ActorID
+	}
+	if filter.ResourceType != "" {
+		query["resource_type"] = filter.ResourceType
+	}
+	if filter.ResourceID != "" {
+		query["resource_id"] = filter.ResourceID
+	}
+	if filter.Action != "" {
+		query["action"] = string(filter.Action)
+	}
+	if !filter.From.IsZero() || !filter.To.IsZero() {
+		occurred := bson.M{}
+		if !filter.From.IsZero() {
+			occurred["$gte"] = filter.From
+		}
+		if !filter.To.IsZero() {
+			occurred["$lte"] = filter.To
+		}
+		query["occurred_at"] = occurred
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "occurred_at", Value: -1}}).
+		SetSkip(int64(filter.Offset)).
+		SetLimit(int64(filter.Limit))
+
+	es, err := r.events.Find(ctx, query, opts)
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to query audit events", err)
+	}
+
+	return models.AuditEventList(es).ToDomain(), nil
+}
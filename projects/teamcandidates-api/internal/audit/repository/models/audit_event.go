@@ -0,0 +1,103 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+type AuditEvent struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	ActorID      string             `bson:"actor_id"`
+	ActorType    string             `bson:"actor_type"`
+	Action       string             `bson:"action"`
+	ResourceType string             `bson:"resource_type"`
+	ResourceID   string             `bson:"resource_id"`
+	Before       map[string]any     `bson:"before,omitempty"`
+	After        map[string]any     `bson:"after,omitempty"`
+	Changes      []FieldChange      `bson:"changes,omitempty"`
+	RequestID    string             `bson:"request_id"`
+	IP           string             `bson:"ip"`
+	UserAgent    string             `bson:"user_agent"`
+	Method       string             `bson:"method,omitempty"`
+	Path         string             `bson:"path,omitempty"`
+	StatusCode   int                `bson:"status_code,omitempty"`
+	OccurredAt   time.Time          `bson:"occurred_at"`
+}
+
+type FieldChange struct {
+	Field  string `bson:"field"`
+	Before any    `bson:"before"`
+	After  any    `bson:"after"`
+}
+
+type AuditEventList []AuditEvent
+
+func FromDomain(e *domain.AuditEvent) *AuditEvent {
+	changes := make([]FieldChange, 0, len(e.Changes))
+	for _, c := range e.Changes {
+		changes = append(changes, FieldChange{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+
+	return &AuditEvent{
+		ActorID:      e.ActorID,
+		ActorType:    e.ActorType,
+		Action:       string(e.Action),
+		ResourceType: e.ResourceType,
+		ResourceID:   e.ResourceID,
+		Before:       e.Before,
+		After:        e.After,
+		Changes:      changes,
+		RequestID:    e.RequestID,
+		IP:           e.IP,
+		UserAgent:    e.UserAgent,
+		Method:       e.Method,
+		Path:         e.Path,
+		StatusCode:   e.StatusCode,
+		OccurredAt:   e.OccurredAt,
+	}
+}
+
+func (m *AuditEvent) ToDomain() *domain.AuditEvent {
+	changes := make([]domain.FieldChange, 0, len(m.Changes))
+	for _, c := range m.Changes {
+		changes = append(changes, domain.FieldChange{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+
+	return &domain.AuditEvent{
+		ID:           m.ID.Hex(),
+		ActorID:      m.ActorID,
+		ActorType:    m.ActorType,
+		Action:       domain.Action(m.Action),
+		ResourceType: m.ResourceType,
+		ResourceID:   m.ResourceID,
+		Before:       m.Before,
+		After:        m.After,
+		Changes:      changes,
+		RequestID:    m.RequestID,
+		IP:           m.IP,
+		UserAgent:    m.UserAgent,
+		Method:       m.Method,
+		Path:         m.Path,
+		StatusCode:   m.StatusCode,
+		OccurredAt:   m.OccurredAt,
+	}
+}
+
+func (l AuditEventList) ToDomain() []domain.AuditEvent {
+	events := make([]domain.AuditEvent, 0, len(l))
+	for i := range l {
+		events = append(events, *l[i].ToDomain())
+	}
+	return events
+}
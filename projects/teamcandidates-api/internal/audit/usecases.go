@@ -0,0 +1,108 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/support"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+type useCases struct {
+	repository Repository
+}
+
+func NewUseCases(r Repository) UseCases {
+	return &useCases{
+		repository: r,
+	}
+}
+
+// Record agrega un evento al log completando actor y datos de la request desde el context.
+func (u *useCases) Record(ctx context.Context, event *domain.AuditEvent) error {
+	if event == nil {
+		return types.NewMissingFieldError("audit event")
+	}
+	if event.Action == "" {
+		return types.NewMissingFieldError("action")
+	}
+
+	if event.ActorID == "" {
+		if principal, ok := types.PrincipalFromContext(ctx); ok {
+			event.ActorID = principal.ID
+			event.ActorType = string(principal.Type)
+		}
+	}
+	if info, ok := domain.RequestInfoFromContext(ctx); ok {
+		if event.RequestID == "" {
+			event.RequestID = info.RequestID
+		}
+		if event.IP == "" {
+			event.IP = info.IP
+		}
+		if event.UserAgent == "" {
+			event.UserAgent = info.UserAgent
+		}
+		if event.Method == "" {
+			event.Method = info.Method
+		}
+		if event.Path == "" {
+			event.Path = info.Path
+		}
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+	if event.Changes == nil && (event.Before != nil || event.After != nil) {
+		event.Changes = support.Diff(event.Before, event.After)
+	}
+
+	id, err := u.repository.AppendEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+	event.ID = id
+	return nil
+}
+
+// RecordChange registra un cambio sobre un recurso con su diff before/after.
+// Es best-effort: un fallo del log de auditoría no debe hacer fallar la operación de negocio.
+func (u *useCases) RecordChange(ctx context.Context, action domain.Action, resourceType, resourceID string, before, after any) {
+	event := &domain.AuditEvent{
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Before:       support.Snapshot(before),
+		After:        support.Snapshot(after),
+	}
+	if err := u.Record(ctx, event); err != nil {
+		log.Printf("audit: failed to record %s on %s/%s: %v", action, resourceType, resourceID, err)
+	}
+}
+
+func (u *useCases) ListEvents(ctx context.Context, filter *domain.Filter) ([]domain.AuditEvent, error) {
+	if filter == nil {
+		filter = &domain.Filter{}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+		return nil, types.NewError(types.ErrInvalidInput, "'from' must be before 'to'", nil)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return u.repository.ListEvents(ctx, filter)
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Action string
+
+const (
+	ActionCreate        Action = "create"
+	ActionUpdate        Action = "update"
+	ActionDelete        Action = "delete"
+	ActionRestore       Action = "restore"
+	ActionPurge         Action = "purge"
+	ActionLogin         Action = "auth.login"
+	ActionLoginFailed   Action = "auth.login_failed"
+	ActionPasswordReset Action = "auth.password_reset"
+	ActionHttpRequest   Action = "http.request"
+)
+
+// AuditEvent es una entrada inmutable del log de auditoría.
+type AuditEvent struct {
+	ID           string
+	ActorID      string
+	ActorType    string
+	Action       Action
+	ResourceType string
+	ResourceID   string
+	Before       map[string]any
+	After        map[string]any
+	Changes      []FieldChange
+	RequestID    string
+	IP           string
+	UserAgent    string
+	Method       string
+	Path         string
+	StatusCode   int
+	OccurredAt   time.Time
+}
+
+// FieldChange es la diferencia de un campo entre Before y After.
+type FieldChange struct {
+	Field  string
+	Before any
+	After  any
+}
+
+// Filter son los criterios de búsqueda para las revisiones de compliance.
+type Filter struct {
+	ActorID      string
+	ResourceType string
+	ResourceID   string
+	Action       Action
+	From         time.Time
+	To           time.Time
+	Limit        int
+	Offset       int
+}
+
+// RequestInfo son los datos de la request HTTP que se adjuntan a cada evento.
+type RequestInfo struct {
+	RequestID string
+	IP        string
+	UserAgent string
+	Method    string
+	Path      string
+}
+
+type requestInfoCtxKey struct{}
+
+// WithRequestInfo guarda los datos de la request en el context para que los use cases los adjunten al evento.
+func WithRequestInfo(ctx context.Context, info *RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoCtxKey{}, info)
+}
+
+// RequestInfoFromContext recupera los datos guardados con WithRequestInfo.
+func RequestInfoFromContext(ctx context.Context) (*RequestInfo, bool) {
+	info, ok := ctx.Value(requestInfoCtxKey{}).(*RequestInfo)
+	return info, ok && info != nil
+}
@@ -0,0 +1,99 @@
+package support
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+// redactedValue reemplaza los valores sensibles en los snapshots.
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeys son fragmentos de nombres de campo cuyo valor nunca se guarda en el log.
+var sensitiveKeys = []string{"password", "secret", "token", "hash"}
+
+// Snapshot convierte una entidad en un mapa serializable, con los campos sensibles redactados.
+func Snapshot(v any) map[string]any {
+	if v == nil {
+		return nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var snapshot map[string]any
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil
+	}
+
+	redact(snapshot)
+	return snapshot
+}
+
+// Diff devuelve los campos de primer nivel que cambiaron entre before y after, ordenados por nombre.
+func Diff(before, after map[string]any) []domain.FieldChange {
+	keys := make(map[string]struct{})
+	for k := range before {
+		keys[k] = struct{}{}
+	}
+	for k := range after {
+		keys[k] = struct{}{}
+	}
+
+	fields := make([]string, 0, len(keys))
+	for k := range keys {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+
+	changes := make([]domain.FieldChange, 0)
+	for _, field := range fields {
+		b, a := before[field], after[field]
+		if reflect.DeepEqual(b, a) {
+			continue
+		}
+		changes = append(changes, domain.FieldChange{
+			Field:  field,
+			Before: b,
+			After:  a,
+		})
+	}
+	return changes
+}
+
+func redact(m map[string]any) {
+	for k, v := range m {
+		if isSensitive(k) {
+			m[k] = redactedValue
+			continue
+		}
+		switch nested := v.(type) {
+		case map[string]any:
+			redact(nested)
+		case []any:
+			for _, item := range nested {
+				if im, ok := item.(map[string]any); ok {
+					redact(im)
+				}
+			}
+		}
+	}
+}
+
+func isSensitive(key string) bool {
+	lower := strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(lower, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"context"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+type noopUseCases struct{}
+
+// NewNoopUseCases devuelve una implementación que descarta los eventos.
+// Útil en tests y herramientas que construyen use cases sin infraestructura de auditoría.
+func NewNoopUseCases() UseCases {
+	return noopUseCases{}
+}
+
+func (noopUseCases) Record(context.Context, *domain.AuditEvent) error { return nil }
+
+func (noopUseCases) RecordChange(context.Context, domain.Action, string, string, any, any) {}
+
+func (noopUseCases) ListEvents(context.Context, *domain.Filter) ([]domain.AuditEvent, error) {
+	return []domain.AuditEvent{}, nil
+}
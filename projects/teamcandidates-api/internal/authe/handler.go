@@ -8,8 +8,11 @@ import (
 	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
 	gsv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
 
 	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/handler/dto"
+	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/handler/support"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
 )
 
 type Handler struct {
@@ -40,6 +43,12 @@ func (h *Handler) Routes() {
 	public := router.Group(publicPrefix)
 	{
 		public.POST("", h.Login)
+		public.POST("/mfa/verify", h.VerifyMfa) // Segundo paso del login con MFA
+
+		public.POST("/email-verification", h.RequestEmailVerification)
+		public.POST("/email-verification/confirm", h.ConfirmEmailVerification)
+		public.POST("/password-reset", h.RequestPasswordReset)
+		public.POST("/password-reset/confirm", h.ConfirmPasswordReset)
 	}
 
 	validated := router.Group(validatedPrefix)
@@ -56,6 +65,11 @@ func (h *Handler) Routes() {
 		protected.Use(h.mws.Protected...)
 
 		protected.GET("/ping", h.ProtectedPing)
+
+		protected.POST("/mfa/enroll", h.EnrollMfa)                                                       // Generar secreto TOTP y URI otpauth
+		protected.POST("/mfa/confirm", h.ConfirmMfa)                                                     // Activar MFA con el primer código
+		protected.POST("/mfa/recovery-codes", h.RegenerateRecoveryCodes)                                 // Regenerar códigos de recuperación
+		protected.DELETE("/mfa/users/:id", mdw.RequirePermission(types.PermissionUserAdmin), h.ResetMfa) // Reset administrativo del MFA de un usuario
 	}
 }
 
@@ -122,6 +136,11 @@ func (h *Handler) pepLogin(c *gin.Context, credentials types.LoginCredentials) {
 		return
 	}
 
+	if token.TokenType == domain.TokenTypePreAuth {
+		respondMfaChallenge(c, token)
+		return
+	}
+
 	c.JSON(http.StatusOK, dto.LoginResponse{
 		AccessToken:     token.AccessToken,
 		AccessExpiresAt: token.AccessExpiresAt,
@@ -135,8 +154,14 @@ func (h *Handler) jwtLogin(c *gin.Context, credentials types.LoginCredentials) {
 		return
 	}
 
+	if token.TokenType == domain.TokenTypePreAuth {
+		respondMfaChallenge(c, token)
+		return
+	}
+
 	c.JSON(http.StatusOK, dto.LoginResponse{
-		AccessToken: token.AccessToken,
+		AccessToken:     token.AccessToken,
+		AccessExpiresAt: token.AccessExpiresAt,
 	})
 }
 
@@ -145,3 +170,204 @@ func (h *Handler) auth0Login(c *gin.Context, credentials types.LoginCredentials)
 		Message: "Auth0 coming soon!",
 	})
 }
+
+func (h *Handler) VerifyMfa(c *gin.Context) {
+	var req dto.VerifyMfa
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	token, err := h.ucs.VerifyMfa(c.Request.Context(), req.PreAuthToken, req.Code)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.LoginResponse{
+		AccessToken:     token.AccessToken,
+		AccessExpiresAt: token.AccessExpiresAt,
+	})
+}
+
+func (h *Handler) EnrollMfa(c *gin.Context) {
+	userID, err := support.GetUserIDFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	enrollment, err := h.ucs.EnrollMfa(c.Request.Context(), userID)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.EnrollMfaResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	})
+}
+
+func (h *Handler) ConfirmMfa(c *gin.Context) {
+	userID, err := support.GetUserIDFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	var req dto.MfaCode
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	recoveryCodes, err := h.ucs.ConfirmMfa(c.Request.Context(), userID, req.Code)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RecoveryCodesResponse{
+		Message:       "MFA enabled successfully, store the recovery codes in a safe place",
+		RecoveryCodes: recoveryCodes,
+	})
+}
+
+func (h *Handler) RegenerateRecoveryCodes(c *gin.Context) {
+	userID, err := support.GetUserIDFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	var req dto.MfaCode
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	recoveryCodes, err := h.ucs.RegenerateRecoveryCodes(c.Request.Context(), userID, req.Code)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RecoveryCodesResponse{
+		Message:       "Recovery codes regenerated successfully",
+		RecoveryCodes: recoveryCodes,
+	})
+}
+
+func (h *Handler) ResetMfa(c *gin.Context) {
+	userID := c.Param("id")
+	if err := h.ucs.ResetMfa(c.Request.Context(), userID); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "MFA reset successfully",
+	})
+}
+
+func (h *Handler) RequestEmailVerification(c *gin.Context) {
+	var req dto.AccountEmail
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	if err := h.ucs.RequestEmailVerification(c.Request.Context(), req.Email); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	// Respuesta genérica para no revelar si la cuenta existe
+	c.JSON(http.StatusAccepted, types.MessageResponse{
+		Message: "If the account exists and is not verified, a verification email has been sent",
+	})
+}
+
+func (h *Handler) ConfirmEmailVerification(c *gin.Context) {
+	var req dto.ActionToken
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	if err := h.ucs.ConfirmEmailVerification(c.Request.Context(), req.Token); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Email verified successfully",
+	})
+}
+
+func (h *Handler) RequestPasswordReset(c *gin.Context) {
+	var req dto.AccountEmail
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	if err := h.ucs.RequestPasswordReset(c.Request.Context(), req.Email); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	// Respuesta genérica para no revelar si la cuenta existe
+	c.JSON(http.StatusAccepted, types.MessageResponse{
+		Message: "If the account exists, a password reset email has been sent",
+	})
+}
+
+func (h *Handler) ConfirmPasswordReset(c *gin.Context) {
+	var req dto.PasswordReset
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	if err := h.ucs.ConfirmPasswordReset(c.Request.Context(), req.Token, req.NewPassword); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Password reset successfully",
+	})
+}
+
+// respondMfaChallenge responde con el token de pre-autenticación cuando el login requiere segundo factor.
+func respondMfaChallenge(c *gin.Context, token *domain.Token) {
+	c.JSON(http.StatusAccepted, dto.MfaChallengeResponse{
+		MfaRequired:  true,
+		PreAuthToken: token.AccessToken,
+		ExpiresAt:    token.AccessExpiresAt,
+	})
+}
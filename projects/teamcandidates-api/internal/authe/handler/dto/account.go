@@ -0,0 +1,14 @@
+package dto
+
+type AccountEmail struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ActionToken struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type PasswordReset struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
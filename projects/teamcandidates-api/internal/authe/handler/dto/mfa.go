@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+type VerifyMfa struct {
+	PreAuthToken string `json:"pre_auth_token" binding:"required"`
+	Code         string `json:"code" binding:"required"`
+}
+
+type MfaCode struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// Response
+type MfaChallengeResponse struct {
+	MfaRequired  bool      `json:"mfa_required"`
+	PreAuthToken string    `json:"pre_auth_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+type EnrollMfaResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"otpauth_uri"` // payload del código QR
+}
+
+type RecoveryCodesResponse struct {
+	Message       string   `json:"message"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
@@ -1,5 +1,27 @@
 package support
 
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+)
+
+// GetUserIDFromToken obtiene el claim "sub" del JWT validado por el middleware de rutas protegidas.
+func GetUserIDFromToken(c *gin.Context) (string, error) {
+	tokenInterface, exists := c.Get(utils.DefaultContextKey)
+	if !exists {
+		return "", errors.New("token not found in context")
+	}
+	token, ok := tokenInterface.(*jwt.Token)
+	if !ok {
+		return "", errors.New("invalid token type in context")
+	}
+	return utils.ExtractClaim(token, "sub")
+}
+
 // func afipJwtData(c *gin.Context) (string, error) {
 // 	// Obtener el token del contexto, ya validado por el middleware
 // 	token, _ := c.Get("token")
@@ -21,6 +21,7 @@ func ToTokenDomain(token *jwt.Token) *domain.Token {
 func ToTokenClaimsDomain(token *jwt.TokenClaims) *domain.TokenClaims {
 	return &domain.TokenClaims{
 		Subject:   token.Subject,
+		Purpose:   token.Purpose,
 		ExpiresAt: token.ExpiresAt,
 		IssuedAt:  token.IssuedAt,
 	}
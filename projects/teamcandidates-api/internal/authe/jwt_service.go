@@ -39,18 +39,29 @@ func (j *jwtService) GenerateHrTokens(ctx context.Context, userID string) (*doma
 	return dto.ToTokenDomain(jwtToken), nil
 }
 
-func (j *jwtService) GenerateLinkTokens(ctx context.Context, userID string) (*domain.Token, error) {
+// GenerateLinkTokens emite el token de alcance restringido de un link de evaluación. El vencimiento
+// efectivo lo gobierna el link, que puede extenderse sin reemitir el token.
+func (j *jwtService) GenerateLinkTokens(ctx context.Context, assessmentID string) (*domain.Token, error) {
 	accessExp := j.config.GetAssessmentConfig().AccessExpirationMinutes
-	refreshExp := j.config.GetAssessmentConfig().RefreshExpirationMinutes
 
-	jwtToken, err := j.jwtService.GenerateTokens(ctx, userID, accessExp, refreshExp)
+	jwtToken, err := j.jwtService.GenerateScopedToken(ctx, assessmentID, domain.TokenPurposeAssessmentLink, accessExp)
 	if err != nil {
-		return nil, fmt.Errorf("error trying to generate tokens: %w", err)
+		return nil, fmt.Errorf("error trying to generate link token: %w", err)
 	}
 
 	return dto.ToTokenDomain(jwtToken), nil
 }
 
+// ValidateLinkToken verifica la firma de un token de link aunque haya vencido: el vencimiento lo
+// controla el link guardado.
+func (j *jwtService) ValidateLinkToken(ctx context.Context, token string) (*domain.TokenClaims, error) {
+	jwtClaims, err := j.jwtService.ValidateTokenAllowExpired(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("error trying to validate link token: %w", err)
+	}
+	return dto.ToTokenClaimsDomain(jwtClaims), nil
+}
+
 func (j *jwtService) ValidateToken(ctx context.Context, token string) (*domain.TokenClaims, error) {
 	jwtClaims, err := j.jwtService.ValidateToken(ctx, token)
 	if err != nil {
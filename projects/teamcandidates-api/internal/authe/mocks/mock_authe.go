@@ -0,0 +1,685 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// Auth0Login mocks base method.
+func (m *MockUseCases) Auth0Login(arg0 context.Context, arg1, arg2, arg3 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth0Login", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Auth0Login indicates an expected call of Auth0Login.
+func (mr *MockUseCasesMockRecorder) Auth0Login(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth0Login", reflect.TypeOf((*MockUseCases)(nil).Auth0Login), arg0, arg1, arg2, arg3)
+}
+
+// ConfirmEmailVerification mocks base method.
+func (m *MockUseCases) ConfirmEmailVerification(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailVerification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmEmailVerification indicates an expected call of ConfirmEmailVerification.
+func (mr *MockUseCasesMockRecorder) ConfirmEmailVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailVerification", reflect.TypeOf((*MockUseCases)(nil).ConfirmEmailVerification), arg0, arg1)
+}
+
+// ConfirmMfa mocks base method.
+func (m *MockUseCases) ConfirmMfa(arg0 context.Context, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmMfa", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmMfa indicates an expected call of ConfirmMfa.
+func (mr *MockUseCasesMockRecorder) ConfirmMfa(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMfa", reflect.TypeOf((*MockUseCases)(nil).ConfirmMfa), arg0, arg1, arg2)
+}
+
+// ConfirmPasswordReset mocks base method.
+func (m *MockUseCases) ConfirmPasswordReset(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPasswordReset", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmPasswordReset indicates an expected call of ConfirmPasswordReset.
+func (mr *MockUseCasesMockRecorder) ConfirmPasswordReset(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPasswordReset", reflect.TypeOf((*MockUseCases)(nil).ConfirmPasswordReset), arg0, arg1, arg2)
+}
+
+// EnrollMfa mocks base method.
+func (m *MockUseCases) EnrollMfa(arg0 context.Context, arg1 string) (*domain.MfaEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMfa", arg0, arg1)
+	ret0, _ := ret[0].(*domain.MfaEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMfa indicates an expected call of EnrollMfa.
+func (mr *MockUseCasesMockRecorder) EnrollMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMfa", reflect.TypeOf((*MockUseCases)(nil).EnrollMfa), arg0, arg1)
+}
+
+// GenerateLinkTokens mocks base method.
+func (m *MockUseCases) GenerateLinkTokens(arg0 context.Context, arg1 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateLinkTokens", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateLinkTokens indicates an expected call of GenerateLinkTokens.
+func (mr *MockUseCasesMockRecorder) GenerateLinkTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLinkTokens", reflect.TypeOf((*MockUseCases)(nil).GenerateLinkTokens), arg0, arg1)
+}
+
+// JwtLogin mocks base method.
+func (m *MockUseCases) JwtLogin(arg0 context.Context, arg1, arg2, arg3 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JwtLogin", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JwtLogin indicates an expected call of JwtLogin.
+func (mr *MockUseCasesMockRecorder) JwtLogin(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JwtLogin", reflect.TypeOf((*MockUseCases)(nil).JwtLogin), arg0, arg1, arg2, arg3)
+}
+
+// PepLogin mocks base method.
+func (m *MockUseCases) PepLogin(arg0 context.Context, arg1, arg2, arg3 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PepLogin", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PepLogin indicates an expected call of PepLogin.
+func (mr *MockUseCasesMockRecorder) PepLogin(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PepLogin", reflect.TypeOf((*MockUseCases)(nil).PepLogin), arg0, arg1, arg2, arg3)
+}
+
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockUseCases) RegenerateRecoveryCodes(arg0 context.Context, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockUseCasesMockRecorder) RegenerateRecoveryCodes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockUseCases)(nil).RegenerateRecoveryCodes), arg0, arg1, arg2)
+}
+
+// RequestEmailVerification mocks base method.
+func (m *MockUseCases) RequestEmailVerification(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestEmailVerification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestEmailVerification indicates an expected call of RequestEmailVerification.
+func (mr *MockUseCasesMockRecorder) RequestEmailVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailVerification", reflect.TypeOf((*MockUseCases)(nil).RequestEmailVerification), arg0, arg1)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockUseCases) RequestPasswordReset(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockUseCasesMockRecorder) RequestPasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockUseCases)(nil).RequestPasswordReset), arg0, arg1)
+}
+
+// ResetMfa mocks base method.
+func (m *MockUseCases) ResetMfa(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetMfa", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetMfa indicates an expected call of ResetMfa.
+func (mr *MockUseCasesMockRecorder) ResetMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetMfa", reflect.TypeOf((*MockUseCases)(nil).ResetMfa), arg0, arg1)
+}
+
+// TokenRevoked mocks base method.
+func (m *MockUseCases) TokenRevoked(arg0 context.Context, arg1 string, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokenRevoked", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TokenRevoked indicates an expected call of TokenRevoked.
+func (mr *MockUseCasesMockRecorder) TokenRevoked(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenRevoked", reflect.TypeOf((*MockUseCases)(nil).TokenRevoked), arg0, arg1, arg2)
+}
+
+// ValidateLinkToken mocks base method.
+func (m *MockUseCases) ValidateLinkToken(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateLinkToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateLinkToken indicates an expected call of ValidateLinkToken.
+func (mr *MockUseCasesMockRecorder) ValidateLinkToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateLinkToken", reflect.TypeOf((*MockUseCases)(nil).ValidateLinkToken), arg0, arg1)
+}
+
+// VerifyMfa mocks base method.
+func (m *MockUseCases) VerifyMfa(arg0 context.Context, arg1, arg2 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMfa", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMfa indicates an expected call of VerifyMfa.
+func (mr *MockUseCasesMockRecorder) VerifyMfa(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMfa", reflect.TypeOf((*MockUseCases)(nil).VerifyMfa), arg0, arg1, arg2)
+}
+
+// MockJwtService is a mock of JwtService interface.
+type MockJwtService struct {
+	ctrl     *gomock.Controller
+	recorder *MockJwtServiceMockRecorder
+}
+
+// MockJwtServiceMockRecorder is the mock recorder for MockJwtService.
+type MockJwtServiceMockRecorder struct {
+	mock *MockJwtService
+}
+
+// NewMockJwtService creates a new mock instance.
+func NewMockJwtService(ctrl *gomock.Controller) *MockJwtService {
+	mock := &MockJwtService{ctrl: ctrl}
+	mock.recorder = &MockJwtServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJwtService) EXPECT() *MockJwtServiceMockRecorder {
+	return m.recorder
+}
+
+// ExtractClaimsFromExternalToken mocks base method.
+func (m *MockJwtService) ExtractClaimsFromExternalToken(arg0 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractClaimsFromExternalToken", arg0)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractClaimsFromExternalToken indicates an expected call of ExtractClaimsFromExternalToken.
+func (mr *MockJwtServiceMockRecorder) ExtractClaimsFromExternalToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractClaimsFromExternalToken", reflect.TypeOf((*MockJwtService)(nil).ExtractClaimsFromExternalToken), arg0)
+}
+
+// GenerateHrTokens mocks base method.
+func (m *MockJwtService) GenerateHrTokens(arg0 context.Context, arg1 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHrTokens", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHrTokens indicates an expected call of GenerateHrTokens.
+func (mr *MockJwtServiceMockRecorder) GenerateHrTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHrTokens", reflect.TypeOf((*MockJwtService)(nil).GenerateHrTokens), arg0, arg1)
+}
+
+// GenerateLinkTokens mocks base method.
+func (m *MockJwtService) GenerateLinkTokens(arg0 context.Context, arg1 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateLinkTokens", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateLinkTokens indicates an expected call of GenerateLinkTokens.
+func (mr *MockJwtServiceMockRecorder) GenerateLinkTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLinkTokens", reflect.TypeOf((*MockJwtService)(nil).GenerateLinkTokens), arg0, arg1)
+}
+
+// GetAccessExpiration mocks base method.
+func (m *MockJwtService) GetAccessExpiration(arg0 context.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessExpiration", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetAccessExpiration indicates an expected call of GetAccessExpiration.
+func (mr *MockJwtServiceMockRecorder) GetAccessExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessExpiration", reflect.TypeOf((*MockJwtService)(nil).GetAccessExpiration), arg0)
+}
+
+// GetRefreshExpiration mocks base method.
+func (m *MockJwtService) GetRefreshExpiration(arg0 context.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshExpiration", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetRefreshExpiration indicates an expected call of GetRefreshExpiration.
+func (mr *MockJwtServiceMockRecorder) GetRefreshExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshExpiration", reflect.TypeOf((*MockJwtService)(nil).GetRefreshExpiration), arg0)
+}
+
+// ValidateLinkToken mocks base method.
+func (m *MockJwtService) ValidateLinkToken(arg0 context.Context, arg1 string) (*domain.TokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateLinkToken", arg0, arg1)
+	ret0, _ := ret[0].(*domain.TokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateLinkToken indicates an expected call of ValidateLinkToken.
+func (mr *MockJwtServiceMockRecorder) ValidateLinkToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateLinkToken", reflect.TypeOf((*MockJwtService)(nil).ValidateLinkToken), arg0, arg1)
+}
+
+// ValidateToken mocks base method.
+func (m *MockJwtService) ValidateToken(arg0 context.Context, arg1 string) (*domain.TokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateToken", arg0, arg1)
+	ret0, _ := ret[0].(*domain.TokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateToken indicates an expected call of ValidateToken.
+func (mr *MockJwtServiceMockRecorder) ValidateToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockJwtService)(nil).ValidateToken), arg0, arg1)
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder struct {
+	mock *MockCache
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache(ctrl *gomock.Controller) *MockCache {
+	mock := &MockCache{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache) EXPECT() *MockCacheMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockCache) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockCacheMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCache)(nil).Close))
+}
+
+// ConsumeActionToken mocks base method.
+func (m *MockCache) ConsumeActionToken(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeActionToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeActionToken indicates an expected call of ConsumeActionToken.
+func (mr *MockCacheMockRecorder) ConsumeActionToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeActionToken", reflect.TypeOf((*MockCache)(nil).ConsumeActionToken), arg0, arg1)
+}
+
+// ConsumePreAuthToken mocks base method.
+func (m *MockCache) ConsumePreAuthToken(arg0 context.Context, arg1 string) (string, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumePreAuthToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConsumePreAuthToken indicates an expected call of ConsumePreAuthToken.
+func (mr *MockCacheMockRecorder) ConsumePreAuthToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumePreAuthToken", reflect.TypeOf((*MockCache)(nil).ConsumePreAuthToken), arg0, arg1)
+}
+
+// DeletePreAuthToken mocks base method.
+func (m *MockCache) DeletePreAuthToken(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePreAuthToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePreAuthToken indicates an expected call of DeletePreAuthToken.
+func (mr *MockCacheMockRecorder) DeletePreAuthToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePreAuthToken", reflect.TypeOf((*MockCache)(nil).DeletePreAuthToken), arg0, arg1)
+}
+
+// IncrementPreAuthFailures mocks base method.
+func (m *MockCache) IncrementPreAuthFailures(arg0 context.Context, arg1 string, arg2 time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementPreAuthFailures", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementPreAuthFailures indicates an expected call of IncrementPreAuthFailures.
+func (mr *MockCacheMockRecorder) IncrementPreAuthFailures(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementPreAuthFailures", reflect.TypeOf((*MockCache)(nil).IncrementPreAuthFailures), arg0, arg1, arg2)
+}
+
+// RetrieveToken mocks base method.
+func (m *MockCache) RetrieveToken(arg0 context.Context, arg1 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveToken", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveToken indicates an expected call of RetrieveToken.
+func (mr *MockCacheMockRecorder) RetrieveToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveToken", reflect.TypeOf((*MockCache)(nil).RetrieveToken), arg0, arg1)
+}
+
+// RevokeUserTokens mocks base method.
+func (m *MockCache) RevokeUserTokens(arg0 context.Context, arg1 string, arg2 time.Time, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokens", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokens indicates an expected call of RevokeUserTokens.
+func (mr *MockCacheMockRecorder) RevokeUserTokens(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokens", reflect.TypeOf((*MockCache)(nil).RevokeUserTokens), arg0, arg1, arg2, arg3)
+}
+
+// StoreActionToken mocks base method.
+func (m *MockCache) StoreActionToken(arg0 context.Context, arg1, arg2 string, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreActionToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreActionToken indicates an expected call of StoreActionToken.
+func (mr *MockCacheMockRecorder) StoreActionToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreActionToken", reflect.TypeOf((*MockCache)(nil).StoreActionToken), arg0, arg1, arg2, arg3)
+}
+
+// StorePreAuthToken mocks base method.
+func (m *MockCache) StorePreAuthToken(arg0 context.Context, arg1, arg2 string, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePreAuthToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePreAuthToken indicates an expected call of StorePreAuthToken.
+func (mr *MockCacheMockRecorder) StorePreAuthToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePreAuthToken", reflect.TypeOf((*MockCache)(nil).StorePreAuthToken), arg0, arg1, arg2, arg3)
+}
+
+// StoreToken mocks base method.
+func (m *MockCache) StoreToken(arg0 context.Context, arg1 string, arg2 *domain.Token) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreToken indicates an expected call of StoreToken.
+func (mr *MockCacheMockRecorder) StoreToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreToken", reflect.TypeOf((*MockCache)(nil).StoreToken), arg0, arg1, arg2)
+}
+
+// TokensRevokedAt mocks base method.
+func (m *MockCache) TokensRevokedAt(arg0 context.Context, arg1 string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokensRevokedAt", arg0, arg1)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TokensRevokedAt indicates an expected call of TokensRevokedAt.
+func (mr *MockCacheMockRecorder) TokensRevokedAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokensRevokedAt", reflect.TypeOf((*MockCache)(nil).TokensRevokedAt), arg0, arg1)
+}
+
+// MockTotpService is a mock of TotpService interface.
+type MockTotpService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTotpServiceMockRecorder
+}
+
+// MockTotpServiceMockRecorder is the mock recorder for MockTotpService.
+type MockTotpServiceMockRecorder struct {
+	mock *MockTotpService
+}
+
+// NewMockTotpService creates a new mock instance.
+func NewMockTotpService(ctrl *gomock.Controller) *MockTotpService {
+	mock := &MockTotpService{ctrl: ctrl}
+	mock.recorder = &MockTotpServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTotpService) EXPECT() *MockTotpServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateSecret mocks base method.
+func (m *MockTotpService) GenerateSecret() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecret")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecret indicates an expected call of GenerateSecret.
+func (mr *MockTotpServiceMockRecorder) GenerateSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecret", reflect.TypeOf((*MockTotpService)(nil).GenerateSecret))
+}
+
+// ProvisioningURI mocks base method.
+func (m *MockTotpService) ProvisioningURI(arg0, arg1 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisioningURI", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ProvisioningURI indicates an expected call of ProvisioningURI.
+func (mr *MockTotpServiceMockRecorder) ProvisioningURI(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisioningURI", reflect.TypeOf((*MockTotpService)(nil).ProvisioningURI), arg0, arg1)
+}
+
+// ValidateCode mocks base method.
+func (m *MockTotpService) ValidateCode(arg0, arg1 string) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCode", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ValidateCode indicates an expected call of ValidateCode.
+func (mr *MockTotpServiceMockRecorder) ValidateCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCode", reflect.TypeOf((*MockTotpService)(nil).ValidateCode), arg0, arg1)
+}
+
+// MockHttpClient is a mock of HttpClient interface.
+type MockHttpClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHttpClientMockRecorder
+}
+
+// MockHttpClientMockRecorder is the mock recorder for MockHttpClient.
+type MockHttpClientMockRecorder struct {
+	mock *MockHttpClient
+}
+
+// NewMockHttpClient creates a new mock instance.
+func NewMockHttpClient(ctrl *gomock.Controller) *MockHttpClient {
+	mock := &MockHttpClient{ctrl: ctrl}
+	mock.recorder = &MockHttpClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHttpClient) EXPECT() *MockHttpClientMockRecorder {
+	return m.recorder
+}
+
+// GetAccessToken mocks base method.
+func (m *MockHttpClient) GetAccessToken(arg0 context.Context, arg1 string, arg2 any) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessToken indicates an expected call of GetAccessToken.
+func (mr *MockHttpClientMockRecorder) GetAccessToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessToken", reflect.TypeOf((*MockHttpClient)(nil).GetAccessToken), arg0, arg1, arg2)
+}
+
+// GetAccessTokenPep mocks base method.
+func (m *MockHttpClient) GetAccessTokenPep(arg0 context.Context, arg1, arg2 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessTokenPep", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessTokenPep indicates an expected call of GetAccessTokenPep.
+func (mr *MockHttpClientMockRecorder) GetAccessTokenPep(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokenPep", reflect.TypeOf((*MockHttpClient)(nil).GetAccessTokenPep), arg0, arg1, arg2)
+}
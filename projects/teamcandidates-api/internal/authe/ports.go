@@ -12,11 +12,27 @@ type UseCases interface {
 	PepLogin(context.Context, string, string, string) (*domain.Token, error)
 	Auth0Login(context.Context, string, string, string) (*domain.Token, error)
 	GenerateLinkTokens(context.Context, string) (*domain.Token, error)
+	ValidateLinkToken(context.Context, string) (string, error)
+
+	// INFO: MFA
+	EnrollMfa(context.Context, string) (*domain.MfaEnrollment, error)
+	ConfirmMfa(context.Context, string, string) ([]string, error)
+	VerifyMfa(context.Context, string, string) (*domain.Token, error)
+	RegenerateRecoveryCodes(context.Context, string, string) ([]string, error)
+	ResetMfa(context.Context, string) error
+
+	// INFO: Account
+	RequestEmailVerification(context.Context, string) error
+	ConfirmEmailVerification(context.Context, string) error
+	RequestPasswordReset(context.Context, string) error
+	ConfirmPasswordReset(context.Context, string, string) error
+	TokenRevoked(context.Context, string, time.Time) (bool, error)
 }
 
 type JwtService interface {
 	GenerateHrTokens(context.Context, string) (*domain.Token, error)
 	GenerateLinkTokens(context.Context, string) (*domain.Token, error)
+	ValidateLinkToken(context.Context, string) (*domain.TokenClaims, error)
 	ValidateToken(context.Context, string) (*domain.TokenClaims, error)
 	GetAccessExpiration(context.Context) time.Duration
 	GetRefreshExpiration(context.Context) time.Duration
@@ -26,10 +42,27 @@ type JwtService interface {
 type Cache interface {
 	StoreToken(context.Context, string, *domain.Token) error
 	RetrieveToken(context.Context, string) (*domain.Token, error)
+	StorePreAuthToken(context.Context, string, string, time.Duration) error
+	ConsumePreAuthToken(context.Context, string) (string, time.Duration, error)
+	DeletePreAuthToken(context.Context, string) error
+	IncrementPreAuthFailures(context.Context, string, time.Duration) (int64, error)
+	StoreActionToken(context.Context, string, string, time.Duration) error
+	ConsumeActionToken(context.Context, string) (string, error)
+	RevokeUserTokens(context.Context, string, time.Time, time.Duration) error
+	TokensRevokedAt(context.Context, string) (time.Time, error)
 	Close()
 }
 
+type TotpService interface {
+	GenerateSecret() (string, error)
+	ProvisioningURI(string, string) string
+	ValidateCode(string, string) (int64, bool, error)
+}
+
 type HttpClient interface {
 	GetAccessToken(context.Context, string, any) (*domain.Token, error)
 	GetAccessTokenPep(context.Context, string, string) (*domain.Token, error)
 }
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_authe.go -package=mocks
@@ -3,6 +3,7 @@ package authe
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	redis0 "github.com/go-redis/redis/v8"
@@ -14,6 +15,18 @@ import (
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
 )
 
+// preAuthKeyPrefix separa los tokens de pre-autenticación MFA del resto de las claves.
+const preAuthKeyPrefix = "mfa:preauth:"
+
+// preAuthFailuresKeyPrefix agrupa los contadores de códigos fallidos de cada token de pre-autenticación.
+const preAuthFailuresKeyPrefix = "mfa:preauth-failures:"
+
+// actionTokenKeyPrefix agrupa los nonces de los tokens de verificación de email y reseteo de contraseña.
+const actionTokenKeyPrefix = "account:token:"
+
+// revokedTokensKeyPrefix guarda, por usuario, el instante hasta el que sus tokens quedan revocados.
+const revokedTokensKeyPrefix = "account:revoked:"
+
 type cache struct {
 	cache redis.Cache
 }
@@ -51,6 +64,92 @@ func (c *cache) RetrieveToken(ctx context.Context, userID string) (*domain.Token
 	return token, nil
 }
 
+func (c *cache) StorePreAuthToken(ctx context.Context, preAuthToken, userID string, expiration time.Duration) error {
+	return c.cache.Set(ctx, preAuthKeyPrefix+preAuthToken, userID, expiration)
+}
+
+// ConsumePreAuthToken recupera y elimina el token en una sola operación (GETDEL), de modo que dos
+// verificaciones concurrentes no puedan canjear el mismo token. Devuelve también el tiempo de vida
+// que le quedaba, para reponerlo si el código resulta inválido.
+func (c *cache) ConsumePreAuthToken(ctx context.Context, preAuthToken string) (string, time.Duration, error) {
+	key := preAuthKeyPrefix + preAuthToken
+	// Si el token ya no existe el TTL falla, pero es GETDEL quien decide el resultado
+	remaining, _ := c.cache.TTL(ctx, key)
+
+	userID, err := c.cache.GetDel(ctx, key)
+	if err != nil {
+		if errors.Is(err, redis0.Nil) {
+			return "", 0, types.NewError(types.ErrTokenNotFound, "pre-auth token not found or expired", nil)
+		}
+		return "", 0, types.NewError(types.ErrConnection, "failed to consume pre-auth token from cache", err)
+	}
+	return userID, remaining, nil
+}
+
+// DeletePreAuthToken invalida el token junto con su contador de intentos fallidos.
+func (c *cache) DeletePreAuthToken(ctx context.Context, preAuthToken string) error {
+	if err := c.cache.Delete(ctx, preAuthFailuresKeyPrefix+preAuthToken); err != nil {
+		return err
+	}
+	return c.cache.Delete(ctx, preAuthKeyPrefix+preAuthToken)
+}
+
+// IncrementPreAuthFailures suma un intento fallido al token y devuelve el total acumulado. El
+// contador vence con el token, por lo que expiration debe ser la duración de la pre-autenticación.
+func (c *cache) IncrementPreAuthFailures(ctx context.Context, preAuthToken string, expiration time.Duration) (int64, error) {
+	count, err := c.cache.Incr(ctx, preAuthFailuresKeyPrefix+preAuthToken, expiration)
+	if err != nil {
+		return 0, types.NewError(types.ErrConnection, "failed to count pre-auth failures in cache", err)
+	}
+	return count, nil
+}
+
+func (c *cache) StoreActionToken(ctx context.Context, nonce, userID string, expiration time.Duration) error {
+	return c.cache.Set(ctx, actionTokenKeyPrefix+nonce, userID, expiration)
+}
+
+// ConsumeActionToken recupera y elimina el nonce, garantizando que el token se use una sola vez.
+func (c *cache) ConsumeActionToken(ctx context.Context, nonce string) (string, error) {
+	key := actionTokenKeyPrefix + nonce
+	userID, err := c.cache.GetDel(ctx, key)
+	if err != nil {
+		if errors.Is(err, redis0.Nil) {
+			return "", types.NewError(types.ErrTokenNotFound, "token already used or expired", nil)
+		}
+		return "", types.NewError(types.ErrConnection, "failed to consume token from cache", err)
+	}
+	return userID, nil
+}
+
+// RevokeUserTokens elimina el token guardado del usuario y registra el instante de revocación, que
+// invalida los JWT y tokens de cuenta emitidos hasta entonces. La marca debe vencer con el token más longevo.
+func (c *cache) RevokeUserTokens(ctx context.Context, userID string, revokedAt time.Time, expiration time.Duration) error {
+	if err := c.cache.Delete(ctx, userID); err != nil {
+		return types.NewError(types.ErrConnection, "failed to delete token from cache", err)
+	}
+	if err := c.cache.Set(ctx, revokedTokensKeyPrefix+userID, revokedAt.Unix(), expiration); err != nil {
+		return types.NewError(types.ErrConnection, "failed to store token revocation in cache", err)
+	}
+	return nil
+}
+
+// TokensRevokedAt devuelve el instante de la última revocación de los tokens del usuario, con precisión de segundos.
+func (c *cache) TokensRevokedAt(ctx context.Context, userID string) (time.Time, error) {
+	value, err := c.cache.Get(ctx, revokedTokensKeyPrefix+userID)
+	if err != nil {
+		if errors.Is(err, redis0.Nil) {
+			return time.Time{}, types.NewError(types.ErrTokenNotFound, "user tokens were never revoked", nil)
+		}
+		return time.Time{}, types.NewError(types.ErrConnection, "failed to retrieve token revocation from cache", err)
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, types.NewError(types.ErrInvalidInput, "failed to parse token revocation", err)
+	}
+	return time.Unix(seconds, 0), nil
+}
+
 func (c *cache) Close() {
 	c.cache.Close()
 }
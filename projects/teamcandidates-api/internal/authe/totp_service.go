@@ -0,0 +1,31 @@
+package authe
+
+import (
+	"time"
+
+	totp "github.com/teamcubation/teamcandidates/pkg/authe/totp"
+)
+
+type totpService struct {
+	totpService totp.Service
+}
+
+func NewTotpService(ts totp.Service) TotpService {
+	return &totpService{
+		totpService: ts,
+	}
+}
+
+func (t *totpService) GenerateSecret() (string, error) {
+	return t.totpService.GenerateSecret()
+}
+
+func (t *totpService) ProvisioningURI(secret, accountName string) string {
+	return t.totpService.ProvisioningURI(secret, accountName)
+}
+
+// ValidateCode verifica el código y devuelve la ventana TOTP en la que coincidió.
+func (t *totpService) ValidateCode(secret, code string) (int64, bool, error) {
+	step, ok, err := t.totpService.MatchStep(secret, code, time.Now())
+	return int64(step), ok, err
+}
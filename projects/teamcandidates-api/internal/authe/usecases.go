@@ -2,57 +2,134 @@ package authe
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"time"
 
 	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
 
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
 	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/support"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	notification "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/notification"
+	user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
 )
 
 type useCases struct {
-	cache      Cache
-	jwtService JwtService
-	httpClient HttpClient
+	cache          Cache
+	jwtService     JwtService
+	httpClient     HttpClient
+	totpService    TotpService
+	userUc         user.UseCases
+	notificationUc notification.UseCases
+	config         config.Loader
+	auditUc        audit.UseCases
 }
 
 func NewUseCases(
 	ch Cache,
 	js JwtService,
 	hc HttpClient,
-
+	ts TotpService,
+	uu user.UseCases,
+	nu notification.UseCases,
+	cfg config.Loader,
+	ad audit.UseCases,
 ) UseCases {
 	return &useCases{
-		cache:      ch,
-		jwtService: js,
-		httpClient: hc,
+		cache:          ch,
+		jwtService:     js,
+		httpClient:     hc,
+		totpService:    ts,
+		userUc:         uu,
+		notificationUc: nu,
+		config:         cfg,
+		auditUc:        ad,
 	}
 }
 
 func (u *useCases) JwtLogin(ctx context.Context, username, email, password string) (*domain.Token, error) {
-	return nil, nil
-}
+	nameCred, passCred, err := support.GetCredentials(username, email, password)
+	if err != nil {
+		return nil, types.NewError(types.ErrInvalidInput, "failed to get credentials", err)
+	}
 
-func (u *useCases) GenerateLinkTokens(ctx context.Context, userID string) (*domain.Token, error) {
-	if userID == "" {
-		return nil, errors.New("userID is empty")
+	// Los usuarios HR se identifican por email
+	hrUser, err := u.userUc.GetUserByEmail(ctx, nameCred)
+	if err != nil {
+		if types.IsNotFound(err) {
+			u.recordLogin(ctx, "", nameCred, false, "unknown email")
+			return nil, types.NewAuthenticationError("invalid credentials", nil)
+		}
+		return nil, types.NewError(types.ErrOperationFailed, "failed to retrieve user", err)
 	}
 
-	token, err := u.jwtService.GenerateHrTokens(ctx, userID)
+	valid, err := utils.VerifyPassword(passCred, hrUser.Credentials.Password)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, types.NewError(types.ErrOperationFailed, "failed to verify password", err)
+	}
+	if !valid {
+		u.recordLogin(ctx, hrUser.ID, nameCred, false, "invalid password")
+		return nil, types.NewAuthenticationError("invalid credentials", nil)
+	}
+
+	if u.config.GetAccountConfig().RequireEmailVerification && !hrUser.EmailValidated {
+		u.recordLogin(ctx, hrUser.ID, nameCred, false, "email not verified")
+		return nil, types.NewAuthenticationError("email not verified", nil)
 	}
 
-	err = u.cache.StoreToken(ctx, userID, token)
+	// Si el usuario tiene MFA activo, se emite un token de pre-autenticación en lugar del JWT
+	mfaRequired, err := u.isMfaRequired(ctx, hrUser.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed storing refresh token: %w", err)
+		return nil, err
+	}
+	if mfaRequired {
+		return u.issuePreAuthToken(ctx, hrUser.ID)
 	}
 
+	token, err := u.jwtService.GenerateHrTokens(ctx, hrUser.ID)
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to generate internal token", err)
+	}
+
+	if err = u.cache.StoreToken(ctx, hrUser.ID, token); err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed storing token in cache", err)
+	}
+
+	u.recordLogin(ctx, hrUser.ID, nameCred, true, "")
 	return token, nil
 }
 
+// GenerateLinkTokens emite el token de un link de evaluación. No es una sesión: no se guarda en caché
+// y el middleware JWT lo rechaza por su claim de propósito.
+func (u *useCases) GenerateLinkTokens(ctx context.Context, assessmentID string) (*domain.Token, error) {
+	if assessmentID == "" {
+		return nil, types.NewMissingFieldError("assessment_id")
+	}
+
+	token, err := u.jwtService.GenerateLinkTokens(ctx, assessmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+	return token, nil
+}
+
+// ValidateLinkToken verifica que el token haya sido emitido para un link de evaluación y devuelve
+// el ID del assessment al que da acceso.
+func (u *useCases) ValidateLinkToken(ctx context.Context, token string) (string, error) {
+	claims, err := u.jwtService.ValidateLinkToken(ctx, token)
+	if err != nil {
+		return "", types.NewAuthenticationError("invalid assessment link token", err)
+	}
+	if claims.Purpose != domain.TokenPurposeAssessmentLink {
+		return "", types.NewAuthenticationError("token was not issued for an assessment link", nil)
+	}
+	if claims.Subject == "" {
+		return "", types.NewAuthenticationError("assessment link token has no subject", nil)
+	}
+	return claims.Subject, nil
+}
+
 func (u *useCases) PepLogin(ctx context.Context, username, email, password string) (*domain.Token, error) {
 	nameCred, passCred, err := support.GetCredentials(username, email, password)
 	if err != nil {
@@ -60,49 +137,53 @@ func (u *useCases) PepLogin(ctx context.Context, username, email, password strin
 		return nil, types.NewError(types.ErrInvalidInput, "failed to get credentials", err)
 	}
 
-	// Intentar recuperar el token desde caché
-	token, err := u.cache.RetrieveToken(ctx, nameCred)
-
-	switch {
-	case err == nil:
-		// Caso 1: No hubo error al buscar en caché
-		if token != nil && token.AccessExpiresAt.After(time.Now()) {
-			// El token aún es válido
-			fmt.Println("Token is still valid until:", token.AccessExpiresAt)
-			return token, nil
-		}
-		// Si está expirado, continúa para obtener uno nuevo
-
-	case types.IsTokenNotFoundError(err):
-		// Caso 2: Token no existe en caché
-		fmt.Println("Token not found in cache, requesting a new one")
-
-	default:
-		// Caso 3: Error inesperado al recuperar el token
-		return nil, types.NewError(types.ErrOperationFailed, "failed to retrieve token from cache", err)
-	}
-
+	// No se reutiliza un token cacheado por nombre de usuario: las credenciales, la verificación de
+	// email y el MFA se validan en cada login.
 	// Obtener el token externo desde la API de PEP
 	externalToken, err := u.httpClient.GetAccessTokenPep(ctx, nameCred, passCred)
 	if err != nil {
 		// No pudimos obtener el token desde la API de Pep
+		u.recordLogin(ctx, "", nameCred, false, "pep authentication failed")
 		return nil, types.NewError(types.ErrOperationFailed, "failed to get pep access token", err)
 	}
 
 	// Extraer las claims del token externo
 	extractedClaims, err := u.jwtService.ExtractClaimsFromExternalToken(externalToken.AccessToken)
 	if err != nil {
+		u.recordLogin(ctx, "", nameCred, false, "invalid pep token")
 		return nil, types.NewError(types.ErrInvalidInput, "failed to extract claims from external token", err)
 	}
 
 	// Obtener el userID y email desde las claims
 	userID, ok := extractedClaims["sub"].(string)
 	if !ok || userID == "" {
+		u.recordLogin(ctx, "", nameCred, false, "invalid pep token")
 		return nil, types.NewError(types.ErrInvalidInput, "invalid or missing userID in token claims", nil)
 	}
 
+	if u.config.GetAccountConfig().RequireEmailVerification {
+		hrUser, err := u.userUc.GetUser(ctx, userID)
+		if err != nil {
+			u.recordLogin(ctx, userID, nameCred, false, "unknown user")
+			return nil, types.NewAuthenticationError("invalid credentials", err)
+		}
+		if !hrUser.EmailValidated {
+			u.recordLogin(ctx, userID, nameCred, false, "email not verified")
+			return nil, types.NewAuthenticationError("email not verified", nil)
+		}
+	}
+
+	// Si el usuario tiene MFA activo, se emite un token de pre-autenticación en lugar del JWT
+	mfaRequired, err := u.isMfaRequired(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaRequired {
+		return u.issuePreAuthToken(ctx, userID)
+	}
+
 	// Generar token interno (nuestro JWT)
-	token, err = u.jwtService.GenerateHrTokens(ctx, userID)
+	token, err := u.jwtService.GenerateHrTokens(ctx, userID)
 	if err != nil {
 		return nil, types.NewError(types.ErrOperationFailed, "failed to generate internal token", err)
 	}
@@ -112,6 +193,7 @@ func (u *useCases) PepLogin(ctx context.Context, username, email, password strin
 		return nil, types.NewError(types.ErrOperationFailed, "failed storing token in cache", err)
 	}
 
+	u.recordLogin(ctx, userID, nameCred, true, "")
 	return token, nil
 }
 
@@ -4,6 +4,9 @@ import (
 	"time"
 )
 
+// TokenTypePreAuth identifica el token de corta duración emitido cuando el login requiere un segundo factor.
+const TokenTypePreAuth = "PreAuth"
+
 type Token struct {
 	AccessToken      string
 	RefreshToken     string
@@ -16,10 +19,38 @@ type Token struct {
 
 type TokenClaims struct {
 	Subject   string
+	Purpose   string
 	ExpiresAt time.Time
 	IssuedAt  time.Time
 }
 
+// TokenPurposeAssessmentLink es el propósito de los tokens de los links de evaluación: el sujeto es
+// el ID del assessment y no sirven como sesión de la API.
+const TokenPurposeAssessmentLink = "assessment_link"
+
+// ActionTokenPurpose identifica el flujo para el que se emitió un token de acción de cuenta.
+type ActionTokenPurpose string
+
+const (
+	ActionTokenEmailVerification ActionTokenPurpose = "email_verification"
+	ActionTokenPasswordReset     ActionTokenPurpose = "password_reset"
+)
+
+// ActionToken es el payload firmado de los tokens de un solo uso enviados por email.
+type ActionToken struct {
+	UserID    string
+	Purpose   ActionTokenPurpose
+	Nonce     string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// MfaEnrollment contiene los datos necesarios para registrar el secreto TOTP en una app autenticadora.
+type MfaEnrollment struct {
+	Secret          string
+	ProvisioningURI string // otpauth:// URI, es el payload del código QR
+}
+
 // type Session struct {
 // 	UserUUID  string
 // 	Token     Token
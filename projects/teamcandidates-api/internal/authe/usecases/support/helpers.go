@@ -1,6 +1,23 @@
 package support
 
-import "errors"
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
+)
 
 func GetCredentials(username, email, password string) (string, string, error) {
 	if username == "" && email == "" {
@@ -19,3 +36,183 @@ func GetCredentials(username, email, password string) (string, string, error) {
 
 	return nameCredential, password, nil
 }
+
+// GenerateOpaqueToken genera un token aleatorio de size bytes codificado en hexadecimal.
+func GenerateOpaqueToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// GenerateRecoveryCodes genera count códigos de recuperación con formato xxxxx-xxxxx.
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	codes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		raw, err := GenerateOpaqueToken(5)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, raw[:5]+"-"+raw[5:])
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode elimina espacios y pasa a minúsculas el código ingresado por el usuario.
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// encryptedSecretPrefix distingue los secretos TOTP cifrados de los guardados en claro antes del cifrado.
+const encryptedSecretPrefix = "enc:v1:"
+
+// EncryptMfaSecret cifra el secreto TOTP con AES-256-GCM: "enc:v1:" + base64(nonce || ciphertext).
+// key es la clave de 32 bytes codificada en base64 de la configuración.
+func EncryptMfaSecret(key, secret string) (string, error) {
+	aead, err := newMfaCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(secret), nil)
+	return encryptedSecretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptMfaSecret descifra un secreto guardado con EncryptMfaSecret. Los secretos sin prefijo son
+// previos al cifrado y se devuelven tal cual con legacy en true, para que se vuelvan a guardar cifrados.
+func DecryptMfaSecret(key, stored string) (secret string, legacy bool, err error) {
+	encoded, found := strings.CutPrefix(stored, encryptedSecretPrefix)
+	if !found {
+		return stored, true, nil
+	}
+
+	aead, err := newMfaCipher(key)
+	if err != nil {
+		return "", false, err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", false, errors.New("malformed encrypted mfa secret")
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", false, errors.New("malformed encrypted mfa secret")
+	}
+
+	plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to decrypt mfa secret: %w", err)
+	}
+	return string(plain), false, nil
+}
+
+func newMfaCipher(key string) (cipher.AEAD, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(raw) != 32 {
+		return nil, errors.New("mfa secret key must be a base64 encoded 32 byte key")
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mfa cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// actionTokenPayload es la representación serializada de domain.ActionToken.
+type actionTokenPayload struct {
+	Sub string `json:"sub"`
+	Pur string `json:"pur"`
+	Jti string `json:"jti"`
+	Iat int64  `json:"iat"`
+	Exp int64  `json:"exp"`
+}
+
+// SignActionToken serializa el token y lo firma con HMAC-SHA256: base64url(payload).base64url(firma).
+func SignActionToken(secret string, token *domain.ActionToken) (string, error) {
+	if secret == "" {
+		return "", errors.New("token secret is empty")
+	}
+	if token == nil {
+		return "", errors.New("action token is nil")
+	}
+
+	payload, err := json.Marshal(actionTokenPayload{
+		Sub: token.UserID,
+		Pur: string(token.Purpose),
+		Jti: token.Nonce,
+		Iat: token.IssuedAt.Unix(),
+		Exp: token.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal action token: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(signPayload(secret, encoded)), nil
+}
+
+// ParseActionToken verifica la firma y la expiración del token y devuelve su contenido.
+func ParseActionToken(secret, raw string, now time.Time) (*domain.ActionToken, error) {
+	encoded, signature, found := strings.Cut(raw, ".")
+	if !found {
+		return nil, errors.New("malformed action token")
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, errors.New("malformed action token signature")
+	}
+	if !hmac.Equal(sig, signPayload(secret, encoded)) {
+		return nil, errors.New("invalid action token signature")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("malformed action token payload")
+	}
+
+	var payload actionTokenPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal action token: %w", err)
+	}
+
+	token := &domain.ActionToken{
+		UserID:    payload.Sub,
+		Purpose:   domain.ActionTokenPurpose(payload.Pur),
+		Nonce:     payload.Jti,
+		IssuedAt:  time.Unix(payload.Iat, 0),
+		ExpiresAt: time.Unix(payload.Exp, 0),
+	}
+	if now.After(token.ExpiresAt) {
+		return nil, errors.New("action token has expired")
+	}
+
+	return token, nil
+}
+
+func signPayload(secret, encoded string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+// RenderEmailTemplate ejecuta el template HTML del cuerpo del email con los datos recibidos.
+func RenderEmailTemplate(tmpl string, data any) (string, error) {
+	t, err := template.New("email").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var body bytes.Buffer
+	if err := t.Execute(&body, data); err != nil {
+		return "", fmt.Errorf("failed to render email template: %w", err)
+	}
+	return body.String(), nil
+}
@@ -0,0 +1,231 @@
+package authe
+
+import (
+	"context"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
+	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/support"
+)
+
+// actionTokenNonceSize es la cantidad de bytes aleatorios del nonce de los tokens de cuenta.
+const actionTokenNonceSize = 16
+
+// accountEmailData son los datos disponibles en los templates de los emails de cuenta.
+type accountEmailData struct {
+	Email     string
+	URL       string
+	ExpiresAt string
+}
+
+// RequestEmailVerification envía un email con un token de verificación de un solo uso.
+// Si el email no existe o ya fue verificado no se informa al cliente, para no exponer qué cuentas existen.
+func (u *useCases) RequestEmailVerification(ctx context.Context, email string) error {
+	hrUser, err := u.userUc.GetUserByEmail(ctx, email)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil
+		}
+		return types.NewError(types.ErrOperationFailed, "failed to retrieve user", err)
+	}
+	if hrUser.EmailValidated {
+		return nil
+	}
+
+	accountCfg := u.config.GetAccountConfig()
+	return u.sendActionTokenEmail(
+		ctx,
+		hrUser.ID,
+		hrUser.Credentials.Email,
+		domain.ActionTokenEmailVerification,
+		accountCfg.VerificationExpirationMinutes,
+		accountCfg.VerificationURL,
+		accountCfg.VerificationSubject,
+		accountCfg.VerificationTemplate,
+	)
+}
+
+// ConfirmEmailVerification canjea el token de verificación y marca el email del usuario como validado.
+func (u *useCases) ConfirmEmailVerification(ctx context.Context, rawToken string) error {
+	userID, err := u.consumeActionToken(ctx, rawToken, domain.ActionTokenEmailVerification)
+	if err != nil {
+		return err
+	}
+
+	if err := u.userUc.MarkEmailValidated(ctx, userID); err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to validate email", err)
+	}
+	return nil
+}
+
+// RequestPasswordReset envía un email con un token de reseteo de contraseña de un solo uso.
+func (u *useCases) RequestPasswordReset(ctx context.Context, email string) error {
+	hrUser, err := u.userUc.GetUserByEmail(ctx, email)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil
+		}
+		return types.NewError(types.ErrOperationFailed, "failed to retrieve user", err)
+	}
+
+	accountCfg := u.config.GetAccountConfig()
+	return u.sendActionTokenEmail(
+		ctx,
+		hrUser.ID,
+		hrUser.Credentials.Email,
+		domain.ActionTokenPasswordReset,
+		accountCfg.PasswordResetExpirationMinutes,
+		accountCfg.PasswordResetURL,
+		accountCfg.PasswordResetSubject,
+		accountCfg.PasswordResetTemplate,
+	)
+}
+
+// ConfirmPasswordReset canjea el token de reseteo y reemplaza la contraseña del usuario.
+// Recibir el email prueba la titularidad de la casilla, por lo que también se marca como validado.
+func (u *useCases) ConfirmPasswordReset(ctx context.Context, rawToken, newPassword string) error {
+	if newPassword == "" {
+		return types.NewMissingFieldError("new_password")
+	}
+	// La complejidad se valida antes de canjear el token para no quemarlo con una contraseña inválida
+	if err := utils.ValidatePasswordComplexity(newPassword); err != nil {
+		return types.NewError(types.ErrValidation, "invalid password", err)
+	}
+
+	userID, err := u.consumeActionToken(ctx, rawToken, domain.ActionTokenPasswordReset)
+	if err != nil {
+		return err
+	}
+
+	if err := u.userUc.ResetPassword(ctx, userID, newPassword); err != nil {
+		if types.IsValidationError(err) {
+			return err
+		}
+		return types.NewError(types.ErrOperationFailed, "failed to reset password", err)
+	}
+
+	// La contraseña anterior pudo estar comprometida: se cierran las sesiones abiertas y se
+	// invalidan los demás tokens de cuenta emitidos hasta ahora
+	if err := u.revokeUserTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := u.userUc.MarkEmailValidated(ctx, userID); err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to validate email", err)
+	}
+	return nil
+}
+
+// TokenRevoked indica si un token del usuario emitido en issuedAt quedó revocado por un reseteo de
+// contraseña posterior. La emisión tiene precisión de segundos, por lo que un token emitido en el
+// mismo segundo que la revocación también se considera revocado.
+func (u *useCases) TokenRevoked(ctx context.Context, userID string, issuedAt time.Time) (bool, error) {
+	revokedAt, err := u.cache.TokensRevokedAt(ctx, userID)
+	if err != nil {
+		if types.IsTokenNotFoundError(err) {
+			return false, nil
+		}
+		return false, types.NewError(types.ErrOperationFailed, "failed to check token revocation", err)
+	}
+	return !issuedAt.After(revokedAt), nil
+}
+
+// revokeUserTokens revoca los JWT y tokens de cuenta del usuario. La marca de revocación se conserva
+// mientras pueda seguir vigente alguno de los tokens emitidos antes.
+func (u *useCases) revokeUserTokens(ctx context.Context, userID string) error {
+	accountCfg := u.config.GetAccountConfig()
+	expiration := max(
+		u.config.GetHrConfig().RefreshExpirationMinutes,
+		accountCfg.VerificationExpirationMinutes,
+		accountCfg.PasswordResetExpirationMinutes,
+	)
+
+	if err := u.cache.RevokeUserTokens(ctx, userID, time.Now(), expiration); err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to revoke user tokens", err)
+	}
+	return nil
+}
+
+// sendActionTokenEmail emite un token firmado, registra su nonce en caché y envía el email templado.
+func (u *useCases) sendActionTokenEmail(
+	ctx context.Context,
+	userID, address string,
+	purpose domain.ActionTokenPurpose,
+	expiration time.Duration,
+	baseURL, subject, bodyTemplate string,
+) error {
+	nonce, err := support.GenerateOpaqueToken(actionTokenNonceSize)
+	if err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to generate token nonce", err)
+	}
+
+	issuedAt := time.Now()
+	expiresAt := issuedAt.Add(expiration)
+	rawToken, err := support.SignActionToken(u.config.GetAccountConfig().TokenSecret, &domain.ActionToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		Nonce:     nonce,
+		IssuedAt:  issuedAt,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to sign token", err)
+	}
+
+	if err := u.cache.StoreActionToken(ctx, nonce, userID, expiration); err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed storing token in cache", err)
+	}
+
+	body, err := support.RenderEmailTemplate(bodyTemplate, accountEmailData{
+		Email:     address,
+		URL:       baseURL + "?token=" + rawToken,
+		ExpiresAt: expiresAt.UTC().Format(time.RFC1123),
+	})
+	if err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to build email body", err)
+	}
+
+	if err := u.notificationUc.SendEmail(ctx, address, subject, body); err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to send email", err)
+	}
+	return nil
+}
+
+// consumeActionToken verifica firma, expiración y propósito del token e invalida su nonce.
+func (u *useCases) consumeActionToken(ctx context.Context, rawToken string, purpose domain.ActionTokenPurpose) (string, error) {
+	if rawToken == "" {
+		return "", types.NewMissingFieldError("token")
+	}
+
+	token, err := support.ParseActionToken(u.config.GetAccountConfig().TokenSecret, rawToken, time.Now())
+	if err != nil {
+		return "", types.NewAuthenticationError("invalid or expired token", err)
+	}
+	if token.Purpose != purpose {
+		return "", types.NewAuthenticationError("token was not issued for this operation", nil)
+	}
+
+	revoked, err := u.TokenRevoked(ctx, token.UserID, token.IssuedAt)
+	if err != nil {
+		return "", err
+	}
+	if revoked {
+		return "", types.NewAuthenticationError("token was revoked", nil)
+	}
+
+	userID, err := u.cache.ConsumeActionToken(ctx, token.Nonce)
+	if err != nil {
+		if types.IsTokenNotFoundError(err) {
+			return "", types.NewAuthenticationError("token already used or expired", err)
+		}
+		return "", types.NewError(types.ErrOperationFailed, "failed to consume token", err)
+	}
+	if userID != token.UserID {
+		return "", types.NewAuthenticationError("token subject mismatch", nil)
+	}
+
+	return userID, nil
+}
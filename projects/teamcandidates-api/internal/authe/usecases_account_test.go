@@ -0,0 +1,395 @@
+package authe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
+	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/support"
+	usrdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+// signActionToken firma un token de cuenta como lo hace sendActionTokenEmail.
+func signActionToken(t *testing.T, userID string, purpose domain.ActionTokenPurpose, nonce string) string {
+	t.Helper()
+	raw, err := support.SignActionToken(testTokenSecret, &domain.ActionToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		Nonce:     nonce,
+		IssuedAt:  time.Now(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+	return raw
+}
+
+// expectNotRevoked espera la consulta de revocación de un usuario que nunca reseteó su contraseña.
+func (f *fields) expectNotRevoked(userID string) {
+	f.cache.EXPECT().TokensRevokedAt(gomock.Any(), userID).Return(time.Time{}, types.NewTokenNotFoundError(nil))
+}
+
+func TestConfirmPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resetToken := signActionToken(t, "user1", domain.ActionTokenPasswordReset, "nonce1")
+	verificationToken := signActionToken(t, "user1", domain.ActionTokenEmailVerification, "nonce2")
+
+	tests := []struct {
+		name     string
+		token    string
+		password string
+		setup    func(f *fields)
+		wantErr  func(error) bool
+	}{
+		{
+			name:     "Error: weak password does not consume the token",
+			token:    resetToken,
+			password: "weak",
+			// No se espera ConsumeActionToken: el token sigue siendo válido.
+			setup:   func(f *fields) {},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name:     "Error: token issued for another operation",
+			token:    verificationToken,
+			password: "Str0ng!Pass",
+			setup:    func(f *fields) {},
+			wantErr:  types.IsAuthenticationError,
+		},
+		{
+			name:     "Error: tampered token",
+			token:    resetToken + "x",
+			password: "Str0ng!Pass",
+			setup:    func(f *fields) {},
+			wantErr:  types.IsAuthenticationError,
+		},
+		{
+			name:     "Error: token already used",
+			token:    resetToken,
+			password: "Str0ng!Pass",
+			setup: func(f *fields) {
+				f.expectNotRevoked("user1")
+				f.cache.EXPECT().
+					ConsumeActionToken(gomock.Any(), "nonce1").
+					Return("", types.NewTokenNotFoundError(nil))
+			},
+			wantErr: types.IsAuthenticationError,
+		},
+		{
+			name:     "Error: token issued before a later password reset",
+			token:    resetToken,
+			password: "Str0ng!Pass",
+			setup: func(f *fields) {
+				// Otro reseteo posterior a la emisión revocó los tokens pendientes.
+				f.cache.EXPECT().TokensRevokedAt(gomock.Any(), "user1").Return(time.Now().Add(time.Minute), nil)
+			},
+			wantErr: types.IsAuthenticationError,
+		},
+		{
+			name:     "Success: password replaced, sessions revoked and email validated",
+			token:    resetToken,
+			password: "Str0ng!Pass",
+			setup: func(f *fields) {
+				f.expectNotRevoked("user1")
+				f.cache.EXPECT().ConsumeActionToken(gomock.Any(), "nonce1").Return("user1", nil)
+				f.userUC.EXPECT().ResetPassword(gomock.Any(), "user1", "Str0ng!Pass").Return(nil)
+				// La marca dura lo que el token más longevo: el refresh de 2 horas.
+				f.cache.EXPECT().RevokeUserTokens(gomock.Any(), "user1", gomock.Any(), 2*time.Hour).Return(nil)
+				f.userUC.EXPECT().MarkEmailValidated(gomock.Any(), "user1").Return(nil)
+			},
+		},
+		{
+			name:     "Error: sessions could not be revoked",
+			token:    resetToken,
+			password: "Str0ng!Pass",
+			setup: func(f *fields) {
+				f.expectNotRevoked("user1")
+				f.cache.EXPECT().ConsumeActionToken(gomock.Any(), "nonce1").Return("user1", nil)
+				f.userUC.EXPECT().ResetPassword(gomock.Any(), "user1", "Str0ng!Pass").Return(nil)
+				f.cache.EXPECT().RevokeUserTokens(gomock.Any(), "user1", gomock.Any(), 2*time.Hour).Return(errors.New("redis down"))
+			},
+			wantErr: func(err error) bool { return err != nil },
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			err := f.useCases().ConfirmPasswordReset(context.Background(), tc.token, tc.password)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+			}
+		})
+	}
+}
+
+func TestRequestEmailVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		setup   func(f *fields)
+		wantErr bool
+	}{
+		{
+			name: "Success: unknown email is not disclosed",
+			setup: func(f *fields) {
+				f.userUC.EXPECT().
+					GetUserByEmail(gomock.Any(), "hr@example.com").
+					Return(nil, types.NewError(types.ErrNotFound, "user not found", nil))
+			},
+		},
+		{
+			name: "Success: already validated email sends nothing",
+			setup: func(f *fields) {
+				f.userUC.EXPECT().
+					GetUserByEmail(gomock.Any(), "hr@example.com").
+					Return(&usrdom.User{ID: "user1", EmailValidated: true}, nil)
+			},
+		},
+		{
+			name: "Success: verification email sent",
+			setup: func(f *fields) {
+				f.userUC.EXPECT().
+					GetUserByEmail(gomock.Any(), "hr@example.com").
+					Return(&usrdom.User{ID: "user1", Credentials: usrdom.Credentials{Email: "hr@example.com"}}, nil)
+				f.cache.EXPECT().StoreActionToken(gomock.Any(), gomock.Any(), "user1", time.Hour).Return(nil)
+				f.notif.EXPECT().SendEmail(gomock.Any(), "hr@example.com", "Verify", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Error: email delivery failed",
+			setup: func(f *fields) {
+				f.userUC.EXPECT().
+					GetUserByEmail(gomock.Any(), "hr@example.com").
+					Return(&usrdom.User{ID: "user1", Credentials: usrdom.Credentials{Email: "hr@example.com"}}, nil)
+				f.cache.EXPECT().StoreActionToken(gomock.Any(), gomock.Any(), "user1", time.Hour).Return(nil)
+				f.notif.EXPECT().SendEmail(gomock.Any(), "hr@example.com", "Verify", gomock.Any()).Return(errors.New("smtp down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			err := f.useCases().RequestEmailVerification(context.Background(), "hr@example.com")
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got nil")
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+			}
+		})
+	}
+}
+
+func TestConfirmEmailVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	verificationToken := signActionToken(t, "user1", domain.ActionTokenEmailVerification, "nonce1")
+
+	tests := []struct {
+		name    string
+		token   string
+		setup   func(f *fields)
+		wantErr bool
+	}{
+		{
+			name:    "Error: missing token",
+			token:   "",
+			setup:   func(f *fields) {},
+			wantErr: true,
+		},
+		{
+			name:  "Error: token subject does not match the cached nonce",
+			token: verificationToken,
+			setup: func(f *fields) {
+				f.expectNotRevoked("user1")
+				f.cache.EXPECT().ConsumeActionToken(gomock.Any(), "nonce1").Return("user2", nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Success: email marked as validated",
+			token: verificationToken,
+			setup: func(f *fields) {
+				f.expectNotRevoked("user1")
+				f.cache.EXPECT().ConsumeActionToken(gomock.Any(), "nonce1").Return("user1", nil)
+				f.userUC.EXPECT().MarkEmailValidated(gomock.Any(), "user1").Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			err := f.useCases().ConfirmEmailVerification(context.Background(), tc.token)
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got nil")
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+			}
+		})
+	}
+}
+
+func TestTokenRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	revokedAt := time.Unix(1_700_000_000, 0)
+
+	tests := []struct {
+		name        string
+		issuedAt    time.Time
+		setup       func(f *fields)
+		wantRevoked bool
+		wantErr     bool
+	}{
+		{
+			name:     "Success: user without revocations",
+			issuedAt: revokedAt,
+			setup:    func(f *fields) { f.expectNotRevoked("user1") },
+		},
+		{
+			name:     "Success: token issued before the revocation",
+			issuedAt: revokedAt.Add(-time.Minute),
+			setup: func(f *fields) {
+				f.cache.EXPECT().TokensRevokedAt(gomock.Any(), "user1").Return(revokedAt, nil)
+			},
+			wantRevoked: true,
+		},
+		{
+			name:     "Success: token issued in the same second as the revocation",
+			issuedAt: revokedAt,
+			setup: func(f *fields) {
+				f.cache.EXPECT().TokensRevokedAt(gomock.Any(), "user1").Return(revokedAt, nil)
+			},
+			wantRevoked: true,
+		},
+		{
+			name:     "Success: token issued after the revocation",
+			issuedAt: revokedAt.Add(time.Second),
+			setup: func(f *fields) {
+				f.cache.EXPECT().TokensRevokedAt(gomock.Any(), "user1").Return(revokedAt, nil)
+			},
+		},
+		{
+			name:     "Error: cache unavailable",
+			issuedAt: revokedAt,
+			setup: func(f *fields) {
+				f.cache.EXPECT().
+					TokensRevokedAt(gomock.Any(), "user1").
+					Return(time.Time{}, types.NewError(types.ErrConnection, "redis down", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			revoked, err := f.useCases().TokenRevoked(context.Background(), "user1", tc.issuedAt)
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got nil")
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.wantRevoked, revoked, "revocation mismatch")
+		})
+	}
+}
+
+func TestPepLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name      string
+		setup     func(f *fields)
+		wantErr   bool
+		wantToken string
+	}{
+		{
+			name: "Error: PEP rejects the credentials",
+			setup: func(f *fields) {
+				f.http.EXPECT().
+					GetAccessTokenPep(gomock.Any(), "hr@example.com", "secret").
+					Return(nil, errors.New("unauthorized"))
+				f.expectLogin(auditdom.ActionLoginFailed)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error: email not verified",
+			setup: func(f *fields) {
+				f.http.EXPECT().
+					GetAccessTokenPep(gomock.Any(), "hr@example.com", "secret").
+					Return(&domain.Token{AccessToken: "pep"}, nil)
+				f.jwt.EXPECT().ExtractClaimsFromExternalToken("pep").Return(map[string]any{"sub": "user1"}, nil)
+				f.userUC.EXPECT().GetUser(gomock.Any(), "user1").Return(&usrdom.User{ID: "user1"}, nil)
+				f.expectLogin(auditdom.ActionLoginFailed)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Success: internal token issued and login recorded",
+			setup: func(f *fields) {
+				f.http.EXPECT().
+					GetAccessTokenPep(gomock.Any(), "hr@example.com", "secret").
+					Return(&domain.Token{AccessToken: "pep"}, nil)
+				f.jwt.EXPECT().ExtractClaimsFromExternalToken("pep").Return(map[string]any{"sub": "user1"}, nil)
+				f.userUC.EXPECT().GetUser(gomock.Any(), "user1").Return(&usrdom.User{ID: "user1", EmailValidated: true}, nil)
+				f.userUC.EXPECT().
+					GetMfa(gomock.Any(), "user1").
+					Return(nil, types.NewError(types.ErrNotFound, "mfa not configured for user", nil))
+				f.jwt.EXPECT().GenerateHrTokens(gomock.Any(), "user1").Return(&domain.Token{AccessToken: "jwt"}, nil)
+				f.cache.EXPECT().StoreToken(gomock.Any(), "hr@example.com", gomock.Any()).Return(nil)
+				f.expectLogin(auditdom.ActionLogin)
+			},
+			wantToken: "jwt",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			token, err := f.useCases().PepLogin(context.Background(), "", "hr@example.com", "secret")
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got nil")
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+				assert.Equal(t, tc.wantToken, token.AccessToken, "token mismatch")
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package authe
+
+import (
+	"context"
+	"log"
+
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+// recordLogin deja constancia de un intento de login en el log de auditoría.
+// userID puede estar vacío cuando el email no corresponde a ningún usuario; email, en el segundo factor.
+func (u *useCases) recordLogin(ctx context.Context, userID, email string, success bool, reason string) {
+	event := &auditdom.AuditEvent{
+		Action:       auditdom.ActionLogin,
+		ActorID:      userID,
+		ActorType:    "user",
+		ResourceType: "user",
+		ResourceID:   userID,
+		After:        map[string]any{},
+	}
+	if email != "" {
+		event.After["email"] = email
+	}
+	if !success {
+		event.Action = auditdom.ActionLoginFailed
+		event.After["reason"] = reason
+	}
+
+	if err := u.auditUc.Record(ctx, event); err != nil {
+		log.Printf("audit: failed to record login of user %q (%s): %v", userID, email, err)
+	}
+}
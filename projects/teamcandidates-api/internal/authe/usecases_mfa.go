@@ -0,0 +1,325 @@
+package authe
+
+import (
+	"context"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
+	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/support"
+	userdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+// preAuthTokenSize es la cantidad de bytes aleatorios del token de pre-autenticación.
+const preAuthTokenSize = 32
+
+// EnrollMfa genera un nuevo secreto TOTP para el usuario. El MFA queda inactivo hasta ConfirmMfa.
+func (u *useCases) EnrollMfa(ctx context.Context, userID string) (*domain.MfaEnrollment, error) {
+	hrUser, err := u.userUc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, types.NewError(types.ErrNotFound, "user not found", err)
+	}
+
+	current, err := u.userUc.GetMfa(ctx, userID)
+	switch {
+	case err == nil && current.Enabled:
+		return nil, types.NewError(types.ErrConflict, "mfa is already enabled for this user", nil)
+	case err != nil && !types.IsNotFound(err):
+		return nil, types.NewError(types.ErrOperationFailed, "failed to retrieve mfa settings", err)
+	}
+
+	secret, err := u.totpService.GenerateSecret()
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to generate totp secret", err)
+	}
+
+	// Solo se persiste cifrado; en claro se devuelve una única vez para la app autenticadora
+	encrypted, err := support.EncryptMfaSecret(u.config.GetMfaConfig().SecretEncryptionKey, secret)
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to encrypt totp secret", err)
+	}
+
+	if err := u.userUc.SaveMfa(ctx, &userdomain.Mfa{
+		UserID:  userID,
+		Secret:  encrypted,
+		Enabled: false,
+	}); err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to store mfa settings", err)
+	}
+
+	return &domain.MfaEnrollment{
+		Secret:          secret,
+		ProvisioningURI: u.totpService.ProvisioningURI(secret, hrUser.Credentials.Email),
+	}, nil
+}
+
+// ConfirmMfa activa el MFA tras verificar el primer código y devuelve los códigos de recuperación en claro.
+// Es la única vez que los códigos se exponen; solo se persisten sus hashes.
+func (u *useCases) ConfirmMfa(ctx context.Context, userID, code string) ([]string, error) {
+	mfa, err := u.userUc.GetMfa(ctx, userID)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrNotFound, "mfa enrollment not started", err)
+		}
+		return nil, types.NewError(types.ErrOperationFailed, "failed to retrieve mfa settings", err)
+	}
+	if mfa.Enabled {
+		return nil, types.NewError(types.ErrConflict, "mfa is already enabled for this user", nil)
+	}
+
+	valid, err := u.validateTotp(ctx, mfa, code)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, types.NewAuthenticationError("invalid totp code", nil)
+	}
+
+	recoveryCodes, err := u.replaceRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	mfa.Enabled = true
+	mfa.ConfirmedAt = time.Now()
+	if err := u.userUc.SaveMfa(ctx, mfa); err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to enable mfa", err)
+	}
+
+	return recoveryCodes, nil
+}
+
+// VerifyMfa completa el login canjeando el token de pre-autenticación y un código TOTP o de recuperación.
+// El token se consume al empezar, por lo que dos verificaciones concurrentes no pueden usarlo; si el
+// código es inválido y quedan intentos, se repone con el tiempo de vida que le quedaba.
+func (u *useCases) VerifyMfa(ctx context.Context, preAuthToken, code string) (*domain.Token, error) {
+	userID, remaining, err := u.cache.ConsumePreAuthToken(ctx, preAuthToken)
+	if err != nil {
+		if types.IsTokenNotFoundError(err) {
+			return nil, types.NewAuthenticationError("invalid or expired pre-auth token", err)
+		}
+		return nil, types.NewError(types.ErrOperationFailed, "failed to retrieve pre-auth token", err)
+	}
+
+	mfa, err := u.userUc.GetMfa(ctx, userID)
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to retrieve mfa settings", err)
+	}
+	if !mfa.Enabled {
+		return nil, types.NewAuthenticationError("mfa is not enabled for this user", nil)
+	}
+
+	if err := u.verifySecondFactor(ctx, mfa, code); err != nil {
+		u.recordLogin(ctx, userID, "", false, "invalid second factor")
+		if lockErr := u.registerMfaFailure(ctx, preAuthToken, userID, remaining); lockErr != nil {
+			return nil, lockErr
+		}
+		return nil, err
+	}
+
+	// El token ya se canjeó; queda descartar su contador de intentos fallidos
+	if err := u.cache.DeletePreAuthToken(ctx, preAuthToken); err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to invalidate pre-auth token", err)
+	}
+
+	token, err := u.jwtService.GenerateHrTokens(ctx, userID)
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to generate internal token", err)
+	}
+
+	if err := u.cache.StoreToken(ctx, userID, token); err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed storing token in cache", err)
+	}
+
+	u.recordLogin(ctx, userID, "", true, "")
+	return token, nil
+}
+
+// RegenerateRecoveryCodes invalida los códigos de recuperación vigentes y emite un nuevo juego.
+func (u *useCases) RegenerateRecoveryCodes(ctx context.Context, userID, code string) ([]string, error) {
+	mfa, err := u.userUc.GetMfa(ctx, userID)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrNotFound, "mfa not configured for user", err)
+		}
+		return nil, types.NewError(types.ErrOperationFailed, "failed to retrieve mfa settings", err)
+	}
+	if !mfa.Enabled {
+		return nil, types.NewError(types.ErrConflict, "mfa is not enabled for this user", nil)
+	}
+
+	valid, err := u.validateTotp(ctx, mfa, code)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, types.NewAuthenticationError("invalid totp code", nil)
+	}
+
+	return u.replaceRecoveryCodes(ctx, userID)
+}
+
+// ResetMfa elimina la configuración MFA de un usuario (uso administrativo, p. ej. pérdida del dispositivo).
+func (u *useCases) ResetMfa(ctx context.Context, userID string) error {
+	if userID == "" {
+		return types.NewMissingFieldError("user_id")
+	}
+
+	if err := u.userUc.DeleteMfa(ctx, userID); err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to reset mfa", err)
+	}
+	return nil
+}
+
+// isMfaRequired indica si el usuario debe completar el segundo factor para obtener un JWT.
+func (u *useCases) isMfaRequired(ctx context.Context, userID string) (bool, error) {
+	mfa, err := u.userUc.GetMfa(ctx, userID)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return false, nil
+		}
+		return false, types.NewError(types.ErrOperationFailed, "failed to retrieve mfa settings", err)
+	}
+	return mfa.Enabled, nil
+}
+
+// registerMfaFailure cuenta un código fallido del token de pre-autenticación ya consumido. Mientras
+// no se alcance MaxVerifyAttempts el token se repone por el tiempo que le quedaba; al alcanzarlo queda
+// invalidado, obligando a repetir el login con la contraseña.
+func (u *useCases) registerMfaFailure(ctx context.Context, preAuthToken, userID string, remaining time.Duration) error {
+	mfaConfig := u.config.GetMfaConfig()
+	failures, err := u.cache.IncrementPreAuthFailures(ctx, preAuthToken, mfaConfig.PreAuthExpirationMinutes)
+	if err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to register mfa failure", err)
+	}
+	if failures < int64(mfaConfig.MaxVerifyAttempts) && remaining > 0 {
+		if err := u.cache.StorePreAuthToken(ctx, preAuthToken, userID, remaining); err != nil {
+			return types.NewError(types.ErrOperationFailed, "failed to restore pre-auth token", err)
+		}
+		return nil
+	}
+
+	if err := u.cache.DeletePreAuthToken(ctx, preAuthToken); err != nil {
+		return types.NewError(types.ErrOperationFailed, "failed to invalidate pre-auth token", err)
+	}
+	return types.NewAuthenticationError("too many invalid codes, log in again", nil)
+}
+
+// issuePreAuthToken emite un token opaco de corta duración que solo sirve para VerifyMfa.
+// No es un JWT, por lo que no es aceptado por las rutas protegidas.
+func (u *useCases) issuePreAuthToken(ctx context.Context, userID string) (*domain.Token, error) {
+	preAuthToken, err := support.GenerateOpaqueToken(preAuthTokenSize)
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to generate pre-auth token", err)
+	}
+
+	now := time.Now()
+	expiration := u.config.GetMfaConfig().PreAuthExpirationMinutes
+	if err := u.cache.StorePreAuthToken(ctx, preAuthToken, userID, expiration); err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed storing pre-auth token in cache", err)
+	}
+
+	return &domain.Token{
+		AccessToken:     preAuthToken,
+		AccessExpiresAt: now.Add(expiration),
+		IssuedAt:        now,
+		Subject:         userID,
+		TokenType:       domain.TokenTypePreAuth,
+	}, nil
+}
+
+// verifySecondFactor acepta un código TOTP o, en su defecto, un código de recuperación no usado.
+func (u *useCases) verifySecondFactor(ctx context.Context, mfa *userdomain.Mfa, code string) error {
+	valid, err := u.validateTotp(ctx, mfa, code)
+	if err != nil {
+		return err
+	}
+	if valid {
+		return nil
+	}
+
+	normalized := support.NormalizeRecoveryCode(code)
+	for _, rc := range mfa.RecoveryCodes {
+		if !rc.UsedAt.IsZero() {
+			continue
+		}
+		match, err := utils.VerifyPassword(normalized, rc.CodeHash)
+		if err != nil {
+			return types.NewError(types.ErrOperationFailed, "failed to verify recovery code", err)
+		}
+		if match {
+			if err := u.userUc.UseRecoveryCode(ctx, rc.ID); err != nil {
+				return types.NewAuthenticationError("recovery code already used", err)
+			}
+			return nil
+		}
+	}
+
+	return types.NewAuthenticationError("invalid mfa code", nil)
+}
+
+// validateTotp descifra el secreto y verifica el código TOTP. Cada ventana se acepta una sola vez: un
+// código de una ventana igual o anterior a la última aceptada se trata como inválido. Los secretos
+// guardados en claro antes del cifrado se vuelven a guardar cifrados tras un código válido.
+func (u *useCases) validateTotp(ctx context.Context, mfa *userdomain.Mfa, code string) (bool, error) {
+	key := u.config.GetMfaConfig().SecretEncryptionKey
+	secret, legacy, err := support.DecryptMfaSecret(key, mfa.Secret)
+	if err != nil {
+		return false, types.NewError(types.ErrOperationFailed, "failed to decrypt totp secret", err)
+	}
+
+	step, valid, err := u.totpService.ValidateCode(secret, code)
+	if err != nil {
+		return false, types.NewError(types.ErrOperationFailed, "failed to validate totp code", err)
+	}
+	if !valid || step <= mfa.LastUsedStep {
+		return false, nil
+	}
+
+	if err := u.userUc.UseTotpStep(ctx, mfa.UserID, step); err != nil {
+		if types.IsConflict(err) {
+			// Otra verificación concurrente aceptó la misma ventana
+			return false, nil
+		}
+		return false, types.NewError(types.ErrOperationFailed, "failed to record totp step", err)
+	}
+	// Así un SaveMfa posterior no pisa la ventana recién registrada
+	mfa.LastUsedStep = step
+
+	if legacy {
+		encrypted, err := support.EncryptMfaSecret(key, secret)
+		if err != nil {
+			return false, types.NewError(types.ErrOperationFailed, "failed to encrypt totp secret", err)
+		}
+		mfa.Secret = encrypted
+		if err := u.userUc.SaveMfa(ctx, mfa); err != nil {
+			return false, types.NewError(types.ErrOperationFailed, "failed to store encrypted totp secret", err)
+		}
+	}
+	return true, nil
+}
+
+// replaceRecoveryCodes genera y persiste (hasheados) un nuevo juego de códigos de recuperación.
+func (u *useCases) replaceRecoveryCodes(ctx context.Context, userID string) ([]string, error) {
+	codes, err := support.GenerateRecoveryCodes(u.config.GetMfaConfig().RecoveryCodesCount)
+	if err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to generate recovery codes", err)
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hash, err := utils.HashPassword(code, 0)
+		if err != nil {
+			return nil, types.NewError(types.ErrOperationFailed, "failed to hash recovery code", err)
+		}
+		hashes = append(hashes, hash)
+	}
+
+	if err := u.userUc.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, types.NewError(types.ErrOperationFailed, "failed to store recovery codes", err)
+	}
+
+	return codes, nil
+}
@@ -0,0 +1,379 @@
+package authe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	mock_audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/mocks"
+	mock_authe "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/mocks"
+	mock_config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config/mocks"
+	mock_notification "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/notification/mocks"
+	mock_user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/mocks"
+
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/domain"
+	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe/usecases/support"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	usrdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+const (
+	testTokenSecret = "test-secret"
+	// testMfaKey son 32 bytes en base64, como exige la configuración de MFA.
+	testMfaKey = "MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTIzNDU2Nzg5MDE="
+)
+
+// fields agrupa las dependencias mockeadas de los casos de uso de autenticación.
+type fields struct {
+	cache  *mock_authe.MockCache
+	jwt    *mock_authe.MockJwtService
+	http   *mock_authe.MockHttpClient
+	totp   *mock_authe.MockTotpService
+	userUC *mock_user.MockUseCases
+	notif  *mock_notification.MockUseCases
+	config *mock_config.MockLoader
+	audit  *mock_audit.MockUseCases
+}
+
+func newFields(ctrl *gomock.Controller) *fields {
+	f := &fields{
+		cache:  mock_authe.NewMockCache(ctrl),
+		jwt:    mock_authe.NewMockJwtService(ctrl),
+		http:   mock_authe.NewMockHttpClient(ctrl),
+		totp:   mock_authe.NewMockTotpService(ctrl),
+		userUC: mock_user.NewMockUseCases(ctrl),
+		notif:  mock_notification.NewMockUseCases(ctrl),
+		config: mock_config.NewMockLoader(ctrl),
+		audit:  mock_audit.NewMockUseCases(ctrl),
+	}
+	// La configuración se consulta libremente; cada test fija solo lo que le importa.
+	f.config.EXPECT().GetMfaConfig().Return(config.MfaConfig{
+		PreAuthExpirationMinutes: 5 * time.Minute,
+		RecoveryCodesCount:       2,
+		MaxVerifyAttempts:        3,
+		SecretEncryptionKey:      testMfaKey,
+	}).AnyTimes()
+	f.config.EXPECT().GetHrConfig().Return(config.HrConfig{
+		AccessExpirationMinutes:  15 * time.Minute,
+		RefreshExpirationMinutes: 2 * time.Hour,
+	}).AnyTimes()
+	f.config.EXPECT().GetAccountConfig().Return(config.AccountConfig{
+		TokenSecret:                    testTokenSecret,
+		RequireEmailVerification:       true,
+		VerificationURL:                "https://app.test/verify",
+		VerificationSubject:            "Verify",
+		VerificationTemplate:           "{{.URL}}",
+		VerificationExpirationMinutes:  time.Hour,
+		PasswordResetURL:               "https://app.test/reset",
+		PasswordResetSubject:           "Reset",
+		PasswordResetTemplate:          "{{.URL}}",
+		PasswordResetExpirationMinutes: time.Hour,
+	}).AnyTimes()
+	return f
+}
+
+func (f *fields) useCases() UseCases {
+	return NewUseCases(f.cache, f.jwt, f.http, f.totp, f.userUC, f.notif, f.config, f.audit)
+}
+
+// expectLogin espera el registro de un login en la auditoría con la acción indicada.
+func (f *fields) expectLogin(action auditdom.Action) {
+	f.audit.EXPECT().
+		Record(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event *auditdom.AuditEvent) error {
+			if event.Action != action {
+				return errors.New("unexpected audit action " + string(event.Action))
+			}
+			return nil
+		})
+}
+
+func TestVerifyMfa(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	recoveryHash, err := utils.HashPassword("abcde-12345", 4)
+	assert.NoError(t, err)
+	encrypted, err := support.EncryptMfaSecret(testMfaKey, "secret")
+	assert.NoError(t, err)
+	// newMfa devuelve una copia por caso, porque la verificación actualiza la última ventana usada.
+	newMfa := func(secret string, lastUsedStep int64) *usrdom.Mfa {
+		return &usrdom.Mfa{
+			UserID:       "user1",
+			Secret:       secret,
+			Enabled:      true,
+			LastUsedStep: lastUsedStep,
+			RecoveryCodes: []usrdom.RecoveryCode{
+				{ID: "rc1", CodeHash: recoveryHash},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		code      string
+		setup     func(f *fields)
+		wantErr   bool
+		wantToken string
+	}{
+		{
+			name: "Error: pre-auth token expired or already consumed",
+			code: "123456",
+			setup: func(f *fields) {
+				f.cache.EXPECT().
+					ConsumePreAuthToken(gomock.Any(), "pre").
+					Return("", time.Duration(0), types.NewTokenNotFoundError(nil))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Success: valid TOTP code",
+			code: "123456",
+			setup: func(f *fields) {
+				f.cache.EXPECT().ConsumePreAuthToken(gomock.Any(), "pre").Return("user1", 4*time.Minute, nil)
+				f.userUC.EXPECT().GetMfa(gomock.Any(), "user1").Return(newMfa(encrypted, 10), nil)
+				// El secreto se descifra antes de validar el código.
+				f.totp.EXPECT().ValidateCode("secret", "123456").Return(int64(11), true, nil)
+				f.userUC.EXPECT().UseTotpStep(gomock.Any(), "user1", int64(11)).Return(nil)
+				f.cache.EXPECT().DeletePreAuthToken(gomock.Any(), "pre").Return(nil)
+				f.jwt.EXPECT().GenerateHrTokens(gomock.Any(), "user1").Return(&domain.Token{AccessToken: "jwt"}, nil)
+				f.cache.EXPECT().StoreToken(gomock.Any(), "user1", gomock.Any()).Return(nil)
+				f.expectLogin(auditdom.ActionLogin)
+			},
+			wantToken: "jwt",
+		},
+		{
+			name: "Success: legacy plaintext secret is stored encrypted",
+			code: "123456",
+			setup: func(f *fields) {
+				f.cache.EXPECT().ConsumePreAuthToken(gomock.Any(), "pre").Return("user1", 4*time.Minute, nil)
+				f.userUC.EXPECT().GetMfa(gomock.Any(), "user1").Return(newMfa("secret", 0), nil)
+				f.totp.EXPECT().ValidateCode("secret", "123456").Return(int64(11), true, nil)
+				f.userUC.EXPECT().UseTotpStep(gomock.Any(), "user1", int64(11)).Return(nil)
+				f.userUC.EXPECT().
+					SaveMfa(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, mfa *usrdom.Mfa) error {
+						secret, legacy, err := support.DecryptMfaSecret(testMfaKey, mfa.Secret)
+						if err != nil || legacy || secret != "secret" || mfa.LastUsedStep != 11 {
+							return errors.New("secret not re-encrypted")
+						}
+						return nil
+					})
+				f.cache.EXPECT().DeletePreAuthToken(gomock.Any(), "pre").Return(nil)
+				f.jwt.EXPECT().GenerateHrTokens(gomock.Any(), "user1").Return(&domain.Token{AccessToken: "jwt"}, nil)
+				f.cache.EXPECT().StoreToken(gomock.Any(), "user1", gomock.Any()).Return(nil)
+				f.expectLogin(auditdom.ActionLogin)
+			},
+			wantToken: "jwt",
+		},
+		{
+			name: "Error: TOTP code from an already used step is rejected",
+			code: "123456",
+			setup: func(f *fields) {
+				f.cache.EXPECT().ConsumePreAuthToken(gomock.Any(), "pre").Return("user1", 4*time.Minute, nil)
+				f.userUC.EXPECT().GetMfa(gomock.Any(), "user1").Return(newMfa(encrypted, 11), nil)
+				f.totp.EXPECT().ValidateCode("secret", "123456").Return(int64(11), true, nil)
+				f.expectLogin(auditdom.ActionLoginFailed)
+				f.cache.EXPECT().IncrementPreAuthFailures(gomock.Any(), "pre", 5*time.Minute).Return(int64(1), nil)
+				f.cache.EXPECT().StorePreAuthToken(gomock.Any(), "pre", "user1", 4*time.Minute).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error: TOTP step accepted concurrently by another verification",
+			code: "123456",
+			setup: func(f *fields) {
+				f.cache.EXPECT().ConsumePreAuthToken(gomock.Any(), "pre").Return("user1", 4*time.Minute, nil)
+				f.userUC.EXPECT().GetMfa(gomock.Any(), "user1").Return(newMfa(encrypted, 10), nil)
+				f.totp.EXPECT().ValidateCode("secret", "123456").Return(int64(11), true, nil)
+				f.userUC.EXPECT().
+					UseTotpStep(gomock.Any(), "user1", int64(11)).
+					Return(types.NewError(types.ErrConflict, "totp code already used", nil))
+				f.expectLogin(auditdom.ActionLoginFailed)
+				f.cache.EXPECT().IncrementPreAuthFailures(gomock.Any(), "pre", 5*time.Minute).Return(int64(1), nil)
+				f.cache.EXPECT().StorePreAuthToken(gomock.Any(), "pre", "user1", 4*time.Minute).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Success: unused recovery code",
+			code: " ABCDE-12345 ",
+			setup: func(f *fields) {
+				f.cache.EXPECT().ConsumePreAuthToken(gomock.Any(), "pre").Return("user1", 4*time.Minute, nil)
+				f.userUC.EXPECT().GetMfa(gomock.Any(), "user1").Return(newMfa(encrypted, 10), nil)
+				f.totp.EXPECT().ValidateCode("secret", " ABCDE-12345 ").Return(int64(0), false, nil)
+				// El código de recuperación se consume.
+				f.userUC.EXPECT().UseRecoveryCode(gomock.Any(), "rc1").Return(nil)
+				f.cache.EXPECT().DeletePreAuthToken(gomock.Any(), "pre").Return(nil)
+				f.jwt.EXPECT().GenerateHrTokens(gomock.Any(), "user1").Return(&domain.Token{AccessToken: "jwt"}, nil)
+				f.cache.EXPECT().StoreToken(gomock.Any(), "user1", gomock.Any()).Return(nil)
+				f.expectLogin(auditdom.ActionLogin)
+			},
+			wantToken: "jwt",
+		},
+		{
+			name: "Error: invalid code below the attempts limit restores the pre-auth token",
+			code: "000000",
+			setup: func(f *fields) {
+				f.cache.EXPECT().ConsumePreAuthToken(gomock.Any(), "pre").Return("user1", 4*time.Minute, nil)
+				f.userUC.EXPECT().GetMfa(gomock.Any(), "user1").Return(newMfa(encrypted, 10), nil)
+				f.totp.EXPECT().ValidateCode("secret", "000000").Return(int64(0), false, nil)
+				f.expectLogin(auditdom.ActionLoginFailed)
+				f.cache.EXPECT().IncrementPreAuthFailures(gomock.Any(), "pre", 5*time.Minute).Return(int64(1), nil)
+				// Se repone solo por el tiempo que le quedaba al token consumido.
+				f.cache.EXPECT().StorePreAuthToken(gomock.Any(), "pre", "user1", 4*time.Minute).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error: invalid code reaching the attempts limit invalidates the pre-auth token",
+			code: "000000",
+			setup: func(f *fields) {
+				f.cache.EXPECT().ConsumePreAuthToken(gomock.Any(), "pre").Return("user1", 4*time.Minute, nil)
+				f.userUC.EXPECT().GetMfa(gomock.Any(), "user1").Return(newMfa(encrypted, 10), nil)
+				f.totp.EXPECT().ValidateCode("secret", "000000").Return(int64(0), false, nil)
+				f.expectLogin(auditdom.ActionLoginFailed)
+				f.cache.EXPECT().IncrementPreAuthFailures(gomock.Any(), "pre", 5*time.Minute).Return(int64(3), nil)
+				f.cache.EXPECT().DeletePreAuthToken(gomock.Any(), "pre").Return(nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			token, err := f.useCases().VerifyMfa(context.Background(), "pre", tc.code)
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, types.IsAuthenticationError(err), "expected an authentication error, got %v", err)
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+				assert.Equal(t, tc.wantToken, token.AccessToken, "token mismatch")
+			}
+		})
+	}
+}
+
+func TestResetMfa(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		userID  string
+		setup   func(f *fields)
+		wantErr bool
+	}{
+		{
+			name:    "Error: missing user ID",
+			userID:  "",
+			setup:   func(f *fields) {},
+			wantErr: true,
+		},
+		{
+			name:   "Error: failed to delete the MFA settings",
+			userID: "user1",
+			setup: func(f *fields) {
+				f.userUC.EXPECT().DeleteMfa(gomock.Any(), "user1").Return(errors.New("db down"))
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Success: MFA settings removed",
+			userID: "user1",
+			setup: func(f *fields) {
+				f.userUC.EXPECT().DeleteMfa(gomock.Any(), "user1").Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			err := f.useCases().ResetMfa(context.Background(), tc.userID)
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got nil")
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+			}
+		})
+	}
+}
+
+func TestValidateLinkToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name             string
+		setup            func(f *fields)
+		wantErr          bool
+		wantAssessmentID string
+	}{
+		{
+			name: "Error: invalid signature",
+			setup: func(f *fields) {
+				f.jwt.EXPECT().ValidateLinkToken(gomock.Any(), "tok").Return(nil, errors.New("bad signature"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error: session token used as a link token",
+			setup: func(f *fields) {
+				// Los JWT de sesión no llevan propósito.
+				f.jwt.EXPECT().ValidateLinkToken(gomock.Any(), "tok").Return(&domain.TokenClaims{Subject: "user1"}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error: link token without subject",
+			setup: func(f *fields) {
+				f.jwt.EXPECT().
+					ValidateLinkToken(gomock.Any(), "tok").
+					Return(&domain.TokenClaims{Purpose: domain.TokenPurposeAssessmentLink}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Success: link token returns its assessment",
+			setup: func(f *fields) {
+				f.jwt.EXPECT().
+					ValidateLinkToken(gomock.Any(), "tok").
+					Return(&domain.TokenClaims{Subject: "assess1", Purpose: domain.TokenPurposeAssessmentLink}, nil)
+			},
+			wantAssessmentID: "assess1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			assessmentID, err := f.useCases().ValidateLinkToken(context.Background(), "tok")
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, types.IsAuthenticationError(err), "expected an authentication error, got %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.wantAssessmentID, assessmentID, "assessment ID mismatch")
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package browserEvent
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events/repository/models"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events/usecases/domain"
+)
+
+// memoryRepository guarda los eventos del navegador en memoria. No aplica la retención del
+// índice TTL: la base vive lo que vive el proceso.
+type memoryRepository struct {
+	events *mapdb.Table[models.BrowserEvent]
+}
+
+// NewMemoryRepository crea el repositorio de eventos del navegador sobre la base en memoria.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		events: mapdb.NewTable(db, browserEventsCollection,
+			func(e *models.BrowserEvent) string { return e.ID },
+			mapdb.Index[models.BrowserEvent]{
+				Name:   "candidateId",
+				Values: func(e *models.BrowserEvent) []string { return []string{e.CandidateID} },
+			},
+			mapdb.Index[models.BrowserEvent]{
+				Name:   "assessmentIds",
+				Values: func(e *models.BrowserEvent) []string { return e.AssessmentIDs },
+			},
+		),
+	}
+}
+
+func (r *memoryRepository) SaveBrowserEvent(ctx context.Context, event *domain.BrowserEvent) error {
+	m, err := models.FromDomain(event)
+	if err != nil {
+		return err
+	}
+	m.ID = primitive.NewObjectID().Hex()
+	m.CreatedAt = time.Now()
+
+	return r.events.Insert(ctx, m)
+}
+
+func (r *memoryRepository) GetBrowserEventsByCandidateID(ctx context.Context, candidateID string) ([]*domain.BrowserEvent, error) {
+	return r.find(ctx, "candidateId", candidateID)
+}
+
+func (r *memoryRepository) GetBrowserEventsByAsssementID(ctx context.Context, assessmentID string) ([]*domain.BrowserEvent, error) {
+	return r.find(ctx, "assessmentIds", assessmentID)
+}
+
+func (r *memoryRepository) find(ctx context.Context, index, value string) ([]*domain.BrowserEvent, error) {
+	list, err := r.events.FindBy(ctx, index, value)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(list, func(a, b models.BrowserEvent) int { return a.Timestamp.Compare(b.Timestamp) })
+
+	events := make([]*domain.BrowserEvent, 0, len(list))
+	for i := range list {
+		event, err := list[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events/usecases/domain"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// BrowserEvent mocks base method.
+func (m *MockUseCases) BrowserEvent(ctx context.Context, browserEvent *domain.BrowserEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BrowserEvent", ctx, browserEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BrowserEvent indicates an expected call of BrowserEvent.
+func (mr *MockUseCasesMockRecorder) BrowserEvent(ctx, browserEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BrowserEvent", reflect.TypeOf((*MockUseCases)(nil).BrowserEvent), ctx, browserEvent)
+}
+
+// ListAssessmentEvents mocks base method.
+func (m *MockUseCases) ListAssessmentEvents(ctx context.Context, assessmentID string) ([]*domain.BrowserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssessmentEvents", ctx, assessmentID)
+	ret0, _ := ret[0].([]*domain.BrowserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssessmentEvents indicates an expected call of ListAssessmentEvents.
+func (mr *MockUseCasesMockRecorder) ListAssessmentEvents(ctx, assessmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssessmentEvents", reflect.TypeOf((*MockUseCases)(nil).ListAssessmentEvents), ctx, assessmentID)
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder struct {
+	mock *MockCache
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache(ctrl *gomock.Controller) *MockCache {
+	mock := &MockCache{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache) EXPECT() *MockCacheMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockCache) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockCacheMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCache)(nil).Close))
+}
+
+// RetrieveRefreshToken mocks base method.
+func (m *MockCache) RetrieveRefreshToken(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveRefreshToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveRefreshToken indicates an expected call of RetrieveRefreshToken.
+func (mr *MockCacheMockRecorder) RetrieveRefreshToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveRefreshToken", reflect.TypeOf((*MockCache)(nil).RetrieveRefreshToken), arg0, arg1)
+}
+
+// StoreRefreshToken mocks base method.
+func (m *MockCache) StoreRefreshToken(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreRefreshToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreRefreshToken indicates an expected call of StoreRefreshToken.
+func (mr *MockCacheMockRecorder) StoreRefreshToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRefreshToken", reflect.TypeOf((*MockCache)(nil).StoreRefreshToken), arg0, arg1, arg2, arg3)
+}
+
+// MockWebSocket is a mock of WebSocket interface.
+type MockWebSocket struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebSocketMockRecorder
+}
+
+// MockWebSocketMockRecorder is the mock recorder for MockWebSocket.
+type MockWebSocketMockRecorder struct {
+	mock *MockWebSocket
+}
+
+// NewMockWebSocket creates a new mock instance.
+func NewMockWebSocket(ctrl *gomock.Controller) *MockWebSocket {
+	mock := &MockWebSocket{ctrl: ctrl}
+	mock.recorder = &MockWebSocketMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebSocket) EXPECT() *MockWebSocketMockRecorder {
+	return m.recorder
+}
+
+// BrowserEvent mocks base method.
+func (m *MockWebSocket) BrowserEvent(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BrowserEvent", arg0, arg1)
+}
+
+// BrowserEvent indicates an expected call of BrowserEvent.
+func (mr *MockWebSocketMockRecorder) BrowserEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BrowserEvent", reflect.TypeOf((*MockWebSocket)(nil).BrowserEvent), arg0, arg1)
+}
+
+// Ping mocks base method.
+func (m *MockWebSocket) Ping(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Ping", arg0, arg1)
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockWebSocketMockRecorder) Ping(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockWebSocket)(nil).Ping), arg0, arg1)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBrowserEventsByAsssementID mocks base method.
+func (m *MockRepository) GetBrowserEventsByAsssementID(arg0 context.Context, arg1 string) ([]*domain.BrowserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrowserEventsByAsssementID", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.BrowserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrowserEventsByAsssementID indicates an expected call of GetBrowserEventsByAsssementID.
+func (mr *MockRepositoryMockRecorder) GetBrowserEventsByAsssementID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrowserEventsByAsssementID", reflect.TypeOf((*MockRepository)(nil).GetBrowserEventsByAsssementID), arg0, arg1)
+}
+
+// GetBrowserEventsByCandidateID mocks base method.
+func (m *MockRepository) GetBrowserEventsByCandidateID(ctx context.Context, candidateID string) ([]*domain.BrowserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrowserEventsByCandidateID", ctx, candidateID)
+	ret0, _ := ret[0].([]*domain.BrowserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrowserEventsByCandidateID indicates an expected call of GetBrowserEventsByCandidateID.
+func (mr *MockRepositoryMockRecorder) GetBrowserEventsByCandidateID(ctx, candidateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrowserEventsByCandidateID", reflect.TypeOf((*MockRepository)(nil).GetBrowserEventsByCandidateID), ctx, candidateID)
+}
+
+// SaveBrowserEvent mocks base method.
+func (m *MockRepository) SaveBrowserEvent(arg0 context.Context, arg1 *domain.BrowserEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBrowserEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBrowserEvent indicates an expected call of SaveBrowserEvent.
+func (mr *MockRepositoryMockRecorder) SaveBrowserEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBrowserEvent", reflect.TypeOf((*MockRepository)(nil).SaveBrowserEvent), arg0, arg1)
+}
@@ -10,6 +10,8 @@ import (
 
 type UseCases interface {
 	BrowserEvent(ctx context.Context, browserEvent *domain.BrowserEvent) error
+	// ListAssessmentEvents devuelve los eventos registrados durante la evaluación, en orden cronológico.
+	ListAssessmentEvents(ctx context.Context, assessmentID string) ([]*domain.BrowserEvent, error)
 }
 
 type Cache interface {
@@ -29,3 +31,6 @@ type Repository interface {
 	GetBrowserEventsByCandidateID(ctx context.Context, candidateID string) ([]*domain.BrowserEvent, error)
 	GetBrowserEventsByAsssementID(context.Context, string) ([]*domain.BrowserEvent, error)
 }
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_browser_events.go -package=mocks
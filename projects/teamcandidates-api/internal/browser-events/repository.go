@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	mng "github.com/teamcubation/teamcandidates/pkg/databases/nosql/mongodb/mongo-driver"
 
@@ -12,13 +13,38 @@ import (
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events/usecases/domain"
 )
 
+const browserEventsCollection = "browser_events"
+
+// browserEventsRetention es cuánto se conservan los eventos crudos del navegador antes de que el TTL los borre.
+const browserEventsRetention = 180 * 24 * time.Hour
+
+// MongoIndexes son los índices de browser_events que se aseguran al arrancar.
+var MongoIndexes = []mng.IndexDefinition{
+	{
+		Collection: browserEventsCollection,
+		Name:       "candidate_timestamp",
+		Keys:       []mng.IndexKey{mng.Asc("candidateId"), mng.Asc("timestamp")},
+	},
+	{
+		Collection: browserEventsCollection,
+		Name:       "assessments_timestamp",
+		Keys:       []mng.IndexKey{mng.Asc("assessmentIds"), mng.Asc("timestamp")},
+	},
+	{
+		Collection: browserEventsCollection,
+		Name:       "created_at_ttl",
+		Keys:       []mng.IndexKey{mng.Asc("createdAt")},
+		TTL:        browserEventsRetention,
+	},
+}
+
 type mongoRepository struct {
-	repository mng.Repository
+	events *mng.Collection[models.BrowserEvent]
 }
 
 func NewRepository(r mng.Repository) Repository {
 	return &mongoRepository{
-		repository: r,
+		events: mng.NewCollection[models.BrowserEvent](r, browserEventsCollection),
 	}
 }
 
@@ -29,74 +55,37 @@ func (r *mongoRepository) SaveBrowserEvent(ctx context.Context, event *domain.Br
 		return err
 	}
 
-	// Asignar la fecha de creación.
+	// Asignar la fecha de creación (la usa el índice TTL).
 	m.CreatedAt = time.Now()
 
-	// Insertar el documento en la colección "browser_events".
-	_, err = r.repository.DB().Collection("browser_events").InsertOne(ctx, m)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	_, err = r.events.InsertOne(ctx, m)
+	return err
 }
 
-// GetBrowserEventsByCandidateID retorna todos los eventos asociados a un CandidateID.
+// GetBrowserEventsByCandidateID retorna todos los eventos asociados a un CandidateID, en orden cronológico.
 func (r *mongoRepository) GetBrowserEventsByCandidateID(ctx context.Context, candidateID string) ([]*domain.BrowserEvent, error) {
-	// Filtro basado en el campo "candidate_id".
-	filter := bson.M{"candidate_id": candidateID}
-
-	cursor, err := r.repository.DB().Collection("browser_events").Find(ctx, filter)
-	if err != nil {
-		return nil, err
-	}
-	defer cursor.Close(ctx)
-
-	var events []*domain.BrowserEvent
-	for cursor.Next(ctx) {
-		var m models.BrowserEvent
-		if err := cursor.Decode(&m); err != nil {
-			return nil, err
-		}
-		event, err := m.ToDomain()
-		if err != nil {
-			return nil, err
-		}
-		events = append(events, event)
-	}
-	if err := cursor.Err(); err != nil {
-		return nil, err
-	}
-	return events, nil
+	return r.find(ctx, bson.M{"candidateId": candidateID})
 }
 
-// GetBrowserEventsByAsssementID retorna todos los eventos asociados a un AssessmentID.
-// Ten en cuenta que se asume que en el modelo, "assessment_ids" es un slice/array y
-// Mongo realiza la búsqueda en el array de forma automática.
+// GetBrowserEventsByAsssementID retorna todos los eventos asociados a un AssessmentID, en orden cronológico.
+// "assessmentIds" es un array y Mongo realiza la búsqueda en el array de forma automática.
 func (r *mongoRepository) GetBrowserEventsByAsssementID(ctx context.Context, assessmentID string) ([]*domain.BrowserEvent, error) {
-	// Filtro basado en el campo "assessment_ids".
-	filter := bson.M{"assessment_ids": assessmentID}
+	return r.find(ctx, bson.M{"assessmentIds": assessmentID})
+}
 
-	cursor, err := r.repository.DB().Collection("browser_events").Find(ctx, filter)
+func (r *mongoRepository) find(ctx context.Context, filter bson.M) ([]*domain.BrowserEvent, error) {
+	list, err := r.events.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}}))
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var events []*domain.BrowserEvent
-	for cursor.Next(ctx) {
-		var m models.BrowserEvent
-		if err := cursor.Decode(&m); err != nil {
-			return nil, err
-		}
-		event, err := m.ToDomain()
+	events := make([]*domain.BrowserEvent, 0, len(list))
+	for i := range list {
+		event, err := list[i].ToDomain()
 		if err != nil {
 			return nil, err
 		}
 		events = append(events, event)
 	}
-	if err := cursor.Err(); err != nil {
-		return nil, err
-	}
 	return events, nil
 }
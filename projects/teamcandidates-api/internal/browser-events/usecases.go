@@ -26,3 +26,12 @@ func (u *useCases) BrowserEvent(ctx context.Context, event *domain.BrowserEvent)
 
 	return nil
 }
+
+func (u *useCases) ListAssessmentEvents(ctx context.Context, assessmentID string) ([]*domain.BrowserEvent, error) {
+	events, err := u.repository.GetBrowserEventsByAsssementID(ctx, assessmentID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing browser events: %w", err)
+	}
+
+	return events, nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
 	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/repository/models"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
@@ -42,21 +44,21 @@ func (r *repository) CreateCandidate(ctx context.Context, candidate *domain.Cand
 	return model.ID, nil
 }
 
-func (r *repository) ListCandidates(ctx context.Context) ([]domain.Candidate, error) {
-	var models []models.CreateCandidate
-	if err := r.db.Client().WithContext(ctx).Find(&models).Error; err != nil {
+func (r *repository) ListCandidates(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Candidate], error) {
+	page, err := gorm.Paginate[models.Candidate](r.db.Client().WithContext(ctx).Model(&models.Candidate{}), spec)
+	if err != nil {
 		return nil, err
 	}
 
-	users := make([]domain.Candidate, 0, len(models))
-	for _, m := range models {
+	candidates := make([]domain.Candidate, 0, len(page.Items))
+	for _, m := range page.Items {
 		candidate, err := m.ToDomain()
 		if err != nil {
 			return nil, err
 		}
-		users = append(users, *candidate)
+		candidates = append(candidates, *candidate)
 	}
-	return users, nil
+	return &types.Page[domain.Candidate]{Items: candidates, Info: page.Info}, nil
 }
 
 func (r *repository) GetCandidate(ctx context.Context, id string) (*domain.Candidate, error) {
@@ -78,6 +80,49 @@ func (r *repository) UpdateCandidate(ctx context.Context, candidate *domain.Cand
 	return r.db.Client().WithContext(ctx).Save(model).Error
 }
 
-func (r *repository) DeleteCandidate(ctx context.Context, id string) error {
-	return r.db.Client().WithContext(ctx).Delete(&models.CreateCandidate{}, "id = ?", id).Error
+func (r *repository) SoftDeleteCandidate(ctx context.Context, id, deletedBy string) error {
+	result := r.db.Client().WithContext(ctx).Model(&models.Candidate{}).Where("id = ?", id).
+		Updates(map[string]any{"deleted_at": time.Now(), "deleted_by": deletedBy})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete candidate: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("candidate with id %s not found", id), nil)
+	}
+	return nil
+}
+
+func (r *repository) HardDeleteCandidate(ctx context.Context, id string) error {
+	result := r.db.Client().WithContext(ctx).Unscoped().Delete(&models.Candidate{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete candidate: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("candidate with id %s not found", id), nil)
+	}
+	return nil
+}
+
+func (r *repository) RestoreCandidate(ctx context.Context, id string) error {
+	result := r.db.Client().WithContext(ctx).Unscoped().Model(&models.Candidate{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_by": nil})
+	if result.Error != nil {
+		return fmt.Errorf("failed to restore candidate: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("deleted candidate with id %s not found", id), nil)
+	}
+	return nil
+}
+
+// PurgeDeletedCandidates borra definitivamente los candidatos con soft delete anterior a before.
+func (r *repository) PurgeDeletedCandidates(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.Client().WithContext(ctx).Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Delete(&models.Candidate{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge deleted candidates: %w", result.Error)
+	}
+	return result.RowsAffected, nil
 }
@@ -61,14 +61,16 @@ func (h *Handler) Routes() {
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionCandidateRead, types.PermissionCandidateWrite))
 
 		protected.GET("/ping", h.ProtectedPing)
 
 		protected.POST("", h.CreateCandidate)
-		protected.GET("", h.ListCandidates)
+		protected.GET("", mdw.ParseQuerySpec(dto.CandidateQuerySchema), h.ListCandidates)
 		protected.GET("/:id", h.GetCandidate)
 		protected.PUT("/:id", h.UpdateCandidate)
 		protected.DELETE("/:id", h.DeleteCandidate)
+		protected.POST("/:id/restore", mdw.RequirePermission(types.PermissionRestore), h.RestoreCandidate)
 	}
 }
 
@@ -104,12 +106,27 @@ func (h *Handler) CreateCandidate(c *gin.Context) {
 
 // ListCandidates retorna la lista de candidates.
 func (h *Handler) ListCandidates(c *gin.Context) {
-	candidates, err := h.ucs.ListCandidates(c.Request.Context())
+	spec, err := mdw.GetQuerySpec(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "error fetching candidates"})
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	page, err := h.ucs.ListCandidates(c.Request.Context(), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainListItem), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
 		return
 	}
-	c.JSON(http.StatusOK, candidates)
+	c.JSON(http.StatusOK, res)
 }
 
 // GetCandidate retorna la información de un candidate en función del ID.
@@ -146,9 +163,11 @@ func (h *Handler) UpdateCandidate(c *gin.Context) {
 }
 
 // DeleteCandidate procesa la eliminación de un candidate.
+// Por defecto es un soft delete; con hardDelete=true se elimina definitivamente.
 func (h *Handler) DeleteCandidate(c *gin.Context) {
 	id := c.Param("id")
-	if err := h.ucs.DeleteCandidate(c.Request.Context(), id); err != nil {
+	hardDelete := c.Query("hardDelete") == "true"
+	if err := h.ucs.DeleteCandidate(c.Request.Context(), id, hardDelete); err != nil {
 		apiErr, errCode := types.NewAPIError(err)
 		c.Error(apiErr).SetMeta(errCode)
 		return
@@ -157,3 +176,16 @@ func (h *Handler) DeleteCandidate(c *gin.Context) {
 		Message: "Candidate deleted successfully",
 	})
 }
+
+// RestoreCandidate deshace el soft delete de un candidate.
+func (h *Handler) RestoreCandidate(c *gin.Context) {
+	id := c.Param("id")
+	if err := h.ucs.RestoreCandidate(c.Request.Context(), id); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Candidate restored successfully",
+	})
+}
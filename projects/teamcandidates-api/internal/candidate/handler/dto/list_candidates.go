@@ -1,5 +1,42 @@
 package dto
 
+import (
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+)
+
 type ListCandidatesResponse struct {
 	Candidates []Candidate `json:"candidates"`
 }
+
+// CandidateListItem es la vista de un candidato en el listado.
+// DeletedAt y DeletedBy solo aparecen cuando un admin lista con deleted=include|only.
+type CandidateListItem struct {
+	Candidate
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy string     `json:"deleted_by,omitempty"`
+}
+
+// CandidateQuerySchema define los campos por los que se puede filtrar, ordenar y seleccionar en el listado.
+var CandidateQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":               {Column: "id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"person_id":        {Column: "person_id", Type: types.FieldString, Filterable: true},
+		"email":            {Column: "email", Type: types.FieldString, Filterable: true, Sortable: true},
+		"experience_level": {Column: "experience_level", Type: types.FieldString, Filterable: true, Sortable: true},
+		"experience_rank":  {Column: "experience_rank", Type: types.FieldInt, Filterable: true, Sortable: true},
+		"assessments_ids":  {Column: "assessments_ids", Type: types.FieldString},
+		"created_at":       {Column: "created_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"deleted_at":       {Column: "deleted_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"-created_at"},
+}
+
+// FromDomainListItem convierte un candidato del listado al DTO.
+func FromDomainListItem(candidate domain.Candidate) CandidateListItem {
+	item, _ := FromDomain(&candidate)
+	return CandidateListItem{Candidate: *item, DeletedAt: candidate.DeletedAt, DeletedBy: candidate.DeletedBy}
+}
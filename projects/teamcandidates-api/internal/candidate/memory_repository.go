@@ -0,0 +1,129 @@
+package candidate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+)
+
+type memoryRepository struct {
+	candidates *mapdb.Table[models.Candidate]
+}
+
+// NewMemoryRepository crea el repositorio de candidatos sobre la base en memoria.
+// Guarda los mismos modelos que GORM, así que los filtros y el orden del listado usan las mismas columnas.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		candidates: mapdb.NewTable(db, "candidates",
+			func(c *models.Candidate) string { return c.ID },
+			mapdb.Index[models.Candidate]{
+				Name:   "email",
+				Unique: true,
+				Values: func(c *models.Candidate) []string { return []string{c.Email} },
+			},
+		),
+	}
+}
+
+func (r *memoryRepository) CreateCandidate(ctx context.Context, candidate *domain.Candidate) (string, error) {
+	if candidate == nil {
+		return "", errors.New("candidate is nil")
+	}
+
+	model, err := models.FromDomainCandidate(candidate)
+	if err != nil {
+		return "", err
+	}
+	model.ID = uuid.New().String()
+	model.CreatedAt = time.Now()
+
+	if err := r.candidates.Insert(ctx, model); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) ListCandidates(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Candidate], error) {
+	page, err := r.candidates.Page(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]domain.Candidate, 0, len(page.Items))
+	for _, m := range page.Items {
+		candidate, err := m.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, *candidate)
+	}
+	return &types.Page[domain.Candidate]{Items: candidates, Info: page.Info}, nil
+}
+
+func (r *memoryRepository) GetCandidate(ctx context.Context, id string) (*domain.Candidate, error) {
+	model, err := r.candidates.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if mapdb.IsDeleted(model) {
+		return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("candidate with id %s not found", id), nil)
+	}
+	return model.ToDomain()
+}
+
+func (r *memoryRepository) UpdateCandidate(ctx context.Context, candidate *domain.Candidate) error {
+	updated, err := models.FromDomainCandidate(candidate)
+	if err != nil {
+		return err
+	}
+	return r.candidates.Modify(ctx, candidate.ID, func(m *models.Candidate) error {
+		now := time.Now()
+		updated.CreatedAt, updated.UpdatedAt = m.CreatedAt, &now
+		updated.DeletedAt, updated.DeletedBy = m.DeletedAt, m.DeletedBy
+		*m = *updated
+		return nil
+	})
+}
+
+func (r *memoryRepository) SoftDeleteCandidate(ctx context.Context, id, deletedBy string) error {
+	return r.candidates.Modify(ctx, id, func(m *models.Candidate) error {
+		if m.DeletedAt.Valid {
+			return types.NewError(types.ErrNotFound, fmt.Sprintf("candidate with id %s not found", id), nil)
+		}
+		m.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		m.DeletedBy = deletedBy
+		return nil
+	})
+}
+
+func (r *memoryRepository) HardDeleteCandidate(ctx context.Context, id string) error {
+	return r.candidates.Delete(ctx, id)
+}
+
+func (r *memoryRepository) RestoreCandidate(ctx context.Context, id string) error {
+	return r.candidates.Modify(ctx, id, func(m *models.Candidate) error {
+		if !m.DeletedAt.Valid {
+			return types.NewError(types.ErrNotFound, fmt.Sprintf("deleted candidate with id %s not found", id), nil)
+		}
+		m.DeletedAt = gorm.DeletedAt{}
+		m.DeletedBy = ""
+		return nil
+	})
+}
+
+func (r *memoryRepository) PurgeDeletedCandidates(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := r.candidates.DeleteWhere(ctx, func(m *models.Candidate) bool {
+		return m.DeletedAt.Valid && m.DeletedAt.Time.Before(before)
+	})
+	return int64(purged), err
+}
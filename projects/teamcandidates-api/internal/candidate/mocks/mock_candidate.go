@@ -0,0 +1,343 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// CreateCandidate mocks base method.
+func (m *MockUseCases) CreateCandidate(arg0 context.Context, arg1 *domain.Candidate) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCandidate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCandidate indicates an expected call of CreateCandidate.
+func (mr *MockUseCasesMockRecorder) CreateCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCandidate", reflect.TypeOf((*MockUseCases)(nil).CreateCandidate), arg0, arg1)
+}
+
+// DeleteCandidate mocks base method.
+func (m *MockUseCases) DeleteCandidate(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCandidate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCandidate indicates an expected call of DeleteCandidate.
+func (mr *MockUseCasesMockRecorder) DeleteCandidate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCandidate", reflect.TypeOf((*MockUseCases)(nil).DeleteCandidate), arg0, arg1, arg2)
+}
+
+// GetCandidate mocks base method.
+func (m *MockUseCases) GetCandidate(arg0 context.Context, arg1 string) (*domain.Candidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCandidate", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Candidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidate indicates an expected call of GetCandidate.
+func (mr *MockUseCasesMockRecorder) GetCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidate", reflect.TypeOf((*MockUseCases)(nil).GetCandidate), arg0, arg1)
+}
+
+// ListCandidates mocks base method.
+func (m *MockUseCases) ListCandidates(arg0 context.Context, arg1 *types.QuerySpec) (*types.Page[domain.Candidate], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCandidates", arg0, arg1)
+	ret0, _ := ret[0].(*types.Page[domain.Candidate])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCandidates indicates an expected call of ListCandidates.
+func (mr *MockUseCasesMockRecorder) ListCandidates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCandidates", reflect.TypeOf((*MockUseCases)(nil).ListCandidates), arg0, arg1)
+}
+
+// PurgeDeletedCandidates mocks base method.
+func (m *MockUseCases) PurgeDeletedCandidates(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedCandidates", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedCandidates indicates an expected call of PurgeDeletedCandidates.
+func (mr *MockUseCasesMockRecorder) PurgeDeletedCandidates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedCandidates", reflect.TypeOf((*MockUseCases)(nil).PurgeDeletedCandidates), arg0, arg1)
+}
+
+// RestoreCandidate mocks base method.
+func (m *MockUseCases) RestoreCandidate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCandidate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreCandidate indicates an expected call of RestoreCandidate.
+func (mr *MockUseCasesMockRecorder) RestoreCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCandidate", reflect.TypeOf((*MockUseCases)(nil).RestoreCandidate), arg0, arg1)
+}
+
+// UpdateCandidate mocks base method.
+func (m *MockUseCases) UpdateCandidate(arg0 context.Context, arg1 *domain.Candidate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCandidate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCandidate indicates an expected call of UpdateCandidate.
+func (mr *MockUseCasesMockRecorder) UpdateCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCandidate", reflect.TypeOf((*MockUseCases)(nil).UpdateCandidate), arg0, arg1)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCandidate mocks base method.
+func (m *MockRepository) CreateCandidate(arg0 context.Context, arg1 *domain.Candidate) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCandidate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCandidate indicates an expected call of CreateCandidate.
+func (mr *MockRepositoryMockRecorder) CreateCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCandidate", reflect.TypeOf((*MockRepository)(nil).CreateCandidate), arg0, arg1)
+}
+
+// GetCandidate mocks base method.
+func (m *MockRepository) GetCandidate(arg0 context.Context, arg1 string) (*domain.Candidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCandidate", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Candidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidate indicates an expected call of GetCandidate.
+func (mr *MockRepositoryMockRecorder) GetCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidate", reflect.TypeOf((*MockRepository)(nil).GetCandidate), arg0, arg1)
+}
+
+// HardDeleteCandidate mocks base method.
+func (m *MockRepository) HardDeleteCandidate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDeleteCandidate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HardDeleteCandidate indicates an expected call of HardDeleteCandidate.
+func (mr *MockRepositoryMockRecorder) HardDeleteCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDeleteCandidate", reflect.TypeOf((*MockRepository)(nil).HardDeleteCandidate), arg0, arg1)
+}
+
+// ListCandidates mocks base method.
+func (m *MockRepository) ListCandidates(arg0 context.Context, arg1 *types.QuerySpec) (*types.Page[domain.Candidate], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCandidates", arg0, arg1)
+	ret0, _ := ret[0].(*types.Page[domain.Candidate])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCandidates indicates an expected call of ListCandidates.
+func (mr *MockRepositoryMockRecorder) ListCandidates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCandidates", reflect.TypeOf((*MockRepository)(nil).ListCandidates), arg0, arg1)
+}
+
+// PurgeDeletedCandidates mocks base method.
+func (m *MockRepository) PurgeDeletedCandidates(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedCandidates", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedCandidates indicates an expected call of PurgeDeletedCandidates.
+func (mr *MockRepositoryMockRecorder) PurgeDeletedCandidates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedCandidates", reflect.TypeOf((*MockRepository)(nil).PurgeDeletedCandidates), arg0, arg1)
+}
+
+// RestoreCandidate mocks base method.
+func (m *MockRepository) RestoreCandidate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCandidate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreCandidate indicates an expected call of RestoreCandidate.
+func (mr *MockRepositoryMockRecorder) RestoreCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCandidate", reflect.TypeOf((*MockRepository)(nil).RestoreCandidate), arg0, arg1)
+}
+
+// SoftDeleteCandidate mocks base method.
+func (m *MockRepository) SoftDeleteCandidate(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteCandidate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteCandidate indicates an expected call of SoftDeleteCandidate.
+func (mr *MockRepositoryMockRecorder) SoftDeleteCandidate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteCandidate", reflect.TypeOf((*MockRepository)(nil).SoftDeleteCandidate), arg0, arg1, arg2)
+}
+
+// UpdateCandidate mocks base method.
+func (m *MockRepository) UpdateCandidate(arg0 context.Context, arg1 *domain.Candidate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCandidate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCandidate indicates an expected call of UpdateCandidate.
+func (mr *MockRepositoryMockRecorder) UpdateCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCandidate", reflect.TypeOf((*MockRepository)(nil).UpdateCandidate), arg0, arg1)
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder struct {
+	mock *MockCache
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache(ctrl *gomock.Controller) *MockCache {
+	mock := &MockCache{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache) EXPECT() *MockCacheMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockCache) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockCacheMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCache)(nil).Close))
+}
+
+// RetrieveRefreshToken mocks base method.
+func (m *MockCache) RetrieveRefreshToken(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveRefreshToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveRefreshToken indicates an expected call of RetrieveRefreshToken.
+func (mr *MockCacheMockRecorder) RetrieveRefreshToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveRefreshToken", reflect.TypeOf((*MockCache)(nil).RetrieveRefreshToken), arg0, arg1)
+}
+
+// StoreRefreshToken mocks base method.
+func (m *MockCache) StoreRefreshToken(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreRefreshToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreRefreshToken indicates an expected call of StoreRefreshToken.
+func (mr *MockCacheMockRecorder) StoreRefreshToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRefreshToken", reflect.TypeOf((*MockCache)(nil).StoreRefreshToken), arg0, arg1, arg2, arg3)
+}
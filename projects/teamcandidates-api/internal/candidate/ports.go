@@ -4,14 +4,18 @@ import (
 	"context"
 	"time"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
 )
 
 type UseCases interface {
 	CreateCandidate(context.Context, *domain.Candidate) (string, error)
 	GetCandidate(context.Context, string) (*domain.Candidate, error)
-	DeleteCandidate(context.Context, string) error
-	ListCandidates(context.Context) ([]domain.Candidate, error)
+	DeleteCandidate(context.Context, string, bool) error
+	RestoreCandidate(context.Context, string) error
+	PurgeDeletedCandidates(context.Context, time.Time) (int64, error)
+	ListCandidates(context.Context, *types.QuerySpec) (*types.Page[domain.Candidate], error)
 	UpdateCandidate(context.Context, *domain.Candidate) error
 }
 
@@ -19,8 +23,11 @@ type Repository interface {
 	CreateCandidate(context.Context, *domain.Candidate) (string, error)
 	UpdateCandidate(context.Context, *domain.Candidate) error
 	GetCandidate(context.Context, string) (*domain.Candidate, error)
-	DeleteCandidate(context.Context, string) error
-	ListCandidates(context.Context) ([]domain.Candidate, error)
+	SoftDeleteCandidate(context.Context, string, string) error
+	HardDeleteCandidate(context.Context, string) error
+	RestoreCandidate(context.Context, string) error
+	PurgeDeletedCandidates(context.Context, time.Time) (int64, error)
+	ListCandidates(context.Context, *types.QuerySpec) (*types.Page[domain.Candidate], error)
 }
 
 type Cache interface {
@@ -50,3 +57,6 @@ type Cache interface {
 // type MessageQueu interface {
 // 	SendVerificationEmail(context.Context, string, string) error
 // }
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_candidate.go -package=mocks
@@ -19,6 +19,7 @@ type Candidate struct {
 	CreatedAt       time.Time      `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt       *time.Time     `gorm:"column:updated_at;autoUpdateTime"`
 	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	DeletedBy       string         `gorm:"column:deleted_by"`
 }
 
 // FromDomainCandidate convierte una entidad de dominio Candidate en el modelo Candidate.
@@ -65,5 +66,14 @@ func (cm *Candidate) ToDomain() (*domain.Candidate, error) {
 			Rank:  cm.ExperienceRank,
 		},
 		AssessmentsIDs: assessmentIDs,
+		DeletedAt:      deletedAt(cm.DeletedAt),
+		DeletedBy:      cm.DeletedBy,
 	}, nil
 }
+
+func deletedAt(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
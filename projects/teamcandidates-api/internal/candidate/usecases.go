@@ -3,17 +3,27 @@ package candidate
 import (
 	"context"
 	"fmt"
+	"time"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
 )
 
+// auditResource es el tipo de recurso con el que se registran los cambios de candidatos.
+const auditResource = "candidate"
+
 type useCases struct {
 	repository Repository
+	audit      audit.UseCases
 }
 
-func NewUseCases(rp Repository) UseCases {
+func NewUseCases(rp Repository, au audit.UseCases) UseCases {
 	return &useCases{
 		repository: rp,
+		audit:      au,
 	}
 }
 
@@ -23,12 +33,13 @@ func (u *useCases) CreateCandidate(ctx context.Context, candidate *domain.Candid
 	if err != nil {
 		return "", fmt.Errorf("failed to create candidate: %w", err)
 	}
+
+	u.audit.RecordChange(ctx, auditdom.ActionCreate, auditResource, candidateID, nil, candidate)
 	return candidateID, nil
 }
 
-func (u *useCases) ListCandidates(ctx context.Context) ([]domain.Candidate, error) {
-	db, err := u.repository.ListCandidates(ctx)
-	return db, err
+func (u *useCases) ListCandidates(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Candidate], error) {
+	return u.repository.ListCandidates(ctx, spec)
 }
 
 func (u *useCases) GetCandidate(ctx context.Context, candidateID string) (*domain.Candidate, error) {
@@ -39,10 +50,53 @@ func (u *useCases) GetCandidate(ctx context.Context, candidateID string) (*domai
 	return candidate, nil
 }
 
-func (u *useCases) DeleteCandidate(ctx context.Context, ID string) error {
-	return u.repository.DeleteCandidate(ctx, ID)
+func (u *useCases) DeleteCandidate(ctx context.Context, ID string, hardDelete bool) error {
+	before, _ := u.repository.GetCandidate(ctx, ID)
+
+	var err error
+	if hardDelete {
+		err = u.repository.HardDeleteCandidate(ctx, ID)
+	} else {
+		err = u.repository.SoftDeleteCandidate(ctx, ID, types.PrincipalIDFromContext(ctx))
+	}
+	if err != nil {
+		return err
+	}
+
+	u.audit.RecordChange(ctx, auditdom.ActionDelete, auditResource, ID, before, nil)
+	return nil
+}
+
+func (u *useCases) RestoreCandidate(ctx context.Context, ID string) error {
+	if err := u.repository.RestoreCandidate(ctx, ID); err != nil {
+		return err
+	}
+
+	after, _ := u.repository.GetCandidate(ctx, ID)
+	u.audit.RecordChange(ctx, auditdom.ActionRestore, auditResource, ID, nil, after)
+	return nil
+}
+
+// PurgeDeletedCandidates elimina definitivamente los candidatos borrados antes de before.
+func (u *useCases) PurgeDeletedCandidates(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := u.repository.PurgeDeletedCandidates(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+
+	if purged > 0 {
+		u.audit.RecordChange(ctx, auditdom.ActionPurge, auditResource, "", nil, map[string]any{"before": before, "purged": purged})
+	}
+	return purged, nil
 }
 
 func (u *useCases) UpdateCandidate(ctx context.Context, updatedCandidate *domain.Candidate) error {
-	return u.repository.UpdateCandidate(ctx, updatedCandidate)
+	before, _ := u.repository.GetCandidate(ctx, updatedCandidate.ID)
+	if err := u.repository.UpdateCandidate(ctx, updatedCandidate); err != nil {
+		return err
+	}
+
+	after, _ := u.repository.GetCandidate(ctx, updatedCandidate.ID)
+	u.audit.RecordChange(ctx, auditdom.ActionUpdate, auditResource, updatedCandidate.ID, before, after)
+	return nil
 }
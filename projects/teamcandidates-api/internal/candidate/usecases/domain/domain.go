@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type ExperienceLevel string
 
 const (
@@ -29,6 +31,8 @@ type Candidate struct {
 	Email          string
 	Experience     Experience
 	AssessmentsIDs []AssessmentID
+	DeletedAt      *time.Time // Momento del soft delete
+	DeletedBy      string     // Principal que lo eliminó
 }
 
 type AssessmentID string
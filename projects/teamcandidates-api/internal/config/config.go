@@ -2,8 +2,11 @@
 package config
 
 import (
+	"cmp"
+	"encoding/base64"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -33,6 +36,66 @@ type AssessmentConfig struct {
 	BodyTemplate             string
 	AccessExpirationMinutes  time.Duration
 	RefreshExpirationMinutes time.Duration
+	ExpiryEnabled            bool            // Si es false no corre el scheduler que cierra las sesiones vencidas
+	ExpiryCheckInterval      time.Duration   // Cada cuánto se buscan sesiones vencidas
+	DeadlineGrace            time.Duration   // Margen después del deadline para el último autosave antes de cerrar la sesión
+	RemindersEnabled         bool            // Si es false no corre el scheduler de recordatorios de los links
+	ReminderOffsets          []time.Duration // Anticipación al vencimiento del link con la que se manda cada recordatorio (de mayor a menor)
+	ReminderCheckInterval    time.Duration   // Cada cuánto se buscan links que necesitan recordatorio
+	ReminderSubject          string
+	ReminderTemplate         string // Se agrega al cuerpo del email del link; %s es la fecha de vencimiento
+}
+
+// MfaConfig contiene la configuración de la autenticación multifactor (TOTP).
+type MfaConfig struct {
+	PreAuthExpirationMinutes time.Duration
+	RecoveryCodesCount       int
+	MaxVerifyAttempts        int    // Códigos fallidos tolerados por token de pre-autenticación
+	SecretEncryptionKey      string // Clave AES-256 en base64 con la que se cifran los secretos TOTP
+}
+
+// AccountConfig contiene la configuración de verificación de email y reseteo de contraseña.
+type AccountConfig struct {
+	TokenSecret                    string
+	RequireEmailVerification       bool
+	VerificationURL                string
+	VerificationSubject            string
+	VerificationTemplate           string
+	VerificationExpirationMinutes  time.Duration
+	PasswordResetURL               string
+	PasswordResetSubject           string
+	PasswordResetTemplate          string
+	PasswordResetExpirationMinutes time.Duration
+}
+
+// RetentionConfig contiene la configuración de la purga de registros con soft delete.
+type RetentionConfig struct {
+	Enabled       bool
+	Window        time.Duration // Antigüedad a partir de la cual un registro borrado se purga
+	PurgeInterval time.Duration
+}
+
+// GradingConfig contiene la configuración de la corrección automática de las evaluaciones.
+type GradingConfig struct {
+	Enabled  bool   // Si es false no se arrancan los workers; los jobs quedan en la cola
+	Queue    string // Cola del broker por la que llegan los jobs de corrección
+	Exchange string // Exchange al que se bindea la cola (el mismo en el que publica el producer)
+	Workers  int    // Cantidad de jobs que se corrigen en paralelo
+
+	QualityEnabled      bool    // Analiza la calidad del código de cada entrega
+	RubricTestWeight    float64 // Peso (0 a 100) del porcentaje de pruebas aprobadas en el puntaje final
+	RubricQualityWeight float64 // Peso (0 a 100) de la calidad del código en el puntaje final
+
+	SimilarityEnabled    bool    // Compara cada entrega con las anteriores del mismo problema
+	SimilarityThreshold  float64 // Similitud mínima (0 a 100) para guardar una entrega como parecida
+	SimilarityFlagScore  float64 // Similitud (0 a 100) a partir de la cual la corrección queda marcada para revisión
+	SimilarityMaxMatches int     // Entregas parecidas que se guardan por corrección
+}
+
+// ReportConfig contiene la configuración de los reportes de resultados de las evaluaciones.
+type ReportConfig struct {
+	IntegrityFlagEvents int // Eventos sospechosos del navegador a partir de los cuales el reporte marca la evaluación
+	ExportMaxRows       int // Evaluaciones que puede incluir una exportación masiva
 }
 
 // PepEndpoints define los endpoints específicos para PEP.
@@ -55,6 +118,11 @@ type Config struct {
 	Hr         HrConfig
 	Assessment AssessmentConfig
 	Pep        PepConfig
+	Mfa        MfaConfig
+	Account    AccountConfig
+	Retention  RetentionConfig
+	Grading    GradingConfig
+	Report     ReportConfig
 }
 
 // configLoader implementa la interfaz Loader.
@@ -94,6 +162,14 @@ func NewConfigLoader() (Loader, error) {
 		BodyTemplate:             getEnv("ASSESSMENT_TEST_TEMPLATE", "This is a test email with a unique link: <a href=\"%s\">Open link</a>"),
 		AccessExpirationMinutes:  getEnvDuration("ASSESSMENT_TEST_TOKEN_ACCESS_EXPIRATION_MINUTES", 4320),
 		RefreshExpirationMinutes: getEnvDuration("ASSESSMENT_TEST_TOKEN_REFRESH_EXPIRATION_MINUTES", 10080),
+		ExpiryEnabled:            getEnvBool("ASSESSMENT_EXPIRY_ENABLED", true),
+		ExpiryCheckInterval:      getEnvDuration("ASSESSMENT_EXPIRY_CHECK_INTERVAL_MINUTES", 1),
+		DeadlineGrace:            getEnvDuration("ASSESSMENT_DEADLINE_GRACE_MINUTES", 1),
+		RemindersEnabled:         getEnvBool("ASSESSMENT_REMINDERS_ENABLED", true),
+		ReminderOffsets:          getEnvDurations("ASSESSMENT_REMINDER_OFFSETS_MINUTES", []int{1440, 60}),
+		ReminderCheckInterval:    getEnvDuration("ASSESSMENT_REMINDER_CHECK_INTERVAL_MINUTES", 5),
+		ReminderSubject:          getEnv("ASSESSMENT_REMINDER_SUBJECT", "Reminder: your assessment link expires soon"),
+		ReminderTemplate:         getEnv("ASSESSMENT_REMINDER_TEMPLATE", "Remember that your assessment link expires on %s."),
 	}
 
 	// Parsear variables de entorno para PepConfig
@@ -107,12 +183,68 @@ func NewConfigLoader() (Loader, error) {
 		SigningMethod: getEnv("PEP_SIGNING_METHOD", "HMAC"), // Añadido SigningMethod
 	}
 
+	// Parsear variables de entorno para MfaConfig
+	mfaConfig := MfaConfig{
+		PreAuthExpirationMinutes: getEnvDuration("MFA_PRE_AUTH_EXPIRATION_MINUTES", 5),
+		RecoveryCodesCount:       getEnvInt("MFA_RECOVERY_CODES_COUNT", 10),
+		MaxVerifyAttempts:        getEnvInt("MFA_MAX_VERIFY_ATTEMPTS", 5),
+		SecretEncryptionKey:      getEnv("MFA_SECRET_ENCRYPTION_KEY", ""),
+	}
+
+	// Parsear variables de entorno para AccountConfig
+	accountConfig := AccountConfig{
+		TokenSecret:                    getEnv("ACCOUNT_TOKEN_SECRET", ""),
+		RequireEmailVerification:       getEnvBool("ACCOUNT_REQUIRE_EMAIL_VERIFICATION", false),
+		VerificationURL:                getEnv("ACCOUNT_VERIFICATION_URL", "http://localhost:8090/verify-email"),
+		VerificationSubject:            getEnv("ACCOUNT_VERIFICATION_SUBJECT", "Verify your email"),
+		VerificationTemplate:           getEnv("ACCOUNT_VERIFICATION_TEMPLATE", "Confirm your email address: <a href=\"{{.URL}}\">Verify email</a>. The link expires at {{.ExpiresAt}}."),
+		VerificationExpirationMinutes:  getEnvDuration("ACCOUNT_VERIFICATION_EXPIRATION_MINUTES", 1440),
+		PasswordResetURL:               getEnv("ACCOUNT_PASSWORD_RESET_URL", "http://localhost:8090/reset-password"),
+		PasswordResetSubject:           getEnv("ACCOUNT_PASSWORD_RESET_SUBJECT", "Reset your password"),
+		PasswordResetTemplate:          getEnv("ACCOUNT_PASSWORD_RESET_TEMPLATE", "Reset your password: <a href=\"{{.URL}}\">Reset password</a>. The link expires at {{.ExpiresAt}}."),
+		PasswordResetExpirationMinutes: getEnvDuration("ACCOUNT_PASSWORD_RESET_EXPIRATION_MINUTES", 30),
+	}
+
+	// Parsear variables de entorno para RetentionConfig
+	retentionConfig := RetentionConfig{
+		Enabled:       getEnvBool("RETENTION_ENABLED", true),
+		Window:        time.Duration(getEnvInt("RETENTION_WINDOW_DAYS", 90)) * 24 * time.Hour,
+		PurgeInterval: getEnvDuration("RETENTION_PURGE_INTERVAL_MINUTES", 1440),
+	}
+
+	// Parsear variables de entorno para GradingConfig
+	gradingConfig := GradingConfig{
+		Enabled:  getEnvBool("GRADING_ENABLED", true),
+		Queue:    getEnv("GRADING_QUEUE", "assessment.grading"),
+		Exchange: getEnv("RABBITMQ_EXCHANGE", ""),
+		Workers:  getEnvInt("GRADING_WORKERS", 2),
+
+		QualityEnabled:      getEnvBool("GRADING_QUALITY_ENABLED", true),
+		RubricTestWeight:    float64(getEnvInt("GRADING_RUBRIC_TEST_WEIGHT", 80)),
+		RubricQualityWeight: float64(getEnvInt("GRADING_RUBRIC_QUALITY_WEIGHT", 20)),
+
+		SimilarityEnabled:    getEnvBool("GRADING_SIMILARITY_ENABLED", true),
+		SimilarityThreshold:  float64(getEnvInt("GRADING_SIMILARITY_THRESHOLD", 30)),
+		SimilarityFlagScore:  float64(getEnvInt("GRADING_SIMILARITY_FLAG_SCORE", 70)),
+		SimilarityMaxMatches: getEnvInt("GRADING_SIMILARITY_MAX_MATCHES", 10),
+	}
+
+	reportConfig := ReportConfig{
+		IntegrityFlagEvents: getEnvInt("REPORT_INTEGRITY_FLAG_EVENTS", 5),
+		ExportMaxRows:       getEnvInt("REPORT_EXPORT_MAX_ROWS", 5000),
+	}
+
 	// Agrupar todas las configuraciones
 	cfg := &Config{
 		App:        appConfig,
 		Hr:         hrConfig,
 		Assessment: assessmentConfig,
 		Pep:        pepConfig, // Asignar PepConfig
+		Mfa:        mfaConfig,
+		Account:    accountConfig,
+		Retention:  retentionConfig,
+		Grading:    gradingConfig,
+		Report:     reportConfig,
 	}
 
 	// Validar configuraciones
@@ -145,12 +277,52 @@ func getEnvInt(key string, defaultVal int) int {
 	return value
 }
 
+// getEnvBool obtiene una variable de entorno, la convierte a bool o retorna un valor por defecto si no está establecida o falla la conversión.
+func getEnvBool(key string, defaultVal bool) bool {
+	valueStr := getEnv(key, "")
+	if valueStr == "" {
+		return defaultVal
+	}
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		fmt.Printf("Warning: could not convert %s to bool. Using default value %t.\n", key, defaultVal)
+		return defaultVal
+	}
+	return value
+}
+
 // getEnvDuration obtiene una variable de entorno, la convierte a time.Duration (en minutos) o retorna un valor por defecto si no está establecida o falla la conversión.
 func getEnvDuration(key string, defaultMinutes int) time.Duration {
 	minutes := getEnvInt(key, defaultMinutes)
 	return time.Duration(minutes) * time.Minute
 }
 
+// getEnvDurations obtiene una lista de minutos separada por comas y la devuelve ordenada de mayor a
+// menor, o retorna los valores por defecto si no está establecida o algún valor no es un entero.
+func getEnvDurations(key string, defaultMinutes []int) []time.Duration {
+	minutes := defaultMinutes
+	if valueStr := getEnv(key, ""); valueStr != "" {
+		parsed := make([]int, 0)
+		for _, part := range strings.Split(valueStr, ",") {
+			value, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil {
+				fmt.Printf("Warning: could not convert %s to a list of ints. Using default value %v.\n", key, defaultMinutes)
+				parsed = defaultMinutes
+				break
+			}
+			parsed = append(parsed, value)
+		}
+		minutes = parsed
+	}
+
+	durations := make([]time.Duration, 0, len(minutes))
+	for _, m := range minutes {
+		durations = append(durations, time.Duration(m)*time.Minute)
+	}
+	slices.SortFunc(durations, func(a, b time.Duration) int { return cmp.Compare(b, a) })
+	return slices.Compact(durations)
+}
+
 // validateConfig valida que las configuraciones críticas estén presentes y sean válidas.
 func validateConfig(cfg *Config) error {
 	// Validaciones para AppConfig
@@ -177,6 +349,25 @@ func validateConfig(cfg *Config) error {
 	if cfg.Assessment.BodyTemplate == "" {
 		return fmt.Errorf("ASSESSMENT_TEST_TEMPLATE is required")
 	}
+	if cfg.Assessment.ExpiryEnabled && cfg.Assessment.ExpiryCheckInterval <= 0 {
+		return fmt.Errorf("ASSESSMENT_EXPIRY_CHECK_INTERVAL_MINUTES must be greater than 0")
+	}
+	if cfg.Assessment.DeadlineGrace < 0 {
+		return fmt.Errorf("ASSESSMENT_DEADLINE_GRACE_MINUTES cannot be negative")
+	}
+	if cfg.Assessment.RemindersEnabled {
+		if cfg.Assessment.ReminderCheckInterval <= 0 {
+			return fmt.Errorf("ASSESSMENT_REMINDER_CHECK_INTERVAL_MINUTES must be greater than 0")
+		}
+		for _, offset := range cfg.Assessment.ReminderOffsets {
+			if offset <= 0 {
+				return fmt.Errorf("ASSESSMENT_REMINDER_OFFSETS_MINUTES must only contain values greater than 0")
+			}
+		}
+		if cfg.Assessment.ReminderSubject == "" {
+			return fmt.Errorf("ASSESSMENT_REMINDER_SUBJECT is required")
+		}
+	}
 
 	// Validaciones para PepConfig
 	if cfg.Pep.BaseURL == "" {
@@ -206,6 +397,76 @@ func validateConfig(cfg *Config) error {
 		}
 	}
 
+	// Validaciones para MfaConfig
+	if cfg.Mfa.PreAuthExpirationMinutes <= 0 {
+		return fmt.Errorf("MFA_PRE_AUTH_EXPIRATION_MINUTES must be greater than 0")
+	}
+	if cfg.Mfa.RecoveryCodesCount <= 0 {
+		return fmt.Errorf("MFA_RECOVERY_CODES_COUNT must be greater than 0")
+	}
+	if cfg.Mfa.MaxVerifyAttempts <= 0 {
+		return fmt.Errorf("MFA_MAX_VERIFY_ATTEMPTS must be greater than 0")
+	}
+	if key, err := base64.StdEncoding.DecodeString(cfg.Mfa.SecretEncryptionKey); err != nil || len(key) != 32 {
+		return fmt.Errorf("MFA_SECRET_ENCRYPTION_KEY must be a base64 encoded 32 byte key")
+	}
+
+	// Validaciones para AccountConfig
+	if cfg.Account.TokenSecret == "" {
+		return fmt.Errorf("ACCOUNT_TOKEN_SECRET is required")
+	}
+	if cfg.Account.VerificationURL == "" {
+		return fmt.Errorf("ACCOUNT_VERIFICATION_URL is required")
+	}
+	if cfg.Account.PasswordResetURL == "" {
+		return fmt.Errorf("ACCOUNT_PASSWORD_RESET_URL is required")
+	}
+	if cfg.Account.VerificationExpirationMinutes <= 0 {
+		return fmt.Errorf("ACCOUNT_VERIFICATION_EXPIRATION_MINUTES must be greater than 0")
+	}
+	if cfg.Account.PasswordResetExpirationMinutes <= 0 {
+		return fmt.Errorf("ACCOUNT_PASSWORD_RESET_EXPIRATION_MINUTES must be greater than 0")
+	}
+
+	// Validaciones para RetentionConfig
+	if cfg.Retention.Enabled {
+		if cfg.Retention.Window <= 0 {
+			return fmt.Errorf("RETENTION_WINDOW_DAYS must be greater than 0")
+		}
+		if cfg.Retention.PurgeInterval <= 0 {
+			return fmt.Errorf("RETENTION_PURGE_INTERVAL_MINUTES must be greater than 0")
+		}
+	}
+
+	// Validaciones para GradingConfig
+	if cfg.Grading.Queue == "" {
+		return fmt.Errorf("GRADING_QUEUE is required")
+	}
+	if cfg.Grading.Enabled && cfg.Grading.Workers <= 0 {
+		return fmt.Errorf("GRADING_WORKERS must be greater than 0")
+	}
+	if cfg.Grading.RubricTestWeight < 0 || cfg.Grading.RubricQualityWeight < 0 ||
+		cfg.Grading.RubricTestWeight+cfg.Grading.RubricQualityWeight != 100 {
+		return fmt.Errorf("GRADING_RUBRIC_TEST_WEIGHT and GRADING_RUBRIC_QUALITY_WEIGHT must add up to 100")
+	}
+	if cfg.Grading.SimilarityThreshold < 0 || cfg.Grading.SimilarityThreshold > 100 {
+		return fmt.Errorf("GRADING_SIMILARITY_THRESHOLD must be between 0 and 100")
+	}
+	if cfg.Grading.SimilarityFlagScore < 0 || cfg.Grading.SimilarityFlagScore > 100 {
+		return fmt.Errorf("GRADING_SIMILARITY_FLAG_SCORE must be between 0 and 100")
+	}
+	if cfg.Grading.SimilarityEnabled && cfg.Grading.SimilarityMaxMatches <= 0 {
+		return fmt.Errorf("GRADING_SIMILARITY_MAX_MATCHES must be greater than 0")
+	}
+
+	// Validaciones para ReportConfig
+	if cfg.Report.IntegrityFlagEvents <= 0 {
+		return fmt.Errorf("REPORT_INTEGRITY_FLAG_EVENTS must be greater than 0")
+	}
+	if cfg.Report.ExportMaxRows <= 0 {
+		return fmt.Errorf("REPORT_EXPORT_MAX_ROWS must be greater than 0")
+	}
+
 	// Añade más validaciones según sea necesario
 	return nil
 }
@@ -231,3 +492,28 @@ func (cl *configLoader) GetAssessmentConfig() AssessmentConfig {
 func (cl *configLoader) GetPepConfig() PepConfig {
 	return cl.config.Pep
 }
+
+// GetMfaConfig retorna la configuración de autenticación multifactor.
+func (cl *configLoader) GetMfaConfig() MfaConfig {
+	return cl.config.Mfa
+}
+
+// GetAccountConfig retorna la configuración de verificación de email y reseteo de contraseña.
+func (cl *configLoader) GetAccountConfig() AccountConfig {
+	return cl.config.Account
+}
+
+// GetRetentionConfig retorna la configuración de la purga de registros borrados.
+func (cl *configLoader) GetRetentionConfig() RetentionConfig {
+	return cl.config.Retention
+}
+
+// GetGradingConfig retorna la configuración de la corrección automática.
+func (cl *configLoader) GetGradingConfig() GradingConfig {
+	return cl.config.Grading
+}
+
+// GetReportConfig retorna la configuración de los reportes de resultados.
+func (cl *configLoader) GetReportConfig() ReportConfig {
+	return cl.config.Report
+}
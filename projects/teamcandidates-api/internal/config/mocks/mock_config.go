@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+)
+
+// MockLoader is a mock of Loader interface.
+type MockLoader struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoaderMockRecorder
+}
+
+// MockLoaderMockRecorder is the mock recorder for MockLoader.
+type MockLoaderMockRecorder struct {
+	mock *MockLoader
+}
+
+// NewMockLoader creates a new mock instance.
+func NewMockLoader(ctrl *gomock.Controller) *MockLoader {
+	mock := &MockLoader{ctrl: ctrl}
+	mock.recorder = &MockLoaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoader) EXPECT() *MockLoaderMockRecorder {
+	return m.recorder
+}
+
+// GetAccountConfig mocks base method.
+func (m *MockLoader) GetAccountConfig() config.AccountConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountConfig")
+	ret0, _ := ret[0].(config.AccountConfig)
+	return ret0
+}
+
+// GetAccountConfig indicates an expected call of GetAccountConfig.
+func (mr *MockLoaderMockRecorder) GetAccountConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountConfig", reflect.TypeOf((*MockLoader)(nil).GetAccountConfig))
+}
+
+// GetAppConfig mocks base method.
+func (m *MockLoader) GetAppConfig() config.AppConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppConfig")
+	ret0, _ := ret[0].(config.AppConfig)
+	return ret0
+}
+
+// GetAppConfig indicates an expected call of GetAppConfig.
+func (mr *MockLoaderMockRecorder) GetAppConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppConfig", reflect.TypeOf((*MockLoader)(nil).GetAppConfig))
+}
+
+// GetAssessmentConfig mocks base method.
+func (m *MockLoader) GetAssessmentConfig() config.AssessmentConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssessmentConfig")
+	ret0, _ := ret[0].(config.AssessmentConfig)
+	return ret0
+}
+
+// GetAssessmentConfig indicates an expected call of GetAssessmentConfig.
+func (mr *MockLoaderMockRecorder) GetAssessmentConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssessmentConfig", reflect.TypeOf((*MockLoader)(nil).GetAssessmentConfig))
+}
+
+// GetGradingConfig mocks base method.
+func (m *MockLoader) GetGradingConfig() config.GradingConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGradingConfig")
+	ret0, _ := ret[0].(config.GradingConfig)
+	return ret0
+}
+
+// GetGradingConfig indicates an expected call of GetGradingConfig.
+func (mr *MockLoaderMockRecorder) GetGradingConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGradingConfig", reflect.TypeOf((*MockLoader)(nil).GetGradingConfig))
+}
+
+// GetHrConfig mocks base method.
+func (m *MockLoader) GetHrConfig() config.HrConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHrConfig")
+	ret0, _ := ret[0].(config.HrConfig)
+	return ret0
+}
+
+// GetHrConfig indicates an expected call of GetHrConfig.
+func (mr *MockLoaderMockRecorder) GetHrConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHrConfig", reflect.TypeOf((*MockLoader)(nil).GetHrConfig))
+}
+
+// GetMfaConfig mocks base method.
+func (m *MockLoader) GetMfaConfig() config.MfaConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMfaConfig")
+	ret0, _ := ret[0].(config.MfaConfig)
+	return ret0
+}
+
+// GetMfaConfig indicates an expected call of GetMfaConfig.
+func (mr *MockLoaderMockRecorder) GetMfaConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMfaConfig", reflect.TypeOf((*MockLoader)(nil).GetMfaConfig))
+}
+
+// GetPepConfig mocks base method.
+func (m *MockLoader) GetPepConfig() config.PepConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPepConfig")
+	ret0, _ := ret[0].(config.PepConfig)
+	return ret0
+}
+
+// GetPepConfig indicates an expected call of GetPepConfig.
+func (mr *MockLoaderMockRecorder) GetPepConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPepConfig", reflect.TypeOf((*MockLoader)(nil).GetPepConfig))
+}
+
+// GetReportConfig mocks base method.
+func (m *MockLoader) GetReportConfig() config.ReportConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportConfig")
+	ret0, _ := ret[0].(config.ReportConfig)
+	return ret0
+}
+
+// GetReportConfig indicates an expected call of GetReportConfig.
+func (mr *MockLoaderMockRecorder) GetReportConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportConfig", reflect.TypeOf((*MockLoader)(nil).GetReportConfig))
+}
+
+// GetRetentionConfig mocks base method.
+func (m *MockLoader) GetRetentionConfig() config.RetentionConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRetentionConfig")
+	ret0, _ := ret[0].(config.RetentionConfig)
+	return ret0
+}
+
+// GetRetentionConfig indicates an expected call of GetRetentionConfig.
+func (mr *MockLoaderMockRecorder) GetRetentionConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetentionConfig", reflect.TypeOf((*MockLoader)(nil).GetRetentionConfig))
+}
@@ -5,4 +5,12 @@ type Loader interface {
 	GetHrConfig() HrConfig
 	GetAssessmentConfig() AssessmentConfig
 	GetPepConfig() PepConfig
+	GetMfaConfig() MfaConfig
+	GetAccountConfig() AccountConfig
+	GetRetentionConfig() RetentionConfig
+	GetGradingConfig() GradingConfig
+	GetReportConfig() ReportConfig
 }
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_config.go -package=mocks
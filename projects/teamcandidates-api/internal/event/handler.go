@@ -11,7 +11,6 @@ import (
 	utils "github.com/teamcubation/teamcandidates/pkg/utils"
 
 	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event/handler/dto"
-	sup "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event/handler/support"
 )
 
 type Handler struct {
@@ -41,7 +40,7 @@ func (h *Handler) Routes() {
 	public := router.Group(publicPrefix)
 	{
 		public.POST("", h.CreateEvent)
-		public.GET("", h.ListEvents)
+		public.GET("", mdw.ParseQuerySpec(dto.EventQuerySchema), h.ListEvents)
 		// public.GET("/:id", h.GetEventByID)
 		// public.PUT("/:id", h.UpdateEvent)
 		// public.DELETE("/:id", h.DeleteEvent)
@@ -70,17 +69,27 @@ func (h *Handler) ProtectedPing(c *gin.Context) {
 }
 
 func (h *Handler) ListEvents(c *gin.Context) {
-	groups, err := h.ucs.ListEvents(c.Request.Context())
+	spec, err := mdw.GetQuerySpec(c)
 	if err != nil {
 		apiErr, code := types.NewAPIError(err)
 		c.Error(apiErr).SetMeta(code)
 		return
 	}
 
-	var list dto.EventList
-	c.JSON(http.StatusOK, sup.ListEventsResponse{
-		List: list.FromDomain(groups),
-	})
+	page, err := h.ucs.ListEvents(c.Request.Context(), spec)
+	if err != nil {
+		apiErr, code := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(code)
+		return
+	}
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainListItem), spec)
+	if err != nil {
+		apiErr, code := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(code)
+		return
+	}
+	c.JSON(http.StatusOK, res)
 }
 
 func (h *Handler) CreateEvent(c *gin.Context) {
@@ -1,5 +1,41 @@
 package dto
 
+import (
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event/usecases/domain"
+)
+
 type ListEvents struct {
 	Event
 }
+
+// EventQuerySchema define los campos por los que se puede filtrar, ordenar y seleccionar en el listado.
+// Las columnas son los nombres de los campos en los documentos de Mongo.
+var EventQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":           {Column: "_id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"title":        {Column: "title", Type: types.FieldString, Filterable: true, Sortable: true},
+		"description":  {Column: "description", Type: types.FieldString, Filterable: true},
+		"location":     {Column: "location", Type: types.FieldString, Filterable: true, Sortable: true},
+		"start_time":   {Column: "start_time", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"end_time":     {Column: "end_time", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"category":     {Column: "category", Type: types.FieldString, Filterable: true},
+		"creator_id":   {Column: "creator_id", Type: types.FieldString},
+		"is_public":    {Column: "is_public", Type: types.FieldBool, Filterable: true},
+		"is_recurring": {Column: "is_recurring", Type: types.FieldBool, Filterable: true},
+		"series_id":    {Column: "series_id", Type: types.FieldString},
+		"status":       {Column: "status", Type: types.FieldString, Filterable: true},
+		"organizer":    {Column: "organizer", Type: types.FieldString},
+		"attendees":    {Column: "attendees", Type: types.FieldString},
+		"planners":     {Column: "planners", Type: types.FieldString},
+		"tags":         {Column: "tags", Type: types.FieldString, Filterable: true},
+		"create_at":    {Column: "create_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"-create_at"},
+}
+
+// FromDomainListItem convierte un evento del listado al DTO.
+func FromDomainListItem(event domain.Event) Event {
+	var dto Event
+	return *dto.FromDomain(&event)
+}
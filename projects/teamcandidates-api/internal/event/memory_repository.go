@@ -0,0 +1,49 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event/usecases/domain"
+)
+
+type memoryRepository struct {
+	events *mapdb.Table[models.Event]
+}
+
+// NewMemoryRepository crea el repositorio de eventos sobre la base en memoria.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		events: mapdb.NewTable(db, eventsCollection,
+			func(e *models.Event) string { return e.ID.Hex() },
+		),
+	}
+}
+
+func (r *memoryRepository) ListEvents(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Event], error) {
+	page, err := r.events.Page(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.MapPage(page, func(e models.Event) domain.Event {
+		return *e.ToDomain()
+	}), nil
+}
+
+func (r *memoryRepository) CreateEvent(ctx context.Context, event *domain.Event) error {
+	var e models.Event
+	if _, err := e.FromDomain(event); err != nil {
+		return err
+	}
+	e.ID = primitive.NewObjectID()
+	e.CreatedAt = time.Now()
+
+	return r.events.Insert(ctx, &e)
+}
@@ -3,6 +3,8 @@ package event
 import (
 	"context"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event/usecases/domain"
 )
 
@@ -13,7 +15,7 @@ type UseCases interface {
 	// UpdateEvent(context.Context, **domain.Event, string) (**domain.Event, error)
 	// ReviveEvent(context.Context, string) (**domain.Event, error)
 	// GetEvent(context.Context, string) (**domain.Event, error)
-	ListEvents(context.Context) ([]domain.Event, error)
+	ListEvents(context.Context, *types.QuerySpec) (*types.Page[domain.Event], error)
 	// AddUserToEvent(context.Context, string, *usr.User) (**domain.Event, error)
 }
 type Repository interface {
@@ -23,6 +25,6 @@ type Repository interface {
 	// UpdateEvent(context.Context, *domain.Event, string) (*domain.Event, error)
 	// ReviveEvent(context.Context, string) (*domain.Event, error)
 	// GetEvent(context.Context, string) (*domain.Event, error)
-	ListEvents(context.Context) ([]domain.Event, error)
+	ListEvents(context.Context, *types.QuerySpec) (*types.Page[domain.Event], error)
 	// AddUserToEvent(context.Context, string, *usr.User) (*domain.Event, error)
 }
@@ -2,53 +2,66 @@ package event
 
 import (
 	"context"
-	"log"
 	"time"
 
-	"go.mongodb.org/mongo-driver/bson"
-
 	mng "github.com/teamcubation/teamcandidates/pkg/databases/nosql/mongodb/mongo-driver"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event/repository/models"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event/usecases/domain"
 )
 
+const eventsCollection = "events"
+
+// MongoIndexes son los índices de events que se aseguran al arrancar.
+var MongoIndexes = []mng.IndexDefinition{
+	{
+		Collection: eventsCollection,
+		Name:       "create_at",
+		Keys:       []mng.IndexKey{mng.Desc("create_at"), mng.Desc("_id")},
+	},
+	{
+		Collection: eventsCollection,
+		Name:       "start_time",
+		Keys:       []mng.IndexKey{mng.Asc("start_time")},
+	},
+	{
+		Collection: eventsCollection,
+		Name:       "creator_id",
+		Keys:       []mng.IndexKey{mng.Asc("creator_id")},
+	},
+}
+
 type mongoRepository struct {
-	repository mng.Repository
+	events *mng.Collection[models.Event]
 }
 
 func NewRepository(r mng.Repository) Repository {
 	return &mongoRepository{
-		repository: r,
+		events: mng.NewCollection[models.Event](r, eventsCollection),
 	}
 }
 
-func (r *mongoRepository) ListEvents(ctx context.Context) ([]domain.Event, error) {
-	cursor, err := r.repository.DB().Collection("events").Find(ctx, bson.M{})
+func (r *mongoRepository) ListEvents(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Event], error) {
+	page, err := r.events.FindPage(ctx, nil, spec)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if closeErr := cursor.Close(ctx); closeErr != nil {
-			log.Printf("Error closing cursor: %v", closeErr)
-		}
-	}()
-
-	var es models.EventList
-	if err := cursor.All(ctx, &es); err != nil {
-		return nil, err
-	}
 
-	return es.ToDomain(), nil
+	return types.MapPage(page, func(e models.Event) domain.Event {
+		return *e.ToDomain()
+	}), nil
 }
 
 func (ed *mongoRepository) CreateEvent(ctx context.Context, event *domain.Event) error {
-	var e models.CreateEvent
-	e.CreatedAt = time.Now()
-	if _, err := ed.repository.DB().Collection("events").InsertOne(ctx, e); err != nil {
+	var e models.Event
+	if _, err := e.FromDomain(event); err != nil {
 		return err
 	}
-	return nil
+	e.CreatedAt = time.Now()
+
+	_, err := ed.events.InsertOne(ctx, &e)
+	return err
 }
 
 // func (ed *mongoRepository) FindByID(ctx context.Context, ID string) (*domain.Event, error) {
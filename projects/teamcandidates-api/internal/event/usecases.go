@@ -3,6 +3,8 @@ package event
 import (
 	"context"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event/usecases/domain"
 )
 
@@ -16,12 +18,12 @@ func NewUseCases(r Repository) UseCases {
 	}
 }
 
-func (u *useCases) ListEvents(ctx context.Context) ([]domain.Event, error) {
-	Events, err := u.repository.ListEvents(ctx)
+func (u *useCases) ListEvents(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Event], error) {
+	events, err := u.repository.ListEvents(ctx, spec)
 	if err != nil {
 		return nil, err
 	}
-	return Events, nil
+	return events, nil
 }
 
 func (u *useCases) CreateEvent(ctx context.Context, Event *domain.Event) error {
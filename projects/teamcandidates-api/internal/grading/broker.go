@@ -0,0 +1,75 @@
+package grading
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/rabbitmq/amqp091-go"
+
+	rabbitcons "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/consumer"
+	rabbit "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/producer"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+type broker struct {
+	producer rabbit.Producer
+	consumer rabbitcons.Consumer
+	queue    string // Cola de corrección; también es la routing key con la que se publica
+	exchange string // Exchange al que se bindea la cola
+}
+
+// NewBroker crea el adapter de la cola de corrección.
+func NewBroker(producer rabbit.Producer, consumer rabbitcons.Consumer, queue, exchange string) Broker {
+	return &broker{
+		producer: producer,
+		consumer: consumer,
+		queue:    queue,
+		exchange: exchange,
+	}
+}
+
+func (b *broker) PublishJob(ctx context.Context, job *domain.Job) error {
+	if _, err := b.producer.Produce(ctx, b.queue, "", job.ResultID, job); err != nil {
+		return fmt.Errorf("failed to publish grading job: %w", err)
+	}
+	return nil
+}
+
+// Consume declara la cola y la bindea al exchange antes de registrar el consumidor. Un mensaje
+// que no se puede decodificar se descarta: reencolarlo solo lo haría fallar de nuevo.
+func (b *broker) Consume(ctx context.Context, consumerTag string, handler func(context.Context, *domain.Job) error) error {
+	if err := b.declareQueue(); err != nil {
+		return err
+	}
+
+	return b.consumer.Consume(ctx, b.queue, consumerTag, func(d amqp091.Delivery) error {
+		var job domain.Job
+		if err := json.Unmarshal(d.Body, &job); err != nil || job.AssessmentID == "" {
+			log.Printf("grading: discarding malformed job %s: %v", d.CorrelationId, err)
+			return nil
+		}
+		return handler(ctx, &job)
+	})
+}
+
+// declareQueue crea la cola durable y la bindea con su nombre como routing key. El
+// MemoryProducer no tiene canal y no necesita declarar nada.
+func (b *broker) declareQueue() error {
+	ch, err := b.producer.Channel()
+	if err != nil || ch == nil {
+		return nil
+	}
+
+	if _, err := ch.QueueDeclare(b.queue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("failed to declare grading queue: %w", err)
+	}
+	if b.exchange != "" {
+		if err := ch.QueueBind(b.queue, b.queue, b.exchange, false, nil); err != nil {
+			return fmt.Errorf("failed to bind grading queue: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package grading
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	gsv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/handler/dto"
+)
+
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{
+		ucs: u,
+		gsv: s,
+		mws: m,
+	}
+}
+
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/grading"
+	protectedPrefix := apiBase + "/protected"
+
+	// Rutas protegidas
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionAssessmentRead, types.PermissionAssessmentWrite))
+
+		protected.POST("/assessments/:id", h.RequestGrading)     // Volver a corregir la entrega (asíncrono)
+		protected.GET("/assessments/:id", h.GetResult)           // Corrección vigente de la evaluación
+		protected.GET("/assessments/:id/results", h.ListResults) // Historial de correcciones
+	}
+}
+
+func (h *Handler) RequestGrading(c *gin.Context) {
+	result, err := h.ucs.RequestGrading(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.FromDomain(result))
+}
+
+func (h *Handler) GetResult(c *gin.Context) {
+	result, err := h.ucs.GetResult(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomain(result))
+}
+
+func (h *Handler) ListResults(c *gin.Context) {
+	results, err := h.ucs.ListResults(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainList(results))
+}
@@ -0,0 +1,192 @@
+package dto
+
+import (
+	"time"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+type TestResult struct {
+	UnitTestID string `json:"unit_test_id"`
+	TestName   string `json:"test_name"`
+	Hidden     bool   `json:"hidden"`
+	Passed     bool   `json:"passed"`
+	Output     string `json:"output,omitempty"`
+	Error      string `json:"error,omitempty"`
+	ExitCode   int    `json:"exit_code"`
+	RuntimeMS  int64  `json:"runtime_ms"`
+	MemoryKB   int64  `json:"memory_kb"`
+	TimedOut   bool   `json:"timed_out"`
+}
+
+type MatchRegion struct {
+	StartLine      int `json:"start_line"`
+	EndLine        int `json:"end_line"`
+	OtherStartLine int `json:"other_start_line"`
+	OtherEndLine   int `json:"other_end_line"`
+}
+
+type SimilarityMatch struct {
+	AssessmentID string        `json:"assessment_id"`
+	SessionID    string        `json:"session_id,omitempty"`
+	CandidateID  string        `json:"candidate_id,omitempty"`
+	Score        float64       `json:"score"`
+	Regions      []MatchRegion `json:"regions"`
+}
+
+type Similarity struct {
+	Status   string            `json:"status"`
+	Reason   string            `json:"reason,omitempty"`
+	Compared int               `json:"compared"`
+	MaxScore float64           `json:"max_score"`
+	Flagged  bool              `json:"flagged"`
+	Matches  []SimilarityMatch `json:"matches"`
+}
+
+type QualityDimension struct {
+	Name     string  `json:"name"`
+	Score    float64 `json:"score"`
+	Weight   float64 `json:"weight"`
+	Findings int     `json:"findings"`
+}
+
+type QualityFinding struct {
+	Dimension string `json:"dimension"`
+	Rule      string `json:"rule"`
+	Line      int    `json:"line"`
+	Message   string `json:"message"`
+}
+
+type QualityReport struct {
+	Status        string             `json:"status"`
+	Reason        string             `json:"reason,omitempty"`
+	Score         float64            `json:"score"`
+	Lines         int                `json:"lines"`
+	Functions     int                `json:"functions"`
+	MaxComplexity int                `json:"max_complexity"`
+	Dimensions    []QualityDimension `json:"dimensions"`
+	Findings      []QualityFinding   `json:"findings"`
+}
+
+type Result struct {
+	ID            string         `json:"id"`
+	AssessmentID  string         `json:"assessment_id"`
+	SessionID     string         `json:"session_id,omitempty"`
+	Language      string         `json:"language,omitempty"`
+	Status        string         `json:"status"`
+	Passed        int            `json:"passed"`
+	Total         int            `json:"total"`
+	Score         float64        `json:"score"`
+	RubricScore   float64        `json:"rubric_score"`
+	CompileOutput string         `json:"compile_output,omitempty"`
+	Error         string         `json:"error,omitempty"`
+	Tests         []TestResult   `json:"tests"`
+	Quality       *QualityReport `json:"quality,omitempty"`
+	Similarity    *Similarity    `json:"similarity,omitempty"`
+	QueuedAt      time.Time      `json:"queued_at"`
+	StartedAt     *time.Time     `json:"started_at,omitempty"`
+	FinishedAt    *time.Time     `json:"finished_at,omitempty"`
+}
+
+type ListResultsResponse struct {
+	Results []Result `json:"results"`
+}
+
+func FromDomain(r *domain.Result) Result {
+	tests := make([]TestResult, 0, len(r.Tests))
+	for _, t := range r.Tests {
+		tests = append(tests, TestResult{
+			UnitTestID: t.UnitTestID,
+			TestName:   t.TestName,
+			Hidden:     t.Hidden,
+			Passed:     t.Passed,
+			Output:     t.Output,
+			Error:      t.Error,
+			ExitCode:   t.ExitCode,
+			RuntimeMS:  t.Runtime.Milliseconds(),
+			MemoryKB:   t.MemoryKB,
+			TimedOut:   t.TimedOut,
+		})
+	}
+
+	resp := Result{
+		ID:            r.ID,
+		AssessmentID:  r.AssessmentID,
+		SessionID:     r.SessionID,
+		Language:      r.Language,
+		Status:        string(r.Status),
+		Passed:        r.Passed,
+		Total:         r.Total,
+		Score:         r.Score,
+		RubricScore:   r.RubricScore,
+		CompileOutput: r.CompileOutput,
+		Error:         r.Error,
+		Tests:         tests,
+		QueuedAt:      r.QueuedAt,
+		StartedAt:     r.StartedAt,
+		FinishedAt:    r.FinishedAt,
+	}
+	if r.Quality != nil {
+		resp.Quality = fromDomainQuality(r.Quality)
+	}
+	if r.Similarity != nil {
+		resp.Similarity = fromDomainSimilarity(r.Similarity)
+	}
+	return resp
+}
+
+func fromDomainQuality(q *domain.QualityReport) *QualityReport {
+	dimensions := make([]QualityDimension, 0, len(q.Dimensions))
+	for _, d := range q.Dimensions {
+		dimensions = append(dimensions, QualityDimension(d))
+	}
+	findings := make([]QualityFinding, 0, len(q.Findings))
+	for _, f := range q.Findings {
+		findings = append(findings, QualityFinding(f))
+	}
+
+	return &QualityReport{
+		Status:        string(q.Status),
+		Reason:        q.Reason,
+		Score:         q.Score,
+		Lines:         q.Lines,
+		Functions:     q.Functions,
+		MaxComplexity: q.MaxComplexity,
+		Dimensions:    dimensions,
+		Findings:      findings,
+	}
+}
+
+func fromDomainSimilarity(s *domain.Similarity) *Similarity {
+	matches := make([]SimilarityMatch, 0, len(s.Matches))
+	for _, m := range s.Matches {
+		regions := make([]MatchRegion, 0, len(m.Regions))
+		for _, r := range m.Regions {
+			regions = append(regions, MatchRegion(r))
+		}
+		matches = append(matches, SimilarityMatch{
+			AssessmentID: m.AssessmentID,
+			SessionID:    m.SessionID,
+			CandidateID:  m.CandidateID,
+			Score:        m.Score,
+			Regions:      regions,
+		})
+	}
+
+	return &Similarity{
+		Status:   string(s.Status),
+		Reason:   s.Reason,
+		Compared: s.Compared,
+		MaxScore: s.MaxScore,
+		Flagged:  s.Flagged,
+		Matches:  matches,
+	}
+}
+
+func FromDomainList(rs []domain.Result) ListResultsResponse {
+	resp := ListResultsResponse{Results: make([]Result, 0, len(rs))}
+	for i := range rs {
+		resp.Results = append(resp.Results, FromDomain(&rs[i]))
+	}
+	return resp
+}
@@ -0,0 +1,181 @@
+package grading
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// memoryRepository guarda cada corrección junto con los resultados de sus pruebas y las entregas
+// parecidas en una sola fila.
+type memoryRepository struct {
+	results     *mapdb.Table[models.GradingResult]
+	submissions *mapdb.Table[models.GradingSubmission]
+}
+
+// NewMemoryRepository crea el repositorio de correcciones sobre la base en memoria.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		results: mapdb.NewTable(db, "grading_results",
+			func(r *models.GradingResult) string { return r.ID },
+			mapdb.Index[models.GradingResult]{
+				Name:   "assessment_id",
+				Values: func(r *models.GradingResult) []string { return []string{r.AssessmentID} },
+			},
+		),
+		submissions: mapdb.NewTable(db, "grading_submissions",
+			func(s *models.GradingSubmission) string { return s.ID },
+			mapdb.Index[models.GradingSubmission]{
+				Name:   "session_id",
+				Unique: true,
+				Values: func(s *models.GradingSubmission) []string { return []string{s.SessionID} },
+			},
+			mapdb.Index[models.GradingSubmission]{
+				Name:   "problem_key",
+				Values: func(s *models.GradingSubmission) []string { return []string{s.ProblemKey} },
+			},
+		),
+	}
+}
+
+func (r *memoryRepository) CreateResult(ctx context.Context, result *domain.Result) (string, error) {
+	if result == nil {
+		return "", errors.New("result is nil")
+	}
+
+	model := models.FromDomain(result)
+	model.ID = uuid.New().String()
+	model.CreatedAt = time.Now()
+	model.UpdatedAt = model.CreatedAt
+	for i := range model.Tests {
+		model.Tests[i].ID = uuid.New().String()
+		model.Tests[i].ResultID = model.ID
+	}
+	for i := range model.SimilarityMatches {
+		model.SimilarityMatches[i].ID = uuid.New().String()
+		model.SimilarityMatches[i].ResultID = model.ID
+	}
+
+	if err := r.results.Insert(ctx, model); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) UpdateResult(ctx context.Context, result *domain.Result) error {
+	if result == nil {
+		return errors.New("result is nil")
+	}
+
+	update := models.FromDomain(result)
+	err := r.results.Modify(ctx, result.ID, func(m *models.GradingResult) error {
+		update.CreatedAt = m.CreatedAt
+		update.UpdatedAt = time.Now()
+		update.AssessmentID = m.AssessmentID
+		update.SessionID = m.SessionID
+		update.QueuedAt = m.QueuedAt
+		for i := range update.Tests {
+			update.Tests[i].ID = uuid.New().String()
+			update.Tests[i].CreatedAt = update.UpdatedAt
+		}
+		for i := range update.SimilarityMatches {
+			update.SimilarityMatches[i].ID = uuid.New().String()
+			update.SimilarityMatches[i].CreatedAt = update.UpdatedAt
+		}
+		*m = *update
+		return nil
+	})
+	if types.IsNotFound(err) {
+		return types.NewError(types.ErrNotFound, "grading result not found", nil)
+	}
+	return err
+}
+
+func (r *memoryRepository) GetResult(ctx context.Context, id string) (*domain.Result, error) {
+	model, err := r.results.Get(ctx, id)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrNotFound, "grading result not found", nil)
+		}
+		return nil, err
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *memoryRepository) GetLatestResult(ctx context.Context, assessmentID string) (*domain.Result, error) {
+	results, err := r.ListResults(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, types.NewError(types.ErrNotFound, "assessment has not been graded", nil)
+	}
+	return &results[0], nil
+}
+
+func (r *memoryRepository) ListResults(ctx context.Context, assessmentID string) ([]domain.Result, error) {
+	ms, err := r.results.FindBy(ctx, "assessment_id", assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(ms, func(a, b models.GradingResult) int { return b.QueuedAt.Compare(a.QueuedAt) })
+
+	results := make([]domain.Result, 0, len(ms))
+	for i := range ms {
+		results = append(results, *ms[i].ToDomain())
+	}
+	return results, nil
+}
+
+func (r *memoryRepository) SaveSubmission(ctx context.Context, submission *domain.Submission) error {
+	if submission == nil {
+		return errors.New("submission is nil")
+	}
+
+	model := models.FromDomainSubmission(submission)
+	existing, err := r.submissions.FindOneBy(ctx, "session_id", model.SessionID)
+	if err != nil && !types.IsNotFound(err) {
+		return err
+	}
+	if existing == nil {
+		model.ID = uuid.New().String()
+		model.CreatedAt = time.Now()
+		model.UpdatedAt = model.CreatedAt
+		return r.submissions.Insert(ctx, model)
+	}
+
+	return r.submissions.Modify(ctx, existing.ID, func(m *models.GradingSubmission) error {
+		model.ID = m.ID
+		model.AssessmentID = m.AssessmentID
+		model.CandidateID = m.CandidateID
+		model.CreatedAt = m.CreatedAt
+		model.UpdatedAt = time.Now()
+		*m = *model
+		return nil
+	})
+}
+
+func (r *memoryRepository) ListSubmissions(ctx context.Context, problemKey string) ([]domain.Submission, error) {
+	ms, err := r.submissions.FindBy(ctx, "problem_key", problemKey)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(ms, func(a, b models.GradingSubmission) int { return a.SubmittedAt.Compare(b.SubmittedAt) })
+
+	submissions := make([]domain.Submission, 0, len(ms))
+	for i := range ms {
+		submissions = append(submissions, *ms[i].ToDomain())
+	}
+	return submissions, nil
+}
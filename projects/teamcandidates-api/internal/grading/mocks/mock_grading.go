@@ -0,0 +1,284 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// GetResult mocks base method.
+func (m *MockUseCases) GetResult(arg0 context.Context, arg1 string) (*domain.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResult", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResult indicates an expected call of GetResult.
+func (mr *MockUseCasesMockRecorder) GetResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResult", reflect.TypeOf((*MockUseCases)(nil).GetResult), arg0, arg1)
+}
+
+// ListResults mocks base method.
+func (m *MockUseCases) ListResults(arg0 context.Context, arg1 string) ([]domain.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResults", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResults indicates an expected call of ListResults.
+func (mr *MockUseCasesMockRecorder) ListResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResults", reflect.TypeOf((*MockUseCases)(nil).ListResults), arg0, arg1)
+}
+
+// ProcessJob mocks base method.
+func (m *MockUseCases) ProcessJob(arg0 context.Context, arg1 *domain.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessJob indicates an expected call of ProcessJob.
+func (mr *MockUseCasesMockRecorder) ProcessJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessJob", reflect.TypeOf((*MockUseCases)(nil).ProcessJob), arg0, arg1)
+}
+
+// RequestGrading mocks base method.
+func (m *MockUseCases) RequestGrading(arg0 context.Context, arg1 string) (*domain.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestGrading", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestGrading indicates an expected call of RequestGrading.
+func (mr *MockUseCasesMockRecorder) RequestGrading(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestGrading", reflect.TypeOf((*MockUseCases)(nil).RequestGrading), arg0, arg1)
+}
+
+// RunWorker mocks base method.
+func (m *MockUseCases) RunWorker(arg0 context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunWorker", arg0)
+}
+
+// RunWorker indicates an expected call of RunWorker.
+func (mr *MockUseCasesMockRecorder) RunWorker(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWorker", reflect.TypeOf((*MockUseCases)(nil).RunWorker), arg0)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateResult mocks base method.
+func (m *MockRepository) CreateResult(arg0 context.Context, arg1 *domain.Result) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResult", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResult indicates an expected call of CreateResult.
+func (mr *MockRepositoryMockRecorder) CreateResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResult", reflect.TypeOf((*MockRepository)(nil).CreateResult), arg0, arg1)
+}
+
+// GetLatestResult mocks base method.
+func (m *MockRepository) GetLatestResult(arg0 context.Context, arg1 string) (*domain.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestResult", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestResult indicates an expected call of GetLatestResult.
+func (mr *MockRepositoryMockRecorder) GetLatestResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestResult", reflect.TypeOf((*MockRepository)(nil).GetLatestResult), arg0, arg1)
+}
+
+// GetResult mocks base method.
+func (m *MockRepository) GetResult(arg0 context.Context, arg1 string) (*domain.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResult", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResult indicates an expected call of GetResult.
+func (mr *MockRepositoryMockRecorder) GetResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResult", reflect.TypeOf((*MockRepository)(nil).GetResult), arg0, arg1)
+}
+
+// ListResults mocks base method.
+func (m *MockRepository) ListResults(arg0 context.Context, arg1 string) ([]domain.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResults", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResults indicates an expected call of ListResults.
+func (mr *MockRepositoryMockRecorder) ListResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResults", reflect.TypeOf((*MockRepository)(nil).ListResults), arg0, arg1)
+}
+
+// ListSubmissions mocks base method.
+func (m *MockRepository) ListSubmissions(arg0 context.Context, arg1 string) ([]domain.Submission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubmissions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Submission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubmissions indicates an expected call of ListSubmissions.
+func (mr *MockRepositoryMockRecorder) ListSubmissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubmissions", reflect.TypeOf((*MockRepository)(nil).ListSubmissions), arg0, arg1)
+}
+
+// SaveSubmission mocks base method.
+func (m *MockRepository) SaveSubmission(arg0 context.Context, arg1 *domain.Submission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSubmission", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSubmission indicates an expected call of SaveSubmission.
+func (mr *MockRepositoryMockRecorder) SaveSubmission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSubmission", reflect.TypeOf((*MockRepository)(nil).SaveSubmission), arg0, arg1)
+}
+
+// UpdateResult mocks base method.
+func (m *MockRepository) UpdateResult(arg0 context.Context, arg1 *domain.Result) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResult", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateResult indicates an expected call of UpdateResult.
+func (mr *MockRepositoryMockRecorder) UpdateResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResult", reflect.TypeOf((*MockRepository)(nil).UpdateResult), arg0, arg1)
+}
+
+// MockBroker is a mock of Broker interface.
+type MockBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBrokerMockRecorder
+}
+
+// MockBrokerMockRecorder is the mock recorder for MockBroker.
+type MockBrokerMockRecorder struct {
+	mock *MockBroker
+}
+
+// NewMockBroker creates a new mock instance.
+func NewMockBroker(ctrl *gomock.Controller) *MockBroker {
+	mock := &MockBroker{ctrl: ctrl}
+	mock.recorder = &MockBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroker) EXPECT() *MockBrokerMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockBroker) Consume(ctx context.Context, consumerTag string, handler func(context.Context, *domain.Job) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, consumerTag, handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockBrokerMockRecorder) Consume(ctx, consumerTag, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockBroker)(nil).Consume), ctx, consumerTag, handler)
+}
+
+// PublishJob mocks base method.
+func (m *MockBroker) PublishJob(arg0 context.Context, arg1 *domain.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishJob indicates an expected call of PublishJob.
+func (mr *MockBrokerMockRecorder) PublishJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishJob", reflect.TypeOf((*MockBroker)(nil).PublishJob), arg0, arg1)
+}
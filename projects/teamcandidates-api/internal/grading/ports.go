@@ -0,0 +1,44 @@
+package grading
+
+import (
+	"context"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// UseCases corrige las entregas de las evaluaciones ejecutando el código del candidato contra
+// sus pruebas unitarias en el sandbox.
+type UseCases interface {
+	// RequestGrading encola una nueva corrección de la evaluación y devuelve el Result en estado queued.
+	RequestGrading(context.Context, string) (*domain.Result, error)
+	// ProcessJob corrige una entrega. Solo devuelve error si conviene reintentar el job.
+	ProcessJob(context.Context, *domain.Job) error
+	// GetResult devuelve la corrección más reciente de la evaluación.
+	GetResult(context.Context, string) (*domain.Result, error)
+	// ListResults devuelve todas las correcciones de la evaluación, de la más reciente a la más antigua.
+	ListResults(context.Context, string) ([]domain.Result, error)
+	// RunWorker consume la cola de corrección hasta que se cancele ctx. No hace nada si está deshabilitada.
+	RunWorker(context.Context)
+}
+
+type Repository interface {
+	CreateResult(context.Context, *domain.Result) (string, error)
+	// UpdateResult guarda el estado, el puntaje y reemplaza los resultados de las pruebas.
+	UpdateResult(context.Context, *domain.Result) error
+	GetResult(context.Context, string) (*domain.Result, error)
+	GetLatestResult(context.Context, string) (*domain.Result, error)
+	ListResults(context.Context, string) ([]domain.Result, error)
+	// SaveSubmission guarda la huella de la entrega, reemplazando la de la misma sesión si existe.
+	SaveSubmission(context.Context, *domain.Submission) error
+	// ListSubmissions devuelve las huellas de las entregas del problema, de la más antigua a la más reciente.
+	ListSubmissions(context.Context, string) ([]domain.Submission, error)
+}
+
+// Broker publica y consume los jobs de la cola de corrección.
+type Broker interface {
+	PublishJob(context.Context, *domain.Job) error
+	Consume(ctx context.Context, consumerTag string, handler func(context.Context, *domain.Job) error) error
+}
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_grading.go -package=mocks
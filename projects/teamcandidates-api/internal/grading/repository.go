@@ -0,0 +1,187 @@
+package grading
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+type repository struct {
+	db gorm.Repository
+}
+
+func NewRepository(db gorm.Repository) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) CreateResult(ctx context.Context, result *domain.Result) (string, error) {
+	if result == nil {
+		return "", errors.New("result is nil")
+	}
+
+	model := models.FromDomain(result)
+	model.ID = uuid.New().String()
+	for i := range model.Tests {
+		model.Tests[i].ID = uuid.New().String()
+		model.Tests[i].ResultID = model.ID
+	}
+	for i := range model.SimilarityMatches {
+		model.SimilarityMatches[i].ID = uuid.New().String()
+		model.SimilarityMatches[i].ResultID = model.ID
+	}
+
+	if err := r.db.DB(ctx).Create(model).Error; err != nil {
+		return "", fmt.Errorf("failed to create grading result: %w", err)
+	}
+	return model.ID, nil
+}
+
+// UpdateResult reemplaza los resultados de las pruebas y las entregas parecidas en la misma
+// transacción que el resumen, así nunca queda un puntaje que no corresponde a las pruebas guardadas.
+func (r *repository) UpdateResult(ctx context.Context, result *domain.Result) error {
+	if result == nil {
+		return errors.New("result is nil")
+	}
+
+	model := models.FromDomain(result)
+	for i := range model.Tests {
+		model.Tests[i].ID = uuid.New().String()
+	}
+	for i := range model.SimilarityMatches {
+		model.SimilarityMatches[i].ID = uuid.New().String()
+	}
+
+	return r.db.DB(ctx).Transaction(func(tx *gorm0.DB) error {
+		res := tx.Model(&models.GradingResult{}).
+			Where("id = ?", model.ID).
+			Select("language", "status", "passed", "total", "score", "rubric_score", "compile_output", "error", "started_at", "finished_at",
+				"quality_status", "quality_score", "quality_report", "similarity_status", "similarity_reason", "similarity_compared", "similarity_score", "similarity_flagged").
+			Updates(model)
+		if res.Error != nil {
+			return fmt.Errorf("failed to update grading result: %w", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return types.NewError(types.ErrNotFound, "grading result not found", nil)
+		}
+
+		if err := tx.Where("result_id = ?", model.ID).Delete(&models.GradingTestResult{}).Error; err != nil {
+			return fmt.Errorf("failed to delete test results: %w", err)
+		}
+		if len(model.Tests) > 0 {
+			if err := tx.Create(&model.Tests).Error; err != nil {
+				return fmt.Errorf("failed to create test results: %w", err)
+			}
+		}
+
+		if err := tx.Where("result_id = ?", model.ID).Delete(&models.GradingSimilarityMatch{}).Error; err != nil {
+			return fmt.Errorf("failed to delete similarity matches: %w", err)
+		}
+		if len(model.SimilarityMatches) > 0 {
+			if err := tx.Create(&model.SimilarityMatches).Error; err != nil {
+				return fmt.Errorf("failed to create similarity matches: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *repository) GetResult(ctx context.Context, id string) (*domain.Result, error) {
+	var model models.GradingResult
+	if err := r.preload(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, "grading result not found", err)
+		}
+		return nil, fmt.Errorf("failed to get grading result: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *repository) GetLatestResult(ctx context.Context, assessmentID string) (*domain.Result, error) {
+	var model models.GradingResult
+	err := r.preload(ctx).
+		Where("assessment_id = ?", assessmentID).
+		Order("queued_at DESC").
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, "assessment has not been graded", err)
+		}
+		return nil, fmt.Errorf("failed to get grading result: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *repository) ListResults(ctx context.Context, assessmentID string) ([]domain.Result, error) {
+	var ms []models.GradingResult
+	err := r.preload(ctx).
+		Where("assessment_id = ?", assessmentID).
+		Order("queued_at DESC").
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grading results: %w", err)
+	}
+
+	results := make([]domain.Result, 0, len(ms))
+	for i := range ms {
+		results = append(results, *ms[i].ToDomain())
+	}
+	return results, nil
+}
+
+func (r *repository) SaveSubmission(ctx context.Context, submission *domain.Submission) error {
+	if submission == nil {
+		return errors.New("submission is nil")
+	}
+
+	model := models.FromDomainSubmission(submission)
+	model.ID = uuid.New().String()
+	err := r.db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "session_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"problem_key", "language", "tokens", "fingerprints", "submitted_at", "updated_at"}),
+	}).Create(model).Error
+	if err != nil {
+		return fmt.Errorf("failed to save submission fingerprints: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) ListSubmissions(ctx context.Context, problemKey string) ([]domain.Submission, error) {
+	var ms []models.GradingSubmission
+	err := r.db.DB(ctx).
+		Where("problem_key = ?", problemKey).
+		Order("submitted_at").
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list submission fingerprints: %w", err)
+	}
+
+	submissions := make([]domain.Submission, 0, len(ms))
+	for i := range ms {
+		submissions = append(submissions, *ms[i].ToDomain())
+	}
+	return submissions, nil
+}
+
+// preload carga las pruebas en el orden en que se ejecutaron y las entregas parecidas de mayor
+// a menor similitud.
+func (r *repository) preload(ctx context.Context) *gorm0.DB {
+	return r.db.DB(ctx).
+		Preload("Tests", func(db *gorm0.DB) *gorm0.DB {
+			return db.Order("position")
+		}).
+		Preload("SimilarityMatches", func(db *gorm0.DB) *gorm0.DB {
+			return db.Order("position")
+		})
+}
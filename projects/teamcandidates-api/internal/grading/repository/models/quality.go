@@ -0,0 +1,71 @@
+package models
+
+import (
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// qualityReport es la forma en que se guarda en JSON el detalle del reporte de calidad; el estado
+// y el puntaje tienen columnas propias en GradingResult para poder filtrar por ellos.
+type qualityReport struct {
+	Reason        string             `json:"reason,omitempty"`
+	Lines         int                `json:"lines"`
+	Functions     int                `json:"functions"`
+	MaxComplexity int                `json:"max_complexity"`
+	Dimensions    []qualityDimension `json:"dimensions"`
+	Findings      []qualityFinding   `json:"findings"`
+}
+
+type qualityDimension struct {
+	Name     string  `json:"name"`
+	Score    float64 `json:"score"`
+	Weight   float64 `json:"weight"`
+	Findings int     `json:"findings"`
+}
+
+type qualityFinding struct {
+	Dimension string `json:"dimension"`
+	Rule      string `json:"rule"`
+	Line      int    `json:"line"`
+	Message   string `json:"message"`
+}
+
+func encodeQuality(q *domain.QualityReport) string {
+	report := qualityReport{
+		Reason:        q.Reason,
+		Lines:         q.Lines,
+		Functions:     q.Functions,
+		MaxComplexity: q.MaxComplexity,
+		Dimensions:    make([]qualityDimension, 0, len(q.Dimensions)),
+		Findings:      make([]qualityFinding, 0, len(q.Findings)),
+	}
+	for _, d := range q.Dimensions {
+		report.Dimensions = append(report.Dimensions, qualityDimension(d))
+	}
+	for _, f := range q.Findings {
+		report.Findings = append(report.Findings, qualityFinding(f))
+	}
+	return encodeJSON(report)
+}
+
+func decodeQuality(status string, score float64, data string) *domain.QualityReport {
+	var report qualityReport
+	decodeJSON(data, &report)
+
+	q := &domain.QualityReport{
+		Status:        domain.QualityStatus(status),
+		Reason:        report.Reason,
+		Score:         score,
+		Lines:         report.Lines,
+		Functions:     report.Functions,
+		MaxComplexity: report.MaxComplexity,
+		Dimensions:    make([]domain.QualityDimension, 0, len(report.Dimensions)),
+		Findings:      make([]domain.QualityFinding, 0, len(report.Findings)),
+	}
+	for _, d := range report.Dimensions {
+		q.Dimensions = append(q.Dimensions, domain.QualityDimension(d))
+	}
+	for _, f := range report.Findings {
+		q.Findings = append(q.Findings, domain.QualityFinding(f))
+	}
+	return q
+}
@@ -0,0 +1,162 @@
+package models
+
+import (
+	"time"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// GradingResult es la corrección de una entrega en la capa GORM.
+type GradingResult struct {
+	ID            string              `gorm:"primaryKey"`
+	AssessmentID  string              `gorm:"index;not null"`                                  // Evaluación corregida
+	SessionID     string              `gorm:"index"`                                           // Sesión entregada
+	Language      string              `gorm:"type:varchar(50)"`                                // Lenguaje de la entrega
+	Status        string              `gorm:"type:varchar(50);not null"`                       // queued, running, completed, compile_error, failed
+	Passed        int                 `gorm:"not null;default:0"`                              // Pruebas aprobadas
+	Total         int                 `gorm:"not null;default:0"`                              // Pruebas ejecutadas
+	Score         float64             `gorm:"not null;default:0"`                              // Porcentaje aprobado
+	RubricScore   float64             `gorm:"not null;default:0"`                              // Puntaje final ponderado
+	CompileOutput string              `gorm:"type:text"`                                       // Salida del compilador
+	Error         string              `gorm:"type:text"`                                       // Motivo del fallo
+	Tests         []GradingTestResult `gorm:"foreignKey:ResultID;constraint:OnDelete:CASCADE"` // Resultado por prueba
+	QueuedAt      time.Time           `gorm:"not null"`
+	StartedAt     *time.Time          `gorm:""`
+	FinishedAt    *time.Time          `gorm:""`
+	CreatedAt     time.Time           `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time           `gorm:"autoUpdateTime"`
+
+	QualityStatus string  `gorm:"type:varchar(20)"`   // analyzed, skipped; vacío si no se analizó
+	QualityScore  float64 `gorm:"not null;default:0"` // Puntaje de calidad del código
+	QualityReport string  `gorm:"type:text"`          // Dimensiones y hallazgos en JSON
+
+	SimilarityStatus   string                   `gorm:"type:varchar(20)"`                                // checked, skipped; vacío si no se comparó
+	SimilarityReason   string                   `gorm:"type:text"`                                       // Motivo si no se comparó
+	SimilarityCompared int                      `gorm:"not null;default:0"`                              // Entregas comparadas
+	SimilarityScore    float64                  `gorm:"not null;default:0"`                              // Mayor similitud encontrada
+	SimilarityFlagged  bool                     `gorm:"index;not null;default:false"`                    // Requiere revisión de HR
+	SimilarityMatches  []GradingSimilarityMatch `gorm:"foreignKey:ResultID;constraint:OnDelete:CASCADE"` // Entregas parecidas
+}
+
+// GradingTestResult es el resultado de una prueba unitaria dentro de una corrección.
+type GradingTestResult struct {
+	ID         string    `gorm:"primaryKey"`
+	ResultID   string    `gorm:"index;not null"`         // Foreign Key a GradingResult
+	Position   int       `gorm:"not null;default:0"`     // Orden de ejecución
+	UnitTestID string    `gorm:"not null"`               // Prueba de la evaluación
+	TestName   string    `gorm:"type:varchar(100)"`      // Nombre de la prueba
+	Hidden     bool      `gorm:"not null;default:false"` // Prueba oculta al candidato
+	Passed     bool      `gorm:"not null;default:false"`
+	Output     string    `gorm:"type:text"`          // Salida recortada
+	Error      string    `gorm:"type:text"`          // Stderr o motivo del fallo
+	ExitCode   int       `gorm:"not null;default:0"` // Exit code del programa
+	RuntimeMS  int64     `gorm:"not null;default:0"` // Tiempo de ejecución en milisegundos
+	MemoryKB   int64     `gorm:"not null;default:0"` // Pico de memoria
+	TimedOut   bool      `gorm:"not null;default:false"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func FromDomain(r *domain.Result) *GradingResult {
+	tests := make([]GradingTestResult, 0, len(r.Tests))
+	for i, t := range r.Tests {
+		tests = append(tests, GradingTestResult{
+			ID:         t.ID,
+			ResultID:   r.ID,
+			Position:   i,
+			UnitTestID: t.UnitTestID,
+			TestName:   t.TestName,
+			Hidden:     t.Hidden,
+			Passed:     t.Passed,
+			Output:     t.Output,
+			Error:      t.Error,
+			ExitCode:   t.ExitCode,
+			RuntimeMS:  t.Runtime.Milliseconds(),
+			MemoryKB:   t.MemoryKB,
+			TimedOut:   t.TimedOut,
+		})
+	}
+
+	model := &GradingResult{
+		ID:            r.ID,
+		AssessmentID:  r.AssessmentID,
+		SessionID:     r.SessionID,
+		Language:      r.Language,
+		Status:        string(r.Status),
+		Passed:        r.Passed,
+		Total:         r.Total,
+		Score:         r.Score,
+		RubricScore:   r.RubricScore,
+		CompileOutput: r.CompileOutput,
+		Error:         r.Error,
+		Tests:         tests,
+		QueuedAt:      r.QueuedAt,
+		StartedAt:     r.StartedAt,
+		FinishedAt:    r.FinishedAt,
+	}
+	if r.Quality != nil {
+		model.QualityStatus = string(r.Quality.Status)
+		model.QualityScore = r.Quality.Score
+		model.QualityReport = encodeQuality(r.Quality)
+	}
+	if r.Similarity != nil {
+		model.SimilarityStatus = string(r.Similarity.Status)
+		model.SimilarityReason = r.Similarity.Reason
+		model.SimilarityCompared = r.Similarity.Compared
+		model.SimilarityScore = r.Similarity.MaxScore
+		model.SimilarityFlagged = r.Similarity.Flagged
+		model.SimilarityMatches = fromDomainMatches(r.ID, r.Similarity.Matches)
+	}
+	return model
+}
+
+func (m *GradingResult) ToDomain() *domain.Result {
+	tests := make([]domain.TestResult, 0, len(m.Tests))
+	for _, t := range m.Tests {
+		tests = append(tests, domain.TestResult{
+			ID:         t.ID,
+			ResultID:   t.ResultID,
+			UnitTestID: t.UnitTestID,
+			TestName:   t.TestName,
+			Hidden:     t.Hidden,
+			Passed:     t.Passed,
+			Output:     t.Output,
+			Error:      t.Error,
+			ExitCode:   t.ExitCode,
+			Runtime:    time.Duration(t.RuntimeMS) * time.Millisecond,
+			MemoryKB:   t.MemoryKB,
+			TimedOut:   t.TimedOut,
+		})
+	}
+
+	result := &domain.Result{
+		ID:            m.ID,
+		AssessmentID:  m.AssessmentID,
+		SessionID:     m.SessionID,
+		Language:      m.Language,
+		Status:        domain.ResultStatus(m.Status),
+		Passed:        m.Passed,
+		Total:         m.Total,
+		Score:         m.Score,
+		RubricScore:   m.RubricScore,
+		CompileOutput: m.CompileOutput,
+		Error:         m.Error,
+		Tests:         tests,
+		QueuedAt:      m.QueuedAt,
+		StartedAt:     m.StartedAt,
+		FinishedAt:    m.FinishedAt,
+	}
+	if m.QualityStatus != "" {
+		result.Quality = decodeQuality(m.QualityStatus, m.QualityScore, m.QualityReport)
+	}
+	if m.SimilarityStatus != "" {
+		result.Similarity = &domain.Similarity{
+			Status:   domain.SimilarityStatus(m.SimilarityStatus),
+			Reason:   m.SimilarityReason,
+			Compared: m.SimilarityCompared,
+			MaxScore: m.SimilarityScore,
+			Flagged:  m.SimilarityFlagged,
+			Matches:  toDomainMatches(m.SimilarityMatches),
+		}
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// GradingSimilarityMatch es una entrega anterior parecida a la corregida.
+type GradingSimilarityMatch struct {
+	ID           string    `gorm:"primaryKey"`
+	ResultID     string    `gorm:"index;not null"`     // Foreign Key a GradingResult
+	Position     int       `gorm:"not null;default:0"` // Orden por similitud descendente
+	AssessmentID string    `gorm:"index;not null"`     // Evaluación de la entrega parecida
+	SessionID    string    `gorm:""`                   // Sesión de la entrega parecida
+	CandidateID  string    `gorm:"index"`              // Candidato de la entrega parecida
+	Score        float64   `gorm:"not null;default:0"` // Porcentaje de la entrega presente en la otra
+	Regions      string    `gorm:"type:text"`          // Tramos coincidentes en JSON
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+// GradingSubmission guarda la huella de cada entrega para compararla con las siguientes del
+// mismo problema. Hay una por sesión: volver a corregir la entrega la reemplaza.
+type GradingSubmission struct {
+	ID           string    `gorm:"primaryKey"`
+	AssessmentID string    `gorm:"index;not null"`
+	SessionID    string    `gorm:"uniqueIndex;not null"`
+	CandidateID  string    `gorm:"index"`
+	ProblemKey   string    `gorm:"type:varchar(300);index;not null"` // Problema del banco o título normalizado
+	Language     string    `gorm:"type:varchar(50)"`
+	Tokens       int       `gorm:"not null;default:0"`
+	Fingerprints string    `gorm:"type:text"` // Fingerprints en JSON
+	SubmittedAt  time.Time `gorm:"index;not null"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+// region y fingerprint son las formas compactas que se guardan en JSON.
+type region struct {
+	StartLine      int `json:"start_line"`
+	EndLine        int `json:"end_line"`
+	OtherStartLine int `json:"other_start_line"`
+	OtherEndLine   int `json:"other_end_line"`
+}
+
+type fingerprint struct {
+	Hash  uint64 `json:"h"`
+	Start int    `json:"s"`
+	End   int    `json:"e"`
+}
+
+func FromDomainSubmission(s *domain.Submission) *GradingSubmission {
+	fps := make([]fingerprint, 0, len(s.Fingerprints))
+	for _, fp := range s.Fingerprints {
+		fps = append(fps, fingerprint{Hash: fp.Hash, Start: fp.StartLine, End: fp.EndLine})
+	}
+
+	return &GradingSubmission{
+		ID:           s.ID,
+		AssessmentID: s.AssessmentID,
+		SessionID:    s.SessionID,
+		CandidateID:  s.CandidateID,
+		ProblemKey:   s.ProblemKey,
+		Language:     s.Language,
+		Tokens:       s.Tokens,
+		Fingerprints: encodeJSON(fps),
+		SubmittedAt:  s.SubmittedAt,
+	}
+}
+
+func (m *GradingSubmission) ToDomain() *domain.Submission {
+	var fps []fingerprint
+	decodeJSON(m.Fingerprints, &fps)
+
+	s := &domain.Submission{
+		ID:           m.ID,
+		AssessmentID: m.AssessmentID,
+		SessionID:    m.SessionID,
+		CandidateID:  m.CandidateID,
+		ProblemKey:   m.ProblemKey,
+		Language:     m.Language,
+		Tokens:       m.Tokens,
+		Fingerprints: make([]domain.Fingerprint, 0, len(fps)),
+		SubmittedAt:  m.SubmittedAt,
+	}
+	for _, fp := range fps {
+		s.Fingerprints = append(s.Fingerprints, domain.Fingerprint{Hash: fp.Hash, StartLine: fp.Start, EndLine: fp.End})
+	}
+	return s
+}
+
+func fromDomainMatches(resultID string, matches []domain.SimilarityMatch) []GradingSimilarityMatch {
+	ms := make([]GradingSimilarityMatch, 0, len(matches))
+	for i, m := range matches {
+		regions := make([]region, 0, len(m.Regions))
+		for _, r := range m.Regions {
+			regions = append(regions, region(r))
+		}
+		ms = append(ms, GradingSimilarityMatch{
+			ID:           m.ID,
+			ResultID:     resultID,
+			Position:     i,
+			AssessmentID: m.AssessmentID,
+			SessionID:    m.SessionID,
+			CandidateID:  m.CandidateID,
+			Score:        m.Score,
+			Regions:      encodeJSON(regions),
+		})
+	}
+	return ms
+}
+
+func toDomainMatches(ms []GradingSimilarityMatch) []domain.SimilarityMatch {
+	matches := make([]domain.SimilarityMatch, 0, len(ms))
+	for _, m := range ms {
+		var regions []region
+		decodeJSON(m.Regions, &regions)
+		match := domain.SimilarityMatch{
+			ID:           m.ID,
+			ResultID:     m.ResultID,
+			AssessmentID: m.AssessmentID,
+			SessionID:    m.SessionID,
+			CandidateID:  m.CandidateID,
+			Score:        m.Score,
+			Regions:      make([]domain.MatchRegion, 0, len(regions)),
+		}
+		for _, r := range regions {
+			match.Regions = append(match.Regions, domain.MatchRegion(r))
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+func encodeJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func decodeJSON(data string, v any) {
+	if data == "" {
+		return
+	}
+	_ = json.Unmarshal([]byte(data), v)
+}
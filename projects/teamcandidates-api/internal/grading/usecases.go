@@ -0,0 +1,302 @@
+package grading
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	pkgquality "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/quality"
+	pkgsimilarity "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/similarity"
+	pkgsandbox "github.com/teamcubation/teamcandidates/pkg/sandbox/local"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+	support "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/support"
+	pipeline "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline"
+)
+
+// maxStoredOutput limita lo que se guarda de la salida de cada prueba y del compilador.
+const maxStoredOutput = 4 * 1024
+
+type useCases struct {
+	repository   Repository
+	broker       Broker
+	sandbox      pkgsandbox.Service
+	quality      pkgquality.Service
+	similarity   pkgsimilarity.Service
+	assessmentUc assessment.UseCases
+	pipelineUc   pipeline.UseCases
+	config       config.GradingConfig
+}
+
+// NewUseCases crea los casos de uso de la corrección automática.
+func NewUseCases(
+	r Repository,
+	b Broker,
+	s pkgsandbox.Service,
+	q pkgquality.Service,
+	sim pkgsimilarity.Service,
+	au assessment.UseCases,
+	pu pipeline.UseCases,
+	cfg config.Loader,
+) UseCases {
+	return &useCases{
+		repository:   r,
+		broker:       b,
+		sandbox:      s,
+		quality:      q,
+		similarity:   sim,
+		assessmentUc: au,
+		pipelineUc:   pu,
+		config:       cfg.GetGradingConfig(),
+	}
+}
+
+func (u *useCases) RequestGrading(ctx context.Context, assessmentID string) (*domain.Result, error) {
+	session, err := u.assessmentUc.GetSubmission(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.Result{
+		AssessmentID: assessmentID,
+		SessionID:    session.ID,
+		Language:     session.Language,
+		Status:       domain.StatusQueued,
+		QueuedAt:     time.Now(),
+	}
+	id, err := u.repository.CreateResult(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+	result.ID = id
+
+	job := &domain.Job{
+		AssessmentID: assessmentID,
+		SessionID:    session.ID,
+		ResultID:     id,
+		SubmittedAt:  *session.SubmittedAt,
+	}
+	if err := u.broker.PublishJob(ctx, job); err != nil {
+		u.finish(ctx, result, domain.StatusFailed, "failed to enqueue grading")
+		return nil, types.NewError(types.ErrOperationFailed, "failed to enqueue grading", err)
+	}
+	return result, nil
+}
+
+func (u *useCases) GetResult(ctx context.Context, assessmentID string) (*domain.Result, error) {
+	return u.repository.GetLatestResult(ctx, assessmentID)
+}
+
+func (u *useCases) ListResults(ctx context.Context, assessmentID string) ([]domain.Result, error) {
+	return u.repository.ListResults(ctx, assessmentID)
+}
+
+func (u *useCases) RunWorker(ctx context.Context) {
+	if !u.config.Enabled {
+		log.Println("grading: automatic grading is disabled")
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := range u.config.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tag := fmt.Sprintf("grading-worker-%d", i+1)
+			if err := u.broker.Consume(ctx, tag, u.ProcessJob); err != nil && !errors.Is(err, context.Canceled) {
+				log.Printf("grading: %s stopped: %v", tag, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// ProcessJob corrige la entrega, analiza la calidad del código, la compara con las entregas
+// anteriores del mismo problema y guarda el Result. Los problemas de la entrega (no compila,
+// lenguaje no soportado, ...) quedan en el Result y no se reintentan; solo se devuelve error
+// cuando falla el repositorio o se cancela ctx, para que el broker vuelva a entregar el job.
+func (u *useCases) ProcessJob(ctx context.Context, job *domain.Job) error {
+	result, err := u.resultFor(ctx, job)
+	if err != nil {
+		return err
+	}
+	if result.IsFinished() {
+		return nil
+	}
+
+	startedAt := time.Now()
+	result.Status = domain.StatusRunning
+	result.StartedAt = &startedAt
+	if err := u.repository.UpdateResult(ctx, result); err != nil {
+		return err
+	}
+
+	status, reason := u.grade(ctx, result)
+	if status != domain.StatusFailed {
+		u.analyzeQuality(ctx, result)
+		u.detectSimilarity(ctx, result)
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := u.finish(ctx, result, status, reason); err != nil {
+		return err
+	}
+
+	log.Printf("grading: assessment %s graded: %s, %d/%d tests passed", result.AssessmentID, result.Status, result.Passed, result.Total)
+
+	// Un fallo de la corrección (StatusFailed) deja la evaluación en submitted para reintentarla
+	if result.Status != domain.StatusFailed {
+		if err := u.assessmentUc.MarkGraded(ctx, result.AssessmentID); err != nil {
+			log.Printf("grading: failed to mark assessment %s as graded: %v", result.AssessmentID, err)
+			return nil
+		}
+		// El candidato avanza en el tablero de las búsquedas en las que espera la evaluación
+		if _, err := u.pipelineUc.CompleteAssessment(ctx, result.AssessmentID); err != nil {
+			log.Printf("grading: failed to advance pipeline for assessment %s: %v", result.AssessmentID, err)
+		}
+	}
+	return nil
+}
+
+// resultFor devuelve el Result del job. Las entregas llegan sin Result: se reutiliza el de una
+// entrega anterior del mismo job que no terminó (p. ej., por un reinicio) o se crea uno nuevo.
+func (u *useCases) resultFor(ctx context.Context, job *domain.Job) (*domain.Result, error) {
+	if job.ResultID != "" {
+		return u.repository.GetResult(ctx, job.ResultID)
+	}
+
+	latest, err := u.repository.GetLatestResult(ctx, job.AssessmentID)
+	if err != nil && !types.IsNotFound(err) {
+		return nil, err
+	}
+	if latest != nil && latest.SessionID == job.SessionID && !latest.IsFinished() {
+		return latest, nil
+	}
+
+	result := &domain.Result{
+		AssessmentID: job.AssessmentID,
+		SessionID:    job.SessionID,
+		Status:       domain.StatusQueued,
+		QueuedAt:     time.Now(),
+	}
+	id, err := u.repository.CreateResult(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+	result.ID = id
+	return result, nil
+}
+
+// grade ejecuta la entrega contra todas las pruebas de la evaluación, visibles y ocultas, y
+// completa result. Devuelve el estado final y, si no se pudo corregir, el motivo.
+func (u *useCases) grade(ctx context.Context, result *domain.Result) (domain.ResultStatus, string) {
+	assessment, err := u.assessmentUc.GetAssessment(ctx, result.AssessmentID)
+	if err != nil {
+		return domain.StatusFailed, fmt.Sprintf("failed to get assessment: %v", err)
+	}
+	session, err := u.assessmentUc.GetSubmission(ctx, result.AssessmentID)
+	if err != nil {
+		return domain.StatusFailed, fmt.Sprintf("failed to get submission: %v", err)
+	}
+
+	result.SessionID = session.ID
+	result.Language = session.Language
+	result.Total = len(assessment.UnitTests)
+	result.Tests = nil
+	if result.Total == 0 {
+		return domain.StatusFailed, "assessment has no unit tests"
+	}
+
+	lang, err := pkgsandbox.ParseLanguage(session.Language)
+	if err != nil {
+		return domain.StatusFailed, err.Error()
+	}
+	if !slices.Contains(u.sandbox.Languages(), lang) {
+		return domain.StatusFailed, fmt.Sprintf("language %s is not available in the sandbox", lang)
+	}
+
+	program, err := u.sandbox.Prepare(ctx, lang, session.Code)
+	if err != nil {
+		var compileErr *pkgsandbox.CompileError
+		if errors.As(err, &compileErr) {
+			result.CompileOutput = support.Truncate(compileErr.Output, maxStoredOutput)
+			return domain.StatusCompileError, ""
+		}
+		return domain.StatusFailed, fmt.Sprintf("failed to prepare program: %v", err)
+	}
+	defer program.Close()
+
+	for _, test := range assessment.UnitTests {
+		if ctx.Err() != nil {
+			break
+		}
+		result.Tests = append(result.Tests, runTest(ctx, program, test))
+	}
+	return domain.StatusCompleted, ""
+}
+
+// runTest ejecuta el programa con la entrada de la prueba y compara su salida con la esperada.
+func runTest(ctx context.Context, program pkgsandbox.Program, test assdomain.UnitTest) domain.TestResult {
+	tr := domain.TestResult{
+		UnitTestID: test.ID,
+		TestName:   test.TestName,
+		Hidden:     test.Hidden,
+	}
+
+	exec, err := program.Run(ctx, test.InputData)
+	if err != nil {
+		tr.Error = err.Error()
+		return tr
+	}
+
+	tr.Output = support.Truncate(exec.Stdout, maxStoredOutput)
+	tr.ExitCode = exec.ExitCode
+	tr.Runtime = exec.Duration
+	tr.MemoryKB = exec.MemoryKB
+	tr.TimedOut = exec.TimedOut
+
+	switch {
+	case exec.TimedOut:
+		tr.Error = "time limit exceeded"
+	case exec.ExitCode != 0:
+		tr.Error = support.Truncate(fmt.Sprintf("exit code %d: %s", exec.ExitCode, exec.Stderr), maxStoredOutput)
+	case exec.OutputTruncated:
+		tr.Error = "output limit exceeded"
+	case !support.OutputMatches(exec.Stdout, test.ExpectedOutput):
+		tr.Error = "wrong answer"
+	default:
+		tr.Passed = true
+	}
+	return tr
+}
+
+// finish calcula el puntaje de las pruebas y el puntaje final, y guarda el estado final del Result.
+func (u *useCases) finish(ctx context.Context, result *domain.Result, status domain.ResultStatus, reason string) error {
+	result.Passed = 0
+	for _, t := range result.Tests {
+		if t.Passed {
+			result.Passed++
+		}
+	}
+	result.Score = 0
+	if result.Total > 0 {
+		result.Score = math.Round(float64(result.Passed)/float64(result.Total)*10000) / 100
+	}
+
+	finishedAt := time.Now()
+	result.Status = status
+	result.Error = reason
+	result.RubricScore = u.rubricScore(result)
+	result.FinishedAt = &finishedAt
+	return u.repository.UpdateResult(ctx, result)
+}
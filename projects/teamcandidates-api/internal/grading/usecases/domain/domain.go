@@ -0,0 +1,66 @@
+package domain
+
+import "time"
+
+// ResultStatus representa el estado de una corrección.
+type ResultStatus string
+
+const (
+	StatusQueued       ResultStatus = "queued"        // Encolada, esperando un worker
+	StatusRunning      ResultStatus = "running"       // Ejecutando las pruebas
+	StatusCompleted    ResultStatus = "completed"     // Todas las pruebas se ejecutaron
+	StatusCompileError ResultStatus = "compile_error" // El código no compiló
+	StatusFailed       ResultStatus = "failed"        // No se pudo corregir (lenguaje no soportado, sin entrega, ...)
+)
+
+// Job es el pedido de corrección que viaja por la cola. Las entregas de los candidatos llegan
+// sin ResultID; las correcciones pedidas a mano ya tienen su Result creado.
+type Job struct {
+	AssessmentID string    `json:"assessment_id"`
+	SessionID    string    `json:"session_id,omitempty"`
+	ResultID     string    `json:"result_id,omitempty"`
+	SubmittedAt  time.Time `json:"submitted_at,omitempty"`
+}
+
+// Result es la corrección de la entrega de una evaluación. Una evaluación puede corregirse
+// varias veces; la vigente es la más reciente.
+type Result struct {
+	ID            string
+	AssessmentID  string // Evaluación corregida
+	SessionID     string // Sesión entregada por el candidato
+	Language      string
+	Status        ResultStatus
+	Passed        int     // Pruebas que pasaron
+	Total         int     // Pruebas ejecutadas (visibles y ocultas)
+	Score         float64 // Porcentaje de pruebas aprobadas, de 0 a 100
+	RubricScore   float64 // Puntaje final: pruebas y calidad del código ponderadas, de 0 a 100
+	CompileOutput string  // Salida del compilador si Status es compile_error
+	Error         string  // Motivo si Status es failed
+	Tests         []TestResult
+	Quality       *QualityReport // Análisis estático del código; nil si no se hizo
+	Similarity    *Similarity    // Comparación con otras entregas; nil si no se hizo
+	QueuedAt      time.Time
+	StartedAt     *time.Time
+	FinishedAt    *time.Time
+}
+
+// TestResult es el resultado de una prueba unitaria de la evaluación.
+type TestResult struct {
+	ID         string
+	ResultID   string
+	UnitTestID string
+	TestName   string
+	Hidden     bool
+	Passed     bool
+	Output     string // Salida del programa (recortada)
+	Error      string // Stderr o motivo del fallo (timeout, exit code, ...)
+	ExitCode   int
+	Runtime    time.Duration // Tiempo de ejecución
+	MemoryKB   int64         // Pico de memoria
+	TimedOut   bool
+}
+
+// IsFinished indica si la corrección terminó, con o sin éxito.
+func (r *Result) IsFinished() bool {
+	return r.Status == StatusCompleted || r.Status == StatusCompileError || r.Status == StatusFailed
+}
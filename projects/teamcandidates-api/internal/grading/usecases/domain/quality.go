@@ -0,0 +1,43 @@
+package domain
+
+// QualityStatus indica si se pudo analizar la calidad del código de la entrega.
+type QualityStatus string
+
+const (
+	QualityAnalyzed QualityStatus = "analyzed" // Se generó el reporte
+	QualitySkipped  QualityStatus = "skipped"  // No se pudo analizar (lenguaje no soportado, no parsea, ...)
+)
+
+// QualityReport es el análisis estático de la entrega: un puntaje por dimensión (complejidad,
+// code smells, números mágicos, formato y manejo de errores) y los hallazgos con su línea.
+type QualityReport struct {
+	Status        QualityStatus
+	Reason        string  // Motivo si Status es skipped
+	Score         float64 // Promedio ponderado de las dimensiones, de 0 a 100
+	Lines         int
+	Functions     int
+	MaxComplexity int
+	Dimensions    []QualityDimension
+	Findings      []QualityFinding
+}
+
+// QualityDimension es el puntaje de una dimensión de la calidad, de 0 a 100.
+type QualityDimension struct {
+	Name     string
+	Score    float64
+	Weight   float64 // Peso en el puntaje de calidad
+	Findings int
+}
+
+// QualityFinding es un problema de calidad encontrado en una línea de la entrega.
+type QualityFinding struct {
+	Dimension string
+	Rule      string
+	Line      int
+	Message   string
+}
+
+// IsAnalyzed indica si el reporte tiene puntaje.
+func (q *QualityReport) IsAnalyzed() bool {
+	return q != nil && q.Status == QualityAnalyzed
+}
@@ -0,0 +1,62 @@
+package domain
+
+import "time"
+
+// SimilarityStatus indica si se pudo comparar la entrega con las anteriores.
+type SimilarityStatus string
+
+const (
+	SimilarityChecked SimilarityStatus = "checked" // Comparada con las entregas anteriores del problema
+	SimilaritySkipped SimilarityStatus = "skipped" // No se pudo comparar (lenguaje no soportado, sin código, ...)
+)
+
+// Similarity es el resultado de comparar la entrega con las entregas anteriores del mismo
+// problema hechas por otros candidatos. Es información para la revisión de HR: no cambia el puntaje.
+type Similarity struct {
+	Status   SimilarityStatus
+	Reason   string  // Motivo si Status es skipped
+	Compared int     // Entregas anteriores comparadas
+	MaxScore float64 // Mayor similitud encontrada, de 0 a 100
+	Flagged  bool    // MaxScore alcanza el umbral de revisión
+	Matches  []SimilarityMatch
+}
+
+// SimilarityMatch es una entrega anterior parecida a la corregida.
+type SimilarityMatch struct {
+	ID           string
+	ResultID     string
+	AssessmentID string  // Evaluación de la entrega parecida
+	SessionID    string  // Sesión de la entrega parecida
+	CandidateID  string  // Candidato que hizo la entrega parecida
+	Score        float64 // Porcentaje de la entrega corregida que aparece en la otra, de 0 a 100
+	Regions      []MatchRegion
+}
+
+// MatchRegion es un tramo de código presente en las dos entregas.
+type MatchRegion struct {
+	StartLine      int // Líneas en la entrega corregida
+	EndLine        int
+	OtherStartLine int // Líneas en la entrega parecida
+	OtherEndLine   int
+}
+
+// Submission es la huella de una entrega, guardada para compararla con las siguientes entregas
+// del mismo problema.
+type Submission struct {
+	ID           string
+	AssessmentID string
+	SessionID    string
+	CandidateID  string
+	ProblemKey   string // Problema del banco o, si se cargó a mano, su título normalizado
+	Language     string
+	Tokens       int // Tokens normalizados del código
+	Fingerprints []Fingerprint
+	SubmittedAt  time.Time
+}
+
+// Fingerprint es el hash de un tramo normalizado de la entrega y las líneas que cubre.
+type Fingerprint struct {
+	Hash      uint64
+	StartLine int
+	EndLine   int
+}
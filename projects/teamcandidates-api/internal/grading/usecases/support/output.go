@@ -0,0 +1,34 @@
+package support
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// NormalizeOutput prepara una salida para compararla con la esperada: unifica los saltos de
+// línea, quita los espacios al final de cada línea y las líneas vacías del final.
+func NormalizeOutput(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// OutputMatches compara la salida del programa con la esperada ignorando las diferencias de formato
+// que NormalizeOutput descarta.
+func OutputMatches(got, expected string) bool {
+	return NormalizeOutput(got) == NormalizeOutput(expected)
+}
+
+// Truncate recorta s a n bytes sin cortar una runa a la mitad.
+func Truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "…"
+}
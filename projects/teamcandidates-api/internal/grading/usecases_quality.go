@@ -0,0 +1,79 @@
+package grading
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	pkgsandbox "github.com/teamcubation/teamcandidates/pkg/sandbox/local"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// analyzeQuality genera el reporte de calidad del código entregado. Si no se puede analizar, el
+// motivo queda en result.Quality como skipped y el puntaje final usa solo las pruebas.
+func (u *useCases) analyzeQuality(ctx context.Context, result *domain.Result) {
+	if !u.config.QualityEnabled {
+		return
+	}
+	result.Quality = u.qualityReport(ctx, result)
+}
+
+func (u *useCases) qualityReport(ctx context.Context, result *domain.Result) *domain.QualityReport {
+	session, err := u.assessmentUc.GetSubmission(ctx, result.AssessmentID)
+	if err != nil {
+		return skippedQuality(fmt.Sprintf("failed to get submission: %v", err))
+	}
+
+	// El análisis trabaja sobre el AST de Go
+	if lang, err := pkgsandbox.ParseLanguage(session.Language); err != nil || lang != pkgsandbox.LanguageGo {
+		return skippedQuality(fmt.Sprintf("quality analysis does not support %q submissions", session.Language))
+	}
+
+	report, err := u.quality.Analyze(session.Code)
+	if err != nil {
+		return skippedQuality(err.Error())
+	}
+
+	q := &domain.QualityReport{
+		Status:        domain.QualityAnalyzed,
+		Score:         report.Score,
+		Lines:         report.Lines,
+		Functions:     report.Functions,
+		MaxComplexity: report.MaxComplexity,
+		Dimensions:    make([]domain.QualityDimension, 0, len(report.Dimensions)),
+		Findings:      make([]domain.QualityFinding, 0, len(report.Findings)),
+	}
+	for _, d := range report.Dimensions {
+		q.Dimensions = append(q.Dimensions, domain.QualityDimension{
+			Name:     string(d.Dimension),
+			Score:    d.Score,
+			Weight:   d.Weight,
+			Findings: d.Findings,
+		})
+	}
+	for _, f := range report.Findings {
+		q.Findings = append(q.Findings, domain.QualityFinding{
+			Dimension: string(f.Dimension),
+			Rule:      f.Rule,
+			Line:      f.Line,
+			Message:   f.Message,
+		})
+	}
+	return q
+}
+
+func skippedQuality(reason string) *domain.QualityReport {
+	return &domain.QualityReport{Status: domain.QualitySkipped, Reason: reason}
+}
+
+// rubricScore combina el porcentaje de pruebas aprobadas con la calidad del código según los
+// pesos configurados. Un código que no compila o que no se pudo corregir no suma por calidad, y
+// si no hay reporte de calidad el puntaje final es el de las pruebas.
+func (u *useCases) rubricScore(result *domain.Result) float64 {
+	if result.Status != domain.StatusCompleted || !result.Quality.IsAnalyzed() {
+		return result.Score
+	}
+	score := (result.Score*u.config.RubricTestWeight + result.Quality.Score*u.config.RubricQualityWeight) / 100
+	return math.Round(score*100) / 100
+}
@@ -0,0 +1,181 @@
+package grading
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	pkgquality "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/quality"
+	pkgsandbox "github.com/teamcubation/teamcandidates/pkg/sandbox/local"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// fakeQuality devuelve siempre el mismo reporte o error.
+type fakeQuality struct {
+	report *pkgquality.Report
+	err    error
+}
+
+func (q *fakeQuality) Analyze(string) (*pkgquality.Report, error) {
+	return q.report, q.err
+}
+
+func TestProcessJobQuality(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sum := assdomain.UnitTest{ID: "ut1", TestName: "sum", InputData: "1 2", ExpectedOutput: "3"}
+	wrong := assdomain.UnitTest{ID: "ut2", TestName: "double", InputData: "2 2", ExpectedOutput: "4"}
+	report := &pkgquality.Report{
+		Score:         80,
+		Lines:         12,
+		Functions:     2,
+		MaxComplexity: 4,
+		Dimensions: []pkgquality.DimensionScore{
+			{Dimension: pkgquality.DimensionComplexity, Score: 100, Weight: 25},
+			{Dimension: pkgquality.DimensionMagicNumbers, Score: 90, Weight: 15, Findings: 2},
+		},
+		Findings: []pkgquality.Finding{
+			{Dimension: pkgquality.DimensionMagicNumbers, Rule: "magic_number", Line: 5, Message: "magic number 42"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		language    string
+		tests       []assdomain.UnitTest
+		quality     *fakeQuality
+		disabled    bool
+		compileErr  bool
+		wantStatus  domain.ResultStatus
+		wantQuality domain.QualityStatus
+		wantScore   float64
+		wantRubric  float64
+	}{
+		{
+			name:        "Success: rubric weights tests and code quality",
+			tests:       []assdomain.UnitTest{sum},
+			quality:     &fakeQuality{report: report},
+			wantStatus:  domain.StatusCompleted,
+			wantQuality: domain.QualityAnalyzed,
+			wantScore:   100,
+			wantRubric:  94,
+		},
+		{
+			name:        "Success: failed tests lower the rubric score",
+			tests:       []assdomain.UnitTest{sum, wrong},
+			quality:     &fakeQuality{report: report},
+			wantStatus:  domain.StatusCompleted,
+			wantQuality: domain.QualityAnalyzed,
+			wantScore:   50,
+			wantRubric:  59,
+		},
+		{
+			name:        "Success: code that does not compile gets no points for quality",
+			tests:       []assdomain.UnitTest{sum},
+			quality:     &fakeQuality{report: report},
+			compileErr:  true,
+			wantStatus:  domain.StatusCompileError,
+			wantQuality: domain.QualityAnalyzed,
+		},
+		{
+			name:        "Skipped: language without quality analysis uses the test score",
+			language:    "python",
+			tests:       []assdomain.UnitTest{sum},
+			quality:     &fakeQuality{report: report},
+			wantStatus:  domain.StatusCompleted,
+			wantQuality: domain.QualitySkipped,
+			wantScore:   100,
+			wantRubric:  100,
+		},
+		{
+			name:        "Skipped: analysis failure uses the test score",
+			tests:       []assdomain.UnitTest{sum, wrong},
+			quality:     &fakeQuality{err: errors.New("failed to parse source")},
+			wantStatus:  domain.StatusCompleted,
+			wantQuality: domain.QualitySkipped,
+			wantScore:   50,
+			wantRubric:  50,
+		},
+		{
+			name:       "Success: analysis disabled uses the test score",
+			tests:      []assdomain.UnitTest{sum, wrong},
+			quality:    &fakeQuality{report: report},
+			disabled:   true,
+			wantStatus: domain.StatusCompleted,
+			wantScore:  50,
+			wantRubric: 50,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFields(ctrl)
+			f.sandbox.languages = []pkgsandbox.Language{pkgsandbox.LanguageGo, pkgsandbox.LanguagePython}
+			if tc.compileErr {
+				f.sandbox.prepareErr = &pkgsandbox.CompileError{Output: "syntax error"}
+			}
+			f.quality = tc.quality
+			f.grading = config.GradingConfig{
+				Enabled:             true,
+				Workers:             1,
+				QualityEnabled:      !tc.disabled,
+				RubricTestWeight:    70,
+				RubricQualityWeight: 30,
+			}
+
+			submittedAt := time.Now()
+			f.assessment.EXPECT().
+				GetAssessment(gomock.Any(), "ass1").
+				Return(&assdomain.Assessment{ID: "ass1", UnitTests: tc.tests}, nil)
+			f.assessment.EXPECT().
+				GetSubmission(gomock.Any(), "ass1").
+				Return(&assdomain.Session{ID: "ses1", AssessmentID: "ass1", Language: cmp.Or(tc.language, "go"), Code: "package main", SubmittedAt: &submittedAt}, nil).
+				AnyTimes()
+			f.expectGraded()
+
+			err := f.useCases().ProcessJob(ctx, &domain.Job{AssessmentID: "ass1", SessionID: "ses1"})
+			assert.NoError(t, err, "expected no error but got one")
+
+			result, err := f.repository.GetLatestResult(ctx, "ass1")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, result.Status, "status mismatch")
+			assert.Equal(t, tc.wantScore, result.Score, "score mismatch")
+			assert.Equal(t, tc.wantRubric, result.RubricScore, "rubric score mismatch")
+
+			if tc.wantQuality == "" {
+				assert.Nil(t, result.Quality, "disabled analysis should not leave a report")
+				return
+			}
+			assert.NotNil(t, result.Quality)
+			assert.Equal(t, tc.wantQuality, result.Quality.Status, "quality status mismatch")
+			if tc.wantQuality == domain.QualitySkipped {
+				assert.NotEmpty(t, result.Quality.Reason, "skipped analysis should record the reason")
+				return
+			}
+			assert.Equal(t, &domain.QualityReport{
+				Status:        domain.QualityAnalyzed,
+				Score:         80,
+				Lines:         12,
+				Functions:     2,
+				MaxComplexity: 4,
+				Dimensions: []domain.QualityDimension{
+					{Name: "complexity", Score: 100, Weight: 25},
+					{Name: "magic_numbers", Score: 90, Weight: 15, Findings: 2},
+				},
+				Findings: []domain.QualityFinding{
+					{Dimension: "magic_numbers", Rule: "magic_number", Line: 5, Message: "magic number 42"},
+				},
+			}, result.Quality, "quality report mismatch")
+		})
+	}
+}
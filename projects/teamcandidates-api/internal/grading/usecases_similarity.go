@@ -0,0 +1,157 @@
+package grading
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math"
+	"slices"
+	"strings"
+
+	pkgsimilarity "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/similarity"
+	pkgsandbox "github.com/teamcubation/teamcandidates/pkg/sandbox/local"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// detectSimilarity guarda la huella de la entrega y la compara con las entregas anteriores del
+// mismo problema hechas por otros candidatos. Si no se puede comparar, el motivo queda en
+// result.Similarity como skipped: nunca impide guardar la corrección.
+func (u *useCases) detectSimilarity(ctx context.Context, result *domain.Result) {
+	if !u.config.SimilarityEnabled {
+		return
+	}
+
+	result.Similarity = u.compareSubmission(ctx, result)
+	if result.Similarity.Flagged {
+		log.Printf("grading: assessment %s flagged for review: %.2f%% similar to a previous submission",
+			result.AssessmentID, result.Similarity.MaxScore)
+	}
+}
+
+func (u *useCases) compareSubmission(ctx context.Context, result *domain.Result) *domain.Similarity {
+	assessment, err := u.assessmentUc.GetAssessment(ctx, result.AssessmentID)
+	if err != nil {
+		return skippedSimilarity(fmt.Sprintf("failed to get assessment: %v", err))
+	}
+	session, err := u.assessmentUc.GetSubmission(ctx, result.AssessmentID)
+	if err != nil {
+		return skippedSimilarity(fmt.Sprintf("failed to get submission: %v", err))
+	}
+
+	// El fingerprinting trabaja sobre el AST de Go
+	if lang, err := pkgsandbox.ParseLanguage(session.Language); err != nil || lang != pkgsandbox.LanguageGo {
+		return skippedSimilarity(fmt.Sprintf("similarity detection does not support %q submissions", session.Language))
+	}
+	key := problemKey(&assessment.Problem)
+	if key == "" {
+		return skippedSimilarity("assessment has no problem to compare submissions")
+	}
+
+	doc, err := u.similarity.Fingerprint(session.Code)
+	if err != nil {
+		return skippedSimilarity(err.Error())
+	}
+	if doc.Tokens == 0 {
+		return skippedSimilarity("submission has no code to compare")
+	}
+
+	current := &domain.Submission{
+		AssessmentID: assessment.ID,
+		SessionID:    session.ID,
+		CandidateID:  assessment.CandidateID,
+		ProblemKey:   key,
+		Language:     string(pkgsandbox.LanguageGo),
+		Tokens:       doc.Tokens,
+		Fingerprints: toDomainFingerprints(doc.Fingerprints),
+	}
+	if session.SubmittedAt != nil {
+		current.SubmittedAt = *session.SubmittedAt
+	}
+	if err := u.repository.SaveSubmission(ctx, current); err != nil {
+		return skippedSimilarity(fmt.Sprintf("failed to save submission fingerprints: %v", err))
+	}
+
+	previous, err := u.repository.ListSubmissions(ctx, key)
+	if err != nil {
+		return skippedSimilarity(fmt.Sprintf("failed to list previous submissions: %v", err))
+	}
+
+	similarity := &domain.Similarity{Status: domain.SimilarityChecked}
+	for i := range previous {
+		other := &previous[i]
+		// Solo entregas anteriores de otros candidatos: volver a rendir no es plagio
+		if other.SessionID == current.SessionID || other.SubmittedAt.After(current.SubmittedAt) ||
+			(current.CandidateID != "" && other.CandidateID == current.CandidateID) {
+			continue
+		}
+
+		similarity.Compared++
+		comparison := u.similarity.Compare(doc, toDocument(other))
+		score := math.Round(comparison.Containment*10000) / 100
+		similarity.MaxScore = max(similarity.MaxScore, score)
+		if comparison.Shared == 0 || score < u.config.SimilarityThreshold {
+			continue
+		}
+
+		match := domain.SimilarityMatch{
+			AssessmentID: other.AssessmentID,
+			SessionID:    other.SessionID,
+			CandidateID:  other.CandidateID,
+			Score:        score,
+			Regions:      make([]domain.MatchRegion, 0, len(comparison.Regions)),
+		}
+		for _, r := range comparison.Regions {
+			match.Regions = append(match.Regions, domain.MatchRegion(r))
+		}
+		similarity.Matches = append(similarity.Matches, match)
+	}
+
+	slices.SortStableFunc(similarity.Matches, func(a, b domain.SimilarityMatch) int { return cmp.Compare(b.Score, a.Score) })
+	if len(similarity.Matches) > u.config.SimilarityMaxMatches {
+		similarity.Matches = similarity.Matches[:u.config.SimilarityMaxMatches]
+	}
+	similarity.Flagged = similarity.Compared > 0 && similarity.MaxScore >= u.config.SimilarityFlagScore
+	return similarity
+}
+
+func skippedSimilarity(reason string) *domain.Similarity {
+	return &domain.Similarity{Status: domain.SimilaritySkipped, Reason: reason}
+}
+
+// problemKey identifica el problema para agrupar las entregas comparables: el problema del banco
+// (todas sus versiones) o, si se cargó a mano, el hash del enunciado normalizado.
+func problemKey(p *assdomain.Problem) string {
+	if p.BankProblemID != "" {
+		return "bank:" + p.BankProblemID
+	}
+	statement := strings.ToLower(strings.Join(strings.Fields(p.Description), " "))
+	if statement == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(statement))
+	return "statement:" + hex.EncodeToString(sum[:])
+}
+
+func toDomainFingerprints(fps []pkgsimilarity.Fingerprint) []domain.Fingerprint {
+	out := make([]domain.Fingerprint, 0, len(fps))
+	for _, fp := range fps {
+		out = append(out, domain.Fingerprint(fp))
+	}
+	return out
+}
+
+func toDocument(s *domain.Submission) *pkgsimilarity.Document {
+	doc := &pkgsimilarity.Document{
+		Tokens:       s.Tokens,
+		Fingerprints: make([]pkgsimilarity.Fingerprint, 0, len(s.Fingerprints)),
+	}
+	for _, fp := range s.Fingerprints {
+		doc.Fingerprints = append(doc.Fingerprints, pkgsimilarity.Fingerprint(fp))
+	}
+	return doc
+}
@@ -0,0 +1,278 @@
+package grading
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	pkgsimilarity "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/similarity"
+	pkgsandbox "github.com/teamcubation/teamcandidates/pkg/sandbox/local"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// currentHash es el fingerprint de la entrega corregida en fakeSimilarity.
+const currentHash = 100
+
+// fakeSimilarity devuelve una huella fija por entrega y compara según el primer fingerprint de la
+// entrega anterior, así cada caso decide qué tan parecida es cada una.
+type fakeSimilarity struct {
+	fingerprintErr error
+	containment    map[uint64]float64
+}
+
+func (s *fakeSimilarity) Fingerprint(source string) (*pkgsimilarity.Document, error) {
+	if s.fingerprintErr != nil {
+		return nil, s.fingerprintErr
+	}
+	tokens := len(strings.Fields(source))
+	if tokens == 0 {
+		return &pkgsimilarity.Document{}, nil
+	}
+	return &pkgsimilarity.Document{
+		Tokens:       tokens,
+		Fingerprints: []pkgsimilarity.Fingerprint{{Hash: currentHash, StartLine: 1, EndLine: 3}},
+	}, nil
+}
+
+func (s *fakeSimilarity) Compare(a, b *pkgsimilarity.Document) *pkgsimilarity.Comparison {
+	other := b.Fingerprints[0]
+	containment := s.containment[other.Hash]
+	if containment == 0 {
+		return &pkgsimilarity.Comparison{}
+	}
+	return &pkgsimilarity.Comparison{
+		Containment: containment,
+		Shared:      1,
+		Regions:     []pkgsimilarity.Region{{StartLine: 1, EndLine: 3, OtherStartLine: other.StartLine, OtherEndLine: other.EndLine}},
+	}
+}
+
+// previousSubmission es una entrega anterior del problema bank:p1 identificada por su fingerprint.
+func previousSubmission(assessmentID, candidateID string, hash uint64, submittedAt time.Time) *domain.Submission {
+	return &domain.Submission{
+		AssessmentID: assessmentID,
+		SessionID:    "ses-" + assessmentID,
+		CandidateID:  candidateID,
+		ProblemKey:   "bank:p1",
+		Language:     "go",
+		Tokens:       10,
+		Fingerprints: []domain.Fingerprint{{Hash: hash, StartLine: 4, EndLine: 6}},
+		SubmittedAt:  submittedAt,
+	}
+}
+
+func TestProcessJobSimilarity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	submittedAt := time.Now()
+	before, after := submittedAt.Add(-time.Hour), submittedAt.Add(time.Hour)
+	bankProblem := assdomain.Problem{BankProblemID: "p1"}
+
+	tests := []struct {
+		name         string
+		problem      assdomain.Problem
+		language     string
+		code         string
+		previous     []*domain.Submission
+		similarity   *fakeSimilarity
+		disabled     bool
+		wantStatus   domain.SimilarityStatus
+		wantCompared int
+		wantMax      float64
+		wantFlagged  bool
+		wantMatches  []string
+		wantSaved    bool
+	}{
+		{
+			name:    "Success: similar submissions of other candidates are stored and flagged",
+			problem: bankProblem,
+			previous: []*domain.Submission{
+				previousSubmission("ass2", "cand2", 1, before),
+				previousSubmission("ass3", "cand3", 2, before),
+				previousSubmission("ass4", "cand4", 3, before),
+			},
+			similarity:   &fakeSimilarity{containment: map[uint64]float64{1: 0.4, 2: 0.8512, 3: 0.1}},
+			wantStatus:   domain.SimilarityChecked,
+			wantCompared: 3,
+			wantMax:      85.12,
+			wantFlagged:  true,
+			wantMatches:  []string{"ass3 85.12", "ass2 40.00"},
+			wantSaved:    true,
+		},
+		{
+			name:    "Success: only the most similar submissions are kept",
+			problem: bankProblem,
+			previous: []*domain.Submission{
+				previousSubmission("ass2", "cand2", 1, before),
+				previousSubmission("ass3", "cand3", 2, before),
+				previousSubmission("ass4", "cand4", 3, before),
+			},
+			similarity:   &fakeSimilarity{containment: map[uint64]float64{1: 0.5, 2: 0.6, 3: 0.55}},
+			wantStatus:   domain.SimilarityChecked,
+			wantCompared: 3,
+			wantMax:      60,
+			wantMatches:  []string{"ass3 60.00", "ass4 55.00"},
+			wantSaved:    true,
+		},
+		{
+			name:    "Success: own and later submissions are not compared",
+			problem: bankProblem,
+			previous: []*domain.Submission{
+				previousSubmission("ass2", "cand1", 1, before),
+				previousSubmission("ass3", "cand3", 2, after),
+			},
+			similarity:   &fakeSimilarity{containment: map[uint64]float64{1: 1, 2: 1}},
+			wantStatus:   domain.SimilarityChecked,
+			wantCompared: 0,
+			wantSaved:    true,
+		},
+		{
+			name:       "Success: first submission of a problem written by hand",
+			problem:    assdomain.Problem{Description: "Sumar  dos números"},
+			similarity: &fakeSimilarity{},
+			wantStatus: domain.SimilarityChecked,
+		},
+		{
+			name:       "Skipped: language without AST fingerprinting",
+			problem:    bankProblem,
+			language:   "python",
+			similarity: &fakeSimilarity{},
+			wantStatus: domain.SimilaritySkipped,
+		},
+		{
+			name:       "Skipped: assessment without problem",
+			similarity: &fakeSimilarity{},
+			wantStatus: domain.SimilaritySkipped,
+		},
+		{
+			name:       "Skipped: submission without code",
+			problem:    bankProblem,
+			code:       "  ",
+			similarity: &fakeSimilarity{},
+			wantStatus: domain.SimilaritySkipped,
+		},
+		{
+			name:       "Skipped: fingerprint failure does not fail the grading",
+			problem:    bankProblem,
+			similarity: &fakeSimilarity{fingerprintErr: errors.New("parse failed")},
+			wantStatus: domain.SimilaritySkipped,
+		},
+		{
+			name:       "Success: detection disabled",
+			problem:    bankProblem,
+			similarity: &fakeSimilarity{},
+			disabled:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFields(ctrl)
+			f.sandbox.languages = []pkgsandbox.Language{pkgsandbox.LanguageGo, pkgsandbox.LanguagePython}
+			f.similarity = tc.similarity
+			f.grading = config.GradingConfig{
+				Enabled:              true,
+				Workers:              1,
+				SimilarityEnabled:    !tc.disabled,
+				SimilarityThreshold:  30,
+				SimilarityFlagScore:  70,
+				SimilarityMaxMatches: 2,
+			}
+			for _, s := range tc.previous {
+				assert.NoError(t, f.repository.SaveSubmission(ctx, s))
+			}
+
+			language, code := cmp.Or(tc.language, "go"), cmp.Or(tc.code, "package main\nfunc main() {}")
+			f.assessment.EXPECT().
+				GetAssessment(gomock.Any(), "ass1").
+				Return(&assdomain.Assessment{
+					ID:          "ass1",
+					CandidateID: "cand1",
+					Problem:     tc.problem,
+					UnitTests:   []assdomain.UnitTest{{ID: "ut1", TestName: "sum", InputData: "1 2", ExpectedOutput: "3"}},
+				}, nil).
+				AnyTimes()
+			f.assessment.EXPECT().
+				GetSubmission(gomock.Any(), "ass1").
+				Return(&assdomain.Session{ID: "ses1", AssessmentID: "ass1", Language: language, Code: code, SubmittedAt: &submittedAt}, nil).
+				AnyTimes()
+			f.expectGraded()
+
+			err := f.useCases().ProcessJob(ctx, &domain.Job{AssessmentID: "ass1", SessionID: "ses1"})
+			assert.NoError(t, err, "expected no error but got one")
+
+			result, err := f.repository.GetLatestResult(ctx, "ass1")
+			assert.NoError(t, err)
+			assert.Equal(t, domain.StatusCompleted, result.Status, "similarity must not change the grading")
+			assert.Equal(t, float64(100), result.Score, "similarity must not change the score")
+
+			if tc.wantStatus == "" {
+				assert.Nil(t, result.Similarity, "disabled detection should not leave a result")
+				return
+			}
+			assert.NotNil(t, result.Similarity)
+			assert.Equal(t, tc.wantStatus, result.Similarity.Status, "status mismatch")
+			if tc.wantStatus == domain.SimilaritySkipped {
+				assert.NotEmpty(t, result.Similarity.Reason, "skipped detection should record the reason")
+			}
+			assert.Equal(t, tc.wantCompared, result.Similarity.Compared, "compared submissions mismatch")
+			assert.Equal(t, tc.wantMax, result.Similarity.MaxScore, "max score mismatch")
+			assert.Equal(t, tc.wantFlagged, result.Similarity.Flagged, "flagged mismatch")
+
+			var matches []string
+			for _, m := range result.Similarity.Matches {
+				matches = append(matches, fmt.Sprintf("%s %.2f", m.AssessmentID, m.Score))
+				assert.Equal(t, []domain.MatchRegion{{StartLine: 1, EndLine: 3, OtherStartLine: 4, OtherEndLine: 6}}, m.Regions, "regions mismatch")
+			}
+			assert.Equal(t, tc.wantMatches, matches, "matches mismatch")
+
+			if tc.wantSaved {
+				saved, err := f.repository.ListSubmissions(ctx, "bank:p1")
+				assert.NoError(t, err)
+				assert.Contains(t, submissionSessions(saved), "ses1", "fingerprints of the submission should be stored")
+			}
+		})
+	}
+}
+
+func TestProblemKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		problem assdomain.Problem
+		want    string
+	}{
+		{name: "bank problem", problem: assdomain.Problem{BankProblemID: "p1", Description: "Sumar"}, want: "bank:p1"},
+		{name: "empty statement", problem: assdomain.Problem{Description: " \n "}, want: ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, problemKey(&tc.problem))
+		})
+	}
+
+	// El enunciado cargado a mano se normaliza antes del hash
+	a := problemKey(&assdomain.Problem{Description: "Sumar  dos\nNúmeros"})
+	b := problemKey(&assdomain.Problem{Description: "sumar dos números"})
+	assert.True(t, strings.HasPrefix(a, "statement:"))
+	assert.Equal(t, a, b, "statement keys should ignore case and whitespace")
+}
+
+func submissionSessions(submissions []domain.Submission) []string {
+	sessions := make([]string, 0, len(submissions))
+	for _, s := range submissions {
+		sessions = append(sessions, s.SessionID)
+	}
+	return sessions
+}
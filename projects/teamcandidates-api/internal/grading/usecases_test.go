@@ -0,0 +1,238 @@
+package grading
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	pkgquality "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/quality"
+	pkgsimilarity "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/similarity"
+	pkgsandbox "github.com/teamcubation/teamcandidates/pkg/sandbox/local"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	mock_assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/mocks"
+	mock_config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config/mocks"
+	mock_grading "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/mocks"
+	mock_pipeline "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/mocks"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// fakeSandbox responde cada ejecución según el stdin, sin compilar ni ejecutar código.
+type fakeSandbox struct {
+	languages  []pkgsandbox.Language
+	prepareErr error
+	outputs    map[string]*pkgsandbox.Execution
+}
+
+func (s *fakeSandbox) Languages() []pkgsandbox.Language {
+	return s.languages
+}
+
+func (s *fakeSandbox) Prepare(_ context.Context, _ pkgsandbox.Language, _ string) (pkgsandbox.Program, error) {
+	if s.prepareErr != nil {
+		return nil, s.prepareErr
+	}
+	return &fakeProgram{outputs: s.outputs}, nil
+}
+
+type fakeProgram struct {
+	outputs map[string]*pkgsandbox.Execution
+}
+
+func (p *fakeProgram) Run(_ context.Context, input string) (*pkgsandbox.Execution, error) {
+	exec, ok := p.outputs[input]
+	if !ok {
+		return nil, errors.New("unexpected input " + input)
+	}
+	return exec, nil
+}
+
+func (p *fakeProgram) Close() error {
+	return nil
+}
+
+// fields usa el repositorio en memoria real y mockea los casos de uso de los que depende la corrección.
+type fields struct {
+	repository Repository
+	sandbox    *fakeSandbox
+	quality    pkgquality.Service
+	similarity pkgsimilarity.Service
+	broker     *mock_grading.MockBroker
+	assessment *mock_assessment.MockUseCases
+	pipeline   *mock_pipeline.MockUseCases
+	config     *mock_config.MockLoader
+	grading    config.GradingConfig
+}
+
+func newFields(ctrl *gomock.Controller) *fields {
+	f := &fields{
+		repository: NewMemoryRepository(mapdb.Bootstrap()),
+		sandbox: &fakeSandbox{
+			languages: []pkgsandbox.Language{pkgsandbox.LanguageGo},
+			outputs: map[string]*pkgsandbox.Execution{
+				"1 2": {Stdout: "3\n"},
+				"2 2": {Stdout: "5\n"},
+				"9 9": {TimedOut: true},
+			},
+		},
+		broker:     mock_grading.NewMockBroker(ctrl),
+		assessment: mock_assessment.NewMockUseCases(ctrl),
+		pipeline:   mock_pipeline.NewMockUseCases(ctrl),
+		config:     mock_config.NewMockLoader(ctrl),
+		// Calidad y similitud deshabilitadas: el puntaje final es el de las pruebas.
+		grading: config.GradingConfig{Enabled: true, Workers: 1},
+	}
+	return f
+}
+
+func (f *fields) useCases() UseCases {
+	f.config.EXPECT().GetGradingConfig().Return(f.grading).AnyTimes()
+	return NewUseCases(f.repository, f.broker, f.sandbox, f.quality, f.similarity, f.assessment, f.pipeline, f.config)
+}
+
+// expectSubmission devuelve la evaluación y la entrega que corrige el job.
+func (f *fields) expectSubmission(language string, tests ...assdomain.UnitTest) {
+	f.assessment.EXPECT().
+		GetAssessment(gomock.Any(), "ass1").
+		Return(&assdomain.Assessment{ID: "ass1", UnitTests: tests}, nil)
+	submittedAt := time.Now()
+	f.assessment.EXPECT().
+		GetSubmission(gomock.Any(), "ass1").
+		Return(&assdomain.Session{ID: "ses1", AssessmentID: "ass1", Language: language, Code: "package main", SubmittedAt: &submittedAt}, nil)
+}
+
+// expectGraded espera que la evaluación pase a graded y avance en el pipeline.
+func (f *fields) expectGraded() {
+	f.assessment.EXPECT().MarkGraded(gomock.Any(), "ass1").Return(nil)
+	f.pipeline.EXPECT().CompleteAssessment(gomock.Any(), "ass1").Return(1, nil)
+}
+
+func TestProcessJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sum := assdomain.UnitTest{ID: "ut1", TestName: "sum", InputData: "1 2", ExpectedOutput: "3"}
+	wrong := assdomain.UnitTest{ID: "ut2", TestName: "double", InputData: "2 2", ExpectedOutput: "4", Hidden: true}
+	slow := assdomain.UnitTest{ID: "ut3", TestName: "slow", InputData: "9 9", ExpectedOutput: "18"}
+
+	tests := []struct {
+		name       string
+		setup      func(t *testing.T, f *fields) *domain.Job
+		wantStatus domain.ResultStatus
+		wantPassed int
+		wantScore  float64
+		wantErrors []string
+	}{
+		{
+			name: "Success: submission graded against visible and hidden tests",
+			setup: func(t *testing.T, f *fields) *domain.Job {
+				f.expectSubmission("go", sum, wrong, slow)
+				f.expectGraded()
+				return &domain.Job{AssessmentID: "ass1", SessionID: "ses1"}
+			},
+			wantStatus: domain.StatusCompleted,
+			wantPassed: 1,
+			wantScore:  33.33,
+			wantErrors: []string{"", "wrong answer", "time limit exceeded"},
+		},
+		{
+			name: "Success: compile error is a final result",
+			setup: func(t *testing.T, f *fields) *domain.Job {
+				f.sandbox.prepareErr = &pkgsandbox.CompileError{Output: "syntax error"}
+				f.expectSubmission("go", sum)
+				f.expectGraded()
+				return &domain.Job{AssessmentID: "ass1", SessionID: "ses1"}
+			},
+			wantStatus: domain.StatusCompileError,
+		},
+		{
+			name: "Failed: language not available in the sandbox keeps the assessment submitted",
+			setup: func(t *testing.T, f *fields) *domain.Job {
+				f.sandbox.languages = nil
+				f.expectSubmission("go", sum)
+				return &domain.Job{AssessmentID: "ass1", SessionID: "ses1"}
+			},
+			wantStatus: domain.StatusFailed,
+		},
+		{
+			name: "Failed: submission not found",
+			setup: func(t *testing.T, f *fields) *domain.Job {
+				f.assessment.EXPECT().
+					GetAssessment(gomock.Any(), "ass1").
+					Return(&assdomain.Assessment{ID: "ass1", UnitTests: []assdomain.UnitTest{sum}}, nil)
+				f.assessment.EXPECT().
+					GetSubmission(gomock.Any(), "ass1").
+					Return(nil, types.NewError(types.ErrConflict, "assessment session has not been submitted", nil))
+				return &domain.Job{AssessmentID: "ass1", SessionID: "ses1"}
+			},
+			wantStatus: domain.StatusFailed,
+		},
+		{
+			name: "Success: finished result is not graded again",
+			setup: func(t *testing.T, f *fields) *domain.Job {
+				id, err := f.repository.CreateResult(context.Background(), &domain.Result{
+					AssessmentID: "ass1",
+					SessionID:    "ses1",
+					Status:       domain.StatusCompleted,
+					Total:        1,
+					Passed:       1,
+					Score:        100,
+				})
+				assert.NoError(t, err)
+				return &domain.Job{AssessmentID: "ass1", SessionID: "ses1", ResultID: id}
+			},
+			wantStatus: domain.StatusCompleted,
+			wantPassed: 1,
+			wantScore:  100,
+		},
+		{
+			name: "Success: mark graded failure does not retry the job",
+			setup: func(t *testing.T, f *fields) *domain.Job {
+				f.expectSubmission("go", sum)
+				f.assessment.EXPECT().MarkGraded(gomock.Any(), "ass1").Return(errors.New("db down"))
+				return &domain.Job{AssessmentID: "ass1", SessionID: "ses1"}
+			},
+			wantStatus: domain.StatusCompleted,
+			wantPassed: 1,
+			wantScore:  100,
+			wantErrors: []string{""},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			job := tc.setup(t, f)
+
+			err := f.useCases().ProcessJob(context.Background(), job)
+			assert.NoError(t, err, "grading problems should not retry the job")
+
+			result, err := f.repository.GetLatestResult(context.Background(), "ass1")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, result.Status, "status mismatch")
+			assert.Equal(t, tc.wantPassed, result.Passed, "passed tests mismatch")
+			assert.Equal(t, tc.wantScore, result.Score, "score mismatch")
+			if tc.wantErrors != nil {
+				errs := make([]string, 0, len(result.Tests))
+				for _, test := range result.Tests {
+					errs = append(errs, test.Error)
+				}
+				assert.Equal(t, tc.wantErrors, errs, "test errors mismatch")
+			}
+			if tc.wantStatus == domain.StatusFailed {
+				assert.NotEmpty(t, result.Error, "failed result should record the reason")
+			}
+			if tc.wantStatus == domain.StatusCompileError {
+				assert.Equal(t, "syntax error", result.CompileOutput, "compile output mismatch")
+			}
+		})
+	}
+}
@@ -57,6 +57,7 @@ func (h *Handler) Routes() {
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionUserRead, types.PermissionUserWrite))
 
 		protected.GET("/ping", h.ProtectedPing)
 
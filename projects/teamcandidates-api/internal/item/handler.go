@@ -40,7 +40,7 @@ func (h *Handler) Routes() {
 	public := router.Group(publicPrefix)
 	{
 		public.POST("", h.CreateItem)
-		public.GET("", h.ListItems)
+		public.GET("", mdw.ParseQuerySpec(dto.ItemQuerySchema), h.ListItems)
 		public.GET("/:id", h.GetItem)
 		public.PUT("/:id", h.UpdateItem)
 		public.DELETE("/:id", h.DeleteItem)
@@ -56,7 +56,12 @@ func (h *Handler) Routes() {
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionCatalogRead, types.PermissionCatalogWrite))
 		protected.GET("/ping", h.ProtectedPing) // Endpoint de prueba protegido
+
+		protected.GET("", mdw.ParseQuerySpec(dto.ItemQuerySchema), h.ListItems)
+		protected.DELETE("/:id", h.DeleteItem)
+		protected.POST("/:id/restore", mdw.RequirePermission(types.PermissionRestore), h.RestoreItem)
 	}
 }
 
@@ -103,7 +108,14 @@ func (h *Handler) CreateItem(c *gin.Context) {
 }
 
 func (h *Handler) ListItems(c *gin.Context) {
-	items, err := h.ucs.ListItems(c.Request.Context())
+	spec, err := mdw.GetQuerySpec(c)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	page, err := h.ucs.ListItems(c.Request.Context(), spec)
 	if err != nil {
 		apiErr, _ := types.NewAPIError(err)
 		c.Error(apiErr).SetMeta(map[string]any{
@@ -111,7 +123,14 @@ func (h *Handler) ListItems(c *gin.Context) {
 		})
 		return
 	}
-	c.JSON(http.StatusOK, items)
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainListItem), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, res)
 }
 
 func (h *Handler) GetItem(c *gin.Context) {
@@ -189,7 +208,8 @@ func (h *Handler) DeleteItem(c *gin.Context) {
 		return
 	}
 
-	if err := h.ucs.DeleteItem(c.Request.Context(), id); err != nil {
+	hardDelete := c.Query("hardDelete") == "true"
+	if err := h.ucs.DeleteItem(c.Request.Context(), id, hardDelete); err != nil {
 		apiErr, _ := types.NewAPIError(err)
 		// Include details such as "item with id X does not exist" in the response meta.
 		c.Error(apiErr).SetMeta(map[string]any{
@@ -201,3 +221,25 @@ func (h *Handler) DeleteItem(c *gin.Context) {
 		Message: "Item deleted successfully",
 	})
 }
+
+func (h *Handler) RestoreItem(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error: "invalid item id",
+		})
+		return
+	}
+
+	if err := h.ucs.RestoreItem(c.Request.Context(), id); err != nil {
+		apiErr, _ := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(map[string]any{
+			"details": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Item restored successfully",
+	})
+}
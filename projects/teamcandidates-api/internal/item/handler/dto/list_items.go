@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/item/usecases/domain"
+)
+
+// ItemQuerySchema define los campos por los que se puede filtrar, ordenar y seleccionar en el listado.
+// La clave es numérica, así que el cursor compara ids como enteros.
+var ItemQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":          {Column: "id", Type: types.FieldInt, Filterable: true, Sortable: true},
+		"name":        {Column: "name", Type: types.FieldString, Filterable: true, Sortable: true},
+		"price_usd":   {Column: "price_usd", Type: types.FieldFloat, Filterable: true, Sortable: true},
+		"category_id": {Column: "category_id", Type: types.FieldInt, Filterable: true},
+		"supplier_id": {Column: "supplier_id", Type: types.FieldInt, Filterable: true},
+		"deleted_at":  {Column: "deleted_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"id"},
+}
+
+// ItemListItem es la vista de un item en el listado.
+// DeletedAt y DeletedBy solo aparecen cuando un admin lista con deleted=include|only.
+type ItemListItem struct {
+	Item
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy string     `json:"deleted_by,omitempty"`
+}
+
+// FromDomainListItem convierte un item del listado al DTO.
+func FromDomainListItem(i domain.Item) ItemListItem {
+	return ItemListItem{Item: *FromDomainItem(i), DeletedAt: i.DeletedAt, DeletedBy: i.DeletedBy}
+}
@@ -2,6 +2,9 @@ package item
 
 import (
 	"context"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/item/usecases/domain"
 )
@@ -9,17 +12,22 @@ import (
 // UseCases define las operaciones de negocio para items.
 type UseCases interface {
 	CreateItem(ctx context.Context, item *domain.Item) (int64, error)
-	ListItems(ctx context.Context) ([]domain.Item, error)
+	ListItems(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Item], error)
 	GetItem(ctx context.Context, itemID int64) (*domain.Item, error)
-	DeleteItem(ctx context.Context, itemID int64) error
+	DeleteItem(ctx context.Context, itemID int64, hardDelete bool) error
+	RestoreItem(ctx context.Context, itemID int64) error
+	PurgeDeletedItems(ctx context.Context, before time.Time) (int64, error)
 	UpdateItem(ctx context.Context, updateItem *domain.Item) error
 }
 
 // Repository define las operaciones que el adaptador GORM debe implementar.
 type Repository interface {
 	CreateItem(ctx context.Context, item *domain.Item) (int64, error)
-	ListItems(ctx context.Context) ([]domain.Item, error)
+	ListItems(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Item], error)
 	GetItem(ctx context.Context, id int64) (*domain.Item, error)
 	UpdateItem(ctx context.Context, item *domain.Item) error
-	DeleteItem(ctx context.Context, id int64) error
+	SoftDeleteItem(ctx context.Context, id int64, deletedBy string) error
+	HardDeleteItem(ctx context.Context, id int64) error
+	RestoreItem(ctx context.Context, id int64) error
+	PurgeDeletedItems(ctx context.Context, before time.Time) (int64, error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	gorm0 "gorm.io/gorm"
 
@@ -41,18 +42,16 @@ func (r *repository) CreateItem(ctx context.Context, item *domain.Item) (int64,
 	return model.ID, nil
 }
 
-// ListItems obtiene todos los items de la base de datos y los convierte a dominio.
-func (r *repository) ListItems(ctx context.Context) ([]domain.Item, error) {
-	var items []models.Item
-	if err := r.db.Client().WithContext(ctx).Find(&items).Error; err != nil {
+// ListItems obtiene una página de items según el query spec y los convierte a dominio.
+func (r *repository) ListItems(ctx context.Context, spec *pkgtypes.QuerySpec) (*pkgtypes.Page[domain.Item], error) {
+	page, err := gorm.Paginate[models.Item](r.db.Client().WithContext(ctx).Model(&models.Item{}), spec)
+	if err != nil {
 		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to list items", err)
 	}
 
-	domainItems := make([]domain.Item, 0, len(items))
-	for _, m := range items {
-		domainItems = append(domainItems, *m.ToDomain())
-	}
-	return domainItems, nil
+	return pkgtypes.MapPage(page, func(m models.Item) domain.Item {
+		return *m.ToDomain()
+	}), nil
 }
 
 // GetItem obtiene un item por su ID y lo convierte a la entidad de dominio.
@@ -94,10 +93,29 @@ func (r *repository) UpdateItem(ctx context.Context, item *domain.Item) error {
 	return nil
 }
 
-// DeleteItem elimina un item a partir de su ID.
-// Si no se encuentra un item con el ID indicado, retorna un error.
-func (r *repository) DeleteItem(ctx context.Context, id int64) error {
+// SoftDeleteItem marca un item como borrado, registrando quién lo borró.
+// Si no se encuentra un item activo con el ID indicado, retorna un error.
+func (r *repository) SoftDeleteItem(ctx context.Context, id int64, deletedBy string) error {
+	result := r.db.Client().WithContext(ctx).
+		Model(&models.Item{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"deleted_at": time.Now(), "deleted_by": deletedBy})
+
+	if result.Error != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete item", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("item with id %d does not exist", id), nil)
+	}
+
+	return nil
+}
+
+// HardDeleteItem elimina definitivamente un item, aunque tenga soft delete.
+func (r *repository) HardDeleteItem(ctx context.Context, id int64) error {
 	result := r.db.Client().WithContext(ctx).
+		Unscoped().
 		Delete(&models.Item{}, "id = ?", id)
 
 	if result.Error != nil {
@@ -110,3 +128,36 @@ func (r *repository) DeleteItem(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// RestoreItem deshace el soft delete de un item.
+func (r *repository) RestoreItem(ctx context.Context, id int64) error {
+	result := r.db.Client().WithContext(ctx).
+		Unscoped().
+		Model(&models.Item{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_by": nil})
+
+	if result.Error != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to restore item", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("deleted item with id %d does not exist", id), nil)
+	}
+
+	return nil
+}
+
+// PurgeDeletedItems elimina definitivamente los items con soft delete anterior a before.
+func (r *repository) PurgeDeletedItems(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.Client().WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Delete(&models.Item{})
+
+	if result.Error != nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to purge deleted items", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
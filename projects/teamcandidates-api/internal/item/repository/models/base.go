@@ -1,16 +1,22 @@
 package models
 
 import (
+	"time"
+
+	"gorm.io/gorm"
+
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/item/usecases/domain"
 )
 
 // Item representa el modelo en la base de datos para un artículo o ítem.
 type Item struct {
-	ID         int64   `gorm:"primaryKey"`
-	Name       string  `gorm:"type:varchar(150);not null"`
-	PriceUSD   float64 `gorm:"not null"`
-	CategoryID int64   `gorm:"not null"`
-	SupplierID int64   `gorm:"not null"`
+	ID         int64          `gorm:"primaryKey"`
+	Name       string         `gorm:"type:varchar(150);not null"`
+	PriceUSD   float64        `gorm:"not null"`
+	CategoryID int64          `gorm:"not null"`
+	SupplierID int64          `gorm:"not null"`
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+	DeletedBy  string         // Principal que hizo el soft delete
 }
 
 // ToDomain convierte el modelo Item a la entidad de dominio.
@@ -21,6 +27,13 @@ func (i Item) ToDomain() *domain.Item {
 		PriceUSD:   i.PriceUSD,
 		CategoryID: i.CategoryID,
 		SupplierID: i.SupplierID,
+		DeletedAt: func() *time.Time {
+			if i.DeletedAt.Valid {
+				return &i.DeletedAt.Time
+			}
+			return nil
+		}(),
+		DeletedBy: i.DeletedBy,
 	}
 }
 
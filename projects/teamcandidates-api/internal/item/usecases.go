@@ -2,6 +2,9 @@ package item
 
 import (
 	"context"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	authe "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe"
 	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
@@ -33,9 +36,9 @@ func (u *useCases) CreateItem(ctx context.Context, item *domain.Item) (int64, er
 	return u.repository.CreateItem(ctx, item)
 }
 
-// ListItems obtiene la lista de todos los items.
-func (u *useCases) ListItems(ctx context.Context) ([]domain.Item, error) {
-	return u.repository.ListItems(ctx)
+// ListItems obtiene una página de items según el query spec.
+func (u *useCases) ListItems(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Item], error) {
+	return u.repository.ListItems(ctx, spec)
 }
 
 // GetItem obtiene un item por su ID.
@@ -43,9 +46,22 @@ func (u *useCases) GetItem(ctx context.Context, itemID int64) (*domain.Item, err
 	return u.repository.GetItem(ctx, itemID)
 }
 
-// DeleteItem elimina un item por su ID.
-func (u *useCases) DeleteItem(ctx context.Context, itemID int64) error {
-	return u.repository.DeleteItem(ctx, itemID)
+// DeleteItem elimina un item por su ID: soft delete salvo que se pida hardDelete.
+func (u *useCases) DeleteItem(ctx context.Context, itemID int64, hardDelete bool) error {
+	if hardDelete {
+		return u.repository.HardDeleteItem(ctx, itemID)
+	}
+	return u.repository.SoftDeleteItem(ctx, itemID, types.PrincipalIDFromContext(ctx))
+}
+
+// RestoreItem deshace el soft delete de un item.
+func (u *useCases) RestoreItem(ctx context.Context, itemID int64) error {
+	return u.repository.RestoreItem(ctx, itemID)
+}
+
+// PurgeDeletedItems elimina definitivamente los items borrados antes de before.
+func (u *useCases) PurgeDeletedItems(ctx context.Context, before time.Time) (int64, error) {
+	return u.repository.PurgeDeletedItems(ctx, before)
 }
 
 // UpdateItem actualiza un item existente.
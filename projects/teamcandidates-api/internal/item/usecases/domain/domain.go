@@ -1,9 +1,13 @@
 package domain
 
+import "time"
+
 type Item struct {
-	ID         int64   // Primary key (numeric)
-	Name       string  // Item name
-	PriceUSD   float64 // Price in USD
-	CategoryID int64   // Foreign key referencing Category
-	SupplierID int64   // Foreign key referencing Supplier
+	ID         int64      // Primary key (numeric)
+	Name       string     // Item name
+	PriceUSD   float64    // Price in USD
+	CategoryID int64      // Foreign key referencing Category
+	SupplierID int64      // Foreign key referencing Supplier
+	DeletedAt  *time.Time // Soft delete timestamp
+	DeletedBy  string     // Principal that soft deleted the item
 }
@@ -0,0 +1,237 @@
+package jobopening
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	gsv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/handler/dto"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/usecases/domain"
+	problemdto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/handler/dto"
+)
+
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{
+		ucs: u,
+		gsv: s,
+		mws: m,
+	}
+}
+
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/job-openings"
+	protectedPrefix := apiBase + "/protected"
+
+	// Rutas protegidas
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionCandidateRead, types.PermissionCandidateWrite))
+
+		protected.POST("", h.CreateOpening)                                                                    // Crear una búsqueda
+		protected.GET("", mdw.ParseQuerySpec(dto.OpeningQuerySchema), h.ListOpenings)                          // Listar (paginado; ?skill= y ?hr_owner=)
+		protected.GET("/candidates/:candidateId", h.ListCandidateApplications)                                 // Búsquedas a las que se postuló el candidato
+		protected.GET("/:id", h.GetOpening)                                                                    // Detalle
+		protected.PUT("/:id", h.UpdateOpening)                                                                 // Editar datos, skills y responsables
+		protected.POST("/:id/status", h.ChangeStatus)                                                          // Publicar, pausar, cerrar o reabrir
+		protected.GET("/:id/summary", h.GetSummary)                                                            // Postulaciones, etapas y evaluaciones
+		protected.POST("/:id/applications", h.Apply)                                                           // Postular un candidato
+		protected.GET("/:id/applications", mdw.ParseQuerySpec(dto.ApplicationQuerySchema), h.ListApplications) // Postulaciones (paginado)
+		protected.POST("/:id/assessments", h.CreateAssessment)                                                 // Evaluación con las skills de la búsqueda
+	}
+}
+
+func (h *Handler) CreateOpening(c *gin.Context) {
+	var req dto.JobOpening
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	ctx := c.Request.Context()
+	id, err := h.ucs.CreateOpening(ctx, req.ToDomain(""))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	opening, err := h.ucs.GetOpening(ctx, id)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.FromDomain(*opening))
+}
+
+func (h *Handler) GetOpening(c *gin.Context) {
+	opening, err := h.ucs.GetOpening(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(*opening))
+}
+
+func (h *Handler) ListOpenings(c *gin.Context) {
+	spec, err := mdw.GetQuerySpec(c)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	filter := domain.Filter{Skill: c.Query("skill"), HROwner: c.Query("hr_owner")}
+	page, err := h.ucs.ListOpenings(c.Request.Context(), spec, filter)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomain), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *Handler) UpdateOpening(c *gin.Context) {
+	var req dto.JobOpening
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	opening, err := h.ucs.UpdateOpening(c.Request.Context(), req.ToDomain(c.Param("id")))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(*opening))
+}
+
+func (h *Handler) ChangeStatus(c *gin.Context) {
+	var req dto.ChangeStatus
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	opening, err := h.ucs.ChangeStatus(c.Request.Context(), c.Param("id"), domain.Status(req.Status))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(*opening))
+}
+
+func (h *Handler) GetSummary(c *gin.Context) {
+	summary, err := h.ucs.GetSummary(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainSummary(summary))
+}
+
+func (h *Handler) Apply(c *gin.Context) {
+	var req dto.Apply
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	application, err := h.ucs.Apply(c.Request.Context(), req.ToDomain(c.Param("id")))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.FromDomainApplication(*application))
+}
+
+func (h *Handler) ListApplications(c *gin.Context) {
+	spec, err := mdw.GetQuerySpec(c)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	page, err := h.ucs.ListApplications(c.Request.Context(), c.Param("id"), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainApplication), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *Handler) ListCandidateApplications(c *gin.Context) {
+	candidateID := c.Param("candidateId")
+	applications, err := h.ucs.ListCandidateApplications(c.Request.Context(), candidateID)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainCandidateApplications(candidateID, applications))
+}
+
+func (h *Handler) CreateAssessment(c *gin.Context) {
+	var req dto.CreateAssessment
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	ctx := c.Request.Context()
+	assessmentReq, err := req.ToDomain(c.Param("id"), types.PrincipalIDFromContext(ctx))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	result, err := h.ucs.CreateAssessment(ctx, assessmentReq)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusCreated, problemdto.FromDomainAssembly(result))
+}
@@ -0,0 +1,230 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	candomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/usecases/domain"
+	locdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/location/usecases/domain"
+)
+
+// OpeningQuerySchema define los campos por los que se puede filtrar y ordenar el listado de
+// búsquedas. Los filtros por skill y por responsable van como ?skill= y ?hr_owner=.
+var OpeningQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":               {Column: "id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"title":            {Column: "title", Type: types.FieldString, Filterable: true, Sortable: true},
+		"team":             {Column: "team", Type: types.FieldString, Filterable: true, Sortable: true},
+		"status":           {Column: "status", Type: types.FieldString, Filterable: true, Sortable: true},
+		"experience_level": {Column: "experience_level", Type: types.FieldString, Filterable: true, Sortable: true},
+		"created_at":       {Column: "created_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"updated_at":       {Column: "updated_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"-created_at"},
+}
+
+// ApplicationQuerySchema define los campos por los que se puede filtrar y ordenar el listado de
+// postulaciones de una búsqueda.
+var ApplicationQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":           {Column: "id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"candidate_id": {Column: "candidate_id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"source":       {Column: "source", Type: types.FieldString, Filterable: true, Sortable: true},
+		"applied_at":   {Column: "applied_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"-applied_at"},
+}
+
+type Location struct {
+	Address    string `json:"address" binding:"omitempty,max=200"`
+	City       string `json:"city" binding:"omitempty,max=100"`
+	State      string `json:"state" binding:"omitempty,max=100"`
+	Country    string `json:"country" binding:"omitempty,max=100"`
+	PostalCode string `json:"postal_code" binding:"omitempty,max=20"`
+}
+
+type Skill struct {
+	SkillName  string `json:"skill_name" binding:"required,max=100"`
+	SkillLevel string `json:"skill_level" binding:"omitempty,max=50"`
+}
+
+// JobOpening es el body de POST / y PUT /:id. En PUT se ignora el estado.
+type JobOpening struct {
+	Title           string   `json:"title" binding:"required,max=200"`
+	Team            string   `json:"team" binding:"omitempty,max=100"`
+	Location        Location `json:"location"`
+	Skills          []Skill  `json:"skills" binding:"required,min=1,max=30,dive"`
+	ExperienceLevel string   `json:"experience_level" binding:"omitempty,oneof=trainee junior mid semi-senior senior"`
+	HROwners        []string `json:"hr_owners" binding:"required,min=1,max=20,dive,required"`
+	Status          string   `json:"status" binding:"omitempty,oneof=draft open"` // Vacío = draft
+}
+
+// ChangeStatus es el body de POST /:id/status.
+type ChangeStatus struct {
+	Status string `json:"status" binding:"required,oneof=draft open on_hold closed"`
+}
+
+// Apply es el body de POST /:id/applications.
+type Apply struct {
+	CandidateID string `json:"candidate_id" binding:"required"`
+	Source      string `json:"source" binding:"omitempty,max=50"`
+}
+
+// CreateAssessment es el body de POST /:id/assessments. Las skills salen de la búsqueda.
+type CreateAssessment struct {
+	CandidateID string   `json:"candidate_id" binding:"required"`
+	Tags        []string `json:"tags"`
+	MaxDuration string   `json:"max_duration" binding:"required"` // Duración de Go, p. ej. "90m"
+}
+
+// Mappers
+func (j *JobOpening) ToDomain(id string) *domain.JobOpening {
+	opening := &domain.JobOpening{
+		ID:    id,
+		Title: j.Title,
+		Team:  j.Team,
+		Location: locdomain.Location{
+			Address:    j.Location.Address,
+			City:       j.Location.City,
+			State:      j.Location.State,
+			Country:    j.Location.Country,
+			PostalCode: j.Location.PostalCode,
+		},
+		Skills:          make([]assdomain.SkillConfig, 0, len(j.Skills)),
+		ExperienceLevel: candomain.ExperienceLevel(j.ExperienceLevel),
+		HROwners:        j.HROwners,
+		Status:          domain.Status(j.Status),
+	}
+	for _, s := range j.Skills {
+		opening.Skills = append(opening.Skills, assdomain.SkillConfig{SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	return opening
+}
+
+func (r *Apply) ToDomain(jobOpeningID string) *domain.Application {
+	return &domain.Application{JobOpeningID: jobOpeningID, CandidateID: r.CandidateID, Source: r.Source}
+}
+
+func (r *CreateAssessment) ToDomain(jobOpeningID, hrID string) (*domain.AssessmentRequest, error) {
+	duration, err := time.ParseDuration(r.MaxDuration)
+	if err != nil {
+		return nil, types.NewError(types.ErrValidation, fmt.Sprintf("invalid max_duration: %v", err), err)
+	}
+	return &domain.AssessmentRequest{
+		JobOpeningID: jobOpeningID,
+		CandidateID:  r.CandidateID,
+		HRID:         hrID,
+		Tags:         r.Tags,
+		MaxDuration:  duration,
+	}, nil
+}
+
+// Response
+type JobOpeningResponse struct {
+	ID              string    `json:"id"`
+	Title           string    `json:"title"`
+	Team            string    `json:"team,omitempty"`
+	Location        Location  `json:"location"`
+	Skills          []Skill   `json:"skills"`
+	ExperienceLevel string    `json:"experience_level,omitempty"`
+	HROwners        []string  `json:"hr_owners"`
+	Status          string    `json:"status"`
+	CreatedBy       string    `json:"created_by,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type Application struct {
+	ID           string    `json:"id"`
+	JobOpeningID string    `json:"job_opening_id"`
+	CandidateID  string    `json:"candidate_id"`
+	Source       string    `json:"source,omitempty"`
+	AppliedBy    string    `json:"applied_by,omitempty"`
+	AppliedAt    time.Time `json:"applied_at"`
+}
+
+type CandidateApplicationsResponse struct {
+	CandidateID  string        `json:"candidate_id"`
+	Applications []Application `json:"applications"`
+}
+
+type SummaryStage struct {
+	Key   string `json:"key"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type SummaryResponse struct {
+	JobOpening   JobOpeningResponse `json:"job_opening"`
+	Applications int                `json:"applications"`
+	Stages       []SummaryStage     `json:"stages"`
+	Assessments  map[string]int     `json:"assessments"` // Cantidad por estado
+}
+
+func FromDomain(j domain.JobOpening) JobOpeningResponse {
+	resp := JobOpeningResponse{
+		ID:    j.ID,
+		Title: j.Title,
+		Team:  j.Team,
+		Location: Location{
+			Address:    j.Location.Address,
+			City:       j.Location.City,
+			State:      j.Location.State,
+			Country:    j.Location.Country,
+			PostalCode: j.Location.PostalCode,
+		},
+		Skills:          make([]Skill, 0, len(j.Skills)),
+		ExperienceLevel: string(j.ExperienceLevel),
+		HROwners:        j.HROwners,
+		Status:          string(j.Status),
+		CreatedBy:       j.CreatedBy,
+		CreatedAt:       j.CreatedAt,
+		UpdatedAt:       j.UpdatedAt,
+	}
+	for _, s := range j.Skills {
+		resp.Skills = append(resp.Skills, Skill{SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	if resp.HROwners == nil {
+		resp.HROwners = []string{}
+	}
+	return resp
+}
+
+func FromDomainApplication(a domain.Application) Application {
+	return Application{
+		ID:           a.ID,
+		JobOpeningID: a.JobOpeningID,
+		CandidateID:  a.CandidateID,
+		Source:       a.Source,
+		AppliedBy:    a.AppliedBy,
+		AppliedAt:    a.AppliedAt,
+	}
+}
+
+func FromDomainCandidateApplications(candidateID string, applications []domain.Application) CandidateApplicationsResponse {
+	resp := CandidateApplicationsResponse{CandidateID: candidateID, Applications: make([]Application, 0, len(applications))}
+	for _, a := range applications {
+		resp.Applications = append(resp.Applications, FromDomainApplication(a))
+	}
+	return resp
+}
+
+func FromDomainSummary(s *domain.Summary) SummaryResponse {
+	resp := SummaryResponse{
+		JobOpening:   FromDomain(*s.JobOpening),
+		Applications: s.Applications,
+		Stages:       make([]SummaryStage, 0, len(s.Stages)),
+		Assessments:  make(map[string]int, len(s.Assessments)),
+	}
+	for _, st := range s.Stages {
+		resp.Stages = append(resp.Stages, SummaryStage{Key: st.Key, Name: st.Name, Count: st.Count})
+	}
+	for status, count := range s.Assessments {
+		resp.Assessments[string(status)] = count
+	}
+	return resp
+}
@@ -0,0 +1,198 @@
+package jobopening
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/usecases/domain"
+)
+
+// memoryRepository guarda cada búsqueda con sus skills y responsables en una fila, y cada
+// postulación en otra.
+type memoryRepository struct {
+	openings     *mapdb.Table[models.JobOpening]
+	applications *mapdb.Table[models.JobApplication]
+}
+
+// NewMemoryRepository crea el repositorio de búsquedas sobre la base en memoria.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		openings: mapdb.NewTable(db, "job_openings",
+			func(j *models.JobOpening) string { return j.ID },
+		),
+		applications: mapdb.NewTable(db, "job_applications",
+			func(a *models.JobApplication) string { return a.ID },
+			mapdb.Index[models.JobApplication]{
+				Name:   "opening_candidate",
+				Unique: true,
+				Values: func(a *models.JobApplication) []string {
+					return []string{applicationKey(a.JobOpeningID, a.CandidateID)}
+				},
+			},
+			mapdb.Index[models.JobApplication]{
+				Name:   "job_opening_id",
+				Values: func(a *models.JobApplication) []string { return []string{a.JobOpeningID} },
+			},
+			mapdb.Index[models.JobApplication]{
+				Name:   "candidate_id",
+				Values: func(a *models.JobApplication) []string { return []string{a.CandidateID} },
+			},
+		),
+	}
+}
+
+func (r *memoryRepository) CreateOpening(ctx context.Context, opening *domain.JobOpening) (string, error) {
+	if opening == nil {
+		return "", errors.New("job opening is nil")
+	}
+
+	model := models.FromDomain(opening)
+	model.ID = uuid.New().String()
+	model.CreatedAt = time.Now()
+	model.UpdatedAt = model.CreatedAt
+	assignChildIDs(model)
+
+	if err := r.openings.Insert(ctx, model); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) GetOpening(ctx context.Context, id string) (*domain.JobOpening, error) {
+	model, err := r.openings.Get(ctx, id)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("job opening with id %s not found", id), nil)
+		}
+		return nil, err
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *memoryRepository) ListOpenings(ctx context.Context, spec *types.QuerySpec, filter domain.Filter) (*types.Page[domain.JobOpening], error) {
+	var preds []mapdb.Predicate[models.JobOpening]
+	if skill := strings.TrimSpace(filter.Skill); skill != "" {
+		preds = append(preds, func(m *models.JobOpening) bool {
+			return slices.ContainsFunc(m.Skills, func(s models.JobOpeningSkill) bool { return strings.EqualFold(s.SkillName, skill) })
+		})
+	}
+	if owner := strings.TrimSpace(filter.HROwner); owner != "" {
+		preds = append(preds, func(m *models.JobOpening) bool {
+			return slices.ContainsFunc(m.Owners, func(o models.JobOpeningOwner) bool { return o.UserID == owner })
+		})
+	}
+
+	page, err := r.openings.Page(ctx, spec, preds...)
+	if err != nil {
+		return nil, err
+	}
+	return types.MapPage(page, func(m models.JobOpening) domain.JobOpening { return *m.ToDomain() }), nil
+}
+
+func (r *memoryRepository) UpdateOpening(ctx context.Context, opening *domain.JobOpening) error {
+	if opening == nil {
+		return errors.New("job opening is nil")
+	}
+
+	update := models.FromDomain(opening)
+	assignChildIDs(update)
+	err := r.openings.Modify(ctx, opening.ID, func(m *models.JobOpening) error {
+		m.Title = update.Title
+		m.Team = update.Team
+		m.Location = update.Location
+		m.ExperienceLevel = update.ExperienceLevel
+		m.Skills = update.Skills
+		m.Owners = update.Owners
+		m.UpdatedAt = time.Now()
+		return nil
+	})
+	if types.IsNotFound(err) {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("job opening with id %s not found", opening.ID), nil)
+	}
+	return err
+}
+
+func (r *memoryRepository) UpdateStatus(ctx context.Context, id string, from, to domain.Status) error {
+	err := r.openings.Modify(ctx, id, func(m *models.JobOpening) error {
+		if m.Status != string(from) {
+			return types.NewError(types.ErrConflict, fmt.Sprintf("job opening %s is no longer %s", id, from), nil)
+		}
+		m.Status = string(to)
+		m.UpdatedAt = time.Now()
+		return nil
+	})
+	if types.IsNotFound(err) {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("job opening with id %s not found", id), nil)
+	}
+	return err
+}
+
+func (r *memoryRepository) CreateApplication(ctx context.Context, application *domain.Application) (string, error) {
+	if application == nil {
+		return "", errors.New("application is nil")
+	}
+
+	model := models.FromDomainApplication(application)
+	model.ID = uuid.New().String()
+	if err := r.applications.Insert(ctx, model); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) GetApplication(ctx context.Context, jobOpeningID, candidateID string) (*domain.Application, error) {
+	model, err := r.applications.FindOneBy(ctx, "opening_candidate", applicationKey(jobOpeningID, candidateID))
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("candidate %s did not apply to job opening %s", candidateID, jobOpeningID), err)
+		}
+		return nil, err
+	}
+	a := model.ToDomain()
+	return &a, nil
+}
+
+func (r *memoryRepository) ListApplications(ctx context.Context, jobOpeningID string, spec *types.QuerySpec) (*types.Page[domain.Application], error) {
+	page, err := r.applications.Page(ctx, spec, func(m *models.JobApplication) bool { return m.JobOpeningID == jobOpeningID })
+	if err != nil {
+		return nil, err
+	}
+	return types.MapPage(page, models.JobApplication.ToDomain), nil
+}
+
+func (r *memoryRepository) ListApplicationsByCandidate(ctx context.Context, candidateID string) ([]domain.Application, error) {
+	ms, err := r.applications.FindBy(ctx, "candidate_id", candidateID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(ms, func(a, b models.JobApplication) int { return b.AppliedAt.Compare(a.AppliedAt) })
+
+	applications := make([]domain.Application, 0, len(ms))
+	for _, m := range ms {
+		applications = append(applications, m.ToDomain())
+	}
+	return applications, nil
+}
+
+func (r *memoryRepository) CountApplications(ctx context.Context, jobOpeningID string) (int, error) {
+	ms, err := r.applications.FindBy(ctx, "job_opening_id", jobOpeningID)
+	if err != nil {
+		return 0, err
+	}
+	return len(ms), nil
+}
+
+func applicationKey(jobOpeningID, candidateID string) string {
+	return jobOpeningID + "/" + candidateID
+}
@@ -0,0 +1,43 @@
+package jobopening
+
+import (
+	"context"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/usecases/domain"
+	problemdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+// UseCases administra las búsquedas, las postulaciones de los candidatos y las evaluaciones que
+// se toman en el marco de cada búsqueda.
+type UseCases interface {
+	CreateOpening(context.Context, *domain.JobOpening) (string, error)
+	GetOpening(context.Context, string) (*domain.JobOpening, error)
+	ListOpenings(context.Context, *types.QuerySpec, domain.Filter) (*types.Page[domain.JobOpening], error)
+	// UpdateOpening edita los datos de la búsqueda; el estado solo cambia con ChangeStatus
+	UpdateOpening(context.Context, *domain.JobOpening) (*domain.JobOpening, error)
+	ChangeStatus(context.Context, string, domain.Status) (*domain.JobOpening, error)
+	// Apply registra la postulación y agrega al candidato al pipeline de la búsqueda
+	Apply(context.Context, *domain.Application) (*domain.Application, error)
+	ListApplications(context.Context, string, *types.QuerySpec) (*types.Page[domain.Application], error)
+	ListCandidateApplications(context.Context, string) ([]domain.Application, error)
+	// CreateAssessment arma desde el banco una evaluación de la búsqueda para un candidato postulado
+	CreateAssessment(context.Context, *domain.AssessmentRequest) (*problemdomain.Assembly, error)
+	GetSummary(context.Context, string) (*domain.Summary, error)
+}
+
+type Repository interface {
+	CreateOpening(context.Context, *domain.JobOpening) (string, error)
+	GetOpening(context.Context, string) (*domain.JobOpening, error)
+	ListOpenings(context.Context, *types.QuerySpec, domain.Filter) (*types.Page[domain.JobOpening], error)
+	// UpdateOpening guarda los datos propios y reemplaza skills y responsables
+	UpdateOpening(context.Context, *domain.JobOpening) error
+	// UpdateStatus cambia el estado solo si la búsqueda sigue en el estado indicado
+	UpdateStatus(context.Context, string, domain.Status, domain.Status) error
+	CreateApplication(context.Context, *domain.Application) (string, error)
+	GetApplication(context.Context, string, string) (*domain.Application, error)
+	ListApplications(context.Context, string, *types.QuerySpec) (*types.Page[domain.Application], error)
+	ListApplicationsByCandidate(context.Context, string) ([]domain.Application, error)
+	CountApplications(context.Context, string) (int, error)
+}
@@ -0,0 +1,241 @@
+package jobopening
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/usecases/domain"
+)
+
+type repository struct {
+	db gorm.Repository
+}
+
+func NewRepository(db gorm.Repository) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// CreateOpening inserta la búsqueda con sus skills y responsables. Usa la transacción del
+// contexto, por lo que el use case las agrupa con WithinTx.
+func (r *repository) CreateOpening(ctx context.Context, opening *domain.JobOpening) (string, error) {
+	if opening == nil {
+		return "", errors.New("job opening is nil")
+	}
+
+	model := models.FromDomain(opening)
+	model.ID = uuid.New().String()
+	if err := r.db.DB(ctx).Omit(clause.Associations).Create(model).Error; err != nil {
+		return "", fmt.Errorf("failed to create job opening: %w", err)
+	}
+	if err := r.replaceChildren(ctx, model); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *repository) GetOpening(ctx context.Context, id string) (*domain.JobOpening, error) {
+	var model models.JobOpening
+	err := r.db.DB(ctx).Preload("Skills").Preload("Owners").Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("job opening with id %s not found", id), err)
+		}
+		return nil, fmt.Errorf("failed to get job opening: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *repository) ListOpenings(ctx context.Context, spec *types.QuerySpec, filter domain.Filter) (*types.Page[domain.JobOpening], error) {
+	db := r.db.DB(ctx)
+	query := db.Model(&models.JobOpening{})
+	if skill := strings.TrimSpace(filter.Skill); skill != "" {
+		query = query.Where("id IN (?)", db.Model(&models.JobOpeningSkill{}).Select("job_opening_id").Where("LOWER(skill_name) = ?", strings.ToLower(skill)))
+	}
+	if owner := strings.TrimSpace(filter.HROwner); owner != "" {
+		query = query.Where("id IN (?)", db.Model(&models.JobOpeningOwner{}).Select("job_opening_id").Where("user_id = ?", owner))
+	}
+
+	page, err := gorm.Paginate[models.JobOpening](query, spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadChildren(ctx, page.Items); err != nil {
+		return nil, err
+	}
+	return types.MapPage(page, func(m models.JobOpening) domain.JobOpening { return *m.ToDomain() }), nil
+}
+
+func (r *repository) UpdateOpening(ctx context.Context, opening *domain.JobOpening) error {
+	if opening == nil {
+		return errors.New("job opening is nil")
+	}
+
+	model := models.FromDomain(opening)
+	result := r.db.DB(ctx).Model(&models.JobOpening{ID: model.ID}).
+		Select("title", "team", "location_address", "location_city", "location_state", "location_country",
+			"location_postal_code", "experience_level").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update job opening: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("job opening with id %s not found", model.ID), nil)
+	}
+	return r.replaceChildren(ctx, model)
+}
+
+func (r *repository) UpdateStatus(ctx context.Context, id string, from, to domain.Status) error {
+	result := r.db.DB(ctx).Model(&models.JobOpening{}).
+		Where("id = ? AND status = ?", id, string(from)).
+		Update("status", string(to))
+	if result.Error != nil {
+		return fmt.Errorf("failed to update job opening status: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrConflict, fmt.Sprintf("job opening %s is no longer %s", id, from), nil)
+	}
+	return nil
+}
+
+func (r *repository) CreateApplication(ctx context.Context, application *domain.Application) (string, error) {
+	if application == nil {
+		return "", errors.New("application is nil")
+	}
+
+	model := models.FromDomainApplication(application)
+	model.ID = uuid.New().String()
+	if err := r.db.DB(ctx).Create(model).Error; err != nil {
+		return "", fmt.Errorf("failed to create application: %w", err)
+	}
+	return model.ID, nil
+}
+
+func (r *repository) GetApplication(ctx context.Context, jobOpeningID, candidateID string) (*domain.Application, error) {
+	var model models.JobApplication
+	err := r.db.DB(ctx).
+		Where("job_opening_id = ? AND candidate_id = ?", jobOpeningID, candidateID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("candidate %s did not apply to job opening %s", candidateID, jobOpeningID), err)
+		}
+		return nil, fmt.Errorf("failed to get application: %w", err)
+	}
+	a := model.ToDomain()
+	return &a, nil
+}
+
+func (r *repository) ListApplications(ctx context.Context, jobOpeningID string, spec *types.QuerySpec) (*types.Page[domain.Application], error) {
+	query := r.db.DB(ctx).Model(&models.JobApplication{}).Where("job_opening_id = ?", jobOpeningID)
+	page, err := gorm.Paginate[models.JobApplication](query, spec)
+	if err != nil {
+		return nil, err
+	}
+	return types.MapPage(page, models.JobApplication.ToDomain), nil
+}
+
+// ListApplicationsByCandidate devuelve las postulaciones del candidato, de la más reciente a la más antigua.
+func (r *repository) ListApplicationsByCandidate(ctx context.Context, candidateID string) ([]domain.Application, error) {
+	var ms []models.JobApplication
+	err := r.db.DB(ctx).Where("candidate_id = ?", candidateID).Order("applied_at DESC").Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list candidate applications: %w", err)
+	}
+
+	applications := make([]domain.Application, 0, len(ms))
+	for _, m := range ms {
+		applications = append(applications, m.ToDomain())
+	}
+	return applications, nil
+}
+
+func (r *repository) CountApplications(ctx context.Context, jobOpeningID string) (int, error) {
+	var count int64
+	err := r.db.DB(ctx).Model(&models.JobApplication{}).Where("job_opening_id = ?", jobOpeningID).Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count applications: %w", err)
+	}
+	return int(count), nil
+}
+
+// replaceChildren reemplaza las skills y los responsables de la búsqueda.
+func (r *repository) replaceChildren(ctx context.Context, model *models.JobOpening) error {
+	db := r.db.DB(ctx)
+	if err := db.Where("job_opening_id = ?", model.ID).Delete(&models.JobOpeningSkill{}).Error; err != nil {
+		return fmt.Errorf("failed to delete job opening skills: %w", err)
+	}
+	if err := db.Where("job_opening_id = ?", model.ID).Delete(&models.JobOpeningOwner{}).Error; err != nil {
+		return fmt.Errorf("failed to delete job opening owners: %w", err)
+	}
+
+	assignChildIDs(model)
+	if len(model.Skills) > 0 {
+		if err := db.Create(&model.Skills).Error; err != nil {
+			return fmt.Errorf("failed to create job opening skills: %w", err)
+		}
+	}
+	if len(model.Owners) > 0 {
+		if err := db.Create(&model.Owners).Error; err != nil {
+			return fmt.Errorf("failed to create job opening owners: %w", err)
+		}
+	}
+	return nil
+}
+
+// loadChildren completa las skills y los responsables de las búsquedas de una página. No se usa
+// Preload en Paginate porque también arma la query del total.
+func (r *repository) loadChildren(ctx context.Context, ms []models.JobOpening) error {
+	if len(ms) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(ms))
+	for _, m := range ms {
+		ids = append(ids, m.ID)
+	}
+
+	var skills []models.JobOpeningSkill
+	if err := r.db.DB(ctx).Where("job_opening_id IN ?", ids).Order("skill_name").Find(&skills).Error; err != nil {
+		return fmt.Errorf("failed to load job opening skills: %w", err)
+	}
+	var owners []models.JobOpeningOwner
+	if err := r.db.DB(ctx).Where("job_opening_id IN ?", ids).Order("user_id").Find(&owners).Error; err != nil {
+		return fmt.Errorf("failed to load job opening owners: %w", err)
+	}
+
+	for i := range ms {
+		for _, s := range skills {
+			if s.JobOpeningID == ms[i].ID {
+				ms[i].Skills = append(ms[i].Skills, s)
+			}
+		}
+		for _, o := range owners {
+			if o.JobOpeningID == ms[i].ID {
+				ms[i].Owners = append(ms[i].Owners, o)
+			}
+		}
+	}
+	return nil
+}
+
+func assignChildIDs(model *models.JobOpening) {
+	for i := range model.Skills {
+		model.Skills[i].ID = uuid.New().String()
+		model.Skills[i].JobOpeningID = model.ID
+	}
+	for i := range model.Owners {
+		model.Owners[i].ID = uuid.New().String()
+		model.Owners[i].JobOpeningID = model.ID
+	}
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"time"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	candomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/usecases/domain"
+	locmodels "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/location/repository/models"
+)
+
+// JobOpening es una búsqueda. Skills y responsables van en tablas aparte.
+type JobOpening struct {
+	ID              string             `gorm:"primaryKey"`
+	Title           string             `gorm:"type:varchar(200);not null"`
+	Team            string             `gorm:"type:varchar(100);index"`
+	Location        locmodels.Location `gorm:"embedded;embeddedPrefix:location_"`
+	ExperienceLevel string             `gorm:"type:varchar(50)"`                // Ver candidate domain.ExperienceLevel
+	Status          string             `gorm:"type:varchar(20);not null;index"` // Ver domain.Status
+	CreatedBy       string             `gorm:"type:varchar(256)"`
+	Skills          []JobOpeningSkill  `gorm:"foreignKey:JobOpeningID;constraint:OnDelete:CASCADE"`
+	Owners          []JobOpeningOwner  `gorm:"foreignKey:JobOpeningID;constraint:OnDelete:CASCADE"`
+	Applications    []JobApplication   `gorm:"foreignKey:JobOpeningID;constraint:OnDelete:CASCADE"` // No se precargan
+	CreatedAt       time.Time          `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time          `gorm:"autoUpdateTime"`
+}
+
+// JobOpeningSkill es una skill requerida por la búsqueda (misma forma que la SkillConfig del assessment).
+type JobOpeningSkill struct {
+	ID           string `gorm:"primaryKey"`
+	JobOpeningID string `gorm:"index;not null"`
+	SkillName    string `gorm:"type:varchar(100);not null;index"`
+	SkillLevel   string `gorm:"type:varchar(50)"`
+}
+
+// JobOpeningOwner relaciona la búsqueda con un usuario responsable de RR. HH.
+type JobOpeningOwner struct {
+	ID           string `gorm:"primaryKey"`
+	JobOpeningID string `gorm:"not null;uniqueIndex:idx_job_opening_owners_opening_user"`
+	UserID       string `gorm:"type:varchar(256);not null;uniqueIndex:idx_job_opening_owners_opening_user;index"`
+}
+
+// JobApplication es la postulación de un candidato a la búsqueda (relación muchos a muchos).
+type JobApplication struct {
+	ID           string    `gorm:"primaryKey"`
+	JobOpeningID string    `gorm:"not null;uniqueIndex:idx_job_applications_opening_candidate"`
+	CandidateID  string    `gorm:"type:varchar(256);not null;uniqueIndex:idx_job_applications_opening_candidate;index"`
+	Source       string    `gorm:"type:varchar(50)"`
+	AppliedBy    string    `gorm:"type:varchar(256)"`
+	AppliedAt    time.Time `gorm:"not null;index"`
+}
+
+// FromDomain convierte la búsqueda al modelo. Los IDs de las filas hijas los asigna el repositorio.
+func FromDomain(j *domain.JobOpening) *JobOpening {
+	model := &JobOpening{
+		ID:              j.ID,
+		Title:           j.Title,
+		Team:            j.Team,
+		Location:        locmodels.FromDomain(j.Location),
+		ExperienceLevel: string(j.ExperienceLevel),
+		Status:          string(j.Status),
+		CreatedBy:       j.CreatedBy,
+		Skills:          make([]JobOpeningSkill, 0, len(j.Skills)),
+		Owners:          make([]JobOpeningOwner, 0, len(j.HROwners)),
+		CreatedAt:       j.CreatedAt,
+		UpdatedAt:       j.UpdatedAt,
+	}
+	for _, s := range j.Skills {
+		model.Skills = append(model.Skills, JobOpeningSkill{JobOpeningID: j.ID, SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	for _, o := range j.HROwners {
+		model.Owners = append(model.Owners, JobOpeningOwner{JobOpeningID: j.ID, UserID: o})
+	}
+	return model
+}
+
+func (m JobOpening) ToDomain() *domain.JobOpening {
+	j := &domain.JobOpening{
+		ID:              m.ID,
+		Title:           m.Title,
+		Team:            m.Team,
+		Location:        m.Location.ToDomain(),
+		ExperienceLevel: candomain.ExperienceLevel(m.ExperienceLevel),
+		Status:          domain.Status(m.Status),
+		CreatedBy:       m.CreatedBy,
+		Skills:          make([]assdomain.SkillConfig, 0, len(m.Skills)),
+		HROwners:        make([]string, 0, len(m.Owners)),
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+	for _, s := range m.Skills {
+		j.Skills = append(j.Skills, assdomain.SkillConfig{SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	for _, o := range m.Owners {
+		j.HROwners = append(j.HROwners, o.UserID)
+	}
+	return j
+}
+
+func FromDomainApplication(a *domain.Application) *JobApplication {
+	return &JobApplication{
+		ID:           a.ID,
+		JobOpeningID: a.JobOpeningID,
+		CandidateID:  a.CandidateID,
+		Source:       a.Source,
+		AppliedBy:    a.AppliedBy,
+		AppliedAt:    a.AppliedAt,
+	}
+}
+
+func (m JobApplication) ToDomain() domain.Application {
+	return domain.Application{
+		ID:           m.ID,
+		JobOpeningID: m.JobOpeningID,
+		CandidateID:  m.CandidateID,
+		Source:       m.Source,
+		AppliedBy:    m.AppliedBy,
+		AppliedAt:    m.AppliedAt,
+	}
+}
@@ -0,0 +1,335 @@
+package jobopening
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/usecases/domain"
+	pipeline "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline"
+	pipedomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+	problem "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem"
+	problemdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+	user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
+)
+
+// Tipos de recurso con los que se registran los cambios en el log de auditoría
+const (
+	auditResourceOpening     = "job_opening"
+	auditResourceApplication = "job_application"
+)
+
+// summaryPageSize es la cantidad de evaluaciones que se leen por página al armar el resumen.
+const summaryPageSize = 100
+
+// summarySort ordena las evaluaciones del resumen por ID, que alimenta el cursor.
+var summarySort = []types.SortField{
+	{Field: "id", Column: "id", Type: types.FieldString},
+}
+
+type useCases struct {
+	repository   Repository
+	txManager    pkgtx.Manager
+	assessmentUc assessment.UseCases
+	candidateUc  candidate.UseCases
+	problemUc    problem.UseCases
+	pipelineUc   pipeline.UseCases
+	userUc       user.UseCases
+	auditUc      audit.UseCases
+}
+
+func NewUseCases(
+	repo Repository,
+	tx pkgtx.Manager,
+	assessmentUC assessment.UseCases,
+	candidateUC candidate.UseCases,
+	problemUC problem.UseCases,
+	pipelineUC pipeline.UseCases,
+	userUC user.UseCases,
+	ad audit.UseCases,
+) UseCases {
+	return &useCases{
+		repository:   repo,
+		txManager:    tx,
+		assessmentUc: assessmentUC,
+		candidateUc:  candidateUC,
+		problemUc:    problemUC,
+		pipelineUc:   pipelineUC,
+		userUc:       userUC,
+		auditUc:      ad,
+	}
+}
+
+// CreateOpening crea la búsqueda en draft, salvo que se pida publicarla directamente (open).
+func (u *useCases) CreateOpening(ctx context.Context, opening *domain.JobOpening) (string, error) {
+	if opening == nil {
+		return "", types.NewError(types.ErrValidation, "job opening is required", nil)
+	}
+	if opening.Status == "" {
+		opening.Status = domain.StatusDraft
+	}
+	if opening.Status != domain.StatusDraft && opening.Status != domain.StatusOpen {
+		return "", types.NewError(types.ErrValidation, "a job opening must be created as draft or open", nil)
+	}
+	if err := opening.Validate(); err != nil {
+		return "", err
+	}
+	if err := u.checkOwners(ctx, opening.HROwners); err != nil {
+		return "", err
+	}
+
+	opening.CreatedBy = types.PrincipalIDFromContext(ctx)
+
+	// La búsqueda, sus skills y sus responsables se guardan en una única transacción
+	var openingID string
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		openingID, err = u.repository.CreateOpening(ctx, opening)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create job opening: %w", err)
+	}
+
+	opening.ID = openingID
+	u.auditUc.RecordChange(ctx, auditdom.ActionCreate, auditResourceOpening, openingID, nil, opening)
+	return openingID, nil
+}
+
+func (u *useCases) GetOpening(ctx context.Context, id string) (*domain.JobOpening, error) {
+	return u.repository.GetOpening(ctx, id)
+}
+
+func (u *useCases) ListOpenings(ctx context.Context, spec *types.QuerySpec, filter domain.Filter) (*types.Page[domain.JobOpening], error) {
+	return u.repository.ListOpenings(ctx, spec, filter)
+}
+
+// UpdateOpening reemplaza los datos de la búsqueda. Las evaluaciones ya creadas conservan las
+// skills que se les copiaron.
+func (u *useCases) UpdateOpening(ctx context.Context, update *domain.JobOpening) (*domain.JobOpening, error) {
+	if update == nil {
+		return nil, types.NewError(types.ErrValidation, "job opening is required", nil)
+	}
+	if err := update.Validate(); err != nil {
+		return nil, err
+	}
+	if err := u.checkOwners(ctx, update.HROwners); err != nil {
+		return nil, err
+	}
+
+	var before *domain.JobOpening
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		before, err = u.repository.GetOpening(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		update.Status = before.Status
+		update.CreatedBy = before.CreatedBy
+		update.CreatedAt = before.CreatedAt
+		return u.repository.UpdateOpening(ctx, update)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourceOpening, update.ID, before, update)
+	return u.repository.GetOpening(ctx, update.ID)
+}
+
+func (u *useCases) ChangeStatus(ctx context.Context, id string, to domain.Status) (*domain.JobOpening, error) {
+	if !to.IsValid() {
+		return nil, types.NewError(types.ErrValidation, fmt.Sprintf("invalid job opening status %q", to), nil)
+	}
+
+	opening, err := u.repository.GetOpening(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	from := opening.Status
+	if !domain.CanTransition(from, to) {
+		return nil, types.NewError(types.ErrConflict, fmt.Sprintf("cannot change job opening status from %s to %s", from, to), nil)
+	}
+	if err := u.repository.UpdateStatus(ctx, id, from, to); err != nil {
+		return nil, err
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourceOpening, id,
+		map[string]any{"status": from},
+		map[string]any{"status": to},
+	)
+	opening.Status = to
+	return opening, nil
+}
+
+// Apply registra la postulación del candidato y lo agrega a la etapa inicial del pipeline de la
+// búsqueda en la misma transacción. Si RR. HH. ya lo había agregado al tablero se conserva su etapa.
+func (u *useCases) Apply(ctx context.Context, application *domain.Application) (*domain.Application, error) {
+	if application == nil || strings.TrimSpace(application.CandidateID) == "" {
+		return nil, types.NewError(types.ErrValidation, "candidate_id is required", nil)
+	}
+
+	opening, err := u.repository.GetOpening(ctx, application.JobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+	if opening.Status != domain.StatusOpen {
+		return nil, types.NewError(types.ErrConflict, fmt.Sprintf("job opening is %s and does not accept applications", opening.Status), nil)
+	}
+	if _, err := u.candidateUc.GetCandidate(ctx, application.CandidateID); err != nil {
+		return nil, err
+	}
+
+	_, err = u.repository.GetApplication(ctx, application.JobOpeningID, application.CandidateID)
+	if err == nil {
+		return nil, types.NewError(types.ErrConflict, "candidate already applied to this job opening", nil)
+	}
+	if !types.IsNotFound(err) {
+		return nil, err
+	}
+
+	application.Source = strings.ToLower(strings.TrimSpace(application.Source))
+	application.AppliedBy = types.PrincipalIDFromContext(ctx)
+	application.AppliedAt = time.Now()
+
+	reason := "applied"
+	if application.Source != "" {
+		reason += " via " + application.Source
+	}
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		id, err := u.repository.CreateApplication(ctx, application)
+		if err != nil {
+			return err
+		}
+		application.ID = id
+
+		_, err = u.pipelineUc.AddCandidate(ctx, &pipedomain.Move{
+			JobOpeningID: application.JobOpeningID,
+			CandidateID:  application.CandidateID,
+			Reason:       reason,
+		})
+		if err != nil && !types.IsConflict(err) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply to job opening: %w", err)
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionCreate, auditResourceApplication, application.ID, nil, application)
+	return application, nil
+}
+
+func (u *useCases) ListApplications(ctx context.Context, jobOpeningID string, spec *types.QuerySpec) (*types.Page[domain.Application], error) {
+	if _, err := u.repository.GetOpening(ctx, jobOpeningID); err != nil {
+		return nil, err
+	}
+	return u.repository.ListApplications(ctx, jobOpeningID, spec)
+}
+
+func (u *useCases) ListCandidateApplications(ctx context.Context, candidateID string) ([]domain.Application, error) {
+	if _, err := u.candidateUc.GetCandidate(ctx, candidateID); err != nil {
+		return nil, err
+	}
+	return u.repository.ListApplicationsByCandidate(ctx, candidateID)
+}
+
+// CreateAssessment arma la evaluación con las skills requeridas de la búsqueda y la deja asociada
+// a ella, así el pipeline y los reportes la cuentan en esta búsqueda.
+func (u *useCases) CreateAssessment(ctx context.Context, req *domain.AssessmentRequest) (*problemdomain.Assembly, error) {
+	if req == nil || strings.TrimSpace(req.CandidateID) == "" {
+		return nil, types.NewError(types.ErrValidation, "candidate_id is required", nil)
+	}
+
+	opening, err := u.repository.GetOpening(ctx, req.JobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+	if opening.Status != domain.StatusOpen {
+		return nil, types.NewError(types.ErrConflict, fmt.Sprintf("cannot create assessments for a %s job opening", opening.Status), nil)
+	}
+	if _, err := u.repository.GetApplication(ctx, req.JobOpeningID, req.CandidateID); err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrValidation, "candidate has not applied to this job opening", err)
+		}
+		return nil, err
+	}
+
+	return u.problemUc.AssembleAssessment(ctx, &problemdomain.AssemblyRequest{
+		CandidateID:  req.CandidateID,
+		HRID:         req.HRID,
+		JobOpeningID: opening.ID,
+		Skills:       slices.Clone(opening.Skills),
+		Tags:         req.Tags,
+		MaxDuration:  req.MaxDuration,
+	})
+}
+
+// GetSummary junta las postulaciones, el tablero del pipeline y las evaluaciones de la búsqueda
+// agrupadas por estado. Las evaluaciones borradas no se cuentan.
+func (u *useCases) GetSummary(ctx context.Context, id string) (*domain.Summary, error) {
+	opening, err := u.repository.GetOpening(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	applications, err := u.repository.CountApplications(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	board, err := u.pipelineUc.GetBoard(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &domain.Summary{
+		JobOpening:   opening,
+		Applications: applications,
+		Stages:       board,
+		Assessments:  make(map[assdomain.AssessmentStatus]int),
+	}
+
+	spec := &types.QuerySpec{Limit: summaryPageSize, Sort: summarySort}
+	spec.AddFilter("job_opening_id", "job_opening_id", types.OpEq, id)
+	for {
+		page, err := u.assessmentUc.ListAssessments(ctx, spec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list assessments: %w", err)
+		}
+		for _, a := range page.Items {
+			summary.Assessments[a.Status]++
+		}
+
+		if !page.Info.HasMore || len(page.Items) == 0 {
+			return summary, nil
+		}
+		if page.Info.NextCursor != "" {
+			spec.Cursor = page.Info.NextCursor
+		} else {
+			spec.Offset += len(page.Items)
+		}
+	}
+}
+
+// checkOwners verifica que los responsables de RR. HH. sean usuarios existentes.
+func (u *useCases) checkOwners(ctx context.Context, owners []string) error {
+	for _, id := range owners {
+		if _, err := u.userUc.GetUser(ctx, id); err != nil {
+			if types.IsNotFound(err) {
+				return types.NewError(types.ErrValidation, fmt.Sprintf("hr owner %s is not a user", id), err)
+			}
+			return fmt.Errorf("failed to get hr owner %s: %w", id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	candomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+	locdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/location/usecases/domain"
+	pipedomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+)
+
+// Status es el estado de una búsqueda.
+type Status string
+
+// Constantes de Status.
+const (
+	StatusDraft  Status = "draft"   // En preparación, todavía no recibe postulaciones
+	StatusOpen   Status = "open"    // Publicada: recibe postulaciones y se toman evaluaciones
+	StatusOnHold Status = "on_hold" // Pausada: conserva los candidatos pero no suma nuevos
+	StatusClosed Status = "closed"  // Cerrada (cubierta o cancelada); se puede reabrir
+)
+
+// transitions es la máquina de estados: para cada estado, a cuáles se puede pasar.
+var transitions = map[Status][]Status{
+	StatusDraft:  {StatusOpen, StatusClosed},
+	StatusOpen:   {StatusOnHold, StatusClosed},
+	StatusOnHold: {StatusOpen, StatusClosed},
+	StatusClosed: {StatusOpen},
+}
+
+// IsValid indica si el estado es uno de los definidos.
+func (s Status) IsValid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// CanTransition indica si la máquina de estados permite pasar de from a to.
+func CanTransition(from, to Status) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// experienceLevels son los niveles de experiencia del candidato que puede pedir una búsqueda.
+var experienceLevels = []candomain.ExperienceLevel{
+	candomain.Trainee, candomain.Junior, candomain.Mid, candomain.SemiSenior, candomain.Senior,
+}
+
+const (
+	maxTitle  = 200
+	maxTeam   = 100
+	maxOwners = 20
+	maxSkills = 30
+)
+
+// JobOpening es una búsqueda (requisición) de RR. HH.: el puesto, las skills y la experiencia
+// que pide, quiénes la llevan y los candidatos que se postularon.
+type JobOpening struct {
+	ID              string
+	Title           string
+	Team            string
+	Location        locdomain.Location
+	Skills          []assdomain.SkillConfig   // Skills requeridas; se copian a las evaluaciones de la búsqueda
+	ExperienceLevel candomain.ExperienceLevel // Nivel de experiencia buscado
+	HROwners        []string                  // IDs de usuario de los responsables de RR. HH.
+	Status          Status
+	CreatedBy       string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Validate controla los datos obligatorios y normaliza skills y responsables.
+func (j *JobOpening) Validate() error {
+	j.Title = strings.TrimSpace(j.Title)
+	j.Team = strings.TrimSpace(j.Team)
+	if j.Title == "" || len(j.Title) > maxTitle {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("title is required and must have at most %d characters", maxTitle), nil)
+	}
+	if len(j.Team) > maxTeam {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("team must have at most %d characters", maxTeam), nil)
+	}
+	if j.ExperienceLevel != "" && !slices.Contains(experienceLevels, j.ExperienceLevel) {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("invalid experience level %q", j.ExperienceLevel), nil)
+	}
+
+	skills := make([]assdomain.SkillConfig, 0, len(j.Skills))
+	for _, s := range j.Skills {
+		s.SkillName = strings.TrimSpace(s.SkillName)
+		s.SkillLevel = strings.TrimSpace(s.SkillLevel)
+		if s.SkillName == "" {
+			return types.NewError(types.ErrValidation, "skill name is required", nil)
+		}
+		if slices.ContainsFunc(skills, func(o assdomain.SkillConfig) bool { return strings.EqualFold(o.SkillName, s.SkillName) }) {
+			return types.NewError(types.ErrValidation, fmt.Sprintf("skill %s is repeated", s.SkillName), nil)
+		}
+		skills = append(skills, assdomain.SkillConfig{SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	if len(skills) == 0 || len(skills) > maxSkills {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("a job opening must require between 1 and %d skills", maxSkills), nil)
+	}
+	j.Skills = skills
+
+	owners := make([]string, 0, len(j.HROwners))
+	for _, o := range j.HROwners {
+		if o = strings.TrimSpace(o); o != "" && !slices.Contains(owners, o) {
+			owners = append(owners, o)
+		}
+	}
+	if len(owners) == 0 || len(owners) > maxOwners {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("a job opening must have between 1 and %d hr owners", maxOwners), nil)
+	}
+	j.HROwners = owners
+	return nil
+}
+
+// Filter agrega al listado los filtros por skill y por responsable, que no son columnas de la búsqueda.
+type Filter struct {
+	Skill   string
+	HROwner string
+}
+
+// Application es la postulación de un candidato a una búsqueda. Un candidato puede postularse a
+// varias búsquedas, pero una sola vez a cada una.
+type Application struct {
+	ID           string
+	JobOpeningID string
+	CandidateID  string
+	Source       string // Origen de la postulación (p. ej., referral, linkedin); opcional
+	AppliedBy    string // Principal que la registró
+	AppliedAt    time.Time
+}
+
+// AssessmentRequest pide armar desde el banco una evaluación de la búsqueda para un candidato
+// postulado, con las skills requeridas de la búsqueda.
+type AssessmentRequest struct {
+	JobOpeningID string
+	CandidateID  string
+	HRID         string
+	Tags         []string // Opcional: el problema debe tener todos estos tags
+	MaxDuration  time.Duration
+}
+
+// Summary agrega los datos de la búsqueda para reportes: postulaciones, candidatos por etapa del
+// pipeline y evaluaciones por estado.
+type Summary struct {
+	JobOpening   *JobOpening
+	Applications int
+	Stages       []pipedomain.StageCount
+	Assessments  map[assdomain.AssessmentStatus]int
+}
@@ -0,0 +1,492 @@
+package jobopening
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	mock_assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/mocks"
+	mock_audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/mocks"
+	mock_candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/mocks"
+	mock_pipeline "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/mocks"
+	mock_problem "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/mocks"
+	mock_user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/mocks"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	candomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening/usecases/domain"
+	pipedomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+	problemdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+	userdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+// fields usa el repositorio y las transacciones en memoria reales y mockea los casos de uso de
+// los que dependen las búsquedas.
+type fields struct {
+	repository Repository
+	db         mapdb.Repository
+	assessment *mock_assessment.MockUseCases
+	candidate  *mock_candidate.MockUseCases
+	problem    *mock_problem.MockUseCases
+	pipeline   *mock_pipeline.MockUseCases
+	user       *mock_user.MockUseCases
+	audit      *mock_audit.MockUseCases
+}
+
+func newFields(ctrl *gomock.Controller) *fields {
+	db := mapdb.Bootstrap()
+	f := &fields{
+		repository: NewMemoryRepository(db),
+		db:         db,
+		assessment: mock_assessment.NewMockUseCases(ctrl),
+		candidate:  mock_candidate.NewMockUseCases(ctrl),
+		problem:    mock_problem.NewMockUseCases(ctrl),
+		pipeline:   mock_pipeline.NewMockUseCases(ctrl),
+		user:       mock_user.NewMockUseCases(ctrl),
+		audit:      mock_audit.NewMockUseCases(ctrl),
+	}
+	f.audit.EXPECT().RecordChange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return f
+}
+
+func (f *fields) useCases() UseCases {
+	return NewUseCases(f.repository, mapdb.NewTxManager(f.db), f.assessment, f.candidate, f.problem, f.pipeline, f.user, f.audit)
+}
+
+// seed guarda una búsqueda en el estado indicado.
+func (f *fields) seed(t *testing.T, status domain.Status) string {
+	opening := newOpening()
+	assert.NoError(t, opening.Validate())
+	opening.Status = status
+	opening.CreatedBy = "hr1"
+	id, err := f.repository.CreateOpening(context.Background(), opening)
+	assert.NoError(t, err)
+	return id
+}
+
+// apply registra la postulación del candidato sin pasar por el caso de uso.
+func (f *fields) apply(t *testing.T, jobOpeningID, candidateID string) {
+	_, err := f.repository.CreateApplication(context.Background(), &domain.Application{
+		JobOpeningID: jobOpeningID,
+		CandidateID:  candidateID,
+		AppliedAt:    time.Now(),
+	})
+	assert.NoError(t, err)
+}
+
+func (f *fields) expectOwners(ids ...string) {
+	for _, id := range ids {
+		f.user.EXPECT().GetUser(gomock.Any(), id).Return(&userdomain.User{ID: id}, nil)
+	}
+}
+
+func newOpening() *domain.JobOpening {
+	return &domain.JobOpening{
+		Title:           " Backend developer ",
+		Team:            "Platform",
+		ExperienceLevel: candomain.Senior,
+		Skills: []assdomain.SkillConfig{
+			{SkillName: " Go ", SkillLevel: "advanced"},
+			{SkillName: "SQL"},
+		},
+		HROwners: []string{"hr1", " hr2 ", "hr1"},
+	}
+}
+
+func TestCreateOpening(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name       string
+		opening    func() *domain.JobOpening
+		setup      func(f *fields)
+		wantErr    func(error) bool
+		wantStatus domain.Status
+	}{
+		{
+			name:       "Success: created as draft with normalized skills and owners",
+			opening:    newOpening,
+			setup:      func(f *fields) { f.expectOwners("hr1", "hr2") },
+			wantStatus: domain.StatusDraft,
+		},
+		{
+			name: "Success: published on creation",
+			opening: func() *domain.JobOpening {
+				o := newOpening()
+				o.Status = domain.StatusOpen
+				return o
+			},
+			setup:      func(f *fields) { f.expectOwners("hr1", "hr2") },
+			wantStatus: domain.StatusOpen,
+		},
+		{
+			name: "Error: created as closed",
+			opening: func() *domain.JobOpening {
+				o := newOpening()
+				o.Status = domain.StatusClosed
+				return o
+			},
+			setup:   func(f *fields) {},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name: "Error: repeated skill",
+			opening: func() *domain.JobOpening {
+				o := newOpening()
+				o.Skills = append(o.Skills, assdomain.SkillConfig{SkillName: "go"})
+				return o
+			},
+			setup:   func(f *fields) {},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name:    "Error: hr owner is not a user",
+			opening: newOpening,
+			setup: func(f *fields) {
+				f.expectOwners("hr1")
+				f.user.EXPECT().
+					GetUser(gomock.Any(), "hr2").
+					Return(nil, types.NewError(types.ErrNotFound, "user not found", nil))
+			},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name:    "Error: hr owner cannot be read",
+			opening: newOpening,
+			setup: func(f *fields) {
+				f.user.EXPECT().GetUser(gomock.Any(), "hr1").Return(nil, errors.New("db down"))
+			},
+			wantErr: func(err error) bool { return err != nil && !types.IsValidationError(err) },
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+			ctx := types.WithPrincipal(context.Background(), &types.Principal{ID: "hr1"})
+
+			id, err := f.useCases().CreateOpening(ctx, tc.opening())
+
+			if tc.wantErr != nil {
+				assert.True(t, tc.wantErr(err), "unexpected error %v", err)
+				assert.Empty(t, id)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+
+			stored, err := f.useCases().GetOpening(ctx, id)
+			assert.NoError(t, err)
+			assert.Equal(t, "Backend developer", stored.Title)
+			assert.Equal(t, tc.wantStatus, stored.Status, "status mismatch")
+			assert.Equal(t, "hr1", stored.CreatedBy)
+			assert.Equal(t, []string{"hr1", "hr2"}, stored.HROwners, "owners mismatch")
+			assert.Equal(t, []assdomain.SkillConfig{
+				{SkillName: "Go", SkillLevel: "advanced"},
+				{SkillName: "SQL"},
+			}, stored.Skills, "skills mismatch")
+		})
+	}
+}
+
+func TestUpdateOpening(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newFields(ctrl)
+	id := f.seed(t, domain.StatusOpen)
+	f.expectOwners("hr3")
+
+	update := newOpening()
+	update.ID = id
+	update.Title = "Staff engineer"
+	update.Status = domain.StatusClosed
+	update.HROwners = []string{"hr3"}
+	update.Skills = []assdomain.SkillConfig{{SkillName: "Kubernetes"}}
+
+	ctx := types.WithPrincipal(context.Background(), &types.Principal{ID: "hr3"})
+	updated, err := f.useCases().UpdateOpening(ctx, update)
+	assert.NoError(t, err, "expected no error but got one")
+	assert.Equal(t, "Staff engineer", updated.Title)
+	assert.Equal(t, domain.StatusOpen, updated.Status, "status only changes with ChangeStatus")
+	assert.Equal(t, "hr1", updated.CreatedBy, "creator must be kept")
+	assert.Equal(t, []string{"hr3"}, updated.HROwners)
+	assert.Equal(t, []assdomain.SkillConfig{{SkillName: "Kubernetes"}}, updated.Skills)
+
+	update.ID = "missing"
+	f.expectOwners("hr3")
+	_, err = f.useCases().UpdateOpening(ctx, update)
+	assert.True(t, types.IsNotFound(err), "unexpected error %v", err)
+}
+
+func TestChangeStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		from    domain.Status
+		to      domain.Status
+		wantErr func(error) bool
+	}{
+		{name: "Success: draft is published", from: domain.StatusDraft, to: domain.StatusOpen},
+		{name: "Success: open is put on hold", from: domain.StatusOpen, to: domain.StatusOnHold},
+		{name: "Success: on hold is reopened", from: domain.StatusOnHold, to: domain.StatusOpen},
+		{name: "Success: closed is reopened", from: domain.StatusClosed, to: domain.StatusOpen},
+		{name: "Error: draft cannot be put on hold", from: domain.StatusDraft, to: domain.StatusOnHold, wantErr: types.IsConflict},
+		{name: "Error: closed cannot go back to draft", from: domain.StatusClosed, to: domain.StatusDraft, wantErr: types.IsConflict},
+		{name: "Error: same status", from: domain.StatusOpen, to: domain.StatusOpen, wantErr: types.IsConflict},
+		{name: "Error: unknown status", from: domain.StatusOpen, to: "archived", wantErr: types.IsValidationError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFields(ctrl)
+			id := f.seed(t, tc.from)
+
+			opening, err := f.useCases().ChangeStatus(ctx, id, tc.to)
+
+			stored, getErr := f.repository.GetOpening(ctx, id)
+			assert.NoError(t, getErr)
+			if tc.wantErr != nil {
+				assert.True(t, tc.wantErr(err), "unexpected error %v", err)
+				assert.Equal(t, tc.from, stored.Status, "rejected transition must not change the status")
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.to, opening.Status)
+			assert.Equal(t, tc.to, stored.Status, "status mismatch")
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		status      domain.Status
+		application *domain.Application
+		setup       func(t *testing.T, f *fields, openingID string)
+		wantErr     func(error) bool
+		wantSaved   bool
+	}{
+		{
+			name:        "Success: application adds the candidate to the pipeline",
+			status:      domain.StatusOpen,
+			application: &domain.Application{CandidateID: "cand1", Source: " Referral "},
+			setup: func(t *testing.T, f *fields, openingID string) {
+				f.candidate.EXPECT().GetCandidate(gomock.Any(), "cand1").Return(&candomain.Candidate{ID: "cand1"}, nil)
+				f.pipeline.EXPECT().
+					AddCandidate(gomock.Any(), &pipedomain.Move{JobOpeningID: openingID, CandidateID: "cand1", Reason: "applied via referral"}).
+					Return(&pipedomain.Entry{}, nil)
+			},
+			wantSaved: true,
+		},
+		{
+			name:        "Success: candidate already on the board keeps the stage",
+			status:      domain.StatusOpen,
+			application: &domain.Application{CandidateID: "cand1"},
+			setup: func(t *testing.T, f *fields, openingID string) {
+				f.candidate.EXPECT().GetCandidate(gomock.Any(), "cand1").Return(&candomain.Candidate{ID: "cand1"}, nil)
+				f.pipeline.EXPECT().
+					AddCandidate(gomock.Any(), &pipedomain.Move{JobOpeningID: openingID, CandidateID: "cand1", Reason: "applied"}).
+					Return(nil, types.NewError(types.ErrConflict, "candidate is already in the pipeline of this job opening", nil))
+			},
+			wantSaved: true,
+		},
+		{
+			name:        "Error: pipeline failure rolls back the application",
+			status:      domain.StatusOpen,
+			application: &domain.Application{CandidateID: "cand1"},
+			setup: func(t *testing.T, f *fields, openingID string) {
+				f.candidate.EXPECT().GetCandidate(gomock.Any(), "cand1").Return(&candomain.Candidate{ID: "cand1"}, nil)
+				f.pipeline.EXPECT().AddCandidate(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+			},
+			wantErr: func(err error) bool { return err != nil },
+		},
+		{
+			name:        "Error: candidate already applied",
+			status:      domain.StatusOpen,
+			application: &domain.Application{CandidateID: "cand1"},
+			setup: func(t *testing.T, f *fields, openingID string) {
+				f.apply(t, openingID, "cand1")
+				f.candidate.EXPECT().GetCandidate(gomock.Any(), "cand1").Return(&candomain.Candidate{ID: "cand1"}, nil)
+			},
+			wantErr:   types.IsConflict,
+			wantSaved: true,
+		},
+		{
+			name:        "Error: unknown candidate",
+			status:      domain.StatusOpen,
+			application: &domain.Application{CandidateID: "cand1"},
+			setup: func(t *testing.T, f *fields, openingID string) {
+				f.candidate.EXPECT().
+					GetCandidate(gomock.Any(), "cand1").
+					Return(nil, types.NewError(types.ErrNotFound, "candidate not found", nil))
+			},
+			wantErr: types.IsNotFound,
+		},
+		{
+			name:        "Error: opening on hold does not accept applications",
+			status:      domain.StatusOnHold,
+			application: &domain.Application{CandidateID: "cand1"},
+			setup:       func(t *testing.T, f *fields, openingID string) {},
+			wantErr:     types.IsConflict,
+		},
+		{
+			name:        "Error: candidate is required",
+			status:      domain.StatusOpen,
+			application: &domain.Application{CandidateID: " "},
+			setup:       func(t *testing.T, f *fields, openingID string) {},
+			wantErr:     types.IsValidationError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			openingID := f.seed(t, tc.status)
+			tc.setup(t, f, openingID)
+			ctx := types.WithPrincipal(context.Background(), &types.Principal{ID: "hr1"})
+			tc.application.JobOpeningID = openingID
+
+			application, err := f.useCases().Apply(ctx, tc.application)
+
+			_, getErr := f.repository.GetApplication(ctx, openingID, "cand1")
+			assert.Equal(t, tc.wantSaved, getErr == nil, "stored application mismatch")
+			if tc.wantErr != nil {
+				assert.True(t, tc.wantErr(err), "unexpected error %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.NotEmpty(t, application.ID)
+			assert.Equal(t, "hr1", application.AppliedBy)
+		})
+	}
+}
+
+func TestCreateAssessment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		status  domain.Status
+		applied bool
+		setup   func(f *fields, openingID string)
+		wantErr func(error) bool
+	}{
+		{
+			name:    "Success: assessment assembled with the opening skills",
+			status:  domain.StatusOpen,
+			applied: true,
+			setup: func(f *fields, openingID string) {
+				f.problem.EXPECT().
+					AssembleAssessment(gomock.Any(), &problemdomain.AssemblyRequest{
+						CandidateID:  "cand1",
+						HRID:         "hr1",
+						JobOpeningID: openingID,
+						Skills: []assdomain.SkillConfig{
+							{SkillName: "Go", SkillLevel: "advanced"},
+							{SkillName: "SQL"},
+						},
+						Tags:        []string{"api"},
+						MaxDuration: time.Hour,
+					}).
+					Return(&problemdomain.Assembly{AssessmentID: "ass1", ProblemID: "p1"}, nil)
+			},
+		},
+		{
+			name:    "Error: candidate has not applied",
+			status:  domain.StatusOpen,
+			setup:   func(f *fields, openingID string) {},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name:    "Error: closed opening",
+			status:  domain.StatusClosed,
+			applied: true,
+			setup:   func(f *fields, openingID string) {},
+			wantErr: types.IsConflict,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			openingID := f.seed(t, tc.status)
+			if tc.applied {
+				f.apply(t, openingID, "cand1")
+			}
+			tc.setup(f, openingID)
+
+			assembly, err := f.useCases().CreateAssessment(context.Background(), &domain.AssessmentRequest{
+				JobOpeningID: openingID,
+				CandidateID:  "cand1",
+				HRID:         "hr1",
+				Tags:         []string{"api"},
+				MaxDuration:  time.Hour,
+			})
+
+			if tc.wantErr != nil {
+				assert.True(t, tc.wantErr(err), "unexpected error %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, "ass1", assembly.AssessmentID)
+		})
+	}
+}
+
+func TestGetSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	f := newFields(ctrl)
+	openingID := f.seed(t, domain.StatusOpen)
+	f.apply(t, openingID, "cand1")
+	f.apply(t, openingID, "cand2")
+
+	board := []pipedomain.StageCount{{Stage: pipedomain.Stage{Key: pipedomain.StageApplied}, Count: 2}}
+	f.pipeline.EXPECT().GetBoard(gomock.Any(), openingID).Return(board, nil)
+	f.assessment.EXPECT().
+		ListAssessments(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, spec *types.QuerySpec) (*types.Page[assdomain.Assessment], error) {
+			assert.Equal(t, []types.Filter{
+				{Field: "job_opening_id", Column: "job_opening_id", Op: types.OpEq, Values: []any{openingID}},
+			}, spec.Filters, "filters mismatch")
+			assert.Empty(t, spec.Cursor, "first page has no cursor")
+			return &types.Page[assdomain.Assessment]{
+				Items: []assdomain.Assessment{{Status: "graded"}, {Status: "pending"}},
+				Info:  types.PageInfo{HasMore: true, NextCursor: "next"},
+			}, nil
+		})
+	f.assessment.EXPECT().
+		ListAssessments(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, spec *types.QuerySpec) (*types.Page[assdomain.Assessment], error) {
+			assert.Equal(t, "next", spec.Cursor, "second page should use the cursor")
+			return &types.Page[assdomain.Assessment]{Items: []assdomain.Assessment{{Status: "graded"}}}, nil
+		})
+
+	summary, err := f.useCases().GetSummary(ctx, openingID)
+	assert.NoError(t, err, "expected no error but got one")
+	assert.Equal(t, openingID, summary.JobOpening.ID)
+	assert.Equal(t, 2, summary.Applications)
+	assert.Equal(t, board, summary.Stages)
+	assert.Equal(t, map[assdomain.AssessmentStatus]int{"graded": 2, "pending": 1}, summary.Assessments, "assessments by status mismatch")
+
+	_, err = f.useCases().GetSummary(ctx, "missing")
+	assert.True(t, types.IsNotFound(err), "unexpected error %v", err)
+}
@@ -1,9 +1,34 @@
 package entities
 
+import (
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/location/usecases/domain"
+)
+
+// Location se guarda embebida en la entidad que la usa (en GORM con un prefijo de columna).
 type Location struct {
-	Address    string `bson:"address"`
-	City       string `bson:"city"`
-	State      string `bson:"state"`
-	Country    string `bson:"country"`
-	PostalCode string `bson:"postal_code"`
+	Address    string `bson:"address" gorm:"type:varchar(200)"`
+	City       string `bson:"city" gorm:"type:varchar(100)"`
+	State      string `bson:"state" gorm:"type:varchar(100)"`
+	Country    string `bson:"country" gorm:"type:varchar(100)"`
+	PostalCode string `bson:"postal_code" gorm:"type:varchar(20)"`
+}
+
+func FromDomain(l domain.Location) Location {
+	return Location{
+		Address:    l.Address,
+		City:       l.City,
+		State:      l.State,
+		Country:    l.Country,
+		PostalCode: l.PostalCode,
+	}
+}
+
+func (m Location) ToDomain() domain.Location {
+	return domain.Location{
+		Address:    m.Address,
+		City:       m.City,
+		State:      m.State,
+		Country:    m.Country,
+		PostalCode: m.PostalCode,
+	}
 }
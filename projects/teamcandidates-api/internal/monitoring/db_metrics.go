@@ -0,0 +1,71 @@
+package monitoring
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	pkgreplicas "github.com/teamcubation/teamcandidates/pkg/databases/sql/replicas"
+)
+
+// StatsSource devuelve el estado de los nodos (primaria y réplicas) de una base de datos.
+type StatsSource func() []pkgreplicas.NodeStats
+
+// DBPoolCollector expone como métricas de Prometheus el pool de conexiones, la salud,
+// el lag de replicación y las lecturas ruteadas de cada nodo de cada base de datos.
+type DBPoolCollector struct {
+	sources map[string]StatsSource
+
+	maxConns     *prometheus.Desc
+	conns        *prometheus.Desc
+	waitCount    *prometheus.Desc
+	waitDuration *prometheus.Desc
+	healthy      *prometheus.Desc
+	lag          *prometheus.Desc
+	reads        *prometheus.Desc
+}
+
+// NewDBPoolCollector crea el collector; sources se indexa por el nombre de la base (label "db").
+func NewDBPoolCollector(sources map[string]StatsSource) *DBPoolCollector {
+	labels := []string{"db", "node", "role"}
+	return &DBPoolCollector{
+		sources:      sources,
+		maxConns:     prometheus.NewDesc("db_pool_max_connections", "Maximum number of open connections.", labels, nil),
+		conns:        prometheus.NewDesc("db_pool_connections", "Open connections by state.", append(labels, "state"), nil),
+		waitCount:    prometheus.NewDesc("db_pool_wait_total", "Connection acquisitions that had to wait.", labels, nil),
+		waitDuration: prometheus.NewDesc("db_pool_wait_seconds_total", "Time spent waiting for (or acquiring) connections.", labels, nil),
+		healthy:      prometheus.NewDesc("db_node_healthy", "Whether the node is in the read rotation (1) or evicted (0).", labels, nil),
+		lag:          prometheus.NewDesc("db_replica_lag_seconds", "Replication lag measured by the last health check.", labels, nil),
+		reads:        prometheus.NewDesc("db_node_reads_total", "Reads routed to the node.", labels, nil),
+	}
+}
+
+func (c *DBPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxConns
+	ch <- c.conns
+	ch <- c.waitCount
+	ch <- c.waitDuration
+	ch <- c.healthy
+	ch <- c.lag
+	ch <- c.reads
+}
+
+func (c *DBPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	for db, source := range c.sources {
+		for _, s := range source() {
+			labels := []string{db, s.Name, s.Role}
+
+			healthy := 0.0
+			if s.Healthy {
+				healthy = 1
+			}
+
+			ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(s.Pool.MaxConns), labels...)
+			ch <- prometheus.MustNewConstMetric(c.conns, prometheus.GaugeValue, float64(s.Pool.InUse), append(labels, "in_use")...)
+			ch <- prometheus.MustNewConstMetric(c.conns, prometheus.GaugeValue, float64(s.Pool.Idle), append(labels, "idle")...)
+			ch <- prometheus.MustNewConstMetric(c.waitCount, prometheus.CounterValue, float64(s.Pool.WaitCount), labels...)
+			ch <- prometheus.MustNewConstMetric(c.waitDuration, prometheus.CounterValue, s.Pool.WaitDuration.Seconds(), labels...)
+			ch <- prometheus.MustNewConstMetric(c.healthy, prometheus.GaugeValue, healthy, labels...)
+			ch <- prometheus.MustNewConstMetric(c.lag, prometheus.GaugeValue, s.Lag.Seconds(), labels...)
+			ch <- prometheus.MustNewConstMetric(c.reads, prometheus.CounterValue, float64(s.Reads), labels...)
+		}
+	}
+}
@@ -20,3 +20,6 @@ type Cache interface {
 	RetrieveRefreshToken(context.Context, string) (string, error)
 	Close()
 }
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_notification.go -package=mocks
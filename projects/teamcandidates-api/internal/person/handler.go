@@ -40,7 +40,7 @@ func (h *Handler) Routes() {
 	public := router.Group(publicPrefix)
 	{
 		public.POST("", h.CreatePerson)
-		public.GET("", h.ListPersons)
+		public.GET("", mdw.ParseQuerySpec(dto.PersonQuerySchema), h.ListPersons)
 		public.GET("/:id", h.GetPerson)
 		public.PUT("/:id", h.UpdatePerson)
 		public.DELETE("/:id", h.DeletePerson)
@@ -57,15 +57,16 @@ func (h *Handler) Routes() {
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionCandidateRead, types.PermissionCandidateWrite))
 
 		protected.GET("/ping", h.ProtectedPing)
 
 		protected.POST("", h.CreatePerson)
-		protected.GET("", h.ListPersons)
+		protected.GET("", mdw.ParseQuerySpec(dto.PersonQuerySchema), h.ListPersons)
 		protected.GET("/:id", h.GetPerson)
 		protected.PUT("/:id", h.UpdatePerson)
 		protected.DELETE("/:id", h.DeletePerson)
-
+		protected.POST("/:id/restore", mdw.RequirePermission(types.PermissionRestore), h.RestorePerson)
 	}
 }
 
@@ -124,6 +125,20 @@ func (h *Handler) DeletePerson(c *gin.Context) {
 	}
 }
 
+func (h *Handler) RestorePerson(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.ucs.RestorePerson(c.Request.Context(), id); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Person restored successfully",
+	})
+}
+
 func (h *Handler) UpdatePerson(c *gin.Context) {
 	// Validamos el JSON de la solicitud en un DTO de actualización
 	var req dto.UpdatePerson
@@ -160,12 +175,25 @@ func (h *Handler) GetPerson(c *gin.Context) {
 }
 
 func (h *Handler) ListPersons(c *gin.Context) {
-	persons, err := h.ucs.ListPersons(c.Request.Context())
+	spec, err := mdw.GetQuerySpec(c)
 	if err != nil {
 		apiErr, code := types.NewAPIError(err)
 		c.Error(apiErr).SetMeta(code)
 		return
 	}
 
-	c.JSON(http.StatusOK, persons)
+	page, err := h.ucs.ListPersons(c.Request.Context(), spec)
+	if err != nil {
+		apiErr, code := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(code)
+		return
+	}
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainListItem), spec)
+	if err != nil {
+		apiErr, code := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(code)
+		return
+	}
+	c.JSON(http.StatusOK, res)
 }
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/usecases/domain"
+)
+
+// PersonListItem es la vista de una persona en el listado.
+// DeletedAt y DeletedBy solo aparecen cuando un admin lista con deleted=include|only.
+type PersonListItem struct {
+	ID string `json:"id"`
+	Person
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy string     `json:"deleted_by,omitempty"`
+}
+
+// PersonQuerySchema define los campos por los que se puede filtrar, ordenar y seleccionar en el listado.
+var PersonQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":          {Column: "id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"first_name":  {Column: "first_name", Type: types.FieldString, Filterable: true, Sortable: true},
+		"last_name":   {Column: "last_name", Type: types.FieldString, Filterable: true, Sortable: true},
+		"age":         {Column: "age", Type: types.FieldInt, Filterable: true, Sortable: true},
+		"gender":      {Column: "gender", Type: types.FieldString, Filterable: true},
+		"national_id": {Column: "national_id", Type: types.FieldInt, Filterable: true},
+		"phone":       {Column: "phone", Type: types.FieldString, Filterable: true},
+		"interests":   {Column: "interests", Type: types.FieldString},
+		"hobbies":     {Column: "hobbies", Type: types.FieldString},
+		"created_at":  {Column: "created_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"deleted_at":  {Column: "deleted_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"-created_at"},
+}
+
+// FromDomainListItem convierte una persona del listado al DTO.
+func FromDomainListItem(p domain.Person) PersonListItem {
+	person, _ := FromDomain(&p)
+	return PersonListItem{ID: p.ID, Person: *person, DeletedAt: p.DeletedAt, DeletedBy: p.DeletedBy}
+}
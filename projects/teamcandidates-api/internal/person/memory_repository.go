@@ -0,0 +1,170 @@
+package person
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/repository/models"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/usecases/domain"
+)
+
+type memoryRepository struct {
+	people *mapdb.Table[models.Person]
+}
+
+// NewMemoryRepository crea el repositorio de personas sobre la base en memoria, con la misma
+// restricción única de national_id que la tabla people.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		people: mapdb.NewTable(db, "people",
+			func(p *models.Person) string { return p.ID },
+			mapdb.Index[models.Person]{
+				Name:   "national_id",
+				Unique: true,
+				Values: func(p *models.Person) []string { return []string{strconv.FormatInt(p.NationalID, 10)} },
+			},
+		),
+	}
+}
+
+func (r *memoryRepository) CreatePerson(ctx context.Context, person *domain.Person) (string, error) {
+	if person == nil {
+		return "", errors.New("person is nil")
+	}
+
+	model, err := models.FromDomain(person)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	model.ID = uuid.New().String()
+	model.CreatedAt, model.UpdatedAt = now, &now
+
+	if err := r.people.Insert(ctx, model); err != nil {
+		if types.IsConflict(err) {
+			return "", errors.New("person already exists")
+		}
+		return "", fmt.Errorf("error creating person: %w", err)
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) ListPersons(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Person], error) {
+	page, err := r.people.Page(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("error querying people: %w", err)
+	}
+
+	people := make([]domain.Person, 0, len(page.Items))
+	for i := range page.Items {
+		personDomain, err := page.Items[i].ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("error converting person to domain: %w", err)
+		}
+		people = append(people, *personDomain)
+	}
+	return &types.Page[domain.Person]{Items: people, Info: page.Info}, nil
+}
+
+func (r *memoryRepository) GetPerson(ctx context.Context, id string) (*domain.Person, error) {
+	model, err := r.people.Get(ctx, id)
+	if err != nil || model.DeletedAt.Valid {
+		return nil, errors.New("person not found")
+	}
+	return model.ToDomain()
+}
+
+func (r *memoryRepository) UpdatePerson(ctx context.Context, ID string, person *domain.Person) error {
+	err := r.people.Modify(ctx, ID, func(m *models.Person) error {
+		now := time.Now()
+		m.NationalID = person.NationalID
+		m.FirstName = person.FirstName
+		m.LastName = person.LastName
+		m.Age = person.Age
+		m.Gender = person.Gender
+		m.Phone = person.Phone
+		m.Interests = pq.StringArray(person.Interests)
+		m.Hobbies = pq.StringArray(person.Hobbies)
+		m.Deleted = person.Deleted
+		m.UpdatedAt = &now
+		return nil
+	})
+	if types.IsNotFound(err) {
+		return errors.New("person not found")
+	}
+	if err != nil {
+		return fmt.Errorf("error updating person: %w", err)
+	}
+	return nil
+}
+
+func (r *memoryRepository) SoftDeletePerson(ctx context.Context, id, deletedBy string) error {
+	err := r.people.Modify(ctx, id, func(m *models.Person) error {
+		if m.DeletedAt.Valid {
+			return types.NewError(types.ErrNotFound, "person not found", nil)
+		}
+		m.Deleted = true
+		m.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		m.DeletedBy = deletedBy
+		return nil
+	})
+	if types.IsNotFound(err) {
+		return errors.New("person not found")
+	}
+	if err != nil {
+		return fmt.Errorf("error performing soft delete: %w", err)
+	}
+	return nil
+}
+
+func (r *memoryRepository) HardDeletePerson(ctx context.Context, id string) error {
+	err := r.people.Delete(ctx, id)
+	if types.IsNotFound(err) {
+		return errors.New("person not found")
+	}
+	if err != nil {
+		return fmt.Errorf("error performing hard delete: %w", err)
+	}
+	return nil
+}
+
+func (r *memoryRepository) RestorePerson(ctx context.Context, id string) error {
+	err := r.people.Modify(ctx, id, func(m *models.Person) error {
+		if !m.DeletedAt.Valid {
+			return types.NewError(types.ErrNotFound, "deleted person not found", nil)
+		}
+		now := time.Now()
+		m.Deleted = false
+		m.DeletedAt = gorm.DeletedAt{}
+		m.DeletedBy = ""
+		m.UpdatedAt = &now
+		return nil
+	})
+	if types.IsNotFound(err) {
+		return errors.New("deleted person not found")
+	}
+	if err != nil {
+		return fmt.Errorf("error restoring person: %w", err)
+	}
+	return nil
+}
+
+func (r *memoryRepository) PurgeDeletedPersons(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := r.people.DeleteWhere(ctx, func(m *models.Person) bool {
+		return m.DeletedAt.Valid && m.DeletedAt.Time.Before(before)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error purging deleted people: %w", err)
+	}
+	return int64(purged), nil
+}
@@ -0,0 +1,279 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/usecases/domain"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// CreatePerson mocks base method.
+func (m *MockUseCases) CreatePerson(arg0 context.Context, arg1 *domain.Person) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePerson", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePerson indicates an expected call of CreatePerson.
+func (mr *MockUseCasesMockRecorder) CreatePerson(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePerson", reflect.TypeOf((*MockUseCases)(nil).CreatePerson), arg0, arg1)
+}
+
+// DeletePerson mocks base method.
+func (m *MockUseCases) DeletePerson(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePerson", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePerson indicates an expected call of DeletePerson.
+func (mr *MockUseCasesMockRecorder) DeletePerson(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePerson", reflect.TypeOf((*MockUseCases)(nil).DeletePerson), arg0, arg1, arg2)
+}
+
+// GetPerson mocks base method.
+func (m *MockUseCases) GetPerson(arg0 context.Context, arg1 string) (*domain.Person, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPerson", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Person)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPerson indicates an expected call of GetPerson.
+func (mr *MockUseCasesMockRecorder) GetPerson(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerson", reflect.TypeOf((*MockUseCases)(nil).GetPerson), arg0, arg1)
+}
+
+// ListPersons mocks base method.
+func (m *MockUseCases) ListPersons(arg0 context.Context, arg1 *types.QuerySpec) (*types.Page[domain.Person], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPersons", arg0, arg1)
+	ret0, _ := ret[0].(*types.Page[domain.Person])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersons indicates an expected call of ListPersons.
+func (mr *MockUseCasesMockRecorder) ListPersons(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersons", reflect.TypeOf((*MockUseCases)(nil).ListPersons), arg0, arg1)
+}
+
+// PurgeDeletedPersons mocks base method.
+func (m *MockUseCases) PurgeDeletedPersons(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedPersons", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedPersons indicates an expected call of PurgeDeletedPersons.
+func (mr *MockUseCasesMockRecorder) PurgeDeletedPersons(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedPersons", reflect.TypeOf((*MockUseCases)(nil).PurgeDeletedPersons), arg0, arg1)
+}
+
+// RestorePerson mocks base method.
+func (m *MockUseCases) RestorePerson(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePerson", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePerson indicates an expected call of RestorePerson.
+func (mr *MockUseCasesMockRecorder) RestorePerson(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePerson", reflect.TypeOf((*MockUseCases)(nil).RestorePerson), arg0, arg1)
+}
+
+// UpdatePerson mocks base method.
+func (m *MockUseCases) UpdatePerson(arg0 context.Context, arg1 string, arg2 *domain.Person) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePerson", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePerson indicates an expected call of UpdatePerson.
+func (mr *MockUseCasesMockRecorder) UpdatePerson(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePerson", reflect.TypeOf((*MockUseCases)(nil).UpdatePerson), arg0, arg1, arg2)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePerson mocks base method.
+func (m *MockRepository) CreatePerson(arg0 context.Context, arg1 *domain.Person) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePerson", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePerson indicates an expected call of CreatePerson.
+func (mr *MockRepositoryMockRecorder) CreatePerson(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePerson", reflect.TypeOf((*MockRepository)(nil).CreatePerson), arg0, arg1)
+}
+
+// GetPerson mocks base method.
+func (m *MockRepository) GetPerson(arg0 context.Context, arg1 string) (*domain.Person, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPerson", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Person)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPerson indicates an expected call of GetPerson.
+func (mr *MockRepositoryMockRecorder) GetPerson(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerson", reflect.TypeOf((*MockRepository)(nil).GetPerson), arg0, arg1)
+}
+
+// HardDeletePerson mocks base method.
+func (m *MockRepository) HardDeletePerson(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDeletePerson", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HardDeletePerson indicates an expected call of HardDeletePerson.
+func (mr *MockRepositoryMockRecorder) HardDeletePerson(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDeletePerson", reflect.TypeOf((*MockRepository)(nil).HardDeletePerson), ctx, id)
+}
+
+// ListPersons mocks base method.
+func (m *MockRepository) ListPersons(arg0 context.Context, arg1 *types.QuerySpec) (*types.Page[domain.Person], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPersons", arg0, arg1)
+	ret0, _ := ret[0].(*types.Page[domain.Person])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersons indicates an expected call of ListPersons.
+func (mr *MockRepositoryMockRecorder) ListPersons(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersons", reflect.TypeOf((*MockRepository)(nil).ListPersons), arg0, arg1)
+}
+
+// PurgeDeletedPersons mocks base method.
+func (m *MockRepository) PurgeDeletedPersons(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedPersons", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedPersons indicates an expected call of PurgeDeletedPersons.
+func (mr *MockRepositoryMockRecorder) PurgeDeletedPersons(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedPersons", reflect.TypeOf((*MockRepository)(nil).PurgeDeletedPersons), ctx, before)
+}
+
+// RestorePerson mocks base method.
+func (m *MockRepository) RestorePerson(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePerson", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePerson indicates an expected call of RestorePerson.
+func (mr *MockRepositoryMockRecorder) RestorePerson(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePerson", reflect.TypeOf((*MockRepository)(nil).RestorePerson), ctx, id)
+}
+
+// SoftDeletePerson mocks base method.
+func (m *MockRepository) SoftDeletePerson(ctx context.Context, id, deletedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeletePerson", ctx, id, deletedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeletePerson indicates an expected call of SoftDeletePerson.
+func (mr *MockRepositoryMockRecorder) SoftDeletePerson(ctx, id, deletedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeletePerson", reflect.TypeOf((*MockRepository)(nil).SoftDeletePerson), ctx, id, deletedBy)
+}
+
+// UpdatePerson mocks base method.
+func (m *MockRepository) UpdatePerson(arg0 context.Context, arg1 string, arg2 *domain.Person) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePerson", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePerson indicates an expected call of UpdatePerson.
+func (mr *MockRepositoryMockRecorder) UpdatePerson(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePerson", reflect.TypeOf((*MockRepository)(nil).UpdatePerson), arg0, arg1, arg2)
+}
@@ -2,22 +2,33 @@ package person
 
 import (
 	"context"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/usecases/domain"
 )
 
 type UseCases interface {
 	CreatePerson(context.Context, *domain.Person) (string, error)
-	ListPersons(context.Context) ([]domain.Person, error)
+	ListPersons(context.Context, *types.QuerySpec) (*types.Page[domain.Person], error)
 	GetPerson(context.Context, string) (*domain.Person, error)
 	UpdatePerson(context.Context, string, *domain.Person) error
 	DeletePerson(context.Context, string, bool) error
+	RestorePerson(context.Context, string) error
+	PurgeDeletedPersons(context.Context, time.Time) (int64, error)
 }
 
 type Repository interface {
 	CreatePerson(context.Context, *domain.Person) (string, error)
-	ListPersons(context.Context) ([]domain.Person, error)
+	ListPersons(context.Context, *types.QuerySpec) (*types.Page[domain.Person], error)
 	GetPerson(context.Context, string) (*domain.Person, error)
 	UpdatePerson(context.Context, string, *domain.Person) error
-	DeletePerson(context.Context, string, bool) error
+	SoftDeletePerson(ctx context.Context, id, deletedBy string) error
+	HardDeletePerson(ctx context.Context, id string) error
+	RestorePerson(ctx context.Context, id string) error
+	PurgeDeletedPersons(ctx context.Context, before time.Time) (int64, error)
 }
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_person.go -package=mocks
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx" // Para errores pgx.ErrNoRows.
 	"github.com/lib/pq"
 
 	pgdb "github.com/teamcubation/teamcandidates/pkg/databases/sql/postgresql/pgxpool"
+	sqlspec "github.com/teamcubation/teamcandidates/pkg/databases/sql/sqlspec"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/repository/models"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/usecases/domain"
@@ -93,64 +96,34 @@ func (r *postgresRepository) CreatePerson(ctx context.Context, person *domain.Pe
 	return model.ID, nil
 }
 
-func (r *postgresRepository) ListPersons(ctx context.Context) ([]domain.Person, error) {
-	query := `
-		SELECT
-			id,
-			first_name,
-			last_name,
-			age,
-			gender,
-			national_id,
-			phone,
-			interests,
-			hobbies,
-			deleted,
-			created_at,
-			updated_at,
-			deleted_at
-		FROM people
-		`
+// personColumns son las columnas que devuelve el listado cuando no se pide un sparse fieldset.
+var personColumns = []string{
+	"id", "first_name", "last_name", "age", "gender", "national_id", "phone",
+	"interests", "hobbies", "deleted", "created_at", "updated_at", "deleted_at",
+	"COALESCE(deleted_by, '') AS deleted_by",
+}
 
-	rows, err := r.postgresRepository.Pool().Query(ctx, query)
+func (r *postgresRepository) ListPersons(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Person], error) {
+	deleted, err := sqlspec.Deleted(spec.Deleted, "deleted_at")
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := pgdb.SelectPage[models.Person](ctx, r.postgresRepository, "people", personColumns, spec, deleted)
 	if err != nil {
 		return nil, fmt.Errorf("error querying people: %w", err)
 	}
-	defer rows.Close()
-
-	var people []domain.Person
-	for rows.Next() {
-		var pm models.Person
-		if err := rows.Scan(
-			&pm.ID,
-			&pm.FirstName,
-			&pm.LastName,
-			&pm.Age,
-			&pm.Gender,
-			&pm.NationalID,
-			&pm.Phone,
-			&pm.Interests,
-			&pm.Hobbies,
-			&pm.Deleted,
-			&pm.CreatedAt,
-			&pm.UpdatedAt,
-			&pm.DeletedAt,
-		); err != nil {
-			return nil, fmt.Errorf("error scanning person row: %w", err)
-		}
 
-		personDomain, err := pm.ToDomain()
+	people := make([]domain.Person, 0, len(page.Items))
+	for i := range page.Items {
+		personDomain, err := page.Items[i].ToDomain()
 		if err != nil {
 			return nil, fmt.Errorf("error converting person to domain: %w", err)
 		}
 		people = append(people, *personDomain)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating person rows: %w", err)
-	}
-
-	return people, nil
+	return &types.Page[domain.Person]{Items: people, Info: page.Info}, nil
 }
 
 func (r *postgresRepository) GetPerson(ctx context.Context, id string) (*domain.Person, error) {
@@ -168,9 +141,10 @@ func (r *postgresRepository) GetPerson(ctx context.Context, id string) (*domain.
 			deleted,
 			created_at,
 			updated_at,
-			deleted_at
+			deleted_at,
+			COALESCE(deleted_by, '')
 		FROM people
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 		`
 
 	var pm models.Person
@@ -188,6 +162,7 @@ func (r *postgresRepository) GetPerson(ctx context.Context, id string) (*domain.
 		&pm.CreatedAt,
 		&pm.UpdatedAt,
 		&pm.DeletedAt,
+		&pm.DeletedBy,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -250,43 +225,81 @@ func (r *postgresRepository) UpdatePerson(ctx context.Context, ID string, person
 	return nil
 }
 
-func (r *postgresRepository) DeletePerson(ctx context.Context, id string, hardDelete bool) error {
-	if hardDelete {
-		query := `
-			DELETE FROM people
-			WHERE id = $1
-			`
-		result, err := r.postgresRepository.Pool().Exec(ctx, query, id)
-		if err != nil {
-			if pqErr, ok := err.(*pq.Error); ok {
-				return fmt.Errorf("database error: %w", pqErr)
-			}
-			return fmt.Errorf("error performing hard delete: %w", err)
+func (r *postgresRepository) SoftDeletePerson(ctx context.Context, id, deletedBy string) error {
+	query := `
+		UPDATE people
+		SET deleted = true, deleted_at = NOW(), deleted_by = $2
+		WHERE id = $1 AND deleted_at IS NULL
+		`
+	result, err := r.postgresRepository.Pool().Exec(ctx, query, id, deletedBy)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			return fmt.Errorf("database error: %w", pqErr)
 		}
+		return fmt.Errorf("error performing soft delete: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.New("person not found")
+	}
 
-		if result.RowsAffected() == 0 {
-			return errors.New("person not found")
+	return nil
+}
+
+func (r *postgresRepository) HardDeletePerson(ctx context.Context, id string) error {
+	query := `
+		DELETE FROM people
+		WHERE id = $1
+		`
+	result, err := r.postgresRepository.Pool().Exec(ctx, query, id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			return fmt.Errorf("database error: %w", pqErr)
 		}
+		return fmt.Errorf("error performing hard delete: %w", err)
+	}
 
-		return nil
+	if result.RowsAffected() == 0 {
+		return errors.New("person not found")
 	}
 
+	return nil
+}
+
+func (r *postgresRepository) RestorePerson(ctx context.Context, id string) error {
 	query := `
 		UPDATE people
-		SET deleted = true, deleted_at = NOW()
-		WHERE id = $1
+		SET deleted = false, deleted_at = NULL, deleted_by = NULL, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NOT NULL
 		`
 	result, err := r.postgresRepository.Pool().Exec(ctx, query, id)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			return fmt.Errorf("database error: %w", pqErr)
 		}
-		return fmt.Errorf("error performing soft delete: %w", err)
+		return fmt.Errorf("error restoring person: %w", err)
 	}
 
 	if result.RowsAffected() == 0 {
-		return errors.New("person not found")
+		return errors.New("deleted person not found")
 	}
 
 	return nil
 }
+
+// PurgeDeletedPersons borra definitivamente las personas con soft delete anterior a before.
+func (r *postgresRepository) PurgeDeletedPersons(ctx context.Context, before time.Time) (int64, error) {
+	query := `
+		DELETE FROM people
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1
+		`
+	result, err := r.postgresRepository.Pool().Exec(ctx, query, before)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			return 0, fmt.Errorf("database error: %w", pqErr)
+		}
+		return 0, fmt.Errorf("error purging deleted people: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -24,6 +24,7 @@ type Person struct {
 	CreatedAt  time.Time      `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt  *time.Time     `gorm:"column:updated_at;autoUpdateTime"`
 	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	DeletedBy  string         `gorm:"column:deleted_by"`
 }
 
 func (Person) TableName() string {
@@ -65,5 +66,14 @@ func (pm *Person) ToDomain() (*domain.Person, error) {
 		Interests:  []string(pm.Interests),
 		Hobbies:    []string(pm.Hobbies),
 		Deleted:    pm.Deleted,
+		DeletedAt:  deletedAt(pm.DeletedAt),
+		DeletedBy:  pm.DeletedBy,
 	}, nil
 }
+
+func deletedAt(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
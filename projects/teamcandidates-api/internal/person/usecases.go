@@ -3,17 +3,27 @@ package person
 import (
 	"context"
 	"fmt"
+	"time"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/usecases/domain"
 )
 
+// auditResource es el tipo de recurso con el que se registran los cambios de personas.
+const auditResource = "person"
+
 type useCases struct {
 	storage Repository
+	audit   audit.UseCases
 }
 
-func NewUseCases(s Repository) UseCases {
+func NewUseCases(s Repository, au audit.UseCases) UseCases {
 	return &useCases{
 		storage: s,
+		audit:   au,
 	}
 }
 
@@ -22,11 +32,13 @@ func (u *useCases) CreatePerson(ctx context.Context, person *domain.Person) (str
 	if err != nil {
 		return "", fmt.Errorf("failed to create person: %w", err)
 	}
+
+	u.audit.RecordChange(ctx, auditdom.ActionCreate, auditResource, personID, nil, person)
 	return personID, nil
 }
 
-func (u *useCases) ListPersons(ctx context.Context) ([]domain.Person, error) {
-	return u.storage.ListPersons(ctx)
+func (u *useCases) ListPersons(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.Person], error) {
+	return u.storage.ListPersons(ctx, spec)
 }
 
 func (ps *useCases) GetPerson(ctx context.Context, ID string) (*domain.Person, error) {
@@ -34,9 +46,53 @@ func (ps *useCases) GetPerson(ctx context.Context, ID string) (*domain.Person, e
 }
 
 func (ps *useCases) UpdatePerson(ctx context.Context, ID string, person *domain.Person) error {
-	return ps.storage.UpdatePerson(ctx, ID, person)
+	before, _ := ps.storage.GetPerson(ctx, ID)
+	if err := ps.storage.UpdatePerson(ctx, ID, person); err != nil {
+		return err
+	}
+
+	after, _ := ps.storage.GetPerson(ctx, ID)
+	ps.audit.RecordChange(ctx, auditdom.ActionUpdate, auditResource, ID, before, after)
+	return nil
 }
 
 func (ps *useCases) DeletePerson(ctx context.Context, ID string, hardDelete bool) error {
-	return ps.storage.DeletePerson(ctx, ID, hardDelete)
+	before, _ := ps.storage.GetPerson(ctx, ID)
+
+	var err error
+	if hardDelete {
+		err = ps.storage.HardDeletePerson(ctx, ID)
+	} else {
+		err = ps.storage.SoftDeletePerson(ctx, ID, types.PrincipalIDFromContext(ctx))
+	}
+	if err != nil {
+		return err
+	}
+
+	ps.audit.RecordChange(ctx, auditdom.ActionDelete, auditResource, ID, before, nil)
+	return nil
+}
+
+// RestorePerson deshace el soft delete de una persona.
+func (ps *useCases) RestorePerson(ctx context.Context, ID string) error {
+	if err := ps.storage.RestorePerson(ctx, ID); err != nil {
+		return err
+	}
+
+	after, _ := ps.storage.GetPerson(ctx, ID)
+	ps.audit.RecordChange(ctx, auditdom.ActionRestore, auditResource, ID, nil, after)
+	return nil
+}
+
+// PurgeDeletedPersons elimina definitivamente las personas borradas antes de before.
+func (ps *useCases) PurgeDeletedPersons(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := ps.storage.PurgeDeletedPersons(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted people: %w", err)
+	}
+
+	if purged > 0 {
+		ps.audit.RecordChange(ctx, auditdom.ActionPurge, auditResource, "", nil, map[string]any{"before": before, "purged": purged})
+	}
+	return purged, nil
 }
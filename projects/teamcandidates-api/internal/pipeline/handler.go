@@ -0,0 +1,170 @@
+package pipeline
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	gsv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/handler/dto"
+)
+
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{
+		ucs: u,
+		gsv: s,
+		mws: m,
+	}
+}
+
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/pipelines"
+	protectedPrefix := apiBase + "/protected"
+
+	// Rutas protegidas
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionCandidateRead, types.PermissionCandidateWrite))
+
+		protected.GET("/candidates/:candidateId", h.ListCandidateEntries)                                   // Búsquedas en las que participa el candidato
+		protected.GET("/:openingId", h.GetPipeline)                                                         // Etapas de la búsqueda
+		protected.PUT("/:openingId", h.ConfigurePipeline)                                                   // Configurar las etapas
+		protected.GET("/:openingId/board", h.GetBoard)                                                      // Cantidad de candidatos por etapa
+		protected.GET("/:openingId/candidates", mdw.ParseQuerySpec(dto.EntryQuerySchema), h.ListCandidates) // Candidatos (paginado; filter[stage]=... por etapa)
+		protected.POST("/:openingId/candidates", h.AddCandidate)                                            // Agregar un candidato al tablero
+		protected.POST("/:openingId/candidates/:candidateId/move", h.MoveCandidate)                         // Mover de etapa
+		protected.GET("/:openingId/candidates/:candidateId/history", h.ListTransitions)                     // Historial de etapas
+	}
+}
+
+func (h *Handler) GetPipeline(c *gin.Context) {
+	pipeline, err := h.ucs.GetPipeline(c.Request.Context(), c.Param("openingId"))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainPipeline(pipeline))
+}
+
+func (h *Handler) ConfigurePipeline(c *gin.Context) {
+	var req dto.Pipeline
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	pipeline, err := h.ucs.ConfigurePipeline(c.Request.Context(), req.ToDomain(c.Param("openingId")))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainPipeline(pipeline))
+}
+
+func (h *Handler) GetBoard(c *gin.Context) {
+	openingID := c.Param("openingId")
+	board, err := h.ucs.GetBoard(c.Request.Context(), openingID)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainBoard(openingID, board))
+}
+
+func (h *Handler) ListCandidates(c *gin.Context) {
+	spec, err := mdw.GetQuerySpec(c)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	page, err := h.ucs.ListCandidates(c.Request.Context(), c.Param("openingId"), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainEntry), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *Handler) AddCandidate(c *gin.Context) {
+	var req dto.AddCandidate
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	entry, err := h.ucs.AddCandidate(c.Request.Context(), req.ToDomain(c.Param("openingId")))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.FromDomainEntry(*entry))
+}
+
+func (h *Handler) MoveCandidate(c *gin.Context) {
+	var req dto.MoveCandidate
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	entry, err := h.ucs.MoveCandidate(c.Request.Context(), req.ToDomain(c.Param("openingId"), c.Param("candidateId")))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainEntry(*entry))
+}
+
+func (h *Handler) ListTransitions(c *gin.Context) {
+	openingID, candidateID := c.Param("openingId"), c.Param("candidateId")
+	history, err := h.ucs.ListTransitions(c.Request.Context(), openingID, candidateID)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainHistory(openingID, candidateID, history))
+}
+
+func (h *Handler) ListCandidateEntries(c *gin.Context) {
+	candidateID := c.Param("candidateId")
+	entries, err := h.ucs.ListCandidateEntries(c.Request.Context(), candidateID)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainCandidateEntries(candidateID, entries))
+}
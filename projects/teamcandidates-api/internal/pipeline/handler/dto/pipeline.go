@@ -0,0 +1,175 @@
+package dto
+
+import (
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+)
+
+// EntryQuerySchema define los campos por los que se puede filtrar y ordenar el listado de
+// candidatos de una búsqueda (p. ej., ?filter[stage]=interview para ver una columna del tablero).
+var EntryQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":               {Column: "id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"candidate_id":     {Column: "candidate_id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"stage":            {Column: "stage", Type: types.FieldString, Filterable: true, Sortable: true},
+		"entered_stage_at": {Column: "entered_stage_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"created_at":       {Column: "created_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"entered_stage_at"},
+}
+
+type Stage struct {
+	Key  string `json:"key" binding:"required,max=50"`
+	Name string `json:"name" binding:"omitempty,max=100"`
+}
+
+// Pipeline es el body de PUT /:openingId y la respuesta de GET /:openingId.
+type Pipeline struct {
+	JobOpeningID             string     `json:"job_opening_id"`
+	Stages                   []Stage    `json:"stages" binding:"required,min=1,max=20,dive"`
+	AssessmentStage          string     `json:"assessment_stage"`
+	AssessmentCompletedStage string     `json:"assessment_completed_stage"`
+	UpdatedBy                string     `json:"updated_by,omitempty"`
+	UpdatedAt                *time.Time `json:"updated_at,omitempty"`
+}
+
+// AddCandidate es el body de POST /:openingId/candidates.
+type AddCandidate struct {
+	CandidateID string `json:"candidate_id" binding:"required"`
+	Stage       string `json:"stage" binding:"omitempty,max=50"` // Vacío = primera etapa
+	Reason      string `json:"reason" binding:"omitempty,max=500"`
+}
+
+// MoveCandidate es el body de POST /:openingId/candidates/:candidateId/move.
+type MoveCandidate struct {
+	Stage  string `json:"stage" binding:"required,max=50"`
+	Reason string `json:"reason" binding:"omitempty,max=500"`
+}
+
+// Mappers
+func (p *Pipeline) ToDomain(jobOpeningID string) *domain.Pipeline {
+	pipeline := &domain.Pipeline{
+		JobOpeningID:             jobOpeningID,
+		Stages:                   make([]domain.Stage, 0, len(p.Stages)),
+		AssessmentStage:          p.AssessmentStage,
+		AssessmentCompletedStage: p.AssessmentCompletedStage,
+	}
+	for _, s := range p.Stages {
+		pipeline.Stages = append(pipeline.Stages, domain.Stage{Key: s.Key, Name: s.Name})
+	}
+	return pipeline
+}
+
+func (r *AddCandidate) ToDomain(jobOpeningID string) *domain.Move {
+	return &domain.Move{JobOpeningID: jobOpeningID, CandidateID: r.CandidateID, To: r.Stage, Reason: r.Reason}
+}
+
+func (r *MoveCandidate) ToDomain(jobOpeningID, candidateID string) *domain.Move {
+	return &domain.Move{JobOpeningID: jobOpeningID, CandidateID: candidateID, To: r.Stage, Reason: r.Reason}
+}
+
+// Response
+type BoardStage struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	Terminal bool   `json:"terminal"`
+	Count    int    `json:"count"`
+}
+
+type BoardResponse struct {
+	JobOpeningID string       `json:"job_opening_id"`
+	Stages       []BoardStage `json:"stages"`
+}
+
+type Entry struct {
+	ID             string    `json:"id"`
+	JobOpeningID   string    `json:"job_opening_id"`
+	CandidateID    string    `json:"candidate_id"`
+	Stage          string    `json:"stage"`
+	EnteredStageAt time.Time `json:"entered_stage_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type CandidateEntriesResponse struct {
+	CandidateID string  `json:"candidate_id"`
+	Entries     []Entry `json:"entries"`
+}
+
+type Transition struct {
+	ID         string    `json:"id"`
+	From       string    `json:"from,omitempty"`
+	To         string    `json:"to"`
+	Reason     string    `json:"reason,omitempty"`
+	Actor      string    `json:"actor"`
+	Automatic  bool      `json:"automatic"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type HistoryResponse struct {
+	JobOpeningID string       `json:"job_opening_id"`
+	CandidateID  string       `json:"candidate_id"`
+	History      []Transition `json:"history"`
+}
+
+func FromDomainPipeline(p *domain.Pipeline) Pipeline {
+	resp := Pipeline{
+		JobOpeningID:             p.JobOpeningID,
+		Stages:                   make([]Stage, 0, len(p.Stages)),
+		AssessmentStage:          p.AssessmentStage,
+		AssessmentCompletedStage: p.AssessmentCompletedStage,
+		UpdatedBy:                p.UpdatedBy,
+	}
+	if !p.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &p.UpdatedAt
+	}
+	for _, s := range p.Stages {
+		resp.Stages = append(resp.Stages, Stage{Key: s.Key, Name: s.Name})
+	}
+	return resp
+}
+
+func FromDomainBoard(jobOpeningID string, board []domain.StageCount) BoardResponse {
+	resp := BoardResponse{JobOpeningID: jobOpeningID, Stages: make([]BoardStage, 0, len(board))}
+	for _, s := range board {
+		resp.Stages = append(resp.Stages, BoardStage{Key: s.Key, Name: s.Name, Terminal: s.IsTerminal(), Count: s.Count})
+	}
+	return resp
+}
+
+func FromDomainEntry(e domain.Entry) Entry {
+	return Entry{
+		ID:             e.ID,
+		JobOpeningID:   e.JobOpeningID,
+		CandidateID:    e.CandidateID,
+		Stage:          e.Stage,
+		EnteredStageAt: e.EnteredStageAt,
+		CreatedAt:      e.CreatedAt,
+	}
+}
+
+func FromDomainCandidateEntries(candidateID string, entries []domain.Entry) CandidateEntriesResponse {
+	resp := CandidateEntriesResponse{CandidateID: candidateID, Entries: make([]Entry, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, FromDomainEntry(e))
+	}
+	return resp
+}
+
+func FromDomainHistory(jobOpeningID, candidateID string, transitions []domain.Transition) HistoryResponse {
+	resp := HistoryResponse{JobOpeningID: jobOpeningID, CandidateID: candidateID, History: make([]Transition, 0, len(transitions))}
+	for _, t := range transitions {
+		resp.History = append(resp.History, Transition{
+			ID:         t.ID,
+			From:       t.From,
+			To:         t.To,
+			Reason:     t.Reason,
+			Actor:      t.Actor,
+			Automatic:  t.Automatic,
+			OccurredAt: t.OccurredAt,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,187 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+)
+
+type memoryRepository struct {
+	pipelines   *mapdb.Table[models.Pipeline]
+	entries     *mapdb.Table[models.PipelineEntry]
+	transitions *mapdb.Table[models.PipelineTransition]
+}
+
+// NewMemoryRepository crea el repositorio de los pipelines sobre la base en memoria.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		pipelines: mapdb.NewTable(db, "pipelines",
+			func(p *models.Pipeline) string { return p.JobOpeningID },
+		),
+		entries: mapdb.NewTable(db, "pipeline_entries",
+			func(e *models.PipelineEntry) string { return e.ID },
+			mapdb.Index[models.PipelineEntry]{
+				Name:   "opening_candidate",
+				Unique: true,
+				Values: func(e *models.PipelineEntry) []string { return []string{entryKey(e.JobOpeningID, e.CandidateID)} },
+			},
+			mapdb.Index[models.PipelineEntry]{
+				Name:   "job_opening_id",
+				Values: func(e *models.PipelineEntry) []string { return []string{e.JobOpeningID} },
+			},
+			mapdb.Index[models.PipelineEntry]{
+				Name:   "candidate_id",
+				Values: func(e *models.PipelineEntry) []string { return []string{e.CandidateID} },
+			},
+		),
+		transitions: mapdb.NewTable(db, "pipeline_transitions",
+			func(t *models.PipelineTransition) string { return t.ID },
+			mapdb.Index[models.PipelineTransition]{
+				Name:   "entry_id",
+				Values: func(t *models.PipelineTransition) []string { return []string{t.EntryID} },
+			},
+		),
+	}
+}
+
+func (r *memoryRepository) GetPipeline(ctx context.Context, jobOpeningID string) (*domain.Pipeline, error) {
+	model, err := r.pipelines.Get(ctx, jobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *memoryRepository) SavePipeline(ctx context.Context, pipeline *domain.Pipeline) error {
+	if pipeline == nil {
+		return errors.New("pipeline is nil")
+	}
+
+	model := models.FromDomainPipeline(pipeline)
+	model.CreatedAt = time.Now()
+	if current, err := r.pipelines.Get(ctx, pipeline.JobOpeningID); err == nil {
+		model.CreatedAt = current.CreatedAt
+	}
+	return r.pipelines.Upsert(ctx, model)
+}
+
+func (r *memoryRepository) CreateEntry(ctx context.Context, entry *domain.Entry) (string, error) {
+	if entry == nil {
+		return "", errors.New("pipeline entry is nil")
+	}
+
+	model := models.FromDomainEntry(entry)
+	model.ID = uuid.New().String()
+	model.CreatedAt = time.Now()
+	model.UpdatedAt = model.CreatedAt
+
+	if err := r.entries.Insert(ctx, model); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) GetEntry(ctx context.Context, jobOpeningID, candidateID string) (*domain.Entry, error) {
+	model, err := r.entries.FindOneBy(ctx, "opening_candidate", entryKey(jobOpeningID, candidateID))
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("candidate %s is not in the pipeline of job opening %s", candidateID, jobOpeningID), err)
+		}
+		return nil, err
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *memoryRepository) UpdateEntryStage(ctx context.Context, entry *domain.Entry, from string) error {
+	if entry == nil {
+		return errors.New("pipeline entry is nil")
+	}
+
+	return r.entries.Modify(ctx, entry.ID, func(m *models.PipelineEntry) error {
+		if m.Stage != from {
+			return types.NewError(types.ErrConflict, fmt.Sprintf("candidate is no longer in stage %s", from), nil)
+		}
+		m.Stage, m.EnteredStageAt = entry.Stage, entry.EnteredStageAt
+		m.UpdatedAt = time.Now()
+		return nil
+	})
+}
+
+func (r *memoryRepository) ListEntries(ctx context.Context, jobOpeningID string, spec *types.QuerySpec) (*types.Page[domain.Entry], error) {
+	page, err := r.entries.Page(ctx, spec, func(m *models.PipelineEntry) bool { return m.JobOpeningID == jobOpeningID })
+	if err != nil {
+		return nil, err
+	}
+	return types.MapPage(page, func(m models.PipelineEntry) domain.Entry { return *m.ToDomain() }), nil
+}
+
+func (r *memoryRepository) ListEntriesByCandidate(ctx context.Context, candidateID string) ([]domain.Entry, error) {
+	ms, err := r.entries.FindBy(ctx, "candidate_id", candidateID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(ms, func(a, b models.PipelineEntry) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	entries := make([]domain.Entry, 0, len(ms))
+	for _, m := range ms {
+		entries = append(entries, *m.ToDomain())
+	}
+	return entries, nil
+}
+
+func (r *memoryRepository) CountByStage(ctx context.Context, jobOpeningID string) (map[string]int, error) {
+	ms, err := r.entries.FindBy(ctx, "job_opening_id", jobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, m := range ms {
+		counts[m.Stage]++
+	}
+	return counts, nil
+}
+
+func (r *memoryRepository) AppendTransition(ctx context.Context, transition *domain.Transition) error {
+	if transition == nil {
+		return errors.New("pipeline transition is nil")
+	}
+
+	model := models.FromDomainTransition(transition)
+	model.ID = uuid.New().String()
+	if err := r.transitions.Insert(ctx, model); err != nil {
+		return err
+	}
+	transition.ID = model.ID
+	return nil
+}
+
+func (r *memoryRepository) ListTransitions(ctx context.Context, entryID string) ([]domain.Transition, error) {
+	ms, err := r.transitions.FindBy(ctx, "entry_id", entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(ms, func(a, b models.PipelineTransition) int { return a.OccurredAt.Compare(b.OccurredAt) })
+
+	transitions := make([]domain.Transition, 0, len(ms))
+	for _, m := range ms {
+		transitions = append(transitions, m.ToDomain())
+	}
+	return transitions, nil
+}
+
+func entryKey(jobOpeningID, candidateID string) string {
+	return jobOpeningID + "/" + candidateID
+}
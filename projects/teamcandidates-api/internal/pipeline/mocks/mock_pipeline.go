@@ -0,0 +1,342 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// AddCandidate mocks base method.
+func (m *MockUseCases) AddCandidate(arg0 context.Context, arg1 *domain.Move) (*domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCandidate", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCandidate indicates an expected call of AddCandidate.
+func (mr *MockUseCasesMockRecorder) AddCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCandidate", reflect.TypeOf((*MockUseCases)(nil).AddCandidate), arg0, arg1)
+}
+
+// CompleteAssessment mocks base method.
+func (m *MockUseCases) CompleteAssessment(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteAssessment", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteAssessment indicates an expected call of CompleteAssessment.
+func (mr *MockUseCasesMockRecorder) CompleteAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteAssessment", reflect.TypeOf((*MockUseCases)(nil).CompleteAssessment), arg0, arg1)
+}
+
+// ConfigurePipeline mocks base method.
+func (m *MockUseCases) ConfigurePipeline(arg0 context.Context, arg1 *domain.Pipeline) (*domain.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigurePipeline", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigurePipeline indicates an expected call of ConfigurePipeline.
+func (mr *MockUseCasesMockRecorder) ConfigurePipeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigurePipeline", reflect.TypeOf((*MockUseCases)(nil).ConfigurePipeline), arg0, arg1)
+}
+
+// GetBoard mocks base method.
+func (m *MockUseCases) GetBoard(arg0 context.Context, arg1 string) ([]domain.StageCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoard", arg0, arg1)
+	ret0, _ := ret[0].([]domain.StageCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoard indicates an expected call of GetBoard.
+func (mr *MockUseCasesMockRecorder) GetBoard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockUseCases)(nil).GetBoard), arg0, arg1)
+}
+
+// GetPipeline mocks base method.
+func (m *MockUseCases) GetPipeline(arg0 context.Context, arg1 string) (*domain.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipeline", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipeline indicates an expected call of GetPipeline.
+func (mr *MockUseCasesMockRecorder) GetPipeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockUseCases)(nil).GetPipeline), arg0, arg1)
+}
+
+// ListCandidateEntries mocks base method.
+func (m *MockUseCases) ListCandidateEntries(arg0 context.Context, arg1 string) ([]domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCandidateEntries", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCandidateEntries indicates an expected call of ListCandidateEntries.
+func (mr *MockUseCasesMockRecorder) ListCandidateEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCandidateEntries", reflect.TypeOf((*MockUseCases)(nil).ListCandidateEntries), arg0, arg1)
+}
+
+// ListCandidates mocks base method.
+func (m *MockUseCases) ListCandidates(arg0 context.Context, arg1 string, arg2 *types.QuerySpec) (*types.Page[domain.Entry], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCandidates", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.Page[domain.Entry])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCandidates indicates an expected call of ListCandidates.
+func (mr *MockUseCasesMockRecorder) ListCandidates(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCandidates", reflect.TypeOf((*MockUseCases)(nil).ListCandidates), arg0, arg1, arg2)
+}
+
+// ListTransitions mocks base method.
+func (m *MockUseCases) ListTransitions(arg0 context.Context, arg1, arg2 string) ([]domain.Transition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransitions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]domain.Transition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransitions indicates an expected call of ListTransitions.
+func (mr *MockUseCasesMockRecorder) ListTransitions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransitions", reflect.TypeOf((*MockUseCases)(nil).ListTransitions), arg0, arg1, arg2)
+}
+
+// MoveCandidate mocks base method.
+func (m *MockUseCases) MoveCandidate(arg0 context.Context, arg1 *domain.Move) (*domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCandidate", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCandidate indicates an expected call of MoveCandidate.
+func (mr *MockUseCasesMockRecorder) MoveCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCandidate", reflect.TypeOf((*MockUseCases)(nil).MoveCandidate), arg0, arg1)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendTransition mocks base method.
+func (m *MockRepository) AppendTransition(arg0 context.Context, arg1 *domain.Transition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendTransition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendTransition indicates an expected call of AppendTransition.
+func (mr *MockRepositoryMockRecorder) AppendTransition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendTransition", reflect.TypeOf((*MockRepository)(nil).AppendTransition), arg0, arg1)
+}
+
+// CountByStage mocks base method.
+func (m *MockRepository) CountByStage(arg0 context.Context, arg1 string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStage", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStage indicates an expected call of CountByStage.
+func (mr *MockRepositoryMockRecorder) CountByStage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStage", reflect.TypeOf((*MockRepository)(nil).CountByStage), arg0, arg1)
+}
+
+// CreateEntry mocks base method.
+func (m *MockRepository) CreateEntry(arg0 context.Context, arg1 *domain.Entry) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockRepositoryMockRecorder) CreateEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockRepository)(nil).CreateEntry), arg0, arg1)
+}
+
+// GetEntry mocks base method.
+func (m *MockRepository) GetEntry(arg0 context.Context, arg1, arg2 string) (*domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockRepositoryMockRecorder) GetEntry(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockRepository)(nil).GetEntry), arg0, arg1, arg2)
+}
+
+// GetPipeline mocks base method.
+func (m *MockRepository) GetPipeline(arg0 context.Context, arg1 string) (*domain.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipeline", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipeline indicates an expected call of GetPipeline.
+func (mr *MockRepositoryMockRecorder) GetPipeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockRepository)(nil).GetPipeline), arg0, arg1)
+}
+
+// ListEntries mocks base method.
+func (m *MockRepository) ListEntries(arg0 context.Context, arg1 string, arg2 *types.QuerySpec) (*types.Page[domain.Entry], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.Page[domain.Entry])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockRepositoryMockRecorder) ListEntries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockRepository)(nil).ListEntries), arg0, arg1, arg2)
+}
+
+// ListEntriesByCandidate mocks base method.
+func (m *MockRepository) ListEntriesByCandidate(arg0 context.Context, arg1 string) ([]domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntriesByCandidate", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesByCandidate indicates an expected call of ListEntriesByCandidate.
+func (mr *MockRepositoryMockRecorder) ListEntriesByCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesByCandidate", reflect.TypeOf((*MockRepository)(nil).ListEntriesByCandidate), arg0, arg1)
+}
+
+// ListTransitions mocks base method.
+func (m *MockRepository) ListTransitions(arg0 context.Context, arg1 string) ([]domain.Transition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransitions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Transition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransitions indicates an expected call of ListTransitions.
+func (mr *MockRepositoryMockRecorder) ListTransitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransitions", reflect.TypeOf((*MockRepository)(nil).ListTransitions), arg0, arg1)
+}
+
+// SavePipeline mocks base method.
+func (m *MockRepository) SavePipeline(arg0 context.Context, arg1 *domain.Pipeline) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePipeline", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePipeline indicates an expected call of SavePipeline.
+func (mr *MockRepositoryMockRecorder) SavePipeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePipeline", reflect.TypeOf((*MockRepository)(nil).SavePipeline), arg0, arg1)
+}
+
+// UpdateEntryStage mocks base method.
+func (m *MockRepository) UpdateEntryStage(arg0 context.Context, arg1 *domain.Entry, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntryStage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEntryStage indicates an expected call of UpdateEntryStage.
+func (mr *MockRepositoryMockRecorder) UpdateEntryStage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntryStage", reflect.TypeOf((*MockRepository)(nil).UpdateEntryStage), arg0, arg1, arg2)
+}
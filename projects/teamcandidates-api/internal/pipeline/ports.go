@@ -0,0 +1,51 @@
+package pipeline
+
+import (
+	"context"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+)
+
+// UseCases administra el tablero de cada búsqueda: sus etapas y en cuál está cada candidato.
+type UseCases interface {
+	// GetPipeline devuelve las etapas de la búsqueda (las predefinidas si no se configuraron)
+	GetPipeline(context.Context, string) (*domain.Pipeline, error)
+	ConfigurePipeline(context.Context, *domain.Pipeline) (*domain.Pipeline, error)
+	// GetBoard devuelve cuántos candidatos hay en cada etapa, en el orden del tablero
+	GetBoard(context.Context, string) ([]domain.StageCount, error)
+
+	// AddCandidate agrega al candidato al tablero en la etapa indicada (o en la primera)
+	AddCandidate(context.Context, *domain.Move) (*domain.Entry, error)
+	MoveCandidate(context.Context, *domain.Move) (*domain.Entry, error)
+	// ListCandidates lista los candidatos de la búsqueda; la etapa se filtra con el QuerySpec
+	ListCandidates(context.Context, string, *types.QuerySpec) (*types.Page[domain.Entry], error)
+	ListTransitions(context.Context, string, string) ([]domain.Transition, error)
+	// ListCandidateEntries devuelve las búsquedas en las que participa el candidato
+	ListCandidateEntries(context.Context, string) ([]domain.Entry, error)
+
+	// CompleteAssessment mueve al candidato de la evaluación a la etapa siguiente configurada en
+	// cada búsqueda donde esté esperando la evaluación; devuelve cuántos movimientos hizo
+	CompleteAssessment(context.Context, string) (int, error)
+}
+
+type Repository interface {
+	GetPipeline(context.Context, string) (*domain.Pipeline, error)
+	SavePipeline(context.Context, *domain.Pipeline) error
+
+	CreateEntry(context.Context, *domain.Entry) (string, error)
+	GetEntry(context.Context, string, string) (*domain.Entry, error)
+	// UpdateEntryStage guarda la etapa solo si el candidato sigue en from
+	UpdateEntryStage(context.Context, *domain.Entry, string) error
+	ListEntries(context.Context, string, *types.QuerySpec) (*types.Page[domain.Entry], error)
+	ListEntriesByCandidate(context.Context, string) ([]domain.Entry, error)
+	// CountByStage devuelve la cantidad de candidatos de la búsqueda en cada etapa
+	CountByStage(context.Context, string) (map[string]int, error)
+
+	AppendTransition(context.Context, *domain.Transition) error
+	ListTransitions(context.Context, string) ([]domain.Transition, error)
+}
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_pipeline.go -package=mocks
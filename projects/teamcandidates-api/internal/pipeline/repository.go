@@ -0,0 +1,181 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+)
+
+type repository struct {
+	db gorm.Repository
+}
+
+func NewRepository(db gorm.Repository) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) GetPipeline(ctx context.Context, jobOpeningID string) (*domain.Pipeline, error) {
+	var model models.Pipeline
+	if err := r.db.DB(ctx).Where("job_opening_id = ?", jobOpeningID).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("pipeline of job opening %s not found", jobOpeningID), err)
+		}
+		return nil, fmt.Errorf("failed to get pipeline: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// SavePipeline crea o reemplaza la configuración del pipeline.
+func (r *repository) SavePipeline(ctx context.Context, pipeline *domain.Pipeline) error {
+	if pipeline == nil {
+		return errors.New("pipeline is nil")
+	}
+
+	model := models.FromDomainPipeline(pipeline)
+	err := r.db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "job_opening_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"stages", "assessment_stage", "assessment_completed_stage", "updated_by", "updated_at"}),
+	}).Create(model).Error
+	if err != nil {
+		return fmt.Errorf("failed to save pipeline: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) CreateEntry(ctx context.Context, entry *domain.Entry) (string, error) {
+	if entry == nil {
+		return "", errors.New("pipeline entry is nil")
+	}
+
+	model := models.FromDomainEntry(entry)
+	model.ID = uuid.New().String()
+	if err := r.db.DB(ctx).Create(model).Error; err != nil {
+		return "", fmt.Errorf("failed to create pipeline entry: %w", err)
+	}
+	return model.ID, nil
+}
+
+func (r *repository) GetEntry(ctx context.Context, jobOpeningID, candidateID string) (*domain.Entry, error) {
+	var model models.PipelineEntry
+	err := r.db.DB(ctx).
+		Where("job_opening_id = ? AND candidate_id = ?", jobOpeningID, candidateID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("candidate %s is not in the pipeline of job opening %s", candidateID, jobOpeningID), err)
+		}
+		return nil, fmt.Errorf("failed to get pipeline entry: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// UpdateEntryStage guarda la etapa con compare-and-set sobre la etapa anterior, así un movimiento
+// manual y uno automático simultáneos no se pisan.
+func (r *repository) UpdateEntryStage(ctx context.Context, entry *domain.Entry, from string) error {
+	if entry == nil {
+		return errors.New("pipeline entry is nil")
+	}
+
+	model := models.FromDomainEntry(entry)
+	result := r.db.DB(ctx).Model(&models.PipelineEntry{}).
+		Where("id = ? AND stage = ?", entry.ID, from).
+		Select("stage", "entered_stage_at").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update pipeline entry: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrConflict, fmt.Sprintf("candidate is no longer in stage %s", from), nil)
+	}
+	return nil
+}
+
+func (r *repository) ListEntries(ctx context.Context, jobOpeningID string, spec *types.QuerySpec) (*types.Page[domain.Entry], error) {
+	query := r.db.DB(ctx).Model(&models.PipelineEntry{}).Where("job_opening_id = ?", jobOpeningID)
+	page, err := gorm.Paginate[models.PipelineEntry](query, spec)
+	if err != nil {
+		return nil, err
+	}
+	return types.MapPage(page, func(m models.PipelineEntry) domain.Entry { return *m.ToDomain() }), nil
+}
+
+func (r *repository) ListEntriesByCandidate(ctx context.Context, candidateID string) ([]domain.Entry, error) {
+	var ms []models.PipelineEntry
+	err := r.db.DB(ctx).
+		Where("candidate_id = ?", candidateID).
+		Order("created_at, id").
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipeline entries: %w", err)
+	}
+
+	entries := make([]domain.Entry, 0, len(ms))
+	for _, m := range ms {
+		entries = append(entries, *m.ToDomain())
+	}
+	return entries, nil
+}
+
+func (r *repository) CountByStage(ctx context.Context, jobOpeningID string) (map[string]int, error) {
+	var rows []struct {
+		Stage string
+		Count int
+	}
+	err := r.db.DB(ctx).Model(&models.PipelineEntry{}).
+		Select("stage, COUNT(*) AS count").
+		Where("job_opening_id = ?", jobOpeningID).
+		Group("stage").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pipeline entries: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Stage] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *repository) AppendTransition(ctx context.Context, transition *domain.Transition) error {
+	if transition == nil {
+		return errors.New("pipeline transition is nil")
+	}
+
+	model := models.FromDomainTransition(transition)
+	model.ID = uuid.New().String()
+	if err := r.db.DB(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to append pipeline transition: %w", err)
+	}
+	transition.ID = model.ID
+	return nil
+}
+
+func (r *repository) ListTransitions(ctx context.Context, entryID string) ([]domain.Transition, error) {
+	var ms []models.PipelineTransition
+	err := r.db.DB(ctx).
+		Where("entry_id = ?", entryID).
+		Order("occurred_at, id").
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipeline transitions: %w", err)
+	}
+
+	transitions := make([]domain.Transition, 0, len(ms))
+	for _, m := range ms {
+		transitions = append(transitions, m.ToDomain())
+	}
+	return transitions, nil
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+)
+
+// Pipeline guarda las etapas configuradas de una búsqueda. Las etapas van en JSON porque
+// siempre se leen y se reemplazan juntas.
+type Pipeline struct {
+	JobOpeningID             string    `gorm:"primaryKey;type:varchar(256)"`
+	Stages                   string    `gorm:"type:text;not null"`
+	AssessmentStage          string    `gorm:"type:varchar(50)"`
+	AssessmentCompletedStage string    `gorm:"type:varchar(50)"`
+	UpdatedBy                string    `gorm:"type:varchar(256)"`
+	CreatedAt                time.Time `gorm:"autoCreateTime"`
+	UpdatedAt                time.Time `gorm:"autoUpdateTime"`
+}
+
+// PipelineEntry es un candidato en el tablero de una búsqueda (uno por búsqueda y candidato).
+type PipelineEntry struct {
+	ID             string    `gorm:"primaryKey"`
+	JobOpeningID   string    `gorm:"type:varchar(256);not null;uniqueIndex:idx_pipeline_entries_opening_candidate"`
+	CandidateID    string    `gorm:"type:varchar(256);not null;uniqueIndex:idx_pipeline_entries_opening_candidate;index"`
+	Stage          string    `gorm:"type:varchar(50);not null;index"`
+	EnteredStageAt time.Time `gorm:"not null"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+// PipelineTransition es una entrada del historial de etapas; la tabla es append-only.
+type PipelineTransition struct {
+	ID           string    `gorm:"primaryKey"`
+	EntryID      string    `gorm:"index;not null"`
+	JobOpeningID string    `gorm:"type:varchar(256);not null"`
+	CandidateID  string    `gorm:"type:varchar(256);not null"`
+	FromStage    string    `gorm:"type:varchar(50)"` // Vacío al entrar al pipeline
+	ToStage      string    `gorm:"type:varchar(50);not null"`
+	Reason       string    `gorm:"type:text"`
+	Actor        string    `gorm:"type:varchar(256)"`
+	Automatic    bool      `gorm:"not null;default:false"`
+	OccurredAt   time.Time `gorm:"not null"`
+}
+
+type stage struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+func FromDomainPipeline(p *domain.Pipeline) *Pipeline {
+	stages := make([]stage, 0, len(p.Stages))
+	for _, s := range p.Stages {
+		stages = append(stages, stage{Key: s.Key, Name: s.Name})
+	}
+	data, _ := json.Marshal(stages)
+
+	return &Pipeline{
+		JobOpeningID:             p.JobOpeningID,
+		Stages:                   string(data),
+		AssessmentStage:          p.AssessmentStage,
+		AssessmentCompletedStage: p.AssessmentCompletedStage,
+		UpdatedBy:                p.UpdatedBy,
+		UpdatedAt:                p.UpdatedAt,
+	}
+}
+
+func (m Pipeline) ToDomain() *domain.Pipeline {
+	var stages []stage
+	_ = json.Unmarshal([]byte(m.Stages), &stages)
+
+	p := &domain.Pipeline{
+		JobOpeningID:             m.JobOpeningID,
+		Stages:                   make([]domain.Stage, 0, len(stages)),
+		AssessmentStage:          m.AssessmentStage,
+		AssessmentCompletedStage: m.AssessmentCompletedStage,
+		UpdatedBy:                m.UpdatedBy,
+		UpdatedAt:                m.UpdatedAt,
+	}
+	for _, s := range stages {
+		p.Stages = append(p.Stages, domain.Stage{Key: s.Key, Name: s.Name})
+	}
+	return p
+}
+
+func FromDomainEntry(e *domain.Entry) *PipelineEntry {
+	return &PipelineEntry{
+		ID:             e.ID,
+		JobOpeningID:   e.JobOpeningID,
+		CandidateID:    e.CandidateID,
+		Stage:          e.Stage,
+		EnteredStageAt: e.EnteredStageAt,
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
+	}
+}
+
+func (m PipelineEntry) ToDomain() *domain.Entry {
+	return &domain.Entry{
+		ID:             m.ID,
+		JobOpeningID:   m.JobOpeningID,
+		CandidateID:    m.CandidateID,
+		Stage:          m.Stage,
+		EnteredStageAt: m.EnteredStageAt,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func FromDomainTransition(t *domain.Transition) *PipelineTransition {
+	return &PipelineTransition{
+		ID:           t.ID,
+		EntryID:      t.EntryID,
+		JobOpeningID: t.JobOpeningID,
+		CandidateID:  t.CandidateID,
+		FromStage:    t.From,
+		ToStage:      t.To,
+		Reason:       t.Reason,
+		Actor:        t.Actor,
+		Automatic:    t.Automatic,
+		OccurredAt:   t.OccurredAt,
+	}
+}
+
+func (m PipelineTransition) ToDomain() domain.Transition {
+	return domain.Transition{
+		ID:           m.ID,
+		EntryID:      m.EntryID,
+		JobOpeningID: m.JobOpeningID,
+		CandidateID:  m.CandidateID,
+		From:         m.FromStage,
+		To:           m.ToStage,
+		Reason:       m.Reason,
+		Actor:        m.Actor,
+		Automatic:    m.Automatic,
+		OccurredAt:   m.OccurredAt,
+	}
+}
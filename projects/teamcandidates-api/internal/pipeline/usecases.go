@@ -0,0 +1,301 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+)
+
+// Tipos de recurso con los que se registran los cambios en el log de auditoría
+const (
+	auditResourcePipeline = "pipeline"
+	auditResourceEntry    = "pipeline_entry"
+)
+
+// actorSystem es el actor de las transiciones automáticas.
+const actorSystem = "system"
+
+type useCases struct {
+	repository   Repository
+	txManager    pkgtx.Manager
+	assessmentUc assessment.UseCases
+	candidateUc  candidate.UseCases
+	auditUc      audit.UseCases
+}
+
+func NewUseCases(
+	repo Repository,
+	tx pkgtx.Manager,
+	assessmentUC assessment.UseCases,
+	candidateUC candidate.UseCases,
+	ad audit.UseCases,
+) UseCases {
+	return &useCases{
+		repository:   repo,
+		txManager:    tx,
+		assessmentUc: assessmentUC,
+		candidateUc:  candidateUC,
+		auditUc:      ad,
+	}
+}
+
+func (u *useCases) GetPipeline(ctx context.Context, jobOpeningID string) (*domain.Pipeline, error) {
+	pipeline, err := u.repository.GetPipeline(ctx, jobOpeningID)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return domain.DefaultPipeline(jobOpeningID), nil
+		}
+		return nil, err
+	}
+	return pipeline, nil
+}
+
+// ConfigurePipeline reemplaza las etapas de la búsqueda. Una etapa solo se puede quitar si no
+// quedan candidatos en ella.
+func (u *useCases) ConfigurePipeline(ctx context.Context, pipeline *domain.Pipeline) (*domain.Pipeline, error) {
+	pipeline.Normalize()
+	if err := pipeline.Validate(); err != nil {
+		return nil, err
+	}
+
+	before, err := u.GetPipeline(ctx, pipeline.JobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := u.repository.CountByStage(ctx, pipeline.JobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+	for stage, count := range counts {
+		if _, ok := pipeline.Stage(stage); !ok && count > 0 {
+			return nil, types.NewError(types.ErrConflict, fmt.Sprintf("stage %s still has %d candidates", stage, count), nil)
+		}
+	}
+
+	pipeline.UpdatedBy = types.PrincipalIDFromContext(ctx)
+	pipeline.UpdatedAt = time.Now()
+	if err := u.repository.SavePipeline(ctx, pipeline); err != nil {
+		return nil, err
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourcePipeline, pipeline.JobOpeningID, before, pipeline)
+	return pipeline, nil
+}
+
+func (u *useCases) GetBoard(ctx context.Context, jobOpeningID string) ([]domain.StageCount, error) {
+	pipeline, err := u.GetPipeline(ctx, jobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := u.repository.CountByStage(ctx, jobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+
+	board := make([]domain.StageCount, 0, len(pipeline.Stages))
+	for _, s := range pipeline.Stages {
+		board = append(board, domain.StageCount{Stage: s, Count: counts[s.Key]})
+	}
+	return board, nil
+}
+
+func (u *useCases) AddCandidate(ctx context.Context, move *domain.Move) (*domain.Entry, error) {
+	if move == nil {
+		return nil, types.NewError(types.ErrValidation, "candidate is required", nil)
+	}
+	if _, err := u.candidateUc.GetCandidate(ctx, move.CandidateID); err != nil {
+		return nil, err
+	}
+	pipeline, err := u.GetPipeline(ctx, move.JobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.repository.GetEntry(ctx, move.JobOpeningID, move.CandidateID)
+	if err == nil {
+		return nil, types.NewError(types.ErrConflict, "candidate is already in the pipeline of this job opening", nil)
+	}
+	if !types.IsNotFound(err) {
+		return nil, err
+	}
+
+	to := strings.ToLower(strings.TrimSpace(move.To))
+	if to == "" {
+		to = pipeline.EntryStage()
+	}
+	now := time.Now()
+	entry := &domain.Entry{JobOpeningID: move.JobOpeningID, CandidateID: move.CandidateID, EnteredStageAt: now}
+	if err := pipeline.ValidateMove(entry, to, move.Reason); err != nil {
+		return nil, err
+	}
+	entry.Stage = to
+
+	reason := move.Reason
+	if reason == "" {
+		reason = "added to pipeline"
+	}
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		id, err := u.repository.CreateEntry(ctx, entry)
+		if err != nil {
+			return err
+		}
+		entry.ID = id
+		return u.repository.AppendTransition(ctx, &domain.Transition{
+			EntryID:      id,
+			JobOpeningID: entry.JobOpeningID,
+			CandidateID:  entry.CandidateID,
+			To:           to,
+			Reason:       reason,
+			Actor:        actorFrom(ctx),
+			OccurredAt:   now,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add candidate to pipeline: %w", err)
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionCreate, auditResourceEntry, entry.ID, nil, map[string]any{
+		"job_opening_id": entry.JobOpeningID,
+		"candidate_id":   entry.CandidateID,
+		"stage":          entry.Stage,
+	})
+	return entry, nil
+}
+
+func (u *useCases) MoveCandidate(ctx context.Context, move *domain.Move) (*domain.Entry, error) {
+	if move == nil {
+		return nil, types.NewError(types.ErrValidation, "stage is required", nil)
+	}
+	pipeline, err := u.GetPipeline(ctx, move.JobOpeningID)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := u.repository.GetEntry(ctx, move.JobOpeningID, move.CandidateID)
+	if err != nil {
+		return nil, err
+	}
+
+	to := strings.ToLower(strings.TrimSpace(move.To))
+	if err := pipeline.ValidateMove(entry, to, move.Reason); err != nil {
+		return nil, err
+	}
+	if err := u.move(ctx, entry, to, move.Reason, actorFrom(ctx), false); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (u *useCases) ListCandidates(ctx context.Context, jobOpeningID string, spec *types.QuerySpec) (*types.Page[domain.Entry], error) {
+	return u.repository.ListEntries(ctx, jobOpeningID, spec)
+}
+
+func (u *useCases) ListTransitions(ctx context.Context, jobOpeningID, candidateID string) ([]domain.Transition, error) {
+	entry, err := u.repository.GetEntry(ctx, jobOpeningID, candidateID)
+	if err != nil {
+		return nil, err
+	}
+	return u.repository.ListTransitions(ctx, entry.ID)
+}
+
+func (u *useCases) ListCandidateEntries(ctx context.Context, candidateID string) ([]domain.Entry, error) {
+	if _, err := u.candidateUc.GetCandidate(ctx, candidateID); err != nil {
+		return nil, err
+	}
+	return u.repository.ListEntriesByCandidate(ctx, candidateID)
+}
+
+// CompleteAssessment se llama cuando la evaluación quedó corregida. El candidato avanza solo en
+// las búsquedas en las que sigue en la etapa de evaluación; si ya lo movieron a mano no se toca.
+// Si la evaluación se creó para una búsqueda, solo avanza en esa.
+func (u *useCases) CompleteAssessment(ctx context.Context, assessmentID string) (int, error) {
+	a, err := u.assessmentUc.GetAssessment(ctx, assessmentID)
+	if err != nil {
+		return 0, err
+	}
+	entries, err := u.repository.ListEntriesByCandidate(ctx, a.CandidateID)
+	if err != nil {
+		return 0, err
+	}
+
+	moved := 0
+	var errs []error
+	reason := fmt.Sprintf("assessment %s completed", assessmentID)
+	for i := range entries {
+		entry := &entries[i]
+		if a.JobOpeningID != "" && entry.JobOpeningID != a.JobOpeningID {
+			continue
+		}
+		pipeline, err := u.GetPipeline(ctx, entry.JobOpeningID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("job opening %s: %w", entry.JobOpeningID, err))
+			continue
+		}
+		if pipeline.AssessmentStage == "" || entry.Stage != pipeline.AssessmentStage {
+			continue
+		}
+
+		if err := u.move(ctx, entry, pipeline.AssessmentCompletedStage, reason, actorSystem, true); err != nil {
+			// Si lo movieron a mano mientras tanto, la transición automática ya no aplica
+			if !types.IsConflict(err) {
+				errs = append(errs, fmt.Errorf("job opening %s: %w", entry.JobOpeningID, err))
+			}
+			continue
+		}
+		log.Printf("pipeline: candidate %s moved to %s in job opening %s", entry.CandidateID, entry.Stage, entry.JobOpeningID)
+		moved++
+	}
+	return moved, errors.Join(errs...)
+}
+
+// move cambia la etapa y la agrega al historial en una transacción, y la registra en la auditoría.
+func (u *useCases) move(ctx context.Context, entry *domain.Entry, to, reason, actor string, automatic bool) error {
+	from, enteredAt := entry.Stage, entry.EnteredStageAt
+	now := time.Now()
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		entry.Stage, entry.EnteredStageAt = to, now
+		if err := u.repository.UpdateEntryStage(ctx, entry, from); err != nil {
+			return err
+		}
+		return u.repository.AppendTransition(ctx, &domain.Transition{
+			EntryID:      entry.ID,
+			JobOpeningID: entry.JobOpeningID,
+			CandidateID:  entry.CandidateID,
+			From:         from,
+			To:           to,
+			Reason:       reason,
+			Actor:        actor,
+			Automatic:    automatic,
+			OccurredAt:   now,
+		})
+	})
+	if err != nil {
+		entry.Stage, entry.EnteredStageAt = from, enteredAt
+		return err
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourceEntry, entry.ID,
+		map[string]any{"stage": from},
+		map[string]any{"stage": to, "reason": reason},
+	)
+	return nil
+}
+
+// actorFrom devuelve el principal autenticado o "system" si el request no trae uno.
+func actorFrom(ctx context.Context) string {
+	if id := types.PrincipalIDFromContext(ctx); id != "" {
+		return id
+	}
+	return actorSystem
+}
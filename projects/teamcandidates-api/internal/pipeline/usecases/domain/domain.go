@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// Etapas predefinidas del proceso de selección. Hired y rejected son las etapas finales y
+// tienen que estar en todos los pipelines.
+const (
+	StageApplied    = "applied"
+	StageScreening  = "screening"
+	StageAssessment = "assessment"
+	StageInterview  = "interview"
+	StageOffer      = "offer"
+	StageHired      = "hired"
+	StageRejected   = "rejected"
+)
+
+const (
+	maxStages      = 20
+	maxStageName   = 100
+	maxReasonChars = 500
+)
+
+var stageKeyPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Stage es una columna del tablero de una búsqueda.
+type Stage struct {
+	Key  string // Identificador (slug) de la etapa
+	Name string // Nombre para mostrar
+}
+
+// IsTerminal indica si la etapa cierra el proceso del candidato.
+func (s Stage) IsTerminal() bool {
+	return IsTerminalStage(s.Key)
+}
+
+func IsTerminalStage(key string) bool {
+	return key == StageHired || key == StageRejected
+}
+
+// Pipeline son las etapas configuradas para una búsqueda, en el orden del tablero.
+type Pipeline struct {
+	JobOpeningID string
+	Stages       []Stage
+	// AssessmentStage es la etapa en la que el candidato rinde la evaluación; al completarla pasa
+	// automáticamente a AssessmentCompletedStage. Vacío desactiva la transición automática.
+	AssessmentStage          string
+	AssessmentCompletedStage string
+	UpdatedBy                string
+	UpdatedAt                time.Time
+}
+
+// DefaultPipeline devuelve el pipeline que usa una búsqueda mientras no se configure otro.
+func DefaultPipeline(jobOpeningID string) *Pipeline {
+	return &Pipeline{
+		JobOpeningID: jobOpeningID,
+		Stages: []Stage{
+			{Key: StageApplied, Name: "Applied"},
+			{Key: StageScreening, Name: "Screening"},
+			{Key: StageAssessment, Name: "Assessment"},
+			{Key: StageInterview, Name: "Interview"},
+			{Key: StageOffer, Name: "Offer"},
+			{Key: StageHired, Name: "Hired"},
+			{Key: StageRejected, Name: "Rejected"},
+		},
+		AssessmentStage:          StageAssessment,
+		AssessmentCompletedStage: StageInterview,
+	}
+}
+
+// Normalize limpia las claves y completa el nombre de las etapas que no lo traen.
+func (p *Pipeline) Normalize() {
+	for i := range p.Stages {
+		p.Stages[i].Key = strings.ToLower(strings.TrimSpace(p.Stages[i].Key))
+		p.Stages[i].Name = strings.TrimSpace(p.Stages[i].Name)
+		if p.Stages[i].Name == "" {
+			p.Stages[i].Name = p.Stages[i].Key
+		}
+	}
+	p.AssessmentStage = strings.ToLower(strings.TrimSpace(p.AssessmentStage))
+	p.AssessmentCompletedStage = strings.ToLower(strings.TrimSpace(p.AssessmentCompletedStage))
+}
+
+func (p *Pipeline) Validate() error {
+	if strings.TrimSpace(p.JobOpeningID) == "" {
+		return types.NewError(types.ErrValidation, "job opening id is required", nil)
+	}
+	if len(p.Stages) == 0 || len(p.Stages) > maxStages {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("a pipeline must have between 1 and %d stages", maxStages), nil)
+	}
+
+	seen := make(map[string]bool, len(p.Stages))
+	for _, s := range p.Stages {
+		if !stageKeyPattern.MatchString(s.Key) || len(s.Key) > 50 {
+			return types.NewError(types.ErrValidation, fmt.Sprintf("invalid stage key %q (use lowercase letters, digits and dashes)", s.Key), nil)
+		}
+		if len(s.Name) > maxStageName {
+			return types.NewError(types.ErrValidation, fmt.Sprintf("stage %s name is too long", s.Key), nil)
+		}
+		if seen[s.Key] {
+			return types.NewError(types.ErrValidation, fmt.Sprintf("stage %s is repeated", s.Key), nil)
+		}
+		seen[s.Key] = true
+	}
+	if !seen[StageHired] || !seen[StageRejected] {
+		return types.NewError(types.ErrValidation, "a pipeline must include the hired and rejected stages", nil)
+	}
+	if p.Stages[0].IsTerminal() {
+		return types.NewError(types.ErrValidation, "the first stage cannot be hired or rejected", nil)
+	}
+
+	if p.AssessmentStage == "" {
+		if p.AssessmentCompletedStage != "" {
+			return types.NewError(types.ErrValidation, "assessment completed stage requires an assessment stage", nil)
+		}
+		return nil
+	}
+	if !seen[p.AssessmentStage] || IsTerminalStage(p.AssessmentStage) {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("assessment stage %q must be a non final stage of the pipeline", p.AssessmentStage), nil)
+	}
+	if !seen[p.AssessmentCompletedStage] || p.AssessmentCompletedStage == p.AssessmentStage {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("assessment completed stage %q must be another stage of the pipeline", p.AssessmentCompletedStage), nil)
+	}
+	return nil
+}
+
+// Stage devuelve la etapa con la clave indicada.
+func (p *Pipeline) Stage(key string) (Stage, bool) {
+	for _, s := range p.Stages {
+		if s.Key == key {
+			return s, true
+		}
+	}
+	return Stage{}, false
+}
+
+// EntryStage es la etapa en la que entran los candidatos que se agregan sin indicar otra.
+func (p *Pipeline) EntryStage() string {
+	return p.Stages[0].Key
+}
+
+// Entry es un candidato en el tablero de una búsqueda.
+type Entry struct {
+	ID             string
+	JobOpeningID   string
+	CandidateID    string
+	Stage          string
+	EnteredStageAt time.Time // Desde cuándo está en la etapa actual
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Transition es un movimiento de un candidato entre etapas.
+type Transition struct {
+	ID           string
+	EntryID      string
+	JobOpeningID string
+	CandidateID  string
+	From         string // Vacío cuando el candidato entra al pipeline
+	To           string
+	Reason       string
+	Actor        string // Principal que movió al candidato o "system"
+	Automatic    bool   // Transición hecha por el sistema (p. ej., al completar la evaluación)
+	OccurredAt   time.Time
+}
+
+// Move es el pedido de mover a un candidato de etapa.
+type Move struct {
+	JobOpeningID string
+	CandidateID  string
+	To           string
+	Reason       string
+}
+
+// ValidateMove aplica las reglas del tablero: el candidato puede moverse entre cualquier par de
+// etapas, pero rechazarlo o reabrir un proceso cerrado requiere un motivo.
+func (p *Pipeline) ValidateMove(entry *Entry, to, reason string) error {
+	if _, ok := p.Stage(to); !ok {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("stage %q is not part of the pipeline", to), nil)
+	}
+	if entry.Stage == to {
+		return types.NewError(types.ErrConflict, fmt.Sprintf("candidate is already in stage %s", to), nil)
+	}
+	if len(reason) > maxReasonChars {
+		return types.NewError(types.ErrValidation, fmt.Sprintf("reason must have at most %d characters", maxReasonChars), nil)
+	}
+	if strings.TrimSpace(reason) == "" && (to == StageRejected || IsTerminalStage(entry.Stage)) {
+		return types.NewError(types.ErrValidation, "a reason is required to reject a candidate or reopen a closed process", nil)
+	}
+	return nil
+}
+
+// StageCount es la cantidad de candidatos en una etapa del tablero.
+type StageCount struct {
+	Stage
+	Count int
+}
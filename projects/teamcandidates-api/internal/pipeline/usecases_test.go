@@ -0,0 +1,513 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	mock_assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/mocks"
+	mock_audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/mocks"
+	mock_candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/mocks"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	candomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline/usecases/domain"
+)
+
+// fields usa el repositorio y las transacciones en memoria reales y mockea los casos de uso de
+// los que depende el tablero.
+type fields struct {
+	repository Repository
+	db         mapdb.Repository
+	assessment *mock_assessment.MockUseCases
+	candidate  *mock_candidate.MockUseCases
+	audit      *mock_audit.MockUseCases
+}
+
+func newFields(ctrl *gomock.Controller) *fields {
+	db := mapdb.Bootstrap()
+	f := &fields{
+		repository: NewMemoryRepository(db),
+		db:         db,
+		assessment: mock_assessment.NewMockUseCases(ctrl),
+		candidate:  mock_candidate.NewMockUseCases(ctrl),
+		audit:      mock_audit.NewMockUseCases(ctrl),
+	}
+	f.audit.EXPECT().RecordChange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return f
+}
+
+func (f *fields) useCases() UseCases {
+	return NewUseCases(f.repository, mapdb.NewTxManager(f.db), f.assessment, f.candidate, f.audit)
+}
+
+// seed deja al candidato en la etapa indicada del tablero de la búsqueda.
+func (f *fields) seed(t *testing.T, jobOpeningID, candidateID, stage string) *domain.Entry {
+	entry := &domain.Entry{JobOpeningID: jobOpeningID, CandidateID: candidateID, Stage: stage, EnteredStageAt: time.Now()}
+	id, err := f.repository.CreateEntry(context.Background(), entry)
+	assert.NoError(t, err)
+	entry.ID = id
+	return entry
+}
+
+func (f *fields) expectCandidate(candidateID string) {
+	f.candidate.EXPECT().GetCandidate(gomock.Any(), candidateID).Return(&candomain.Candidate{ID: candidateID}, nil)
+}
+
+// customPipeline es un pipeline sin etapa de evaluación.
+func customPipeline(jobOpeningID string) *domain.Pipeline {
+	return &domain.Pipeline{
+		JobOpeningID: jobOpeningID,
+		Stages: []domain.Stage{
+			{Key: " Sourced "},
+			{Key: "tech-interview", Name: "Tech interview"},
+			{Key: domain.StageHired, Name: "Hired"},
+			{Key: domain.StageRejected, Name: "Rejected"},
+		},
+	}
+}
+
+func TestConfigurePipeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name      string
+		pipeline  *domain.Pipeline
+		setup     func(t *testing.T, f *fields)
+		wantErr   func(error) bool
+		wantStage []string
+	}{
+		{
+			name:      "Success: stages are normalized and stored",
+			pipeline:  customPipeline("job1"),
+			setup:     func(t *testing.T, f *fields) {},
+			wantStage: []string{"sourced", "tech-interview", domain.StageHired, domain.StageRejected},
+		},
+		{
+			name:     "Success: stages without candidates can be removed",
+			pipeline: customPipeline("job1"),
+			setup: func(t *testing.T, f *fields) {
+				f.seed(t, "job1", "cand1", domain.StageHired)
+				f.seed(t, "job2", "cand2", domain.StageScreening)
+			},
+			wantStage: []string{"sourced", "tech-interview", domain.StageHired, domain.StageRejected},
+		},
+		{
+			name:     "Error: removed stage still has candidates",
+			pipeline: customPipeline("job1"),
+			setup: func(t *testing.T, f *fields) {
+				f.seed(t, "job1", "cand1", domain.StageScreening)
+			},
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Error: pipeline without final stages",
+			pipeline: &domain.Pipeline{
+				JobOpeningID: "job1",
+				Stages:       []domain.Stage{{Key: "applied"}, {Key: domain.StageHired}},
+			},
+			setup:   func(t *testing.T, f *fields) {},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name: "Error: assessment completed stage outside the pipeline",
+			pipeline: &domain.Pipeline{
+				JobOpeningID:             "job1",
+				Stages:                   customPipeline("job1").Stages,
+				AssessmentStage:          "tech-interview",
+				AssessmentCompletedStage: domain.StageOffer,
+			},
+			setup:   func(t *testing.T, f *fields) {},
+			wantErr: types.IsValidationError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(t, f)
+			ctx := types.WithPrincipal(context.Background(), &types.Principal{ID: "hr1"})
+
+			pipeline, err := f.useCases().ConfigurePipeline(ctx, tc.pipeline)
+
+			if tc.wantErr != nil {
+				assert.True(t, tc.wantErr(err), "unexpected error %v", err)
+				current, err := f.useCases().GetPipeline(ctx, "job1")
+				assert.NoError(t, err)
+				assert.Equal(t, domain.DefaultPipeline("job1"), current, "rejected configuration must not be stored")
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, "hr1", pipeline.UpdatedBy)
+
+			stored, err := f.useCases().GetPipeline(ctx, "job1")
+			assert.NoError(t, err)
+			var keys []string
+			for _, s := range stored.Stages {
+				keys = append(keys, s.Key)
+			}
+			assert.Equal(t, tc.wantStage, keys, "stages mismatch")
+			assert.Equal(t, "sourced", stored.Stages[0].Name, "stage without name uses the key")
+		})
+	}
+}
+
+func TestGetBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newFields(ctrl)
+	f.seed(t, "job1", "cand1", domain.StageApplied)
+	f.seed(t, "job1", "cand2", domain.StageApplied)
+	f.seed(t, "job1", "cand3", domain.StageInterview)
+	f.seed(t, "job2", "cand1", domain.StageInterview)
+
+	board, err := f.useCases().GetBoard(context.Background(), "job1")
+	assert.NoError(t, err, "expected no error but got one")
+
+	counts := make(map[string]int, len(board))
+	var order []string
+	for _, s := range board {
+		counts[s.Key] = s.Count
+		order = append(order, s.Key)
+	}
+	assert.Equal(t, []string{
+		domain.StageApplied, domain.StageScreening, domain.StageAssessment, domain.StageInterview,
+		domain.StageOffer, domain.StageHired, domain.StageRejected,
+	}, order, "board should follow the pipeline order")
+	assert.Equal(t, 2, counts[domain.StageApplied])
+	assert.Equal(t, 1, counts[domain.StageInterview])
+	assert.Equal(t, 0, counts[domain.StageOffer])
+}
+
+func TestAddCandidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name       string
+		move       *domain.Move
+		setup      func(t *testing.T, f *fields)
+		wantErr    func(error) bool
+		wantStage  string
+		wantReason string
+	}{
+		{
+			name: "Success: candidate enters the first stage",
+			move: &domain.Move{JobOpeningID: "job1", CandidateID: "cand1"},
+			setup: func(t *testing.T, f *fields) {
+				f.expectCandidate("cand1")
+			},
+			wantStage:  domain.StageApplied,
+			wantReason: "added to pipeline",
+		},
+		{
+			name: "Success: candidate enters the requested stage",
+			move: &domain.Move{JobOpeningID: "job1", CandidateID: "cand1", To: " Screening ", Reason: "referral"},
+			setup: func(t *testing.T, f *fields) {
+				f.expectCandidate("cand1")
+			},
+			wantStage:  domain.StageScreening,
+			wantReason: "referral",
+		},
+		{
+			name: "Error: candidate already in the pipeline",
+			move: &domain.Move{JobOpeningID: "job1", CandidateID: "cand1"},
+			setup: func(t *testing.T, f *fields) {
+				f.expectCandidate("cand1")
+				f.seed(t, "job1", "cand1", domain.StageInterview)
+			},
+			wantErr: types.IsConflict,
+		},
+		{
+			name: "Error: unknown candidate",
+			move: &domain.Move{JobOpeningID: "job1", CandidateID: "cand1"},
+			setup: func(t *testing.T, f *fields) {
+				f.candidate.EXPECT().
+					GetCandidate(gomock.Any(), "cand1").
+					Return(nil, types.NewError(types.ErrNotFound, "candidate not found", nil))
+			},
+			wantErr: types.IsNotFound,
+		},
+		{
+			name: "Error: stage is not part of the pipeline",
+			move: &domain.Move{JobOpeningID: "job1", CandidateID: "cand1", To: "sourced"},
+			setup: func(t *testing.T, f *fields) {
+				f.expectCandidate("cand1")
+			},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name: "Error: rejected without reason",
+			move: &domain.Move{JobOpeningID: "job1", CandidateID: "cand1", To: domain.StageRejected},
+			setup: func(t *testing.T, f *fields) {
+				f.expectCandidate("cand1")
+			},
+			wantErr: types.IsValidationError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(t, f)
+			ctx := types.WithPrincipal(context.Background(), &types.Principal{ID: "hr1"})
+
+			entry, err := f.useCases().AddCandidate(ctx, tc.move)
+
+			if tc.wantErr != nil {
+				assert.True(t, tc.wantErr(err), "unexpected error %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.NotEmpty(t, entry.ID)
+			assert.Equal(t, tc.wantStage, entry.Stage, "stage mismatch")
+
+			transitions, err := f.repository.ListTransitions(ctx, entry.ID)
+			assert.NoError(t, err)
+			assert.Len(t, transitions, 1)
+			assert.Equal(t, "", transitions[0].From, "entering the pipeline has no previous stage")
+			assert.Equal(t, tc.wantStage, transitions[0].To)
+			assert.Equal(t, tc.wantReason, transitions[0].Reason)
+			assert.Equal(t, "hr1", transitions[0].Actor)
+		})
+	}
+}
+
+func TestMoveCandidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name      string
+		from      string
+		move      *domain.Move
+		principal string
+		wantErr   func(error) bool
+	}{
+		{
+			name:      "Success: candidate moved by HR",
+			from:      domain.StageApplied,
+			move:      &domain.Move{To: domain.StageOffer},
+			principal: "hr1",
+		},
+		{
+			name:      "Success: rejected with reason",
+			from:      domain.StageInterview,
+			move:      &domain.Move{To: domain.StageRejected, Reason: "salary expectations"},
+			principal: "hr1",
+		},
+		{
+			name: "Success: reopened closed process by the system",
+			from: domain.StageRejected,
+			move: &domain.Move{To: domain.StageScreening, Reason: "new opening"},
+		},
+		{
+			name:    "Error: reopen without reason",
+			from:    domain.StageHired,
+			move:    &domain.Move{To: domain.StageOffer},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name:    "Error: candidate already in the stage",
+			from:    domain.StageInterview,
+			move:    &domain.Move{To: "Interview"},
+			wantErr: types.IsConflict,
+		},
+		{
+			name:    "Error: candidate not in the pipeline",
+			move:    &domain.Move{To: domain.StageInterview},
+			wantErr: types.IsNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			ctx := context.Background()
+			if tc.principal != "" {
+				ctx = types.WithPrincipal(ctx, &types.Principal{ID: tc.principal})
+			}
+			if tc.from != "" {
+				f.seed(t, "job1", "cand1", tc.from)
+			}
+			tc.move.JobOpeningID, tc.move.CandidateID = "job1", "cand1"
+
+			entry, err := f.useCases().MoveCandidate(ctx, tc.move)
+
+			if tc.wantErr != nil {
+				assert.True(t, tc.wantErr(err), "unexpected error %v", err)
+				if tc.from != "" {
+					stored, err := f.repository.GetEntry(ctx, "job1", "cand1")
+					assert.NoError(t, err)
+					assert.Equal(t, tc.from, stored.Stage, "rejected move must not change the stage")
+				}
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.move.To, entry.Stage, "stage mismatch")
+
+			transitions, err := f.useCases().ListTransitions(ctx, "job1", "cand1")
+			assert.NoError(t, err)
+			assert.Len(t, transitions, 1)
+			assert.Equal(t, tc.from, transitions[0].From)
+			assert.Equal(t, tc.move.To, transitions[0].To)
+			assert.Equal(t, tc.move.Reason, transitions[0].Reason)
+			assert.Equal(t, wantActor(tc.principal), transitions[0].Actor, "actor mismatch")
+			assert.False(t, transitions[0].Automatic)
+		})
+	}
+}
+
+// wantActor es el actor esperado de un movimiento hecho con o sin principal.
+func wantActor(principal string) string {
+	if principal == "" {
+		return actorSystem
+	}
+	return principal
+}
+
+func TestCompleteAssessment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		jobOpeningID string
+		setup        func(t *testing.T, f *fields)
+		wantMoved    int
+		wantStages   map[string]string
+		wantErr      bool
+	}{
+		{
+			name: "Success: candidate advances in every opening waiting for the assessment",
+			setup: func(t *testing.T, f *fields) {
+				f.seed(t, "job1", "cand1", domain.StageAssessment)
+				f.seed(t, "job2", "cand1", domain.StageAssessment)
+				f.seed(t, "job3", "cand1", domain.StageOffer)
+			},
+			wantMoved: 2,
+			wantStages: map[string]string{
+				"job1": domain.StageInterview,
+				"job2": domain.StageInterview,
+				"job3": domain.StageOffer,
+			},
+		},
+		{
+			name:         "Success: assessment of an opening only advances in that opening",
+			jobOpeningID: "job2",
+			setup: func(t *testing.T, f *fields) {
+				f.seed(t, "job1", "cand1", domain.StageAssessment)
+				f.seed(t, "job2", "cand1", domain.StageAssessment)
+			},
+			wantMoved: 1,
+			wantStages: map[string]string{
+				"job1": domain.StageAssessment,
+				"job2": domain.StageInterview,
+			},
+		},
+		{
+			name: "Success: configured stages are used and pipelines without assessment stage are skipped",
+			setup: func(t *testing.T, f *fields) {
+				ctx := context.Background()
+				withAssessment := customPipeline("job1")
+				withAssessment.AssessmentStage, withAssessment.AssessmentCompletedStage = "sourced", "tech-interview"
+				withAssessment.Normalize()
+				assert.NoError(t, f.repository.SavePipeline(ctx, withAssessment))
+				withoutAssessment := customPipeline("job2")
+				withoutAssessment.Normalize()
+				assert.NoError(t, f.repository.SavePipeline(ctx, withoutAssessment))
+
+				f.seed(t, "job1", "cand1", "sourced")
+				f.seed(t, "job2", "cand1", "sourced")
+			},
+			wantMoved: 1,
+			wantStages: map[string]string{
+				"job1": "tech-interview",
+				"job2": "sourced",
+			},
+		},
+		{
+			name:       "Success: candidate without pipelines",
+			setup:      func(t *testing.T, f *fields) {},
+			wantStages: map[string]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFields(ctrl)
+			tc.setup(t, f)
+			f.assessment.EXPECT().
+				GetAssessment(gomock.Any(), "ass1").
+				Return(&assdomain.Assessment{ID: "ass1", CandidateID: "cand1", JobOpeningID: tc.jobOpeningID}, nil)
+
+			moved, err := f.useCases().CompleteAssessment(ctx, "ass1")
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, tc.wantMoved, moved, "moved candidates mismatch")
+
+			for jobOpeningID, stage := range tc.wantStages {
+				entry, err := f.repository.GetEntry(ctx, jobOpeningID, "cand1")
+				assert.NoError(t, err)
+				assert.Equal(t, stage, entry.Stage, "stage of %s mismatch", jobOpeningID)
+
+				transitions, err := f.repository.ListTransitions(ctx, entry.ID)
+				assert.NoError(t, err)
+				if len(transitions) == 0 {
+					continue
+				}
+				assert.True(t, transitions[0].Automatic, "assessment transitions are automatic")
+				assert.Equal(t, actorSystem, transitions[0].Actor)
+				assert.Equal(t, "assessment ass1 completed", transitions[0].Reason)
+			}
+		})
+	}
+
+	t.Run("Error: assessment not found", func(t *testing.T) {
+		f := newFields(ctrl)
+		f.assessment.EXPECT().
+			GetAssessment(gomock.Any(), "ass1").
+			Return(nil, types.NewError(types.ErrNotFound, "assessment not found", nil))
+
+		moved, err := f.useCases().CompleteAssessment(context.Background(), "ass1")
+		assert.True(t, types.IsNotFound(err), "unexpected error %v", err)
+		assert.Zero(t, moved)
+	})
+}
+
+// conflictRepository simula que otro usuario movió al candidato entre la lectura y la escritura.
+type conflictRepository struct {
+	Repository
+}
+
+func (r conflictRepository) UpdateEntryStage(context.Context, *domain.Entry, string) error {
+	return types.NewError(types.ErrConflict, "candidate is no longer in stage assessment", nil)
+}
+
+func TestCompleteAssessmentIgnoresManualMoves(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newFields(ctrl)
+	f.seed(t, "job1", "cand1", domain.StageAssessment)
+	f.repository = conflictRepository{Repository: f.repository}
+	f.assessment.EXPECT().
+		GetAssessment(gomock.Any(), "ass1").
+		Return(&assdomain.Assessment{ID: "ass1", CandidateID: "cand1"}, nil)
+
+	moved, err := f.useCases().CompleteAssessment(context.Background(), "ass1")
+	assert.NoError(t, err, "a concurrent manual move is not an error")
+	assert.Zero(t, moved)
+
+	entry, err := f.repository.GetEntry(context.Background(), "job1", "cand1")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StageAssessment, entry.Stage)
+}
@@ -0,0 +1,174 @@
+package problem
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	gsv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/handler/dto"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{
+		ucs: u,
+		gsv: s,
+		mws: m,
+	}
+}
+
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/problems"
+	protectedPrefix := apiBase + "/protected"
+
+	// Rutas protegidas
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionAssessmentRead, types.PermissionAssessmentWrite))
+
+		protected.POST("", h.CreateProblem)                                           // Agregar un problema al banco
+		protected.GET("", mdw.ParseQuerySpec(dto.ProblemQuerySchema), h.ListProblems) // Listar (paginado; ?tag= y ?skill= opcionales)
+		protected.POST("/assemble", h.AssembleAssessment)                             // Armar una evaluación para un candidato
+		protected.GET("/:id", h.GetProblem)                                           // Problema con su versión vigente (o ?version=N)
+		protected.PUT("/:id", h.UpdateProblem)                                        // Editar; si cambia el contenido crea una versión
+		protected.GET("/:id/versions", h.ListVersions)                                // Historial de versiones
+	}
+}
+
+func (h *Handler) CreateProblem(c *gin.Context) {
+	var req dto.Problem
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	problemID, err := h.ucs.CreateProblem(c.Request.Context(), req.ToDomain())
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateProblemResponse{
+		Message:   "Problem created successfully",
+		ProblemID: problemID,
+	})
+}
+
+func (h *Handler) ListProblems(c *gin.Context) {
+	spec, err := mdw.GetQuerySpec(c)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	filter := domain.Filter{Tag: c.Query("tag"), Skill: c.Query("skill")}
+	page, err := h.ucs.ListProblems(c.Request.Context(), spec, filter)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainListItem), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *Handler) GetProblem(c *gin.Context) {
+	version := 0
+	if raw := c.Query("version"); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			apiErr, errCode := types.NewAPIError(types.NewError(types.ErrValidation, "version must be a positive integer", err))
+			c.Error(apiErr).SetMeta(errCode)
+			return
+		}
+		version = v
+	}
+
+	problem, err := h.ucs.GetProblem(c.Request.Context(), c.Param("id"), version)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(problem))
+}
+
+func (h *Handler) UpdateProblem(c *gin.Context) {
+	var req dto.UpdateProblem
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	update := req.ToDomain()
+	update.ID = c.Param("id")
+	problem, err := h.ucs.UpdateProblem(c.Request.Context(), update, req.BaseVersion)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(problem))
+}
+
+func (h *Handler) ListVersions(c *gin.Context) {
+	id := c.Param("id")
+	versions, err := h.ucs.ListVersions(c.Request.Context(), id)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainVersions(id, versions))
+}
+
+func (h *Handler) AssembleAssessment(c *gin.Context) {
+	var req dto.AssembleAssessment
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	ctx := c.Request.Context()
+	assembly, err := req.ToDomain(types.PrincipalIDFromContext(ctx))
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	result, err := h.ucs.AssembleAssessment(ctx, assembly)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.FromDomainAssembly(result))
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+// AssembleAssessment pide armar una evaluación desde el banco para el candidato.
+type AssembleAssessment struct {
+	CandidateID string   `json:"candidate_id" binding:"required"`
+	Skills      []Skill  `json:"skills" binding:"required,min=1,dive"`
+	Tags        []string `json:"tags"`
+	MaxDuration string   `json:"max_duration" binding:"required"` // Duración de Go, p. ej. "90m"
+}
+
+func (a AssembleAssessment) ToDomain(hrID string) (*domain.AssemblyRequest, error) {
+	duration, err := time.ParseDuration(a.MaxDuration)
+	if err != nil {
+		return nil, types.NewError(types.ErrValidation, fmt.Sprintf("invalid max_duration: %v", err), err)
+	}
+
+	skills := make([]assdomain.SkillConfig, 0, len(a.Skills))
+	for _, s := range a.Skills {
+		skills = append(skills, assdomain.SkillConfig{SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	return &domain.AssemblyRequest{
+		CandidateID: a.CandidateID,
+		HRID:        hrID,
+		Skills:      skills,
+		Tags:        a.Tags,
+		MaxDuration: duration,
+	}, nil
+}
+
+type AssemblyResponse struct {
+	Message          string   `json:"message"`
+	AssessmentID     string   `json:"assessment_id"`
+	ProblemID        string   `json:"problem_id"`
+	Version          int      `json:"version"`
+	Difficulty       string   `json:"difficulty"`
+	TargetDifficulty string   `json:"target_difficulty"`
+	MatchedSkills    []string `json:"matched_skills"`
+}
+
+func FromDomainAssembly(a *domain.Assembly) AssemblyResponse {
+	return AssemblyResponse{
+		Message:          "Assessment assembled successfully",
+		AssessmentID:     a.AssessmentID,
+		ProblemID:        a.ProblemID,
+		Version:          a.Version,
+		Difficulty:       string(a.Difficulty),
+		TargetDifficulty: string(a.Target),
+		MatchedSkills:    a.MatchedSkills,
+	}
+}
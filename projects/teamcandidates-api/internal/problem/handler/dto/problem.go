@@ -0,0 +1,181 @@
+package dto
+
+import (
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+// ProblemQuerySchema define los campos por los que se puede filtrar, ordenar y seleccionar en el
+// listado. Los filtros por tag y skill van aparte (?tag=...&skill=...).
+var ProblemQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":              {Column: "id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"title":           {Column: "title", Type: types.FieldString, Filterable: true, Sortable: true},
+		"difficulty":      {Column: "difficulty", Type: types.FieldString, Filterable: true, Sortable: true},
+		"archived":        {Column: "archived", Type: types.FieldBool, Filterable: true},
+		"current_version": {Column: "current_version", Type: types.FieldInt, Filterable: true, Sortable: true},
+		"created_at":      {Column: "created_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"updated_at":      {Column: "updated_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"-created_at"},
+}
+
+// Problem es el body para crear o editar un problema del banco.
+type Problem struct {
+	Title       string     `json:"title" binding:"required,max=200"`
+	Difficulty  string     `json:"difficulty" binding:"required,oneof=easy medium hard"`
+	Tags        []string   `json:"tags"`
+	Skills      []Skill    `json:"skills" binding:"required,min=1,dive"`
+	Statement   string     `json:"statement" binding:"required"`
+	Language    string     `json:"language"`
+	StarterCode string     `json:"starter_code"`
+	UnitTests   []UnitTest `json:"unit_tests" binding:"required,min=1,dive"`
+	Archived    bool       `json:"archived"`
+}
+
+// UpdateProblem agrega la versión sobre la que se hizo la edición; si se indica y el problema
+// ya tiene una más nueva, la edición se rechaza con un conflicto.
+type UpdateProblem struct {
+	Problem
+	BaseVersion int `json:"base_version"`
+}
+
+type Skill struct {
+	SkillName  string `json:"skill_name" binding:"required"`
+	SkillLevel string `json:"skill_level"`
+}
+
+type UnitTest struct {
+	TestName       string `json:"test_name" binding:"required"`
+	InputData      string `json:"input_data"`
+	ExpectedOutput string `json:"expected_output"`
+	Hidden         bool   `json:"hidden"`
+}
+
+func (p Problem) ToDomain() *domain.Problem {
+	skills := make([]domain.Skill, 0, len(p.Skills))
+	for _, s := range p.Skills {
+		skills = append(skills, domain.Skill{SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	tests := make([]domain.UnitTest, 0, len(p.UnitTests))
+	for _, t := range p.UnitTests {
+		tests = append(tests, domain.UnitTest{
+			TestName:       t.TestName,
+			InputData:      t.InputData,
+			ExpectedOutput: t.ExpectedOutput,
+			Hidden:         t.Hidden,
+		})
+	}
+
+	return &domain.Problem{
+		Title:      p.Title,
+		Difficulty: domain.Difficulty(p.Difficulty),
+		Tags:       p.Tags,
+		Skills:     skills,
+		Archived:   p.Archived,
+		Current: domain.Version{
+			Statement:   p.Statement,
+			Language:    p.Language,
+			StarterCode: p.StarterCode,
+			UnitTests:   tests,
+		},
+	}
+}
+
+type CreateProblemResponse struct {
+	Message   string `json:"message"`
+	ProblemID string `json:"problem_id"`
+}
+
+// ProblemListItem es la vista de un problema en el listado, sin el contenido.
+type ProblemListItem struct {
+	ID             string    `json:"id"`
+	Title          string    `json:"title"`
+	Difficulty     string    `json:"difficulty"`
+	Tags           []string  `json:"tags"`
+	Skills         []Skill   `json:"skills"`
+	Archived       bool      `json:"archived"`
+	CurrentVersion int       `json:"current_version"`
+	CreatedBy      string    `json:"created_by,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// ProblemResponse es el problema con el contenido de una versión.
+type ProblemResponse struct {
+	ProblemListItem
+	Version Version `json:"version"`
+}
+
+type Version struct {
+	Version     int        `json:"version"`
+	Statement   string     `json:"statement"`
+	Language    string     `json:"language,omitempty"`
+	StarterCode string     `json:"starter_code,omitempty"`
+	UnitTests   []UnitTest `json:"unit_tests"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type VersionsResponse struct {
+	ProblemID string    `json:"problem_id"`
+	Versions  []Version `json:"versions"`
+}
+
+func FromDomainListItem(p domain.Problem) ProblemListItem {
+	skills := make([]Skill, 0, len(p.Skills))
+	for _, s := range p.Skills {
+		skills = append(skills, Skill{SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	return ProblemListItem{
+		ID:             p.ID,
+		Title:          p.Title,
+		Difficulty:     string(p.Difficulty),
+		Tags:           p.Tags,
+		Skills:         skills,
+		Archived:       p.Archived,
+		CurrentVersion: p.CurrentVersion,
+		CreatedBy:      p.CreatedBy,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+}
+
+func FromDomain(p *domain.Problem) ProblemResponse {
+	return ProblemResponse{
+		ProblemListItem: FromDomainListItem(*p),
+		Version:         FromDomainVersion(p.Current),
+	}
+}
+
+func FromDomainVersion(v domain.Version) Version {
+	tests := make([]UnitTest, 0, len(v.UnitTests))
+	for _, t := range v.UnitTests {
+		tests = append(tests, UnitTest{
+			TestName:       t.TestName,
+			InputData:      t.InputData,
+			ExpectedOutput: t.ExpectedOutput,
+			Hidden:         t.Hidden,
+		})
+	}
+	return Version{
+		Version:     v.Version,
+		Statement:   v.Statement,
+		Language:    v.Language,
+		StarterCode: v.StarterCode,
+		UnitTests:   tests,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   v.CreatedAt,
+	}
+}
+
+func FromDomainVersions(problemID string, versions []domain.Version) VersionsResponse {
+	res := VersionsResponse{ProblemID: problemID, Versions: make([]Version, 0, len(versions))}
+	for _, v := range versions {
+		res.Versions = append(res.Versions, FromDomainVersion(v))
+	}
+	return res
+}
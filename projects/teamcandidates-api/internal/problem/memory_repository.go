@@ -0,0 +1,203 @@
+package problem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+// memoryRepository guarda cada problema con sus tags y skills en una fila, y cada versión con
+// sus pruebas en otra.
+type memoryRepository struct {
+	problems *mapdb.Table[models.BankProblem]
+	versions *mapdb.Table[models.BankProblemVersion]
+}
+
+// NewMemoryRepository crea el repositorio del banco de problemas sobre la base en memoria.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		problems: mapdb.NewTable(db, "bank_problems",
+			func(p *models.BankProblem) string { return p.ID },
+		),
+		versions: mapdb.NewTable(db, "bank_problem_versions",
+			func(v *models.BankProblemVersion) string { return v.ID },
+			mapdb.Index[models.BankProblemVersion]{
+				Name:   "problem_id",
+				Values: func(v *models.BankProblemVersion) []string { return []string{v.ProblemID} },
+			},
+			mapdb.Index[models.BankProblemVersion]{
+				Name:   "problem_version",
+				Unique: true,
+				Values: func(v *models.BankProblemVersion) []string { return []string{versionKey(v.ProblemID, v.Version)} },
+			},
+		),
+	}
+}
+
+func (r *memoryRepository) CreateProblem(ctx context.Context, problem *domain.Problem) (string, error) {
+	if problem == nil {
+		return "", errors.New("problem is nil")
+	}
+
+	model, version := models.FromDomain(problem)
+	model.ID = uuid.New().String()
+	model.CreatedAt = time.Now()
+	model.UpdatedAt = model.CreatedAt
+	assignLabelIDs(model)
+
+	if err := r.problems.Insert(ctx, model); err != nil {
+		return "", err
+	}
+	if err := r.createVersion(ctx, model.ID, version); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) GetProblem(ctx context.Context, id string) (*domain.Problem, error) {
+	model, err := r.problems.Get(ctx, id)
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("problem with id %s not found", id), nil)
+		}
+		return nil, err
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *memoryRepository) GetVersion(ctx context.Context, problemID string, version int) (*domain.Version, error) {
+	model, err := r.versions.FindOneBy(ctx, "problem_version", versionKey(problemID, version))
+	if err != nil {
+		if types.IsNotFound(err) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("version %d of problem %s not found", version, problemID), nil)
+		}
+		return nil, err
+	}
+	v := model.ToDomain()
+	return &v, nil
+}
+
+func (r *memoryRepository) ListVersions(ctx context.Context, problemID string) ([]domain.Version, error) {
+	ms, err := r.versions.FindBy(ctx, "problem_id", problemID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(ms, func(a, b models.BankProblemVersion) int { return b.Version - a.Version })
+
+	versions := make([]domain.Version, 0, len(ms))
+	for _, m := range ms {
+		versions = append(versions, m.ToDomain())
+	}
+	return versions, nil
+}
+
+func (r *memoryRepository) ListProblems(ctx context.Context, spec *types.QuerySpec, filter domain.Filter) (*types.Page[domain.Problem], error) {
+	var preds []mapdb.Predicate[models.BankProblem]
+	if tag := strings.ToLower(strings.TrimSpace(filter.Tag)); tag != "" {
+		preds = append(preds, func(m *models.BankProblem) bool {
+			return slices.ContainsFunc(m.Tags, func(t models.BankProblemTag) bool { return t.Tag == tag })
+		})
+	}
+	if skill := strings.TrimSpace(filter.Skill); skill != "" {
+		preds = append(preds, func(m *models.BankProblem) bool {
+			return slices.ContainsFunc(m.Skills, func(s models.BankProblemSkill) bool { return strings.EqualFold(s.SkillName, skill) })
+		})
+	}
+
+	page, err := r.problems.Page(ctx, spec, preds...)
+	if err != nil {
+		return nil, err
+	}
+	return types.MapPage(page, func(m models.BankProblem) domain.Problem { return *m.ToDomain() }), nil
+}
+
+func (r *memoryRepository) UpdateProblem(ctx context.Context, problem *domain.Problem, fromVersion int) error {
+	if problem == nil {
+		return errors.New("problem is nil")
+	}
+
+	update, _ := models.FromDomain(problem)
+	assignLabelIDs(update)
+	err := r.problems.Modify(ctx, problem.ID, func(m *models.BankProblem) error {
+		if m.CurrentVersion != fromVersion {
+			return types.NewError(types.ErrConflict, fmt.Sprintf("problem %s is no longer at version %d", m.ID, fromVersion), nil)
+		}
+		m.Title = update.Title
+		m.Difficulty = update.Difficulty
+		m.Archived = update.Archived
+		m.CurrentVersion = update.CurrentVersion
+		m.Tags = update.Tags
+		m.Skills = update.Skills
+		m.UpdatedAt = time.Now()
+		return nil
+	})
+	if types.IsNotFound(err) {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("problem with id %s not found", problem.ID), nil)
+	}
+	return err
+}
+
+func (r *memoryRepository) CreateVersion(ctx context.Context, problemID string, version *domain.Version) error {
+	if version == nil {
+		return errors.New("version is nil")
+	}
+	return r.createVersion(ctx, problemID, models.FromDomainVersion(problemID, version))
+}
+
+func (r *memoryRepository) FindBySkills(ctx context.Context, skillNames []string) ([]domain.Problem, error) {
+	ms, err := r.problems.Find(ctx, func(m *models.BankProblem) bool {
+		return !m.Archived && slices.ContainsFunc(m.Skills, func(s models.BankProblemSkill) bool {
+			return slices.ContainsFunc(skillNames, func(n string) bool { return strings.EqualFold(n, s.SkillName) })
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	problems := make([]domain.Problem, 0, len(ms))
+	for _, m := range ms {
+		problems = append(problems, *m.ToDomain())
+	}
+	return problems, nil
+}
+
+func (r *memoryRepository) createVersion(ctx context.Context, problemID string, version *models.BankProblemVersion) error {
+	version.ID = uuid.New().String()
+	version.ProblemID = problemID
+	if version.CreatedAt.IsZero() {
+		version.CreatedAt = time.Now()
+	}
+	for i := range version.UnitTests {
+		version.UnitTests[i].ID = uuid.New().String()
+		version.UnitTests[i].VersionID = version.ID
+	}
+	return r.versions.Insert(ctx, version)
+}
+
+func assignLabelIDs(model *models.BankProblem) {
+	for i := range model.Tags {
+		model.Tags[i].ID = uuid.New().String()
+		model.Tags[i].ProblemID = model.ID
+	}
+	for i := range model.Skills {
+		model.Skills[i].ID = uuid.New().String()
+		model.Skills[i].ProblemID = model.ID
+	}
+}
+
+func versionKey(problemID string, version int) string {
+	return problemID + "/" + strconv.Itoa(version)
+}
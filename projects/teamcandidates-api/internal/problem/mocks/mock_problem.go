@@ -0,0 +1,268 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// AssembleAssessment mocks base method.
+func (m *MockUseCases) AssembleAssessment(arg0 context.Context, arg1 *domain.AssemblyRequest) (*domain.Assembly, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssembleAssessment", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Assembly)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssembleAssessment indicates an expected call of AssembleAssessment.
+func (mr *MockUseCasesMockRecorder) AssembleAssessment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssembleAssessment", reflect.TypeOf((*MockUseCases)(nil).AssembleAssessment), arg0, arg1)
+}
+
+// CreateProblem mocks base method.
+func (m *MockUseCases) CreateProblem(arg0 context.Context, arg1 *domain.Problem) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProblem", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProblem indicates an expected call of CreateProblem.
+func (mr *MockUseCasesMockRecorder) CreateProblem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProblem", reflect.TypeOf((*MockUseCases)(nil).CreateProblem), arg0, arg1)
+}
+
+// GetProblem mocks base method.
+func (m *MockUseCases) GetProblem(arg0 context.Context, arg1 string, arg2 int) (*domain.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProblem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProblem indicates an expected call of GetProblem.
+func (mr *MockUseCasesMockRecorder) GetProblem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblem", reflect.TypeOf((*MockUseCases)(nil).GetProblem), arg0, arg1, arg2)
+}
+
+// ListProblems mocks base method.
+func (m *MockUseCases) ListProblems(arg0 context.Context, arg1 *types.QuerySpec, arg2 domain.Filter) (*types.Page[domain.Problem], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProblems", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.Page[domain.Problem])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProblems indicates an expected call of ListProblems.
+func (mr *MockUseCasesMockRecorder) ListProblems(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProblems", reflect.TypeOf((*MockUseCases)(nil).ListProblems), arg0, arg1, arg2)
+}
+
+// ListVersions mocks base method.
+func (m *MockUseCases) ListVersions(arg0 context.Context, arg1 string) ([]domain.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockUseCasesMockRecorder) ListVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockUseCases)(nil).ListVersions), arg0, arg1)
+}
+
+// UpdateProblem mocks base method.
+func (m *MockUseCases) UpdateProblem(arg0 context.Context, arg1 *domain.Problem, arg2 int) (*domain.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProblem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProblem indicates an expected call of UpdateProblem.
+func (mr *MockUseCasesMockRecorder) UpdateProblem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProblem", reflect.TypeOf((*MockUseCases)(nil).UpdateProblem), arg0, arg1, arg2)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateProblem mocks base method.
+func (m *MockRepository) CreateProblem(arg0 context.Context, arg1 *domain.Problem) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProblem", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProblem indicates an expected call of CreateProblem.
+func (mr *MockRepositoryMockRecorder) CreateProblem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProblem", reflect.TypeOf((*MockRepository)(nil).CreateProblem), arg0, arg1)
+}
+
+// CreateVersion mocks base method.
+func (m *MockRepository) CreateVersion(arg0 context.Context, arg1 string, arg2 *domain.Version) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVersion indicates an expected call of CreateVersion.
+func (mr *MockRepositoryMockRecorder) CreateVersion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersion", reflect.TypeOf((*MockRepository)(nil).CreateVersion), arg0, arg1, arg2)
+}
+
+// FindBySkills mocks base method.
+func (m *MockRepository) FindBySkills(arg0 context.Context, arg1 []string) ([]domain.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySkills", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySkills indicates an expected call of FindBySkills.
+func (mr *MockRepositoryMockRecorder) FindBySkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySkills", reflect.TypeOf((*MockRepository)(nil).FindBySkills), arg0, arg1)
+}
+
+// GetProblem mocks base method.
+func (m *MockRepository) GetProblem(arg0 context.Context, arg1 string) (*domain.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProblem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProblem indicates an expected call of GetProblem.
+func (mr *MockRepositoryMockRecorder) GetProblem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblem", reflect.TypeOf((*MockRepository)(nil).GetProblem), arg0, arg1)
+}
+
+// GetVersion mocks base method.
+func (m *MockRepository) GetVersion(arg0 context.Context, arg1 string, arg2 int) (*domain.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockRepositoryMockRecorder) GetVersion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockRepository)(nil).GetVersion), arg0, arg1, arg2)
+}
+
+// ListProblems mocks base method.
+func (m *MockRepository) ListProblems(arg0 context.Context, arg1 *types.QuerySpec, arg2 domain.Filter) (*types.Page[domain.Problem], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProblems", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.Page[domain.Problem])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProblems indicates an expected call of ListProblems.
+func (mr *MockRepositoryMockRecorder) ListProblems(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProblems", reflect.TypeOf((*MockRepository)(nil).ListProblems), arg0, arg1, arg2)
+}
+
+// ListVersions mocks base method.
+func (m *MockRepository) ListVersions(arg0 context.Context, arg1 string) ([]domain.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockRepositoryMockRecorder) ListVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockRepository)(nil).ListVersions), arg0, arg1)
+}
+
+// UpdateProblem mocks base method.
+func (m *MockRepository) UpdateProblem(arg0 context.Context, arg1 *domain.Problem, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProblem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProblem indicates an expected call of UpdateProblem.
+func (mr *MockRepositoryMockRecorder) UpdateProblem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProblem", reflect.TypeOf((*MockRepository)(nil).UpdateProblem), arg0, arg1, arg2)
+}
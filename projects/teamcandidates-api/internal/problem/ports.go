@@ -0,0 +1,42 @@
+package problem
+
+import (
+	"context"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+// UseCases administra el banco de problemas y arma evaluaciones a partir de él.
+type UseCases interface {
+	CreateProblem(context.Context, *domain.Problem) (string, error)
+	// GetProblem devuelve el problema con la versión pedida en Current (0 = la vigente)
+	GetProblem(context.Context, string, int) (*domain.Problem, error)
+	ListProblems(context.Context, *types.QuerySpec, domain.Filter) (*types.Page[domain.Problem], error)
+	ListVersions(context.Context, string) ([]domain.Version, error)
+	// UpdateProblem edita el problema; si cambia el contenido crea una versión nueva. Con una
+	// versión base distinta de 0 falla si el problema ya no está en esa versión.
+	UpdateProblem(context.Context, *domain.Problem, int) (*domain.Problem, error)
+	// AssembleAssessment elige un problema del banco para el candidato y crea la evaluación
+	AssembleAssessment(context.Context, *domain.AssemblyRequest) (*domain.Assembly, error)
+}
+
+type Repository interface {
+	// CreateProblem guarda el problema con Current como primera versión
+	CreateProblem(context.Context, *domain.Problem) (string, error)
+	// GetProblem devuelve el problema sin el contenido de las versiones
+	GetProblem(context.Context, string) (*domain.Problem, error)
+	GetVersion(context.Context, string, int) (*domain.Version, error)
+	ListVersions(context.Context, string) ([]domain.Version, error)
+	ListProblems(context.Context, *types.QuerySpec, domain.Filter) (*types.Page[domain.Problem], error)
+	// UpdateProblem guarda título, dificultad, Archived y la versión vigente y reemplaza tags y
+	// skills, solo si la versión vigente sigue siendo la indicada
+	UpdateProblem(context.Context, *domain.Problem, int) error
+	CreateVersion(context.Context, string, *domain.Version) error
+	// FindBySkills devuelve los problemas no archivados que cubren alguna de las skills
+	FindBySkills(context.Context, []string) ([]domain.Problem, error)
+}
+
+// Gomock
+// mockgen -source=ports.go -destination=./mocks/mock_problem.go -package=mocks
@@ -0,0 +1,258 @@
+package problem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+type repository struct {
+	db gorm.Repository
+}
+
+func NewRepository(db gorm.Repository) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// CreateProblem inserta el problema, sus tags y skills y la primera versión con sus pruebas.
+// Usa la transacción del contexto, por lo que el use case las agrupa con WithinTx.
+func (r *repository) CreateProblem(ctx context.Context, problem *domain.Problem) (string, error) {
+	if problem == nil {
+		return "", errors.New("problem is nil")
+	}
+
+	model, version := models.FromDomain(problem)
+	model.ID = uuid.New().String()
+
+	db := r.db.DB(ctx)
+	if err := db.Omit(clause.Associations).Create(model).Error; err != nil {
+		return "", fmt.Errorf("failed to create problem: %w", err)
+	}
+	if err := r.replaceLabels(ctx, model); err != nil {
+		return "", err
+	}
+	if err := r.createVersion(ctx, model.ID, version); err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+func (r *repository) GetProblem(ctx context.Context, id string) (*domain.Problem, error) {
+	var model models.BankProblem
+	err := r.db.DB(ctx).Preload("Tags").Preload("Skills").Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("problem with id %s not found", id), err)
+		}
+		return nil, fmt.Errorf("failed to get problem: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+func (r *repository) GetVersion(ctx context.Context, problemID string, version int) (*domain.Version, error) {
+	var model models.BankProblemVersion
+	err := r.preloadTests(ctx).
+		Where("problem_id = ? AND version = ?", problemID, version).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, fmt.Sprintf("version %d of problem %s not found", version, problemID), err)
+		}
+		return nil, fmt.Errorf("failed to get problem version: %w", err)
+	}
+	v := model.ToDomain()
+	return &v, nil
+}
+
+// ListVersions devuelve las versiones de la más reciente a la más antigua.
+func (r *repository) ListVersions(ctx context.Context, problemID string) ([]domain.Version, error) {
+	var ms []models.BankProblemVersion
+	err := r.preloadTests(ctx).
+		Where("problem_id = ?", problemID).
+		Order("version DESC").
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list problem versions: %w", err)
+	}
+
+	versions := make([]domain.Version, 0, len(ms))
+	for _, m := range ms {
+		versions = append(versions, m.ToDomain())
+	}
+	return versions, nil
+}
+
+func (r *repository) ListProblems(ctx context.Context, spec *types.QuerySpec, filter domain.Filter) (*types.Page[domain.Problem], error) {
+	db := r.db.DB(ctx)
+	query := db.Model(&models.BankProblem{})
+	if tag := strings.ToLower(strings.TrimSpace(filter.Tag)); tag != "" {
+		query = query.Where("id IN (?)", db.Model(&models.BankProblemTag{}).Select("problem_id").Where("tag = ?", tag))
+	}
+	if skill := strings.TrimSpace(filter.Skill); skill != "" {
+		query = query.Where("id IN (?)", db.Model(&models.BankProblemSkill{}).Select("problem_id").Where("LOWER(skill_name) = ?", strings.ToLower(skill)))
+	}
+
+	page, err := gorm.Paginate[models.BankProblem](query, spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadLabels(ctx, page.Items); err != nil {
+		return nil, err
+	}
+	return types.MapPage(page, func(m models.BankProblem) domain.Problem { return *m.ToDomain() }), nil
+}
+
+func (r *repository) UpdateProblem(ctx context.Context, problem *domain.Problem, fromVersion int) error {
+	if problem == nil {
+		return errors.New("problem is nil")
+	}
+
+	model, _ := models.FromDomain(problem)
+	result := r.db.DB(ctx).Model(&models.BankProblem{}).
+		Where("id = ? AND current_version = ?", model.ID, fromVersion).
+		Select("title", "difficulty", "archived", "current_version").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update problem: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrConflict, fmt.Sprintf("problem %s is no longer at version %d", model.ID, fromVersion), nil)
+	}
+	return r.replaceLabels(ctx, model)
+}
+
+func (r *repository) CreateVersion(ctx context.Context, problemID string, version *domain.Version) error {
+	if version == nil {
+		return errors.New("version is nil")
+	}
+	return r.createVersion(ctx, problemID, models.FromDomainVersion(problemID, version))
+}
+
+func (r *repository) FindBySkills(ctx context.Context, skillNames []string) ([]domain.Problem, error) {
+	names := make([]string, 0, len(skillNames))
+	for _, n := range skillNames {
+		names = append(names, strings.ToLower(n))
+	}
+
+	db := r.db.DB(ctx)
+	var ms []models.BankProblem
+	err := db.Preload("Tags").Preload("Skills").
+		Where("archived = ?", false).
+		Where("id IN (?)", db.Model(&models.BankProblemSkill{}).Select("problem_id").Where("LOWER(skill_name) IN ?", names)).
+		Find(&ms).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find problems by skills: %w", err)
+	}
+
+	problems := make([]domain.Problem, 0, len(ms))
+	for _, m := range ms {
+		problems = append(problems, *m.ToDomain())
+	}
+	return problems, nil
+}
+
+func (r *repository) createVersion(ctx context.Context, problemID string, version *models.BankProblemVersion) error {
+	version.ID = uuid.New().String()
+	version.ProblemID = problemID
+	for i := range version.UnitTests {
+		version.UnitTests[i].ID = uuid.New().String()
+		version.UnitTests[i].VersionID = version.ID
+	}
+
+	db := r.db.DB(ctx)
+	if err := db.Omit(clause.Associations).Create(version).Error; err != nil {
+		return fmt.Errorf("failed to create problem version: %w", err)
+	}
+	if len(version.UnitTests) > 0 {
+		if err := db.Create(&version.UnitTests).Error; err != nil {
+			return fmt.Errorf("failed to create problem unit tests: %w", err)
+		}
+	}
+	return nil
+}
+
+// replaceLabels reemplaza los tags y las skills del problema.
+func (r *repository) replaceLabels(ctx context.Context, model *models.BankProblem) error {
+	db := r.db.DB(ctx)
+	if err := db.Where("problem_id = ?", model.ID).Delete(&models.BankProblemTag{}).Error; err != nil {
+		return fmt.Errorf("failed to delete problem tags: %w", err)
+	}
+	if err := db.Where("problem_id = ?", model.ID).Delete(&models.BankProblemSkill{}).Error; err != nil {
+		return fmt.Errorf("failed to delete problem skills: %w", err)
+	}
+
+	for i := range model.Tags {
+		model.Tags[i].ID = uuid.New().String()
+		model.Tags[i].ProblemID = model.ID
+	}
+	if len(model.Tags) > 0 {
+		if err := db.Create(&model.Tags).Error; err != nil {
+			return fmt.Errorf("failed to create problem tags: %w", err)
+		}
+	}
+	for i := range model.Skills {
+		model.Skills[i].ID = uuid.New().String()
+		model.Skills[i].ProblemID = model.ID
+	}
+	if len(model.Skills) > 0 {
+		if err := db.Create(&model.Skills).Error; err != nil {
+			return fmt.Errorf("failed to create problem skills: %w", err)
+		}
+	}
+	return nil
+}
+
+// loadLabels completa los tags y las skills de los problemas de una página. No se usa Preload
+// en Paginate porque también arma la query del total.
+func (r *repository) loadLabels(ctx context.Context, ms []models.BankProblem) error {
+	if len(ms) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(ms))
+	for _, m := range ms {
+		ids = append(ids, m.ID)
+	}
+
+	var tags []models.BankProblemTag
+	if err := r.db.DB(ctx).Where("problem_id IN ?", ids).Order("tag").Find(&tags).Error; err != nil {
+		return fmt.Errorf("failed to load problem tags: %w", err)
+	}
+	var skills []models.BankProblemSkill
+	if err := r.db.DB(ctx).Where("problem_id IN ?", ids).Order("skill_name").Find(&skills).Error; err != nil {
+		return fmt.Errorf("failed to load problem skills: %w", err)
+	}
+
+	for i := range ms {
+		for _, t := range tags {
+			if t.ProblemID == ms[i].ID {
+				ms[i].Tags = append(ms[i].Tags, t)
+			}
+		}
+		for _, s := range skills {
+			if s.ProblemID == ms[i].ID {
+				ms[i].Skills = append(ms[i].Skills, s)
+			}
+		}
+	}
+	return nil
+}
+
+// preloadTests carga las pruebas en el orden en que se cargaron.
+func (r *repository) preloadTests(ctx context.Context) *gorm0.DB {
+	return r.db.DB(ctx).Preload("UnitTests", func(db *gorm0.DB) *gorm0.DB {
+		return db.Order("position")
+	})
+}
@@ -0,0 +1,159 @@
+package models
+
+import (
+	"time"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+// BankProblem es un problema del banco. El contenido está en BankProblemVersion.
+type BankProblem struct {
+	ID             string               `gorm:"primaryKey"`
+	Title          string               `gorm:"type:varchar(200);not null"`
+	Difficulty     string               `gorm:"type:varchar(20);not null;index"` // Ver domain.Difficulty
+	Archived       bool                 `gorm:"not null;default:false;index"`
+	CurrentVersion int                  `gorm:"not null;default:1"` // Versión vigente
+	CreatedBy      string               `gorm:"type:varchar(256)"`
+	Tags           []BankProblemTag     `gorm:"foreignKey:ProblemID;constraint:OnDelete:CASCADE"`
+	Skills         []BankProblemSkill   `gorm:"foreignKey:ProblemID;constraint:OnDelete:CASCADE"`
+	Versions       []BankProblemVersion `gorm:"foreignKey:ProblemID;constraint:OnDelete:CASCADE"` // No se precargan
+	CreatedAt      time.Time            `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time            `gorm:"autoUpdateTime"`
+}
+
+// BankProblemTag es un tag del problema (en minúsculas).
+type BankProblemTag struct {
+	ID        string `gorm:"primaryKey"`
+	ProblemID string `gorm:"index;not null"`
+	Tag       string `gorm:"type:varchar(50);not null;index"`
+}
+
+// BankProblemSkill relaciona el problema con una skill.
+type BankProblemSkill struct {
+	ID         string `gorm:"primaryKey"`
+	ProblemID  string `gorm:"index;not null"`
+	SkillName  string `gorm:"type:varchar(100);not null;index"`
+	SkillLevel string `gorm:"type:varchar(50)"`
+}
+
+// BankProblemVersion es una versión inmutable del contenido del problema.
+type BankProblemVersion struct {
+	ID          string            `gorm:"primaryKey"`
+	ProblemID   string            `gorm:"not null;uniqueIndex:idx_bank_problem_versions_problem_version"`
+	Version     int               `gorm:"not null;uniqueIndex:idx_bank_problem_versions_problem_version"`
+	Statement   string            `gorm:"type:text;not null"`
+	Language    string            `gorm:"type:varchar(50)"`
+	StarterCode string            `gorm:"type:text"`
+	CreatedBy   string            `gorm:"type:varchar(256)"`
+	UnitTests   []BankProblemTest `gorm:"foreignKey:VersionID;constraint:OnDelete:CASCADE"`
+	CreatedAt   time.Time         `gorm:"autoCreateTime"`
+}
+
+// BankProblemTest es una prueba de una versión, en el orden en que se cargó.
+type BankProblemTest struct {
+	ID             string `gorm:"primaryKey"`
+	VersionID      string `gorm:"index;not null"`
+	Position       int    `gorm:"not null;default:0"`
+	TestName       string `gorm:"type:varchar(100);not null"`
+	InputData      string `gorm:"type:text;not null"`
+	ExpectedOutput string `gorm:"type:text;not null"`
+	Hidden         bool   `gorm:"not null;default:false"`
+}
+
+// FromDomain convierte el problema y su versión vigente (Current) al modelo. Los IDs de las
+// filas hijas los asigna el repositorio.
+func FromDomain(p *domain.Problem) (*BankProblem, *BankProblemVersion) {
+	model := &BankProblem{
+		ID:             p.ID,
+		Title:          p.Title,
+		Difficulty:     string(p.Difficulty),
+		Archived:       p.Archived,
+		CurrentVersion: p.CurrentVersion,
+		CreatedBy:      p.CreatedBy,
+		Tags:           tagsFromDomain(p.ID, p.Tags),
+		Skills:         skillsFromDomain(p.ID, p.Skills),
+	}
+	return model, FromDomainVersion(p.ID, &p.Current)
+}
+
+func FromDomainVersion(problemID string, v *domain.Version) *BankProblemVersion {
+	model := &BankProblemVersion{
+		ProblemID:   problemID,
+		Version:     v.Version,
+		Statement:   v.Statement,
+		Language:    v.Language,
+		StarterCode: v.StarterCode,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   v.CreatedAt,
+	}
+	for i, t := range v.UnitTests {
+		model.UnitTests = append(model.UnitTests, BankProblemTest{
+			Position:       i,
+			TestName:       t.TestName,
+			InputData:      t.InputData,
+			ExpectedOutput: t.ExpectedOutput,
+			Hidden:         t.Hidden,
+		})
+	}
+	return model
+}
+
+// ToDomain convierte el problema sin el contenido; Current lo completa el repositorio si hace falta.
+func (m BankProblem) ToDomain() *domain.Problem {
+	p := &domain.Problem{
+		ID:             m.ID,
+		Title:          m.Title,
+		Difficulty:     domain.Difficulty(m.Difficulty),
+		Archived:       m.Archived,
+		CurrentVersion: m.CurrentVersion,
+		CreatedBy:      m.CreatedBy,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+		Tags:           make([]string, 0, len(m.Tags)),
+		Skills:         make([]domain.Skill, 0, len(m.Skills)),
+	}
+	for _, t := range m.Tags {
+		p.Tags = append(p.Tags, t.Tag)
+	}
+	for _, s := range m.Skills {
+		p.Skills = append(p.Skills, domain.Skill{SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	return p
+}
+
+func (m BankProblemVersion) ToDomain() domain.Version {
+	v := domain.Version{
+		Version:     m.Version,
+		Statement:   m.Statement,
+		Language:    m.Language,
+		StarterCode: m.StarterCode,
+		CreatedBy:   m.CreatedBy,
+		CreatedAt:   m.CreatedAt,
+		UnitTests:   make([]domain.UnitTest, 0, len(m.UnitTests)),
+	}
+	for _, t := range m.UnitTests {
+		v.UnitTests = append(v.UnitTests, domain.UnitTest{
+			TestName:       t.TestName,
+			InputData:      t.InputData,
+			ExpectedOutput: t.ExpectedOutput,
+			Hidden:         t.Hidden,
+		})
+	}
+	return v
+}
+
+func tagsFromDomain(problemID string, tags []string) []BankProblemTag {
+	ms := make([]BankProblemTag, 0, len(tags))
+	for _, t := range tags {
+		ms = append(ms, BankProblemTag{ProblemID: problemID, Tag: t})
+	}
+	return ms
+}
+
+func skillsFromDomain(problemID string, skills []domain.Skill) []BankProblemSkill {
+	ms := make([]BankProblemSkill, 0, len(skills))
+	for _, s := range skills {
+		ms = append(ms, BankProblemSkill{ProblemID: problemID, SkillName: s.SkillName, SkillLevel: s.SkillLevel})
+	}
+	return ms
+}
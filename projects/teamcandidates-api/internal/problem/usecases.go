@@ -0,0 +1,148 @@
+package problem
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+// Tipo de recurso con el que se registran los cambios en el log de auditoría
+const auditResourceProblem = "bank_problem"
+
+type useCases struct {
+	repository   Repository
+	txManager    pkgtx.Manager
+	assessmentUc assessment.UseCases
+	candidateUc  candidate.UseCases
+	auditUc      audit.UseCases
+}
+
+func NewUseCases(
+	repo Repository,
+	tx pkgtx.Manager,
+	assessmentUC assessment.UseCases,
+	candidateUC candidate.UseCases,
+	ad audit.UseCases,
+) UseCases {
+	return &useCases{
+		repository:   repo,
+		txManager:    tx,
+		assessmentUc: assessmentUC,
+		candidateUc:  candidateUC,
+		auditUc:      ad,
+	}
+}
+
+func (u *useCases) CreateProblem(ctx context.Context, problem *domain.Problem) (string, error) {
+	if err := problem.Validate(); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	problem.CreatedBy = types.PrincipalIDFromContext(ctx)
+	problem.CurrentVersion = 1
+	problem.Current.Version = 1
+	problem.Current.CreatedBy = problem.CreatedBy
+	problem.Current.CreatedAt = now
+
+	// El problema, sus tags y skills y la primera versión se guardan en una única transacción
+	var problemID string
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		problemID, err = u.repository.CreateProblem(ctx, problem)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create problem: %w", err)
+	}
+
+	problem.ID = problemID
+	u.auditUc.RecordChange(ctx, auditdom.ActionCreate, auditResourceProblem, problemID, nil, problem)
+	return problemID, nil
+}
+
+func (u *useCases) GetProblem(ctx context.Context, id string, version int) (*domain.Problem, error) {
+	problem, err := u.repository.GetProblem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if version <= 0 {
+		version = problem.CurrentVersion
+	}
+
+	current, err := u.repository.GetVersion(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+	problem.Current = *current
+	return problem, nil
+}
+
+func (u *useCases) ListProblems(ctx context.Context, spec *types.QuerySpec, filter domain.Filter) (*types.Page[domain.Problem], error) {
+	return u.repository.ListProblems(ctx, spec, filter)
+}
+
+func (u *useCases) ListVersions(ctx context.Context, id string) ([]domain.Version, error) {
+	if _, err := u.repository.GetProblem(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.repository.ListVersions(ctx, id)
+}
+
+// UpdateProblem guarda los cambios del problema. Las evaluaciones ya armadas guardan una copia
+// de la versión que usaron, así que una versión nueva no las modifica.
+func (u *useCases) UpdateProblem(ctx context.Context, update *domain.Problem, baseVersion int) (*domain.Problem, error) {
+	if err := update.Validate(); err != nil {
+		return nil, err
+	}
+
+	var before *domain.Problem
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		before, err = u.GetProblem(ctx, update.ID, 0)
+		if err != nil {
+			return err
+		}
+		from := before.CurrentVersion
+		if baseVersion > 0 && baseVersion != from {
+			return types.NewError(types.ErrConflict, fmt.Sprintf("problem is at version %d, the update was based on version %d", from, baseVersion), nil)
+		}
+
+		update.CreatedBy = before.CreatedBy
+		update.CreatedAt = before.CreatedAt
+		update.CurrentVersion = from
+		newVersion := !update.Current.SameContent(&before.Current)
+		if newVersion {
+			update.CurrentVersion = from + 1
+			update.Current.Version = from + 1
+			update.Current.CreatedBy = types.PrincipalIDFromContext(ctx)
+			update.Current.CreatedAt = time.Now()
+		} else {
+			update.Current = before.Current
+		}
+
+		// Primero se mueve la versión vigente: si otro request creó una versión en paralelo, falla acá
+		if err := u.repository.UpdateProblem(ctx, update, from); err != nil {
+			return err
+		}
+		if newVersion {
+			return u.repository.CreateVersion(ctx, update.ID, &update.Current)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.auditUc.RecordChange(ctx, auditdom.ActionUpdate, auditResourceProblem, update.ID, before, update)
+	return u.GetProblem(ctx, update.ID, 0)
+}
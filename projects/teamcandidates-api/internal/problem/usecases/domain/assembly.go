@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+)
+
+// AssemblyRequest pide armar una evaluación para el candidato a partir del banco.
+type AssemblyRequest struct {
+	CandidateID  string
+	HRID         string
+	JobOpeningID string                  // Opcional: búsqueda para la que se arma la evaluación
+	Skills       []assdomain.SkillConfig // Skills requeridas; el problema debe cubrir al menos una
+	Tags         []string                // Opcional: el problema debe tener todos estos tags
+	MaxDuration  time.Duration
+}
+
+// Assembly es el resultado del armado: la evaluación creada y el problema elegido.
+type Assembly struct {
+	AssessmentID  string
+	ProblemID     string
+	Version       int
+	Difficulty    Difficulty
+	Target        Difficulty // Dificultad buscada según la experiencia del candidato
+	MatchedSkills []string
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+)
+
+// Difficulty es la dificultad de un problema del banco.
+type Difficulty string
+
+// Constantes de Difficulty.
+const (
+	DifficultyEasy   Difficulty = "easy"
+	DifficultyMedium Difficulty = "medium"
+	DifficultyHard   Difficulty = "hard"
+)
+
+// IsValid indica si la dificultad es una de las definidas.
+func (d Difficulty) IsValid() bool {
+	return d.Rank() > 0
+}
+
+// Rank ordena las dificultades (1 = easy); 0 si no es válida.
+func (d Difficulty) Rank() int {
+	switch d {
+	case DifficultyEasy:
+		return 1
+	case DifficultyMedium:
+		return 2
+	case DifficultyHard:
+		return 3
+	}
+	return 0
+}
+
+// DifficultyForExperience devuelve la dificultad que corresponde al rank de experiencia del
+// candidato (candidate Experience.Rank: 1 trainee ... 5 senior). Sin rank se usa medium.
+func DifficultyForExperience(rank int) Difficulty {
+	switch {
+	case rank <= 0:
+		return DifficultyMedium
+	case rank <= 2:
+		return DifficultyEasy
+	case rank <= 4:
+		return DifficultyMedium
+	default:
+		return DifficultyHard
+	}
+}
+
+// Skill relaciona el problema con una skill (SkillName/SkillLevel de la SkillConfig del assessment).
+type Skill struct {
+	SkillName  string
+	SkillLevel string
+}
+
+// UnitTest es una prueba de una versión del problema. Las ocultas solo se usan para corregir.
+type UnitTest struct {
+	TestName       string
+	InputData      string
+	ExpectedOutput string
+	Hidden         bool
+}
+
+// Version es el contenido de un problema en un momento dado. Las versiones no se modifican:
+// cambiar el enunciado, el código inicial o las pruebas crea una nueva.
+type Version struct {
+	Version     int
+	Statement   string
+	Language    string // Lenguaje del código inicial (opcional)
+	StarterCode string
+	UnitTests   []UnitTest
+	CreatedBy   string
+	CreatedAt   time.Time
+}
+
+// Problem es un problema reutilizable del banco. Título, dificultad, tags, skills y Archived
+// se editan en el lugar; Current es la versión vigente (o la pedida al leerlo).
+type Problem struct {
+	ID             string
+	Title          string
+	Difficulty     Difficulty
+	Tags           []string
+	Skills         []Skill
+	Archived       bool // Los archivados no se usan para armar evaluaciones
+	CurrentVersion int
+	Current        Version
+	CreatedBy      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Filter agrega al listado los filtros por tag y por skill, que no son columnas del problema.
+type Filter struct {
+	Tag   string
+	Skill string
+}
+
+// Validate controla los datos obligatorios y normaliza tags y skills.
+func (p *Problem) Validate() error {
+	p.Title = strings.TrimSpace(p.Title)
+	if p.Title == "" {
+		return types.NewError(types.ErrValidation, "title is required", nil)
+	}
+	if !p.Difficulty.IsValid() {
+		return types.NewError(types.ErrValidation, "difficulty must be easy, medium or hard", nil)
+	}
+
+	p.Tags = NormalizeTags(p.Tags)
+
+	skills := make([]Skill, 0, len(p.Skills))
+	for _, s := range p.Skills {
+		s.SkillName = strings.TrimSpace(s.SkillName)
+		s.SkillLevel = strings.TrimSpace(s.SkillLevel)
+		if s.SkillName == "" {
+			return types.NewError(types.ErrValidation, "skill name is required", nil)
+		}
+		if !slices.ContainsFunc(skills, func(o Skill) bool { return strings.EqualFold(o.SkillName, s.SkillName) }) {
+			skills = append(skills, s)
+		}
+	}
+	if len(skills) == 0 {
+		return types.NewError(types.ErrValidation, "at least one skill is required", nil)
+	}
+	p.Skills = skills
+
+	return p.Current.Validate()
+}
+
+// Validate controla que la versión tenga enunciado y al menos una prueba.
+func (v *Version) Validate() error {
+	if strings.TrimSpace(v.Statement) == "" {
+		return types.NewError(types.ErrValidation, "statement is required", nil)
+	}
+	if len(v.UnitTests) == 0 {
+		return types.NewError(types.ErrValidation, "at least one unit test is required", nil)
+	}
+	for _, t := range v.UnitTests {
+		if strings.TrimSpace(t.TestName) == "" {
+			return types.NewError(types.ErrValidation, "unit test name is required", nil)
+		}
+	}
+	return nil
+}
+
+// SameContent indica si dos versiones tienen el mismo enunciado, código inicial y pruebas.
+func (v *Version) SameContent(o *Version) bool {
+	return v.Statement == o.Statement &&
+		v.Language == o.Language &&
+		v.StarterCode == o.StarterCode &&
+		slices.Equal(v.UnitTests, o.UnitTests)
+}
+
+// HasSkill indica si el problema cubre la skill (sin distinguir mayúsculas).
+func (p *Problem) HasSkill(name string) (Skill, bool) {
+	for _, s := range p.Skills {
+		if strings.EqualFold(s.SkillName, name) {
+			return s, true
+		}
+	}
+	return Skill{}, false
+}
+
+// HasTags indica si el problema tiene todos los tags.
+func (p *Problem) HasTags(tags []string) bool {
+	for _, t := range NormalizeTags(tags) {
+		if !slices.Contains(p.Tags, t) {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeTags pasa los tags a minúsculas y quita vacíos y repetidos.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t != "" && !slices.Contains(normalized, t) {
+			normalized = append(normalized, t)
+		}
+	}
+	return normalized
+}
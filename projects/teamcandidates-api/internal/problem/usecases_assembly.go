@@ -0,0 +1,170 @@
+package problem
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem/usecases/domain"
+)
+
+// match es un problema candidato para el armado con su afinidad con el pedido.
+type match struct {
+	problem  domain.Problem
+	skills   []string // Skills pedidas que cubre
+	levels   int      // Cuántas de esas coinciden también en nivel
+	distance int      // Distancia entre su dificultad y la buscada
+}
+
+// AssembleAssessment elige del banco el problema que mejor cubre las skills pedidas con la
+// dificultad que corresponde a la experiencia del candidato, sin repetir problemas que ya vio,
+// y crea la evaluación con una copia de su versión vigente.
+func (u *useCases) AssembleAssessment(ctx context.Context, req *domain.AssemblyRequest) (*domain.Assembly, error) {
+	if err := validateAssembly(req); err != nil {
+		return nil, err
+	}
+
+	cand, err := u.candidateUc.GetCandidate(ctx, req.CandidateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get candidate: %w", err)
+	}
+	target := domain.DifficultyForExperience(cand.Experience.Rank)
+
+	seen, err := u.assessmentUc.ListSeenProblems(ctx, req.CandidateID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(req.Skills))
+	for _, s := range req.Skills {
+		names = append(names, s.SkillName)
+	}
+	pool, err := u.repository.FindBySkills(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	best, ok := pickProblem(pool, req, seen, target)
+	if !ok {
+		return nil, types.NewError(types.ErrNotFound, "no unseen problem in the bank matches the requested skills", nil)
+	}
+
+	version, err := u.repository.GetVersion(ctx, best.problem.ID, best.problem.CurrentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	assessmentID, err := u.assessmentUc.CreateAssessment(ctx, buildAssessment(req, &best.problem, version))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Assembly{
+		AssessmentID:  assessmentID,
+		ProblemID:     best.problem.ID,
+		Version:       version.Version,
+		Difficulty:    best.problem.Difficulty,
+		Target:        target,
+		MatchedSkills: best.skills,
+	}, nil
+}
+
+func validateAssembly(req *domain.AssemblyRequest) error {
+	if req == nil || req.CandidateID == "" {
+		return types.NewError(types.ErrValidation, "candidate_id is required", nil)
+	}
+	if len(req.Skills) == 0 {
+		return types.NewError(types.ErrValidation, "at least one skill is required", nil)
+	}
+	for _, s := range req.Skills {
+		if strings.TrimSpace(s.SkillName) == "" {
+			return types.NewError(types.ErrValidation, "skill name is required", nil)
+		}
+	}
+	if req.MaxDuration <= 0 {
+		return types.NewError(types.ErrValidation, "max_duration must be greater than 0", nil)
+	}
+	return nil
+}
+
+// pickProblem ordena los problemas no vistos por skills cubiertas, cercanía a la dificultad
+// buscada y niveles coincidentes. Los empates se resuelven al azar para no repetir siempre
+// el mismo problema entre candidatos.
+func pickProblem(pool []domain.Problem, req *domain.AssemblyRequest, seen []string, target domain.Difficulty) (match, bool) {
+	matches := make([]match, 0, len(pool))
+	for _, p := range pool {
+		if slices.Contains(seen, p.ID) || !p.HasTags(req.Tags) {
+			continue
+		}
+
+		m := match{problem: p, distance: abs(p.Difficulty.Rank() - target.Rank())}
+		for _, want := range req.Skills {
+			got, ok := p.HasSkill(want.SkillName)
+			if !ok {
+				continue
+			}
+			m.skills = append(m.skills, got.SkillName)
+			if want.SkillLevel != "" && strings.EqualFold(want.SkillLevel, got.SkillLevel) {
+				m.levels++
+			}
+		}
+		if len(m.skills) > 0 {
+			matches = append(matches, m)
+		}
+	}
+	if len(matches) == 0 {
+		return match{}, false
+	}
+
+	rand.Shuffle(len(matches), func(i, j int) { matches[i], matches[j] = matches[j], matches[i] })
+	slices.SortStableFunc(matches, func(a, b match) int {
+		return cmp.Or(
+			cmp.Compare(len(b.skills), len(a.skills)),
+			cmp.Compare(a.distance, b.distance),
+			cmp.Compare(b.levels, a.levels),
+		)
+	})
+	return matches[0], true
+}
+
+// buildAssessment copia la versión del problema en una evaluación nueva.
+func buildAssessment(req *domain.AssemblyRequest, problem *domain.Problem, version *domain.Version) *assdomain.Assessment {
+	tests := make([]assdomain.UnitTest, 0, len(version.UnitTests))
+	for _, t := range version.UnitTests {
+		tests = append(tests, assdomain.UnitTest{
+			TestName:       t.TestName,
+			InputData:      t.InputData,
+			ExpectedOutput: t.ExpectedOutput,
+			Hidden:         t.Hidden,
+		})
+	}
+
+	return &assdomain.Assessment{
+		HRID:         req.HRID,
+		CandidateID:  req.CandidateID,
+		JobOpeningID: req.JobOpeningID,
+		MaxDuration:  req.MaxDuration,
+		Skills:       slices.Clone(req.Skills),
+		Problem: assdomain.Problem{
+			Description:   version.Statement,
+			Language:      version.Language,
+			StarterCode:   version.StarterCode,
+			BankProblemID: problem.ID,
+			BankVersion:   version.Version,
+		},
+		UnitTests: tests,
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -1,45 +1,100 @@
 package rating
 
 import (
-	mongo "github.com/teamcubation/teamcandidates/pkg/databases/nosql/mongodb/mongo-driver"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	mng "github.com/teamcubation/teamcandidates/pkg/databases/nosql/mongodb/mongo-driver"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/rating/usecases/domain"
 )
 
-type MongoDbRepository struct {
-	repository mongo.Repository
-}
+const ratingsCollection = "ratings"
 
-func NewMongoDbRepository(r mongo.Repository) Repository { //(Repository, error) {
-	// r, err := mongo.Bootstrap("", "", "", "", "")
-	// if err != nil {
-	// 	return nil, fmt.Errorf("failed to initialize MongoDB client: %w", err)
-	// }
+// MongoIndexes son los índices de ratings que se aseguran al arrancar.
+// El índice único impide que un usuario califique dos veces el mismo objetivo.
+var MongoIndexes = []mng.IndexDefinition{
+	{
+		Collection: ratingsCollection,
+		Name:       "rater_target_unique",
+		Keys:       []mng.IndexKey{mng.Asc("rater_id"), mng.Asc("target_id"), mng.Asc("target_type")},
+		Unique:     true,
+	},
+	{
+		Collection: ratingsCollection,
+		Name:       "target_created_at",
+		Keys:       []mng.IndexKey{mng.Asc("target_id"), mng.Asc("target_type"), mng.Desc("created_at")},
+	},
+}
 
-	//	return &MongoDbRepository{
-	//		repository: r,
-	//	}, nil
+type MongoDbRepository struct {
+	ratings *mng.Collection[domain.Rating]
+}
 
+func NewMongoDbRepository(r mng.Repository) Repository {
 	return &MongoDbRepository{
-		repository: r,
+		ratings: mng.NewCollection[domain.Rating](r, ratingsCollection),
 	}
 }
 
-func (rat *MongoDbRepository) CreateRating(r *domain.Rating) (*domain.Rating, error) {
-	return nil, nil
+func (rat *MongoDbRepository) CreateRating(ctx context.Context, r *domain.Rating) (*domain.Rating, error) {
+	now := time.Now()
+	r.ID = ""
+	r.CreatedAt = now
+	r.UpdatedAt = now
+
+	id, err := rat.ratings.InsertOne(ctx, r)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, types.NewError(types.ErrConflict, "rating already exists for this target", err)
+		}
+		return nil, err
+	}
+	r.ID = id
+	return r, nil
 }
 
-func (rat *MongoDbRepository) GetRatingByID(ID string) (*domain.Rating, error) {
-	return nil, nil
+func (rat *MongoDbRepository) GetRatingByID(ctx context.Context, ID string) (*domain.Rating, error) {
+	return rat.ratings.FindByID(ctx, ID)
 }
 
-func (rat *MongoDbRepository) GetRatingByTarget(targetID string, targetType domain.TargetType) ([]*domain.Rating, error) {
-	return nil, nil
+func (rat *MongoDbRepository) GetRatingByTarget(ctx context.Context, targetID string, targetType domain.TargetType) ([]*domain.Rating, error) {
+	list, err := rat.ratings.Find(ctx,
+		bson.M{"target_id": targetID, "target_type": targetType},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make([]*domain.Rating, len(list))
+	for i := range list {
+		ratings[i] = &list[i]
+	}
+	return ratings, nil
 }
 
-func (rat *MongoDbRepository) UpdateRating(r *domain.Rating) (*domain.Rating, error) {
-	return nil, nil
+func (rat *MongoDbRepository) UpdateRating(ctx context.Context, r *domain.Rating) (*domain.Rating, error) {
+	r.UpdatedAt = time.Now()
+	update := bson.M{"$set": bson.M{
+		"score":      r.Score,
+		"comment":    r.Comment,
+		"updated_at": r.UpdatedAt,
+	}}
+	if err := rat.ratings.UpdateByID(ctx, r.ID, update); err != nil {
+		return nil, err
+	}
+	return rat.ratings.FindByID(ctx, r.ID)
 }
 
-func (rat *MongoDbRepository) GetRatingByRaterAndTarget(raterID, targetID string, targetType domain.TargetType) (*domain.Rating, error) {
-	return nil, nil
+func (rat *MongoDbRepository) GetRatingByRaterAndTarget(ctx context.Context, raterID, targetID string, targetType domain.TargetType) (*domain.Rating, error) {
+	return rat.ratings.FindOne(ctx, bson.M{
+		"rater_id":    raterID,
+		"target_id":   targetID,
+		"target_type": targetType,
+	})
 }
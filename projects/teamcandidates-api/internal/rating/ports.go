@@ -1,13 +1,17 @@
 package rating
 
-import domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/rating/usecases/domain"
+import (
+	"context"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/rating/usecases/domain"
+)
 
 type Repository interface {
-	CreateRating(r *domain.Rating) (*domain.Rating, error)
-	GetRatingByID(ID string) (*domain.Rating, error)
-	GetRatingByTarget(targetID string, targetType domain.TargetType) ([]*domain.Rating, error)
-	UpdateRating(r *domain.Rating) (*domain.Rating, error)
-	GetRatingByRaterAndTarget(raterID, targetID string, targetType domain.TargetType) (*domain.Rating, error) // Para verificar si ya existe una calificación de un usuario a un evento
+	CreateRating(ctx context.Context, r *domain.Rating) (*domain.Rating, error)
+	GetRatingByID(ctx context.Context, ID string) (*domain.Rating, error)
+	GetRatingByTarget(ctx context.Context, targetID string, targetType domain.TargetType) ([]*domain.Rating, error)
+	UpdateRating(ctx context.Context, r *domain.Rating) (*domain.Rating, error)
+	GetRatingByRaterAndTarget(ctx context.Context, raterID, targetID string, targetType domain.TargetType) (*domain.Rating, error) // Para verificar si ya existe una calificación de un usuario a un evento
 }
 
 type GrpcClient any
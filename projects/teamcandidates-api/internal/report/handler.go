@@ -0,0 +1,110 @@
+package report
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	gsv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report/handler/dto"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report/usecases/domain"
+)
+
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{
+		ucs: u,
+		gsv: s,
+		mws: m,
+	}
+}
+
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/assessments"
+	protectedPrefix := apiBase + "/protected"
+
+	// Rutas protegidas: comparten el prefijo de las evaluaciones
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionAssessmentRead, types.PermissionAssessmentWrite))
+
+		protected.GET("/reports/export", h.ExportResults) // Resultados de varias evaluaciones (?format=csv|xlsx&from=&to=&skill=&hr_id=&job_opening_id=)
+		protected.GET("/:id/report", h.GetReport)         // Reporte del candidato (?format=json|html|pdf)
+	}
+}
+
+func (h *Handler) GetReport(c *gin.Context) {
+	var req dto.ReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr, errCode := types.NewAPIError(types.NewError(types.ErrValidation, "invalid query parameters", err))
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	format := req.ToDomain()
+	if format == domain.FormatJSON {
+		report, err := h.ucs.GetReport(c.Request.Context(), c.Param("id"))
+		if err != nil {
+			apiErr, errCode := types.NewAPIError(err)
+			c.Error(apiErr).SetMeta(errCode)
+			return
+		}
+		c.JSON(http.StatusOK, dto.FromDomain(report))
+		return
+	}
+
+	file, err := h.ucs.RenderReport(c.Request.Context(), c.Param("id"), format)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	sendFile(c, file, format == domain.FormatHTML)
+}
+
+func (h *Handler) ExportResults(c *gin.Context) {
+	var req dto.ExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr, errCode := types.NewAPIError(types.NewError(types.ErrValidation, "invalid query parameters", err))
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	filter, format, err := req.ToDomain()
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	file, err := h.ucs.ExportResults(c.Request.Context(), filter, format)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	sendFile(c, file, false)
+}
+
+// sendFile responde con el archivo generado. El HTML se muestra en el navegador; el resto se descarga.
+func sendFile(c *gin.Context, file *domain.File, inline bool) {
+	disposition := "attachment"
+	if inline {
+		disposition = "inline"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("%s; filename=%q", disposition, file.Name))
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
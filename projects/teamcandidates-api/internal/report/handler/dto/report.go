@@ -0,0 +1,212 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report/usecases/domain"
+)
+
+const dateLayout = "2006-01-02"
+
+type ReportRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=json html pdf"`
+}
+
+type ExportRequest struct {
+	Format       string `form:"format" binding:"omitempty,oneof=csv xlsx"`
+	From         string `form:"from" binding:"omitempty"` // RFC3339 o YYYY-MM-DD
+	To           string `form:"to" binding:"omitempty"`   // RFC3339 o YYYY-MM-DD (incluye todo el día)
+	Skill        string `form:"skill" binding:"omitempty,max=100"`
+	HRID         string `form:"hr_id" binding:"omitempty,max=100"`
+	JobOpeningID string `form:"job_opening_id" binding:"omitempty,max=256"`
+}
+
+// Mappers
+func (r *ReportRequest) ToDomain() domain.Format {
+	if r.Format == "" {
+		return domain.FormatJSON
+	}
+	return domain.Format(r.Format)
+}
+
+func (r *ExportRequest) ToDomain() (*domain.ExportFilter, domain.Format, error) {
+	format := domain.FormatCSV
+	if r.Format != "" {
+		format = domain.Format(r.Format)
+	}
+
+	filter := &domain.ExportFilter{Skill: strings.TrimSpace(r.Skill), HRID: r.HRID, JobOpeningID: r.JobOpeningID}
+	if r.From != "" {
+		from, _, err := parseDate(r.From)
+		if err != nil {
+			return nil, "", types.NewError(types.ErrInvalidInput, "invalid 'from', expected RFC3339 or YYYY-MM-DD", err)
+		}
+		filter.From = from
+	}
+	if r.To != "" {
+		to, dateOnly, err := parseDate(r.To)
+		if err != nil {
+			return nil, "", types.NewError(types.ErrInvalidInput, "invalid 'to', expected RFC3339 or YYYY-MM-DD", err)
+		}
+		if dateOnly {
+			to = to.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		filter.To = to
+	}
+	return filter, format, nil
+}
+
+// parseDate acepta un instante RFC3339 o una fecha (en UTC), e indica si era solo una fecha.
+func parseDate(raw string) (time.Time, bool, error) {
+	if t, err := time.Parse(dateLayout, raw); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	return t, false, err
+}
+
+// Response
+type Candidate struct {
+	ID         string `json:"id"`
+	Email      string `json:"email,omitempty"`
+	Experience string `json:"experience,omitempty"`
+}
+
+type Skill struct {
+	Name  string `json:"name"`
+	Level string `json:"level,omitempty"`
+}
+
+type Timing struct {
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	SubmittedAt     *time.Time `json:"submitted_at,omitempty"`
+	TimeTakenSecs   int64      `json:"time_taken_seconds"`
+	MaxDurationSecs int64      `json:"max_duration_seconds"`
+	Deadline        *time.Time `json:"deadline,omitempty"`
+	OnTime          bool       `json:"on_time"`
+}
+
+type Tests struct {
+	Status      string  `json:"status"`
+	Passed      int     `json:"passed"`
+	Total       int     `json:"total"`
+	Score       float64 `json:"score"`
+	RubricScore float64 `json:"rubric_score"`
+}
+
+type Quality struct {
+	Status        string  `json:"status"`
+	Reason        string  `json:"reason,omitempty"`
+	Score         float64 `json:"score"`
+	MaxComplexity int     `json:"max_complexity"`
+	Findings      int     `json:"findings"`
+}
+
+type Similarity struct {
+	Status   string  `json:"status"`
+	Compared int     `json:"compared"`
+	MaxScore float64 `json:"max_score"`
+	Flagged  bool    `json:"flagged"`
+}
+
+type EventCount struct {
+	Type       string `json:"type"`
+	Count      int    `json:"count"`
+	Suspicious bool   `json:"suspicious"`
+}
+
+type Integrity struct {
+	Available  bool         `json:"available"`
+	Reason     string       `json:"reason,omitempty"`
+	Total      int          `json:"total"`
+	Suspicious int          `json:"suspicious"`
+	Flagged    bool         `json:"flagged"`
+	ByType     []EventCount `json:"by_type"`
+}
+
+type Report struct {
+	AssessmentID string      `json:"assessment_id"`
+	HRID         string      `json:"hr_id"`
+	JobOpeningID string      `json:"job_opening_id,omitempty"`
+	Status       string      `json:"status"`
+	Candidate    Candidate   `json:"candidate"`
+	Skills       []Skill     `json:"skills"`
+	Timing       Timing      `json:"timing"`
+	Tests        *Tests      `json:"tests,omitempty"`
+	Quality      *Quality    `json:"quality,omitempty"`
+	Similarity   *Similarity `json:"similarity,omitempty"`
+	Integrity    Integrity   `json:"integrity"`
+	GeneratedAt  time.Time   `json:"generated_at"`
+}
+
+func FromDomain(r *domain.Report) Report {
+	a := r.Assessment
+	resp := Report{
+		AssessmentID: a.ID,
+		HRID:         a.HRID,
+		JobOpeningID: a.JobOpeningID,
+		Status:       string(a.Status),
+		Candidate:    Candidate{ID: a.CandidateID},
+		Skills:       make([]Skill, 0, len(a.Skills)),
+		Timing: Timing{
+			StartedAt:       r.Timing.StartedAt,
+			SubmittedAt:     r.Timing.SubmittedAt,
+			TimeTakenSecs:   int64(r.Timing.TimeTaken.Seconds()),
+			MaxDurationSecs: int64(r.Timing.MaxDuration.Seconds()),
+			OnTime:          r.Timing.OnTime,
+		},
+		Integrity: Integrity{
+			Available:  r.Integrity.Available,
+			Reason:     r.Integrity.Reason,
+			Total:      r.Integrity.Total,
+			Suspicious: r.Integrity.Suspicious,
+			Flagged:    r.Integrity.Flagged,
+			ByType:     make([]EventCount, 0, len(r.Integrity.ByType)),
+		},
+		GeneratedAt: r.GeneratedAt,
+	}
+	if c := r.Candidate; c != nil {
+		resp.Candidate.Email = c.Email
+		resp.Candidate.Experience = string(c.Experience.Level)
+	}
+	for _, s := range a.Skills {
+		resp.Skills = append(resp.Skills, Skill{Name: s.SkillName, Level: s.SkillLevel})
+	}
+	if !r.Timing.Deadline.IsZero() {
+		resp.Timing.Deadline = &r.Timing.Deadline
+	}
+	for _, e := range r.Integrity.ByType {
+		resp.Integrity.ByType = append(resp.Integrity.ByType, EventCount(e))
+	}
+
+	if res := r.Result; res != nil {
+		resp.Tests = &Tests{
+			Status:      string(res.Status),
+			Passed:      res.Passed,
+			Total:       res.Total,
+			Score:       res.Score,
+			RubricScore: res.RubricScore,
+		}
+		if q := res.Quality; q != nil {
+			resp.Quality = &Quality{
+				Status:        string(q.Status),
+				Reason:        q.Reason,
+				Score:         q.Score,
+				MaxComplexity: q.MaxComplexity,
+				Findings:      len(q.Findings),
+			}
+		}
+		if s := res.Similarity; s != nil {
+			resp.Similarity = &Similarity{
+				Status:   string(s.Status),
+				Compared: s.Compared,
+				MaxScore: s.MaxScore,
+				Flagged:  s.Flagged,
+			}
+		}
+	}
+	return resp
+}
@@ -0,0 +1,19 @@
+package report
+
+import (
+	"context"
+
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report/usecases/domain"
+)
+
+// UseCases arma los reportes de resultados de las evaluaciones a partir de la evaluación, la
+// entrega, la corrección y los eventos del navegador. No guarda nada: los reportes se generan
+// en cada pedido con los datos vigentes.
+type UseCases interface {
+	// GetReport devuelve el reporte de la evaluación.
+	GetReport(context.Context, string) (*domain.Report, error)
+	// RenderReport genera el reporte de la evaluación en HTML o PDF.
+	RenderReport(context.Context, string, domain.Format) (*domain.File, error)
+	// ExportResults genera una planilla CSV o XLSX con una fila por evaluación que cumple el filtro.
+	ExportResults(context.Context, *domain.ExportFilter, domain.Format) (*domain.File, error)
+}
@@ -0,0 +1,240 @@
+package report
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	pkgdocuments "github.com/teamcubation/teamcandidates/pkg/documents/local"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	browserevent "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	grading "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report/usecases/domain"
+)
+
+// exportPageSize es la cantidad de evaluaciones que se leen por página al exportar.
+const exportPageSize = 100
+
+// exportSort ordena la exportación por inicio de la evaluación; el ID desempata y alimenta el cursor.
+var exportSort = []types.SortField{
+	{Field: "start_date", Column: "start_date", Type: types.FieldTime},
+	{Field: "id", Column: "id", Type: types.FieldString},
+}
+
+type useCases struct {
+	assessmentUc   assessment.UseCases
+	candidateUc    candidate.UseCases
+	gradingUc      grading.UseCases
+	browserEventUc browserevent.UseCases
+	documents      pkgdocuments.Service
+	config         config.ReportConfig
+}
+
+// NewUseCases crea los casos de uso de los reportes de resultados.
+func NewUseCases(
+	au assessment.UseCases,
+	cu candidate.UseCases,
+	gu grading.UseCases,
+	bu browserevent.UseCases,
+	d pkgdocuments.Service,
+	cfg config.Loader,
+) UseCases {
+	return &useCases{
+		assessmentUc:   au,
+		candidateUc:    cu,
+		gradingUc:      gu,
+		browserEventUc: bu,
+		documents:      d,
+		config:         cfg.GetReportConfig(),
+	}
+}
+
+func (u *useCases) GetReport(ctx context.Context, assessmentID string) (*domain.Report, error) {
+	a, err := u.assessmentUc.GetAssessment(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	return u.buildReport(ctx, a)
+}
+
+func (u *useCases) RenderReport(ctx context.Context, assessmentID string, format domain.Format) (*domain.File, error) {
+	if !format.IsReportFormat() {
+		return nil, types.NewError(types.ErrValidation, fmt.Sprintf("unsupported report format %q (use html or pdf)", format), nil)
+	}
+
+	report, err := u.GetReport(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	return u.renderReport(report, format)
+}
+
+func (u *useCases) ExportResults(ctx context.Context, filter *domain.ExportFilter, format domain.Format) (*domain.File, error) {
+	if !format.IsExportFormat() {
+		return nil, types.NewError(types.ErrValidation, fmt.Sprintf("unsupported export format %q (use csv or xlsx)", format), nil)
+	}
+	if filter == nil {
+		filter = &domain.ExportFilter{}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return nil, types.NewError(types.ErrValidation, "export date range is invalid: to is before from", nil)
+	}
+
+	reports, err := u.listReports(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return u.renderExport(reports, format)
+}
+
+// listReports recorre las evaluaciones que cumplen el filtro, página por página, y arma el
+// reporte de cada una. La habilidad se filtra en memoria: el listado no trae las skills.
+func (u *useCases) listReports(ctx context.Context, filter *domain.ExportFilter) ([]domain.Report, error) {
+	spec := &types.QuerySpec{Limit: exportPageSize, Sort: exportSort}
+	if !filter.From.IsZero() {
+		spec.AddFilter("start_date", "start_date", types.OpGte, filter.From)
+	}
+	if !filter.To.IsZero() {
+		spec.AddFilter("start_date", "start_date", types.OpLte, filter.To)
+	}
+	if filter.HRID != "" {
+		spec.AddFilter("hr_id", "hr_id", types.OpEq, filter.HRID)
+	}
+	if filter.JobOpeningID != "" {
+		spec.AddFilter("job_opening_id", "job_opening_id", types.OpEq, filter.JobOpeningID)
+	}
+
+	var reports []domain.Report
+	for {
+		page, err := u.assessmentUc.ListAssessments(ctx, spec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list assessments: %w", err)
+		}
+
+		for _, item := range page.Items {
+			a, err := u.assessmentUc.GetAssessment(ctx, item.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get assessment %s: %w", item.ID, err)
+			}
+			if !hasSkill(a, filter.Skill) {
+				continue
+			}
+			if len(reports) == u.config.ExportMaxRows {
+				return nil, types.NewError(types.ErrValidation,
+					fmt.Sprintf("export exceeds %d assessments, narrow the filters", u.config.ExportMaxRows), nil)
+			}
+
+			report, err := u.buildReport(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+			reports = append(reports, *report)
+		}
+
+		if !page.Info.HasMore || len(page.Items) == 0 {
+			return reports, nil
+		}
+		if page.Info.NextCursor != "" {
+			spec.Cursor = page.Info.NextCursor
+		} else {
+			spec.Offset += len(page.Items)
+		}
+	}
+}
+
+// buildReport junta los datos de la evaluación. Lo que falta (candidato borrado, sin entrega,
+// sin corrección o sin eventos) queda vacío en el reporte en lugar de impedir generarlo.
+func (u *useCases) buildReport(ctx context.Context, a *assdomain.Assessment) (*domain.Report, error) {
+	report := &domain.Report{Assessment: a, GeneratedAt: time.Now()}
+
+	if a.CandidateID != "" {
+		// El reporte sigue sirviendo sin los datos de contacto del candidato
+		c, err := u.candidateUc.GetCandidate(ctx, a.CandidateID)
+		if err != nil && !types.IsNotFound(err) {
+			log.Printf("report: failed to get candidate %s: %v", a.CandidateID, err)
+		}
+		report.Candidate = c
+	}
+
+	session, err := u.assessmentUc.GetSubmission(ctx, a.ID)
+	if err != nil && !types.IsNotFound(err) && !types.IsConflict(err) {
+		return nil, fmt.Errorf("failed to get submission: %w", err)
+	}
+	report.Session = session
+
+	result, err := u.gradingUc.GetResult(ctx, a.ID)
+	if err != nil && !types.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get grading result: %w", err)
+	}
+	report.Result = result
+
+	report.Timing = timing(a, session)
+	report.Integrity = u.integrity(ctx, a.ID)
+	return report, nil
+}
+
+// timing calcula el tiempo usado a partir de la sesión entregada o, si no hay entrega, de las
+// fechas de la evaluación.
+func timing(a *assdomain.Assessment, session *assdomain.Session) domain.Timing {
+	t := domain.Timing{MaxDuration: a.MaxDuration, Deadline: a.Deadline()}
+	if !a.StartDate.IsZero() {
+		t.StartedAt = &a.StartDate
+	}
+	if session == nil {
+		return t
+	}
+
+	t.StartedAt = &session.StartedAt
+	t.SubmittedAt = session.SubmittedAt
+	if t.SubmittedAt != nil {
+		t.TimeTaken = t.SubmittedAt.Sub(session.StartedAt)
+		t.OnTime = t.Deadline.IsZero() || !t.SubmittedAt.After(t.Deadline)
+	}
+	return t
+}
+
+// integrity cuenta los eventos del navegador de la evaluación. Si el almacén de eventos no
+// responde el reporte se genera igual, indicando que la integridad no está disponible.
+func (u *useCases) integrity(ctx context.Context, assessmentID string) domain.Integrity {
+	events, err := u.browserEventUc.ListAssessmentEvents(ctx, assessmentID)
+	if err != nil {
+		log.Printf("report: failed to list browser events of assessment %s: %v", assessmentID, err)
+		return domain.Integrity{Reason: "browser events are not available"}
+	}
+
+	integrity := domain.Integrity{Available: true, Total: len(events)}
+	counts := make(map[string]int)
+	for _, e := range events {
+		counts[e.EventType]++
+	}
+	for eventType, count := range counts {
+		suspicious := slices.Contains(domain.SuspiciousEventTypes, eventType)
+		if suspicious {
+			integrity.Suspicious += count
+		}
+		integrity.ByType = append(integrity.ByType, domain.EventCount{Type: eventType, Count: count, Suspicious: suspicious})
+	}
+	slices.SortFunc(integrity.ByType, func(a, b domain.EventCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.Type, b.Type))
+	})
+	integrity.Flagged = integrity.Suspicious >= u.config.IntegrityFlagEvents
+	return integrity
+}
+
+func hasSkill(a *assdomain.Assessment, skill string) bool {
+	if skill == "" {
+		return true
+	}
+	return slices.ContainsFunc(a.Skills, func(s assdomain.SkillConfig) bool {
+		return strings.EqualFold(strings.TrimSpace(s.SkillName), strings.TrimSpace(skill))
+	})
+}
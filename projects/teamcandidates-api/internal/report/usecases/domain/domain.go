@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"time"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	candomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+	gradingdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+)
+
+// Format es el formato en el que se genera un reporte o una exportación.
+type Format string
+
+const (
+	FormatJSON Format = "json" // Reporte por candidato como datos, sin renderizar
+	FormatHTML Format = "html" // Reporte por candidato
+	FormatPDF  Format = "pdf"  // Reporte por candidato
+	FormatCSV  Format = "csv"  // Exportación masiva
+	FormatXLSX Format = "xlsx" // Exportación masiva
+)
+
+// IsReportFormat indica si el formato sirve para renderizar el reporte de un candidato.
+func (f Format) IsReportFormat() bool {
+	return f == FormatHTML || f == FormatPDF
+}
+
+// IsExportFormat indica si el formato sirve para la exportación masiva.
+func (f Format) IsExportFormat() bool {
+	return f == FormatCSV || f == FormatXLSX
+}
+
+// SuspiciousEventTypes son los eventos del navegador que sugieren ayuda externa: copiar y pegar
+// código o salir de la pestaña de la evaluación.
+var SuspiciousEventTypes = []string{"paste", "copy", "cut", "blur", "visibilitychange", "contextmenu"}
+
+// Report resume el resultado de una evaluación para compartirlo con RR. HH.: pruebas aprobadas,
+// tiempo usado, integridad según los eventos del navegador y calidad del código.
+type Report struct {
+	Assessment  *assdomain.Assessment
+	Candidate   *candomain.Candidate  // nil si el candidato ya no existe
+	Session     *assdomain.Session    // Entrega; nil si el candidato no entregó
+	Result      *gradingdomain.Result // Corrección vigente; nil si no se corrigió
+	Timing      Timing
+	Integrity   Integrity
+	GeneratedAt time.Time
+}
+
+// Timing es el uso del tiempo de la evaluación.
+type Timing struct {
+	StartedAt   *time.Time
+	SubmittedAt *time.Time
+	TimeTaken   time.Duration // Desde el inicio hasta la entrega; cero si no entregó
+	MaxDuration time.Duration
+	Deadline    time.Time
+	OnTime      bool // Entregó antes del vencimiento (o la evaluación no tenía límite)
+}
+
+// Integrity resume los eventos del navegador registrados durante la evaluación.
+type Integrity struct {
+	Available  bool   // false si no se pudieron leer los eventos
+	Reason     string // Motivo si Available es false
+	Total      int
+	Suspicious int
+	ByType     []EventCount // Ordenados por cantidad, de mayor a menor
+	Flagged    bool         // Suspicious alcanza el umbral de revisión
+}
+
+// EventCount es la cantidad de eventos de un tipo.
+type EventCount struct {
+	Type       string
+	Count      int
+	Suspicious bool
+}
+
+// ExportFilter selecciona las evaluaciones de una exportación masiva. Los campos vacíos no filtran.
+type ExportFilter struct {
+	From         time.Time // Inicio de la evaluación desde (inclusive)
+	To           time.Time // Inicio de la evaluación hasta (inclusive)
+	Skill        string    // Habilidad requerida, sin distinguir mayúsculas
+	HRID         string    // Responsable de RR. HH.
+	JobOpeningID string    // Búsqueda para la que se crearon las evaluaciones
+}
+
+// File es un reporte o una exportación ya generados.
+type File struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
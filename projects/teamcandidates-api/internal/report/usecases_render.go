@@ -0,0 +1,373 @@
+package report
+
+import (
+	"bytes"
+	"embed"
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	pkgdocuments "github.com/teamcubation/teamcandidates/pkg/documents/local"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report/usecases/domain"
+)
+
+// maxPDFFindings limita los hallazgos de calidad que se listan en el PDF; el HTML los lista todos.
+const maxPDFFindings = 20
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+var reportTemplate = template.Must(template.New("report.html").Funcs(template.FuncMap{
+	"datetime": formatTime,
+	"duration": formatDuration,
+	"score":    formatScore,
+	"skills":   formatSkills,
+}).ParseFS(templatesFS, "templates/report.html"))
+
+// exportHeader son las columnas de la exportación masiva, en el mismo orden que exportRow.
+var exportHeader = []string{
+	"assessment_id", "candidate_id", "candidate_email", "hr_id", "job_opening_id", "status", "skills",
+	"started_at", "submitted_at", "time_taken_minutes", "on_time",
+	"grading_status", "tests_passed", "tests_total", "test_score", "final_score", "quality_score",
+	"similarity_score", "similarity_flagged", "browser_events", "suspicious_events", "integrity_flagged",
+}
+
+func (u *useCases) renderReport(report *domain.Report, format domain.Format) (*domain.File, error) {
+	name := fmt.Sprintf("assessment-%s-report.%s", report.Assessment.ID, format)
+
+	if format == domain.FormatHTML {
+		var buf bytes.Buffer
+		if err := reportTemplate.Execute(&buf, report); err != nil {
+			return nil, fmt.Errorf("failed to render report: %w", err)
+		}
+		return &domain.File{Name: name, ContentType: "text/html; charset=utf-8", Content: buf.Bytes()}, nil
+	}
+
+	content, err := u.documents.PDF(pdfDocument(report))
+	if err != nil {
+		return nil, fmt.Errorf("failed to render report: %w", err)
+	}
+	return &domain.File{Name: name, ContentType: "application/pdf", Content: content}, nil
+}
+
+func (u *useCases) renderExport(reports []domain.Report, format domain.Format) (*domain.File, error) {
+	rows := make([][]any, 0, len(reports))
+	for i := range reports {
+		rows = append(rows, exportRow(&reports[i]))
+	}
+	name := fmt.Sprintf("assessment-results-%s.%s", time.Now().Format("20060102-150405"), format)
+
+	if format == domain.FormatCSV {
+		content, err := writeCSV(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render export: %w", err)
+		}
+		return &domain.File{Name: name, ContentType: "text/csv; charset=utf-8", Content: content}, nil
+	}
+
+	content, err := u.documents.XLSX(pkgdocuments.Sheet{Name: "Results", Header: exportHeader, Rows: rows})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render export: %w", err)
+	}
+	return &domain.File{
+		Name:        name,
+		ContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		Content:     content,
+	}, nil
+}
+
+// exportRow devuelve la fila de la evaluación. Los datos que faltan quedan vacíos (nil).
+func exportRow(r *domain.Report) []any {
+	a := r.Assessment
+	row := []any{a.ID, a.CandidateID, nil, a.HRID, a.JobOpeningID, string(a.Status), formatSkills(a.Skills)}
+	if r.Candidate != nil {
+		row[2] = r.Candidate.Email
+	}
+
+	row = append(row, timeCell(r.Timing.StartedAt), timeCell(r.Timing.SubmittedAt))
+	if r.Timing.SubmittedAt != nil {
+		row = append(row, round(r.Timing.TimeTaken.Minutes()), r.Timing.OnTime)
+	} else {
+		row = append(row, nil, nil)
+	}
+
+	if res := r.Result; res != nil {
+		row = append(row, string(res.Status), res.Passed, res.Total, res.Score, res.RubricScore)
+		if res.Quality != nil && res.Quality.IsAnalyzed() {
+			row = append(row, res.Quality.Score)
+		} else {
+			row = append(row, nil)
+		}
+		if res.Similarity != nil && res.Similarity.Compared > 0 {
+			row = append(row, res.Similarity.MaxScore, res.Similarity.Flagged)
+		} else {
+			row = append(row, nil, nil)
+		}
+	} else {
+		row = append(row, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+
+	if r.Integrity.Available {
+		row = append(row, r.Integrity.Total, r.Integrity.Suspicious, r.Integrity.Flagged)
+	} else {
+		row = append(row, nil, nil, nil)
+	}
+	return row
+}
+
+// writeCSV escribe la exportación con su encabezado. Los textos que empiezan como una fórmula
+// se escapan con un apóstrofo para que la planilla no los evalúe al abrir el archivo.
+func writeCSV(rows [][]any) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(exportHeader); err != nil {
+		return nil, err
+	}
+
+	record := make([]string, len(exportHeader))
+	for _, row := range rows {
+		for i, v := range row {
+			record[i] = csvValue(v)
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func csvValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+			return "'" + v
+		}
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// pdfDocument arma el PDF con las mismas secciones que el template HTML.
+func pdfDocument(r *domain.Report) *pkgdocuments.Document {
+	a := r.Assessment
+	doc := &pkgdocuments.Document{
+		Title:    "Assessment report",
+		Subtitle: fmt.Sprintf("Assessment %s - generated %s", a.ID, formatTime(r.GeneratedAt)),
+		Author:   a.HRID,
+	}
+
+	email := "not available"
+	if r.Candidate != nil {
+		email = r.Candidate.Email
+	}
+	doc.Sections = append(doc.Sections, pkgdocuments.Section{
+		Heading: "Candidate",
+		Fields: []pkgdocuments.Field{
+			{Label: "Candidate", Value: email},
+			{Label: "Candidate ID", Value: a.CandidateID},
+			{Label: "HR owner", Value: a.HRID},
+			{Label: "Status", Value: string(a.Status)},
+			{Label: "Skills", Value: formatSkills(a.Skills)},
+		},
+	})
+
+	t := r.Timing
+	timeSection := pkgdocuments.Section{Heading: "Time", Fields: []pkgdocuments.Field{
+		{Label: "Started", Value: timeOr(t.StartedAt, "not started")},
+		{Label: "Submitted", Value: timeOr(t.SubmittedAt, "not submitted")},
+	}}
+	if t.SubmittedAt != nil {
+		timeSection.Fields = append(timeSection.Fields,
+			pkgdocuments.Field{Label: "Time taken", Value: formatDuration(t.TimeTaken)},
+			pkgdocuments.Field{Label: "On time", Value: yesNo(t.OnTime)})
+	}
+	if t.MaxDuration > 0 {
+		timeSection.Fields = append(timeSection.Fields, pkgdocuments.Field{Label: "Time limit", Value: formatDuration(t.MaxDuration)})
+	}
+	doc.Sections = append(doc.Sections, timeSection, testsSection(r), qualitySection(r), integritySection(r))
+
+	if r.Session != nil && r.Session.Code != "" {
+		doc.Sections = append(doc.Sections, pkgdocuments.Section{
+			Heading:    "Submission",
+			Paragraphs: []string{"Language: " + r.Session.Language},
+			Code:       r.Session.Code,
+		})
+	}
+	return doc
+}
+
+func testsSection(r *domain.Report) pkgdocuments.Section {
+	s := pkgdocuments.Section{Heading: "Tests"}
+	res := r.Result
+	if res == nil {
+		s.Paragraphs = []string{"The assessment has not been graded."}
+		return s
+	}
+
+	s.Fields = []pkgdocuments.Field{
+		{Label: "Grading status", Value: string(res.Status)},
+		{Label: "Tests passed", Value: fmt.Sprintf("%d / %d", res.Passed, res.Total)},
+		{Label: "Test score", Value: formatScore(res.Score)},
+		{Label: "Final score", Value: formatScore(res.RubricScore)},
+	}
+	if res.Error != "" {
+		s.Fields = append(s.Fields, pkgdocuments.Field{Label: "Error", Value: res.Error})
+	}
+	for _, test := range res.Tests {
+		result := "passed"
+		switch {
+		case test.TimedOut:
+			result = "timed out"
+		case !test.Passed:
+			result = "failed"
+		}
+		visibility := "visible"
+		if test.Hidden {
+			visibility = "hidden"
+		}
+		s.Fields = append(s.Fields, pkgdocuments.Field{
+			Label: test.TestName,
+			Value: fmt.Sprintf("%s (%s, %s)", result, visibility, formatDuration(test.Runtime)),
+		})
+	}
+	s.Code = res.CompileOutput
+	return s
+}
+
+func qualitySection(r *domain.Report) pkgdocuments.Section {
+	s := pkgdocuments.Section{Heading: "Code quality"}
+	if r.Result == nil || r.Result.Quality == nil {
+		s.Paragraphs = []string{"Not analyzed."}
+		return s
+	}
+	q := r.Result.Quality
+	if !q.IsAnalyzed() {
+		s.Paragraphs = []string{"Not analyzed: " + q.Reason}
+		return s
+	}
+
+	s.Fields = []pkgdocuments.Field{
+		{Label: "Quality score", Value: formatScore(q.Score)},
+		{Label: "Lines / functions", Value: fmt.Sprintf("%d / %d", q.Lines, q.Functions)},
+		{Label: "Max complexity", Value: strconv.Itoa(q.MaxComplexity)},
+	}
+	for _, d := range q.Dimensions {
+		s.Fields = append(s.Fields, pkgdocuments.Field{Label: d.Name, Value: fmt.Sprintf("%s (%d findings)", formatScore(d.Score), d.Findings)})
+	}
+	for i, f := range q.Findings {
+		if i == maxPDFFindings {
+			s.Paragraphs = append(s.Paragraphs, fmt.Sprintf("... and %d more findings.", len(q.Findings)-maxPDFFindings))
+			break
+		}
+		s.Paragraphs = append(s.Paragraphs, fmt.Sprintf("Line %d (%s): %s", f.Line, f.Dimension, f.Message))
+	}
+	return s
+}
+
+func integritySection(r *domain.Report) pkgdocuments.Section {
+	s := pkgdocuments.Section{Heading: "Integrity"}
+	in := r.Integrity
+	if !in.Available {
+		s.Paragraphs = []string{in.Reason}
+	} else {
+		suspicious := strconv.Itoa(in.Suspicious)
+		if in.Flagged {
+			suspicious += " (review)"
+		}
+		s.Fields = []pkgdocuments.Field{
+			{Label: "Browser events", Value: strconv.Itoa(in.Total)},
+			{Label: "Suspicious events", Value: suspicious},
+		}
+		for _, e := range in.ByType {
+			value := strconv.Itoa(e.Count)
+			if e.Suspicious {
+				value += " (suspicious)"
+			}
+			s.Fields = append(s.Fields, pkgdocuments.Field{Label: e.Type, Value: value})
+		}
+	}
+
+	if r.Result != nil && r.Result.Similarity != nil && r.Result.Similarity.Compared > 0 {
+		sim := r.Result.Similarity
+		value := fmt.Sprintf("%s, compared with %d submissions", formatScore(sim.MaxScore), sim.Compared)
+		if sim.Flagged {
+			value += " (review)"
+		}
+		s.Fields = append(s.Fields, pkgdocuments.Field{Label: "Similarity", Value: value})
+	}
+	return s
+}
+
+func formatTime(t any) string {
+	switch t := t.(type) {
+	case time.Time:
+		return t.UTC().Format("2006-01-02 15:04 MST")
+	case *time.Time:
+		if t != nil {
+			return t.UTC().Format("2006-01-02 15:04 MST")
+		}
+	}
+	return ""
+}
+
+func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(time.Second).String()
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(round(score), 'f', -1, 64) + "%"
+}
+
+func formatSkills(skills []assdomain.SkillConfig) string {
+	names := make([]string, 0, len(skills))
+	for _, s := range skills {
+		if s.SkillLevel != "" {
+			names = append(names, fmt.Sprintf("%s (%s)", s.SkillName, s.SkillLevel))
+		} else {
+			names = append(names, s.SkillName)
+		}
+	}
+	return strings.Join(names, "; ")
+}
+
+func timeCell(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+func timeOr(t *time.Time, missing string) string {
+	if t == nil {
+		return missing
+	}
+	return formatTime(t)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,470 @@
+package report
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	pkgdocuments "github.com/teamcubation/teamcandidates/pkg/documents/local"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	mock_assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/mocks"
+	mock_browserevent "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events/mocks"
+	mock_candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/mocks"
+	mock_config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config/mocks"
+	mock_grading "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/mocks"
+
+	assdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment/usecases/domain"
+	bedomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events/usecases/domain"
+	candomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate/usecases/domain"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	gradingdomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report/usecases/domain"
+)
+
+// fakeDocuments guarda el documento y las hojas que recibe en lugar de generar los archivos.
+type fakeDocuments struct {
+	doc    *pkgdocuments.Document
+	sheets []pkgdocuments.Sheet
+}
+
+func (d *fakeDocuments) PDF(doc *pkgdocuments.Document) ([]byte, error) {
+	d.doc = doc
+	return []byte("%PDF"), nil
+}
+
+func (d *fakeDocuments) XLSX(sheets ...pkgdocuments.Sheet) ([]byte, error) {
+	d.sheets = sheets
+	return []byte("PK"), nil
+}
+
+type fields struct {
+	assessment   *mock_assessment.MockUseCases
+	candidate    *mock_candidate.MockUseCases
+	grading      *mock_grading.MockUseCases
+	browserEvent *mock_browserevent.MockUseCases
+	documents    *fakeDocuments
+	config       *mock_config.MockLoader
+}
+
+func newFields(ctrl *gomock.Controller) *fields {
+	f := &fields{
+		assessment:   mock_assessment.NewMockUseCases(ctrl),
+		candidate:    mock_candidate.NewMockUseCases(ctrl),
+		grading:      mock_grading.NewMockUseCases(ctrl),
+		browserEvent: mock_browserevent.NewMockUseCases(ctrl),
+		documents:    &fakeDocuments{},
+		config:       mock_config.NewMockLoader(ctrl),
+	}
+	f.config.EXPECT().GetReportConfig().Return(config.ReportConfig{IntegrityFlagEvents: 3, ExportMaxRows: 2}).AnyTimes()
+	return f
+}
+
+func (f *fields) useCases() UseCases {
+	return NewUseCases(f.assessment, f.candidate, f.grading, f.browserEvent, f.documents, f.config)
+}
+
+var (
+	startedAt   = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	submittedAt = startedAt.Add(40 * time.Minute)
+)
+
+func newAssessment(id string, skills ...string) *assdomain.Assessment {
+	a := &assdomain.Assessment{
+		ID:          id,
+		HRID:        "hr1",
+		CandidateID: "cand-" + id,
+		StartDate:   startedAt,
+		MaxDuration: time.Hour,
+		Status:      assdomain.AssessmentStatus("graded"),
+	}
+	for _, s := range skills {
+		a.Skills = append(a.Skills, assdomain.SkillConfig{SkillName: s})
+	}
+	return a
+}
+
+// expectComplete devuelve los datos de una evaluación entregada, corregida y con eventos.
+func (f *fields) expectComplete(a *assdomain.Assessment) {
+	f.candidate.EXPECT().
+		GetCandidate(gomock.Any(), a.CandidateID).
+		Return(&candomain.Candidate{ID: a.CandidateID, Email: a.CandidateID + "@mail.com"}, nil)
+	f.assessment.EXPECT().
+		GetSubmission(gomock.Any(), a.ID).
+		Return(&assdomain.Session{ID: "ses-" + a.ID, Language: "go", Code: "package main", StartedAt: startedAt, SubmittedAt: &submittedAt}, nil)
+	f.grading.EXPECT().
+		GetResult(gomock.Any(), a.ID).
+		Return(&gradingdomain.Result{
+			Status:      gradingdomain.StatusCompleted,
+			Passed:      3,
+			Total:       4,
+			Score:       75,
+			RubricScore: 80,
+			Quality:     &gradingdomain.QualityReport{Status: gradingdomain.QualityAnalyzed, Score: 90},
+			Similarity:  &gradingdomain.Similarity{Status: gradingdomain.SimilarityChecked, Compared: 2, MaxScore: 72.5, Flagged: true},
+		}, nil)
+	f.browserEvent.EXPECT().
+		ListAssessmentEvents(gomock.Any(), a.ID).
+		Return([]*bedomain.BrowserEvent{{EventType: "keydown"}, {EventType: "paste"}, {EventType: "blur"}, {EventType: "paste"}}, nil)
+}
+
+// expectEmpty devuelve una evaluación sin candidato, sin entrega, sin corrección ni eventos.
+func (f *fields) expectEmpty(a *assdomain.Assessment) {
+	f.candidate.EXPECT().
+		GetCandidate(gomock.Any(), a.CandidateID).
+		Return(nil, types.NewError(types.ErrNotFound, "candidate not found", nil))
+	f.assessment.EXPECT().
+		GetSubmission(gomock.Any(), a.ID).
+		Return(nil, types.NewError(types.ErrConflict, "assessment session has not been submitted", nil))
+	f.grading.EXPECT().
+		GetResult(gomock.Any(), a.ID).
+		Return(nil, types.NewError(types.ErrNotFound, "grading result not found", nil))
+	f.browserEvent.EXPECT().
+		ListAssessmentEvents(gomock.Any(), a.ID).
+		Return(nil, errors.New("redis down"))
+}
+
+func TestGetReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		setup   func(f *fields)
+		check   func(t *testing.T, r *domain.Report)
+		wantErr bool
+	}{
+		{
+			name: "Success: submitted and graded assessment",
+			setup: func(f *fields) {
+				a := newAssessment("ass1")
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass1").Return(a, nil)
+				f.expectComplete(a)
+			},
+			check: func(t *testing.T, r *domain.Report) {
+				assert.Equal(t, "cand-ass1@mail.com", r.Candidate.Email)
+				assert.Equal(t, 75.0, r.Result.Score)
+				assert.Equal(t, domain.Timing{
+					StartedAt:   &startedAt,
+					SubmittedAt: &submittedAt,
+					TimeTaken:   40 * time.Minute,
+					MaxDuration: time.Hour,
+					Deadline:    startedAt.Add(time.Hour),
+					OnTime:      true,
+				}, r.Timing, "timing mismatch")
+				assert.Equal(t, domain.Integrity{
+					Available:  true,
+					Total:      4,
+					Suspicious: 3,
+					Flagged:    true,
+					ByType: []domain.EventCount{
+						{Type: "paste", Count: 2, Suspicious: true},
+						{Type: "blur", Count: 1, Suspicious: true},
+						{Type: "keydown", Count: 1},
+					},
+				}, r.Integrity, "integrity mismatch")
+			},
+		},
+		{
+			name: "Success: missing data is left empty",
+			setup: func(f *fields) {
+				a := newAssessment("ass1")
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass1").Return(a, nil)
+				f.expectEmpty(a)
+			},
+			check: func(t *testing.T, r *domain.Report) {
+				assert.Nil(t, r.Candidate)
+				assert.Nil(t, r.Session)
+				assert.Nil(t, r.Result)
+				assert.Equal(t, &startedAt, r.Timing.StartedAt, "without submission the assessment start is used")
+				assert.Nil(t, r.Timing.SubmittedAt)
+				assert.False(t, r.Integrity.Available)
+				assert.NotEmpty(t, r.Integrity.Reason)
+			},
+		},
+		{
+			name: "Success: late submission",
+			setup: func(f *fields) {
+				a := newAssessment("ass1")
+				a.MaxDuration = 30 * time.Minute
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass1").Return(a, nil)
+				f.expectComplete(a)
+			},
+			check: func(t *testing.T, r *domain.Report) {
+				assert.False(t, r.Timing.OnTime, "submission after the deadline should not be on time")
+			},
+		},
+		{
+			name: "Error: assessment not found",
+			setup: func(f *fields) {
+				f.assessment.EXPECT().
+					GetAssessment(gomock.Any(), "ass1").
+					Return(nil, types.NewError(types.ErrNotFound, "assessment not found", nil))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error: grading result cannot be read",
+			setup: func(f *fields) {
+				a := newAssessment("ass1")
+				a.CandidateID = ""
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass1").Return(a, nil)
+				f.assessment.EXPECT().GetSubmission(gomock.Any(), "ass1").Return(nil, types.NewError(types.ErrNotFound, "no session", nil))
+				f.grading.EXPECT().GetResult(gomock.Any(), "ass1").Return(nil, errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			report, err := f.useCases().GetReport(context.Background(), "ass1")
+
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error but got none")
+				assert.Nil(t, report)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			tc.check(t, report)
+		})
+	}
+}
+
+func TestRenderReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name            string
+		format          domain.Format
+		setup           func(f *fields)
+		wantContentType string
+		check           func(t *testing.T, f *fields, file *domain.File)
+		wantErr         bool
+	}{
+		{
+			name:   "Success: HTML report escapes the candidate data",
+			format: domain.FormatHTML,
+			setup: func(f *fields) {
+				a := newAssessment("ass1", "Go")
+				a.HRID = "<script>alert(1)</script>"
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass1").Return(a, nil)
+				f.expectComplete(a)
+			},
+			wantContentType: "text/html; charset=utf-8",
+			check: func(t *testing.T, f *fields, file *domain.File) {
+				html := string(file.Content)
+				assert.Contains(t, html, "cand-ass1@mail.com")
+				assert.Contains(t, html, "80%", "final score should be rendered")
+				assert.NotContains(t, html, "<script>alert(1)</script>", "report data must be escaped")
+			},
+		},
+		{
+			name:   "Success: PDF report has the same sections",
+			format: domain.FormatPDF,
+			setup: func(f *fields) {
+				a := newAssessment("ass1", "Go")
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass1").Return(a, nil)
+				f.expectComplete(a)
+			},
+			wantContentType: "application/pdf",
+			check: func(t *testing.T, f *fields, file *domain.File) {
+				var headings []string
+				for _, s := range f.documents.doc.Sections {
+					headings = append(headings, s.Heading)
+				}
+				assert.Equal(t, []string{"Candidate", "Time", "Tests", "Code quality", "Integrity", "Submission"}, headings)
+				integrity := f.documents.doc.Sections[4]
+				assert.Contains(t, integrity.Fields, pkgdocuments.Field{Label: "Suspicious events", Value: "3 (review)"})
+				assert.Contains(t, integrity.Fields, pkgdocuments.Field{Label: "Similarity", Value: "72.5%, compared with 2 submissions (review)"})
+			},
+		},
+		{
+			name:   "Success: PDF of an assessment without submission",
+			format: domain.FormatPDF,
+			setup: func(f *fields) {
+				a := newAssessment("ass1")
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass1").Return(a, nil)
+				f.expectEmpty(a)
+			},
+			wantContentType: "application/pdf",
+			check: func(t *testing.T, f *fields, file *domain.File) {
+				sections := f.documents.doc.Sections
+				assert.Len(t, sections, 5, "without code there is no submission section")
+				assert.Equal(t, []string{"The assessment has not been graded."}, sections[2].Paragraphs)
+				assert.Equal(t, []string{"browser events are not available"}, sections[4].Paragraphs)
+			},
+		},
+		{
+			name:    "Error: export format is not a report format",
+			format:  domain.FormatCSV,
+			setup:   func(f *fields) {},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			file, err := f.useCases().RenderReport(context.Background(), "ass1", tc.format)
+
+			if tc.wantErr {
+				assert.True(t, types.IsValidationError(err), "expected a validation error, got %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.Equal(t, "assessment-ass1-report."+string(tc.format), file.Name)
+			assert.Equal(t, tc.wantContentType, file.ContentType)
+			tc.check(t, f, file)
+		})
+	}
+}
+
+func TestExportResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name    string
+		filter  *domain.ExportFilter
+		format  domain.Format
+		setup   func(f *fields)
+		check   func(t *testing.T, f *fields, file *domain.File)
+		wantErr bool
+	}{
+		{
+			name:   "Success: CSV pages through the assessments and filters by skill",
+			filter: &domain.ExportFilter{From: from, To: to, HRID: "hr1", JobOpeningID: "job1", Skill: " go "},
+			format: domain.FormatCSV,
+			setup: func(f *fields) {
+				first, second, python := newAssessment("ass1", "Go"), newAssessment("ass2", "go", "SQL"), newAssessment("ass3", "Python")
+				first.HRID = "=HYPERLINK(\"x\")"
+
+				f.assessment.EXPECT().
+					ListAssessments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, spec *types.QuerySpec) (*types.Page[assdomain.Assessment], error) {
+						assert.Equal(t, []types.Filter{
+							{Field: "start_date", Column: "start_date", Op: types.OpGte, Values: []any{from}},
+							{Field: "start_date", Column: "start_date", Op: types.OpLte, Values: []any{to}},
+							{Field: "hr_id", Column: "hr_id", Op: types.OpEq, Values: []any{"hr1"}},
+							{Field: "job_opening_id", Column: "job_opening_id", Op: types.OpEq, Values: []any{"job1"}},
+						}, spec.Filters, "filters mismatch")
+						assert.Empty(t, spec.Cursor, "first page has no cursor")
+						return &types.Page[assdomain.Assessment]{
+							Items: []assdomain.Assessment{{ID: "ass1"}, {ID: "ass3"}},
+							Info:  types.PageInfo{HasMore: true, NextCursor: "next"},
+						}, nil
+					})
+				f.assessment.EXPECT().
+					ListAssessments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, spec *types.QuerySpec) (*types.Page[assdomain.Assessment], error) {
+						assert.Equal(t, "next", spec.Cursor, "second page should use the cursor")
+						return &types.Page[assdomain.Assessment]{Items: []assdomain.Assessment{{ID: "ass2"}}}, nil
+					})
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass1").Return(first, nil)
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass3").Return(python, nil)
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass2").Return(second, nil)
+				f.expectComplete(first)
+				f.expectEmpty(second)
+			},
+			check: func(t *testing.T, f *fields, file *domain.File) {
+				assert.Equal(t, "text/csv; charset=utf-8", file.ContentType)
+				records, err := csv.NewReader(strings.NewReader(string(file.Content))).ReadAll()
+				assert.NoError(t, err)
+				assert.Len(t, records, 3, "header and one row per assessment with the skill")
+				assert.Equal(t, exportHeader, records[0])
+				assert.Equal(t, []string{
+					"ass1", "cand-ass1", "cand-ass1@mail.com", "'=HYPERLINK(\"x\")", "", "graded", "Go",
+					"2024-05-01T10:00:00Z", "2024-05-01T10:40:00Z", "40", "true",
+					"completed", "3", "4", "75", "80", "90",
+					"72.5", "true", "4", "3", "true",
+				}, records[1], "complete row mismatch")
+				assert.Equal(t, []string{
+					"ass2", "cand-ass2", "", "hr1", "", "graded", "go; SQL",
+					"2024-05-01T10:00:00Z", "", "", "",
+					"", "", "", "", "", "",
+					"", "", "", "", "",
+				}, records[2], "row without data mismatch")
+			},
+		},
+		{
+			name:   "Success: XLSX export",
+			format: domain.FormatXLSX,
+			setup: func(f *fields) {
+				a := newAssessment("ass1")
+				f.assessment.EXPECT().
+					ListAssessments(gomock.Any(), gomock.Any()).
+					Return(&types.Page[assdomain.Assessment]{Items: []assdomain.Assessment{{ID: "ass1"}}}, nil)
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass1").Return(a, nil)
+				f.expectComplete(a)
+			},
+			check: func(t *testing.T, f *fields, file *domain.File) {
+				assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", file.ContentType)
+				assert.Len(t, f.documents.sheets, 1)
+				assert.Equal(t, exportHeader, f.documents.sheets[0].Header)
+				assert.Len(t, f.documents.sheets[0].Rows, 1)
+			},
+		},
+		{
+			name:   "Error: export exceeds the maximum rows",
+			format: domain.FormatCSV,
+			setup: func(f *fields) {
+				f.assessment.EXPECT().
+					ListAssessments(gomock.Any(), gomock.Any()).
+					Return(&types.Page[assdomain.Assessment]{Items: []assdomain.Assessment{{ID: "ass1"}, {ID: "ass2"}, {ID: "ass3"}}}, nil)
+				for _, id := range []string{"ass1", "ass2"} {
+					a := newAssessment(id)
+					f.assessment.EXPECT().GetAssessment(gomock.Any(), id).Return(a, nil)
+					f.expectComplete(a)
+				}
+				f.assessment.EXPECT().GetAssessment(gomock.Any(), "ass3").Return(newAssessment("ass3"), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Error: invalid date range",
+			filter:  &domain.ExportFilter{From: to, To: from},
+			format:  domain.FormatCSV,
+			setup:   func(f *fields) {},
+			wantErr: true,
+		},
+		{
+			name:    "Error: report format is not an export format",
+			format:  domain.FormatPDF,
+			setup:   func(f *fields) {},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFields(ctrl)
+			tc.setup(f)
+
+			file, err := f.useCases().ExportResults(context.Background(), tc.filter, tc.format)
+
+			if tc.wantErr {
+				assert.True(t, types.IsValidationError(err), "expected a validation error, got %v", err)
+				return
+			}
+			assert.NoError(t, err, "expected no error but got one")
+			assert.True(t, strings.HasSuffix(file.Name, "."+string(tc.format)), "file name should have the format extension")
+			tc.check(t, f, file)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package retention
+
+import (
+	"context"
+	"time"
+)
+
+// UseCases purga definitivamente los registros cuyo soft delete es más antiguo que la ventana de retención.
+type UseCases interface {
+	// Run ejecuta la purga cada PurgeInterval hasta que se cancele ctx. No hace nada si la retención está deshabilitada.
+	Run(context.Context)
+	// PurgeDeleted ejecuta una purga sobre todos los targets y devuelve cuántos registros borró cada uno.
+	PurgeDeleted(context.Context) (map[string]int64, error)
+}
+
+// Target es una entidad con soft delete que sabe purgar los registros borrados antes de un instante.
+// Purge suele ser el PurgeDeletedX del use case de la entidad.
+type Target struct {
+	Name  string
+	Purge func(ctx context.Context, before time.Time) (int64, error)
+}
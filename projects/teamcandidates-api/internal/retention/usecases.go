@@ -0,0 +1,75 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+)
+
+type useCases struct {
+	config  config.RetentionConfig
+	targets []Target
+}
+
+// NewUseCases crea el job de retención sobre los targets indicados.
+func NewUseCases(cfg config.Loader, targets ...Target) UseCases {
+	return &useCases{
+		config:  cfg.GetRetentionConfig(),
+		targets: targets,
+	}
+}
+
+func (u *useCases) Run(ctx context.Context) {
+	if !u.config.Enabled {
+		log.Println("retention: purge of deleted records is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(u.config.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		u.purge(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (u *useCases) PurgeDeleted(ctx context.Context) (map[string]int64, error) {
+	before := time.Now().Add(-u.config.Window)
+
+	purged := make(map[string]int64, len(u.targets))
+	var errs []error
+	for _, target := range u.targets {
+		n, err := target.Purge(ctx, before)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", target.Name, err))
+			continue
+		}
+		purged[target.Name] = n
+	}
+	return purged, errors.Join(errs...)
+}
+
+// purge ejecuta una pasada acotada por el intervalo, para que una base lenta no solape ejecuciones.
+func (u *useCases) purge(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, u.config.PurgeInterval)
+	defer cancel()
+
+	purged, err := u.PurgeDeleted(ctx)
+	if err != nil {
+		log.Printf("retention: failed to purge deleted records: %v", err)
+	}
+	for name, n := range purged {
+		if n > 0 {
+			log.Printf("retention: purged %d deleted %s", n, name)
+		}
+	}
+}
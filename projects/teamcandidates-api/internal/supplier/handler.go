@@ -48,6 +48,7 @@ func (h *Handler) Routes() {
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionCatalogRead, types.PermissionCatalogWrite))
 		protected.GET("/ping", h.ProtectedPing) // Protected test endpoint
 	}
 }
@@ -10,6 +10,7 @@ import (
 	redis0 "github.com/go-redis/redis/v8"
 
 	redis "github.com/teamcubation/teamcandidates/pkg/databases/cache/redis/v8"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/cache/models"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/usecases/domain"
@@ -36,9 +37,9 @@ func (r *cache) InvalidateUserTimeline(ctx context.Context, userID string) error
 	return nil
 }
 
-// GetTimeline obtiene la línea de tiempo de un usuario desde Redis.
+// GetTimeline obtiene la primera página del timeline de un usuario desde Redis.
 // Se espera que los datos estén almacenados en formato JSON.
-func (r *cache) GetTimeline(ctx context.Context, userID string) ([]domain.Tweet, error) {
+func (r *cache) GetTimeline(ctx context.Context, userID string) (*types.Page[domain.Tweet], error) {
 	key := fmt.Sprintf("timeline:%s", userID)
 
 	// Obtener los datos de Redis.
@@ -50,14 +51,14 @@ func (r *cache) GetTimeline(ctx context.Context, userID string) ([]domain.Tweet,
 		return nil, fmt.Errorf("failed to retrieve timeline cache for user %s: %w", userID, err)
 	}
 
-	// Deserializar el JSON en un slice de modelos de cache.
-	var timelineCache []models.Tweet
+	// Deserializar el JSON en el modelo de cache.
+	var timelineCache models.Timeline
 	if err := json.Unmarshal([]byte(data), &timelineCache); err != nil {
 		return nil, fmt.Errorf("failed to parse timeline data for user %s: %w", userID, err)
 	}
 
 	// Convertir a objetos del dominio.
-	domainTweets, err := models.ToDomainSlice(timelineCache)
+	domainTweets, err := models.ToDomainSlice(timelineCache.Tweets)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert cache models to domain models for user %s: %w", userID, err)
 	}
@@ -67,21 +68,32 @@ func (r *cache) GetTimeline(ctx context.Context, userID string) ([]domain.Tweet,
 		return domainTweets[i].CreatedAt.After(domainTweets[j].CreatedAt)
 	})
 
-	return domainTweets, nil
+	return &types.Page[domain.Tweet]{
+		Items: domainTweets,
+		Info: types.PageInfo{
+			Limit:      timelineCache.Limit,
+			NextCursor: timelineCache.NextCursor,
+			HasMore:    timelineCache.NextCursor != "",
+		},
+	}, nil
 }
 
-// SetTimeline almacena la línea de tiempo de un usuario en Redis.
-// Se serializa el slice de tweets a JSON y se guarda con una clave basada en el userID.
+// SetTimeline almacena la primera página del timeline de un usuario en Redis.
+// Se serializa la página a JSON y se guarda con una clave basada en el userID.
 // Puedes agregar un tiempo de expiración si lo deseas.
-func (r *cache) SetTimeline(ctx context.Context, userID string, tweets []domain.Tweet) error {
+func (r *cache) SetTimeline(ctx context.Context, userID string, page *types.Page[domain.Tweet]) error {
 	key := fmt.Sprintf("timeline:%s", userID)
 
 	// Convertir los tweets de dominio a modelos de cache (si es que manejas una conversión)
-	timelineCache, err := models.FromDomainSlice(tweets)
+	tweets, err := models.FromDomainSlice(page.Items)
 	if err != nil {
 		return fmt.Errorf("failed to convert domain models to cache models for user %s: %w", userID, err)
 	}
-	data, err := json.Marshal(timelineCache)
+	data, err := json.Marshal(models.Timeline{
+		Tweets:     tweets,
+		Limit:      page.Info.Limit,
+		NextCursor: page.Info.NextCursor,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to serialize timeline data for user %s: %w", userID, err)
 	}
@@ -10,6 +10,7 @@ import (
 
 // Tweet representa el modelo que se almacenará en Redis.
 type Tweet struct {
+	ID        string    `json:"id"`
 	UserID    string    `json:"user_id"`
 	Content   string    `json:"content"`
 	CreatedAt time.Time `json:"created_at"`
@@ -21,6 +22,7 @@ func FromDomain(tweet *domain.Tweet) (*Tweet, error) {
 		return nil, fmt.Errorf("tweet cannot be nil")
 	}
 	return &Tweet{
+		ID:        tweet.ID,
 		UserID:    tweet.UserID,
 		Content:   tweet.Content,
 		CreatedAt: tweet.CreatedAt,
@@ -33,12 +35,21 @@ func (m *Tweet) ToDomain() (*domain.Tweet, error) {
 		return nil, fmt.Errorf("ToDomain: tweet model is nil")
 	}
 	return &domain.Tweet{
+		ID:        m.ID,
 		UserID:    m.UserID,
 		Content:   m.Content,
 		CreatedAt: m.CreatedAt,
 	}, nil
 }
 
+// Timeline es la primera página del timeline de un usuario guardada en Redis, con el cursor
+// para seguir leyendo desde Cassandra.
+type Timeline struct {
+	Tweets     []Tweet `json:"tweets"`
+	Limit      int     `json:"limit"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
+
 // ToDomainSlice convierte un slice de modelos de Redis a un slice de tweets del dominio.
 func ToDomainSlice(cacheTweets []Tweet) ([]domain.Tweet, error) {
 	domainTweets := make([]domain.Tweet, len(cacheTweets))
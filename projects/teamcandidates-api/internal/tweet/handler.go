@@ -1,7 +1,6 @@
 package tweet
 
 import (
-	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -41,7 +40,7 @@ func (h *Handler) Routes() {
 	public := router.Group(publicPrefix)
 	{
 		public.POST("", h.CreateTweet)
-		public.GET("/:id/timeline", h.GetTimeline)
+		public.GET("/:id/timeline", mdw.ParseQuerySpec(dto.TimelineQuerySchema), h.GetTimeline)
 	}
 
 	validated := router.Group(validatedPrefix)
@@ -92,30 +91,32 @@ func (h *Handler) CreateTweet(c *gin.Context) {
 	})
 }
 
-// GetTimeline obtiene el timeline de tweets de un usuario y lo mapea a DTOs.
+// GetTimeline obtiene una página del timeline de tweets de un usuario y la mapea a DTOs.
+// La página siguiente se pide con ?cursor=<meta.next_cursor>.
 func (h *Handler) GetTimeline(c *gin.Context) {
 	userID := c.Param("id")
 
-	// Obtener el timeline (slice de domain.Tweet) desde la capa de usecases.
-	timeline, err := h.ucs.GetTimeline(c.Request.Context(), userID)
+	spec, err := mdw.GetQuerySpec(c)
 	if err != nil {
 		apiErr, code := types.NewAPIError(err)
 		c.Error(apiErr).SetMeta(code)
-		c.JSON(code, apiErr.ToResponse())
 		return
 	}
 
-	// Mapear cada tweet del dominio al DTO GetTimeline.
-	dtoTimeline := make([]dto.GetTimeline, 0, len(timeline))
-	for _, dTweet := range timeline {
-		mapped, err := dto.FromDomainToGetTimeline(&dTweet)
-		if err != nil {
-			log.Printf("Error mapping domain tweet to DTO: %v", err)
-			continue
-		}
-		dtoTimeline = append(dtoTimeline, *mapped)
+	// Obtener la página del timeline desde la capa de usecases.
+	page, err := h.ucs.GetTimeline(c.Request.Context(), userID, spec)
+	if err != nil {
+		apiErr, code := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(code)
+		return
 	}
 
-	// Enviar la respuesta con el timeline mapeado.
-	c.JSON(http.StatusOK, dtoTimeline)
+	// Mapear cada tweet del dominio al DTO GetTimeline y enviar la respuesta.
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainTimelineItem), spec)
+	if err != nil {
+		apiErr, code := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(code)
+		return
+	}
+	c.JSON(http.StatusOK, res)
 }
@@ -4,15 +4,31 @@ import (
 	"errors"
 	"time"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/usecases/domain"
 )
 
 // GetTimeline representa el DTO para el timeline que se enviará en la respuesta.
 type GetTimeline struct {
+	ID string `json:"id"`
 	Tweet
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// TimelineQuerySchema define la paginación del timeline. Cassandra no admite offset: las páginas
+// siguientes se piden con el cursor de la anterior. Solo se puede invertir el orden por fecha.
+var TimelineQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":         {Column: "tweet_id", Type: types.FieldString},
+		"user_id":    {Column: "author_id", Type: types.FieldString},
+		"content":    {Column: "content", Type: types.FieldString},
+		"created_at": {Column: "created_at", Type: types.FieldTime, Sortable: true},
+	},
+	DefaultSort:  []string{"-created_at"},
+	DefaultLimit: domain.TimelinePageSize,
+}
+
 // FromDomainToGetTimeline convierte un objeto del dominio a un DTO GetTimeline.
 func FromDomainToGetTimeline(tweet *domain.Tweet) (*GetTimeline, error) {
 	if tweet == nil {
@@ -26,7 +42,14 @@ func FromDomainToGetTimeline(tweet *domain.Tweet) (*GetTimeline, error) {
 	}
 
 	return &GetTimeline{
+		ID:        tweet.ID,
 		Tweet:     *baseTweet,
 		CreatedAt: tweet.CreatedAt,
 	}, nil
 }
+
+// FromDomainTimelineItem convierte un tweet de la página del timeline al DTO.
+func FromDomainTimelineItem(tweet domain.Tweet) GetTimeline {
+	item, _ := FromDomainToGetTimeline(&tweet)
+	return *item
+}
@@ -14,9 +14,11 @@ import (
 	cass "github.com/teamcubation/teamcandidates/pkg/databases/nosql/cassandra/gocql"
 	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
 	pg "github.com/teamcubation/teamcandidates/pkg/databases/sql/postgresql/pgxpool"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	// Usecases y dominio de tweets.
 	tweet "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet"
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/handler/dto"
 	tweetDomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/usecases/domain"
 
 	// Usecases de usuarios.
@@ -26,6 +28,9 @@ import (
 	// Usecases de personas.
 	person "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person"
 	personDomain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person/usecases/domain"
+
+	// El log de auditoría no forma parte de estas pruebas.
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 )
 
 func TestTweetWithUserAndPersonIntegration(t *testing.T) {
@@ -49,11 +54,11 @@ func TestTweetWithUserAndPersonIntegration(t *testing.T) {
 	userDB, err := gorm.Bootstrap("", "", "", "", "", 0)
 	assert.NoError(t, err, "Error bootstrapping GORM repository for users")
 	userRepo := user.NewRepository(userDB)
-	userUseCases := user.NewUseCases(userRepo)
+	userUseCases := user.NewUseCases(userRepo, gorm.NewTxManager(userDB), audit.NewNoopUseCases())
 
 	personPool, _ := pg.Bootstrap("", "", "", "", "", "")
 	personRepo := person.NewPostgresRepository(personPool)
-	personUseCases := person.NewUseCases(personRepo)
+	personUseCases := person.NewUseCases(personRepo, audit.NewNoopUseCases())
 
 	// --- Crear una persona ---
 	newPerson := &personDomain.Person{
@@ -119,11 +124,11 @@ func TestGetTimelineIntegration(t *testing.T) {
 	userDB, err := gorm.Bootstrap("", "", "", "", "", 0)
 	assert.NoError(t, err, "Error bootstrapping GORM repository for users")
 	userRepo := user.NewRepository(userDB)
-	userUseCases := user.NewUseCases(userRepo)
+	userUseCases := user.NewUseCases(userRepo, gorm.NewTxManager(userDB), audit.NewNoopUseCases())
 
 	personPool, _ := pg.Bootstrap("", "", "", "", "", "")
 	personRepo := person.NewPostgresRepository(personPool)
-	personUseCases := person.NewUseCases(personRepo)
+	personUseCases := person.NewUseCases(personRepo, audit.NewNoopUseCases())
 
 	newPerson := &personDomain.Person{
 		FirstName:  "John",
@@ -194,10 +199,10 @@ func TestGetTimelineIntegration(t *testing.T) {
 	assert.NotEmpty(t, createdTweetID, "Expected non-empty tweet ID")
 	t.Logf("Tweet created with ID: %s", createdTweetID)
 
-	timeline, err := tweetUseCases.GetTimeline(context.Background(), userID)
+	timeline, err := tweetUseCases.GetTimeline(context.Background(), followerUserIDs[0], types.NewQuerySpec(dto.TimelineQuerySchema))
 	assert.NoError(t, err, "GetTimeline returned an error")
-	assert.NotEmpty(t, timeline, "Expected non-empty timeline")
-	t.Logf("Timeline first tweet: %v", timeline[0])
+	assert.NotEmpty(t, timeline.Items, "Expected non-empty timeline")
+	t.Logf("Timeline first tweet: %v", timeline.Items[0])
 
 	err = userUseCases.DeleteUser(context.Background(), userID, true)
 	assert.NoError(t, err, "Error deleting user")
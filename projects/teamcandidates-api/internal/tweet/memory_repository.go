@@ -0,0 +1,90 @@
+package tweet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/repository/models"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/usecases/domain"
+)
+
+// memory es la implementación del repositorio sobre la base en memoria. Replica las dos tablas
+// de Cassandra: tweets y el timeline desnormalizado por usuario.
+type memory struct {
+	tweets   *mapdb.Table[models.Tweet]
+	timeline *mapdb.Table[models.TimelineEntry]
+	tx       pkgtx.Manager
+}
+
+// NewMemoryRepository retorna una implementación del repositorio en memoria.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memory{
+		tweets: mapdb.NewTable(db, "tweets",
+			func(t *models.Tweet) string { return t.ID },
+		),
+		timeline: mapdb.NewTable(db, "timeline_by_user",
+			func(e *models.TimelineEntry) string { return e.OwnerID + "/" + e.TweetID },
+		),
+		tx: mapdb.NewTxManager(db),
+	}
+}
+
+func (r *memory) SaveTweet(ctx context.Context, tweet *domain.Tweet) (string, error) {
+	if tweet == nil {
+		return "", errors.New("tweet cannot be nil")
+	}
+
+	model, err := models.FromDomain(tweet)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert tweet to model: %w", err)
+	}
+	model.ID = uuid.New().String()
+	if model.CreatedAt.IsZero() {
+		model.CreatedAt = time.Now()
+	}
+
+	if err := r.tweets.Insert(ctx, model); err != nil {
+		return "", fmt.Errorf("failed to save tweet: %w", err)
+	}
+	return model.ID, nil
+}
+
+// GetTimeline devuelve una página del timeline de userID con el orden del schema (por defecto,
+// del más reciente al más antiguo). El cursor es keyset en lugar del page state de Cassandra.
+func (r *memory) GetTimeline(ctx context.Context, userID string, spec *types.QuerySpec) (*types.Page[domain.Tweet], error) {
+	if userID == "" {
+		return nil, types.NewError(types.ErrValidation, "user id is required", nil)
+	}
+
+	page, err := r.timeline.Page(ctx, spec, func(e *models.TimelineEntry) bool { return e.OwnerID == userID })
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timeline for user %s: %w", userID, err)
+	}
+	return types.MapPage(page, models.TimelineEntry.ToDomain), nil
+}
+
+// InsertTweetIntoTimelines copia el tweet en el timeline de cada seguidor en una sola transacción.
+func (r *memory) InsertTweetIntoTimelines(ctx context.Context, followerIDs []string, tweet *domain.Tweet) error {
+	model, err := models.FromDomain(tweet)
+	if err != nil {
+		return fmt.Errorf("failed to convert tweet to model: %w", err)
+	}
+
+	return r.tx.WithinTx(ctx, func(ctx context.Context) error {
+		for _, followerID := range followerIDs {
+			entry := models.NewTimelineEntry(followerID, model)
+			if err := r.timeline.Upsert(ctx, &entry); err != nil {
+				return fmt.Errorf("failed to insert tweet %s into timelines: %w", model.ID, err)
+			}
+		}
+		return nil
+	})
+}
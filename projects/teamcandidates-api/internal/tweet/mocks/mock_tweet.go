@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/usecases/domain"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -51,18 +52,18 @@ func (mr *MockUseCasesMockRecorder) CreateTweet(arg0, arg1 interface{}) *gomock.
 }
 
 // GetTimeline mocks base method.
-func (m *MockUseCases) GetTimeline(arg0 context.Context, arg1 string) ([]domain.Tweet, error) {
+func (m *MockUseCases) GetTimeline(arg0 context.Context, arg1 string, arg2 *types.QuerySpec) (*types.Page[domain.Tweet], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTimeline", arg0, arg1)
-	ret0, _ := ret[0].([]domain.Tweet)
+	ret := m.ctrl.Call(m, "GetTimeline", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.Page[domain.Tweet])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTimeline indicates an expected call of GetTimeline.
-func (mr *MockUseCasesMockRecorder) GetTimeline(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) GetTimeline(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockUseCases)(nil).GetTimeline), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockUseCases)(nil).GetTimeline), arg0, arg1, arg2)
 }
 
 // MockRepository is a mock of Repository interface.
@@ -88,33 +89,33 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GetTweetsByUserIDs mocks base method.
-func (m *MockRepository) GetTweetsByUserIDs(arg0 context.Context, arg1 []string, arg2, arg3 int) ([]domain.Tweet, error) {
+// GetTimeline mocks base method.
+func (m *MockRepository) GetTimeline(arg0 context.Context, arg1 string, arg2 *types.QuerySpec) (*types.Page[domain.Tweet], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTweetsByUserIDs", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]domain.Tweet)
+	ret := m.ctrl.Call(m, "GetTimeline", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.Page[domain.Tweet])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTweetsByUserIDs indicates an expected call of GetTweetsByUserIDs.
-func (mr *MockRepositoryMockRecorder) GetTweetsByUserIDs(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// GetTimeline indicates an expected call of GetTimeline.
+func (mr *MockRepositoryMockRecorder) GetTimeline(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTweetsByUserIDs", reflect.TypeOf((*MockRepository)(nil).GetTweetsByUserIDs), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockRepository)(nil).GetTimeline), arg0, arg1, arg2)
 }
 
-// InsertTweetIntoTimeline mocks base method.
-func (m *MockRepository) InsertTweetIntoTimeline(arg0 context.Context, arg1 string, arg2 *domain.Tweet) error {
+// InsertTweetIntoTimelines mocks base method.
+func (m *MockRepository) InsertTweetIntoTimelines(arg0 context.Context, arg1 []string, arg2 *domain.Tweet) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertTweetIntoTimeline", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "InsertTweetIntoTimelines", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// InsertTweetIntoTimeline indicates an expected call of InsertTweetIntoTimeline.
-func (mr *MockRepositoryMockRecorder) InsertTweetIntoTimeline(arg0, arg1, arg2 interface{}) *gomock.Call {
+// InsertTweetIntoTimelines indicates an expected call of InsertTweetIntoTimelines.
+func (mr *MockRepositoryMockRecorder) InsertTweetIntoTimelines(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTweetIntoTimeline", reflect.TypeOf((*MockRepository)(nil).InsertTweetIntoTimeline), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTweetIntoTimelines", reflect.TypeOf((*MockRepository)(nil).InsertTweetIntoTimelines), arg0, arg1, arg2)
 }
 
 // SaveTweet mocks base method.
@@ -168,10 +169,10 @@ func (mr *MockCacheMockRecorder) Close() *gomock.Call {
 }
 
 // GetTimeline mocks base method.
-func (m *MockCache) GetTimeline(arg0 context.Context, arg1 string) ([]domain.Tweet, error) {
+func (m *MockCache) GetTimeline(arg0 context.Context, arg1 string) (*types.Page[domain.Tweet], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTimeline", arg0, arg1)
-	ret0, _ := ret[0].([]domain.Tweet)
+	ret0, _ := ret[0].(*types.Page[domain.Tweet])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -211,7 +212,7 @@ func (mr *MockCacheMockRecorder) PushTweetToTimeline(arg0, arg1, arg2 interface{
 }
 
 // SetTimeline mocks base method.
-func (m *MockCache) SetTimeline(arg0 context.Context, arg1 string, arg2 []domain.Tweet) error {
+func (m *MockCache) SetTimeline(arg0 context.Context, arg1 string, arg2 *types.Page[domain.Tweet]) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetTimeline", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
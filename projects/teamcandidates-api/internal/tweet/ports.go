@@ -3,27 +3,29 @@ package tweet
 import (
 	"context"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/usecases/domain"
 )
 
 // UseCases define las operaciones de la capa de negocio para tweets.
 type UseCases interface {
 	CreateTweet(context.Context, *domain.Tweet) (string, error)
-	GetTimeline(context.Context, string) ([]domain.Tweet, error)
+	GetTimeline(context.Context, string, *types.QuerySpec) (*types.Page[domain.Tweet], error)
 }
 
 // Repository define las operaciones necesarias en Cassandra.
 type Repository interface {
 	SaveTweet(context.Context, *domain.Tweet) (string, error)
-	GetTweetsByUserIDs(context.Context, []string, int, int) ([]domain.Tweet, error)
-	InsertTweetIntoTimeline(context.Context, string, *domain.Tweet) error
+	GetTimeline(context.Context, string, *types.QuerySpec) (*types.Page[domain.Tweet], error)
+	InsertTweetIntoTimelines(context.Context, []string, *domain.Tweet) error
 }
 
 // Cache define la interfaz para las operaciones de caché.
 type Cache interface {
 	InvalidateUserTimeline(context.Context, string) error
-	GetTimeline(context.Context, string) ([]domain.Tweet, error)
-	SetTimeline(context.Context, string, []domain.Tweet) error
+	GetTimeline(context.Context, string) (*types.Page[domain.Tweet], error)
+	SetTimeline(context.Context, string, *types.Page[domain.Tweet]) error
 	PushTweetToTimeline(context.Context, string, *domain.Tweet) error
 	Close()
 }
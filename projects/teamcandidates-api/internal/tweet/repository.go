@@ -4,12 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"time"
 
 	"github.com/google/uuid"
 
 	cass "github.com/teamcubation/teamcandidates/pkg/databases/nosql/cassandra/gocql"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/repository/models"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/usecases/domain"
@@ -27,6 +27,14 @@ func NewRepository(r cass.Repository) Repository {
 	}
 }
 
+var (
+	tweetMapper    = cass.NewMapper[models.Tweet]()
+	timelineMapper = cass.NewMapper[models.TimelineEntry]()
+)
+
+// timelineBatchSize limita las filas por batch al repartir un tweet entre los timelines.
+const timelineBatchSize = 50
+
 // SaveTweet inserta un tweet en la tabla global "tweets" de Cassandra.
 func (r *cassandra) SaveTweet(ctx context.Context, tweet *domain.Tweet) (string, error) {
 	if tweet == nil {
@@ -45,10 +53,10 @@ func (r *cassandra) SaveTweet(ctx context.Context, tweet *domain.Tweet) (string,
 		cassTweet.CreatedAt = time.Now()
 	}
 
-	const query = "INSERT INTO tweets (id, user_id, content, created_at) VALUES (?, ?, ?, ?)"
-	if err := r.repository.GetSession().Query(query,
-		cassTweet.ID, cassTweet.UserID, cassTweet.Content, cassTweet.CreatedAt).
-		WithContext(ctx).
+	// El id se genera acá, por lo que repetir el INSERT no duplica el tweet: es seguro reintentarlo.
+	if err := r.repository.Query(ctx,
+		tweetMapper.InsertCQL("tweets"), tweetMapper.Values(cassTweet),
+		cass.WithConsistency(cass.LocalQuorum), cass.Idempotent()).
 		Exec(); err != nil {
 		return "", fmt.Errorf("failed to save tweet: %w", err)
 	}
@@ -56,67 +64,57 @@ func (r *cassandra) SaveTweet(ctx context.Context, tweet *domain.Tweet) (string,
 	return cassTweet.ID, nil
 }
 
-// GetTweetsByUserIDs consulta los tweets de la vista desnormalizada "timeline_by_user"
-// para una lista de userIDs. El parámetro offset se ignora, ya que Cassandra no lo soporta de forma nativa.
-func (r *cassandra) GetTweetsByUserIDs(ctx context.Context, userIDs []string, limit int, offset int) ([]domain.Tweet, error) {
-	if len(userIDs) == 0 {
-		return nil, errors.New("userIDs list is empty")
-	}
-	if limit <= 0 {
-		return nil, errors.New("limit must be greater than zero")
+// GetTimeline devuelve una página del timeline de userID, del tweet más reciente al más antiguo
+// (el orden de clustering de timeline_by_user). La página siguiente se pide con el cursor,
+// que es el page state de Cassandra.
+func (r *cassandra) GetTimeline(ctx context.Context, userID string, spec *types.QuerySpec) (*types.Page[domain.Tweet], error) {
+	if userID == "" {
+		return nil, types.NewError(types.ErrValidation, "user id is required", nil)
 	}
 
-	// Consultar la tabla desnormalizada "timeline_by_user".
-	// Se selecciona tweet_id (para asignarlo a ID), user_id, content y created_at.
-	const query = "SELECT tweet_id, user_id, content, created_at FROM timeline_by_user WHERE user_id IN ? LIMIT ?"
-	iter := r.repository.GetSession().Query(query, userIDs, limit).
-		WithContext(ctx).
-		Iter()
-
-	var tweet models.Tweet
-	var tweets []models.Tweet
-	for iter.Scan(&tweet.ID, &tweet.UserID, &tweet.Content, &tweet.CreatedAt) {
-		// Hacemos una copia local para evitar problemas de memoria.
-		t := tweet
-		tweets = append(tweets, t)
-	}
+	pageSpec := *spec
+	// El mapper lee siempre todas las columnas; el sparse fieldset se aplica al armar la respuesta
+	pageSpec.Columns = nil
+	pageSpec.Filters = []types.Filter{{Field: "user_id", Column: "user_id", Op: types.OpEq, Values: []any{userID}}}
 
-	if err := iter.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close iterator: %w", err)
+	query, args, err := cass.BuildSelect("timeline_by_user", timelineMapper.Columns(), &pageSpec, "created_at")
+	if err != nil {
+		return nil, err
 	}
 
-	// Convertir los modelos de Cassandra al objeto del dominio.
-	domainTweets, err := models.ToDomainSlice(tweets)
+	// El timeline tolera leer una réplica algo atrasada: LOCAL_ONE, e idempotente para permitir
+	// reintentos y la ejecución especulativa configurada en la sesión
+	page, err := cass.SelectPage(ctx, r.repository, query, args, &pageSpec, timelineMapper.Scan,
+		cass.WithConsistency(cass.LocalOne), cass.Idempotent())
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert tweets to domain model: %w", err)
+		return nil, fmt.Errorf("failed to get timeline for user %s: %w", userID, err)
 	}
 
-	// Ordenar los tweets de forma descendente por CreatedAt (más reciente primero).
-	sort.Slice(domainTweets, func(i, j int) bool {
-		return domainTweets[i].CreatedAt.After(domainTweets[j].CreatedAt)
-	})
-
-	return domainTweets, nil
+	return types.MapPage(page, models.TimelineEntry.ToDomain), nil
 }
 
-// InsertTweetIntoTimeline inserta un tweet en la tabla desnormalizada "timeline_by_user",
-// asociándolo al timeline del usuario (followee) pasado como parámetro.
-func (r *cassandra) InsertTweetIntoTimeline(ctx context.Context, followeeID string, tweet *domain.Tweet) error {
+// InsertTweetIntoTimelines copia el tweet en la tabla desnormalizada "timeline_by_user" de cada
+// seguidor. Las filas se agrupan por partición (el dueño del timeline) y se envían como batches
+// UNLOGGED en paralelo. Devuelve los errores de las particiones que fallaron.
+func (r *cassandra) InsertTweetIntoTimelines(ctx context.Context, followerIDs []string, tweet *domain.Tweet) error {
 	// Convertir del dominio al modelo de Cassandra.
 	cassTweet, err := models.FromDomain(tweet)
 	if err != nil {
 		return fmt.Errorf("failed to convert tweet to Cassandra model: %w", err)
 	}
 
-	const query = `
-		INSERT INTO timeline_by_user (user_id, created_at, tweet_id, content)
-		VALUES (?, ?, ?, ?)
-	`
-	if err := r.repository.GetSession().Query(query,
-		followeeID, cassTweet.CreatedAt, cassTweet.ID, cassTweet.Content).
-		WithContext(ctx).
-		Exec(); err != nil {
-		return fmt.Errorf("failed to insert tweet into timeline for user %s: %w", followeeID, err)
+	writer := r.repository.NewBatchWriter(cass.BatchOptions{
+		MaxStatements: timelineBatchSize,
+		Options:       []cass.QueryOption{cass.Idempotent()},
+	})
+	insert := timelineMapper.InsertCQL("timeline_by_user")
+	for _, followerID := range followerIDs {
+		entry := models.NewTimelineEntry(followerID, cassTweet)
+		writer.Add(followerID, insert, timelineMapper.Values(&entry)...)
+	}
+
+	if err := writer.Flush(ctx); err != nil {
+		return fmt.Errorf("failed to insert tweet %s into timelines: %w", cassTweet.ID, err)
 	}
 	return nil
 }
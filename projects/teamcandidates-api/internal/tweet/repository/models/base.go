@@ -9,10 +9,40 @@ import (
 
 // Tweet representa el modelo de datos para Cassandra.
 type Tweet struct {
-	ID        string    // Identificador único.
-	UserID    string    // Identificador del usuario creador.
-	Content   string    // Contenido del tweet (máximo 280 caracteres).
-	CreatedAt time.Time // Fecha y hora de creación.
+	ID        string    `cql:"id"`         // Identificador único.
+	UserID    string    `cql:"user_id"`    // Identificador del usuario creador.
+	Content   string    `cql:"content"`    // Contenido del tweet (máximo 280 caracteres).
+	CreatedAt time.Time `cql:"created_at"` // Fecha y hora de creación.
+}
+
+// TimelineEntry es una fila de timeline_by_user: la copia de un tweet en el timeline de un usuario.
+type TimelineEntry struct {
+	OwnerID   string    `cql:"user_id"` // Dueño del timeline (el seguidor).
+	CreatedAt time.Time `cql:"created_at"`
+	TweetID   string    `cql:"tweet_id"`
+	AuthorID  string    `cql:"author_id"` // Autor del tweet; vacío en filas anteriores a la columna.
+	Content   string    `cql:"content"`
+}
+
+// NewTimelineEntry arma la fila del tweet en el timeline de ownerID.
+func NewTimelineEntry(ownerID string, t *Tweet) TimelineEntry {
+	return TimelineEntry{
+		OwnerID:   ownerID,
+		CreatedAt: t.CreatedAt,
+		TweetID:   t.ID,
+		AuthorID:  t.UserID,
+		Content:   t.Content,
+	}
+}
+
+// ToDomain convierte una fila del timeline en el tweet del dominio.
+func (e TimelineEntry) ToDomain() domain.Tweet {
+	return domain.Tweet{
+		ID:        e.TweetID,
+		UserID:    e.AuthorID,
+		Content:   e.Content,
+		CreatedAt: e.CreatedAt,
+	}
 }
 
 // ToDomain convierte una instancia de models.Tweet a un domain.Tweet.
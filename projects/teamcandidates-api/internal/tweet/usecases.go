@@ -4,9 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"sync"
 	"time"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/usecases/domain"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
 )
@@ -67,36 +68,12 @@ func (uc *usecases) CreateTweet(ctx context.Context, tweet *domain.Tweet) (strin
 	}
 
 	// 6. Fan‑out: Insertar el tweet en la vista desnormalizada (timeline_by_user)
-	//    de cada seguidor utilizando un worker pool.
-	const numWorkers = 50
-	followerChan := make(chan string, len(followers))
-	var wg sync.WaitGroup
-
-	for i := 0; i < numWorkers; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			for followerID := range followerChan {
-				// Insertar el tweet en el timeline del seguidor.
-				if err := uc.cassRepository.InsertTweetIntoTimeline(ctx, followerID, newTweet); err != nil {
-					log.Printf("error pushing tweet to timeline for follower %s: %v", followerID, err)
-				}
-			}
-		}()
-	}
-
-outer:
-	for _, followerID := range followers {
-		select {
-		case <-ctx.Done():
-			log.Printf("context cancelled while sending follower IDs: %v", ctx.Err())
-			break outer
-		default:
-			followerChan <- followerID
+	//    de cada seguidor, en batches por partición.
+	if len(followers) > 0 {
+		if err := uc.cassRepository.InsertTweetIntoTimelines(ctx, followers, newTweet); err != nil {
+			log.Printf("error pushing tweet to followers timelines: %v", err)
 		}
 	}
-	close(followerChan)
-	wg.Wait()
 
 	// 7. Publicar un evento de tweet creado.
 	if err := uc.producer.PublishTweetCreated(ctx, newTweet); err != nil {
@@ -106,31 +83,38 @@ outer:
 	return newTweet.ID, nil
 }
 
-// GetTimeline consulta el timeline de un usuario. Primero intenta obtenerlo
-// del caché; si no está, lo consulta en Cassandra (tabla desnormalizada, e.g. timeline_by_user)
-// y actualiza el caché de forma asíncrona.
-func (uc *usecases) GetTimeline(ctx context.Context, userID string) ([]domain.Tweet, error) {
+// GetTimeline consulta una página del timeline de un usuario. La primera página (sin cursor,
+// con el tamaño y el orden por defecto) se intenta obtener del caché; si no está, se consulta
+// en Cassandra (tabla desnormalizada timeline_by_user) y se actualiza el caché de forma asíncrona.
+// Las páginas siguientes se leen siempre de Cassandra con el cursor.
+func (uc *usecases) GetTimeline(ctx context.Context, userID string, spec *types.QuerySpec) (*types.Page[domain.Tweet], error) {
+	cacheable := isFirstTimelinePage(spec)
+
 	// 1. Intentar obtener el timeline desde la caché.
-	cachedTimeline, err := uc.cache.GetTimeline(ctx, userID)
-	if err == nil && cachedTimeline != nil && len(cachedTimeline) > 0 {
-		return cachedTimeline, nil
-	} else if err != nil {
-		log.Printf("failed to get timeline from cache for user %s: %v", userID, err)
+	if cacheable {
+		cachedTimeline, err := uc.cache.GetTimeline(ctx, userID)
+		if err == nil && cachedTimeline != nil && len(cachedTimeline.Items) > 0 {
+			return cachedTimeline, nil
+		} else if err != nil {
+			log.Printf("failed to get timeline from cache for user %s: %v", userID, err)
+		}
 	}
 
 	// 2. Consultar el timeline en Cassandra.
-	// Se pasa el userID en un slice, ya que el repositorio espera []string.
-	tweets, err := uc.cassRepository.GetTweetsByUserIDs(ctx, []string{userID}, 50, 0)
+	page, err := uc.cassRepository.GetTimeline(ctx, userID, spec)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving timeline from Cassandra: %w", err)
 	}
+	if !cacheable {
+		return page, nil
+	}
 
 	// 3. Actualizar el caché de forma asíncrona.
 	errCh := make(chan error, 1)
 	go func() {
 		updateCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		if err := uc.cache.SetTimeline(updateCtx, userID, tweets); err != nil {
+		if err := uc.cache.SetTimeline(updateCtx, userID, page); err != nil {
 			errCh <- fmt.Errorf("failed to cache timeline for user %s: %w", userID, err)
 			return
 		}
@@ -146,5 +130,13 @@ func (uc *usecases) GetTimeline(ctx context.Context, userID string) ([]domain.Tw
 		log.Printf("cache update for user %s is taking longer than expected", userID)
 	}
 
-	return tweets, nil
+	return page, nil
+}
+
+// isFirstTimelinePage indica si spec pide la página que se guarda en caché.
+func isFirstTimelinePage(spec *types.QuerySpec) bool {
+	if spec.Cursor != "" || spec.Limit != domain.TimelinePageSize {
+		return false
+	}
+	return len(spec.Sort) == 0 || (spec.Sort[0].Field == "created_at" && spec.Sort[0].Desc)
 }
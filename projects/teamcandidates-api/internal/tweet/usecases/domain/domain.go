@@ -7,6 +7,9 @@ import (
 
 const MaxTweetLength = 280
 
+// TimelinePageSize es el tamaño por defecto de una página del timeline; esa primera página se cachea.
+const TimelinePageSize = 50
+
 type Tweet struct {
 	ID        string
 	UserID    string
@@ -9,9 +9,12 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
 	mock_tweet "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/mocks"
 	mock_user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/mocks"
 
+	dto "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/handler/dto"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet/usecases/domain"
 	usrdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
 )
@@ -92,12 +95,9 @@ func TestCreateTweet(t *testing.T) {
 				f.cache.EXPECT().
 					InvalidateUserTimeline(gomock.Any(), "follower2").
 					Return(nil)
-				// 5. Se inserta el tweet en el timeline de cada follower.
-				f.cassRepository.EXPECT().
-					InsertTweetIntoTimeline(gomock.Any(), "follower1", gomock.Any()).
-					Return(nil)
+				// 5. Se inserta el tweet en el timeline de los followers.
 				f.cassRepository.EXPECT().
-					InsertTweetIntoTimeline(gomock.Any(), "follower2", gomock.Any()).
+					InsertTweetIntoTimelines(gomock.Any(), []string{"follower1", "follower2"}, gomock.Any()).
 					Return(nil)
 				// 6. Se publica el evento de tweet creado.
 				f.producer.EXPECT().
@@ -112,7 +112,7 @@ func TestCreateTweet(t *testing.T) {
 			wantTweetID: "tweet123",
 		},
 		{
-			name: "Success: Tweet creation with error in one InsertTweetIntoTimeline",
+			name: "Success: Tweet creation with error in InsertTweetIntoTimelines",
 			setup: func(f *fields) {
 				// Simular que el usuario existe.
 				f.userUC.EXPECT().
@@ -133,14 +133,10 @@ func TestCreateTweet(t *testing.T) {
 				f.cache.EXPECT().
 					InvalidateUserTimeline(gomock.Any(), "follower2").
 					Return(nil)
-				// Insertar el tweet en el timeline:
-				// Para "follower1" retorna nil, para "follower2" simula un error.
-				f.cassRepository.EXPECT().
-					InsertTweetIntoTimeline(gomock.Any(), "follower1", gomock.Any()).
-					Return(nil)
+				// Insertar el tweet en los timelines: simula que falla la partición de "follower2".
 				f.cassRepository.EXPECT().
-					InsertTweetIntoTimeline(gomock.Any(), "follower2", gomock.Any()).
-					Return(errors.New("error inserting timeline"))
+					InsertTweetIntoTimelines(gomock.Any(), []string{"follower1", "follower2"}, gomock.Any()).
+					Return(errors.New("partition follower2: error inserting timeline"))
 				// Publicar el evento de tweet creado.
 				f.producer.EXPECT().
 					PublishTweetCreated(gomock.Any(), gomock.Any()).
@@ -150,7 +146,7 @@ func TestCreateTweet(t *testing.T) {
 				ctx:   context.Background(),
 				tweet: &domain.Tweet{UserID: "user1", Content: "Hello World"},
 			},
-			// Aunque una inserción falle, el error se registra y se continúa el flujo.
+			// Aunque una partición falle, el error se registra y se continúa el flujo.
 			wantErr:     false,
 			wantTweetID: "tweet123",
 		},
@@ -196,7 +192,10 @@ func TestGetTimeline(t *testing.T) {
 	type args struct {
 		ctx    context.Context
 		userID string
+		spec   *types.QuerySpec
 	}
+	firstPage := types.NewQuerySpec(dto.TimelineQuerySchema)
+
 	tests := []struct {
 		name       string
 		setup      func(f *fields)
@@ -208,9 +207,9 @@ func TestGetTimeline(t *testing.T) {
 			name: "Cache hit: timeline obtained from cache",
 			setup: func(f *fields) {
 				// Simular que la caché retorna un timeline no vacío.
-				tweets := []domain.Tweet{
+				tweets := &types.Page[domain.Tweet]{Items: []domain.Tweet{
 					{ID: "tweet1", UserID: "user1", Content: "Message", CreatedAt: time.Now()},
-				}
+				}}
 				f.cache.EXPECT().
 					GetTimeline(gomock.Any(), "user1").
 					Return(tweets, nil)
@@ -218,6 +217,7 @@ func TestGetTimeline(t *testing.T) {
 			args: args{
 				ctx:    context.Background(),
 				userID: "user1",
+				spec:   firstPage,
 			},
 			wantErr:    false,
 			wantTweets: []domain.Tweet{{ID: "tweet1", UserID: "user1", Content: "Message"}},
@@ -230,11 +230,11 @@ func TestGetTimeline(t *testing.T) {
 					GetTimeline(gomock.Any(), "user1").
 					Return(nil, nil)
 				// Se consulta a Cassandra y se devuelve un timeline.
-				tweets := []domain.Tweet{
+				tweets := &types.Page[domain.Tweet]{Items: []domain.Tweet{
 					{ID: "tweet2", UserID: "user1", Content: "Another Message", CreatedAt: time.Now()},
-				}
+				}}
 				f.cassRepository.EXPECT().
-					GetTweetsByUserIDs(gomock.Any(), []string{"user1"}, 50, 0).
+					GetTimeline(gomock.Any(), "user1", gomock.Any()).
 					Return(tweets, nil)
 				// Actualización asíncrona de la caché.
 				f.cache.EXPECT().
@@ -244,6 +244,7 @@ func TestGetTimeline(t *testing.T) {
 			args: args{
 				ctx:    context.Background(),
 				userID: "user1",
+				spec:   firstPage,
 			},
 			wantErr:    false,
 			wantTweets: []domain.Tweet{{ID: "tweet2", UserID: "user1", Content: "Another Message"}},
@@ -255,26 +256,45 @@ func TestGetTimeline(t *testing.T) {
 					GetTimeline(gomock.Any(), "user1").
 					Return(nil, nil)
 				f.cassRepository.EXPECT().
-					GetTweetsByUserIDs(gomock.Any(), []string{"user1"}, 50, 0).
+					GetTimeline(gomock.Any(), "user1", gomock.Any()).
 					Return(nil, errors.New("db error"))
 			},
 			args: args{
 				ctx:    context.Background(),
 				userID: "user1",
+				spec:   firstPage,
 			},
 			wantErr: true,
 		},
+		{
+			name: "Next page: read from Cassandra without touching the cache",
+			setup: func(f *fields) {
+				tweets := &types.Page[domain.Tweet]{Items: []domain.Tweet{
+					{ID: "tweet4", UserID: "user1", Content: "Older Message", CreatedAt: time.Now()},
+				}}
+				f.cassRepository.EXPECT().
+					GetTimeline(gomock.Any(), "user1", gomock.Any()).
+					Return(tweets, nil)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userID: "user1",
+				spec:   &types.QuerySpec{Limit: domain.TimelinePageSize, Cursor: "c3RhdGU"},
+			},
+			wantErr:    false,
+			wantTweets: []domain.Tweet{{ID: "tweet4", UserID: "user1", Content: "Older Message"}},
+		},
 		{
 			name: "Cache error but timeline retrieved from Cassandra successfully",
 			setup: func(f *fields) {
 				f.cache.EXPECT().
 					GetTimeline(gomock.Any(), "user1").
 					Return(nil, errors.New("cache error"))
-				tweets := []domain.Tweet{
+				tweets := &types.Page[domain.Tweet]{Items: []domain.Tweet{
 					{ID: "tweet3", UserID: "user1", Content: "Alternate Message", CreatedAt: time.Now()},
-				}
+				}}
 				f.cassRepository.EXPECT().
-					GetTweetsByUserIDs(gomock.Any(), []string{"user1"}, 50, 0).
+					GetTimeline(gomock.Any(), "user1", gomock.Any()).
 					Return(tweets, nil)
 				f.cache.EXPECT().
 					SetTimeline(gomock.Any(), "user1", tweets).
@@ -283,6 +303,7 @@ func TestGetTimeline(t *testing.T) {
 			args: args{
 				ctx:    context.Background(),
 				userID: "user1",
+				spec:   firstPage,
 			},
 			wantErr:    false,
 			wantTweets: []domain.Tweet{{ID: "tweet3", UserID: "user1", Content: "Alternate Message"}},
@@ -299,13 +320,13 @@ func TestGetTimeline(t *testing.T) {
 			}
 			tc.setup(&f)
 			uc := NewUseCases(f.cassRepository, f.userUC, f.cache, f.producer)
-			gotTweets, err := uc.GetTimeline(tc.args.ctx, tc.args.userID)
+			gotPage, err := uc.GetTimeline(tc.args.ctx, tc.args.userID, tc.args.spec)
 
 			if tc.wantErr {
 				assert.Error(t, err, "expected an error but got nil")
 			} else {
 				assert.NoError(t, err, "expected no error but got one")
-				assert.Equal(t, len(tc.wantTweets), len(gotTweets), "number of tweets mismatch")
+				assert.Equal(t, len(tc.wantTweets), len(gotPage.Items), "number of tweets mismatch")
 				// Opcional: comparar campos deterministas de los tweets.
 			}
 		})
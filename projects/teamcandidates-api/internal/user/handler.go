@@ -40,7 +40,7 @@ func (h *Handler) Routes() {
 	public := router.Group(publicPrefix)
 	{
 		public.POST("", h.CreateUser)
-		public.GET("", h.ListUsers)
+		public.GET("", mdw.ParseQuerySpec(dto.UserQuerySchema), h.ListUsers)
 		public.GET("/:id", h.GetUser)
 		public.PUT("/:id", h.UpdateUser)
 		public.DELETE("/:id", h.DeleteUser)
@@ -61,8 +61,14 @@ func (h *Handler) Routes() {
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
+		protected.Use(mdw.RequireMethodPermission(types.PermissionUserRead, types.PermissionUserWrite))
 
 		protected.GET("/ping", h.ProtectedPing)
+
+		protected.GET("", mdw.ParseQuerySpec(dto.UserQuerySchema), h.ListUsers)
+		protected.POST("/:id/restore", mdw.RequirePermission(types.PermissionRestore), h.RestoreUser)
+		protected.GET("/:id/roles", h.GetUserRoles)
+		protected.PUT("/:id/roles", mdw.RequirePermission(types.PermissionUserAdmin), h.SetUserRoles)
 	}
 }
 
@@ -95,13 +101,27 @@ func (h *Handler) CreateUser(c *gin.Context) {
 }
 
 func (h *Handler) ListUsers(c *gin.Context) {
-	users, err := h.ucs.ListUsers(c.Request.Context())
+	spec, err := mdw.GetQuerySpec(c)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	page, err := h.ucs.ListUsers(c.Request.Context(), spec)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	res, err := types.NewPageResponse(types.MapPage(page, dto.FromDomainListItem), spec)
 	if err != nil {
 		apiErr, errCode := types.NewAPIError(err)
 		c.Error(apiErr).SetMeta(errCode)
 		return
 	}
-	c.JSON(http.StatusOK, users)
+	c.JSON(http.StatusOK, res)
 }
 
 func (h *Handler) GetUser(c *gin.Context) {
@@ -148,6 +168,54 @@ func (h *Handler) DeleteUser(c *gin.Context) {
 	})
 }
 
+func (h *Handler) RestoreUser(c *gin.Context) {
+	id := c.Param("id")
+	if err := h.ucs.RestoreUser(c.Request.Context(), id); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "User restored successfully",
+	})
+}
+
+func (h *Handler) GetUserRoles(c *gin.Context) {
+	id := c.Param("id")
+	roles, err := h.ucs.GetUserRoles(c.Request.Context(), id)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.UserRolesResponse{UserID: id, Roles: roles})
+}
+
+func (h *Handler) SetUserRoles(c *gin.Context) {
+	var req dto.UserRoles
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if err := h.ucs.SetUserRoles(ctx, id, req.Roles); err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+
+	roles, err := h.ucs.GetUserRoles(ctx, id)
+	if err != nil {
+		apiErr, errCode := types.NewAPIError(err)
+		c.Error(apiErr).SetMeta(errCode)
+		return
+	}
+	c.JSON(http.StatusOK, dto.UserRolesResponse{UserID: id, Roles: roles})
+}
+
 func (h *Handler) FollowUser(c *gin.Context) {
 	var req dto.Follow
 	if err := c.ShouldBindJSON(&req); err != nil {
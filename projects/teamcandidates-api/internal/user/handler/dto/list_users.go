@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+// UserListItem es la vista de un usuario en el listado; nunca expone la contraseña.
+type UserListItem struct {
+	ID             string     `json:"id"`
+	Email          string     `json:"email"`
+	UserType       string     `json:"user_type"`
+	EmailValidated bool       `json:"email_validated"`
+	PersonID       string     `json:"person_id,omitempty"`
+	LoggedAt       *time.Time `json:"logged_at,omitempty"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy      string     `json:"deleted_by,omitempty"`
+}
+
+// UserQuerySchema define los campos por los que se puede filtrar, ordenar y seleccionar en el listado.
+var UserQuerySchema = types.QuerySchema{
+	Fields: map[string]types.FieldSpec{
+		"id":              {Column: "id", Type: types.FieldString, Filterable: true, Sortable: true},
+		"email":           {Column: "email", Type: types.FieldString, Filterable: true, Sortable: true},
+		"user_type":       {Column: "user_type", Type: types.FieldString, Filterable: true, Sortable: true},
+		"email_validated": {Column: "email_validated", Type: types.FieldBool, Filterable: true},
+		"person_id":       {Column: "person_id", Type: types.FieldString, Filterable: true},
+		"logged_at":       {Column: "logged_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"created_at":      {Column: "created_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+		"deleted_at":      {Column: "deleted_at", Type: types.FieldTime, Filterable: true, Sortable: true},
+	},
+	DefaultSort: []string{"-created_at"},
+}
+
+// FromDomainListItem convierte un usuario del listado al DTO.
+func FromDomainListItem(user domain.User) UserListItem {
+	item := UserListItem{
+		ID:             user.ID,
+		Email:          user.Credentials.Email,
+		UserType:       string(user.UserType),
+		EmailValidated: user.EmailValidated,
+		PersonID:       user.PersonID,
+		DeletedAt:      user.DeletedAt,
+		DeletedBy:      user.DeletedBy,
+	}
+	if !user.LoggedAt.IsZero() {
+		loggedAt := user.LoggedAt
+		item.LoggedAt = &loggedAt
+	}
+	return item
+}
@@ -0,0 +1,12 @@
+package dto
+
+// UserRoles es el body de PUT /:id/roles y reemplaza todos los roles del usuario.
+type UserRoles struct {
+	Roles []string `json:"roles" binding:"required,dive,required"`
+}
+
+// Response
+type UserRolesResponse struct {
+	UserID string   `json:"user_id"`
+	Roles  []string `json:"roles"`
+}
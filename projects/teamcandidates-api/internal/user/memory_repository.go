@@ -0,0 +1,458 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+type memoryRepository struct {
+	users         *mapdb.Table[models.User]
+	follows       *mapdb.Table[models.Follow]
+	mfas          *mapdb.Table[models.UserMfa]
+	recoveryCodes *mapdb.Table[models.RecoveryCode]
+	roles         *mapdb.Table[models.UserRole]
+	tx            pkgtx.Manager
+}
+
+// NewMemoryRepository crea el repositorio de usuarios sobre la base en memoria.
+// Respeta el soft delete de GORM: los usuarios con deleted_at no se devuelven.
+func NewMemoryRepository(db mapdb.Repository) Repository {
+	return &memoryRepository{
+		users: mapdb.NewTable(db, "users",
+			func(u *models.User) string { return u.ID },
+			mapdb.Index[models.User]{
+				Name:   "email",
+				Unique: true,
+				Values: func(u *models.User) []string { return []string{u.Email} },
+			},
+		),
+		follows: mapdb.NewTable(db, "follows",
+			func(f *models.Follow) string { return f.ID },
+			mapdb.Index[models.Follow]{
+				Name:   "follower_id",
+				Values: func(f *models.Follow) []string { return []string{f.FollowerID} },
+			},
+			mapdb.Index[models.Follow]{
+				Name:   "followee_id",
+				Values: func(f *models.Follow) []string { return []string{f.FolloweeID} },
+			},
+		),
+		mfas: mapdb.NewTable(db, "user_mfas",
+			func(m *models.UserMfa) string { return m.UserID },
+		),
+		recoveryCodes: mapdb.NewTable(db, "recovery_codes",
+			func(c *models.RecoveryCode) string { return c.ID },
+			mapdb.Index[models.RecoveryCode]{
+				Name:   "user_id",
+				Values: func(c *models.RecoveryCode) []string { return []string{c.UserID} },
+			},
+		),
+		roles: mapdb.NewTable(db, "user_roles",
+			func(r *models.UserRole) string { return r.UserID + "/" + r.Role },
+			mapdb.Index[models.UserRole]{
+				Name:   "user_id",
+				Values: func(r *models.UserRole) []string { return []string{r.UserID} },
+			},
+		),
+		tx: mapdb.NewTxManager(db),
+	}
+}
+
+func notDeletedUser(u *models.User) bool {
+	return !u.DeletedAt.Valid
+}
+
+func (r *memoryRepository) CreateUser(ctx context.Context, user *domain.User) (string, error) {
+	if user == nil {
+		return "", fmt.Errorf("user is nil")
+	}
+
+	model, err := models.FromDomain(user)
+	if err != nil {
+		return "", fmt.Errorf("error converting domain user to model: %w", err)
+	}
+	model.ID = uuid.New().String()
+	model.CreatedAt = time.Now()
+	model.UpdatedAt = model.CreatedAt
+
+	if err := r.users.Insert(ctx, model); err != nil {
+		return "", fmt.Errorf("error creating user in database: %w", err)
+	}
+	return model.ID, nil
+}
+
+func (r *memoryRepository) ListUsers(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.User], error) {
+	page, err := r.users.Page(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("error listing users: %w", err)
+	}
+
+	users := make([]domain.User, 0, len(page.Items))
+	for _, m := range page.Items {
+		user, err := m.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("error converting model to domain: %w", err)
+		}
+		users = append(users, *user)
+	}
+	return &types.Page[domain.User]{Items: users, Info: page.Info}, nil
+}
+
+// getUser devuelve el usuario id si existe y no está borrado.
+func (r *memoryRepository) getUser(ctx context.Context, id string) (*models.User, error) {
+	model, err := r.users.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !notDeletedUser(model) {
+		return nil, types.NewError(types.ErrNotFound, "user not found", nil)
+	}
+	return model, nil
+}
+
+func (r *memoryRepository) GetUser(ctx context.Context, id string) (*domain.User, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id is empty")
+	}
+
+	model, err := r.getUser(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving user with id %s: %w", id, err)
+	}
+	return model.ToDomain()
+}
+
+func (r *memoryRepository) UpdateUser(ctx context.Context, user *domain.User) error {
+	if user == nil {
+		return fmt.Errorf("user is nil")
+	}
+
+	updated, err := models.FromDomain(user)
+	if err != nil {
+		return fmt.Errorf("error converting domain user to model: %w", err)
+	}
+	err = r.users.Modify(ctx, user.ID, func(m *models.User) error {
+		updated.CreatedAt, updated.UpdatedAt, updated.DeletedAt, updated.DeletedBy = m.CreatedAt, time.Now(), m.DeletedAt, m.DeletedBy
+		*m = *updated
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating user with id %s: %w", user.ID, err)
+	}
+	return nil
+}
+
+func (r *memoryRepository) SoftDeleteUser(ctx context.Context, id, deletedBy string) error {
+	if id == "" {
+		return fmt.Errorf("id is empty")
+	}
+
+	err := r.users.Modify(ctx, id, func(m *models.User) error {
+		if m.DeletedAt.Valid {
+			return types.NewError(types.ErrNotFound, fmt.Sprintf("user with id %s not found", id), nil)
+		}
+		m.DeletedAt = gorm0.DeletedAt{Time: time.Now(), Valid: true}
+		m.DeletedBy = deletedBy
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting user with id %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *memoryRepository) HardDeleteUser(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("id is empty")
+	}
+
+	if err := r.users.Delete(ctx, id); err != nil {
+		return fmt.Errorf("error deleting user with id %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *memoryRepository) RestoreUser(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("id is empty")
+	}
+
+	err := r.users.Modify(ctx, id, func(m *models.User) error {
+		if !m.DeletedAt.Valid {
+			return types.NewError(types.ErrNotFound, fmt.Sprintf("deleted user with id %s not found", id), nil)
+		}
+		m.DeletedAt = gorm0.DeletedAt{}
+		m.DeletedBy = ""
+		m.UpdatedAt = time.Now()
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error restoring user with id %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *memoryRepository) PurgeDeletedUsers(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := r.users.DeleteWhere(ctx, func(m *models.User) bool {
+		return m.DeletedAt.Valid && m.DeletedAt.Time.Before(before)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error purging deleted users: %w", err)
+	}
+	return int64(purged), nil
+}
+
+func (r *memoryRepository) FollowUser(ctx context.Context, followerID, followeeID string) (string, error) {
+	if followerID == "" || followeeID == "" {
+		return "", fmt.Errorf("followerID or followeeID is empty")
+	}
+
+	follow := &models.Follow{
+		ID:         uuid.New().String(),
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		CreatedAt:  time.Now(),
+	}
+	if err := r.follows.Insert(ctx, follow); err != nil {
+		return "", fmt.Errorf("error creating follow relationship: %w", err)
+	}
+	return follow.ID, nil
+}
+
+func (r *memoryRepository) GetFolloweeUsers(ctx context.Context, followerID string) ([]string, error) {
+	if followerID == "" {
+		return nil, fmt.Errorf("followerID is empty")
+	}
+
+	follows, err := r.follows.FindBy(ctx, "follower_id", followerID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving follow relationships: %w", err)
+	}
+	followeeIDs := make([]string, 0, len(follows))
+	for _, f := range follows {
+		followeeIDs = append(followeeIDs, f.FolloweeID)
+	}
+	return followeeIDs, nil
+}
+
+func (r *memoryRepository) GetFollowerUsers(ctx context.Context, followeeID string) ([]string, error) {
+	if followeeID == "" {
+		return nil, fmt.Errorf("followeeID is empty")
+	}
+
+	follows, err := r.follows.FindBy(ctx, "followee_id", followeeID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving follower relationships: %w", err)
+	}
+	followerIDs := make([]string, 0, len(follows))
+	for _, f := range follows {
+		followerIDs = append(followerIDs, f.FollowerID)
+	}
+	return followerIDs, nil
+}
+
+func (r *memoryRepository) FollowExists(ctx context.Context, followerID, followeeID string) (bool, error) {
+	count, err := r.follows.Count(ctx,
+		mapdb.Where[models.Follow]("follower_id", types.OpEq, followerID),
+		mapdb.Where[models.Follow]("followee_id", types.OpEq, followeeID),
+	)
+	if err != nil {
+		return false, fmt.Errorf("error checking follow existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *memoryRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if email == "" {
+		return nil, fmt.Errorf("email is empty")
+	}
+
+	model, err := r.users.FindOneBy(ctx, "email", email)
+	if err != nil || !notDeletedUser(model) {
+		return nil, types.NewError(types.ErrNotFound, "user not found", err)
+	}
+	return model.ToDomain()
+}
+
+func (r *memoryRepository) SaveMfa(ctx context.Context, mfa *domain.Mfa) error {
+	model, err := models.FromDomainMfa(mfa)
+	if err != nil {
+		return fmt.Errorf("error converting domain mfa to model: %w", err)
+	}
+	model.UpdatedAt = time.Now()
+	if current, err := r.mfas.Get(ctx, model.UserID); err == nil {
+		model.CreatedAt = current.CreatedAt
+	} else {
+		model.CreatedAt = model.UpdatedAt
+	}
+
+	if err := r.mfas.Upsert(ctx, model); err != nil {
+		return fmt.Errorf("error saving mfa for user %s: %w", mfa.UserID, err)
+	}
+	return nil
+}
+
+func (r *memoryRepository) GetMfa(ctx context.Context, userID string) (*domain.Mfa, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("userID is empty")
+	}
+
+	model, err := r.mfas.Get(ctx, userID)
+	if err != nil {
+		return nil, types.NewError(types.ErrNotFound, "mfa not configured for user", err)
+	}
+	codes, err := r.recoveryCodes.FindBy(ctx, "user_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving recovery codes for user %s: %w", userID, err)
+	}
+	return model.ToDomain(codes)
+}
+
+func (r *memoryRepository) DeleteMfa(ctx context.Context, userID string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	return r.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := r.recoveryCodes.DeleteWhere(ctx, mapdb.Where[models.RecoveryCode]("user_id", types.OpEq, userID)); err != nil {
+			return fmt.Errorf("error deleting recovery codes for user %s: %w", userID, err)
+		}
+		if err := r.mfas.Delete(ctx, userID); err != nil && !types.IsNotFound(err) {
+			return fmt.Errorf("error deleting mfa for user %s: %w", userID, err)
+		}
+		return nil
+	})
+}
+
+func (r *memoryRepository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	return r.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := r.recoveryCodes.DeleteWhere(ctx, mapdb.Where[models.RecoveryCode]("user_id", types.OpEq, userID)); err != nil {
+			return fmt.Errorf("error deleting recovery codes for user %s: %w", userID, err)
+		}
+		for _, hash := range codeHashes {
+			code := &models.RecoveryCode{
+				ID:        uuid.New().String(),
+				UserID:    userID,
+				CodeHash:  hash,
+				CreatedAt: time.Now(),
+			}
+			if err := r.recoveryCodes.Insert(ctx, code); err != nil {
+				return fmt.Errorf("error storing recovery codes for user %s: %w", userID, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *memoryRepository) UseRecoveryCode(ctx context.Context, codeID string) error {
+	if codeID == "" {
+		return fmt.Errorf("codeID is empty")
+	}
+
+	err := r.recoveryCodes.Modify(ctx, codeID, func(c *models.RecoveryCode) error {
+		if c.UsedAt != nil {
+			return types.NewError(types.ErrConflict, "recovery code already used", nil)
+		}
+		now := time.Now()
+		c.UsedAt = &now
+		return nil
+	})
+	// Como en GORM, un código inexistente se reporta igual que uno ya usado
+	if types.IsNotFound(err) {
+		return types.NewError(types.ErrConflict, "recovery code already used", nil)
+	}
+	return err
+}
+
+func (r *memoryRepository) UseTotpStep(ctx context.Context, userID string, step int64) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	err := r.mfas.Modify(ctx, userID, func(m *models.UserMfa) error {
+		if m.LastUsedStep >= step {
+			return types.NewError(types.ErrConflict, "totp code already used", nil)
+		}
+		m.LastUsedStep = step
+		return nil
+	})
+	// Como en GORM, un usuario sin MFA se reporta igual que un código ya usado
+	if types.IsNotFound(err) {
+		return types.NewError(types.ErrConflict, "totp code already used", nil)
+	}
+	return err
+}
+
+func (r *memoryRepository) MarkEmailValidated(ctx context.Context, userID string) error {
+	err := r.users.Modify(ctx, userID, func(u *models.User) error {
+		u.EmailValidated = true
+		return nil
+	})
+	if types.IsNotFound(err) {
+		return types.NewError(types.ErrNotFound, "user not found", nil)
+	}
+	return err
+}
+
+func (r *memoryRepository) UpdatePassword(ctx context.Context, userID, hashedPassword string) error {
+	err := r.users.Modify(ctx, userID, func(u *models.User) error {
+		u.Password = hashedPassword
+		return nil
+	})
+	if types.IsNotFound(err) {
+		return types.NewError(types.ErrNotFound, "user not found", nil)
+	}
+	return err
+}
+
+func (r *memoryRepository) GetUserRoles(ctx context.Context, userID string) ([]string, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("userID is empty")
+	}
+
+	rows, err := r.roles.FindBy(ctx, "user_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving roles of user %s: %w", userID, err)
+	}
+	roles := make([]string, 0, len(rows))
+	for _, row := range rows {
+		roles = append(roles, row.Role)
+	}
+	sort.Strings(roles)
+	return roles, nil
+}
+
+func (r *memoryRepository) SetUserRoles(ctx context.Context, userID string, roles []string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	return r.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := r.roles.DeleteWhere(ctx, mapdb.Where[models.UserRole]("user_id", types.OpEq, userID)); err != nil {
+			return fmt.Errorf("error deleting roles of user %s: %w", userID, err)
+		}
+		for _, role := range roles {
+			row := &models.UserRole{UserID: userID, Role: role, CreatedAt: time.Now()}
+			if err := r.roles.Insert(ctx, row); err != nil {
+				return fmt.Errorf("error storing roles of user %s: %w", userID, err)
+			}
+		}
+		return nil
+	})
+}
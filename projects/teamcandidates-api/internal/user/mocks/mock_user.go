@@ -7,9 +7,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
-	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
 	gomock "github.com/golang/mock/gomock"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
 )
 
 // MockUseCases is a mock of UseCases interface.
@@ -50,6 +52,20 @@ func (mr *MockUseCasesMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUseCases)(nil).CreateUser), arg0, arg1)
 }
 
+// DeleteMfa mocks base method.
+func (m *MockUseCases) DeleteMfa(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMfa", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMfa indicates an expected call of DeleteMfa.
+func (mr *MockUseCasesMockRecorder) DeleteMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMfa", reflect.TypeOf((*MockUseCases)(nil).DeleteMfa), arg0, arg1)
+}
+
 // DeleteUser mocks base method.
 func (m *MockUseCases) DeleteUser(arg0 context.Context, arg1 string, arg2 bool) error {
 	m.ctrl.T.Helper()
@@ -109,6 +125,21 @@ func (mr *MockUseCasesMockRecorder) GetFollowerUsers(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowerUsers", reflect.TypeOf((*MockUseCases)(nil).GetFollowerUsers), arg0, arg1)
 }
 
+// GetMfa mocks base method.
+func (m *MockUseCases) GetMfa(arg0 context.Context, arg1 string) (*domain.Mfa, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMfa", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Mfa)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMfa indicates an expected call of GetMfa.
+func (mr *MockUseCasesMockRecorder) GetMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMfa", reflect.TypeOf((*MockUseCases)(nil).GetMfa), arg0, arg1)
+}
+
 // GetUser mocks base method.
 func (m *MockUseCases) GetUser(arg0 context.Context, arg1 string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -124,19 +155,148 @@ func (mr *MockUseCasesMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUseCases)(nil).GetUser), arg0, arg1)
 }
 
+// GetUserByEmail mocks base method.
+func (m *MockUseCases) GetUserByEmail(arg0 context.Context, arg1 string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", arg0, arg1)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockUseCasesMockRecorder) GetUserByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUseCases)(nil).GetUserByEmail), arg0, arg1)
+}
+
+// GetUserRoles mocks base method.
+func (m *MockUseCases) GetUserRoles(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRoles", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockUseCasesMockRecorder) GetUserRoles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockUseCases)(nil).GetUserRoles), arg0, arg1)
+}
+
 // ListUsers mocks base method.
-func (m *MockUseCases) ListUsers(arg0 context.Context) ([]domain.User, error) {
+func (m *MockUseCases) ListUsers(arg0 context.Context, arg1 *types.QuerySpec) (*types.Page[domain.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", arg0)
-	ret0, _ := ret[0].([]domain.User)
+	ret := m.ctrl.Call(m, "ListUsers", arg0, arg1)
+	ret0, _ := ret[0].(*types.Page[domain.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUsers indicates an expected call of ListUsers.
-func (mr *MockUseCasesMockRecorder) ListUsers(arg0 interface{}) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) ListUsers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUseCases)(nil).ListUsers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUseCases)(nil).ListUsers), arg0, arg1)
+}
+
+// MarkEmailValidated mocks base method.
+func (m *MockUseCases) MarkEmailValidated(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailValidated", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEmailValidated indicates an expected call of MarkEmailValidated.
+func (mr *MockUseCasesMockRecorder) MarkEmailValidated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailValidated", reflect.TypeOf((*MockUseCases)(nil).MarkEmailValidated), arg0, arg1)
+}
+
+// PurgeDeletedUsers mocks base method.
+func (m *MockUseCases) PurgeDeletedUsers(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedUsers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedUsers indicates an expected call of PurgeDeletedUsers.
+func (mr *MockUseCasesMockRecorder) PurgeDeletedUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedUsers", reflect.TypeOf((*MockUseCases)(nil).PurgeDeletedUsers), arg0, arg1)
+}
+
+// ReplaceRecoveryCodes mocks base method.
+func (m *MockUseCases) ReplaceRecoveryCodes(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodes indicates an expected call of ReplaceRecoveryCodes.
+func (mr *MockUseCasesMockRecorder) ReplaceRecoveryCodes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodes", reflect.TypeOf((*MockUseCases)(nil).ReplaceRecoveryCodes), arg0, arg1, arg2)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUseCases) ResetPassword(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUseCasesMockRecorder) ResetPassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUseCases)(nil).ResetPassword), arg0, arg1, arg2)
+}
+
+// RestoreUser mocks base method.
+func (m *MockUseCases) RestoreUser(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockUseCasesMockRecorder) RestoreUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockUseCases)(nil).RestoreUser), arg0, arg1)
+}
+
+// SaveMfa mocks base method.
+func (m *MockUseCases) SaveMfa(arg0 context.Context, arg1 *domain.Mfa) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMfa", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMfa indicates an expected call of SaveMfa.
+func (mr *MockUseCasesMockRecorder) SaveMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMfa", reflect.TypeOf((*MockUseCases)(nil).SaveMfa), arg0, arg1)
+}
+
+// SetUserRoles mocks base method.
+func (m *MockUseCases) SetUserRoles(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserRoles", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserRoles indicates an expected call of SetUserRoles.
+func (mr *MockUseCasesMockRecorder) SetUserRoles(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRoles", reflect.TypeOf((*MockUseCases)(nil).SetUserRoles), arg0, arg1, arg2)
 }
 
 // UpdateUser mocks base method.
@@ -153,6 +313,49 @@ func (mr *MockUseCasesMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUseCases)(nil).UpdateUser), arg0, arg1)
 }
 
+// UseRecoveryCode mocks base method.
+func (m *MockUseCases) UseRecoveryCode(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockUseCasesMockRecorder) UseRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockUseCases)(nil).UseRecoveryCode), arg0, arg1)
+}
+
+// UseTotpStep mocks base method.
+func (m *MockUseCases) UseTotpStep(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTotpStep", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseTotpStep indicates an expected call of UseTotpStep.
+func (mr *MockUseCasesMockRecorder) UseTotpStep(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTotpStep", reflect.TypeOf((*MockUseCases)(nil).UseTotpStep), arg0, arg1, arg2)
+}
+
+// UserPermissions mocks base method.
+func (m *MockUseCases) UserPermissions(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserPermissions", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserPermissions indicates an expected call of UserPermissions.
+func (mr *MockUseCasesMockRecorder) UserPermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserPermissions", reflect.TypeOf((*MockUseCases)(nil).UserPermissions), arg0, arg1)
+}
+
 // MockRepository is a mock of Repository interface.
 type MockRepository struct {
 	ctrl     *gomock.Controller
@@ -191,18 +394,18 @@ func (mr *MockRepositoryMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockRepository)(nil).CreateUser), arg0, arg1)
 }
 
-// DeleteUser mocks base method.
-func (m *MockRepository) DeleteUser(arg0 context.Context, arg1 string, arg2 bool) error {
+// DeleteMfa mocks base method.
+func (m *MockRepository) DeleteMfa(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "DeleteMfa", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockRepositoryMockRecorder) DeleteUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+// DeleteMfa indicates an expected call of DeleteMfa.
+func (mr *MockRepositoryMockRecorder) DeleteMfa(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockRepository)(nil).DeleteUser), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMfa", reflect.TypeOf((*MockRepository)(nil).DeleteMfa), arg0, arg1)
 }
 
 // FollowExists mocks base method.
@@ -265,6 +468,21 @@ func (mr *MockRepositoryMockRecorder) GetFollowerUsers(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowerUsers", reflect.TypeOf((*MockRepository)(nil).GetFollowerUsers), arg0, arg1)
 }
 
+// GetMfa mocks base method.
+func (m *MockRepository) GetMfa(arg0 context.Context, arg1 string) (*domain.Mfa, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMfa", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Mfa)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMfa indicates an expected call of GetMfa.
+func (mr *MockRepositoryMockRecorder) GetMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMfa", reflect.TypeOf((*MockRepository)(nil).GetMfa), arg0, arg1)
+}
+
 // GetUser mocks base method.
 func (m *MockRepository) GetUser(arg0 context.Context, arg1 string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -280,19 +498,176 @@ func (mr *MockRepositoryMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepository)(nil).GetUser), arg0, arg1)
 }
 
+// GetUserByEmail mocks base method.
+func (m *MockRepository) GetUserByEmail(arg0 context.Context, arg1 string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", arg0, arg1)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockRepositoryMockRecorder) GetUserByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockRepository)(nil).GetUserByEmail), arg0, arg1)
+}
+
+// GetUserRoles mocks base method.
+func (m *MockRepository) GetUserRoles(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRoles", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockRepositoryMockRecorder) GetUserRoles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockRepository)(nil).GetUserRoles), arg0, arg1)
+}
+
+// HardDeleteUser mocks base method.
+func (m *MockRepository) HardDeleteUser(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HardDeleteUser indicates an expected call of HardDeleteUser.
+func (mr *MockRepositoryMockRecorder) HardDeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDeleteUser", reflect.TypeOf((*MockRepository)(nil).HardDeleteUser), arg0, arg1)
+}
+
 // ListUsers mocks base method.
-func (m *MockRepository) ListUsers(arg0 context.Context) ([]domain.User, error) {
+func (m *MockRepository) ListUsers(arg0 context.Context, arg1 *types.QuerySpec) (*types.Page[domain.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", arg0)
-	ret0, _ := ret[0].([]domain.User)
+	ret := m.ctrl.Call(m, "ListUsers", arg0, arg1)
+	ret0, _ := ret[0].(*types.Page[domain.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUsers indicates an expected call of ListUsers.
-func (mr *MockRepositoryMockRecorder) ListUsers(arg0 interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) ListUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockRepository)(nil).ListUsers), arg0, arg1)
+}
+
+// MarkEmailValidated mocks base method.
+func (m *MockRepository) MarkEmailValidated(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailValidated", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEmailValidated indicates an expected call of MarkEmailValidated.
+func (mr *MockRepositoryMockRecorder) MarkEmailValidated(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockRepository)(nil).ListUsers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailValidated", reflect.TypeOf((*MockRepository)(nil).MarkEmailValidated), arg0, arg1)
+}
+
+// PurgeDeletedUsers mocks base method.
+func (m *MockRepository) PurgeDeletedUsers(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedUsers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedUsers indicates an expected call of PurgeDeletedUsers.
+func (mr *MockRepositoryMockRecorder) PurgeDeletedUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedUsers", reflect.TypeOf((*MockRepository)(nil).PurgeDeletedUsers), arg0, arg1)
+}
+
+// ReplaceRecoveryCodes mocks base method.
+func (m *MockRepository) ReplaceRecoveryCodes(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodes indicates an expected call of ReplaceRecoveryCodes.
+func (mr *MockRepositoryMockRecorder) ReplaceRecoveryCodes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodes", reflect.TypeOf((*MockRepository)(nil).ReplaceRecoveryCodes), arg0, arg1, arg2)
+}
+
+// RestoreUser mocks base method.
+func (m *MockRepository) RestoreUser(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockRepositoryMockRecorder) RestoreUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockRepository)(nil).RestoreUser), arg0, arg1)
+}
+
+// SaveMfa mocks base method.
+func (m *MockRepository) SaveMfa(arg0 context.Context, arg1 *domain.Mfa) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMfa", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMfa indicates an expected call of SaveMfa.
+func (mr *MockRepositoryMockRecorder) SaveMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMfa", reflect.TypeOf((*MockRepository)(nil).SaveMfa), arg0, arg1)
+}
+
+// SetUserRoles mocks base method.
+func (m *MockRepository) SetUserRoles(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserRoles", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserRoles indicates an expected call of SetUserRoles.
+func (mr *MockRepositoryMockRecorder) SetUserRoles(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRoles", reflect.TypeOf((*MockRepository)(nil).SetUserRoles), arg0, arg1, arg2)
+}
+
+// SoftDeleteUser mocks base method.
+func (m *MockRepository) SoftDeleteUser(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteUser indicates an expected call of SoftDeleteUser.
+func (mr *MockRepositoryMockRecorder) SoftDeleteUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteUser", reflect.TypeOf((*MockRepository)(nil).SoftDeleteUser), arg0, arg1, arg2)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockRepository) UpdatePassword(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockRepositoryMockRecorder) UpdatePassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockRepository)(nil).UpdatePassword), arg0, arg1, arg2)
 }
 
 // UpdateUser mocks base method.
@@ -308,3 +683,31 @@ func (mr *MockRepositoryMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockRepository)(nil).UpdateUser), arg0, arg1)
 }
+
+// UseRecoveryCode mocks base method.
+func (m *MockRepository) UseRecoveryCode(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockRepositoryMockRecorder) UseRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockRepository)(nil).UseRecoveryCode), arg0, arg1)
+}
+
+// UseTotpStep mocks base method.
+func (m *MockRepository) UseTotpStep(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTotpStep", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseTotpStep indicates an expected call of UseTotpStep.
+func (mr *MockRepositoryMockRecorder) UseTotpStep(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTotpStep", reflect.TypeOf((*MockRepository)(nil).UseTotpStep), arg0, arg1, arg2)
+}
@@ -2,6 +2,9 @@ package user
 
 import (
 	"context"
+	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
 )
@@ -10,23 +13,63 @@ type UseCases interface {
 	CreateUser(context.Context, *domain.User) (string, error)
 	GetUser(context.Context, string) (*domain.User, error)
 	DeleteUser(context.Context, string, bool) error
-	ListUsers(context.Context) ([]domain.User, error)
+	RestoreUser(context.Context, string) error
+	PurgeDeletedUsers(context.Context, time.Time) (int64, error)
+	ListUsers(context.Context, *types.QuerySpec) (*types.Page[domain.User], error)
 	UpdateUser(context.Context, *domain.User) error
 	FollowUser(context.Context, string, string) (string, error)
 	GetFolloweeUsers(context.Context, string) ([]string, error)
 	GetFollowerUsers(context.Context, string) ([]string, error)
+	GetUserByEmail(context.Context, string) (*domain.User, error)
+
+	// INFO: MFA
+	SaveMfa(context.Context, *domain.Mfa) error
+	GetMfa(context.Context, string) (*domain.Mfa, error)
+	DeleteMfa(context.Context, string) error
+	ReplaceRecoveryCodes(context.Context, string, []string) error
+	UseRecoveryCode(context.Context, string) error
+	UseTotpStep(context.Context, string, int64) error
+
+	// INFO: Account
+	MarkEmailValidated(context.Context, string) error
+	ResetPassword(context.Context, string, string) error
+
+	// INFO: Roles
+	GetUserRoles(context.Context, string) ([]string, error)
+	SetUserRoles(context.Context, string, []string) error
+	UserPermissions(context.Context, string) ([]string, error)
 }
 
 type Repository interface {
 	CreateUser(context.Context, *domain.User) (string, error)
 	UpdateUser(context.Context, *domain.User) error
 	GetUser(context.Context, string) (*domain.User, error)
-	DeleteUser(context.Context, string, bool) error
-	ListUsers(context.Context) ([]domain.User, error)
+	SoftDeleteUser(context.Context, string, string) error
+	HardDeleteUser(context.Context, string) error
+	RestoreUser(context.Context, string) error
+	PurgeDeletedUsers(context.Context, time.Time) (int64, error)
+	ListUsers(context.Context, *types.QuerySpec) (*types.Page[domain.User], error)
 	FollowUser(context.Context, string, string) (string, error)
 	GetFolloweeUsers(context.Context, string) ([]string, error)
 	GetFollowerUsers(context.Context, string) ([]string, error)
 	FollowExists(context.Context, string, string) (bool, error)
+	GetUserByEmail(context.Context, string) (*domain.User, error)
+
+	// INFO: MFA
+	SaveMfa(context.Context, *domain.Mfa) error
+	GetMfa(context.Context, string) (*domain.Mfa, error)
+	DeleteMfa(context.Context, string) error
+	ReplaceRecoveryCodes(context.Context, string, []string) error
+	UseRecoveryCode(context.Context, string) error
+	UseTotpStep(context.Context, string, int64) error
+
+	// INFO: Account
+	MarkEmailValidated(context.Context, string) error
+	UpdatePassword(context.Context, string, string) error
+
+	// INFO: Roles
+	GetUserRoles(context.Context, string) ([]string, error)
+	SetUserRoles(context.Context, string, []string) error
 }
 
 // Gomock
@@ -3,9 +3,11 @@ package user
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 
 	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/repository/models"
 	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
@@ -35,29 +37,29 @@ func (r *repository) CreateUser(ctx context.Context, user *domain.User) (string,
 	}
 	model.ID = uuid.New().String()
 
-	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+	if err := r.db.DB(ctx).Create(model).Error; err != nil {
 		return "", fmt.Errorf("error creating user in database: %w", err)
 	}
 
 	return model.ID, nil
 }
 
-// ListUsers retrieves all users from the database.
-func (r *repository) ListUsers(ctx context.Context) ([]domain.User, error) {
-	var modelsList []models.User
-	if err := r.db.Client().WithContext(ctx).Find(&modelsList).Error; err != nil {
+// ListUsers retrieves a page of users matching the query spec.
+func (r *repository) ListUsers(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.User], error) {
+	page, err := gorm.Paginate[models.User](r.db.DB(ctx).Model(&models.User{}), spec)
+	if err != nil {
 		return nil, fmt.Errorf("error listing users: %w", err)
 	}
 
-	users := make([]domain.User, 0, len(modelsList))
-	for _, m := range modelsList {
+	users := make([]domain.User, 0, len(page.Items))
+	for _, m := range page.Items {
 		user, err := m.ToDomain()
 		if err != nil {
 			return nil, fmt.Errorf("error converting model to domain: %w", err)
 		}
 		users = append(users, *user)
 	}
-	return users, nil
+	return &types.Page[domain.User]{Items: users, Info: page.Info}, nil
 }
 
 // GetUser retrieves a user by its ID.
@@ -67,7 +69,7 @@ func (r *repository) GetUser(ctx context.Context, id string) (*domain.User, erro
 	}
 
 	var model models.User
-	if err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	if err := r.db.DB(ctx).Where("id = ?", id).First(&model).Error; err != nil {
 		return nil, fmt.Errorf("error retrieving user with id %s: %w", id, err)
 	}
 
@@ -89,27 +91,71 @@ func (r *repository) UpdateUser(ctx context.Context, user *domain.User) error {
 		return fmt.Errorf("error converting domain user to model: %w", err)
 	}
 
-	if err := r.db.Client().WithContext(ctx).Save(model).Error; err != nil {
+	if err := r.db.DB(ctx).Save(model).Error; err != nil {
 		return fmt.Errorf("error updating user with id %s: %w", user.ID, err)
 	}
 	return nil
 }
 
-// DeleteUser deletes a user by its ID.
-func (r *repository) DeleteUser(ctx context.Context, id string, hardDelete bool) error {
+// SoftDeleteUser marks a user as deleted, recording who deleted it.
+func (r *repository) SoftDeleteUser(ctx context.Context, id, deletedBy string) error {
+	if id == "" {
+		return fmt.Errorf("id is empty")
+	}
+	result := r.db.DB(ctx).Model(&models.User{}).Where("id = ?", id).
+		Updates(map[string]any{"deleted_at": time.Now(), "deleted_by": deletedBy})
+	if result.Error != nil {
+		return fmt.Errorf("error deleting user with id %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("user with id %s not found", id), nil)
+	}
+	return nil
+}
+
+// HardDeleteUser permanently deletes a user, even if it was soft deleted.
+func (r *repository) HardDeleteUser(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("id is empty")
+	}
+	result := r.db.DB(ctx).Unscoped().Delete(&models.User{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("error deleting user with id %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("user with id %s not found", id), nil)
+	}
+	return nil
+}
+
+// RestoreUser clears the soft delete of a user.
+func (r *repository) RestoreUser(ctx context.Context, id string) error {
 	if id == "" {
 		return fmt.Errorf("id is empty")
 	}
-	db := r.db.Client().WithContext(ctx)
-	if hardDelete {
-		db = db.Unscoped()
+	result := r.db.DB(ctx).Unscoped().Model(&models.User{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_by": nil})
+	if result.Error != nil {
+		return fmt.Errorf("error restoring user with id %s: %w", id, result.Error)
 	}
-	if err := db.Delete(&models.User{}, "id = ?", id).Error; err != nil {
-		return fmt.Errorf("error deleting user with id %s: %w", id, err)
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, fmt.Sprintf("deleted user with id %s not found", id), nil)
 	}
 	return nil
 }
 
+// PurgeDeletedUsers permanently deletes the users soft deleted before the given time.
+func (r *repository) PurgeDeletedUsers(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.DB(ctx).Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Delete(&models.User{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("error purging deleted users: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
 // FollowUser creates a follow relationship between two users.
 // El repository se limita a persistir la relación sin aplicar reglas de negocio.
 func (r *repository) FollowUser(ctx context.Context, followerID, followeeID string) (string, error) {
@@ -123,7 +169,7 @@ func (r *repository) FollowUser(ctx context.Context, followerID, followeeID stri
 		FolloweeID: followeeID,
 	}
 
-	if err := r.db.Client().WithContext(ctx).Create(&followModel).Error; err != nil {
+	if err := r.db.DB(ctx).Create(&followModel).Error; err != nil {
 		return "", fmt.Errorf("error creating follow relationship: %w", err)
 	}
 
@@ -137,7 +183,7 @@ func (r *repository) GetFolloweeUsers(ctx context.Context, followerID string) ([
 	}
 
 	var follows []models.Follow
-	if err := r.db.Client().WithContext(ctx).
+	if err := r.db.DB(ctx).
 		Where("follower_id = ?", followerID).
 		Find(&follows).Error; err != nil {
 		return nil, fmt.Errorf("error retrieving follow relationships: %w", err)
@@ -154,8 +200,7 @@ func (r *repository) GetFolloweeUsers(ctx context.Context, followerID string) ([
 // FollowExists checks whether a follow relationship between follower and followee already exists.
 func (r *repository) FollowExists(ctx context.Context, followerID, followeeID string) (bool, error) {
 	var count int64
-	err := r.db.Client().
-		WithContext(ctx).
+	err := r.db.DB(ctx).
 		Model(&models.Follow{}).
 		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
 		Count(&count).Error
@@ -172,7 +217,7 @@ func (r *repository) GetFollowerUsers(ctx context.Context, followeeID string) ([
 	}
 
 	var follows []models.Follow
-	if err := r.db.Client().WithContext(ctx).
+	if err := r.db.DB(ctx).
 		Where("followee_id = ?", followeeID).
 		Find(&follows).Error; err != nil {
 		return nil, fmt.Errorf("error retrieving follower relationships: %w", err)
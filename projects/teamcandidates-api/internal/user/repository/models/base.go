@@ -20,35 +20,16 @@ type User struct {
 	CreatedAt      time.Time      `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt      time.Time      `gorm:"column:updated_at;autoUpdateTime"`
 	DeletedAt      gorm.DeletedAt `gorm:"index;column:deleted_at"`
+	DeletedBy      string         `gorm:"column:deleted_by"`
 	// Los roles se manejarán a través de una tabla de unión (no se incluyen directamente aquí)
 }
 
-type Role struct {
-	ID          string         `gorm:"primaryKey;column:id"`
-	Name        string         `gorm:"column:name;unique;not null"`
-	Description string         `gorm:"column:description"`
-	CreatedAt   time.Time      `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt   time.Time      `gorm:"column:updated_at;autoUpdateTime"`
-	DeletedAt   gorm.DeletedAt `gorm:"index;column:deleted_at"`
-}
-
-type Permission struct {
-	ID          string         `gorm:"primaryKey;column:id"`
-	Name        string         `gorm:"column:name;unique;not null"`
-	Description string         `gorm:"column:description"`
-	CreatedAt   time.Time      `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt   time.Time      `gorm:"column:updated_at;autoUpdateTime"`
-	DeletedAt   gorm.DeletedAt `gorm:"index;column:deleted_at"`
-}
-
+// UserRole asigna un rol a un usuario. Los roles y sus permisos se definen en
+// domain.RolePermissions, por lo que solo se persiste el nombre.
 type UserRole struct {
-	UserID string `gorm:"column:user_id;primaryKey"`
-	RoleID string `gorm:"column:role_id;primaryKey"`
-}
-
-type RolePermission struct {
-	RoleID       string `gorm:"column:role_id;primaryKey"`
-	PermissionID string `gorm:"column:permission_id;primaryKey"`
+	UserID    string    `gorm:"column:user_id;primaryKey"`
+	Role      string    `gorm:"column:role;primaryKey;size:50"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
 }
 
 type Follow struct {
@@ -82,6 +63,8 @@ func FromDomain(u *domain.User) (*User, error) {
 		EmailValidated: u.EmailValidated,
 		UserType:       string(u.UserType),
 		LoggedAt:       loggedAt,
+		DeletedAt:      toDeletedAt(u.DeletedAt),
+		DeletedBy:      u.DeletedBy,
 	}, nil
 }
 
@@ -108,8 +91,24 @@ func (um *User) ToDomain() (*domain.User, error) {
 			Email:    um.Email,
 			Password: um.Password,
 		},
-		UserType: domain.UserType(um.UserType),
-		LoggedAt: loggedAt,
-		Roles:    []domain.Role{},
+		UserType:  domain.UserType(um.UserType),
+		LoggedAt:  loggedAt,
+		Roles:     []domain.Role{},
+		DeletedAt: fromDeletedAt(um.DeletedAt),
+		DeletedBy: um.DeletedBy,
 	}, nil
 }
+
+func toDeletedAt(t *time.Time) gorm.DeletedAt {
+	if t == nil {
+		return gorm.DeletedAt{}
+	}
+	return gorm.DeletedAt{Time: *t, Valid: true}
+}
+
+func fromDeletedAt(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
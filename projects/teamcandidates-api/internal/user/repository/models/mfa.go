@@ -0,0 +1,79 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+type UserMfa struct {
+	UserID       string     `gorm:"primaryKey;column:user_id"`
+	Secret       string     `gorm:"column:secret;not null"`
+	Enabled      bool       `gorm:"column:enabled;default:false"`
+	ConfirmedAt  *time.Time `gorm:"column:confirmed_at"`
+	LastUsedStep int64      `gorm:"column:last_used_step;not null;default:0"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+type RecoveryCode struct {
+	ID        string     `gorm:"primaryKey;column:id"`
+	UserID    string     `gorm:"column:user_id;index;not null"`
+	CodeHash  string     `gorm:"column:code_hash;not null"`
+	UsedAt    *time.Time `gorm:"column:used_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// Mappers
+func FromDomainMfa(m *domain.Mfa) (*UserMfa, error) {
+	if m == nil {
+		return nil, errors.New("mfa cannot be nil")
+	}
+
+	var confirmedAt *time.Time
+	if !m.ConfirmedAt.IsZero() {
+		confirmedAt = &m.ConfirmedAt
+	}
+
+	return &UserMfa{
+		UserID:       m.UserID,
+		Secret:       m.Secret,
+		Enabled:      m.Enabled,
+		ConfirmedAt:  confirmedAt,
+		LastUsedStep: m.LastUsedStep,
+	}, nil
+}
+
+func (mm *UserMfa) ToDomain(codes []RecoveryCode) (*domain.Mfa, error) {
+	if mm == nil {
+		return nil, errors.New("mfa model is nil")
+	}
+
+	var confirmedAt time.Time
+	if mm.ConfirmedAt != nil {
+		confirmedAt = *mm.ConfirmedAt
+	}
+
+	recoveryCodes := make([]domain.RecoveryCode, 0, len(codes))
+	for _, c := range codes {
+		var usedAt time.Time
+		if c.UsedAt != nil {
+			usedAt = *c.UsedAt
+		}
+		recoveryCodes = append(recoveryCodes, domain.RecoveryCode{
+			ID:       c.ID,
+			CodeHash: c.CodeHash,
+			UsedAt:   usedAt,
+		})
+	}
+
+	return &domain.Mfa{
+		UserID:        mm.UserID,
+		Secret:        mm.Secret,
+		Enabled:       mm.Enabled,
+		ConfirmedAt:   confirmedAt,
+		LastUsedStep:  mm.LastUsedStep,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
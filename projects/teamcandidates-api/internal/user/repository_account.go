@@ -0,0 +1,40 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/repository/models"
+)
+
+// MarkEmailValidated sets the email_validated flag of a user.
+func (r *repository) MarkEmailValidated(ctx context.Context, userID string) error {
+	result := r.db.DB(ctx).
+		Model(&models.User{}).
+		Where("id = ?", userID).
+		Update("email_validated", true)
+	if result.Error != nil {
+		return fmt.Errorf("error validating email of user %s: %w", userID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, "user not found", nil)
+	}
+	return nil
+}
+
+// UpdatePassword replaces the stored password hash of a user.
+func (r *repository) UpdatePassword(ctx context.Context, userID, hashedPassword string) error {
+	result := r.db.DB(ctx).
+		Model(&models.User{}).
+		Where("id = ?", userID).
+		Update("password", hashedPassword)
+	if result.Error != nil {
+		return fmt.Errorf("error updating password of user %s: %w", userID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrNotFound, "user not found", nil)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	gorm0 "gorm.io/gorm"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/repository/models"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+// GetUserByEmail retrieves a user by its email address.
+func (r *repository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if email == "" {
+		return nil, fmt.Errorf("email is empty")
+	}
+
+	var model models.User
+	if err := r.db.DB(ctx).Where("email = ?", email).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, "user not found", err)
+		}
+		return nil, fmt.Errorf("error retrieving user with email %s: %w", email, err)
+	}
+
+	return model.ToDomain()
+}
+
+// SaveMfa creates or replaces the MFA settings of a user.
+func (r *repository) SaveMfa(ctx context.Context, mfa *domain.Mfa) error {
+	model, err := models.FromDomainMfa(mfa)
+	if err != nil {
+		return fmt.Errorf("error converting domain mfa to model: %w", err)
+	}
+
+	if err := r.db.DB(ctx).Save(model).Error; err != nil {
+		return fmt.Errorf("error saving mfa for user %s: %w", mfa.UserID, err)
+	}
+	return nil
+}
+
+// GetMfa retrieves the MFA settings of a user along with its recovery codes.
+func (r *repository) GetMfa(ctx context.Context, userID string) (*domain.Mfa, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("userID is empty")
+	}
+
+	db := r.db.DB(ctx)
+
+	var model models.UserMfa
+	if err := db.Where("user_id = ?", userID).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, types.NewError(types.ErrNotFound, "mfa not configured for user", err)
+		}
+		return nil, fmt.Errorf("error retrieving mfa for user %s: %w", userID, err)
+	}
+
+	var codes []models.RecoveryCode
+	if err := db.Where("user_id = ?", userID).Find(&codes).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving recovery codes for user %s: %w", userID, err)
+	}
+
+	return model.ToDomain(codes)
+}
+
+// DeleteMfa removes the MFA settings and recovery codes of a user.
+func (r *repository) DeleteMfa(ctx context.Context, userID string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	return r.db.DB(ctx).Transaction(func(tx *gorm0.DB) error {
+		if err := tx.Delete(&models.RecoveryCode{}, "user_id = ?", userID).Error; err != nil {
+			return fmt.Errorf("error deleting recovery codes for user %s: %w", userID, err)
+		}
+		if err := tx.Delete(&models.UserMfa{}, "user_id = ?", userID).Error; err != nil {
+			return fmt.Errorf("error deleting mfa for user %s: %w", userID, err)
+		}
+		return nil
+	})
+}
+
+// ReplaceRecoveryCodes discards the previous recovery codes of a user and stores the given hashes.
+func (r *repository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	return r.db.DB(ctx).Transaction(func(tx *gorm0.DB) error {
+		if err := tx.Delete(&models.RecoveryCode{}, "user_id = ?", userID).Error; err != nil {
+			return fmt.Errorf("error deleting recovery codes for user %s: %w", userID, err)
+		}
+
+		if len(codeHashes) == 0 {
+			return nil
+		}
+
+		codes := make([]models.RecoveryCode, 0, len(codeHashes))
+		for _, hash := range codeHashes {
+			codes = append(codes, models.RecoveryCode{
+				ID:       uuid.New().String(),
+				UserID:   userID,
+				CodeHash: hash,
+			})
+		}
+		if err := tx.Create(&codes).Error; err != nil {
+			return fmt.Errorf("error storing recovery codes for user %s: %w", userID, err)
+		}
+		return nil
+	})
+}
+
+// UseRecoveryCode marks a recovery code as consumed. It fails if the code was already used.
+func (r *repository) UseRecoveryCode(ctx context.Context, codeID string) error {
+	if codeID == "" {
+		return fmt.Errorf("codeID is empty")
+	}
+
+	result := r.db.DB(ctx).
+		Model(&models.RecoveryCode{}).
+		Where("id = ? AND used_at IS NULL", codeID).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("error using recovery code %s: %w", codeID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrConflict, "recovery code already used", nil)
+	}
+	return nil
+}
+
+// UseTotpStep stores the accepted TOTP step. It fails if the step is not newer than the last one.
+func (r *repository) UseTotpStep(ctx context.Context, userID string, step int64) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	result := r.db.DB(ctx).
+		Model(&models.UserMfa{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return fmt.Errorf("error using totp step for user %s: %w", userID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return types.NewError(types.ErrConflict, "totp code already used", nil)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	gorm0 "gorm.io/gorm"
+
+	models "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/repository/models"
+)
+
+// GetUserRoles returns the names of the roles assigned to a user.
+func (r *repository) GetUserRoles(ctx context.Context, userID string) ([]string, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("userID is empty")
+	}
+
+	var roles []string
+	if err := r.db.DB(ctx).Model(&models.UserRole{}).Where("user_id = ?", userID).Order("role").Pluck("role", &roles).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving roles of user %s: %w", userID, err)
+	}
+	return roles, nil
+}
+
+// SetUserRoles replaces the roles assigned to a user.
+func (r *repository) SetUserRoles(ctx context.Context, userID string, roles []string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	return r.db.DB(ctx).Transaction(func(tx *gorm0.DB) error {
+		if err := tx.Delete(&models.UserRole{}, "user_id = ?", userID).Error; err != nil {
+			return fmt.Errorf("error deleting roles of user %s: %w", userID, err)
+		}
+
+		if len(roles) == 0 {
+			return nil
+		}
+
+		rows := make([]models.UserRole, 0, len(roles))
+		for _, role := range roles {
+			rows = append(rows, models.UserRole{UserID: userID, Role: role})
+		}
+		if err := tx.Create(&rows).Error; err != nil {
+			return fmt.Errorf("error storing roles of user %s: %w", userID, err)
+		}
+		return nil
+	})
+}
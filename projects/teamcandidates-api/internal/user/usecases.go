@@ -3,19 +3,31 @@ package user
 import (
 	"context"
 	"fmt"
+	"time"
 
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
 )
 
+// auditResource es el tipo de recurso con el que se registran los cambios de usuarios.
+const auditResource = "user"
+
 type useCases struct {
 	repository Repository
+	txManager  pkgtx.Manager
+	audit      audit.UseCases
 }
 
 // NewUseCases crea una nueva instancia de useCases.
-func NewUseCases(rp Repository) UseCases {
+func NewUseCases(rp Repository, tx pkgtx.Manager, au audit.UseCases) UseCases {
 	return &useCases{
 		repository: rp,
+		txManager:  tx,
+		audit:      au,
 	}
 }
 
@@ -32,21 +44,32 @@ func (u *useCases) CreateUser(ctx context.Context, user *domain.User) (string, e
 	}
 	user.Credentials.Password = hashedPassword
 
-	newUserID, err := u.repository.CreateUser(ctx, user)
+	// Los roles del request se ignoran: todo usuario nuevo arranca con RoleUser y solo un admin
+	// puede otorgarle otros (ver SetUserRoles).
+	var newUserID string
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		id, err := u.repository.CreateUser(ctx, user)
+		if err != nil {
+			return err
+		}
+		newUserID = id
+		return u.repository.SetUserRoles(ctx, id, []string{domain.RoleUser})
+	})
 	if err != nil {
 		return "", fmt.Errorf("error creating user: %w", err)
 	}
 
+	u.audit.RecordChange(ctx, auditdom.ActionCreate, auditResource, newUserID, nil, user)
 	return newUserID, nil
 }
 
-// ListUsers retrieves a list of all users.
-func (u *useCases) ListUsers(ctx context.Context) ([]domain.User, error) {
-	users, err := u.repository.ListUsers(ctx)
+// ListUsers retrieves a page of users matching the query spec.
+func (u *useCases) ListUsers(ctx context.Context, spec *types.QuerySpec) (*types.Page[domain.User], error) {
+	page, err := u.repository.ListUsers(ctx, spec)
 	if err != nil {
 		return nil, fmt.Errorf("error listing users: %w", err)
 	}
-	return users, nil
+	return page, nil
 }
 
 // GetUser retrieves a user by its ID.
@@ -68,51 +91,108 @@ func (u *useCases) DeleteUser(ctx context.Context, id string, hardDelete bool) e
 		return fmt.Errorf("id is empty")
 	}
 
-	if err := u.repository.DeleteUser(ctx, id, hardDelete); err != nil {
+	before, _ := u.repository.GetUser(ctx, id)
+
+	var err error
+	if hardDelete {
+		err = u.repository.HardDeleteUser(ctx, id)
+	} else {
+		err = u.repository.SoftDeleteUser(ctx, id, types.PrincipalIDFromContext(ctx))
+	}
+	if err != nil {
 		return fmt.Errorf("error deleting user with ID %s: %w", id, err)
 	}
+
+	u.audit.RecordChange(ctx, auditdom.ActionDelete, auditResource, id, before, nil)
 	return nil
 }
 
-// UpdateUser updates the user's information.
-func (u *useCases) UpdateUser(ctx context.Context, updatedUser *domain.User) error {
-	if updatedUser == nil {
-		return fmt.Errorf("updatedUser is nil")
+// RestoreUser clears the soft delete of a user.
+func (u *useCases) RestoreUser(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("id is empty")
 	}
 
-	if err := u.repository.UpdateUser(ctx, updatedUser); err != nil {
-		return fmt.Errorf("error updating user with ID %s: %w", updatedUser.ID, err)
+	if err := u.repository.RestoreUser(ctx, id); err != nil {
+		return fmt.Errorf("error restoring user with ID %s: %w", id, err)
 	}
+
+	after, _ := u.repository.GetUser(ctx, id)
+	u.audit.RecordChange(ctx, auditdom.ActionRestore, auditResource, id, nil, after)
 	return nil
 }
 
-// FollowUser creates a follow relationship between two users.
-func (u *useCases) FollowUser(ctx context.Context, followerID, followeeID string) (string, error) {
-	if followerID == "" || followeeID == "" {
-		return "", fmt.Errorf("followerID or followeeID is empty")
+// PurgeDeletedUsers permanently deletes the users soft deleted before the given time.
+func (u *useCases) PurgeDeletedUsers(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := u.repository.PurgeDeletedUsers(ctx, before)
+	if err != nil {
+		return 0, err
 	}
 
-	// Verifica que el seguidor exista.
-	if _, err := u.repository.GetUser(ctx, followerID); err != nil {
-		return "", fmt.Errorf("follower with ID %s does not exist: %w", followerID, err)
+	if purged > 0 {
+		u.audit.RecordChange(ctx, auditdom.ActionPurge, auditResource, "", nil, map[string]any{"before": before, "purged": purged})
 	}
+	return purged, nil
+}
 
-	// Verifica que el seguido exista.
-	if _, err := u.repository.GetUser(ctx, followeeID); err != nil {
-		return "", fmt.Errorf("followee with ID %s does not exist: %w", followeeID, err)
+// UpdateUser updates the user's information.
+func (u *useCases) UpdateUser(ctx context.Context, updatedUser *domain.User) error {
+	if updatedUser == nil {
+		return fmt.Errorf("updatedUser is nil")
 	}
 
-	exists, err := u.repository.FollowExists(ctx, followerID, followeeID)
+	var before, after *domain.User
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		before, _ = u.repository.GetUser(ctx, updatedUser.ID)
+		if err := u.repository.UpdateUser(ctx, updatedUser); err != nil {
+			return fmt.Errorf("error updating user with ID %s: %w", updatedUser.ID, err)
+		}
+		after, _ = u.repository.GetUser(ctx, updatedUser.ID)
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("error checking follow relationship: %w", err)
+		return err
 	}
-	if exists {
-		return "", fmt.Errorf("follower %s already follows user %s", followerID, followeeID)
+
+	u.audit.RecordChange(ctx, auditdom.ActionUpdate, auditResource, updatedUser.ID, before, after)
+	return nil
+}
+
+// FollowUser creates a follow relationship between two users.
+func (u *useCases) FollowUser(ctx context.Context, followerID, followeeID string) (string, error) {
+	if followerID == "" || followeeID == "" {
+		return "", fmt.Errorf("followerID or followeeID is empty")
 	}
 
-	relationID, err := u.repository.FollowUser(ctx, followerID, followeeID)
+	// Las verificaciones y el alta de la relación se hacen en la misma transacción
+	var relationID string
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		// Verifica que el seguidor exista.
+		if _, err := u.repository.GetUser(ctx, followerID); err != nil {
+			return fmt.Errorf("follower with ID %s does not exist: %w", followerID, err)
+		}
+
+		// Verifica que el seguido exista.
+		if _, err := u.repository.GetUser(ctx, followeeID); err != nil {
+			return fmt.Errorf("followee with ID %s does not exist: %w", followeeID, err)
+		}
+
+		exists, err := u.repository.FollowExists(ctx, followerID, followeeID)
+		if err != nil {
+			return fmt.Errorf("error checking follow relationship: %w", err)
+		}
+		if exists {
+			return fmt.Errorf("follower %s already follows user %s", followerID, followeeID)
+		}
+
+		relationID, err = u.repository.FollowUser(ctx, followerID, followeeID)
+		if err != nil {
+			return fmt.Errorf("error following user: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("error following user: %w", err)
+		return "", err
 	}
 	return relationID, nil
 }
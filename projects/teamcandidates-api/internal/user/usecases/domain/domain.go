@@ -2,6 +2,8 @@ package domain
 
 import (
 	"time"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
 )
 
 type UserType string
@@ -18,6 +20,50 @@ type User struct {
 	Roles          []Role
 	LoggedAt       time.Time
 	EmailValidated bool
+	DeletedAt      *time.Time // Momento del soft delete
+	DeletedBy      string     // Principal que lo eliminó
+}
+
+// Roles de usuario. RoleUser se asigna al registrarse; RoleAdmin solo puede otorgarlo otro admin
+// (o el comando "api roles").
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// RolePermissions mapea cada rol a los permisos que otorga. RoleUser solo lee; lo que un usuario
+// hace sobre su propia cuenta (MFA, contraseña) no requiere permisos.
+var RolePermissions = map[string][]string{
+	RoleUser: {
+		types.PermissionCandidateRead,
+		types.PermissionAssessmentRead,
+		types.PermissionUserRead,
+		types.PermissionCatalogRead,
+	},
+	RoleAdmin: {
+		types.PermissionCandidateRead, types.PermissionCandidateWrite,
+		types.PermissionAssessmentRead, types.PermissionAssessmentWrite,
+		types.PermissionUserRead, types.PermissionUserWrite,
+		types.PermissionCatalogRead, types.PermissionCatalogWrite,
+		types.PermissionAuditRead, types.PermissionUserAdmin, types.PermissionAPIKeyAdmin,
+		types.PermissionReadDeleted, types.PermissionRestore,
+	},
+}
+
+// PermissionsForRoles devuelve la unión, sin duplicados, de los permisos de los roles indicados.
+// Los roles desconocidos no otorgan permisos.
+func PermissionsForRoles(roles []string) []string {
+	seen := make(map[string]bool)
+	permissions := make([]string, 0)
+	for _, role := range roles {
+		for _, perm := range RolePermissions[role] {
+			if !seen[perm] {
+				seen[perm] = true
+				permissions = append(permissions, perm)
+			}
+		}
+	}
+	return permissions
 }
 
 type Credentials struct {
@@ -35,6 +81,23 @@ type Permission struct {
 	Description string
 }
 
+// Mfa representa la configuración de autenticación multifactor (TOTP) de un usuario.
+type Mfa struct {
+	UserID        string
+	Secret        string // Cifrado con la clave de MFA de la configuración
+	Enabled       bool
+	ConfirmedAt   time.Time
+	LastUsedStep  int64 // Última ventana TOTP aceptada; las menores o iguales se rechazan
+	RecoveryCodes []RecoveryCode
+}
+
+// RecoveryCode es un código de recuperación de un solo uso; solo se guarda su hash.
+type RecoveryCode struct {
+	ID       string
+	CodeHash string
+	UsedAt   time.Time
+}
+
 type Follow struct {
 	FollowerID string // seguidor
 	FolloweeID string // seguido
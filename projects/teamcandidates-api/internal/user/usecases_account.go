@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+)
+
+// MarkEmailValidated marks the user's email as verified.
+func (u *useCases) MarkEmailValidated(ctx context.Context, userID string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	if err := u.repository.MarkEmailValidated(ctx, userID); err != nil {
+		return fmt.Errorf("error validating email of user %s: %w", userID, err)
+	}
+	return nil
+}
+
+// ResetPassword valida la complejidad de la nueva contraseña, la hashea y la persiste.
+func (u *useCases) ResetPassword(ctx context.Context, userID, newPassword string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	if err := utils.ValidatePasswordComplexity(newPassword); err != nil {
+		return types.NewError(types.ErrValidation, "invalid password", err)
+	}
+
+	hashedPassword, err := utils.HashPassword(newPassword, 12)
+	if err != nil {
+		return fmt.Errorf("error hashing password: %w", err)
+	}
+
+	if err := u.repository.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+		return fmt.Errorf("error updating password of user %s: %w", userID, err)
+	}
+
+	// El snapshot no incluye la contraseña: solo queda constancia del cambio
+	u.audit.RecordChange(ctx, auditdom.ActionPasswordReset, auditResource, userID, nil, nil)
+	return nil
+}
@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+// GetUserByEmail retrieves a user by its email address.
+func (u *useCases) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if email == "" {
+		return nil, fmt.Errorf("email is empty")
+	}
+
+	user, err := u.repository.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving user with email %s: %w", email, err)
+	}
+	return user, nil
+}
+
+// SaveMfa stores the MFA settings of a user.
+func (u *useCases) SaveMfa(ctx context.Context, mfa *domain.Mfa) error {
+	if mfa == nil {
+		return fmt.Errorf("mfa is nil")
+	}
+	if mfa.UserID == "" {
+		return fmt.Errorf("mfa userID is empty")
+	}
+
+	if err := u.repository.SaveMfa(ctx, mfa); err != nil {
+		return fmt.Errorf("error saving mfa: %w", err)
+	}
+	return nil
+}
+
+// GetMfa retrieves the MFA settings of a user.
+func (u *useCases) GetMfa(ctx context.Context, userID string) (*domain.Mfa, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("userID is empty")
+	}
+
+	mfa, err := u.repository.GetMfa(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving mfa for user %s: %w", userID, err)
+	}
+	return mfa, nil
+}
+
+// DeleteMfa removes the MFA settings of a user.
+func (u *useCases) DeleteMfa(ctx context.Context, userID string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	if err := u.repository.DeleteMfa(ctx, userID); err != nil {
+		return fmt.Errorf("error deleting mfa for user %s: %w", userID, err)
+	}
+	return nil
+}
+
+// ReplaceRecoveryCodes stores a new set of hashed recovery codes for a user.
+func (u *useCases) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	if err := u.repository.ReplaceRecoveryCodes(ctx, userID, codeHashes); err != nil {
+		return fmt.Errorf("error replacing recovery codes for user %s: %w", userID, err)
+	}
+	return nil
+}
+
+// UseRecoveryCode marks a recovery code as consumed.
+func (u *useCases) UseRecoveryCode(ctx context.Context, codeID string) error {
+	if codeID == "" {
+		return fmt.Errorf("codeID is empty")
+	}
+
+	if err := u.repository.UseRecoveryCode(ctx, codeID); err != nil {
+		return fmt.Errorf("error using recovery code: %w", err)
+	}
+	return nil
+}
+
+// UseTotpStep records the TOTP time step accepted for a user. It fails with a conflict if the
+// step is not newer than the last accepted one, so a code cannot be replayed.
+func (u *useCases) UseTotpStep(ctx context.Context, userID string, step int64) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	if err := u.repository.UseTotpStep(ctx, userID, step); err != nil {
+		return fmt.Errorf("error using totp step: %w", err)
+	}
+	return nil
+}
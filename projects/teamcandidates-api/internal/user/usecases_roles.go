@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	auditdom "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/usecases/domain"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+// GetUserRoles returns the roles assigned to a user.
+func (u *useCases) GetUserRoles(ctx context.Context, userID string) ([]string, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("userID is empty")
+	}
+
+	roles, err := u.repository.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving roles of user %s: %w", userID, err)
+	}
+	return roles, nil
+}
+
+// SetUserRoles reemplaza los roles de un usuario. Solo se aceptan los roles de domain.RolePermissions.
+func (u *useCases) SetUserRoles(ctx context.Context, userID string, roles []string) error {
+	if userID == "" {
+		return fmt.Errorf("userID is empty")
+	}
+
+	normalized := make([]string, 0, len(roles))
+	seen := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		if _, ok := domain.RolePermissions[role]; !ok {
+			return types.NewError(types.ErrValidation, fmt.Sprintf("unknown role %q", role), nil)
+		}
+		if !seen[role] {
+			seen[role] = true
+			normalized = append(normalized, role)
+		}
+	}
+	sort.Strings(normalized)
+
+	var before []string
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := u.repository.GetUser(ctx, userID); err != nil {
+			return err
+		}
+		current, err := u.repository.GetUserRoles(ctx, userID)
+		if err != nil {
+			return err
+		}
+		before = current
+		return u.repository.SetUserRoles(ctx, userID, normalized)
+	})
+	if err != nil {
+		return fmt.Errorf("error updating roles of user %s: %w", userID, err)
+	}
+
+	u.audit.RecordChange(ctx, auditdom.ActionUpdate, auditResource, userID,
+		map[string]any{"roles": before}, map[string]any{"roles": normalized})
+	return nil
+}
+
+// UserPermissions devuelve los permisos que otorgan los roles del usuario. Falla si el usuario
+// no existe o fue eliminado, de modo que su JWT deja de dar acceso.
+func (u *useCases) UserPermissions(ctx context.Context, userID string) ([]string, error) {
+	if _, err := u.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	roles, err := u.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.PermissionsForRoles(roles), nil
+}
@@ -0,0 +1,133 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	types "github.com/teamcubation/teamcandidates/pkg/types"
+
+	mock_audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit/mocks"
+	mock_user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/mocks"
+	domain "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user/usecases/domain"
+)
+
+func TestSetUserRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type fields struct {
+		repository *mock_user.MockRepository
+		audit      *mock_audit.MockUseCases
+	}
+
+	tests := []struct {
+		name    string
+		roles   []string
+		setup   func(f *fields)
+		wantErr func(error) bool
+	}{
+		{
+			name:    "Error: unknown role",
+			roles:   []string{"root"},
+			setup:   func(f *fields) {},
+			wantErr: types.IsValidationError,
+		},
+		{
+			name:  "Error: unknown user",
+			roles: []string{domain.RoleAdmin},
+			setup: func(f *fields) {
+				f.repository.EXPECT().
+					GetUser(gomock.Any(), "user1").
+					Return(nil, types.NewError(types.ErrNotFound, "user not found", nil))
+			},
+			wantErr: types.IsNotFound,
+		},
+		{
+			name:  "Error: current roles cannot be read",
+			roles: []string{domain.RoleAdmin},
+			setup: func(f *fields) {
+				f.repository.EXPECT().GetUser(gomock.Any(), "user1").Return(&domain.User{ID: "user1"}, nil)
+				// Sin los roles actuales no se puede auditar el cambio, así que no se reemplazan.
+				f.repository.EXPECT().GetUserRoles(gomock.Any(), "user1").Return(nil, errors.New("db down"))
+			},
+			wantErr: func(err error) bool { return err != nil },
+		},
+		{
+			name:  "Success: roles replaced and audited",
+			roles: []string{domain.RoleUser, domain.RoleAdmin, domain.RoleUser},
+			setup: func(f *fields) {
+				f.repository.EXPECT().GetUser(gomock.Any(), "user1").Return(&domain.User{ID: "user1"}, nil)
+				f.repository.EXPECT().GetUserRoles(gomock.Any(), "user1").Return([]string{domain.RoleUser}, nil)
+				f.repository.EXPECT().
+					SetUserRoles(gomock.Any(), "user1", []string{domain.RoleAdmin, domain.RoleUser}).
+					Return(nil)
+				f.audit.EXPECT().RecordChange(gomock.Any(), gomock.Any(), auditResource, "user1",
+					map[string]any{"roles": []string{domain.RoleUser}},
+					map[string]any{"roles": []string{domain.RoleAdmin, domain.RoleUser}},
+				)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockUseCases(ctrl),
+			}
+			tc.setup(&f)
+
+			uc := NewUseCases(f.repository, mapdb.NewTxManager(mapdb.Bootstrap()), f.audit)
+			err := uc.SetUserRoles(context.Background(), "user1", tc.roles)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err, "expected an error but got nil")
+				assert.True(t, tc.wantErr(err), "unexpected error type: %v", err)
+			} else {
+				assert.NoError(t, err, "expected no error but got one")
+			}
+		})
+	}
+}
+
+func TestPermissionsForRoles(t *testing.T) {
+	tests := []struct {
+		name      string
+		roles     []string
+		want      []string
+		wantNever []string
+	}{
+		{
+			name:      "user role only reads",
+			roles:     []string{domain.RoleUser},
+			want:      []string{types.PermissionCandidateRead, types.PermissionAssessmentRead, types.PermissionUserRead, types.PermissionCatalogRead},
+			wantNever: []string{types.PermissionCandidateWrite, types.PermissionUserWrite, types.PermissionUserAdmin},
+		},
+		{
+			name:  "admin role includes the administrative permissions",
+			roles: []string{domain.RoleUser, domain.RoleAdmin},
+			want:  []string{types.PermissionUserWrite, types.PermissionUserAdmin, types.PermissionAPIKeyAdmin, types.PermissionRestore},
+		},
+		{
+			name:  "unknown role grants nothing",
+			roles: []string{"root"},
+			want:  []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			permissions := domain.PermissionsForRoles(tc.roles)
+
+			assert.Subset(t, permissions, tc.want, "missing permissions")
+			for _, perm := range tc.wantNever {
+				assert.NotContains(t, permissions, perm, "unexpected permission")
+			}
+		})
+	}
+}
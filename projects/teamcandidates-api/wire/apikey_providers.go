@@ -0,0 +1,31 @@
+package wire
+
+import (
+	"errors"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+
+	apikey "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey"
+)
+
+func ProvideApiKeyRepository(repo gorm.Repository) (apikey.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	return apikey.NewRepository(repo), nil
+}
+
+func ProvideApiKeyUseCases(repo apikey.Repository) apikey.UseCases {
+	return apikey.NewUseCases(repo)
+}
+
+// ProvideApiKeyAuthenticator expone los casos de uso de API keys al middleware de autenticación.
+func ProvideApiKeyAuthenticator(usecases apikey.UseCases) mdw.APIKeyAuthenticator {
+	return usecases
+}
+
+func ProvideApiKeyHandler(server ginsrv.Server, usecases apikey.UseCases, middlewares *mdw.Middlewares) *apikey.Handler {
+	return apikey.NewHandler(server, usecases, middlewares)
+}
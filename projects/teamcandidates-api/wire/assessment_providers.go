@@ -3,11 +3,14 @@ package wire
 import (
 	"errors"
 
+	rabbit "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/producer"
 	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
 	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
 	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
@@ -23,16 +26,27 @@ func ProvideAssessmentRepository(repo gorm.Repository) (assessment.Repository, e
 	return assessment.NewRepository(repo), nil
 }
 
+// ProvideAssessmentBroker inyecta el broker con el que se encolan las entregas para su corrección.
+func ProvideAssessmentBroker(prod rabbit.Producer, cfg config.Loader) (assessment.Broker, error) {
+	if prod == nil {
+		return nil, errors.New("rabbit producer cannot be nil")
+	}
+	return assessment.NewBroker(prod, cfg.GetGradingConfig().Queue), nil
+}
+
 // ProvideAssessmentUseCases inyecta las dependencias requeridas por la capa de casos de uso de Assessment.
 func ProvideAssessmentUseCases(
 	repo assessment.Repository,
+	tx pkgtx.Manager,
 	notif notification.UseCases,
 	cand candidate.UseCases,
 	cfg config.Loader,
 	au authe.UseCases,
 	pn person.UseCases,
+	ad audit.UseCases,
+	br assessment.Broker,
 ) assessment.UseCases {
-	return assessment.NewUseCases(repo, notif, cand, cfg, au, pn)
+	return assessment.NewUseCases(repo, tx, notif, cand, cfg, au, pn, ad, br)
 }
 
 // ProvideAssessmentHandler inyecta las dependencias para crear el Handler de Assessment.
@@ -0,0 +1,26 @@
+package wire
+
+import (
+	"errors"
+
+	mng "github.com/teamcubation/teamcandidates/pkg/databases/nosql/mongodb/mongo-driver"
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+)
+
+func ProvideAuditRepository(repo mng.Repository) (audit.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("mongoDB repository cannot be nil")
+	}
+	return audit.NewRepository(repo), nil
+}
+
+func ProvideAuditUseCases(repo audit.Repository) audit.UseCases {
+	return audit.NewUseCases(repo)
+}
+
+func ProvideAuditHandler(server ginsrv.Server, usecases audit.UseCases, middlewares *mdw.Middlewares) *audit.Handler {
+	return audit.NewHandler(server, usecases, middlewares)
+}
@@ -5,13 +5,17 @@ import (
 	"errors"
 
 	jwt "github.com/teamcubation/teamcandidates/pkg/authe/jwt/v5"
+	totp "github.com/teamcubation/teamcandidates/pkg/authe/totp"
 	redis "github.com/teamcubation/teamcandidates/pkg/databases/cache/redis/v8"
 	resty "github.com/teamcubation/teamcandidates/pkg/http/clients/resty"
 	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
 	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 	authe "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe"
 	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	notification "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/notification"
+	user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
 )
 
 // ProvideAutheCache proporciona una implementación de authe.Cache utilizando Redis.
@@ -38,9 +42,31 @@ func ProvideAutheHttpClient(httpc resty.Client, cnfLdr config.Loader) (authe.Htt
 	return authe.NewHttpClient(httpc, cnfLdr), nil
 }
 
+// ProvideAutheTotpService proporciona una implementación de authe.TotpService utilizando el servicio TOTP.
+func ProvideAutheTotpService(totpSrv totp.Service) (authe.TotpService, error) {
+	if totpSrv == nil {
+		return nil, errors.New("totp service cannot be nil")
+	}
+	return authe.NewTotpService(totpSrv), nil
+}
+
 // ProvideAutheUseCases proporciona una implementación de authe.UseCases con todas sus dependencias.
-func ProvideAutheUseCases(ch authe.Cache, js authe.JwtService, hc authe.HttpClient) authe.UseCases {
-	return authe.NewUseCases(ch, js, hc)
+func ProvideAutheUseCases(
+	ch authe.Cache,
+	js authe.JwtService,
+	hc authe.HttpClient,
+	ts authe.TotpService,
+	uu user.UseCases,
+	nu notification.UseCases,
+	cnfLdr config.Loader,
+	ad audit.UseCases,
+) authe.UseCases {
+	return authe.NewUseCases(ch, js, hc, ts, uu, nu, cnfLdr, ad)
+}
+
+// ProvideTokenRevocationChecker expone la revocación de tokens de authe al middleware de autenticación.
+func ProvideTokenRevocationChecker(usecases authe.UseCases) mdw.TokenRevocationChecker {
+	return usecases
 }
 
 // ProvideAutheHandler proporciona un controlador de authe.Handler configurado con el servidor, casos de uso y middlewares.
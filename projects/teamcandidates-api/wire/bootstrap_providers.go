@@ -4,16 +4,23 @@ import (
 	"fmt"
 
 	jwt "github.com/teamcubation/teamcandidates/pkg/authe/jwt/v5"
+	totp "github.com/teamcubation/teamcandidates/pkg/authe/totp"
+	rabbitcons "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/consumer"
 	rabbit "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/producer"
 	rdch "github.com/teamcubation/teamcandidates/pkg/databases/cache/redis/v8"
 	cass "github.com/teamcubation/teamcandidates/pkg/databases/nosql/cassandra/gocql"
 	mng "github.com/teamcubation/teamcandidates/pkg/databases/nosql/mongodb/mongo-driver"
 	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
 	pgdb "github.com/teamcubation/teamcandidates/pkg/databases/sql/postgresql/pgxpool"
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	documents "github.com/teamcubation/teamcandidates/pkg/documents/local"
 	resty "github.com/teamcubation/teamcandidates/pkg/http/clients/resty"
 	restymdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/resty"
 	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 	ssmtp "github.com/teamcubation/teamcandidates/pkg/notification/smtp"
+	quality "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/quality"
+	similarity "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/similarity"
+	sandbox "github.com/teamcubation/teamcandidates/pkg/sandbox/local"
 	ws "github.com/teamcubation/teamcandidates/pkg/websocket/gorilla"
 )
 
@@ -26,6 +33,11 @@ func ProvideGormRepository() (gorm.Repository, error) {
 	return repo, nil
 }
 
+// ProvideGormTxManager provee el unit of work compartido por los repositorios GORM.
+func ProvideGormTxManager(repo gorm.Repository) pkgtx.Manager {
+	return gorm.NewTxManager(repo)
+}
+
 func ProvideGinServer() (ginsrv.Server, error) {
 	isTest := false
 	server, err := ginsrv.Bootstrap("", "", isTest)
@@ -95,6 +107,15 @@ func ProvideJwtService() (jwt.Service, error) {
 	return jwtSrv, nil
 }
 
+func ProvideTotpService() (totp.Service, error) {
+	totpSrv, err := totp.Bootstrap("", 0, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize TOTP service: %w", err)
+	}
+
+	return totpSrv, nil
+}
+
 func ProvideRabbitProducer() (rabbit.Producer, error) {
 	prod, err := rabbit.Bootstrap()
 	if err != nil {
@@ -104,6 +125,51 @@ func ProvideRabbitProducer() (rabbit.Producer, error) {
 	return prod, nil
 }
 
+func ProvideRabbitConsumer() (rabbitcons.Consumer, error) {
+	cons, err := rabbitcons.BootstrapConsumer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize RabbitMQ consumer: %w", err)
+	}
+
+	return cons, nil
+}
+
+func ProvideSandboxService() (sandbox.Service, error) {
+	srv, err := sandbox.Bootstrap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize sandbox: %w", err)
+	}
+
+	return srv, nil
+}
+
+func ProvideQualityService() (quality.Service, error) {
+	srv, err := quality.Bootstrap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize quality service: %w", err)
+	}
+
+	return srv, nil
+}
+
+func ProvideDocumentsService() (documents.Service, error) {
+	srv, err := documents.Bootstrap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize documents service: %w", err)
+	}
+
+	return srv, nil
+}
+
+func ProvideSimilarityService() (similarity.Service, error) {
+	srv, err := similarity.Bootstrap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize similarity service: %w", err)
+	}
+
+	return srv, nil
+}
+
 func ProvideCassandraRepository() (cass.Repository, error) {
 	repo, err := cass.Bootstrap()
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
 	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
 )
 
@@ -18,9 +19,9 @@ func ProvideCandidateRepository(repo gorm.Repository) (candidate.Repository, err
 	return candidate.NewRepository(repo), nil
 }
 
-// ProvideCandidateUseCases retorna candidate.UseCases a partir del repositorio.
-func ProvideCandidateUseCases(repo candidate.Repository) candidate.UseCases {
-	return candidate.NewUseCases(repo)
+// ProvideCandidateUseCases retorna candidate.UseCases a partir del repositorio y del log de auditoría.
+func ProvideCandidateUseCases(repo candidate.Repository, ad audit.UseCases) candidate.UseCases {
+	return candidate.NewUseCases(repo, ad)
 }
 
 // ProvideCandidateHandler retorna el Handler de candidate inyectando el servidor Gin,
@@ -0,0 +1,52 @@
+package wire
+
+import (
+	"errors"
+
+	rabbitcons "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/consumer"
+	rabbit "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/producer"
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+	quality "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/quality"
+	similarity "github.com/teamcubation/teamcandidates/pkg/repo-tools/ast/similarity"
+	sandbox "github.com/teamcubation/teamcandidates/pkg/sandbox/local"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	grading "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading"
+	pipeline "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline"
+)
+
+func ProvideGradingRepository(repo gorm.Repository) (grading.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	return grading.NewRepository(repo), nil
+}
+
+// ProvideGradingBroker inyecta la cola de corrección: publica con el producer y consume con el consumer.
+func ProvideGradingBroker(prod rabbit.Producer, cons rabbitcons.Consumer, cfg config.Loader) (grading.Broker, error) {
+	if prod == nil || cons == nil {
+		return nil, errors.New("rabbit producer and consumer cannot be nil")
+	}
+	gc := cfg.GetGradingConfig()
+	return grading.NewBroker(prod, cons, gc.Queue, gc.Exchange), nil
+}
+
+func ProvideGradingUseCases(
+	repo grading.Repository,
+	broker grading.Broker,
+	srv sandbox.Service,
+	q quality.Service,
+	sim similarity.Service,
+	assessmentUC assessment.UseCases,
+	pipelineUC pipeline.UseCases,
+	cfg config.Loader,
+) grading.UseCases {
+	return grading.NewUseCases(repo, broker, srv, q, sim, assessmentUC, pipelineUC, cfg)
+}
+
+func ProvideGradingHandler(server ginsrv.Server, usecases grading.UseCases, middlewares *mdw.Middlewares) *grading.Handler {
+	return grading.NewHandler(server, usecases, middlewares)
+}
@@ -0,0 +1,42 @@
+package wire
+
+import (
+	"errors"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	jobopening "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening"
+	pipeline "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline"
+	problem "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem"
+	user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
+)
+
+func ProvideJobOpeningRepository(repo gorm.Repository) (jobopening.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	return jobopening.NewRepository(repo), nil
+}
+
+func ProvideJobOpeningUseCases(
+	repo jobopening.Repository,
+	tx pkgtx.Manager,
+	assessmentUC assessment.UseCases,
+	candidateUC candidate.UseCases,
+	problemUC problem.UseCases,
+	pipelineUC pipeline.UseCases,
+	userUC user.UseCases,
+	auditUC audit.UseCases,
+) jobopening.UseCases {
+	return jobopening.NewUseCases(repo, tx, assessmentUC, candidateUC, problemUC, pipelineUC, userUC, auditUC)
+}
+
+func ProvideJobOpeningHandler(server ginsrv.Server, usecases jobopening.UseCases, middlewares *mdw.Middlewares) *jobopening.Handler {
+	return jobopening.NewHandler(server, usecases, middlewares)
+}
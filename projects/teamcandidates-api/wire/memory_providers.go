@@ -0,0 +1,150 @@
+package wire
+
+import (
+	"errors"
+	"fmt"
+
+	rabbitcons "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/consumer"
+	rabbit "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/producer"
+	rdch "github.com/teamcubation/teamcandidates/pkg/databases/cache/redis/v8"
+	mapdb "github.com/teamcubation/teamcandidates/pkg/databases/in-memory/mapdb"
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	browserevent "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	event "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event"
+	grading "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading"
+	jobopening "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening"
+	person "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person"
+	pipeline "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline"
+	problem "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem"
+	tweet "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet"
+	user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
+)
+
+// Proveedores de InitializeInMemory: reemplazan Postgres, Mongo, Cassandra, Redis y RabbitMQ por
+// implementaciones en memoria. Los módulos que solo tienen repositorio GORM usan SQLite (SQLITE_PATH).
+
+var errNilMemoryDB = errors.New("in-memory database cannot be nil")
+
+func ProvideMemoryDB() mapdb.Repository {
+	return mapdb.Bootstrap()
+}
+
+// ProvideMemoryTxManager provee el unit of work de la base en memoria; lo comparten user y assessment.
+func ProvideMemoryTxManager(db mapdb.Repository) pkgtx.Manager {
+	return mapdb.NewTxManager(db)
+}
+
+func ProvideMemoryGormRepository() (gorm.Repository, error) {
+	repo, err := gorm.Bootstrap("sqlite", "", "", "", "", 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize in-memory Gorm: %w", err)
+	}
+	return repo, nil
+}
+
+func ProvideMemoryRedisCache() rdch.Cache {
+	return rdch.NewMemoryCache(0)
+}
+
+func ProvideMemoryRabbitProducer() rabbit.Producer {
+	return rabbit.NewMemoryProducer()
+}
+
+// ProvideMemoryRabbitConsumer consume los mensajes del MemoryProducer, así los workers reciben
+// lo que publica la propia API.
+func ProvideMemoryRabbitConsumer(prod rabbit.Producer) (rabbitcons.Consumer, error) {
+	cons, ok := prod.(rabbitcons.Consumer)
+	if !ok {
+		return nil, errors.New("rabbit producer cannot be consumed in memory")
+	}
+	return cons, nil
+}
+
+func ProvidePersonMemoryRepository(db mapdb.Repository) (person.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return person.NewMemoryRepository(db), nil
+}
+
+func ProvideUserMemoryRepository(db mapdb.Repository) (user.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return user.NewMemoryRepository(db), nil
+}
+
+func ProvideCandidateMemoryRepository(db mapdb.Repository) (candidate.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return candidate.NewMemoryRepository(db), nil
+}
+
+func ProvideAssessmentMemoryRepository(db mapdb.Repository) (assessment.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return assessment.NewMemoryRepository(db), nil
+}
+
+func ProvideTweetMemoryRepository(db mapdb.Repository) (tweet.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return tweet.NewMemoryRepository(db), nil
+}
+
+func ProvideEventMemoryRepository(db mapdb.Repository) (event.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return event.NewMemoryRepository(db), nil
+}
+
+func ProvideBrowserEventsMemoryRepository(db mapdb.Repository) (browserevent.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return browserevent.NewMemoryRepository(db), nil
+}
+
+func ProvideAuditMemoryRepository(db mapdb.Repository) (audit.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return audit.NewMemoryRepository(db), nil
+}
+
+func ProvideGradingMemoryRepository(db mapdb.Repository) (grading.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return grading.NewMemoryRepository(db), nil
+}
+
+func ProvideProblemMemoryRepository(db mapdb.Repository) (problem.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return problem.NewMemoryRepository(db), nil
+}
+
+func ProvidePipelineMemoryRepository(db mapdb.Repository) (pipeline.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return pipeline.NewMemoryRepository(db), nil
+}
+
+func ProvideJobOpeningMemoryRepository(db mapdb.Repository) (jobopening.Repository, error) {
+	if db == nil {
+		return nil, errNilMemoryDB
+	}
+	return jobopening.NewMemoryRepository(db), nil
+}
@@ -5,6 +5,8 @@ import (
 
 	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
 	utils "github.com/teamcubation/teamcandidates/pkg/utils"
+
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 )
 
 func ProvideJwtMiddleware() (gin.HandlerFunc, error) {
@@ -12,7 +14,7 @@ func ProvideJwtMiddleware() (gin.HandlerFunc, error) {
 	return middleware, nil
 }
 
-func ProvideMiddlewares(jwtMiddleware gin.HandlerFunc) (*mdw.Middlewares, error) {
+func ProvideMiddlewares(jwtMiddleware gin.HandlerFunc, apiKeyAuth mdw.APIKeyAuthenticator, permissions mdw.UserPermissionResolver, revocations mdw.TokenRevocationChecker, auditUseCases audit.UseCases) (*mdw.Middlewares, error) {
 	globalMiddlewares := []gin.HandlerFunc{
 		mdw.ErrorHandlingMiddleware(),
 		mdw.RequestAndResponseLogger(mdw.HttpLoggingOptions{
@@ -22,19 +24,21 @@ func ProvideMiddlewares(jwtMiddleware gin.HandlerFunc) (*mdw.Middlewares, error)
 			ExcludedPaths: []string{
 				"/health",
 				"/ping",
+				"/metrics",
 				"/swagger/spec",
 				"/swagger/ui/index.html",
 			},
 		}),
+		// Deja request ID, IP y user agent en el context y registra las requests mutantes
+		audit.NewMiddleware(auditUseCases),
 	}
 
 	validatedMiddlewares := []gin.HandlerFunc{
 		mdw.ValidateCredentials(),
 	}
 
-	protectedMiddlewares := []gin.HandlerFunc{
-		jwtMiddleware,
-	}
+	// Acepta Bearer JWT o X-API-Key y deja el principal unificado, con sus permisos, en el contexto
+	protectedMiddlewares := mdw.Authenticate(jwtMiddleware, apiKeyAuth, permissions, revocations)
 
 	return &mdw.Middlewares{
 		Global:    globalMiddlewares,
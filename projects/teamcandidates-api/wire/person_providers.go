@@ -7,6 +7,7 @@ import (
 	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
 	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 	person "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person"
 )
 
@@ -17,8 +18,8 @@ func ProvidePersonRepository(repo pgdb.Repository) (person.Repository, error) {
 	return person.NewPostgresRepository(repo), nil
 }
 
-func ProvidePersonUseCases(repo person.Repository) person.UseCases {
-	return person.NewUseCases(repo)
+func ProvidePersonUseCases(repo person.Repository, ad audit.UseCases) person.UseCases {
+	return person.NewUseCases(repo, ad)
 }
 
 func ProvidePersonHandler(server ginsrv.Server, usecases person.UseCases, middlewares *mdw.Middlewares) *person.Handler {
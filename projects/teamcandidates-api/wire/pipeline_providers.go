@@ -0,0 +1,36 @@
+package wire
+
+import (
+	"errors"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	pipeline "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline"
+)
+
+func ProvidePipelineRepository(repo gorm.Repository) (pipeline.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	return pipeline.NewRepository(repo), nil
+}
+
+func ProvidePipelineUseCases(
+	repo pipeline.Repository,
+	tx pkgtx.Manager,
+	assessmentUC assessment.UseCases,
+	candidateUC candidate.UseCases,
+	auditUC audit.UseCases,
+) pipeline.UseCases {
+	return pipeline.NewUseCases(repo, tx, assessmentUC, candidateUC, auditUC)
+}
+
+func ProvidePipelineHandler(server ginsrv.Server, usecases pipeline.UseCases, middlewares *mdw.Middlewares) *pipeline.Handler {
+	return pipeline.NewHandler(server, usecases, middlewares)
+}
@@ -0,0 +1,36 @@
+package wire
+
+import (
+	"errors"
+
+	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	problem "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem"
+)
+
+func ProvideProblemRepository(repo gorm.Repository) (problem.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	return problem.NewRepository(repo), nil
+}
+
+func ProvideProblemUseCases(
+	repo problem.Repository,
+	tx pkgtx.Manager,
+	assessmentUC assessment.UseCases,
+	candidateUC candidate.UseCases,
+	auditUC audit.UseCases,
+) problem.UseCases {
+	return problem.NewUseCases(repo, tx, assessmentUC, candidateUC, auditUC)
+}
+
+func ProvideProblemHandler(server ginsrv.Server, usecases problem.UseCases, middlewares *mdw.Middlewares) *problem.Handler {
+	return problem.NewHandler(server, usecases, middlewares)
+}
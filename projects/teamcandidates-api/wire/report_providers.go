@@ -0,0 +1,29 @@
+package wire
+
+import (
+	documents "github.com/teamcubation/teamcandidates/pkg/documents/local"
+	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
+	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
+
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	browserevent "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	grading "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading"
+	report "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report"
+)
+
+func ProvideReportUseCases(
+	assessmentUC assessment.UseCases,
+	candidateUC candidate.UseCases,
+	gradingUC grading.UseCases,
+	browserEventUC browserevent.UseCases,
+	docs documents.Service,
+	cfg config.Loader,
+) report.UseCases {
+	return report.NewUseCases(assessmentUC, candidateUC, gradingUC, browserEventUC, docs, cfg)
+}
+
+func ProvideReportHandler(server ginsrv.Server, usecases report.UseCases, middlewares *mdw.Middlewares) *report.Handler {
+	return report.NewHandler(server, usecases, middlewares)
+}
@@ -0,0 +1,28 @@
+package wire
+
+import (
+	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
+	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
+	item "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/item"
+	person "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person"
+	retention "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/retention"
+	user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
+)
+
+func ProvideRetentionUseCases(
+	cfg config.Loader,
+	personUC person.UseCases,
+	userUC user.UseCases,
+	candidateUC candidate.UseCases,
+	assessmentUC assessment.UseCases,
+	itemUC item.UseCases,
+) retention.UseCases {
+	return retention.NewUseCases(cfg,
+		retention.Target{Name: "people", Purge: personUC.PurgeDeletedPersons},
+		retention.Target{Name: "users", Purge: userUC.PurgeDeletedUsers},
+		retention.Target{Name: "candidates", Purge: candidateUC.PurgeDeletedCandidates},
+		retention.Target{Name: "assessments", Purge: assessmentUC.PurgeDeletedAssessments},
+		retention.Target{Name: "items", Purge: itemUC.PurgeDeletedItems},
+	)
+}
@@ -4,9 +4,11 @@ import (
 	"errors"
 
 	gorm "github.com/teamcubation/teamcandidates/pkg/databases/sql/gorm"
+	pkgtx "github.com/teamcubation/teamcandidates/pkg/databases/sql/transactions"
 	mdw "github.com/teamcubation/teamcandidates/pkg/http/middlewares/gin"
 	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 	user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
 )
 
@@ -17,8 +19,13 @@ func ProvideUserRepository(repo gorm.Repository) (user.Repository, error) {
 	return user.NewRepository(repo), nil
 }
 
-func ProvideUserUseCases(repo user.Repository) user.UseCases {
-	return user.NewUseCases(repo)
+func ProvideUserUseCases(repo user.Repository, tx pkgtx.Manager, ad audit.UseCases) user.UseCases {
+	return user.NewUseCases(repo, tx, ad)
+}
+
+// ProvideUserPermissionResolver expone los permisos por rol de los usuarios al middleware de autenticación.
+func ProvideUserPermissionResolver(usecases user.UseCases) mdw.UserPermissionResolver {
+	return usecases
 }
 
 func ProvideUserHandler(server ginsrv.Server, usecases user.UseCases, middlewares *mdw.Middlewares) *user.Handler {
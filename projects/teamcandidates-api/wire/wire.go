@@ -10,6 +10,7 @@ import (
 	ginsrv "github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 
 	jwt "github.com/teamcubation/teamcandidates/pkg/authe/jwt/v5"
+	totp "github.com/teamcubation/teamcandidates/pkg/authe/totp"
 	rabbit "github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/producer"
 	redis "github.com/teamcubation/teamcandidates/pkg/databases/cache/redis/v8"
 	cass "github.com/teamcubation/teamcandidates/pkg/databases/nosql/cassandra/gocql"
@@ -19,18 +20,26 @@ import (
 	smtp "github.com/teamcubation/teamcandidates/pkg/notification/smtp"
 	ws "github.com/teamcubation/teamcandidates/pkg/websocket/gorilla"
 
+	apikey "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey"
 	assessment "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	audit "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 	authe "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe"
 	browserevent "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events"
 	candidate "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
 	category "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/category"
 	config "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
 	event "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event"
+	grading "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading"
 	group "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/group"
 	item "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/item"
+	jobopening "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening"
 	macrocategory "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/macrocategory"
 	notification "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/notification"
 	person "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person"
+	pipeline "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline"
+	problem "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem"
+	report "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report"
+	retention "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/retention"
 	supplier "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/supplier"
 	tweet "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet"
 	user "github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
@@ -45,6 +54,7 @@ type Dependencies struct {
 	PostgresRepository  pg.Repository
 	RedisCache          redis.Cache
 	JwtService          jwt.Service
+	TotpService         totp.Service
 	RestyClient         resty.Client
 	SmtpService         smtp.Service
 	RabbitProducer      rabbit.Producer
@@ -68,12 +78,23 @@ type Dependencies struct {
 	CategoryHandler        *category.Handler
 	MacroCategoryHandler   *macrocategory.Handler
 	SupplierHandler        *supplier.Handler
+	ApiKeyHandler          *apikey.Handler
+	AuditHandler           *audit.Handler
+	GradingHandler         *grading.Handler
+	ProblemHandler         *problem.Handler
+	PipelineHandler        *pipeline.Handler
+	JobOpeningHandler      *jobopening.Handler
+	ReportHandler          *report.Handler
 
 	// Para pruebas
 	PersonUseCases person.UseCases
 	UserUseCases   user.UseCases
 	TweetUseCases  tweet.UseCases
 	ItemUseCases   item.UseCases
+
+	AssessmentUseCases assessment.UseCases
+	RetentionUseCases  retention.UseCases
+	GradingUseCases    grading.UseCases
 }
 
 // Initialize se encarga de inyectar todas las dependencias usando Wire.
@@ -83,16 +104,23 @@ func Initialize() (*Dependencies, error) {
 		ProvideConfigLoader,
 		ProvideGinServer,
 		ProvideGormRepository,
+		ProvideGormTxManager,
 		ProvideMongoDbRepository,
 		ProvidePostgresRepository,
 		ProvideJwtMiddleware,
 		ProvideMiddlewares,
 		ProvideRedisCache,
 		ProvideJwtService,
+		ProvideTotpService,
 		ProvideHttpClient,
 		ProvideSmtpService,
 		ProvideRabbitProducer,
+		ProvideRabbitConsumer,
 		ProvideCassandraRepository,
+		ProvideSandboxService,
+		ProvideQualityService,
+		ProvideSimilarityService,
+		ProvideDocumentsService,
 		ProvideWebSocketUpgrader,
 
 		// Person
@@ -113,10 +141,12 @@ func Initialize() (*Dependencies, error) {
 		// User
 		ProvideUserRepository,
 		ProvideUserUseCases,
+		ProvideUserPermissionResolver,
 		ProvideUserHandler,
 
 		// Assessment
 		ProvideAssessmentRepository,
+		ProvideAssessmentBroker,
 		ProvideAssessmentUseCases,
 		ProvideAssessmentHandler,
 
@@ -140,7 +170,9 @@ func Initialize() (*Dependencies, error) {
 		ProvideAutheCache,
 		ProvideAutheHttpClient,
 		ProvideAutheJwtService,
+		ProvideAutheTotpService,
 		ProvideAutheUseCases,
+		ProvideTokenRevocationChecker,
 		ProvideAutheHandler,
 
 		// Tweet
@@ -170,7 +202,206 @@ func Initialize() (*Dependencies, error) {
 		ProvideSupplierUseCases,
 		ProvideSupplierHandler,
 
+		// ApiKey
+		ProvideApiKeyRepository,
+		ProvideApiKeyUseCases,
+		ProvideApiKeyAuthenticator,
+		ProvideApiKeyHandler,
+
+		// Audit
+		ProvideAuditRepository,
+		ProvideAuditUseCases,
+		ProvideAuditHandler,
+
+		// Retention
+		ProvideRetentionUseCases,
+
+		// Hiring pipeline
+		ProvidePipelineRepository,
+		ProvidePipelineUseCases,
+		ProvidePipelineHandler,
+
+		// Grading
+		ProvideGradingRepository,
+		ProvideGradingBroker,
+		ProvideGradingUseCases,
+		ProvideGradingHandler,
+
+		// Problem bank
+		ProvideProblemRepository,
+		ProvideProblemUseCases,
+		ProvideProblemHandler,
+
+		// Job openings
+		ProvideJobOpeningRepository,
+		ProvideJobOpeningUseCases,
+		ProvideJobOpeningHandler,
+
+		// Reports
+		ProvideReportUseCases,
+		ProvideReportHandler,
+
 		wire.Struct(new(Dependencies), "*"),
 	)
 	return &Dependencies{}, nil
 }
+
+// InitializeInMemory inyecta las mismas dependencias que Initialize pero sin servicios externos:
+// los repositorios de Postgres, Mongo y Cassandra se reemplazan por la base en memoria, Redis y
+// RabbitMQ por sus versiones en memoria y GORM usa SQLite. MongoRepository, PostgresRepository y
+// CassandraRepository quedan en nil.
+func InitializeInMemory() (*Dependencies, error) {
+	wire.Build(
+		// Proveedores bootstrap
+		ProvideConfigLoader,
+		ProvideGinServer,
+		ProvideMemoryDB,
+		ProvideMemoryTxManager,
+		ProvideMemoryGormRepository,
+		ProvideJwtMiddleware,
+		ProvideMiddlewares,
+		ProvideMemoryRedisCache,
+		ProvideJwtService,
+		ProvideTotpService,
+		ProvideHttpClient,
+		ProvideSmtpService,
+		ProvideMemoryRabbitProducer,
+		ProvideMemoryRabbitConsumer,
+		ProvideSandboxService,
+		ProvideQualityService,
+		ProvideSimilarityService,
+		ProvideDocumentsService,
+		ProvideWebSocketUpgrader,
+
+		// Person
+		ProvidePersonMemoryRepository,
+		ProvidePersonUseCases,
+		ProvidePersonHandler,
+
+		// Group
+		ProvideGroupRepository,
+		ProvideGroupUseCases,
+		ProvideGroupHandler,
+
+		// Event
+		ProvideEventMemoryRepository,
+		ProvideEventUseCases,
+		ProvideEventHandler,
+
+		// User
+		ProvideUserMemoryRepository,
+		ProvideUserUseCases,
+		ProvideUserPermissionResolver,
+		ProvideUserHandler,
+
+		// Assessment
+		ProvideAssessmentMemoryRepository,
+		ProvideAssessmentBroker,
+		ProvideAssessmentUseCases,
+		ProvideAssessmentHandler,
+
+		// Candidate
+		ProvideCandidateMemoryRepository,
+		ProvideCandidateUseCases,
+		ProvideCandidateHandler,
+
+		// Browser Events
+		ProvideBrowserEventsMemoryRepository,
+		ProvideBrowserEventsUseCases,
+		ProvideBrowserEventsWebsocket,
+		ProvideBrowserEventsHandler,
+
+		// Notification
+		ProvideNotificationSmtpService,
+		ProvideNotificationUseCases,
+		ProvideNotificationHandler,
+
+		// Authe
+		ProvideAutheCache,
+		ProvideAutheHttpClient,
+		ProvideAutheJwtService,
+		ProvideAutheTotpService,
+		ProvideAutheUseCases,
+		ProvideTokenRevocationChecker,
+		ProvideAutheHandler,
+
+		// Tweet
+		ProvideTweetBroker,
+		ProvideTweetCache,
+		ProvideTweetMemoryRepository,
+		ProvideTweetUseCases,
+		ProvideTweetHandler,
+
+		// Item
+		ProvideItemRepository,
+		ProvideItemUseCases,
+		ProvideItemHandler,
+
+		// Category
+		ProvideCategoryRepository,
+		ProvideCategoryUseCases,
+		ProvideCategoryHandler,
+
+		// MacroCategory
+		ProvideMacroCategoryRepository,
+		ProvideMacroCategoryUseCases,
+		ProvideMacroCategoryHandler,
+
+		// Supplier
+		ProvideSupplierRepository,
+		ProvideSupplierUseCases,
+		ProvideSupplierHandler,
+
+		// ApiKey
+		ProvideApiKeyRepository,
+		ProvideApiKeyUseCases,
+		ProvideApiKeyAuthenticator,
+		ProvideApiKeyHandler,
+
+		// Audit
+		ProvideAuditMemoryRepository,
+		ProvideAuditUseCases,
+		ProvideAuditHandler,
+
+		// Retention
+		ProvideRetentionUseCases,
+
+		// Hiring pipeline
+		ProvidePipelineMemoryRepository,
+		ProvidePipelineUseCases,
+		ProvidePipelineHandler,
+
+		// Grading
+		ProvideGradingMemoryRepository,
+		ProvideGradingBroker,
+		ProvideGradingUseCases,
+		ProvideGradingHandler,
+
+		// Problem bank
+		ProvideProblemMemoryRepository,
+		ProvideProblemUseCases,
+		ProvideProblemHandler,
+
+		// Job openings
+		ProvideJobOpeningMemoryRepository,
+		ProvideJobOpeningUseCases,
+		ProvideJobOpeningHandler,
+
+		// Reports
+		ProvideReportUseCases,
+		ProvideReportHandler,
+
+		wire.Struct(new(Dependencies),
+			"ConfigLoader", "GinServer", "GormRepository", "RedisCache", "JwtService", "TotpService",
+			"RestyClient", "SmtpService", "RabbitProducer", "WebSocket", "Middlewares",
+			"PersonHandler", "GroupHandler", "EventHandler", "UserHandler", "AssessmentHandler",
+			"CandidateHandler", "BrowserEventsHandler", "BrowserEventsWebSocket", "AutheHandler",
+			"NotificationHandler", "TweetHandler", "ItemHandler", "CategoryHandler",
+			"MacroCategoryHandler", "SupplierHandler", "ApiKeyHandler", "AuditHandler", "GradingHandler",
+			"ProblemHandler", "PipelineHandler", "JobOpeningHandler", "ReportHandler",
+			"PersonUseCases", "UserUseCases", "TweetUseCases", "ItemUseCases", "RetentionUseCases",
+			"AssessmentUseCases", "GradingUseCases",
+		),
+	)
+	return &Dependencies{}, nil
+}
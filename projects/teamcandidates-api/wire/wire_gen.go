@@ -8,6 +8,7 @@ package wire
 
 import (
 	"github.com/teamcubation/teamcandidates/pkg/authe/jwt/v5"
+	"github.com/teamcubation/teamcandidates/pkg/authe/totp"
 	"github.com/teamcubation/teamcandidates/pkg/brokers/rabbitmq/amqp091/producer"
 	"github.com/teamcubation/teamcandidates/pkg/databases/cache/redis/v8"
 	"github.com/teamcubation/teamcandidates/pkg/databases/nosql/cassandra/gocql"
@@ -19,18 +20,26 @@ import (
 	"github.com/teamcubation/teamcandidates/pkg/http/servers/gin"
 	"github.com/teamcubation/teamcandidates/pkg/notification/smtp"
 	"github.com/teamcubation/teamcandidates/pkg/websocket/gorilla"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/apikey"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/assessment"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/audit"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/authe"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/browser-events"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/candidate"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/category"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/config"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/event"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/grading"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/group"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/item"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/jobopening"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/macrocategory"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/notification"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/person"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/pipeline"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/problem"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/report"
+	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/retention"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/supplier"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/tweet"
 	"github.com/teamcubation/teamcandidates/projects/teamcandidates-api/internal/user"
@@ -52,6 +61,7 @@ func Initialize() (*Dependencies, error) {
 	if err != nil {
 		return nil, err
 	}
+	manager := ProvideGormTxManager(repository)
 	pkgmongoRepository, err := ProvideMongoDbRepository()
 	if err != nil {
 		return nil, err
@@ -68,6 +78,10 @@ func Initialize() (*Dependencies, error) {
 	if err != nil {
 		return nil, err
 	}
+	totpService, err := ProvideTotpService()
+	if err != nil {
+		return nil, err
+	}
 	client, err := ProvideHttpClient()
 	if err != nil {
 		return nil, err
@@ -80,6 +94,10 @@ func Initialize() (*Dependencies, error) {
 	if err != nil {
 		return nil, err
 	}
+	consumer, err := ProvideRabbitConsumer()
+	if err != nil {
+		return nil, err
+	}
 	pkgcassandraRepository, err := ProvideCassandraRepository()
 	if err != nil {
 		return nil, err
@@ -92,7 +110,47 @@ func Initialize() (*Dependencies, error) {
 	if err != nil {
 		return nil, err
 	}
-	middlewares, err := ProvideMiddlewares(handlerFunc)
+	apikeyRepository, err := ProvideApiKeyRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	apikeyUseCases := ProvideApiKeyUseCases(apikeyRepository)
+	apiKeyAuthenticator := ProvideApiKeyAuthenticator(apikeyUseCases)
+	auditRepository, err := ProvideAuditRepository(pkgmongoRepository)
+	if err != nil {
+		return nil, err
+	}
+	auditUseCases := ProvideAuditUseCases(auditRepository)
+	userRepository, err := ProvideUserRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	userUseCases := ProvideUserUseCases(userRepository, manager, auditUseCases)
+	userPermissionResolver := ProvideUserPermissionResolver(userUseCases)
+	smtpService, err := ProvideNotificationSmtpService(pkgsmtpService)
+	if err != nil {
+		return nil, err
+	}
+	notificationUseCases := ProvideNotificationUseCases(smtpService)
+	autheCache, err := ProvideAutheCache(cache)
+	if err != nil {
+		return nil, err
+	}
+	jwtService, err := ProvideAutheJwtService(service, loader)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := ProvideAutheHttpClient(client, loader)
+	if err != nil {
+		return nil, err
+	}
+	autheTotpService, err := ProvideAutheTotpService(totpService)
+	if err != nil {
+		return nil, err
+	}
+	autheUseCases := ProvideAutheUseCases(autheCache, jwtService, httpClient, autheTotpService, userUseCases, notificationUseCases, loader, auditUseCases)
+	tokenRevocationChecker := ProvideTokenRevocationChecker(autheUseCases)
+	middlewares, err := ProvideMiddlewares(handlerFunc, apiKeyAuthenticator, userPermissionResolver, tokenRevocationChecker, auditUseCases)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +158,7 @@ func Initialize() (*Dependencies, error) {
 	if err != nil {
 		return nil, err
 	}
-	useCases := ProvidePersonUseCases(personRepository)
+	useCases := ProvidePersonUseCases(personRepository, auditUseCases)
 	handler := ProvidePersonHandler(server, useCases, middlewares)
 	groupRepository, err := ProvideGroupRepository(repository)
 	if err != nil {
@@ -114,26 +172,238 @@ func Initialize() (*Dependencies, error) {
 	}
 	eventUseCases := ProvideEventUseCases(eventRepository)
 	eventHandler := ProvideEventHandler(server, eventUseCases, middlewares)
-	userRepository, err := ProvideUserRepository(repository)
+	userHandler := ProvideUserHandler(server, userUseCases, middlewares)
+	assessmentRepository, err := ProvideAssessmentRepository(repository)
 	if err != nil {
 		return nil, err
 	}
-	userUseCases := ProvideUserUseCases(userRepository)
-	userHandler := ProvideUserHandler(server, userUseCases, middlewares)
-	assessmentRepository, err := ProvideAssessmentRepository(repository)
+	candidateRepository, err := ProvideCandidateRepository(repository)
 	if err != nil {
 		return nil, err
 	}
-	smtpService, err := ProvideNotificationSmtpService(pkgsmtpService)
+	candidateUseCases := ProvideCandidateUseCases(candidateRepository, auditUseCases)
+	assessmentBroker, err := ProvideAssessmentBroker(producer, loader)
 	if err != nil {
 		return nil, err
 	}
-	notificationUseCases := ProvideNotificationUseCases(smtpService)
-	candidateRepository, err := ProvideCandidateRepository(repository)
+	assessmentUseCases := ProvideAssessmentUseCases(assessmentRepository, manager, notificationUseCases, candidateUseCases, loader, autheUseCases, useCases, auditUseCases, assessmentBroker)
+	assessmentHandler := ProvideAssessmentHandler(server, assessmentUseCases, middlewares)
+	candidateHandler := ProvideCandidateHandler(server, candidateUseCases, middlewares)
+	browserEventRepository, err := ProvideBrowserEventsRepository(pkgmongoRepository)
+	if err != nil {
+		return nil, err
+	}
+	browserEventUseCases := ProvideBrowserEventsUseCases(browserEventRepository)
+	webSocket := ProvideBrowserEventsWebsocket(browserEventUseCases, upgrader)
+	browserEventHandler := ProvideBrowserEventsHandler(server, browserEventUseCases, middlewares, webSocket)
+	autheHandler := ProvideAutheHandler(server, autheUseCases, middlewares)
+	notificationHandler := ProvideNotificationHandler(server, notificationUseCases, middlewares)
+	tweetRepository, err := ProvideTweetRepository(pkgcassandraRepository)
+	if err != nil {
+		return nil, err
+	}
+	tweetCache, err := ProvideTweetCache(cache)
+	if err != nil {
+		return nil, err
+	}
+	broker, err := ProvideTweetBroker(producer)
+	if err != nil {
+		return nil, err
+	}
+	tweetUseCases := ProvideTweetUseCases(tweetRepository, userUseCases, tweetCache, broker)
+	tweetHandler := ProvideTweetHandler(server, tweetUseCases, middlewares)
+	itemRepository, err := ProvideItemRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	itemUseCases := ProvideItemUseCases(itemRepository, loader, autheUseCases)
+	itemHandler := ProvideItemHandler(server, itemUseCases, middlewares)
+	categoryRepository, err := ProvideCategoryRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	categoryUseCases := ProvideCategoryUseCases(categoryRepository)
+	categoryHandler := ProvideCategoryHandler(server, categoryUseCases, middlewares)
+	macrocategoryRepository, err := ProvideMacroCategoryRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	macrocategoryUseCases := ProvideMacroCategoryUseCases(macrocategoryRepository)
+	macrocategoryHandler := ProvideMacroCategoryHandler(server, macrocategoryUseCases, middlewares)
+	supplierRepository, err := ProvideSupplierRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	supplierUseCases := ProvideSupplierUseCases(supplierRepository)
+	supplierHandler := ProvideSupplierHandler(server, supplierUseCases, middlewares)
+	apikeyHandler := ProvideApiKeyHandler(server, apikeyUseCases, middlewares)
+	auditHandler := ProvideAuditHandler(server, auditUseCases, middlewares)
+	retentionUseCases := ProvideRetentionUseCases(loader, useCases, userUseCases, candidateUseCases, assessmentUseCases, itemUseCases)
+	gradingRepository, err := ProvideGradingRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	gradingBroker, err := ProvideGradingBroker(producer, consumer, loader)
+	if err != nil {
+		return nil, err
+	}
+	pkgsandboxService, err := ProvideSandboxService()
+	if err != nil {
+		return nil, err
+	}
+	pkgqualityService, err := ProvideQualityService()
+	if err != nil {
+		return nil, err
+	}
+	pkgsimilarityService, err := ProvideSimilarityService()
+	if err != nil {
+		return nil, err
+	}
+	pipelineRepository, err := ProvidePipelineRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	pipelineUseCases := ProvidePipelineUseCases(pipelineRepository, manager, assessmentUseCases, candidateUseCases, auditUseCases)
+	pipelineHandler := ProvidePipelineHandler(server, pipelineUseCases, middlewares)
+	gradingUseCases := ProvideGradingUseCases(gradingRepository, gradingBroker, pkgsandboxService, pkgqualityService, pkgsimilarityService, assessmentUseCases, pipelineUseCases, loader)
+	gradingHandler := ProvideGradingHandler(server, gradingUseCases, middlewares)
+	problemRepository, err := ProvideProblemRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	problemUseCases := ProvideProblemUseCases(problemRepository, manager, assessmentUseCases, candidateUseCases, auditUseCases)
+	problemHandler := ProvideProblemHandler(server, problemUseCases, middlewares)
+	jobopeningRepository, err := ProvideJobOpeningRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	jobopeningUseCases := ProvideJobOpeningUseCases(jobopeningRepository, manager, assessmentUseCases, candidateUseCases, problemUseCases, pipelineUseCases, userUseCases, auditUseCases)
+	jobopeningHandler := ProvideJobOpeningHandler(server, jobopeningUseCases, middlewares)
+	pkgdocumentsService, err := ProvideDocumentsService()
+	if err != nil {
+		return nil, err
+	}
+	reportUseCases := ProvideReportUseCases(assessmentUseCases, candidateUseCases, gradingUseCases, browserEventUseCases, pkgdocumentsService, loader)
+	reportHandler := ProvideReportHandler(server, reportUseCases, middlewares)
+	dependencies := &Dependencies{
+		ConfigLoader:           loader,
+		GinServer:              server,
+		GormRepository:         repository,
+		MongoRepository:        pkgmongoRepository,
+		PostgresRepository:     pkgpostgresqlRepository,
+		RedisCache:             cache,
+		JwtService:             service,
+		TotpService:            totpService,
+		RestyClient:            client,
+		SmtpService:            pkgsmtpService,
+		RabbitProducer:         producer,
+		CassandraRepository:    pkgcassandraRepository,
+		WebSocket:              upgrader,
+		Middlewares:            middlewares,
+		PersonHandler:          handler,
+		GroupHandler:           groupHandler,
+		EventHandler:           eventHandler,
+		UserHandler:            userHandler,
+		AssessmentHandler:      assessmentHandler,
+		CandidateHandler:       candidateHandler,
+		BrowserEventsHandler:   browserEventHandler,
+		BrowserEventsWebSocket: webSocket,
+		AutheHandler:           autheHandler,
+		NotificationHandler:    notificationHandler,
+		TweetHandler:           tweetHandler,
+		ItemHandler:            itemHandler,
+		CategoryHandler:        categoryHandler,
+		MacroCategoryHandler:   macrocategoryHandler,
+		SupplierHandler:        supplierHandler,
+		ApiKeyHandler:          apikeyHandler,
+		AuditHandler:           auditHandler,
+		GradingHandler:         gradingHandler,
+		ProblemHandler:         problemHandler,
+		PipelineHandler:        pipelineHandler,
+		JobOpeningHandler:      jobopeningHandler,
+		ReportHandler:          reportHandler,
+		PersonUseCases:         useCases,
+		UserUseCases:           userUseCases,
+		TweetUseCases:          tweetUseCases,
+		ItemUseCases:           itemUseCases,
+		AssessmentUseCases:     assessmentUseCases,
+		RetentionUseCases:      retentionUseCases,
+		GradingUseCases:        gradingUseCases,
+	}
+	return dependencies, nil
+}
+
+// InitializeInMemory inyecta las mismas dependencias que Initialize pero sin servicios externos:
+// los repositorios de Postgres, Mongo y Cassandra se reemplazan por la base en memoria, Redis y
+// RabbitMQ por sus versiones en memoria y GORM usa SQLite. MongoRepository, PostgresRepository y
+// CassandraRepository quedan en nil.
+func InitializeInMemory() (*Dependencies, error) {
+	loader, err := ProvideConfigLoader()
+	if err != nil {
+		return nil, err
+	}
+	server, err := ProvideGinServer()
+	if err != nil {
+		return nil, err
+	}
+	pkgmapdbRepository := ProvideMemoryDB()
+	manager := ProvideMemoryTxManager(pkgmapdbRepository)
+	repository, err := ProvideMemoryGormRepository()
+	if err != nil {
+		return nil, err
+	}
+	cache := ProvideMemoryRedisCache()
+	service, err := ProvideJwtService()
+	if err != nil {
+		return nil, err
+	}
+	totpService, err := ProvideTotpService()
+	if err != nil {
+		return nil, err
+	}
+	client, err := ProvideHttpClient()
+	if err != nil {
+		return nil, err
+	}
+	pkgsmtpService, err := ProvideSmtpService()
+	if err != nil {
+		return nil, err
+	}
+	producer := ProvideMemoryRabbitProducer()
+	consumer, err := ProvideMemoryRabbitConsumer(producer)
+	if err != nil {
+		return nil, err
+	}
+	upgrader, err := ProvideWebSocketUpgrader()
+	if err != nil {
+		return nil, err
+	}
+	handlerFunc, err := ProvideJwtMiddleware()
+	if err != nil {
+		return nil, err
+	}
+	apikeyRepository, err := ProvideApiKeyRepository(repository)
 	if err != nil {
 		return nil, err
 	}
-	candidateUseCases := ProvideCandidateUseCases(candidateRepository)
+	apikeyUseCases := ProvideApiKeyUseCases(apikeyRepository)
+	apiKeyAuthenticator := ProvideApiKeyAuthenticator(apikeyUseCases)
+	auditRepository, err := ProvideAuditMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	auditUseCases := ProvideAuditUseCases(auditRepository)
+	userRepository, err := ProvideUserMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	userUseCases := ProvideUserUseCases(userRepository, manager, auditUseCases)
+	userPermissionResolver := ProvideUserPermissionResolver(userUseCases)
+	smtpService, err := ProvideNotificationSmtpService(pkgsmtpService)
+	if err != nil {
+		return nil, err
+	}
+	notificationUseCases := ProvideNotificationUseCases(smtpService)
 	autheCache, err := ProvideAutheCache(cache)
 	if err != nil {
 		return nil, err
@@ -146,11 +416,52 @@ func Initialize() (*Dependencies, error) {
 	if err != nil {
 		return nil, err
 	}
-	autheUseCases := ProvideAutheUseCases(autheCache, jwtService, httpClient)
-	assessmentUseCases := ProvideAssessmentUseCases(assessmentRepository, notificationUseCases, candidateUseCases, loader, autheUseCases, useCases)
+	autheTotpService, err := ProvideAutheTotpService(totpService)
+	if err != nil {
+		return nil, err
+	}
+	autheUseCases := ProvideAutheUseCases(autheCache, jwtService, httpClient, autheTotpService, userUseCases, notificationUseCases, loader, auditUseCases)
+	tokenRevocationChecker := ProvideTokenRevocationChecker(autheUseCases)
+	middlewares, err := ProvideMiddlewares(handlerFunc, apiKeyAuthenticator, userPermissionResolver, tokenRevocationChecker, auditUseCases)
+	if err != nil {
+		return nil, err
+	}
+	personRepository, err := ProvidePersonMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	useCases := ProvidePersonUseCases(personRepository, auditUseCases)
+	handler := ProvidePersonHandler(server, useCases, middlewares)
+	groupRepository, err := ProvideGroupRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	groupUseCases := ProvideGroupUseCases(groupRepository)
+	groupHandler := ProvideGroupHandler(server, groupUseCases, middlewares)
+	eventRepository, err := ProvideEventMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	eventUseCases := ProvideEventUseCases(eventRepository)
+	eventHandler := ProvideEventHandler(server, eventUseCases, middlewares)
+	userHandler := ProvideUserHandler(server, userUseCases, middlewares)
+	assessmentRepository, err := ProvideAssessmentMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	candidateRepository, err := ProvideCandidateMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	candidateUseCases := ProvideCandidateUseCases(candidateRepository, auditUseCases)
+	assessmentBroker, err := ProvideAssessmentBroker(producer, loader)
+	if err != nil {
+		return nil, err
+	}
+	assessmentUseCases := ProvideAssessmentUseCases(assessmentRepository, manager, notificationUseCases, candidateUseCases, loader, autheUseCases, useCases, auditUseCases, assessmentBroker)
 	assessmentHandler := ProvideAssessmentHandler(server, assessmentUseCases, middlewares)
 	candidateHandler := ProvideCandidateHandler(server, candidateUseCases, middlewares)
-	browserEventRepository, err := ProvideBrowserEventsRepository(pkgmongoRepository)
+	browserEventRepository, err := ProvideBrowserEventsMemoryRepository(pkgmapdbRepository)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +470,7 @@ func Initialize() (*Dependencies, error) {
 	browserEventHandler := ProvideBrowserEventsHandler(server, browserEventUseCases, middlewares, webSocket)
 	autheHandler := ProvideAutheHandler(server, autheUseCases, middlewares)
 	notificationHandler := ProvideNotificationHandler(server, notificationUseCases, middlewares)
-	tweetRepository, err := ProvideTweetRepository(pkgcassandraRepository)
+	tweetRepository, err := ProvideTweetMemoryRepository(pkgmapdbRepository)
 	if err != nil {
 		return nil, err
 	}
@@ -197,18 +508,65 @@ func Initialize() (*Dependencies, error) {
 	}
 	supplierUseCases := ProvideSupplierUseCases(supplierRepository)
 	supplierHandler := ProvideSupplierHandler(server, supplierUseCases, middlewares)
+	apikeyHandler := ProvideApiKeyHandler(server, apikeyUseCases, middlewares)
+	auditHandler := ProvideAuditHandler(server, auditUseCases, middlewares)
+	retentionUseCases := ProvideRetentionUseCases(loader, useCases, userUseCases, candidateUseCases, assessmentUseCases, itemUseCases)
+	gradingRepository, err := ProvideGradingMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	gradingBroker, err := ProvideGradingBroker(producer, consumer, loader)
+	if err != nil {
+		return nil, err
+	}
+	pkgsandboxService, err := ProvideSandboxService()
+	if err != nil {
+		return nil, err
+	}
+	pkgqualityService, err := ProvideQualityService()
+	if err != nil {
+		return nil, err
+	}
+	pkgsimilarityService, err := ProvideSimilarityService()
+	if err != nil {
+		return nil, err
+	}
+	pipelineRepository, err := ProvidePipelineMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	pipelineUseCases := ProvidePipelineUseCases(pipelineRepository, manager, assessmentUseCases, candidateUseCases, auditUseCases)
+	pipelineHandler := ProvidePipelineHandler(server, pipelineUseCases, middlewares)
+	gradingUseCases := ProvideGradingUseCases(gradingRepository, gradingBroker, pkgsandboxService, pkgqualityService, pkgsimilarityService, assessmentUseCases, pipelineUseCases, loader)
+	gradingHandler := ProvideGradingHandler(server, gradingUseCases, middlewares)
+	problemRepository, err := ProvideProblemMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	problemUseCases := ProvideProblemUseCases(problemRepository, manager, assessmentUseCases, candidateUseCases, auditUseCases)
+	problemHandler := ProvideProblemHandler(server, problemUseCases, middlewares)
+	jobopeningRepository, err := ProvideJobOpeningMemoryRepository(pkgmapdbRepository)
+	if err != nil {
+		return nil, err
+	}
+	jobopeningUseCases := ProvideJobOpeningUseCases(jobopeningRepository, manager, assessmentUseCases, candidateUseCases, problemUseCases, pipelineUseCases, userUseCases, auditUseCases)
+	jobopeningHandler := ProvideJobOpeningHandler(server, jobopeningUseCases, middlewares)
+	pkgdocumentsService, err := ProvideDocumentsService()
+	if err != nil {
+		return nil, err
+	}
+	reportUseCases := ProvideReportUseCases(assessmentUseCases, candidateUseCases, gradingUseCases, browserEventUseCases, pkgdocumentsService, loader)
+	reportHandler := ProvideReportHandler(server, reportUseCases, middlewares)
 	dependencies := &Dependencies{
 		ConfigLoader:           loader,
 		GinServer:              server,
 		GormRepository:         repository,
-		MongoRepository:        pkgmongoRepository,
-		PostgresRepository:     pkgpostgresqlRepository,
 		RedisCache:             cache,
 		JwtService:             service,
+		TotpService:            totpService,
 		RestyClient:            client,
 		SmtpService:            pkgsmtpService,
 		RabbitProducer:         producer,
-		CassandraRepository:    pkgcassandraRepository,
 		WebSocket:              upgrader,
 		Middlewares:            middlewares,
 		PersonHandler:          handler,
@@ -226,10 +584,20 @@ func Initialize() (*Dependencies, error) {
 		CategoryHandler:        categoryHandler,
 		MacroCategoryHandler:   macrocategoryHandler,
 		SupplierHandler:        supplierHandler,
+		ApiKeyHandler:          apikeyHandler,
+		AuditHandler:           auditHandler,
+		GradingHandler:         gradingHandler,
+		ProblemHandler:         problemHandler,
+		PipelineHandler:        pipelineHandler,
+		JobOpeningHandler:      jobopeningHandler,
+		ReportHandler:          reportHandler,
 		PersonUseCases:         useCases,
 		UserUseCases:           userUseCases,
 		TweetUseCases:          tweetUseCases,
 		ItemUseCases:           itemUseCases,
+		AssessmentUseCases:     assessmentUseCases,
+		RetentionUseCases:      retentionUseCases,
+		GradingUseCases:        gradingUseCases,
 	}
 	return dependencies, nil
 }
@@ -245,6 +613,7 @@ type Dependencies struct {
 	PostgresRepository  pkgpostgresql.Repository
 	RedisCache          pkgredis.Cache
 	JwtService          pkgjwt.Service
+	TotpService         pkgtotp.Service
 	RestyClient         pkcresty.Client
 	SmtpService         pkgsmtp.Service
 	RabbitProducer      pkgrabbit.Producer
@@ -268,10 +637,21 @@ type Dependencies struct {
 	CategoryHandler        *category.Handler
 	MacroCategoryHandler   *macrocategory.Handler
 	SupplierHandler        *supplier.Handler
+	ApiKeyHandler          *apikey.Handler
+	AuditHandler           *audit.Handler
+	GradingHandler         *grading.Handler
+	ProblemHandler         *problem.Handler
+	PipelineHandler        *pipeline.Handler
+	JobOpeningHandler      *jobopening.Handler
+	ReportHandler          *report.Handler
 
 	// Para pruebas
 	PersonUseCases person.UseCases
 	UserUseCases   user.UseCases
 	TweetUseCases  tweet.UseCases
 	ItemUseCases   item.UseCases
+
+	AssessmentUseCases assessment.UseCases
+	RetentionUseCases  retention.UseCases
+	GradingUseCases    grading.UseCases
 }